@@ -4,11 +4,13 @@ import (
 	"log"
 	"net"
 	"os"
+	"time"
 
 	"course-platform/internal/configs"
 	"course-platform/internal/domain/content/model"
 	"course-platform/internal/domain/content/repository"
 	"course-platform/internal/domain/content/service"
+	courseRepository "course-platform/internal/domain/course/repository"
 	"course-platform/internal/infrastructure/db"
 	"course-platform/internal/shared/pb/contentpb"
 	"course-platform/internal/transport/grpc"
@@ -33,7 +35,7 @@ func main() {
 	log.Println("✅ 成功连接到 MySQL 数据库")
 
 	// 数据库迁移
-	if err := database.AutoMigrate(&model.FileInfo{}); err != nil {
+	if err := database.AutoMigrate(&model.FileInfo{}, &model.File{}, &model.FileVersion{}, &model.HLSKey{}); err != nil {
 		log.Fatalf("❌ 数据库迁移失败: %v", err)
 	}
 	log.Println("✅ 数据库迁移完成")
@@ -55,10 +57,17 @@ func main() {
 
 	// 初始化仓库层
 	contentRepo := repository.NewContentRepository(database, rdb)
+	courseRepo := courseRepository.NewCourseRepository(database, rdb) // 私有文件鉴权需要查询课程讲师
 
 	// 初始化服务层
 	baseURL := "http://localhost:8083/uploads" // API Gateway作为文件访问代理
-	contentService := service.NewContentService(contentRepo, uploadDir, baseURL)
+	hlsOptions := service.HLSOptions{
+		Enabled:         cfg.HLS.Enabled,
+		Encrypt:         cfg.HLS.Encrypt,
+		SegmentDuration: time.Duration(cfg.HLS.SegmentSeconds) * time.Second,
+		KeyURLFormat:    "/api/v1/content/files/%d/hls/key", // 由API Gateway鉴权后下发密钥
+	}
+	contentService := service.NewContentService(contentRepo, courseRepo, uploadDir, baseURL, hlsOptions)
 
 	// 初始化gRPC处理器
 	contentHandler := grpc.NewContentHandler(contentService)
@@ -1,16 +1,78 @@
 ﻿package main
 
 import (
+	"context"
 	"log"
 	"net"
+	"strings"
+	_ "time/tzdata" // 班期和直播按IANA时区解析，容器镜像可能不带时区数据库
 
 	"course-platform/internal/configs"
+	announcementModel "course-platform/internal/domain/announcement/model"
+	announcementRepository "course-platform/internal/domain/announcement/repository"
+	announcementService "course-platform/internal/domain/announcement/service"
+	assignmentModel "course-platform/internal/domain/assignment/model"
+	assignmentRepository "course-platform/internal/domain/assignment/repository"
+	assignmentService "course-platform/internal/domain/assignment/service"
+	bundleModel "course-platform/internal/domain/bundle/model"
+	bundleRepository "course-platform/internal/domain/bundle/repository"
+	bundleService "course-platform/internal/domain/bundle/service"
+	certificateModel "course-platform/internal/domain/certificate/model"
+	certificateRepository "course-platform/internal/domain/certificate/repository"
+	certificateService "course-platform/internal/domain/certificate/service"
+	cohortModel "course-platform/internal/domain/cohort/model"
+	cohortRepository "course-platform/internal/domain/cohort/repository"
+	cohortService "course-platform/internal/domain/cohort/service"
+	couponModel "course-platform/internal/domain/coupon/model"
+	couponRepository "course-platform/internal/domain/coupon/repository"
+	couponService "course-platform/internal/domain/coupon/service"
 	"course-platform/internal/domain/course/model"
 	"course-platform/internal/domain/course/repository"
 	"course-platform/internal/domain/course/service"
+	discussionModel "course-platform/internal/domain/discussion/model"
+	discussionRepository "course-platform/internal/domain/discussion/repository"
+	discussionService "course-platform/internal/domain/discussion/service"
+	emailModel "course-platform/internal/domain/email/model"
+	emailRepository "course-platform/internal/domain/email/repository"
+	emailService "course-platform/internal/domain/email/service"
+	ledgerModel "course-platform/internal/domain/ledger/model"
+	ledgerRepository "course-platform/internal/domain/ledger/repository"
+	ledgerService "course-platform/internal/domain/ledger/service"
+	notificationModel "course-platform/internal/domain/notification/model"
+	notificationRepository "course-platform/internal/domain/notification/repository"
+	notificationService "course-platform/internal/domain/notification/service"
+	orderModel "course-platform/internal/domain/order/model"
+	orderRepository "course-platform/internal/domain/order/repository"
+	orderService "course-platform/internal/domain/order/service"
+	privacyModel "course-platform/internal/domain/privacy/model"
+	privacyRepository "course-platform/internal/domain/privacy/repository"
+	privacyService "course-platform/internal/domain/privacy/service"
+	quizModel "course-platform/internal/domain/quiz/model"
+	quizRepository "course-platform/internal/domain/quiz/repository"
+	quizService "course-platform/internal/domain/quiz/service"
+	refundModel "course-platform/internal/domain/refund/model"
+	refundRepository "course-platform/internal/domain/refund/repository"
+	refundService "course-platform/internal/domain/refund/service"
 	userRepository "course-platform/internal/domain/user/repository"
 	"course-platform/internal/infrastructure/db"
+	grpcClient "course-platform/internal/infrastructure/grpc_client"
+	"course-platform/internal/infrastructure/mail"
+	"course-platform/internal/infrastructure/payment"
+	"course-platform/internal/shared/pb/announcementpb"
+	"course-platform/internal/shared/pb/assignmentpb"
+	"course-platform/internal/shared/pb/bundlepb"
+	"course-platform/internal/shared/pb/certificatepb"
+	"course-platform/internal/shared/pb/cohortpb"
+	"course-platform/internal/shared/pb/couponpb"
 	"course-platform/internal/shared/pb/coursepb"
+	"course-platform/internal/shared/pb/discussionpb"
+	"course-platform/internal/shared/pb/emailpb"
+	"course-platform/internal/shared/pb/ledgerpb"
+	"course-platform/internal/shared/pb/notificationpb"
+	"course-platform/internal/shared/pb/orderpb"
+	"course-platform/internal/shared/pb/privacypb"
+	"course-platform/internal/shared/pb/quizpb"
+	"course-platform/internal/shared/pb/refundpb"
 	"course-platform/internal/transport/grpc"
 
 	grpcServer "google.golang.org/grpc"
@@ -38,6 +100,43 @@ func main() {
 	// 3. 数据库自动迁移
 	err = database.AutoMigrate(
 		&model.Course{},
+		&model.Enrollment{},
+		&model.EnrollmentGrant{},
+		&model.Chapter{},
+		&model.LessonProgress{},
+		&model.CoursePrerequisite{},
+		&quizModel.Question{},
+		&quizModel.Quiz{},
+		&quizModel.QuizItem{},
+		&quizModel.QuizAttempt{},
+		&quizModel.AttemptAnswer{},
+		&assignmentModel.Assignment{},
+		&assignmentModel.Submission{},
+		&certificateModel.Certificate{},
+		&certificateModel.CertificateTemplate{},
+		&orderModel.Order{},
+		&couponModel.Coupon{},
+		&couponModel.CouponRedemption{},
+		&refundModel.Refund{},
+		&refundModel.RefundAuditLog{},
+		&ledgerModel.LedgerEntry{},
+		&bundleModel.Bundle{},
+		&bundleModel.BundleCourse{},
+		&bundleModel.BundleEnrollment{},
+		&cohortModel.Cohort{},
+		&cohortModel.CohortMember{},
+		&cohortModel.LiveSession{},
+		&cohortModel.CalendarFeed{},
+		&discussionModel.Thread{},
+		&discussionModel.Reply{},
+		&discussionModel.Vote{},
+		&notificationModel.Notification{},
+		&notificationModel.Preference{},
+		&announcementModel.Announcement{},
+		&emailModel.Outbox{},
+		&emailModel.Preference{},
+		&privacyModel.DataExport{},
+		&privacyModel.AccountDeletion{},
 	)
 	if err != nil {
 		log.Fatalf("❌ 数据库迁移失败: %v", err)
@@ -56,18 +155,138 @@ func main() {
 	// 5. 初始化仓储层
 	courseRepo := repository.NewCourseRepository(database, redisClient)
 	userRepo := userRepository.NewUserRepository(database, redisClient)
+	enrollmentRepo := repository.NewEnrollmentRepository(database)
+	chapterRepo := repository.NewChapterRepository(database)
+	quizRepo := quizRepository.NewQuizRepository(database)
+	assignmentRepo := assignmentRepository.NewAssignmentRepository(database)
+	progressRepo := repository.NewProgressRepository(database)
+	prerequisiteRepo := repository.NewPrerequisiteRepository(database)
+	certificateRepo := certificateRepository.NewCertificateRepository(database)
+	orderRepo := orderRepository.NewOrderRepository(database)
+	couponRepo := couponRepository.NewCouponRepository(database)
+	refundRepo := refundRepository.NewRefundRepository(database)
+	ledgerRepo := ledgerRepository.NewLedgerRepository(database)
+	bundleRepo := bundleRepository.NewBundleRepository(database)
+	cohortRepo := cohortRepository.NewCohortRepository(database)
+	liveSessionRepo := cohortRepository.NewLiveSessionRepository(database)
+	calendarFeedRepo := cohortRepository.NewCalendarFeedRepository(database)
+	discussionRepo := discussionRepository.NewDiscussionRepository(database)
+	notificationRepo := notificationRepository.NewNotificationRepository(database)
+	announcementRepo := announcementRepository.NewAnnouncementRepository(database)
+	emailRepo := emailRepository.NewEmailRepository(database)
+	privacyRepo := privacyRepository.NewPrivacyRepository(database)
+
+	// 证书PDF保存到内容服务
+	contentClient, err := grpcClient.NewContentGRPCClientService(configs.GetServiceAddresses().ContentService)
+	if err != nil {
+		log.Fatalf("❌ 连接内容服务失败: %v", err)
+	}
+	defer contentClient.Close()
+
+	// 注销账号时由用户服务吊销令牌并删除账号
+	userClient, err := grpcClient.NewUserGRPCClientService()
+	if err != nil {
+		log.Fatalf("❌ 连接用户服务失败: %v", err)
+	}
+	defer userClient.Close()
+
+	// 支付渠道
+	var paymentProvider payment.Provider
+	switch config.Payment.Provider {
+	case "", payment.ProviderFake:
+		if !config.Server.IsDevelopment() {
+			log.Fatalf("❌ 模拟支付渠道只能在开发或测试环境使用，请配置真实支付渠道（当前环境: %q）", config.Server.Environment)
+		}
+		paymentProvider = payment.NewFakeProvider(config.Payment.WebhookSecret)
+		log.Println("⚠️ 使用模拟支付渠道，仅适用于开发和测试环境")
+	default:
+		log.Fatalf("❌ 不支持的支付渠道: %s", config.Payment.Provider)
+	}
+
+	// 邮件发送任务，处理所有服务入队的邮件
+	var mailSender mail.Sender
+	if config.Mail.Host != "" {
+		mailSender = mail.NewSMTPSender(config.Mail.Host, config.Mail.Port, config.Mail.Username, config.Mail.Password, config.Mail.From, config.Mail.FromName)
+	} else {
+		mailSender = mail.NewLogSender()
+		log.Println("⚠️ 未配置 SMTP 服务器，邮件只记录到日志")
+	}
+	go emailService.NewWorker(emailRepo, mailSender, config.Mail.MaxAttempts).Run(context.Background())
+	emailRenderer, err := emailService.NewRenderer()
+	if err != nil {
+		log.Fatalf("❌ 加载邮件模板失败: %v", err)
+	}
 
 	// 6. 初始化服务层
-	courseService := service.NewCourseService(courseRepo, userRepo)
+	courseService := service.NewCourseService(courseRepo, userRepo, enrollmentRepo, chapterRepo, progressRepo, prerequisiteRepo)
+	if err := courseService.SyncInstructorRoles(); err != nil {
+		log.Printf("⚠️ 同步讲师角色失败: %v", err)
+	}
+	notificationSvc := notificationService.NewNotificationService(notificationRepo, redisClient)
+	emailSvc := emailService.NewEmailService(emailRepo, userRepo, emailRenderer, emailService.Options{
+		SiteURL:           config.Server.PublicURL,
+		UnsubscribeSecret: config.Mail.UnsubscribeSecret,
+	})
+	quizSvc := quizService.NewQuizService(quizRepo, courseService)
+	assignmentSvc := assignmentService.NewAssignmentService(assignmentRepo, courseService, notificationSvc)
+	verifyURLFormat := strings.TrimRight(config.Server.PublicURL, "/") + "/certificates/%s"
+	certificateSvc := certificateService.NewCertificateService(certificateRepo, courseService, userRepo,
+		certificateService.NewContentStorage(contentClient), verifyURLFormat)
+	couponSvc := couponService.NewCouponService(couponRepo, courseService, userRepo)
+	bundleSvc := bundleService.NewBundleService(bundleRepo, courseService)
+	cohortSvc := cohortService.NewCohortService(cohortRepo, liveSessionRepo, calendarFeedRepo, courseService)
+	discussionSvc := discussionService.NewDiscussionService(discussionRepo, courseService, userRepo, notificationSvc)
+	announcementSvc := announcementService.NewAnnouncementService(announcementRepo, courseService, notificationSvc, emailSvc)
+	ledgerSvc := ledgerService.NewLedgerService(ledgerRepo, courseService, config.Revenue.PlatformSharePercent)
+	orderSvc := orderService.NewOrderService(orderRepo, courseService, couponSvc, bundleSvc, ledgerSvc, emailSvc, paymentProvider)
+	refundSvc := refundService.NewRefundService(refundRepo, orderRepo, courseService, bundleSvc, userRepo, ledgerSvc, paymentProvider, refundService.Policy{
+		WindowDays:         config.Refund.WindowDays,
+		MaxProgressPercent: config.Refund.MaxProgressPercent,
+	})
+	privacySvc := privacyService.NewPrivacyService(privacyRepo, userRepo, courseService, discussionSvc, emailSvc,
+		privacyService.NewContentFileStore(contentClient), userClient, privacyService.Options{
+			DeletionGraceDays: config.Privacy.DeletionGraceDays,
+			ExportTTLDays:     config.Privacy.ExportTTLDays,
+		})
+	// 数据导出打包、过期清理和到期注销
+	go privacyService.NewWorker(privacySvc).Run(context.Background())
 
 	// 7. 初始化gRPC处理器
-	courseHandler := grpc.NewCourseHandler(courseService)
+	courseHandler := grpc.NewCourseHandler(courseService, certificateSvc)
+	quizHandler := grpc.NewQuizHandler(quizSvc)
+	assignmentHandler := grpc.NewAssignmentHandler(assignmentSvc)
+	certificateHandler := grpc.NewCertificateHandler(certificateSvc)
+	orderHandler := grpc.NewOrderHandler(orderSvc)
+	couponHandler := grpc.NewCouponHandler(couponSvc)
+	refundHandler := grpc.NewRefundHandler(refundSvc)
+	ledgerHandler := grpc.NewLedgerHandler(ledgerSvc)
+	bundleHandler := grpc.NewBundleHandler(bundleSvc)
+	cohortHandler := grpc.NewCohortHandler(cohortSvc)
+	discussionHandler := grpc.NewDiscussionHandler(discussionSvc)
+	announcementHandler := grpc.NewAnnouncementHandler(announcementSvc)
+	notificationHandler := grpc.NewNotificationHandler(notificationSvc)
+	emailHandler := grpc.NewEmailHandler(emailSvc)
+	privacyHandler := grpc.NewPrivacyHandler(privacySvc)
 
 	// 8. 创建gRPC服务器
 	grpcSrv := grpcServer.NewServer()
 
 	// 9. 注册课程服务
 	coursepb.RegisterCourseServiceServer(grpcSrv, courseHandler)
+	quizpb.RegisterQuizServiceServer(grpcSrv, quizHandler)
+	assignmentpb.RegisterAssignmentServiceServer(grpcSrv, assignmentHandler)
+	certificatepb.RegisterCertificateServiceServer(grpcSrv, certificateHandler)
+	orderpb.RegisterOrderServiceServer(grpcSrv, orderHandler)
+	couponpb.RegisterCouponServiceServer(grpcSrv, couponHandler)
+	refundpb.RegisterRefundServiceServer(grpcSrv, refundHandler)
+	ledgerpb.RegisterLedgerServiceServer(grpcSrv, ledgerHandler)
+	bundlepb.RegisterBundleServiceServer(grpcSrv, bundleHandler)
+	cohortpb.RegisterCohortServiceServer(grpcSrv, cohortHandler)
+	discussionpb.RegisterDiscussionServiceServer(grpcSrv, discussionHandler)
+	announcementpb.RegisterAnnouncementServiceServer(grpcSrv, announcementHandler)
+	notificationpb.RegisterNotificationServiceServer(grpcSrv, notificationHandler)
+	emailpb.RegisterEmailServiceServer(grpcSrv, emailHandler)
+	privacypb.RegisterPrivacyServiceServer(grpcSrv, privacyHandler)
 
 	// 10. 创建监听器
 	listener, err := net.Listen("tcp", ":50052")
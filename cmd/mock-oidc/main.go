@@ -0,0 +1,266 @@
+// mock-oidc 本地开发用的模拟身份提供者，实现授权码模式 + PKCE 的最小子集
+// 授权页面直接填写要登录的邮箱和姓名，不校验密码，不要部署到生产环境
+package main
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"html/template"
+	"log"
+	"math/big"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// 默认配置，与 configs/config.yaml 中的示例一致，可通过环境变量覆盖
+const (
+	defaultAddr         = ":9400"
+	defaultIssuer       = "http://localhost:9400"
+	defaultClientID     = "course-platform"
+	defaultClientSecret = "mock-secret"
+	signingKeyID        = "mock-key"
+	codeTTL             = time.Minute
+)
+
+// authCode 已签发的授权码
+type authCode struct {
+	ClientID      string
+	RedirectURI   string
+	CodeChallenge string
+	Nonce         string
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+	ExpiresAt     time.Time
+}
+
+// mockProvider 模拟身份提供者
+type mockProvider struct {
+	issuer       string
+	clientID     string
+	clientSecret string
+	key          *rsa.PrivateKey
+
+	mu    sync.Mutex
+	codes map[string]*authCode
+}
+
+var authorizePage = template.Must(template.New("authorize").Parse(`<!DOCTYPE html>
+<html lang="zh-CN">
+<head><meta charset="UTF-8"><title>模拟企业登录</title></head>
+<body style="font-family: sans-serif; max-width: 360px; margin: 60px auto;">
+    <h2>模拟企业登录</h2>
+    <form method="post">
+        {{range $k, $v := .Params}}<input type="hidden" name="{{$k}}" value="{{index $v 0}}">{{end}}
+        <p><label>邮箱<br><input name="email" value="alice@example.com" required style="width: 100%"></label></p>
+        <p><label>姓名<br><input name="name" value="Alice" style="width: 100%"></label></p>
+        <p><label><input type="checkbox" name="email_verified" value="true" checked> 邮箱已验证</label></p>
+        <button type="submit" name="action" value="allow">登录</button>
+        <button type="submit" name="action" value="deny">取消</button>
+    </form>
+</body>
+</html>`))
+
+func main() {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		log.Fatalf("❌ 生成签名密钥失败: %v", err)
+	}
+	p := &mockProvider{
+		issuer:       getEnv("MOCK_OIDC_ISSUER", defaultIssuer),
+		clientID:     getEnv("MOCK_OIDC_CLIENT_ID", defaultClientID),
+		clientSecret: getEnv("MOCK_OIDC_CLIENT_SECRET", defaultClientSecret),
+		key:          key,
+		codes:        make(map[string]*authCode),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", p.discovery)
+	mux.HandleFunc("/authorize", p.authorize)
+	mux.HandleFunc("/token", p.token)
+	mux.HandleFunc("/jwks", p.jwks)
+
+	addr := getEnv("MOCK_OIDC_ADDR", defaultAddr)
+	log.Printf("🚀 模拟身份提供者启动在 %s，issuer: %s", addr, p.issuer)
+	log.Fatal(http.ListenAndServe(addr, mux))
+}
+
+// discovery 发现文档
+func (p *mockProvider) discovery(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"issuer":                                p.issuer,
+		"authorization_endpoint":                p.issuer + "/authorize",
+		"token_endpoint":                        p.issuer + "/token",
+		"jwks_uri":                              p.issuer + "/jwks",
+		"response_types_supported":              []string{"code"},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+		"code_challenge_methods_supported":      []string{"S256"},
+		"token_endpoint_auth_methods_supported": []string{"client_secret_basic", "client_secret_post"},
+	})
+}
+
+// authorize GET 显示登录表单，POST 签发授权码并跳回客户端
+func (p *mockProvider) authorize(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "invalid request", http.StatusBadRequest)
+		return
+	}
+	params := r.Form
+	if params.Get("client_id") != p.clientID || params.Get("response_type") != "code" {
+		http.Error(w, "unknown client or unsupported response_type", http.StatusBadRequest)
+		return
+	}
+	if params.Get("code_challenge") == "" || params.Get("code_challenge_method") != "S256" {
+		http.Error(w, "PKCE S256 is required", http.StatusBadRequest)
+		return
+	}
+	redirectURI, err := url.Parse(params.Get("redirect_uri"))
+	if err != nil || redirectURI.Scheme == "" {
+		http.Error(w, "invalid redirect_uri", http.StatusBadRequest)
+		return
+	}
+
+	if r.Method == http.MethodGet {
+		authorizeParams := url.Values{}
+		for _, k := range []string{"client_id", "response_type", "redirect_uri", "scope", "state", "nonce", "code_challenge", "code_challenge_method"} {
+			authorizeParams.Set(k, params.Get(k))
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		authorizePage.Execute(w, map[string]interface{}{"Params": authorizeParams})
+		return
+	}
+
+	query := redirectURI.Query()
+	query.Set("state", params.Get("state"))
+	if params.Get("action") != "allow" {
+		query.Set("error", "access_denied")
+	} else {
+		email := strings.ToLower(strings.TrimSpace(params.Get("email")))
+		code := randomString()
+		p.mu.Lock()
+		p.codes[code] = &authCode{
+			ClientID:      params.Get("client_id"),
+			RedirectURI:   params.Get("redirect_uri"),
+			CodeChallenge: params.Get("code_challenge"),
+			Nonce:         params.Get("nonce"),
+			Subject:       "mock|" + email,
+			Email:         email,
+			EmailVerified: params.Get("email_verified") == "true",
+			Name:          params.Get("name"),
+			ExpiresAt:     time.Now().Add(codeTTL),
+		}
+		p.mu.Unlock()
+		query.Set("code", code)
+	}
+	redirectURI.RawQuery = query.Encode()
+	http.Redirect(w, r, redirectURI.String(), http.StatusFound)
+}
+
+// token 校验客户端和 PKCE，用授权码换取 ID Token
+func (p *mockProvider) token(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost || r.ParseForm() != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_request"})
+		return
+	}
+
+	clientID, clientSecret, ok := r.BasicAuth()
+	if ok {
+		clientID, _ = url.QueryUnescape(clientID)
+		clientSecret, _ = url.QueryUnescape(clientSecret)
+	} else {
+		clientID, clientSecret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+	}
+	if clientID != p.clientID || clientSecret != p.clientSecret {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_client"})
+		return
+	}
+
+	p.mu.Lock()
+	code, found := p.codes[r.PostForm.Get("code")]
+	delete(p.codes, r.PostForm.Get("code"))
+	p.mu.Unlock()
+	if !found || time.Now().After(code.ExpiresAt) || code.ClientID != clientID || code.RedirectURI != r.PostForm.Get("redirect_uri") {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+		return
+	}
+	sum := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+	if base64.RawURLEncoding.EncodeToString(sum[:]) != code.CodeChallenge {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant", "error_description": "PKCE verification failed"})
+		return
+	}
+
+	now := time.Now()
+	idToken := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss":            p.issuer,
+		"sub":            code.Subject,
+		"aud":            clientID,
+		"iat":            now.Unix(),
+		"exp":            now.Add(5 * time.Minute).Unix(),
+		"nonce":          code.Nonce,
+		"email":          code.Email,
+		"email_verified": code.EmailVerified,
+		"name":           code.Name,
+	})
+	idToken.Header["kid"] = signingKeyID
+	signed, err := idToken.SignedString(p.key)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, map[string]string{"error": "server_error"})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"access_token": randomString(),
+		"token_type":   "Bearer",
+		"expires_in":   300,
+		"id_token":     signed,
+	})
+}
+
+// jwks 公布签名公钥
+func (p *mockProvider) jwks(w http.ResponseWriter, r *http.Request) {
+	pub := p.key.PublicKey
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": signingKeyID,
+			"use": "sig",
+			"alg": "RS256",
+			"n":   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+		}},
+	})
+}
+
+// writeJSON 输出 JSON 响应
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
+
+// randomString 生成授权码和访问令牌
+func randomString() string {
+	buf := make([]byte, 24)
+	rand.Read(buf)
+	return base64.RawURLEncoding.EncodeToString(buf)
+}
+
+// getEnv 读取环境变量，未设置时使用默认值
+func getEnv(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
@@ -52,7 +52,12 @@ func main() {
 	log.Println("✅ 数据库迁移完成")
 
 	// 设置路由和依赖注入
-	r := router.SetupRouter(database, redisClient)
+	r := router.SetupRouter(database, redisClient, config)
+
+	// 只信任配置中的反向代理转发的客户端IP，登录限制按来源IP统计，不能让请求头随意伪造
+	if err := r.SetTrustedProxies(config.Server.TrustedProxies); err != nil {
+		log.Fatalf("反向代理配置无效: %v", err)
+	}
 
 	// 使用配置文件中的端口启动服务器
 	log.Printf("🚀 服务器启动在端口: %s", config.Server.Port)
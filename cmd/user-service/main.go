@@ -5,6 +5,9 @@ import (
 	"net"
 
 	"course-platform/internal/configs"
+	emailModel "course-platform/internal/domain/email/model"
+	emailRepository "course-platform/internal/domain/email/repository"
+	emailService "course-platform/internal/domain/email/service"
 	"course-platform/internal/domain/user/model"
 	"course-platform/internal/domain/user/repository"
 	"course-platform/internal/domain/user/service"
@@ -37,6 +40,14 @@ func main() {
 	// 3. 数据库自动迁移
 	err = database.AutoMigrate(
 		&model.User{},
+		&model.PasswordReset{},
+		&model.RecoveryCode{},
+		&model.MFAPolicy{},
+		&model.OIDCIdentity{},
+		&model.UserSession{},
+		&model.APIToken{},
+		&emailModel.Outbox{},
+		&emailModel.Preference{},
 	)
 	if err != nil {
 		log.Fatalf("❌ 数据库迁移失败: %v", err)
@@ -54,9 +65,24 @@ func main() {
 
 	// 5. 初始化仓储层
 	userRepo := repository.NewUserRepository(database, redisClient)
-
-	// 6. 初始化服务层
-	userService := service.NewUserService(userRepo)
+	passwordResetRepo := repository.NewPasswordResetRepository(database)
+	mfaRepo := repository.NewMFARepository(database, redisClient)
+	loginAttemptRepo := repository.NewLoginAttemptRepository(redisClient)
+	oidcIdentityRepo := repository.NewOIDCIdentityRepository(database)
+	sessionRepo := repository.NewSessionRepository(database, redisClient)
+	apiTokenRepo := repository.NewAPITokenRepository(database, redisClient)
+	emailRepo := emailRepository.NewEmailRepository(database)
+
+	// 6. 初始化服务层（邮件只在此入队，由课程微服务的发送任务投递）
+	emailRenderer, err := emailService.NewRenderer()
+	if err != nil {
+		log.Fatalf("❌ 加载邮件模板失败: %v", err)
+	}
+	emailSvc := emailService.NewEmailService(emailRepo, userRepo, emailRenderer, emailService.Options{
+		SiteURL:           config.Server.PublicURL,
+		UnsubscribeSecret: config.Mail.UnsubscribeSecret,
+	})
+	userService := service.NewUserService(userRepo, passwordResetRepo, mfaRepo, loginAttemptRepo, oidcIdentityRepo, sessionRepo, apiTokenRepo, emailSvc)
 
 	// 7. 初始化gRPC处理器
 	userHandler := grpc.NewUserHandler(userService)
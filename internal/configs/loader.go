@@ -8,14 +8,31 @@ import (
 
 // Config 結構體定義了應用程式的所有配置
 type Config struct {
-	Server ServerConfig `mapstructure:"server"`
-	MySQL  MySQLConfig  `mapstructure:"mysql"`
-	Redis  RedisConfig  `mapstructure:"redis"`
+	Server  ServerConfig  `mapstructure:"server"`
+	MySQL   MySQLConfig   `mapstructure:"mysql"`
+	Redis   RedisConfig   `mapstructure:"redis"`
+	HLS     HLSConfig     `mapstructure:"hls"`
+	Payment PaymentConfig `mapstructure:"payment"`
+	Refund  RefundConfig  `mapstructure:"refund"`
+	Revenue RevenueConfig `mapstructure:"revenue"`
+	Mail    MailConfig    `mapstructure:"mail"`
+	OIDC    OIDCConfig    `mapstructure:"oidc"`
+	Privacy PrivacyConfig `mapstructure:"privacy"`
 }
 
 // ServerConfig 伺服器配置
 type ServerConfig struct {
-	Port string `mapstructure:"port"`
+	Port      string `mapstructure:"port"`
+	PublicURL string `mapstructure:"public_url"` // 對外訪問的網址，用於產生證書驗證連結
+	// TrustedProxies 可信的反向代理（IP 或 CIDR），只採用它們轉發的 X-Forwarded-For，為空時使用連線來源 IP
+	TrustedProxies []string `mapstructure:"trusted_proxies"`
+	// Environment 運行環境：development、test 或 production，未填寫時視為 production
+	Environment string `mapstructure:"environment"`
+}
+
+// IsDevelopment 是否為開發或測試環境，模擬支付只允許在這兩種環境使用
+func (c ServerConfig) IsDevelopment() bool {
+	return c.Environment == "development" || c.Environment == "test"
 }
 
 // MySQLConfig MySQL 資料庫配置
@@ -34,6 +51,66 @@ type RedisConfig struct {
 	DB       int    `mapstructure:"db"`
 }
 
+// HLSConfig 影片 HLS 切片配置
+type HLSConfig struct {
+	Enabled        bool `mapstructure:"enabled"`         // 是否在上傳 MP4 後自動切片
+	Encrypt        bool `mapstructure:"encrypt"`         // 是否使用 AES-128 加密分片
+	SegmentSeconds int  `mapstructure:"segment_seconds"` // 目標分片時長（秒）
+}
+
+// PaymentConfig 支付渠道配置
+type PaymentConfig struct {
+	Provider      string `mapstructure:"provider"`       // 支付渠道，目前支援 fake（本地模擬，僅限開發與測試環境）
+	WebhookSecret string `mapstructure:"webhook_secret"` // 支付回調簽名密鑰
+	// SimulateEndpoint 是否開放模擬支付接口，讓前端直接標記訂單支付結果；僅在開發與測試環境生效
+	SimulateEndpoint bool `mapstructure:"simulate_endpoint"`
+}
+
+// RefundConfig 退款政策配置，設為 0 表示不限制
+type RefundConfig struct {
+	WindowDays         int `mapstructure:"window_days"`          // 支付後可申請退款的天數
+	MaxProgressPercent int `mapstructure:"max_progress_percent"` // 學習進度達到此百分比後不可退款
+}
+
+// RevenueConfig 課程收入分帳配置
+type RevenueConfig struct {
+	PlatformSharePercent int `mapstructure:"platform_share_percent"` // 平台抽成百分比，其餘歸講師
+}
+
+// MailConfig 郵件發送配置，host 留空時只把郵件記錄到日誌
+type MailConfig struct {
+	Host              string `mapstructure:"host"`               // SMTP 主機，本地開發可使用 MailHog
+	Port              int    `mapstructure:"port"`               // SMTP 連接埠，MailHog 為 1025
+	Username          string `mapstructure:"username"`           // SMTP 帳號，留空表示不需要認證
+	Password          string `mapstructure:"password"`           // SMTP 密碼
+	From              string `mapstructure:"from"`               // 寄件人地址
+	FromName          string `mapstructure:"from_name"`          // 寄件人名稱
+	MaxAttempts       int    `mapstructure:"max_attempts"`       // 發送失敗時的最大嘗試次數
+	UnsubscribeSecret string `mapstructure:"unsubscribe_secret"` // 退訂連結簽名密鑰
+}
+
+// OIDCConfig 單一登入（OpenID Connect）配置，沒有配置提供者時登入頁不顯示單一登入按鈕
+type OIDCConfig struct {
+	Providers []OIDCProviderConfig `mapstructure:"providers"`
+}
+
+// OIDCProviderConfig 身份提供者配置，回調地址為 public_url + /auth/oidc/{name}/callback
+type OIDCProviderConfig struct {
+	Name         string   `mapstructure:"name"`          // 提供者名稱，用於網址和綁定記錄，設定後不要修改
+	DisplayName  string   `mapstructure:"display_name"`  // 登入按鈕上顯示的名稱
+	Issuer       string   `mapstructure:"issuer"`        // 發行者網址，端點從 /.well-known/openid-configuration 取得
+	ClientID     string   `mapstructure:"client_id"`     // 客戶端 ID
+	ClientSecret string   `mapstructure:"client_secret"` // 客戶端密鑰，公開客戶端留空只使用 PKCE
+	Scopes       []string `mapstructure:"scopes"`        // 申請的權限，預設 openid email profile
+	TrustEmail   bool     `mapstructure:"trust_email"`   // 信任其已驗證的郵箱，首次登入時自動綁定同郵箱的既有帳號
+}
+
+// PrivacyConfig 個人資料匯出與帳號註銷配置，設為 0 時使用預設值
+type PrivacyConfig struct {
+	DeletionGraceDays int `mapstructure:"deletion_grace_days"` // 申請註銷後的寬限天數，期間可撤銷，預設 14 天
+	ExportTTLDays     int `mapstructure:"export_ttl_days"`     // 匯出檔案的保留天數，到期後自動刪除，預設 7 天
+}
+
 // LoadConfig 讀取並解析配置檔案
 func LoadConfig() (*Config, error) {
 	viper.SetConfigName("config")
@@ -1,8 +1,13 @@
 package configs
 
 import (
+	"fmt"
 	"log"
+	"net/url"
 	"os"
+	"path"
+	"path/filepath"
+	"strings"
 )
 
 // ServiceConfig 服务配置结构
@@ -92,3 +97,23 @@ func GetStaticPathConfig() *StaticPathConfig {
 		UploadsDir:   "./uploads",
 	}
 }
+
+// UploadPath 将内容服务返回的文件访问URL（.../uploads/...）转换为上传目录下的磁盘路径
+func (c *StaticPathConfig) UploadPath(fileURL string) (string, error) {
+	u, err := url.Parse(fileURL)
+	if err != nil {
+		return "", fmt.Errorf("无效的文件地址: %w", err)
+	}
+
+	idx := strings.Index(u.Path, "/uploads/")
+	if idx < 0 {
+		return "", fmt.Errorf("不是上传文件地址: %s", fileURL)
+	}
+
+	// 清理路径，防止通过 .. 访问上传目录之外的文件
+	rel := path.Clean("/" + u.Path[idx+len("/uploads/"):])
+	if rel == "/" {
+		return "", fmt.Errorf("不是上传文件地址: %s", fileURL)
+	}
+	return filepath.Join(c.UploadsDir, filepath.FromSlash(rel)), nil
+}
@@ -0,0 +1,250 @@
+package handler
+
+import (
+	"log"
+	"net/http"
+	"strconv"
+
+	service "course-platform/internal/infrastructure/grpc_client"
+	"course-platform/internal/shared/pb/announcementpb"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AnnouncementHandler API Gateway的课程公告处理器
+type AnnouncementHandler struct {
+	announcementGRPCClient *service.AnnouncementGRPCClientService
+}
+
+// NewAnnouncementHandler 创建课程公告处理器
+func NewAnnouncementHandler(announcementGRPCClient *service.AnnouncementGRPCClientService) *AnnouncementHandler {
+	return &AnnouncementHandler{
+		announcementGRPCClient: announcementGRPCClient,
+	}
+}
+
+// CreateAnnouncementRequest 发布公告请求结构
+type CreateAnnouncementRequest struct {
+	Title string `json:"title" binding:"required"`
+	Body  string `json:"body"`
+}
+
+// ListAnnouncements 获取课程公告
+// @Summary 课程公告列表
+// @Description 分页获取课程公告（最新的在前），登录学员可看到未读标记
+// @Tags 课程公告
+// @Produce json
+// @Param id path int true "课程ID"
+// @Param page query int false "页码，默认1"
+// @Param page_size query int false "每页数量，默认10"
+// @Success 200 {object} map[string]interface{}
+// @Router /api/v1/courses/{id}/announcements [get]
+func (h *AnnouncementHandler) ListAnnouncements(c *gin.Context) {
+	courseID, ok := parseIDParam(c, "id", "课程ID参数无效")
+	if !ok {
+		return
+	}
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 50 {
+		pageSize = 10
+	}
+
+	resp, err := h.announcementGRPCClient.ListAnnouncements(c.Request.Context(), courseID, c.GetUint("userID"), uint(page), uint(pageSize))
+	if err != nil {
+		respondGRPCError(c, "获取公告列表失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	announcements := make([]gin.H, 0, len(resp.Announcements))
+	unread := 0
+	for _, a := range resp.Announcements {
+		if a.Unread {
+			unread++
+		}
+		announcements = append(announcements, convertAnnouncementToDisplay(a))
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data": gin.H{
+			"announcements": announcements,
+			"unread_count":  unread,
+			"total":         resp.Total,
+			"page":          page,
+			"page_size":     pageSize,
+		},
+	})
+}
+
+// CreateAnnouncement 发布公告
+// @Summary 发布公告
+// @Description 讲师发布课程公告，公告会以站内通知推送给全部在读学员
+// @Tags 课程公告
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path int true "课程ID"
+// @Param announcement body CreateAnnouncementRequest true "公告内容"
+// @Success 200 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Router /api/v1/courses/{id}/announcements [post]
+func (h *AnnouncementHandler) CreateAnnouncement(c *gin.Context) {
+	courseID, ok := parseIDParam(c, "id", "课程ID参数无效")
+	if !ok {
+		return
+	}
+
+	var req CreateAnnouncementRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    400,
+			"message": "请求参数无效: " + err.Error(),
+		})
+		return
+	}
+
+	resp, err := h.announcementGRPCClient.CreateAnnouncement(c.Request.Context(), courseID, c.GetUint("userID"), req.Title, req.Body)
+	if err != nil {
+		respondGRPCError(c, "发布公告失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	log.Printf("✅ API: 公告发布成功 - 课程ID: %d, 推送人数: %d", courseID, resp.Announcement.RecipientCount)
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data":    convertAnnouncementToDisplay(resp.Announcement),
+	})
+}
+
+// MarkAnnouncementRead 标记公告已读
+// @Summary 标记公告已读
+// @Description 将公告及对应的站内通知标记为已读
+// @Tags 课程公告
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path int true "公告ID"
+// @Success 200 {object} map[string]interface{}
+// @Router /api/v1/announcements/{id}/read [post]
+func (h *AnnouncementHandler) MarkAnnouncementRead(c *gin.Context) {
+	announcementID, ok := parseIDParam(c, "id", "公告ID参数无效")
+	if !ok {
+		return
+	}
+
+	resp, err := h.announcementGRPCClient.MarkAnnouncementRead(c.Request.Context(), announcementID, c.GetUint("userID"))
+	if err != nil {
+		respondGRPCError(c, "标记公告已读失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+	})
+}
+
+// DeleteAnnouncement 删除公告
+// @Summary 删除公告
+// @Description 讲师删除课程公告，已推送的通知不会撤回
+// @Tags 课程公告
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path int true "公告ID"
+// @Success 200 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Router /api/v1/announcements/{id} [delete]
+func (h *AnnouncementHandler) DeleteAnnouncement(c *gin.Context) {
+	announcementID, ok := parseIDParam(c, "id", "公告ID参数无效")
+	if !ok {
+		return
+	}
+
+	resp, err := h.announcementGRPCClient.DeleteAnnouncement(c.Request.Context(), announcementID, c.GetUint("userID"))
+	if err != nil {
+		respondGRPCError(c, "删除公告失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+	})
+}
+
+// convertAnnouncementToDisplay 转换公告显示数据（protobuf的JSON会省略零值，未读标记需要显式输出）
+func convertAnnouncementToDisplay(a *announcementpb.Announcement) gin.H {
+	if a == nil {
+		return gin.H{}
+	}
+	return gin.H{
+		"id":              a.Id,
+		"course_id":       a.CourseId,
+		"author_id":       a.AuthorId,
+		"title":           a.Title,
+		"body":            a.Body,
+		"recipient_count": a.RecipientCount,
+		"unread":          a.Unread,
+		"created_at":      a.CreatedAt,
+	}
+}
+
+// parseIDParam 解析路径中的ID参数，失败时直接返回400
+func parseIDParam(c *gin.Context, name, message string) (uint, bool) {
+	id, err := strconv.ParseUint(c.Param(name), 10, 32)
+	if err != nil || id == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    400,
+			"message": message,
+		})
+		return 0, false
+	}
+	return uint(id), true
+}
+
+// respondGRPCError 返回调用微服务失败的响应
+func respondGRPCError(c *gin.Context, action string, err error) {
+	log.Printf("❌ API: %s - %v", action, err)
+	c.JSON(http.StatusInternalServerError, gin.H{
+		"code":    500,
+		"message": action + ": " + err.Error(),
+	})
+}
+
+// respondBusinessError 按业务码返回对应HTTP状态
+func respondBusinessError(c *gin.Context, code int32, message string) {
+	status := http.StatusBadRequest
+	switch code {
+	case 403:
+		status = http.StatusForbidden
+	case 404:
+		status = http.StatusNotFound
+	case 409:
+		status = http.StatusConflict
+	}
+	c.JSON(status, gin.H{
+		"code":    code,
+		"message": message,
+	})
+}
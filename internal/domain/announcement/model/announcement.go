@@ -0,0 +1,32 @@
+package model
+
+import (
+	"time"
+)
+
+// 公告内容长度限制
+const (
+	MaxTitleLength = 200
+	MaxBodyLength  = 5000
+)
+
+// Announcement 课程公告，发布时通过站内通知推送给全部在读学员
+type Announcement struct {
+	ID        uint      `gorm:"primarykey" json:"id"` // 主键ID
+	CreatedAt time.Time `json:"created_at"`           // 发布时间
+	UpdatedAt time.Time `json:"updated_at"`           // 更新时间
+
+	CourseID       uint   `gorm:"not null;index" json:"course_id"`           // 所属课程ID
+	AuthorID       uint   `gorm:"not null" json:"author_id"`                 // 发布人ID（课程讲师）
+	Title          string `gorm:"size:200;not null" json:"title"`            // 标题
+	Body           string `gorm:"type:text" json:"body"`                     // 内容
+	RecipientCount int    `gorm:"not null;default:0" json:"recipient_count"` // 推送的学员人数
+
+	// 以下字段不入库，由服务层填充
+	Unread bool `gorm:"-" json:"unread"` // 当前用户是否未读（只有收到推送的学员可能为未读）
+}
+
+// TableName 指定表名
+func (Announcement) TableName() string {
+	return "course_announcements"
+}
@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"course-platform/internal/domain/announcement/model"
+
+	"gorm.io/gorm"
+)
+
+// AnnouncementRepositoryInterface 课程公告仓储接口
+type AnnouncementRepositoryInterface interface {
+	Create(announcement *model.Announcement) error
+	UpdateRecipientCount(id uint, count int) error
+	GetByID(id uint) (*model.Announcement, error)
+	ListByCourse(courseID uint, offset, limit int) ([]*model.Announcement, int64, error)
+	Delete(id uint) error
+}
+
+// AnnouncementRepository 课程公告仓储实现
+type AnnouncementRepository struct {
+	db *gorm.DB
+}
+
+// NewAnnouncementRepository 创建课程公告仓储实例
+func NewAnnouncementRepository(db *gorm.DB) AnnouncementRepositoryInterface {
+	return &AnnouncementRepository{db: db}
+}
+
+// Create 创建公告
+func (r *AnnouncementRepository) Create(announcement *model.Announcement) error {
+	if err := r.db.Create(announcement).Error; err != nil {
+		log.Printf("❌ Repository: 创建公告失败 - %v", err)
+		return fmt.Errorf("创建公告失败: %w", err)
+	}
+
+	log.Printf("✅ Repository: 公告创建成功 - ID: %d", announcement.ID)
+	return nil
+}
+
+// UpdateRecipientCount 记录公告推送的人数
+func (r *AnnouncementRepository) UpdateRecipientCount(id uint, count int) error {
+	if err := r.db.Model(&model.Announcement{}).Where("id = ?", id).
+		Update("recipient_count", count).Error; err != nil {
+		return fmt.Errorf("更新公告失败: %w", err)
+	}
+	return nil
+}
+
+// GetByID 根据ID获取公告
+func (r *AnnouncementRepository) GetByID(id uint) (*model.Announcement, error) {
+	var announcement model.Announcement
+	if err := r.db.First(&announcement, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("公告不存在")
+		}
+		return nil, fmt.Errorf("查询公告失败: %w", err)
+	}
+	return &announcement, nil
+}
+
+// ListByCourse 分页获取课程公告（最新的在前）
+func (r *AnnouncementRepository) ListByCourse(courseID uint, offset, limit int) ([]*model.Announcement, int64, error) {
+	query := r.db.Model(&model.Announcement{}).Where("course_id = ?", courseID)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("统计公告失败: %w", err)
+	}
+
+	var announcements []*model.Announcement
+	if err := query.Order("created_at DESC, id DESC").Offset(offset).Limit(limit).
+		Find(&announcements).Error; err != nil {
+		log.Printf("❌ Repository: 查询公告列表失败 - %v", err)
+		return nil, 0, fmt.Errorf("查询公告列表失败: %w", err)
+	}
+	return announcements, total, nil
+}
+
+// Delete 删除公告
+func (r *AnnouncementRepository) Delete(id uint) error {
+	if err := r.db.Delete(&model.Announcement{}, id).Error; err != nil {
+		return fmt.Errorf("删除公告失败: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,179 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"unicode/utf8"
+
+	"course-platform/internal/domain/announcement/model"
+	"course-platform/internal/domain/announcement/repository"
+	courseService "course-platform/internal/domain/course/service"
+	emailModel "course-platform/internal/domain/email/model"
+	emailService "course-platform/internal/domain/email/service"
+	notificationModel "course-platform/internal/domain/notification/model"
+	notificationService "course-platform/internal/domain/notification/service"
+)
+
+// 分页默认值
+const (
+	defaultPageSize = 10
+	maxPageSize     = 50
+)
+
+// AnnouncementServiceInterface 课程公告服务接口
+type AnnouncementServiceInterface interface {
+	CreateAnnouncement(courseID, userID uint, title, body string) (*model.Announcement, error)
+	ListAnnouncements(courseID, userID uint, page, pageSize int) ([]*model.Announcement, int64, error)
+	MarkRead(announcementID, userID uint) error
+	DeleteAnnouncement(announcementID, userID uint) error
+}
+
+// AnnouncementService 课程公告服务实现
+type AnnouncementService struct {
+	announcementRepo    repository.AnnouncementRepositoryInterface
+	courseService       courseService.CourseServiceInterface
+	notificationService notificationService.NotificationServiceInterface
+	emailService        emailService.EmailServiceInterface
+}
+
+// NewAnnouncementService 创建课程公告服务实例
+func NewAnnouncementService(announcementRepo repository.AnnouncementRepositoryInterface, courseService courseService.CourseServiceInterface, notificationService notificationService.NotificationServiceInterface, emailService emailService.EmailServiceInterface) AnnouncementServiceInterface {
+	return &AnnouncementService{
+		announcementRepo:    announcementRepo,
+		courseService:       courseService,
+		notificationService: notificationService,
+		emailService:        emailService,
+	}
+}
+
+// CreateAnnouncement 发布公告（仅课程讲师），并通过站内通知和邮件推送给全部在读学员
+func (s *AnnouncementService) CreateAnnouncement(courseID, userID uint, title, body string) (*model.Announcement, error) {
+	log.Printf("🔍 Service: 发布公告 - 课程ID: %d, 用户ID: %d", courseID, userID)
+
+	course, err := s.courseService.GetCourseByID(courseID)
+	if err != nil {
+		return nil, err
+	}
+	if course.InstructorID != userID {
+		return nil, errors.New("无权发布公告，只有课程讲师可以操作")
+	}
+
+	title = strings.TrimSpace(title)
+	body = strings.TrimSpace(body)
+	if title == "" {
+		return nil, errors.New("公告标题不能为空")
+	}
+	if utf8.RuneCountInString(title) > model.MaxTitleLength {
+		return nil, fmt.Errorf("公告标题不能超过%d个字", model.MaxTitleLength)
+	}
+	if utf8.RuneCountInString(body) > model.MaxBodyLength {
+		return nil, fmt.Errorf("公告内容不能超过%d个字", model.MaxBodyLength)
+	}
+
+	announcement := &model.Announcement{
+		CourseID: courseID,
+		AuthorID: userID,
+		Title:    title,
+		Body:     body,
+	}
+	if err := s.announcementRepo.Create(announcement); err != nil {
+		return nil, err
+	}
+
+	// 推送失败不影响公告本身，学员仍可以在课程页看到
+	studentIDs, err := s.courseService.ListActiveStudentIDs(courseID)
+	if err != nil {
+		log.Printf("⚠️ Service: 查询课程学员失败，公告未推送 - %v", err)
+		return announcement, nil
+	}
+	if _, err := s.emailService.SendToUsers(studentIDs, emailModel.CategoryAnnouncement, emailService.TemplateAnnouncement, map[string]interface{}{
+		"CourseTitle": course.Title,
+		"Title":       title,
+		"Body":        body,
+		"CoursePath":  fmt.Sprintf("/course/%d#announcements", courseID),
+	}); err != nil {
+		log.Printf("⚠️ Service: 公告邮件入队失败 - %v", err)
+	}
+	count, err := s.notificationService.Notify(studentIDs, &notificationService.Message{
+		Type:  notificationModel.TypeAnnouncement,
+		Title: fmt.Sprintf("《%s》发布了新公告：%s", course.Title, title),
+		Body:  body,
+		Link:  fmt.Sprintf("/course/%d#announcements", courseID),
+		RefID: announcement.ID,
+	})
+	if err != nil {
+		log.Printf("⚠️ Service: 公告推送失败 - %v", err)
+		return announcement, nil
+	}
+	if err := s.announcementRepo.UpdateRecipientCount(announcement.ID, count); err != nil {
+		log.Printf("⚠️ Service: 记录推送人数失败 - %v", err)
+	}
+	announcement.RecipientCount = count
+	return announcement, nil
+}
+
+// ListAnnouncements 分页获取课程公告，登录用户附带未读标记
+func (s *AnnouncementService) ListAnnouncements(courseID, userID uint, page, pageSize int) ([]*model.Announcement, int64, error) {
+	if _, err := s.courseService.GetCourseByID(courseID); err != nil {
+		return nil, 0, err
+	}
+
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > maxPageSize {
+		pageSize = defaultPageSize
+	}
+	announcements, total, err := s.announcementRepo.ListByCourse(courseID, (page-1)*pageSize, pageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if userID > 0 && len(announcements) > 0 {
+		ids := make([]uint, 0, len(announcements))
+		for _, a := range announcements {
+			ids = append(ids, a.ID)
+		}
+		readState, err := s.notificationService.ReadStateByRef(userID, notificationModel.TypeAnnouncement, ids)
+		if err != nil {
+			log.Printf("⚠️ Service: 查询公告已读状态失败 - %v", err)
+		} else {
+			for _, a := range announcements {
+				read, notified := readState[a.ID]
+				a.Unread = notified && !read
+			}
+		}
+	}
+	return announcements, total, nil
+}
+
+// MarkRead 将公告标记为已读
+func (s *AnnouncementService) MarkRead(announcementID, userID uint) error {
+	if userID == 0 {
+		return errors.New("用户ID不能为空")
+	}
+	if _, err := s.announcementRepo.GetByID(announcementID); err != nil {
+		return err
+	}
+	return s.notificationService.MarkRefRead(userID, notificationModel.TypeAnnouncement, announcementID)
+}
+
+// DeleteAnnouncement 删除公告（仅课程讲师），已发出的通知保留
+func (s *AnnouncementService) DeleteAnnouncement(announcementID, userID uint) error {
+	announcement, err := s.announcementRepo.GetByID(announcementID)
+	if err != nil {
+		return err
+	}
+	course, err := s.courseService.GetCourseByID(announcement.CourseID)
+	if err != nil {
+		return err
+	}
+	if course.InstructorID != userID {
+		return errors.New("无权删除公告，只有课程讲师可以操作")
+	}
+
+	log.Printf("🔍 Service: 删除公告 - ID: %d", announcementID)
+	return s.announcementRepo.Delete(announcementID)
+}
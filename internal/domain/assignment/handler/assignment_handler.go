@@ -0,0 +1,508 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+
+	contentModel "course-platform/internal/domain/content/model"
+	service "course-platform/internal/infrastructure/grpc_client"
+	"course-platform/internal/shared/pb/assignmentpb"
+	"course-platform/internal/shared/pb/contentpb"
+
+	"github.com/gin-gonic/gin"
+)
+
+// maxSubmissionFileSize 作业附件大小上限（50MB）
+const maxSubmissionFileSize = 50 * 1024 * 1024
+
+// AssignmentHandler API Gateway的作业处理器
+// 作业附件先上传到内容服务，再将文件信息随提交记录保存到作业服务
+type AssignmentHandler struct {
+	assignmentGRPCClient *service.AssignmentGRPCClientService
+	contentGRPCClient    *service.ContentGRPCClientService
+}
+
+// NewAssignmentHandler 创建作业处理器
+func NewAssignmentHandler(assignmentGRPCClient *service.AssignmentGRPCClientService, contentGRPCClient *service.ContentGRPCClientService) *AssignmentHandler {
+	return &AssignmentHandler{
+		assignmentGRPCClient: assignmentGRPCClient,
+		contentGRPCClient:    contentGRPCClient,
+	}
+}
+
+// CreateAssignmentRequest 创建作业请求结构
+type CreateAssignmentRequest struct {
+	CourseID           uint32 `json:"course_id" binding:"required"`
+	ChapterID          uint32 `json:"chapter_id"`
+	Title              string `json:"title" binding:"required"`
+	Description        string `json:"description"`
+	DueAt              string `json:"due_at" binding:"required"` // RFC3339格式，如 2025-07-01T23:59:00+08:00
+	LatePolicy         string `json:"late_policy"`               // reject/accept/penalty，默认reject
+	LatePenaltyPercent uint32 `json:"late_penalty_percent"`      // penalty策略下每迟交一天扣除的百分比
+	LateCutoffHours    uint32 `json:"late_cutoff_hours"`         // 截止后仍可提交的小时数，0表示不限
+	Rubric             []struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+		MaxScore    uint32 `json:"max_score"`
+	} `json:"rubric" binding:"required"`
+}
+
+// GradeSubmissionRequest 批改作业请求结构
+type GradeSubmissionRequest struct {
+	Scores []struct {
+		Criterion string `json:"criterion"`
+		Score     uint32 `json:"score"`
+		Comment   string `json:"comment"`
+	} `json:"scores" binding:"required"`
+	Feedback string `json:"feedback"`
+}
+
+// CreateAssignment 创建作业
+// @Summary 创建作业
+// @Description 课程讲师创建带截止时间、迟交策略和评分标准的作业
+// @Tags 作业管理
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param assignment body CreateAssignmentRequest true "作业信息"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Router /api/v1/assignments [post]
+func (h *AssignmentHandler) CreateAssignment(c *gin.Context) {
+	var req CreateAssignmentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    400,
+			"message": "请求参数无效: " + err.Error(),
+		})
+		return
+	}
+
+	rubric := make([]*assignmentpb.RubricCriterion, len(req.Rubric))
+	for i, r := range req.Rubric {
+		rubric[i] = &assignmentpb.RubricCriterion{
+			Name:        r.Name,
+			Description: r.Description,
+			MaxScore:    r.MaxScore,
+		}
+	}
+
+	resp, err := h.assignmentGRPCClient.CreateAssignment(c.Request.Context(), &assignmentpb.CreateAssignmentRequest{
+		CourseId:           req.CourseID,
+		ChapterId:          req.ChapterID,
+		UserId:             uint32(c.GetUint("userID")),
+		Title:              req.Title,
+		Description:        req.Description,
+		DueAt:              req.DueAt,
+		LatePolicy:         req.LatePolicy,
+		LatePenaltyPercent: req.LatePenaltyPercent,
+		LateCutoffHours:    req.LateCutoffHours,
+		Rubric:             rubric,
+	})
+	if err != nil {
+		respondGRPCError(c, "创建作业失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	log.Printf("✅ API: 创建作业成功 - 作业ID: %d", resp.Assignment.Id)
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data":    resp.Assignment,
+	})
+}
+
+// ListAssignments 获取课程作业列表
+// @Summary 获取作业列表
+// @Description 获取课程下的作业列表（按截止时间排序）
+// @Tags 作业管理
+// @Produce json
+// @Param course_id query int true "课程ID"
+// @Success 200 {object} map[string]interface{}
+// @Router /api/v1/assignments [get]
+func (h *AssignmentHandler) ListAssignments(c *gin.Context) {
+	courseID, err := strconv.ParseUint(c.Query("course_id"), 10, 32)
+	if err != nil || courseID == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    400,
+			"message": "课程ID参数无效",
+		})
+		return
+	}
+
+	resp, err := h.assignmentGRPCClient.ListAssignments(c.Request.Context(), uint(courseID))
+	if err != nil {
+		respondGRPCError(c, "获取作业列表失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": "获取成功",
+		"data":    resp.Assignments,
+	})
+}
+
+// GetAssignment 获取作业详情
+// @Summary 获取作业详情
+// @Tags 作业管理
+// @Produce json
+// @Param id path int true "作业ID"
+// @Success 200 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Router /api/v1/assignments/{id} [get]
+func (h *AssignmentHandler) GetAssignment(c *gin.Context) {
+	assignmentID, ok := parseIDParam(c, "id", "作业ID参数无效")
+	if !ok {
+		return
+	}
+
+	resp, err := h.assignmentGRPCClient.GetAssignment(c.Request.Context(), assignmentID)
+	if err != nil {
+		respondGRPCError(c, "获取作业失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": "获取成功",
+		"data":    resp.Assignment,
+	})
+}
+
+// SubmitAssignment 提交作业
+// @Summary 提交作业
+// @Description 提交文字答案和/或附件，附件保存到内容服务；批改前可重新提交，迟交按作业策略标记
+// @Tags 作业管理
+// @Accept multipart/form-data
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path int true "作业ID"
+// @Param content formData string false "文字答案"
+// @Param file formData file false "作业附件"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Router /api/v1/assignments/{id}/submissions [post]
+func (h *AssignmentHandler) SubmitAssignment(c *gin.Context) {
+	assignmentID, ok := parseIDParam(c, "id", "作业ID参数无效")
+	if !ok {
+		return
+	}
+	userID := c.GetUint("userID")
+	ctx := c.Request.Context()
+
+	req := &assignmentpb.SubmitAssignmentRequest{
+		AssignmentId: uint32(assignmentID),
+		UserId:       uint32(userID),
+		Content:      c.PostForm("content"),
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil && !errors.Is(err, http.ErrMissingFile) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    400,
+			"message": "读取上传文件失败: " + err.Error(),
+		})
+		return
+	}
+
+	if fileHeader != nil {
+		if fileHeader.Size > maxSubmissionFileSize {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"code":    400,
+				"message": "附件大小不能超过50MB",
+			})
+			return
+		}
+
+		// 附件按作业所属课程归档
+		assignmentResp, err := h.assignmentGRPCClient.GetAssignment(ctx, assignmentID)
+		if err != nil {
+			respondGRPCError(c, "获取作业失败", err)
+			return
+		}
+		if assignmentResp.Code != 200 {
+			respondBusinessError(c, assignmentResp.Code, assignmentResp.Message)
+			return
+		}
+
+		file, err := fileHeader.Open()
+		if err != nil {
+			respondGRPCError(c, "读取上传文件失败", err)
+			return
+		}
+		fileData, err := io.ReadAll(file)
+		file.Close()
+		if err != nil {
+			respondGRPCError(c, "读取上传文件失败", err)
+			return
+		}
+
+		uploadResp, err := h.contentGRPCClient.UploadFile(ctx, &contentpb.UploadFileRequest{
+			FileName:   fileHeader.Filename,
+			FileData:   fileData,
+			FileType:   contentModel.FileTypeSubmission,
+			CourseId:   assignmentResp.Assignment.CourseId,
+			UploaderId: uint32(userID),
+		})
+		if err != nil {
+			respondGRPCError(c, "上传作业附件失败", err)
+			return
+		}
+		if uploadResp.Code != 200 {
+			respondBusinessError(c, uploadResp.Code, uploadResp.Message)
+			return
+		}
+
+		fileID, _ := strconv.ParseUint(uploadResp.FileInfo.FileId, 10, 32)
+		req.FileId = uint32(fileID)
+		req.FileName = uploadResp.FileInfo.FileName
+		req.FileUrl = fmt.Sprintf(contentModel.DownloadURLFormat, fileID) // 附件只能经鉴权的下载接口获取
+	}
+
+	resp, err := h.assignmentGRPCClient.SubmitAssignment(ctx, req)
+	if err == nil && resp.Code != 200 {
+		err = errors.New(resp.Message)
+	}
+	if err != nil {
+		// 提交失败时清理已上传的附件
+		if req.FileId != 0 {
+			h.removeUploadedFile(c, req.FileId, userID)
+		}
+		if resp != nil {
+			respondBusinessError(c, resp.Code, resp.Message)
+		} else {
+			respondGRPCError(c, "提交作业失败", err)
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data":    resp.Submission,
+	})
+}
+
+// GetMySubmission 获取本人的作业提交及批改结果
+// @Summary 获取我的提交
+// @Tags 作业管理
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path int true "作业ID"
+// @Success 200 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Router /api/v1/assignments/{id}/submissions/me [get]
+func (h *AssignmentHandler) GetMySubmission(c *gin.Context) {
+	assignmentID, ok := parseIDParam(c, "id", "作业ID参数无效")
+	if !ok {
+		return
+	}
+
+	resp, err := h.assignmentGRPCClient.GetMySubmission(c.Request.Context(), assignmentID, c.GetUint("userID"))
+	if err != nil {
+		respondGRPCError(c, "获取作业提交失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": "获取成功",
+		"data":    resp.Submission,
+	})
+}
+
+// ListSubmissions 获取作业的全部提交（仅讲师）
+// @Summary 获取作业提交列表
+// @Tags 作业管理
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path int true "作业ID"
+// @Param status query string false "提交状态 (submitted/graded)"
+// @Success 200 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Router /api/v1/assignments/{id}/submissions [get]
+func (h *AssignmentHandler) ListSubmissions(c *gin.Context) {
+	assignmentID, ok := parseIDParam(c, "id", "作业ID参数无效")
+	if !ok {
+		return
+	}
+
+	resp, err := h.assignmentGRPCClient.ListSubmissions(c.Request.Context(), assignmentID, c.GetUint("userID"), c.Query("status"))
+	if err != nil {
+		respondGRPCError(c, "获取作业提交列表失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": "获取成功",
+		"data":    resp.Submissions,
+	})
+}
+
+// GetGradingQueue 获取课程待批改队列（仅讲师）
+// @Summary 获取待批改队列
+// @Description 按提交时间先后列出课程下所有待批改的作业提交
+// @Tags 作业管理
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path int true "课程ID"
+// @Success 200 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Router /api/v1/courses/{id}/grading-queue [get]
+func (h *AssignmentHandler) GetGradingQueue(c *gin.Context) {
+	courseID, ok := parseIDParam(c, "id", "课程ID参数无效")
+	if !ok {
+		return
+	}
+
+	resp, err := h.assignmentGRPCClient.GetGradingQueue(c.Request.Context(), courseID, c.GetUint("userID"))
+	if err != nil {
+		respondGRPCError(c, "获取待批改队列失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": "获取成功",
+		"data":    resp.Submissions,
+	})
+}
+
+// GradeSubmission 批改作业
+// @Summary 批改作业
+// @Description 按评分标准逐项打分并填写评语，迟交扣分自动计算；可重复批改
+// @Tags 作业管理
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param submission_id path int true "提交ID"
+// @Param grade body GradeSubmissionRequest true "评分"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Router /api/v1/assignments/submissions/{submission_id}/grade [post]
+func (h *AssignmentHandler) GradeSubmission(c *gin.Context) {
+	submissionID, ok := parseIDParam(c, "submission_id", "提交ID参数无效")
+	if !ok {
+		return
+	}
+
+	var req GradeSubmissionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    400,
+			"message": "请求参数无效: " + err.Error(),
+		})
+		return
+	}
+
+	scores := make([]*assignmentpb.RubricScore, len(req.Scores))
+	for i, s := range req.Scores {
+		scores[i] = &assignmentpb.RubricScore{
+			Criterion: s.Criterion,
+			Score:     s.Score,
+			Comment:   s.Comment,
+		}
+	}
+
+	resp, err := h.assignmentGRPCClient.GradeSubmission(c.Request.Context(), &assignmentpb.GradeSubmissionRequest{
+		SubmissionId: uint32(submissionID),
+		UserId:       uint32(c.GetUint("userID")),
+		Scores:       scores,
+		Feedback:     req.Feedback,
+	})
+	if err != nil {
+		respondGRPCError(c, "批改作业失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data":    resp.Submission,
+	})
+}
+
+// removeUploadedFile 删除提交失败后遗留的附件
+func (h *AssignmentHandler) removeUploadedFile(c *gin.Context, fileID uint32, userID uint) {
+	_, err := h.contentGRPCClient.DeleteFile(c.Request.Context(), &contentpb.DeleteFileRequest{
+		FileId: strconv.FormatUint(uint64(fileID), 10),
+		UserId: uint32(userID),
+	})
+	if err != nil {
+		log.Printf("⚠️ API: 清理作业附件失败 - 文件ID: %d, %v", fileID, err)
+	}
+}
+
+// parseIDParam 解析路径中的ID参数，失败时直接返回400
+func parseIDParam(c *gin.Context, name, message string) (uint, bool) {
+	id, err := strconv.ParseUint(c.Param(name), 10, 32)
+	if err != nil || id == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    400,
+			"message": message,
+		})
+		return 0, false
+	}
+	return uint(id), true
+}
+
+// respondGRPCError 返回调用微服务失败的响应
+func respondGRPCError(c *gin.Context, action string, err error) {
+	log.Printf("❌ API: %s - %v", action, err)
+	c.JSON(http.StatusInternalServerError, gin.H{
+		"code":    500,
+		"message": action + ": " + err.Error(),
+	})
+}
+
+// respondBusinessError 按业务码返回对应HTTP状态
+func respondBusinessError(c *gin.Context, code int32, message string) {
+	status := http.StatusBadRequest
+	switch code {
+	case 403:
+		status = http.StatusForbidden
+	case 404:
+		status = http.StatusNotFound
+	}
+	c.JSON(status, gin.H{
+		"code":    code,
+		"message": message,
+	})
+}
@@ -0,0 +1,140 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// 迟交策略
+const (
+	LatePolicyReject  = "reject"  // 截止后不再接受提交
+	LatePolicyAccept  = "accept"  // 接受迟交，仅标记
+	LatePolicyPenalty = "penalty" // 接受迟交，按天扣分
+)
+
+// 提交状态
+const (
+	SubmissionStatusSubmitted = "submitted" // 待批改
+	SubmissionStatusGraded    = "graded"    // 已批改
+)
+
+// RubricCriterion 评分标准项
+type RubricCriterion struct {
+	Name        string `json:"name"`        // 标准名称
+	Description string `json:"description"` // 标准说明
+	MaxScore    int    `json:"max_score"`   // 满分
+}
+
+// RubricScore 单项评分
+type RubricScore struct {
+	Criterion string `json:"criterion"` // 对应的标准名称
+	Score     int    `json:"score"`     // 得分
+	Comment   string `json:"comment"`   // 评语
+}
+
+// Assignment 课程作业
+type Assignment struct {
+	ID        uint           `gorm:"primarykey" json:"id"` // 主键ID
+	CreatedAt time.Time      `json:"created_at"`           // 创建时间
+	UpdatedAt time.Time      `json:"updated_at"`           // 更新时间
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`       // 软删除时间
+
+	CourseID           uint              `gorm:"not null;index" json:"course_id"`                      // 所属课程ID
+	ChapterID          uint              `gorm:"not null;default:0" json:"chapter_id"`                 // 所属章节ID，0表示不挂载章节
+	CreatorID          uint              `gorm:"not null" json:"creator_id"`                           // 创建者ID
+	Title              string            `gorm:"not null;size:200" json:"title"`                       // 作业标题
+	Description        string            `gorm:"type:text" json:"description"`                         // 作业要求
+	DueAt              time.Time         `gorm:"not null" json:"due_at"`                               // 截止时间
+	LatePolicy         string            `gorm:"size:20;not null;default:'reject'" json:"late_policy"` // 迟交策略
+	LatePenaltyPercent int               `gorm:"not null;default:0" json:"late_penalty_percent"`       // 每迟交一天扣除的百分比
+	LateCutoffHours    int               `gorm:"not null;default:0" json:"late_cutoff_hours"`          // 截止后仍可提交的小时数，0表示不限
+	Rubric             []RubricCriterion `gorm:"type:text;serializer:json" json:"rubric"`              // 评分标准
+}
+
+// TableName 指定表名
+func (Assignment) TableName() string {
+	return "assignments"
+}
+
+// MaxScore 作业满分（评分标准各项满分之和）
+func (a *Assignment) MaxScore() int {
+	total := 0
+	for _, c := range a.Rubric {
+		total += c.MaxScore
+	}
+	return total
+}
+
+// LateDays 计算迟交天数，不足一天按一天计，未迟交返回0
+func (a *Assignment) LateDays(submittedAt time.Time) int {
+	if !submittedAt.After(a.DueAt) {
+		return 0
+	}
+	late := submittedAt.Sub(a.DueAt)
+	days := int(late / (24 * time.Hour))
+	if late%(24*time.Hour) > 0 {
+		days++
+	}
+	return days
+}
+
+// AcceptsSubmissionAt 判断在指定时间是否还能提交
+func (a *Assignment) AcceptsSubmissionAt(t time.Time) bool {
+	if !t.After(a.DueAt) {
+		return true
+	}
+	if a.LatePolicy == LatePolicyReject {
+		return false
+	}
+	if a.LateCutoffHours > 0 {
+		return !t.After(a.DueAt.Add(time.Duration(a.LateCutoffHours) * time.Hour))
+	}
+	return true
+}
+
+// PenaltyPercent 根据迟交天数计算扣分百分比
+func (a *Assignment) PenaltyPercent(lateDays int) int {
+	if a.LatePolicy != LatePolicyPenalty || lateDays <= 0 {
+		return 0
+	}
+	percent := a.LatePenaltyPercent * lateDays
+	if percent > 100 {
+		percent = 100
+	}
+	return percent
+}
+
+// Submission 学员作业提交，每个学员每份作业一条记录，批改前可重新提交
+type Submission struct {
+	ID        uint      `gorm:"primarykey" json:"id"` // 主键ID
+	CreatedAt time.Time `json:"created_at"`           // 创建时间
+	UpdatedAt time.Time `json:"updated_at"`           // 更新时间
+
+	AssignmentID uint      `gorm:"not null;uniqueIndex:idx_assignment_user" json:"assignment_id"` // 作业ID
+	UserID       uint      `gorm:"not null;uniqueIndex:idx_assignment_user" json:"user_id"`       // 学员ID
+	Content      string    `gorm:"type:text" json:"content"`                                      // 文字答案
+	FileID       uint      `gorm:"not null;default:0" json:"file_id"`                             // 附件ID（内容服务）
+	FileName     string    `gorm:"size:255" json:"file_name"`                                     // 附件文件名
+	FileURL      string    `gorm:"size:500" json:"file_url"`                                      // 附件URL
+	Status       string    `gorm:"size:20;not null;index" json:"status"`                          // 提交状态
+	IsLate       bool      `gorm:"not null;default:false" json:"is_late"`                         // 是否迟交
+	LateDays     int       `gorm:"not null;default:0" json:"late_days"`                           // 迟交天数
+	SubmittedAt  time.Time `gorm:"not null" json:"submitted_at"`                                  // 最近一次提交时间
+
+	// 批改结果
+	RubricScores   []RubricScore `gorm:"type:text;serializer:json" json:"rubric_scores"` // 各项评分
+	RawScore       int           `gorm:"not null;default:0" json:"raw_score"`            // 扣分前得分
+	PenaltyPercent int           `gorm:"not null;default:0" json:"penalty_percent"`      // 迟交扣分百分比
+	Score          int           `gorm:"not null;default:0" json:"score"`                // 最终得分
+	Feedback       string        `gorm:"type:text" json:"feedback"`                      // 总评
+	GradedBy       uint          `gorm:"not null;default:0" json:"graded_by"`            // 批改人ID
+	GradedAt       *time.Time    `json:"graded_at"`                                      // 批改时间
+
+	Assignment Assignment `gorm:"foreignKey:AssignmentID" json:"-"` // 关联作业
+}
+
+// TableName 指定表名
+func (Submission) TableName() string {
+	return "assignment_submissions"
+}
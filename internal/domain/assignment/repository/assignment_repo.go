@@ -0,0 +1,180 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"course-platform/internal/domain/assignment/model"
+
+	"gorm.io/gorm"
+)
+
+// AssignmentRepositoryInterface 作业仓储接口
+type AssignmentRepositoryInterface interface {
+	CreateAssignment(assignment *model.Assignment) error
+	GetAssignmentByID(id uint) (*model.Assignment, error)
+	ListAssignments(courseID uint) ([]*model.Assignment, error)
+	GetSubmission(assignmentID, userID uint) (*model.Submission, error)
+	GetSubmissionByID(id uint) (*model.Submission, error)
+	CreateSubmission(submission *model.Submission) error
+	UpdateSubmissionContent(submission *model.Submission) error
+	ListSubmissions(assignmentID uint, status string) ([]*model.Submission, error)
+	ListPendingSubmissions(courseID uint) ([]*model.Submission, error)
+	SaveGrade(submission *model.Submission) error
+}
+
+// AssignmentRepository 作业仓储实现
+type AssignmentRepository struct {
+	db *gorm.DB
+}
+
+// NewAssignmentRepository 创建作业仓储实例
+func NewAssignmentRepository(db *gorm.DB) AssignmentRepositoryInterface {
+	return &AssignmentRepository{db: db}
+}
+
+// CreateAssignment 创建作业
+func (r *AssignmentRepository) CreateAssignment(assignment *model.Assignment) error {
+	if err := r.db.Create(assignment).Error; err != nil {
+		log.Printf("❌ Repository: 创建作业失败 - %v", err)
+		return fmt.Errorf("创建作业失败: %w", err)
+	}
+
+	log.Printf("✅ Repository: 作业创建成功 - ID: %d", assignment.ID)
+	return nil
+}
+
+// GetAssignmentByID 获取作业
+func (r *AssignmentRepository) GetAssignmentByID(id uint) (*model.Assignment, error) {
+	var assignment model.Assignment
+	if err := r.db.First(&assignment, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("作业不存在")
+		}
+		return nil, fmt.Errorf("查询作业失败: %w", err)
+	}
+	return &assignment, nil
+}
+
+// ListAssignments 获取课程作业（按截止时间排序）
+func (r *AssignmentRepository) ListAssignments(courseID uint) ([]*model.Assignment, error) {
+	var assignments []*model.Assignment
+	if err := r.db.Where("course_id = ?", courseID).
+		Order("due_at ASC, id ASC").Find(&assignments).Error; err != nil {
+		log.Printf("❌ Repository: 查询作业列表失败 - %v", err)
+		return nil, fmt.Errorf("查询作业列表失败: %w", err)
+	}
+	return assignments, nil
+}
+
+// GetSubmission 获取学员对某作业的提交，不存在时返回nil
+func (r *AssignmentRepository) GetSubmission(assignmentID, userID uint) (*model.Submission, error) {
+	var submission model.Submission
+	err := r.db.Where("assignment_id = ? AND user_id = ?", assignmentID, userID).First(&submission).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("查询作业提交失败: %w", err)
+	}
+	return &submission, nil
+}
+
+// GetSubmissionByID 获取提交记录（包含作业）
+func (r *AssignmentRepository) GetSubmissionByID(id uint) (*model.Submission, error) {
+	var submission model.Submission
+	if err := r.db.Preload("Assignment").First(&submission, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("提交记录不存在")
+		}
+		return nil, fmt.Errorf("查询提交记录失败: %w", err)
+	}
+	return &submission, nil
+}
+
+// CreateSubmission 创建提交记录
+func (r *AssignmentRepository) CreateSubmission(submission *model.Submission) error {
+	if err := r.db.Omit("Assignment").Create(submission).Error; err != nil {
+		log.Printf("❌ Repository: 创建作业提交失败 - %v", err)
+		return fmt.Errorf("提交作业失败: %w", err)
+	}
+	return nil
+}
+
+// UpdateSubmissionContent 重新提交，仅在尚未批改时更新
+func (r *AssignmentRepository) UpdateSubmissionContent(submission *model.Submission) error {
+	result := r.db.Model(&model.Submission{}).
+		Where("id = ? AND status = ?", submission.ID, model.SubmissionStatusSubmitted).
+		Updates(map[string]interface{}{
+			"content":      submission.Content,
+			"file_id":      submission.FileID,
+			"file_name":    submission.FileName,
+			"file_url":     submission.FileURL,
+			"is_late":      submission.IsLate,
+			"late_days":    submission.LateDays,
+			"submitted_at": submission.SubmittedAt,
+		})
+	if result.Error != nil {
+		log.Printf("❌ Repository: 更新作业提交失败 - %v", result.Error)
+		return fmt.Errorf("提交作业失败: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("作业已批改，无法重新提交")
+	}
+	return nil
+}
+
+// ListSubmissions 获取作业的提交列表，status为空时返回全部
+func (r *AssignmentRepository) ListSubmissions(assignmentID uint, status string) ([]*model.Submission, error) {
+	query := r.db.Where("assignment_id = ?", assignmentID)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	var submissions []*model.Submission
+	if err := query.Preload("Assignment").Order("submitted_at ASC").Find(&submissions).Error; err != nil {
+		log.Printf("❌ Repository: 查询作业提交失败 - %v", err)
+		return nil, fmt.Errorf("查询作业提交失败: %w", err)
+	}
+	return submissions, nil
+}
+
+// ListPendingSubmissions 获取课程下待批改的提交（先交先批）
+func (r *AssignmentRepository) ListPendingSubmissions(courseID uint) ([]*model.Submission, error) {
+	var submissions []*model.Submission
+	err := r.db.Joins("JOIN assignments ON assignments.id = assignment_submissions.assignment_id AND assignments.deleted_at IS NULL").
+		Where("assignments.course_id = ? AND assignment_submissions.status = ?", courseID, model.SubmissionStatusSubmitted).
+		Preload("Assignment").
+		Order("assignment_submissions.submitted_at ASC").
+		Find(&submissions).Error
+	if err != nil {
+		log.Printf("❌ Repository: 查询待批改队列失败 - %v", err)
+		return nil, fmt.Errorf("查询待批改队列失败: %w", err)
+	}
+	return submissions, nil
+}
+
+// SaveGrade 保存批改结果，允许讲师重新批改
+func (r *AssignmentRepository) SaveGrade(submission *model.Submission) error {
+	// 使用结构体更新，使评分明细经过JSON序列化
+	err := r.db.Model(&model.Submission{ID: submission.ID}).
+		Select("status", "rubric_scores", "raw_score", "penalty_percent", "score", "feedback", "graded_by", "graded_at").
+		Updates(&model.Submission{
+			Status:         submission.Status,
+			RubricScores:   submission.RubricScores,
+			RawScore:       submission.RawScore,
+			PenaltyPercent: submission.PenaltyPercent,
+			Score:          submission.Score,
+			Feedback:       submission.Feedback,
+			GradedBy:       submission.GradedBy,
+			GradedAt:       submission.GradedAt,
+		}).Error
+	if err != nil {
+		log.Printf("❌ Repository: 保存批改结果失败 - %v", err)
+		return fmt.Errorf("保存批改结果失败: %w", err)
+	}
+
+	log.Printf("✅ Repository: 批改结果已保存 - 提交ID: %d", submission.ID)
+	return nil
+}
@@ -0,0 +1,382 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"course-platform/internal/domain/assignment/model"
+	"course-platform/internal/domain/assignment/repository"
+	courseService "course-platform/internal/domain/course/service"
+	notificationModel "course-platform/internal/domain/notification/model"
+	notificationService "course-platform/internal/domain/notification/service"
+)
+
+// AssignmentServiceInterface 作业服务接口
+type AssignmentServiceInterface interface {
+	CreateAssignment(req *CreateAssignmentRequest) (*model.Assignment, error)
+	GetAssignment(assignmentID uint) (*model.Assignment, error)
+	ListAssignments(courseID uint) ([]*model.Assignment, error)
+	SubmitAssignment(req *SubmitAssignmentRequest) (*model.Submission, *model.Assignment, error)
+	GetMySubmission(assignmentID, userID uint) (*model.Submission, *model.Assignment, error)
+	ListSubmissions(assignmentID, userID uint, status string) ([]*model.Submission, error)
+	GetGradingQueue(courseID, userID uint) ([]*model.Submission, error)
+	GradeSubmission(req *GradeSubmissionRequest) (*model.Submission, error)
+}
+
+// CreateAssignmentRequest 创建作业请求
+type CreateAssignmentRequest struct {
+	CourseID           uint
+	ChapterID          uint
+	UserID             uint
+	Title              string
+	Description        string
+	DueAt              time.Time
+	LatePolicy         string
+	LatePenaltyPercent int
+	LateCutoffHours    int
+	Rubric             []model.RubricCriterion
+}
+
+// SubmitAssignmentRequest 提交作业请求，附件需先上传到内容服务
+type SubmitAssignmentRequest struct {
+	AssignmentID uint
+	UserID       uint
+	Content      string
+	FileID       uint
+	FileName     string
+	FileURL      string
+}
+
+// GradeSubmissionRequest 批改作业请求
+type GradeSubmissionRequest struct {
+	SubmissionID uint
+	UserID       uint
+	Scores       []model.RubricScore
+	Feedback     string
+}
+
+// AssignmentService 作业服务实现
+type AssignmentService struct {
+	assignmentRepo  repository.AssignmentRepositoryInterface
+	courseService   courseService.CourseServiceInterface
+	notificationSvc notificationService.NotificationServiceInterface
+}
+
+// NewAssignmentService 创建作业服务实例
+func NewAssignmentService(assignmentRepo repository.AssignmentRepositoryInterface, courseService courseService.CourseServiceInterface, notificationSvc notificationService.NotificationServiceInterface) AssignmentServiceInterface {
+	return &AssignmentService{
+		assignmentRepo:  assignmentRepo,
+		courseService:   courseService,
+		notificationSvc: notificationSvc,
+	}
+}
+
+// CreateAssignment 创建作业（仅课程讲师）
+func (s *AssignmentService) CreateAssignment(req *CreateAssignmentRequest) (*model.Assignment, error) {
+	log.Printf("🔍 Service: 创建作业 - 课程ID: %d, 标题: %s", req.CourseID, req.Title)
+
+	if strings.TrimSpace(req.Title) == "" {
+		return nil, errors.New("作业标题不能为空")
+	}
+	if req.DueAt.IsZero() {
+		return nil, errors.New("请设置截止时间")
+	}
+	if err := validateLatePolicy(req); err != nil {
+		return nil, err
+	}
+	if err := validateRubric(req.Rubric); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkInstructor(req.CourseID, req.UserID); err != nil {
+		return nil, err
+	}
+	if req.ChapterID != 0 {
+		chapter, err := s.courseService.GetChapterByID(req.ChapterID)
+		if err != nil {
+			return nil, err
+		}
+		if chapter.CourseID != req.CourseID {
+			return nil, errors.New("章节不属于该课程")
+		}
+	}
+
+	assignment := &model.Assignment{
+		CourseID:           req.CourseID,
+		ChapterID:          req.ChapterID,
+		CreatorID:          req.UserID,
+		Title:              strings.TrimSpace(req.Title),
+		Description:        req.Description,
+		DueAt:              req.DueAt,
+		LatePolicy:         req.LatePolicy,
+		LatePenaltyPercent: req.LatePenaltyPercent,
+		LateCutoffHours:    req.LateCutoffHours,
+		Rubric:             req.Rubric,
+	}
+	if err := s.assignmentRepo.CreateAssignment(assignment); err != nil {
+		return nil, err
+	}
+	return assignment, nil
+}
+
+// GetAssignment 获取作业详情
+func (s *AssignmentService) GetAssignment(assignmentID uint) (*model.Assignment, error) {
+	return s.assignmentRepo.GetAssignmentByID(assignmentID)
+}
+
+// ListAssignments 获取课程作业列表
+func (s *AssignmentService) ListAssignments(courseID uint) ([]*model.Assignment, error) {
+	if courseID == 0 {
+		return nil, errors.New("课程ID不能为空")
+	}
+	return s.assignmentRepo.ListAssignments(courseID)
+}
+
+// SubmitAssignment 提交作业，批改前可重复提交，以最后一次为准
+func (s *AssignmentService) SubmitAssignment(req *SubmitAssignmentRequest) (*model.Submission, *model.Assignment, error) {
+	log.Printf("🔍 Service: 提交作业 - 作业ID: %d, 用户ID: %d", req.AssignmentID, req.UserID)
+
+	if strings.TrimSpace(req.Content) == "" && req.FileID == 0 {
+		return nil, nil, errors.New("请填写作业内容或上传附件")
+	}
+
+	assignment, err := s.assignmentRepo.GetAssignmentByID(req.AssignmentID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hasAccess, err := s.courseService.HasCourseAccess(req.UserID, assignment.CourseID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !hasAccess {
+		return nil, nil, errors.New("请先报名该课程")
+	}
+
+	now := time.Now()
+	if !assignment.AcceptsSubmissionAt(now) {
+		return nil, nil, errors.New("作业已截止，不再接受提交")
+	}
+	lateDays := assignment.LateDays(now)
+
+	existing, err := s.assignmentRepo.GetSubmission(assignment.ID, req.UserID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	submission := existing
+	if submission == nil {
+		submission = &model.Submission{
+			AssignmentID: assignment.ID,
+			UserID:       req.UserID,
+			Status:       model.SubmissionStatusSubmitted,
+		}
+	} else if submission.Status == model.SubmissionStatusGraded {
+		return nil, nil, errors.New("作业已批改，无法重新提交")
+	}
+	submission.Content = req.Content
+	submission.FileID = req.FileID
+	submission.FileName = req.FileName
+	submission.FileURL = req.FileURL
+	submission.IsLate = lateDays > 0
+	submission.LateDays = lateDays
+	submission.SubmittedAt = now
+
+	if existing == nil {
+		err = s.assignmentRepo.CreateSubmission(submission)
+	} else {
+		err = s.assignmentRepo.UpdateSubmissionContent(submission)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if submission.IsLate {
+		log.Printf("⚠️ Service: 作业迟交 - 提交ID: %d, 迟交天数: %d", submission.ID, lateDays)
+	}
+	log.Printf("✅ Service: 作业提交成功 - 提交ID: %d", submission.ID)
+	return submission, assignment, nil
+}
+
+// GetMySubmission 获取学员本人的提交
+func (s *AssignmentService) GetMySubmission(assignmentID, userID uint) (*model.Submission, *model.Assignment, error) {
+	assignment, err := s.assignmentRepo.GetAssignmentByID(assignmentID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	submission, err := s.assignmentRepo.GetSubmission(assignmentID, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if submission == nil {
+		return nil, nil, errors.New("尚未提交该作业")
+	}
+	submission.Assignment = *assignment
+	return submission, assignment, nil
+}
+
+// ListSubmissions 获取作业的提交列表（仅课程讲师）
+func (s *AssignmentService) ListSubmissions(assignmentID, userID uint, status string) ([]*model.Submission, error) {
+	if status != "" && status != model.SubmissionStatusSubmitted && status != model.SubmissionStatusGraded {
+		return nil, fmt.Errorf("无效的提交状态: %s", status)
+	}
+
+	assignment, err := s.assignmentRepo.GetAssignmentByID(assignmentID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkInstructor(assignment.CourseID, userID); err != nil {
+		return nil, err
+	}
+	return s.assignmentRepo.ListSubmissions(assignmentID, status)
+}
+
+// GetGradingQueue 获取课程待批改队列（仅课程讲师）
+func (s *AssignmentService) GetGradingQueue(courseID, userID uint) ([]*model.Submission, error) {
+	if err := s.checkInstructor(courseID, userID); err != nil {
+		return nil, err
+	}
+	return s.assignmentRepo.ListPendingSubmissions(courseID)
+}
+
+// GradeSubmission 按评分标准批改作业，迟交扣分按作业的迟交策略计算
+func (s *AssignmentService) GradeSubmission(req *GradeSubmissionRequest) (*model.Submission, error) {
+	log.Printf("🔍 Service: 批改作业 - 提交ID: %d, 批改人: %d", req.SubmissionID, req.UserID)
+
+	submission, err := s.assignmentRepo.GetSubmissionByID(req.SubmissionID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkInstructor(submission.Assignment.CourseID, req.UserID); err != nil {
+		return nil, err
+	}
+
+	scores, rawScore, err := scoreRubric(submission.Assignment.Rubric, req.Scores)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	penalty := submission.Assignment.PenaltyPercent(submission.LateDays)
+	submission.Status = model.SubmissionStatusGraded
+	submission.RubricScores = scores
+	submission.RawScore = rawScore
+	submission.PenaltyPercent = penalty
+	submission.Score = rawScore * (100 - penalty) / 100
+	submission.Feedback = req.Feedback
+	submission.GradedBy = req.UserID
+	submission.GradedAt = &now
+
+	if err := s.assignmentRepo.SaveGrade(submission); err != nil {
+		return nil, err
+	}
+
+	log.Printf("✅ Service: 批改完成 - 提交ID: %d, 得分: %d/%d", submission.ID, submission.Score, submission.Assignment.MaxScore())
+
+	// 通知学员批改结果，发送失败不影响批改
+	if _, err := s.notificationSvc.Notify([]uint{submission.UserID}, &notificationService.Message{
+		Type:  notificationModel.TypeAssignmentGraded,
+		Title: fmt.Sprintf("作业「%s」已批改", submission.Assignment.Title),
+		Body:  fmt.Sprintf("得分 %d/%d", submission.Score, submission.Assignment.MaxScore()),
+		Link:  fmt.Sprintf("/course/%d", submission.Assignment.CourseID),
+		RefID: submission.ID,
+	}); err != nil {
+		log.Printf("⚠️ Service: 批改通知发送失败 - 提交ID: %d, 错误: %v", submission.ID, err)
+	}
+	return submission, nil
+}
+
+// checkInstructor 校验用户是否为课程讲师
+func (s *AssignmentService) checkInstructor(courseID, userID uint) error {
+	course, err := s.courseService.GetCourseByID(courseID)
+	if err != nil {
+		return err
+	}
+	if userID == 0 || course.InstructorID != userID {
+		return errors.New("只有课程讲师可以管理作业")
+	}
+	return nil
+}
+
+// validateLatePolicy 校验迟交策略，未设置时默认不接受迟交
+func validateLatePolicy(req *CreateAssignmentRequest) error {
+	if req.LatePolicy == "" {
+		req.LatePolicy = model.LatePolicyReject
+	}
+	switch req.LatePolicy {
+	case model.LatePolicyReject:
+		req.LatePenaltyPercent = 0
+		req.LateCutoffHours = 0
+	case model.LatePolicyAccept:
+		req.LatePenaltyPercent = 0
+	case model.LatePolicyPenalty:
+		if req.LatePenaltyPercent <= 0 || req.LatePenaltyPercent > 100 {
+			return errors.New("迟交扣分比例必须在1到100之间")
+		}
+	default:
+		return fmt.Errorf("不支持的迟交策略: %s", req.LatePolicy)
+	}
+	if req.LateCutoffHours < 0 {
+		return errors.New("迟交截止时长不能为负数")
+	}
+	return nil
+}
+
+// validateRubric 校验评分标准：至少一项，名称唯一且满分大于0
+func validateRubric(rubric []model.RubricCriterion) error {
+	if len(rubric) == 0 {
+		return errors.New("请至少设置一项评分标准")
+	}
+	seen := make(map[string]bool, len(rubric))
+	for i := range rubric {
+		rubric[i].Name = strings.TrimSpace(rubric[i].Name)
+		name := rubric[i].Name
+		if name == "" {
+			return errors.New("评分标准名称不能为空")
+		}
+		if seen[name] {
+			return fmt.Errorf("评分标准名称重复: %s", name)
+		}
+		if rubric[i].MaxScore <= 0 {
+			return fmt.Errorf("评分标准 %s 的满分必须大于0", name)
+		}
+		seen[name] = true
+	}
+	return nil
+}
+
+// scoreRubric 校验各项评分并按评分标准顺序返回，每一项都必须打分
+func scoreRubric(rubric []model.RubricCriterion, given []model.RubricScore) ([]model.RubricScore, int, error) {
+	byName := make(map[string]model.RubricScore, len(given))
+	for _, g := range given {
+		name := strings.TrimSpace(g.Criterion)
+		if _, dup := byName[name]; dup {
+			return nil, 0, fmt.Errorf("评分标准重复打分: %s", name)
+		}
+		g.Criterion = name
+		byName[name] = g
+	}
+
+	scores := make([]model.RubricScore, 0, len(rubric))
+	total := 0
+	for _, c := range rubric {
+		g, ok := byName[c.Name]
+		if !ok {
+			return nil, 0, fmt.Errorf("缺少评分项: %s", c.Name)
+		}
+		if g.Score < 0 || g.Score > c.MaxScore {
+			return nil, 0, fmt.Errorf("评分项 %s 的得分必须在0到%d之间", c.Name, c.MaxScore)
+		}
+		scores = append(scores, g)
+		total += g.Score
+		delete(byName, c.Name)
+	}
+	for name := range byName {
+		return nil, 0, fmt.Errorf("未知的评分项: %s", name)
+	}
+	return scores, total, nil
+}
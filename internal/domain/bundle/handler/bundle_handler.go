@@ -0,0 +1,423 @@
+package handler
+
+import (
+	"log"
+	"net/http"
+	"strconv"
+
+	service "course-platform/internal/infrastructure/grpc_client"
+	"course-platform/internal/shared/pb/bundlepb"
+
+	"github.com/gin-gonic/gin"
+)
+
+// BundleHandler API Gateway的课程套餐处理器
+type BundleHandler struct {
+	bundleGRPCClient *service.BundleGRPCClientService
+}
+
+// NewBundleHandler 创建课程套餐处理器
+func NewBundleHandler(bundleGRPCClient *service.BundleGRPCClientService) *BundleHandler {
+	return &BundleHandler{
+		bundleGRPCClient: bundleGRPCClient,
+	}
+}
+
+// BundleStepRequest 套餐中的一门课程
+type BundleStepRequest struct {
+	CourseID              uint32   `json:"course_id" binding:"required"`
+	PrerequisiteCourseIDs []uint32 `json:"prerequisite_course_ids"`
+}
+
+// SaveBundleRequest 创建或更新套餐请求结构
+// kind 为 bundle（课程套餐）或 path（学习路径），只有学习路径可以设置先修课程
+type SaveBundleRequest struct {
+	Title       string              `json:"title" binding:"required"`
+	Description string              `json:"description"`
+	CoverImage  string              `json:"cover_image"`
+	Kind        string              `json:"kind"`
+	Price       float32             `json:"price"`
+	Steps       []BundleStepRequest `json:"steps" binding:"required"`
+}
+
+// ListBundles 获取已发布的套餐列表
+// @Summary 套餐列表
+// @Description 获取已发布的课程套餐和学习路径
+// @Tags 课程套餐
+// @Produce json
+// @Param page query int false "页码，默认1"
+// @Param page_size query int false "每页数量，默认10"
+// @Success 200 {object} map[string]interface{}
+// @Router /api/v1/bundles [get]
+func (h *BundleHandler) ListBundles(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 50 {
+		pageSize = 10
+	}
+
+	resp, err := h.bundleGRPCClient.ListBundles(c.Request.Context(), uint(page), uint(pageSize))
+	if err != nil {
+		respondGRPCError(c, "获取套餐列表失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data": gin.H{
+			"bundles":   resp.Bundles,
+			"total":     resp.Total,
+			"page":      page,
+			"page_size": pageSize,
+		},
+	})
+}
+
+// GetBundle 获取套餐详情
+// @Summary 套餐详情
+// @Description 获取套餐及其包含的课程，未发布的套餐仅创建者可见
+// @Tags 课程套餐
+// @Produce json
+// @Param id path int true "套餐ID"
+// @Success 200 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Router /api/v1/bundles/{id} [get]
+func (h *BundleHandler) GetBundle(c *gin.Context) {
+	bundleID, ok := parseIDParam(c, "id", "套餐ID参数无效")
+	if !ok {
+		return
+	}
+
+	resp, err := h.bundleGRPCClient.GetBundle(c.Request.Context(), bundleID, c.GetUint("userID"))
+	if err != nil {
+		respondGRPCError(c, "获取套餐失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data":    resp.Bundle,
+	})
+}
+
+// CreateBundle 创建套餐
+// @Summary 创建套餐
+// @Description 讲师用自己的课程创建套餐或学习路径，需要包含2到30门课程，创建后为草稿状态
+// @Tags 课程套餐
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param bundle body SaveBundleRequest true "套餐信息"
+// @Success 200 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Router /api/v1/bundles [post]
+func (h *BundleHandler) CreateBundle(c *gin.Context) {
+	var req SaveBundleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    400,
+			"message": "请求参数无效: " + err.Error(),
+		})
+		return
+	}
+
+	resp, err := h.bundleGRPCClient.CreateBundle(c.Request.Context(), &bundlepb.CreateBundleRequest{
+		UserId:      uint32(c.GetUint("userID")),
+		Title:       req.Title,
+		Description: req.Description,
+		CoverImage:  req.CoverImage,
+		Kind:        req.Kind,
+		Price:       req.Price,
+		Steps:       convertStepsToPB(req.Steps),
+	})
+	if err != nil {
+		respondGRPCError(c, "创建套餐失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data":    resp.Bundle,
+	})
+}
+
+// UpdateBundle 更新套餐
+// @Summary 更新套餐
+// @Description 更新套餐信息和课程列表，课程顺序以 steps 的顺序为准
+// @Tags 课程套餐
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path int true "套餐ID"
+// @Param bundle body SaveBundleRequest true "套餐信息"
+// @Success 200 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Router /api/v1/bundles/{id} [put]
+func (h *BundleHandler) UpdateBundle(c *gin.Context) {
+	bundleID, ok := parseIDParam(c, "id", "套餐ID参数无效")
+	if !ok {
+		return
+	}
+
+	var req SaveBundleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    400,
+			"message": "请求参数无效: " + err.Error(),
+		})
+		return
+	}
+
+	resp, err := h.bundleGRPCClient.UpdateBundle(c.Request.Context(), &bundlepb.UpdateBundleRequest{
+		BundleId:    uint32(bundleID),
+		UserId:      uint32(c.GetUint("userID")),
+		Title:       req.Title,
+		Description: req.Description,
+		CoverImage:  req.CoverImage,
+		Kind:        req.Kind,
+		Price:       req.Price,
+		Steps:       convertStepsToPB(req.Steps),
+	})
+	if err != nil {
+		respondGRPCError(c, "更新套餐失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data":    resp.Bundle,
+	})
+}
+
+// PublishBundle 发布套餐
+// @Summary 发布套餐
+// @Description 发布套餐，套餐中的课程必须都已发布
+// @Tags 课程套餐
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path int true "套餐ID"
+// @Success 200 {object} map[string]interface{}
+// @Router /api/v1/bundles/{id}/publish [post]
+func (h *BundleHandler) PublishBundle(c *gin.Context) {
+	bundleID, ok := parseIDParam(c, "id", "套餐ID参数无效")
+	if !ok {
+		return
+	}
+
+	resp, err := h.bundleGRPCClient.PublishBundle(c.Request.Context(), bundleID, c.GetUint("userID"))
+	if err != nil {
+		respondGRPCError(c, "发布套餐失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data":    resp.Bundle,
+	})
+}
+
+// ListMyBundles 获取本人创建的套餐
+// @Summary 我的套餐
+// @Description 讲师查看自己创建的全部套餐，包括草稿
+// @Tags 课程套餐
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Success 200 {object} map[string]interface{}
+// @Router /api/v1/bundles/mine [get]
+func (h *BundleHandler) ListMyBundles(c *gin.Context) {
+	resp, err := h.bundleGRPCClient.ListMyBundles(c.Request.Context(), c.GetUint("userID"))
+	if err != nil {
+		respondGRPCError(c, "获取我的套餐失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data":    resp.Bundles,
+	})
+}
+
+// EnrollBundle 报名免费套餐
+// @Summary 报名套餐
+// @Description 报名免费套餐，一次开通其中的全部课程；付费套餐请通过订单购买
+// @Tags 课程套餐
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path int true "套餐ID"
+// @Success 200 {object} map[string]interface{}
+// @Router /api/v1/bundles/{id}/enroll [post]
+func (h *BundleHandler) EnrollBundle(c *gin.Context) {
+	bundleID, ok := parseIDParam(c, "id", "套餐ID参数无效")
+	if !ok {
+		return
+	}
+
+	resp, err := h.bundleGRPCClient.EnrollBundle(c.Request.Context(), bundleID, c.GetUint("userID"))
+	if err != nil {
+		respondGRPCError(c, "报名套餐失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+	})
+}
+
+// GetBundleProgress 获取学习路径进度
+// @Summary 学习路径进度
+// @Description 获取当前用户在套餐各课程的学习进度，先修课程未完成的步骤标记为 locked
+// @Tags 课程套餐
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path int true "套餐ID"
+// @Success 200 {object} map[string]interface{}
+// @Router /api/v1/bundles/{id}/progress [get]
+func (h *BundleHandler) GetBundleProgress(c *gin.Context) {
+	bundleID, ok := parseIDParam(c, "id", "套餐ID参数无效")
+	if !ok {
+		return
+	}
+
+	resp, err := h.bundleGRPCClient.GetBundleProgress(c.Request.Context(), bundleID, c.GetUint("userID"))
+	if err != nil {
+		respondGRPCError(c, "获取学习进度失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data":    resp.Progress,
+	})
+}
+
+// BundleDetailPage 套餐详情页面
+func (h *BundleHandler) BundleDetailPage(c *gin.Context) {
+	log.Printf("🎨 页面: 收到套餐详情页面请求")
+
+	bundleID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.HTML(http.StatusBadRequest, "bundle-detail.html", gin.H{
+			"SiteName": "Course Platform",
+			"Error":    "套餐ID参数无效",
+		})
+		return
+	}
+
+	resp, err := h.bundleGRPCClient.GetBundle(c.Request.Context(), uint(bundleID), 0)
+	if err != nil {
+		c.HTML(http.StatusInternalServerError, "bundle-detail.html", gin.H{
+			"SiteName": "Course Platform",
+			"Error":    "套餐服务暂时不可用，请稍后重试",
+		})
+		return
+	}
+	if resp.Code != 200 {
+		c.HTML(http.StatusNotFound, "bundle-detail.html", gin.H{
+			"SiteName": "Course Platform",
+			"Error":    "套餐不存在或尚未发布",
+		})
+		return
+	}
+
+	bundle := resp.Bundle
+	c.HTML(http.StatusOK, "bundle-detail.html", gin.H{
+		"SiteName": "Course Platform",
+		"Bundle":   bundle,
+		"IsPath":   bundle.Kind == "path",
+		"Savings":  bundle.CoursesPrice - bundle.Price,
+	})
+}
+
+// convertStepsToPB 转换套餐课程列表
+func convertStepsToPB(steps []BundleStepRequest) []*bundlepb.BundleStep {
+	result := make([]*bundlepb.BundleStep, 0, len(steps))
+	for _, step := range steps {
+		result = append(result, &bundlepb.BundleStep{
+			CourseId:              step.CourseID,
+			PrerequisiteCourseIds: step.PrerequisiteCourseIDs,
+		})
+	}
+	return result
+}
+
+// parseIDParam 解析路径中的ID参数，失败时直接返回400
+func parseIDParam(c *gin.Context, name, message string) (uint, bool) {
+	id, err := strconv.ParseUint(c.Param(name), 10, 32)
+	if err != nil || id == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    400,
+			"message": message,
+		})
+		return 0, false
+	}
+	return uint(id), true
+}
+
+// respondGRPCError 返回调用微服务失败的响应
+func respondGRPCError(c *gin.Context, action string, err error) {
+	log.Printf("❌ API: %s - %v", action, err)
+	c.JSON(http.StatusInternalServerError, gin.H{
+		"code":    500,
+		"message": action + ": " + err.Error(),
+	})
+}
+
+// respondBusinessError 按业务码返回对应HTTP状态
+func respondBusinessError(c *gin.Context, code int32, message string) {
+	status := http.StatusBadRequest
+	switch code {
+	case 403:
+		status = http.StatusForbidden
+	case 404:
+		status = http.StatusNotFound
+	case 409:
+		status = http.StatusConflict
+	}
+	c.JSON(status, gin.H{
+		"code":    code,
+		"message": message,
+	})
+}
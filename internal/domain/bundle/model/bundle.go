@@ -0,0 +1,135 @@
+package model
+
+import (
+	"time"
+)
+
+// 套餐类型
+const (
+	BundleKindBundle = "bundle" // 课程套餐：打包优惠购买，不限学习顺序
+	BundleKindPath   = "path"   // 学习路径：按步骤顺序学习，可设置步骤间的先修关系
+)
+
+// 套餐状态
+const (
+	BundleStatusDraft     = "draft"     // 草稿
+	BundleStatusPublished = "published" // 已发布
+)
+
+// 学习步骤状态
+const (
+	StepStatusLocked     = "locked"      // 先修步骤未完成
+	StepStatusAvailable  = "available"   // 可以开始学习
+	StepStatusInProgress = "in_progress" // 学习中
+	StepStatusCompleted  = "completed"   // 已学完
+)
+
+// Bundle 课程套餐或学习路径，由同一讲师的多门课程按顺序组成
+// 价格为0表示免费领取；付费套餐通过订单购买，支付后开通全部课程
+type Bundle struct {
+	ID        uint      `gorm:"primarykey" json:"id"` // 主键ID
+	CreatedAt time.Time `json:"created_at"`           // 创建时间
+	UpdatedAt time.Time `json:"updated_at"`           // 更新时间
+
+	Title       string  `gorm:"not null;size:200" json:"title"`                       // 套餐标题
+	Description string  `gorm:"type:text" json:"description"`                         // 套餐介绍
+	CoverImage  string  `gorm:"size:500" json:"cover_image"`                          // 封面图片
+	Kind        string  `gorm:"size:20;not null;default:'bundle'" json:"kind"`        // 套餐类型
+	CreatorID   uint    `gorm:"not null;index" json:"creator_id"`                     // 创建讲师ID
+	Price       float32 `gorm:"not null;default:0" json:"price"`                      // 套餐价格（元）
+	Status      string  `gorm:"size:20;not null;default:'draft';index" json:"status"` // 状态
+
+	Courses []BundleCourse `gorm:"foreignKey:BundleID" json:"courses"` // 按顺序排列的课程
+}
+
+// TableName 指定表名
+func (Bundle) TableName() string {
+	return "bundles"
+}
+
+// IsPublished 是否已发布
+func (b *Bundle) IsPublished() bool {
+	return b.Status == BundleStatusPublished
+}
+
+// IsFree 是否免费
+func (b *Bundle) IsFree() bool {
+	return b.Price <= 0
+}
+
+// CourseIDs 按顺序返回套餐中的课程ID
+func (b *Bundle) CourseIDs() []uint {
+	ids := make([]uint, 0, len(b.Courses))
+	for _, c := range b.Courses {
+		ids = append(ids, c.CourseID)
+	}
+	return ids
+}
+
+// CoursesPrice 单独购买套餐中全部课程的价格（元）
+func (b *Bundle) CoursesPrice() float32 {
+	var total float32
+	for _, c := range b.Courses {
+		total += c.CoursePrice
+	}
+	return total
+}
+
+// BundleCourse 套餐中的一门课程（学习路径中的一个步骤）
+// PrerequisiteCourseIDs 只能引用排在前面的课程，这些课程学完后本步骤才解锁
+type BundleCourse struct {
+	ID uint `gorm:"primarykey" json:"id"` // 主键ID
+
+	BundleID              uint   `gorm:"not null;uniqueIndex:idx_bundle_course" json:"bundle_id"`       // 套餐ID
+	CourseID              uint   `gorm:"not null;uniqueIndex:idx_bundle_course;index" json:"course_id"` // 课程ID
+	Position              int    `gorm:"not null;default:0" json:"position"`                            // 排列顺序，从0开始
+	PrerequisiteCourseIDs []uint `gorm:"type:text;serializer:json" json:"prerequisite_course_ids"`      // 先修课程ID
+
+	// 以下字段不入库，由服务层按课程信息填充
+	CourseTitle string  `gorm:"-" json:"course_title"` // 课程标题
+	CoursePrice float32 `gorm:"-" json:"course_price"` // 课程单独购买价格（元）
+}
+
+// TableName 指定表名
+func (BundleCourse) TableName() string {
+	return "bundle_courses"
+}
+
+// BundleEnrollment 学员领取或购买套餐的记录，课程权限仍以每门课程的选课记录为准
+type BundleEnrollment struct {
+	ID        uint      `gorm:"primarykey" json:"id"` // 主键ID
+	CreatedAt time.Time `json:"created_at"`           // 创建时间
+	UpdatedAt time.Time `json:"updated_at"`           // 更新时间
+
+	UserID     uint      `gorm:"not null;uniqueIndex:idx_bundle_enrollment_user" json:"user_id"`         // 学员ID
+	BundleID   uint      `gorm:"not null;uniqueIndex:idx_bundle_enrollment_user;index" json:"bundle_id"` // 套餐ID
+	Status     string    `gorm:"size:20;not null;default:'active'" json:"status"`                        // 状态，与课程选课状态一致
+	EnrolledAt time.Time `json:"enrolled_at"`                                                            // 报名时间
+}
+
+// TableName 指定表名
+func (BundleEnrollment) TableName() string {
+	return "bundle_enrollments"
+}
+
+// StepProgress 学习路径中单个步骤的进度
+type StepProgress struct {
+	CourseID              uint
+	CourseTitle           string
+	Position              int
+	PrerequisiteCourseIDs []uint
+	CompletedChapters     int
+	TotalChapters         int
+	Percent               int
+	Status                string
+}
+
+// PathProgress 学习路径整体进度，Percent 为已学完课程数占比
+type PathProgress struct {
+	BundleID         uint
+	Enrolled         bool
+	CompletedCourses int
+	TotalCourses     int
+	Percent          int
+	Steps            []*StepProgress
+}
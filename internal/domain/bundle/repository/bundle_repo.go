@@ -0,0 +1,151 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"course-platform/internal/domain/bundle/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// BundleRepositoryInterface 课程套餐仓储接口
+type BundleRepositoryInterface interface {
+	Create(bundle *model.Bundle) error
+	Update(bundle *model.Bundle) error
+	GetByID(id uint) (*model.Bundle, error)
+	ListPublished(page, pageSize int) ([]*model.Bundle, int64, error)
+	ListByCreator(creatorID uint) ([]*model.Bundle, error)
+	GetEnrollment(userID, bundleID uint) (*model.BundleEnrollment, error)
+	SaveEnrollment(enrollment *model.BundleEnrollment) error
+}
+
+// BundleRepository 课程套餐仓储实现
+type BundleRepository struct {
+	db *gorm.DB
+}
+
+// NewBundleRepository 创建课程套餐仓储实例
+func NewBundleRepository(db *gorm.DB) BundleRepositoryInterface {
+	return &BundleRepository{db: db}
+}
+
+// Create 创建套餐及其课程列表
+func (r *BundleRepository) Create(bundle *model.Bundle) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Courses").Create(bundle).Error; err != nil {
+			return err
+		}
+		return createBundleCourses(tx, bundle)
+	})
+	if err != nil {
+		log.Printf("❌ Repository: 创建套餐失败 - %v", err)
+		return fmt.Errorf("创建套餐失败: %w", err)
+	}
+
+	log.Printf("✅ Repository: 套餐创建成功 - ID: %d", bundle.ID)
+	return nil
+}
+
+// Update 更新套餐信息并整体替换课程列表
+func (r *BundleRepository) Update(bundle *model.Bundle) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Courses").Save(bundle).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("bundle_id = ?", bundle.ID).Delete(&model.BundleCourse{}).Error; err != nil {
+			return err
+		}
+		return createBundleCourses(tx, bundle)
+	})
+	if err != nil {
+		log.Printf("❌ Repository: 更新套餐失败 - %v", err)
+		return fmt.Errorf("更新套餐失败: %w", err)
+	}
+	return nil
+}
+
+// createBundleCourses 写入套餐课程列表，Position 按列表顺序重新编号
+func createBundleCourses(tx *gorm.DB, bundle *model.Bundle) error {
+	if len(bundle.Courses) == 0 {
+		return nil
+	}
+	for i := range bundle.Courses {
+		bundle.Courses[i].ID = 0
+		bundle.Courses[i].BundleID = bundle.ID
+		bundle.Courses[i].Position = i
+	}
+	return tx.Omit(clause.Associations).Create(&bundle.Courses).Error
+}
+
+// GetByID 获取套餐（包含按顺序排列的课程）
+func (r *BundleRepository) GetByID(id uint) (*model.Bundle, error) {
+	var bundle model.Bundle
+	err := r.db.Preload("Courses", func(db *gorm.DB) *gorm.DB {
+		return db.Order("position ASC")
+	}).First(&bundle, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("套餐不存在")
+		}
+		return nil, fmt.Errorf("查询套餐失败: %w", err)
+	}
+	return &bundle, nil
+}
+
+// ListPublished 分页获取已发布的套餐
+func (r *BundleRepository) ListPublished(page, pageSize int) ([]*model.Bundle, int64, error) {
+	query := r.db.Model(&model.Bundle{}).Where("status = ?", model.BundleStatusPublished)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("统计套餐数量失败: %w", err)
+	}
+
+	var bundles []*model.Bundle
+	err := query.Preload("Courses", func(db *gorm.DB) *gorm.DB {
+		return db.Order("position ASC")
+	}).Order("created_at DESC").Offset((page - 1) * pageSize).Limit(pageSize).Find(&bundles).Error
+	if err != nil {
+		log.Printf("❌ Repository: 查询套餐列表失败 - %v", err)
+		return nil, 0, fmt.Errorf("查询套餐列表失败: %w", err)
+	}
+	return bundles, total, nil
+}
+
+// ListByCreator 获取讲师创建的全部套餐
+func (r *BundleRepository) ListByCreator(creatorID uint) ([]*model.Bundle, error) {
+	var bundles []*model.Bundle
+	err := r.db.Preload("Courses", func(db *gorm.DB) *gorm.DB {
+		return db.Order("position ASC")
+	}).Where("creator_id = ?", creatorID).Order("created_at DESC").Find(&bundles).Error
+	if err != nil {
+		log.Printf("❌ Repository: 查询讲师套餐失败 - %v", err)
+		return nil, fmt.Errorf("查询套餐列表失败: %w", err)
+	}
+	return bundles, nil
+}
+
+// GetEnrollment 获取学员的套餐报名记录，不存在时返回nil
+func (r *BundleRepository) GetEnrollment(userID, bundleID uint) (*model.BundleEnrollment, error) {
+	var enrollment model.BundleEnrollment
+	err := r.db.Where("user_id = ? AND bundle_id = ?", userID, bundleID).First(&enrollment).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("查询套餐报名记录失败: %w", err)
+	}
+	return &enrollment, nil
+}
+
+// SaveEnrollment 创建或更新套餐报名记录
+func (r *BundleRepository) SaveEnrollment(enrollment *model.BundleEnrollment) error {
+	if err := r.db.Save(enrollment).Error; err != nil {
+		log.Printf("❌ Repository: 保存套餐报名记录失败 - %v", err)
+		return fmt.Errorf("保存套餐报名记录失败: %w", err)
+	}
+	return nil
+}
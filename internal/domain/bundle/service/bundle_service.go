@@ -0,0 +1,440 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"course-platform/internal/domain/bundle/model"
+	"course-platform/internal/domain/bundle/repository"
+	courseModel "course-platform/internal/domain/course/model"
+	courseService "course-platform/internal/domain/course/service"
+)
+
+// 套餐课程数量限制
+const (
+	minBundleCourses = 2
+	maxBundleCourses = 30
+)
+
+// BundleServiceInterface 课程套餐服务接口
+type BundleServiceInterface interface {
+	CreateBundle(req *SaveBundleRequest) (*model.Bundle, error)
+	UpdateBundle(bundleID uint, req *SaveBundleRequest) (*model.Bundle, error)
+	PublishBundle(bundleID, userID uint) (*model.Bundle, error)
+	GetBundle(bundleID, userID uint) (*model.Bundle, error)
+	ListBundles(page, pageSize int) ([]*model.Bundle, int64, error)
+	ListMyBundles(userID uint) ([]*model.Bundle, error)
+	EnrollBundle(userID, bundleID uint) (*model.BundleEnrollment, error)
+	GrantBundle(userID, bundleID uint) (*model.BundleEnrollment, error)
+	RevokeBundle(userID, bundleID uint) error
+	IsEnrolled(userID, bundleID uint) (bool, error)
+	GetPathProgress(userID, bundleID uint) (*model.PathProgress, error)
+}
+
+// SaveBundleRequest 创建或更新套餐请求，Steps 的顺序即学习顺序
+type SaveBundleRequest struct {
+	UserID      uint
+	Title       string
+	Description string
+	CoverImage  string
+	Kind        string
+	Price       float32
+	Steps       []StepInput
+}
+
+// StepInput 套餐中的一门课程，先修课程只能是排在前面的课程
+type StepInput struct {
+	CourseID              uint
+	PrerequisiteCourseIDs []uint
+}
+
+// BundleService 课程套餐服务实现
+type BundleService struct {
+	bundleRepo    repository.BundleRepositoryInterface
+	courseService courseService.CourseServiceInterface
+}
+
+// NewBundleService 创建课程套餐服务实例
+func NewBundleService(bundleRepo repository.BundleRepositoryInterface, courseService courseService.CourseServiceInterface) BundleServiceInterface {
+	return &BundleService{
+		bundleRepo:    bundleRepo,
+		courseService: courseService,
+	}
+}
+
+// CreateBundle 讲师用自己的课程创建套餐或学习路径，创建后为草稿状态
+func (s *BundleService) CreateBundle(req *SaveBundleRequest) (*model.Bundle, error) {
+	log.Printf("🔍 Service: 创建套餐 - 标题: %s, 讲师ID: %d", req.Title, req.UserID)
+
+	bundle := &model.Bundle{
+		CreatorID: req.UserID,
+		Status:    model.BundleStatusDraft,
+	}
+	if err := s.applyRequest(bundle, req); err != nil {
+		return nil, err
+	}
+	if err := s.bundleRepo.Create(bundle); err != nil {
+		return nil, err
+	}
+
+	log.Printf("✅ Service: 套餐创建成功 - ID: %d", bundle.ID)
+	return s.GetBundle(bundle.ID, req.UserID)
+}
+
+// UpdateBundle 更新套餐信息和课程列表（仅创建者）
+// 已报名的学员不会自动获得新加入的课程
+func (s *BundleService) UpdateBundle(bundleID uint, req *SaveBundleRequest) (*model.Bundle, error) {
+	log.Printf("🔍 Service: 更新套餐 - ID: %d", bundleID)
+
+	bundle, err := s.getOwnedBundle(bundleID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.applyRequest(bundle, req); err != nil {
+		return nil, err
+	}
+	if bundle.IsPublished() {
+		if err := s.checkCoursesPublished(bundle.CourseIDs()); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.bundleRepo.Update(bundle); err != nil {
+		return nil, err
+	}
+
+	log.Printf("✅ Service: 套餐更新成功 - ID: %d", bundle.ID)
+	return s.GetBundle(bundle.ID, req.UserID)
+}
+
+// PublishBundle 发布套餐，套餐中的课程必须都已发布
+func (s *BundleService) PublishBundle(bundleID, userID uint) (*model.Bundle, error) {
+	bundle, err := s.getOwnedBundle(bundleID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if bundle.IsPublished() {
+		return bundle, nil
+	}
+	if err := s.checkCoursesPublished(bundle.CourseIDs()); err != nil {
+		return nil, err
+	}
+
+	bundle.Status = model.BundleStatusPublished
+	if err := s.bundleRepo.Update(bundle); err != nil {
+		return nil, err
+	}
+
+	log.Printf("✅ Service: 套餐已发布 - ID: %d", bundle.ID)
+	return s.GetBundle(bundle.ID, userID)
+}
+
+// GetBundle 获取套餐详情，草稿仅创建者可见
+func (s *BundleService) GetBundle(bundleID, userID uint) (*model.Bundle, error) {
+	bundle, err := s.bundleRepo.GetByID(bundleID)
+	if err != nil {
+		return nil, err
+	}
+	if !bundle.IsPublished() && bundle.CreatorID != userID {
+		return nil, errors.New("套餐不存在")
+	}
+	s.fillCourses(bundle)
+	return bundle, nil
+}
+
+// ListBundles 分页获取已发布的套餐
+func (s *BundleService) ListBundles(page, pageSize int) ([]*model.Bundle, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 50 {
+		pageSize = 12
+	}
+	bundles, total, err := s.bundleRepo.ListPublished(page, pageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+	s.fillCourses(bundles...)
+	return bundles, total, nil
+}
+
+// ListMyBundles 获取讲师创建的全部套餐（含草稿）
+func (s *BundleService) ListMyBundles(userID uint) ([]*model.Bundle, error) {
+	if userID == 0 {
+		return nil, errors.New("用户ID不能为空")
+	}
+	bundles, err := s.bundleRepo.ListByCreator(userID)
+	if err != nil {
+		return nil, err
+	}
+	s.fillCourses(bundles...)
+	return bundles, nil
+}
+
+// EnrollBundle 领取免费套餐，付费套餐需要下单购买
+func (s *BundleService) EnrollBundle(userID, bundleID uint) (*model.BundleEnrollment, error) {
+	log.Printf("🔍 Service: 报名套餐 - 用户ID: %d, 套餐ID: %d", userID, bundleID)
+
+	bundle, err := s.bundleRepo.GetByID(bundleID)
+	if err != nil {
+		return nil, err
+	}
+	if !bundle.IsPublished() {
+		return nil, errors.New("套餐尚未发布")
+	}
+	if !bundle.IsFree() {
+		return nil, errors.New("付费套餐需要购买后才能学习")
+	}
+	return s.grant(userID, bundle)
+}
+
+// GrantBundle 为已付款订单开通套餐中的全部课程（不校验价格），重复调用只补开通
+func (s *BundleService) GrantBundle(userID, bundleID uint) (*model.BundleEnrollment, error) {
+	bundle, err := s.bundleRepo.GetByID(bundleID)
+	if err != nil {
+		return nil, err
+	}
+	return s.grant(userID, bundle)
+}
+
+// RevokeBundle 取消套餐报名及由该套餐开通的课程选课（退款等）
+// 单独购买、免费报名或其他套餐开通的课程不受影响
+func (s *BundleService) RevokeBundle(userID, bundleID uint) error {
+	log.Printf("🔍 Service: 取消套餐报名 - 用户ID: %d, 套餐ID: %d", userID, bundleID)
+
+	bundle, err := s.bundleRepo.GetByID(bundleID)
+	if err != nil {
+		return err
+	}
+	for _, courseID := range bundle.CourseIDs() {
+		if err := s.courseService.RevokeEnrollmentSource(userID, courseID, courseModel.EnrollmentSourceBundle, bundleID); err != nil {
+			return err
+		}
+	}
+
+	enrollment, err := s.bundleRepo.GetEnrollment(userID, bundleID)
+	if err != nil {
+		return err
+	}
+	if enrollment == nil || enrollment.Status != courseModel.EnrollmentStatusActive {
+		return nil
+	}
+	enrollment.Status = courseModel.EnrollmentStatusRevoked
+	return s.bundleRepo.SaveEnrollment(enrollment)
+}
+
+// IsEnrolled 学员是否已报名套餐
+func (s *BundleService) IsEnrolled(userID, bundleID uint) (bool, error) {
+	enrollment, err := s.bundleRepo.GetEnrollment(userID, bundleID)
+	if err != nil {
+		return false, err
+	}
+	return enrollment != nil && enrollment.Status == courseModel.EnrollmentStatusActive, nil
+}
+
+// GetPathProgress 获取学员在套餐各步骤的学习进度
+// 先修课程未学完的步骤标记为锁定，仅作学习顺序提示，不限制课程访问
+func (s *BundleService) GetPathProgress(userID, bundleID uint) (*model.PathProgress, error) {
+	bundle, err := s.GetBundle(bundleID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	progress := &model.PathProgress{
+		BundleID:     bundle.ID,
+		TotalCourses: len(bundle.Courses),
+	}
+	if userID != 0 {
+		if progress.Enrolled, err = s.IsEnrolled(userID, bundle.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	completed := make(map[uint]bool, len(bundle.Courses))
+	for _, item := range bundle.Courses {
+		step := &model.StepProgress{
+			CourseID:              item.CourseID,
+			CourseTitle:           item.CourseTitle,
+			Position:              item.Position,
+			PrerequisiteCourseIDs: item.PrerequisiteCourseIDs,
+		}
+
+		if userID != 0 {
+			courseProgress, err := s.courseService.GetCourseProgress(userID, item.CourseID)
+			if err != nil {
+				return nil, err
+			}
+			step.CompletedChapters = len(courseProgress.CompletedChapterIDs)
+			step.TotalChapters = courseProgress.TotalChapters
+			if courseProgress.TotalChapters > 0 {
+				step.Percent = step.CompletedChapters * 100 / courseProgress.TotalChapters
+			}
+			completed[item.CourseID] = courseProgress.IsCompleted()
+		}
+
+		switch {
+		case completed[item.CourseID]:
+			step.Status = model.StepStatusCompleted
+			progress.CompletedCourses++
+		case !prerequisitesMet(item.PrerequisiteCourseIDs, completed):
+			step.Status = model.StepStatusLocked
+		case step.CompletedChapters > 0:
+			step.Status = model.StepStatusInProgress
+		default:
+			step.Status = model.StepStatusAvailable
+		}
+		progress.Steps = append(progress.Steps, step)
+	}
+
+	if progress.TotalCourses > 0 {
+		progress.Percent = progress.CompletedCourses * 100 / progress.TotalCourses
+	}
+	return progress, nil
+}
+
+// grant 开通套餐中的全部课程并记录套餐报名
+func (s *BundleService) grant(userID uint, bundle *model.Bundle) (*model.BundleEnrollment, error) {
+	if userID == 0 {
+		return nil, errors.New("用户ID不能为空")
+	}
+
+	for _, courseID := range bundle.CourseIDs() {
+		if _, err := s.courseService.GrantEnrollment(userID, courseID, courseModel.EnrollmentSourceBundle, bundle.ID); err != nil {
+			log.Printf("❌ Service: 开通套餐课程失败 - 套餐ID: %d, 课程ID: %d, 错误: %v", bundle.ID, courseID, err)
+			return nil, err
+		}
+	}
+
+	enrollment, err := s.bundleRepo.GetEnrollment(userID, bundle.ID)
+	if err != nil {
+		return nil, err
+	}
+	if enrollment == nil {
+		enrollment = &model.BundleEnrollment{UserID: userID, BundleID: bundle.ID}
+	}
+	if enrollment.Status != courseModel.EnrollmentStatusActive {
+		enrollment.Status = courseModel.EnrollmentStatusActive
+		enrollment.EnrolledAt = time.Now()
+		if err := s.bundleRepo.SaveEnrollment(enrollment); err != nil {
+			return nil, err
+		}
+	}
+
+	log.Printf("✅ Service: 套餐开通成功 - 用户ID: %d, 套餐ID: %d", userID, bundle.ID)
+	return enrollment, nil
+}
+
+// applyRequest 校验请求并写入套餐字段
+func (s *BundleService) applyRequest(bundle *model.Bundle, req *SaveBundleRequest) error {
+	title := strings.TrimSpace(req.Title)
+	if title == "" {
+		return errors.New("套餐标题不能为空")
+	}
+	if len([]rune(title)) > 200 {
+		return errors.New("套餐标题不能超过200个字符")
+	}
+	kind := req.Kind
+	if kind == "" {
+		kind = model.BundleKindBundle
+	}
+	if kind != model.BundleKindBundle && kind != model.BundleKindPath {
+		return errors.New("套餐类型只能是 bundle 或 path")
+	}
+	if req.Price < 0 {
+		return errors.New("套餐价格不能为负数")
+	}
+	if len(req.Steps) < minBundleCourses || len(req.Steps) > maxBundleCourses {
+		return fmt.Errorf("套餐需要包含%d到%d门课程", minBundleCourses, maxBundleCourses)
+	}
+
+	courses := make([]model.BundleCourse, 0, len(req.Steps))
+	seen := make(map[uint]bool, len(req.Steps))
+	for _, step := range req.Steps {
+		if seen[step.CourseID] {
+			return fmt.Errorf("课程 %d 重复出现", step.CourseID)
+		}
+		course, err := s.courseService.GetCourseByID(step.CourseID)
+		if err != nil {
+			return fmt.Errorf("课程 %d 不存在", step.CourseID)
+		}
+		if course.InstructorID != req.UserID {
+			return fmt.Errorf("无权将课程《%s》加入套餐，只能使用自己的课程", course.Title)
+		}
+
+		if len(step.PrerequisiteCourseIDs) > 0 && kind != model.BundleKindPath {
+			return errors.New("只有学习路径可以设置先修课程")
+		}
+		for _, prerequisiteID := range step.PrerequisiteCourseIDs {
+			if !seen[prerequisiteID] {
+				return fmt.Errorf("课程《%s》的先修课程必须是排在它前面的课程", course.Title)
+			}
+		}
+
+		seen[step.CourseID] = true
+		courses = append(courses, model.BundleCourse{
+			CourseID:              step.CourseID,
+			PrerequisiteCourseIDs: step.PrerequisiteCourseIDs,
+		})
+	}
+
+	bundle.Title = title
+	bundle.Description = strings.TrimSpace(req.Description)
+	bundle.CoverImage = strings.TrimSpace(req.CoverImage)
+	bundle.Kind = kind
+	bundle.Price = req.Price
+	bundle.Courses = courses
+	return nil
+}
+
+// fillCourses 填充套餐课程的标题和价格，课程不存在时保留空值
+func (s *BundleService) fillCourses(bundles ...*model.Bundle) {
+	for _, bundle := range bundles {
+		for i := range bundle.Courses {
+			course, err := s.courseService.GetCourseByID(bundle.Courses[i].CourseID)
+			if err != nil {
+				log.Printf("⚠️ Service: 套餐课程不存在 - 套餐ID: %d, 课程ID: %d", bundle.ID, bundle.Courses[i].CourseID)
+				continue
+			}
+			bundle.Courses[i].CourseTitle = course.Title
+			bundle.Courses[i].CoursePrice = course.Price
+		}
+	}
+}
+
+// checkCoursesPublished 检查课程是否都已发布
+func (s *BundleService) checkCoursesPublished(courseIDs []uint) error {
+	for _, courseID := range courseIDs {
+		course, err := s.courseService.GetCourseByID(courseID)
+		if err != nil {
+			return err
+		}
+		if !course.IsPublished() {
+			return fmt.Errorf("课程《%s》尚未发布，无法发布套餐", course.Title)
+		}
+	}
+	return nil
+}
+
+// getOwnedBundle 获取套餐并校验创建者
+func (s *BundleService) getOwnedBundle(bundleID, userID uint) (*model.Bundle, error) {
+	bundle, err := s.bundleRepo.GetByID(bundleID)
+	if err != nil {
+		return nil, err
+	}
+	if bundle.CreatorID != userID {
+		return nil, errors.New("无权修改该套餐")
+	}
+	return bundle, nil
+}
+
+// prerequisitesMet 先修课程是否都已学完
+func prerequisitesMet(prerequisiteIDs []uint, completed map[uint]bool) bool {
+	for _, id := range prerequisiteIDs {
+		if !completed[id] {
+			return false
+		}
+	}
+	return true
+}
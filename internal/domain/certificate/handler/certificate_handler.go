@@ -0,0 +1,284 @@
+package handler
+
+import (
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"time"
+
+	"course-platform/internal/configs"
+	service "course-platform/internal/infrastructure/grpc_client"
+	"course-platform/internal/shared/pb/certificatepb"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CertificateHandler API Gateway的证书处理器
+type CertificateHandler struct {
+	certificateGRPCClient *service.CertificateGRPCClientService
+}
+
+// NewCertificateHandler 创建证书处理器
+func NewCertificateHandler(certificateGRPCClient *service.CertificateGRPCClientService) *CertificateHandler {
+	return &CertificateHandler{
+		certificateGRPCClient: certificateGRPCClient,
+	}
+}
+
+// SaveTemplateRequest 保存证书模板请求结构
+// 正文支持占位符 {{student}} {{course}} {{instructor}} {{date}}
+type SaveTemplateRequest struct {
+	Heading     string `json:"heading"`
+	Body        string `json:"body"`
+	Footer      string `json:"footer"`
+	AccentColor string `json:"accent_color"`
+	Layout      string `json:"layout"`
+}
+
+// CertificatePage 证书公开验证页面
+func (h *CertificateHandler) CertificatePage(c *gin.Context) {
+	code := c.Param("code")
+	log.Printf("📜 渲染证书验证页面 - 验证码: %s", code)
+
+	resp, err := h.certificateGRPCClient.GetCertificate(c.Request.Context(), code)
+	if err != nil {
+		c.HTML(http.StatusInternalServerError, "certificate.html", gin.H{
+			"SiteName": "Course Platform",
+			"Code":     code,
+			"Error":    "证书服务暂时不可用，请稍后重试",
+		})
+		return
+	}
+	if resp.Code != 200 {
+		c.HTML(http.StatusNotFound, "certificate.html", gin.H{
+			"SiteName": "Course Platform",
+			"Code":     code,
+			"Error":    "未找到该证书，请核对验证码",
+		})
+		return
+	}
+
+	issuedAt := resp.Certificate.IssuedAt
+	if t, err := time.Parse(time.RFC3339, issuedAt); err == nil {
+		issuedAt = t.Format("2006年01月02日")
+	}
+
+	exposeCertificateURL(resp.Certificate)
+	c.HTML(http.StatusOK, "certificate.html", gin.H{
+		"SiteName":    "Course Platform",
+		"Code":        resp.Certificate.Code,
+		"Certificate": resp.Certificate,
+		"IssuedAt":    issuedAt,
+	})
+}
+
+// GetCertificate 根据验证码查询证书
+// @Summary 验证证书
+// @Description 根据验证码查询证书信息，无需登录
+// @Tags 证书管理
+// @Produce json
+// @Param code path string true "证书验证码"
+// @Success 200 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Router /api/v1/certificates/{code} [get]
+func (h *CertificateHandler) GetCertificate(c *gin.Context) {
+	resp, err := h.certificateGRPCClient.GetCertificate(c.Request.Context(), c.Param("code"))
+	if err != nil {
+		respondGRPCError(c, "获取证书失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	exposeCertificateURL(resp.Certificate)
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data":    resp.Certificate,
+	})
+}
+
+// DownloadCertificatePDF 按验证码获取证书PDF，与验证页面一样无需登录
+func (h *CertificateHandler) DownloadCertificatePDF(c *gin.Context) {
+	resp, err := h.certificateGRPCClient.GetCertificate(c.Request.Context(), c.Param("code"))
+	if err != nil {
+		respondGRPCError(c, "获取证书失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	// 证书PDF保存在私有文件中，由网关从上传目录读取，不暴露静态地址
+	filePath, err := configs.GetStaticPathConfig().UploadPath(resp.Certificate.FileUrl)
+	if err == nil {
+		_, err = os.Stat(filePath)
+	}
+	if err != nil {
+		log.Printf("❌ 读取证书PDF失败: %v", err)
+		respondBusinessError(c, 404, "证书PDF不存在")
+		return
+	}
+	c.File(filePath)
+}
+
+// ListMyCertificates 获取本人的全部证书
+// @Summary 我的证书
+// @Description 获取当前用户获得的全部结业证书
+// @Tags 证书管理
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Success 200 {object} map[string]interface{}
+// @Router /api/v1/certificates [get]
+func (h *CertificateHandler) ListMyCertificates(c *gin.Context) {
+	resp, err := h.certificateGRPCClient.ListMyCertificates(c.Request.Context(), c.GetUint("userID"))
+	if err != nil {
+		respondGRPCError(c, "获取证书列表失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	for _, cert := range resp.Certificates {
+		exposeCertificateURL(cert)
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data":    resp.Certificates,
+	})
+}
+
+// exposeCertificateURL 将证书PDF地址替换为按验证码下载的地址
+func exposeCertificateURL(cert *certificatepb.Certificate) {
+	if cert != nil && cert.FileUrl != "" {
+		cert.FileUrl = "/certificates/" + url.PathEscape(cert.Code) + "/pdf"
+	}
+}
+
+// GetTemplate 获取课程证书模板
+// @Summary 获取证书模板
+// @Description 获取课程的证书模板，未配置时返回默认模板
+// @Tags 证书管理
+// @Produce json
+// @Param id path int true "课程ID"
+// @Success 200 {object} map[string]interface{}
+// @Router /api/v1/courses/{id}/certificate-template [get]
+func (h *CertificateHandler) GetTemplate(c *gin.Context) {
+	courseID, ok := parseIDParam(c, "id", "课程ID参数无效")
+	if !ok {
+		return
+	}
+
+	resp, err := h.certificateGRPCClient.GetCertificateTemplate(c.Request.Context(), courseID)
+	if err != nil {
+		respondGRPCError(c, "获取证书模板失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data":    resp.Template,
+	})
+}
+
+// SaveTemplate 保存课程证书模板
+// @Summary 保存证书模板
+// @Description 课程讲师设置证书标题、正文、落款、主题色和版式（classic/modern），只影响之后生成的证书
+// @Tags 证书管理
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path int true "课程ID"
+// @Param template body SaveTemplateRequest true "证书模板"
+// @Success 200 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Router /api/v1/courses/{id}/certificate-template [put]
+func (h *CertificateHandler) SaveTemplate(c *gin.Context) {
+	courseID, ok := parseIDParam(c, "id", "课程ID参数无效")
+	if !ok {
+		return
+	}
+
+	var req SaveTemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    400,
+			"message": "请求参数无效: " + err.Error(),
+		})
+		return
+	}
+
+	resp, err := h.certificateGRPCClient.SaveCertificateTemplate(c.Request.Context(), &certificatepb.SaveCertificateTemplateRequest{
+		CourseId:    uint32(courseID),
+		UserId:      uint32(c.GetUint("userID")),
+		Heading:     req.Heading,
+		Body:        req.Body,
+		Footer:      req.Footer,
+		AccentColor: req.AccentColor,
+		Layout:      req.Layout,
+	})
+	if err != nil {
+		respondGRPCError(c, "保存证书模板失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data":    resp.Template,
+	})
+}
+
+// parseIDParam 解析路径中的ID参数，失败时直接返回400
+func parseIDParam(c *gin.Context, name, message string) (uint, bool) {
+	id, err := strconv.ParseUint(c.Param(name), 10, 32)
+	if err != nil || id == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    400,
+			"message": message,
+		})
+		return 0, false
+	}
+	return uint(id), true
+}
+
+// respondGRPCError 返回调用微服务失败的响应
+func respondGRPCError(c *gin.Context, action string, err error) {
+	log.Printf("❌ API: %s - %v", action, err)
+	c.JSON(http.StatusInternalServerError, gin.H{
+		"code":    500,
+		"message": action + ": " + err.Error(),
+	})
+}
+
+// respondBusinessError 按业务码返回对应HTTP状态
+func respondBusinessError(c *gin.Context, code int32, message string) {
+	status := http.StatusBadRequest
+	switch code {
+	case 403:
+		status = http.StatusForbidden
+	case 404:
+		status = http.StatusNotFound
+	}
+	c.JSON(status, gin.H{
+		"code":    code,
+		"message": message,
+	})
+}
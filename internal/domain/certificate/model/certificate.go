@@ -0,0 +1,66 @@
+package model
+
+import (
+	"time"
+)
+
+// 证书版式
+const (
+	LayoutClassic = "classic" // 双线边框，居中排版
+	LayoutModern  = "modern"  // 左侧色条，左对齐排版
+)
+
+// Certificate 课程结业证书
+// 颁发时记录学员、课程和讲师名称的快照，之后改名不影响已颁发的证书
+type Certificate struct {
+	ID        uint      `gorm:"primarykey" json:"id"` // 主键ID
+	CreatedAt time.Time `json:"created_at"`           // 创建时间
+	UpdatedAt time.Time `json:"updated_at"`           // 更新时间
+
+	Code           string    `gorm:"uniqueIndex;not null;size:20" json:"code"`                          // 验证码
+	UserID         uint      `gorm:"not null;uniqueIndex:idx_certificate_user_course" json:"user_id"`   // 学员ID
+	CourseID       uint      `gorm:"not null;uniqueIndex:idx_certificate_user_course" json:"course_id"` // 课程ID
+	StudentName    string    `gorm:"not null;size:100" json:"student_name"`                             // 学员姓名
+	CourseTitle    string    `gorm:"not null;size:200" json:"course_title"`                             // 课程标题
+	InstructorName string    `gorm:"not null;size:100" json:"instructor_name"`                          // 讲师姓名
+	IssuedAt       time.Time `gorm:"not null" json:"issued_at"`                                         // 颁发时间
+	FileID         uint      `gorm:"not null;default:0" json:"file_id"`                                 // PDF文件ID（内容服务）
+	FileURL        string    `gorm:"size:500" json:"file_url"`                                          // PDF文件URL
+}
+
+// TableName 指定表名
+func (Certificate) TableName() string {
+	return "certificates"
+}
+
+// CertificateTemplate 课程证书模板
+// Body 支持占位符 {{student}} {{course}} {{instructor}} {{date}}
+type CertificateTemplate struct {
+	ID        uint      `gorm:"primarykey" json:"id"` // 主键ID
+	CreatedAt time.Time `json:"created_at"`           // 创建时间
+	UpdatedAt time.Time `json:"updated_at"`           // 更新时间
+
+	CourseID    uint   `gorm:"uniqueIndex;not null" json:"course_id"`            // 课程ID
+	Heading     string `gorm:"not null;size:100" json:"heading"`                 // 证书标题
+	Body        string `gorm:"type:text" json:"body"`                            // 证书正文
+	Footer      string `gorm:"size:200" json:"footer"`                           // 落款
+	AccentColor string `gorm:"size:7;not null" json:"accent_color"`              // 主题色 #RRGGBB
+	Layout      string `gorm:"size:20;not null;default:'classic'" json:"layout"` // 版式
+}
+
+// TableName 指定表名
+func (CertificateTemplate) TableName() string {
+	return "certificate_templates"
+}
+
+// DefaultTemplate 未配置模板时使用的默认模板
+func DefaultTemplate(courseID uint) *CertificateTemplate {
+	return &CertificateTemplate{
+		CourseID:    courseID,
+		Heading:     "结业证书",
+		Body:        "兹证明 {{student}} 已完成课程《{{course}}》的全部学习内容，成绩合格，特发此证。",
+		Footer:      "Course Platform",
+		AccentColor: "#1E3A8A",
+		Layout:      LayoutClassic,
+	}
+}
@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"course-platform/internal/domain/certificate/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// CertificateRepositoryInterface 证书仓储接口
+type CertificateRepositoryInterface interface {
+	Create(certificate *model.Certificate) error
+	GetByCode(code string) (*model.Certificate, error)
+	GetByUserAndCourse(userID, courseID uint) (*model.Certificate, error)
+	ListByUser(userID uint) ([]*model.Certificate, error)
+	UpdateFile(id, fileID uint, fileURL string) error
+	GetTemplate(courseID uint) (*model.CertificateTemplate, error)
+	SaveTemplate(template *model.CertificateTemplate) error
+}
+
+// CertificateRepository 证书仓储实现
+type CertificateRepository struct {
+	db *gorm.DB
+}
+
+// NewCertificateRepository 创建证书仓储实例
+func NewCertificateRepository(db *gorm.DB) CertificateRepositoryInterface {
+	return &CertificateRepository{db: db}
+}
+
+// Create 创建证书记录
+func (r *CertificateRepository) Create(certificate *model.Certificate) error {
+	if err := r.db.Create(certificate).Error; err != nil {
+		log.Printf("❌ Repository: 创建证书失败 - %v", err)
+		return fmt.Errorf("创建证书失败: %w", err)
+	}
+
+	log.Printf("✅ Repository: 证书创建成功 - 验证码: %s", certificate.Code)
+	return nil
+}
+
+// GetByCode 根据验证码获取证书
+func (r *CertificateRepository) GetByCode(code string) (*model.Certificate, error) {
+	var certificate model.Certificate
+	if err := r.db.Where("code = ?", code).First(&certificate).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("证书不存在")
+		}
+		return nil, fmt.Errorf("查询证书失败: %w", err)
+	}
+	return &certificate, nil
+}
+
+// GetByUserAndCourse 获取学员在课程中的证书，不存在时返回nil
+func (r *CertificateRepository) GetByUserAndCourse(userID, courseID uint) (*model.Certificate, error) {
+	var certificate model.Certificate
+	err := r.db.Where("user_id = ? AND course_id = ?", userID, courseID).First(&certificate).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("查询证书失败: %w", err)
+	}
+	return &certificate, nil
+}
+
+// ListByUser 获取学员的全部证书
+func (r *CertificateRepository) ListByUser(userID uint) ([]*model.Certificate, error) {
+	var certificates []*model.Certificate
+	if err := r.db.Where("user_id = ?", userID).Order("issued_at DESC").Find(&certificates).Error; err != nil {
+		log.Printf("❌ Repository: 查询证书列表失败 - %v", err)
+		return nil, fmt.Errorf("查询证书列表失败: %w", err)
+	}
+	return certificates, nil
+}
+
+// UpdateFile 更新证书PDF文件信息
+func (r *CertificateRepository) UpdateFile(id, fileID uint, fileURL string) error {
+	err := r.db.Model(&model.Certificate{}).Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"file_id":  fileID,
+			"file_url": fileURL,
+		}).Error
+	if err != nil {
+		return fmt.Errorf("更新证书文件失败: %w", err)
+	}
+	return nil
+}
+
+// GetTemplate 获取课程证书模板，未配置时返回nil
+func (r *CertificateRepository) GetTemplate(courseID uint) (*model.CertificateTemplate, error) {
+	var template model.CertificateTemplate
+	err := r.db.Where("course_id = ?", courseID).First(&template).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("查询证书模板失败: %w", err)
+	}
+	return &template, nil
+}
+
+// SaveTemplate 保存课程证书模板（每门课程一份）
+func (r *CertificateRepository) SaveTemplate(template *model.CertificateTemplate) error {
+	err := r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "course_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"heading", "body", "footer", "accent_color", "layout", "updated_at"}),
+	}).Create(template).Error
+	if err != nil {
+		log.Printf("❌ Repository: 保存证书模板失败 - %v", err)
+		return fmt.Errorf("保存证书模板失败: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,284 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"course-platform/internal/domain/certificate/model"
+	"course-platform/internal/domain/certificate/repository"
+	courseService "course-platform/internal/domain/course/service"
+	userModel "course-platform/internal/domain/user/model"
+	userRepository "course-platform/internal/domain/user/repository"
+	"course-platform/internal/infrastructure/pdf"
+)
+
+// 验证码字符集，去掉了容易混淆的 0/O、1/I
+const codeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+// CertificateServiceInterface 证书服务接口
+type CertificateServiceInterface interface {
+	IssueCertificate(ctx context.Context, userID, courseID uint) (*model.Certificate, error)
+	GetCertificate(ctx context.Context, code string) (*model.Certificate, error)
+	ListUserCertificates(userID uint) ([]*model.Certificate, error)
+	GetTemplate(courseID uint) (*model.CertificateTemplate, error)
+	SaveTemplate(userID uint, template *model.CertificateTemplate) (*model.CertificateTemplate, error)
+}
+
+// CertificateService 证书服务实现
+type CertificateService struct {
+	certificateRepo repository.CertificateRepositoryInterface
+	courseService   courseService.CourseServiceInterface
+	userRepo        userRepository.UserRepositoryInterface
+	storage         CertificateStorage
+	verifyURLFormat string
+}
+
+// NewCertificateService 创建证书服务实例
+// verifyURLFormat 为证书验证页地址格式，如 http://localhost:8083/certificates/%s
+func NewCertificateService(certificateRepo repository.CertificateRepositoryInterface, courseService courseService.CourseServiceInterface, userRepo userRepository.UserRepositoryInterface, storage CertificateStorage, verifyURLFormat string) CertificateServiceInterface {
+	return &CertificateService{
+		certificateRepo: certificateRepo,
+		courseService:   courseService,
+		userRepo:        userRepo,
+		storage:         storage,
+		verifyURLFormat: verifyURLFormat,
+	}
+}
+
+// IssueCertificate 为学完全部章节的学员颁发证书，已颁发时直接返回原证书
+func (s *CertificateService) IssueCertificate(ctx context.Context, userID, courseID uint) (*model.Certificate, error) {
+	log.Printf("🔍 Service: 颁发证书 - 用户ID: %d, 课程ID: %d", userID, courseID)
+
+	existing, err := s.certificateRepo.GetByUserAndCourse(userID, courseID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return existing, nil
+	}
+
+	progress, err := s.courseService.GetCourseProgress(userID, courseID)
+	if err != nil {
+		return nil, err
+	}
+	if !progress.IsCompleted() {
+		return nil, errors.New("尚未完成全部课程内容")
+	}
+
+	course, err := s.courseService.GetCourseByID(courseID)
+	if err != nil {
+		return nil, err
+	}
+	student, err := s.userRepo.GetByID(userID)
+	if err != nil {
+		return nil, err
+	}
+	instructor, err := s.userRepo.GetByID(course.InstructorID)
+	if err != nil {
+		return nil, err
+	}
+
+	certificate := &model.Certificate{
+		UserID:         userID,
+		CourseID:       courseID,
+		StudentName:    displayName(student),
+		CourseTitle:    course.Title,
+		InstructorName: displayName(instructor),
+		IssuedAt:       time.Now(),
+	}
+
+	// 验证码冲突时重新生成
+	for attempt := 0; ; attempt++ {
+		code, err := generateCode()
+		if err != nil {
+			return nil, err
+		}
+		certificate.Code = code
+		if err = s.certificateRepo.Create(certificate); err == nil {
+			break
+		}
+
+		// 并发完成时可能已由另一个请求颁发
+		if existing, _ := s.certificateRepo.GetByUserAndCourse(userID, courseID); existing != nil {
+			return existing, nil
+		}
+		if attempt >= 2 {
+			return nil, err
+		}
+	}
+
+	// PDF生成失败不影响证书有效性，查看证书时会重新生成
+	if err := s.generatePDF(ctx, certificate); err != nil {
+		log.Printf("⚠️ Service: 证书PDF生成失败 - 验证码: %s, 错误: %v", certificate.Code, err)
+	}
+
+	log.Printf("✅ Service: 证书颁发成功 - 验证码: %s", certificate.Code)
+	return certificate, nil
+}
+
+// GetCertificate 根据验证码获取证书
+func (s *CertificateService) GetCertificate(ctx context.Context, code string) (*model.Certificate, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if code == "" {
+		return nil, errors.New("验证码不能为空")
+	}
+
+	certificate, err := s.certificateRepo.GetByCode(code)
+	if err != nil {
+		return nil, err
+	}
+
+	if certificate.FileURL == "" {
+		if err := s.generatePDF(ctx, certificate); err != nil {
+			log.Printf("⚠️ Service: 证书PDF补生成失败 - 验证码: %s, 错误: %v", certificate.Code, err)
+		}
+	}
+	return certificate, nil
+}
+
+// ListUserCertificates 获取学员的全部证书
+func (s *CertificateService) ListUserCertificates(userID uint) ([]*model.Certificate, error) {
+	if userID == 0 {
+		return nil, errors.New("用户ID不能为空")
+	}
+	return s.certificateRepo.ListByUser(userID)
+}
+
+// GetTemplate 获取课程证书模板，未配置时返回默认模板
+func (s *CertificateService) GetTemplate(courseID uint) (*model.CertificateTemplate, error) {
+	if courseID == 0 {
+		return nil, errors.New("课程ID不能为空")
+	}
+
+	template, err := s.certificateRepo.GetTemplate(courseID)
+	if err != nil {
+		return nil, err
+	}
+	if template == nil {
+		template = model.DefaultTemplate(courseID)
+	}
+	return template, nil
+}
+
+// SaveTemplate 保存课程证书模板（仅课程讲师），只影响之后生成的证书文件
+func (s *CertificateService) SaveTemplate(userID uint, template *model.CertificateTemplate) (*model.CertificateTemplate, error) {
+	log.Printf("🔍 Service: 保存证书模板 - 课程ID: %d", template.CourseID)
+
+	course, err := s.courseService.GetCourseByID(template.CourseID)
+	if err != nil {
+		return nil, err
+	}
+	if userID == 0 || course.InstructorID != userID {
+		return nil, errors.New("只有课程讲师可以设置证书模板")
+	}
+
+	defaults := model.DefaultTemplate(template.CourseID)
+	template.Heading = strings.TrimSpace(template.Heading)
+	if template.Heading == "" {
+		template.Heading = defaults.Heading
+	}
+	if strings.TrimSpace(template.Body) == "" {
+		template.Body = defaults.Body
+	}
+	if template.AccentColor == "" {
+		template.AccentColor = defaults.AccentColor
+	}
+	if template.Layout == "" {
+		template.Layout = defaults.Layout
+	}
+	if err := validateTemplate(template); err != nil {
+		return nil, err
+	}
+
+	if err := s.certificateRepo.SaveTemplate(template); err != nil {
+		return nil, err
+	}
+	return s.certificateRepo.GetTemplate(template.CourseID)
+}
+
+// generatePDF 按课程模板生成证书PDF并保存到内容服务
+func (s *CertificateService) generatePDF(ctx context.Context, certificate *model.Certificate) error {
+	template, err := s.GetTemplate(certificate.CourseID)
+	if err != nil {
+		return err
+	}
+
+	data, err := renderCertificate(certificate, template, s.verifyURL(certificate.Code))
+	if err != nil {
+		return err
+	}
+
+	fileName := fmt.Sprintf("certificate-%s.pdf", certificate.Code)
+	fileID, fileURL, err := s.storage.Store(ctx, fileName, data, certificate.CourseID, certificate.UserID)
+	if err != nil {
+		return err
+	}
+	if err := s.certificateRepo.UpdateFile(certificate.ID, fileID, fileURL); err != nil {
+		return err
+	}
+
+	certificate.FileID = fileID
+	certificate.FileURL = fileURL
+	return nil
+}
+
+// verifyURL 证书公开验证页地址
+func (s *CertificateService) verifyURL(code string) string {
+	if s.verifyURLFormat == "" {
+		return ""
+	}
+	return fmt.Sprintf(s.verifyURLFormat, code)
+}
+
+// validateTemplate 校验模板内容
+func validateTemplate(template *model.CertificateTemplate) error {
+	if utf8.RuneCountInString(template.Heading) > 30 {
+		return errors.New("证书标题不能超过30个字符")
+	}
+	if utf8.RuneCountInString(template.Body) > 300 {
+		return errors.New("证书正文不能超过300个字符")
+	}
+	if utf8.RuneCountInString(template.Footer) > 60 {
+		return errors.New("落款不能超过60个字符")
+	}
+	if _, err := pdf.ParseHexColor(template.AccentColor); err != nil {
+		return errors.New("主题色格式应为 #RRGGBB")
+	}
+	if template.Layout != model.LayoutClassic && template.Layout != model.LayoutModern {
+		return fmt.Errorf("不支持的证书版式: %s", template.Layout)
+	}
+	return nil
+}
+
+// generateCode 生成 XXXX-XXXX-XXXX 格式的随机验证码
+func generateCode() (string, error) {
+	var b strings.Builder
+	max := big.NewInt(int64(len(codeAlphabet)))
+	for i := 0; i < 12; i++ {
+		if i > 0 && i%4 == 0 {
+			b.WriteByte('-')
+		}
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", fmt.Errorf("生成验证码失败: %w", err)
+		}
+		b.WriteByte(codeAlphabet[n.Int64()])
+	}
+	return b.String(), nil
+}
+
+// displayName 证书上显示的姓名，未设置昵称时使用用户名
+func displayName(user *userModel.User) string {
+	name := strings.TrimSpace(user.Nickname)
+	if name == "" || name == "新用户" {
+		return user.Username
+	}
+	return name
+}
@@ -0,0 +1,113 @@
+package service
+
+import (
+	"strings"
+
+	"course-platform/internal/domain/certificate/model"
+	"course-platform/internal/infrastructure/pdf"
+)
+
+// 证书配色
+var (
+	inkColor   = pdf.Color{R: 0.13, G: 0.13, B: 0.13}
+	mutedColor = pdf.Color{R: 0.45, G: 0.45, B: 0.45}
+)
+
+// renderCertificate 按模板生成证书PDF（A4横向）
+func renderCertificate(cert *model.Certificate, tmpl *model.CertificateTemplate, verifyURL string) ([]byte, error) {
+	accent, err := pdf.ParseHexColor(tmpl.AccentColor)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := pdf.New(pdf.A4Height, pdf.A4Width)
+	doc.SetTitle(tmpl.Heading + " - " + cert.CourseTitle)
+
+	body := fillPlaceholders(tmpl.Body, cert)
+	date := cert.IssuedAt.Format("2006年01月02日")
+
+	switch tmpl.Layout {
+	case model.LayoutModern:
+		renderModern(doc, accent, tmpl, cert, body, date)
+	default:
+		renderClassic(doc, accent, tmpl, cert, body, date)
+	}
+
+	footer := "证书编号：" + cert.Code
+	if verifyURL != "" {
+		footer += "    验证地址：" + verifyURL
+	}
+	doc.TextCentered(doc.Width()/2, 28, 9, mutedColor, footer)
+
+	return doc.Bytes()
+}
+
+// renderClassic 经典版式：双线边框，内容居中
+func renderClassic(doc *pdf.Document, accent pdf.Color, tmpl *model.CertificateTemplate, cert *model.Certificate, body, date string) {
+	w, h := doc.Width(), doc.Height()
+	cx := w / 2
+
+	doc.StrokeRect(20, 45, w-40, h-65, 4, accent)
+	doc.StrokeRect(30, 55, w-60, h-85, 1, accent)
+
+	doc.TextCentered(cx, h-120, 40, accent, tmpl.Heading)
+	doc.Line(cx-120, h-138, cx+120, h-138, 1.5, accent)
+
+	doc.TextCentered(cx, h-200, 30, inkColor, cert.StudentName)
+
+	y := h - 250.0
+	for _, line := range pdf.WrapText(body, 16, w-220) {
+		doc.TextCentered(cx, y, 16, inkColor, line)
+		y -= 26
+	}
+
+	renderSignatures(doc, 140, w-140, 120, cert.InstructorName, date)
+	if tmpl.Footer != "" {
+		doc.TextCentered(cx, 78, 12, accent, tmpl.Footer)
+	}
+}
+
+// renderModern 现代版式：左侧色条，内容左对齐
+func renderModern(doc *pdf.Document, accent pdf.Color, tmpl *model.CertificateTemplate, cert *model.Certificate, body, date string) {
+	w, h := doc.Width(), doc.Height()
+	left := 120.0
+
+	doc.FillRect(0, 0, 70, h, accent)
+	doc.FillRect(70, h-8, w-70, 8, accent)
+
+	doc.Text(left, h-110, 36, accent, tmpl.Heading)
+	doc.Text(left, h-180, 28, inkColor, cert.StudentName)
+	doc.Line(left, h-192, left+pdf.TextWidth(cert.StudentName, 28)+40, h-192, 1, accent)
+
+	y := h - 240.0
+	for _, line := range pdf.WrapText(body, 15, w-left-80) {
+		doc.Text(left, y, 15, inkColor, line)
+		y -= 24
+	}
+
+	renderSignatures(doc, left+100, w-180, 120, cert.InstructorName, date)
+	if tmpl.Footer != "" {
+		doc.Text(left, 78, 12, accent, tmpl.Footer)
+	}
+}
+
+// renderSignatures 绘制讲师签名和颁发日期
+func renderSignatures(doc *pdf.Document, leftX, rightX, y float64, instructor, date string) {
+	doc.Line(leftX-80, y, leftX+80, y, 0.8, mutedColor)
+	doc.TextCentered(leftX, y+8, 14, inkColor, instructor)
+	doc.TextCentered(leftX, y-18, 10, mutedColor, "授课讲师")
+
+	doc.Line(rightX-80, y, rightX+80, y, 0.8, mutedColor)
+	doc.TextCentered(rightX, y+8, 14, inkColor, date)
+	doc.TextCentered(rightX, y-18, 10, mutedColor, "颁发日期")
+}
+
+// fillPlaceholders 替换模板占位符
+func fillPlaceholders(text string, cert *model.Certificate) string {
+	return strings.NewReplacer(
+		"{{student}}", cert.StudentName,
+		"{{course}}", cert.CourseTitle,
+		"{{instructor}}", cert.InstructorName,
+		"{{date}}", cert.IssuedAt.Format("2006年01月02日"),
+	).Replace(text)
+}
@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	contentModel "course-platform/internal/domain/content/model"
+	grpcClient "course-platform/internal/infrastructure/grpc_client"
+	"course-platform/internal/shared/pb/contentpb"
+)
+
+// CertificateStorage 证书文件存储
+type CertificateStorage interface {
+	Store(ctx context.Context, fileName string, data []byte, courseID, userID uint) (fileID uint, fileURL string, err error)
+}
+
+// contentStorage 将证书PDF保存到内容服务
+type contentStorage struct {
+	client *grpcClient.ContentGRPCClientService
+}
+
+// NewContentStorage 创建基于内容服务的证书存储
+func NewContentStorage(client *grpcClient.ContentGRPCClientService) CertificateStorage {
+	return &contentStorage{client: client}
+}
+
+// Store 上传证书PDF，返回文件ID和访问URL
+func (s *contentStorage) Store(ctx context.Context, fileName string, data []byte, courseID, userID uint) (uint, string, error) {
+	resp, err := s.client.UploadFile(ctx, &contentpb.UploadFileRequest{
+		FileName:   fileName,
+		FileData:   data,
+		FileType:   contentModel.FileTypeCertificate,
+		CourseId:   uint32(courseID),
+		UploaderId: uint32(userID),
+	})
+	if err != nil {
+		return 0, "", err
+	}
+	if resp.Code != 200 || resp.FileInfo == nil {
+		return 0, "", errors.New(resp.Message)
+	}
+
+	fileID, err := strconv.ParseUint(resp.FileInfo.FileId, 10, 32)
+	if err != nil {
+		return 0, "", fmt.Errorf("文件ID格式错误: %s", resp.FileInfo.FileId)
+	}
+	return uint(fileID), resp.FileInfo.FileUrl, nil
+}
@@ -0,0 +1,679 @@
+package handler
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	service "course-platform/internal/infrastructure/grpc_client"
+	"course-platform/internal/shared/pb/cohortpb"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CohortHandler API Gateway的班期与直播处理器
+type CohortHandler struct {
+	cohortGRPCClient *service.CohortGRPCClientService
+}
+
+// NewCohortHandler 创建班期处理器
+func NewCohortHandler(cohortGRPCClient *service.CohortGRPCClientService) *CohortHandler {
+	return &CohortHandler{
+		cohortGRPCClient: cohortGRPCClient,
+	}
+}
+
+// SaveCohortRequest 开设或更新班期请求结构
+// 时间可以是RFC3339格式，也可以是 timezone 中的当地时间（2006-01-02 15:04）
+type SaveCohortRequest struct {
+	Name      string `json:"name" binding:"required"`
+	StartDate string `json:"start_date" binding:"required"`
+	EndDate   string `json:"end_date"`
+	Capacity  int32  `json:"capacity" binding:"required"`
+	Timezone  string `json:"timezone"`
+}
+
+// SaveLiveSessionRequest 安排或更新直播请求结构，timezone 为空时使用班期时区
+type SaveLiveSessionRequest struct {
+	Title           string `json:"title" binding:"required"`
+	Description     string `json:"description"`
+	StartsAt        string `json:"starts_at" binding:"required"`
+	DurationMinutes int32  `json:"duration_minutes"`
+	Timezone        string `json:"timezone"`
+	JoinURL         string `json:"join_url"`
+}
+
+// ListCohorts 获取课程的班期列表
+// @Summary 班期列表
+// @Description 获取课程开设的班期，包含已报名人数、候补人数和当前用户的报名状态
+// @Tags 班期
+// @Produce json
+// @Param id path int true "课程ID"
+// @Success 200 {object} map[string]interface{}
+// @Router /api/v1/courses/{id}/cohorts [get]
+func (h *CohortHandler) ListCohorts(c *gin.Context) {
+	courseID, ok := parseIDParam(c, "id", "课程ID参数无效")
+	if !ok {
+		return
+	}
+
+	resp, err := h.cohortGRPCClient.ListCohorts(c.Request.Context(), courseID, c.GetUint("userID"))
+	if err != nil {
+		respondGRPCError(c, "获取班期列表失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	cohorts := make([]gin.H, 0, len(resp.Cohorts))
+	for _, cohort := range resp.Cohorts {
+		cohorts = append(cohorts, convertCohortToDisplay(cohort))
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data":    cohorts,
+	})
+}
+
+// GetCohort 获取班期详情
+// @Summary 班期详情
+// @Description 获取班期信息和直播安排，入会链接仅班期正式成员和讲师可见
+// @Tags 班期
+// @Produce json
+// @Param id path int true "班期ID"
+// @Success 200 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Router /api/v1/cohorts/{id} [get]
+func (h *CohortHandler) GetCohort(c *gin.Context) {
+	cohortID, ok := parseIDParam(c, "id", "班期ID参数无效")
+	if !ok {
+		return
+	}
+
+	resp, err := h.cohortGRPCClient.GetCohort(c.Request.Context(), cohortID, c.GetUint("userID"))
+	if err != nil {
+		respondGRPCError(c, "获取班期失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	sessions := make([]gin.H, 0, len(resp.Sessions))
+	for _, session := range resp.Sessions {
+		sessions = append(sessions, convertLiveSessionToDisplay(session))
+	}
+
+	data := convertCohortToDisplay(resp.Cohort)
+	data["sessions"] = sessions
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data":    data,
+	})
+}
+
+// CreateCohort 开设班期
+// @Summary 开设班期
+// @Description 讲师为自己的课程开设班期，设置开班日期、名额和时区
+// @Tags 班期
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path int true "课程ID"
+// @Param cohort body SaveCohortRequest true "班期信息"
+// @Success 200 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Router /api/v1/courses/{id}/cohorts [post]
+func (h *CohortHandler) CreateCohort(c *gin.Context) {
+	courseID, ok := parseIDParam(c, "id", "课程ID参数无效")
+	if !ok {
+		return
+	}
+
+	var req SaveCohortRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    400,
+			"message": "请求参数无效: " + err.Error(),
+		})
+		return
+	}
+
+	resp, err := h.cohortGRPCClient.CreateCohort(c.Request.Context(), &cohortpb.CreateCohortRequest{
+		CourseId:  uint32(courseID),
+		UserId:    uint32(c.GetUint("userID")),
+		Name:      req.Name,
+		StartDate: req.StartDate,
+		EndDate:   req.EndDate,
+		Capacity:  req.Capacity,
+		Timezone:  req.Timezone,
+	})
+	if err != nil {
+		respondGRPCError(c, "开设班期失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data":    convertCohortToDisplay(resp.Cohort),
+	})
+}
+
+// UpdateCohort 更新班期
+// @Summary 更新班期
+// @Description 更新班期信息，增加名额时按候补顺序自动递补
+// @Tags 班期
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path int true "班期ID"
+// @Param cohort body SaveCohortRequest true "班期信息"
+// @Success 200 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Router /api/v1/cohorts/{id} [put]
+func (h *CohortHandler) UpdateCohort(c *gin.Context) {
+	cohortID, ok := parseIDParam(c, "id", "班期ID参数无效")
+	if !ok {
+		return
+	}
+
+	var req SaveCohortRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    400,
+			"message": "请求参数无效: " + err.Error(),
+		})
+		return
+	}
+
+	resp, err := h.cohortGRPCClient.UpdateCohort(c.Request.Context(), &cohortpb.UpdateCohortRequest{
+		CohortId:  uint32(cohortID),
+		UserId:    uint32(c.GetUint("userID")),
+		Name:      req.Name,
+		StartDate: req.StartDate,
+		EndDate:   req.EndDate,
+		Capacity:  req.Capacity,
+		Timezone:  req.Timezone,
+	})
+	if err != nil {
+		respondGRPCError(c, "更新班期失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data":    convertCohortToDisplay(resp.Cohort),
+	})
+}
+
+// EnrollCohort 报名班期
+// @Summary 报名班期
+// @Description 报名课程的指定班期，名额已满时进入候补；免费课程会同时报名课程，付费课程需先购买
+// @Tags 班期
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path int true "班期ID"
+// @Success 200 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Router /api/v1/cohorts/{id}/enroll [post]
+func (h *CohortHandler) EnrollCohort(c *gin.Context) {
+	cohortID, ok := parseIDParam(c, "id", "班期ID参数无效")
+	if !ok {
+		return
+	}
+
+	resp, err := h.cohortGRPCClient.EnrollCohort(c.Request.Context(), cohortID, c.GetUint("userID"))
+	if err != nil {
+		respondGRPCError(c, "报名班期失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		// 先修要求未满足时返回缺少的课程和完成度
+		if len(resp.MissingPrerequisites) > 0 {
+			missing := make([]gin.H, 0, len(resp.MissingPrerequisites))
+			for _, m := range resp.MissingPrerequisites {
+				missing = append(missing, gin.H{
+					"course_id":            m.CourseId,
+					"course_title":         m.CourseTitle,
+					"min_progress_percent": m.MinProgressPercent,
+					"current_percent":      m.CurrentPercent,
+					"enrolled":             m.Enrolled,
+				})
+			}
+			c.JSON(http.StatusForbidden, gin.H{
+				"code":    resp.Code,
+				"message": resp.Message,
+				"data": gin.H{
+					"missing_prerequisites": missing,
+				},
+			})
+			return
+		}
+
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	log.Printf("✅ API: 报名班期成功 - 班期ID: %d, 状态: %s", cohortID, resp.Cohort.MyStatus)
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data":    convertCohortToDisplay(resp.Cohort),
+	})
+}
+
+// LeaveCohort 退出班期
+// @Summary 退出班期
+// @Description 退出班期或取消候补，空出的名额按候补顺序递补；不影响课程报名
+// @Tags 班期
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path int true "班期ID"
+// @Success 200 {object} map[string]interface{}
+// @Router /api/v1/cohorts/{id}/leave [post]
+func (h *CohortHandler) LeaveCohort(c *gin.Context) {
+	cohortID, ok := parseIDParam(c, "id", "班期ID参数无效")
+	if !ok {
+		return
+	}
+
+	resp, err := h.cohortGRPCClient.LeaveCohort(c.Request.Context(), cohortID, c.GetUint("userID"))
+	if err != nil {
+		respondGRPCError(c, "退出班期失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+	})
+}
+
+// ListMembers 获取班期成员
+// @Summary 班期成员
+// @Description 讲师查看班期正式成员和候补名单
+// @Tags 班期
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path int true "班期ID"
+// @Success 200 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Router /api/v1/cohorts/{id}/members [get]
+func (h *CohortHandler) ListMembers(c *gin.Context) {
+	cohortID, ok := parseIDParam(c, "id", "班期ID参数无效")
+	if !ok {
+		return
+	}
+
+	resp, err := h.cohortGRPCClient.ListCohortMembers(c.Request.Context(), cohortID, c.GetUint("userID"))
+	if err != nil {
+		respondGRPCError(c, "获取班期成员失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	members := make([]gin.H, 0, len(resp.Members))
+	for _, m := range resp.Members {
+		members = append(members, gin.H{
+			"user_id":        m.UserId,
+			"status":         m.Status,
+			"queued_at":      m.QueuedAt,
+			"promoted_at":    m.PromotedAt,
+			"waitlist_order": m.WaitlistOrder,
+		})
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data":    members,
+	})
+}
+
+// CreateSession 安排直播
+// @Summary 安排直播
+// @Description 讲师为班期安排直播答疑，设置开始时间、时长、时区和入会链接
+// @Tags 班期
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path int true "班期ID"
+// @Param session body SaveLiveSessionRequest true "直播信息"
+// @Success 200 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Router /api/v1/cohorts/{id}/sessions [post]
+func (h *CohortHandler) CreateSession(c *gin.Context) {
+	cohortID, ok := parseIDParam(c, "id", "班期ID参数无效")
+	if !ok {
+		return
+	}
+
+	var req SaveLiveSessionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    400,
+			"message": "请求参数无效: " + err.Error(),
+		})
+		return
+	}
+
+	resp, err := h.cohortGRPCClient.CreateLiveSession(c.Request.Context(), buildSaveSessionRequest(c, cohortID, 0, &req))
+	if err != nil {
+		respondGRPCError(c, "安排直播失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data":    convertLiveSessionToDisplay(resp.Session),
+	})
+}
+
+// UpdateSession 更新直播
+// @Summary 更新直播
+// @Description 讲师调整直播时间或入会链接，日历订阅会在下次刷新时同步
+// @Tags 班期
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path int true "班期ID"
+// @Param session_id path int true "直播ID"
+// @Param session body SaveLiveSessionRequest true "直播信息"
+// @Success 200 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Router /api/v1/cohorts/{id}/sessions/{session_id} [put]
+func (h *CohortHandler) UpdateSession(c *gin.Context) {
+	cohortID, ok := parseIDParam(c, "id", "班期ID参数无效")
+	if !ok {
+		return
+	}
+	sessionID, ok := parseIDParam(c, "session_id", "直播ID参数无效")
+	if !ok {
+		return
+	}
+
+	var req SaveLiveSessionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    400,
+			"message": "请求参数无效: " + err.Error(),
+		})
+		return
+	}
+
+	resp, err := h.cohortGRPCClient.UpdateLiveSession(c.Request.Context(), buildSaveSessionRequest(c, cohortID, sessionID, &req))
+	if err != nil {
+		respondGRPCError(c, "更新直播失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data":    convertLiveSessionToDisplay(resp.Session),
+	})
+}
+
+// DeleteSession 取消直播
+// @Summary 取消直播
+// @Description 讲师取消已安排的直播
+// @Tags 班期
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path int true "班期ID"
+// @Param session_id path int true "直播ID"
+// @Success 200 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Router /api/v1/cohorts/{id}/sessions/{session_id} [delete]
+func (h *CohortHandler) DeleteSession(c *gin.Context) {
+	cohortID, ok := parseIDParam(c, "id", "班期ID参数无效")
+	if !ok {
+		return
+	}
+	sessionID, ok := parseIDParam(c, "session_id", "直播ID参数无效")
+	if !ok {
+		return
+	}
+
+	resp, err := h.cohortGRPCClient.DeleteLiveSession(c.Request.Context(), cohortID, sessionID, c.GetUint("userID"))
+	if err != nil {
+		respondGRPCError(c, "取消直播失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+	})
+}
+
+// GetCalendarFeed 获取个人日历订阅地址
+// @Summary 日历订阅地址
+// @Description 获取个人的iCalendar订阅地址，可添加到Google日历、Outlook或Apple日历中自动同步直播安排
+// @Tags 班期
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Success 200 {object} map[string]interface{}
+// @Router /api/v1/calendar/feed [get]
+func (h *CohortHandler) GetCalendarFeed(c *gin.Context) {
+	h.respondFeedToken(c, false)
+}
+
+// ResetCalendarFeed 重置日历订阅地址
+// @Summary 重置日历订阅地址
+// @Description 重新生成订阅地址，旧地址立即失效，用于订阅地址泄露的情况
+// @Tags 班期
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Success 200 {object} map[string]interface{}
+// @Router /api/v1/calendar/feed/reset [post]
+func (h *CohortHandler) ResetCalendarFeed(c *gin.Context) {
+	h.respondFeedToken(c, true)
+}
+
+// CalendarFeed 输出iCalendar订阅内容
+// @Summary iCalendar订阅
+// @Description 日历应用按订阅地址拉取直播安排，令牌即凭证，无需登录
+// @Tags 班期
+// @Produce text/calendar
+// @Param file path string true "订阅令牌，带 .ics 后缀"
+// @Success 200 {string} string "iCalendar内容"
+// @Failure 404 {object} map[string]interface{}
+// @Router /api/v1/calendar/feeds/{file} [get]
+func (h *CohortHandler) CalendarFeed(c *gin.Context) {
+	token := strings.TrimSuffix(c.Param("file"), ".ics")
+	if token == "" {
+		c.JSON(http.StatusNotFound, gin.H{
+			"code":    404,
+			"message": "日历订阅不存在",
+		})
+		return
+	}
+
+	resp, err := h.cohortGRPCClient.GetCalendarFeed(c.Request.Context(), token)
+	if err != nil {
+		respondGRPCError(c, "获取日历订阅失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	c.Header("Cache-Control", "private, max-age=300")
+	c.Header("Content-Disposition", `inline; filename="course-platform.ics"`)
+	c.Data(http.StatusOK, "text/calendar; charset=utf-8", resp.Ics)
+}
+
+// respondFeedToken 获取订阅令牌并返回完整订阅地址
+func (h *CohortHandler) respondFeedToken(c *gin.Context, regenerate bool) {
+	resp, err := h.cohortGRPCClient.GetCalendarFeedToken(c.Request.Context(), c.GetUint("userID"), regenerate)
+	if err != nil {
+		respondGRPCError(c, "获取日历订阅失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	host := c.Request.Host
+	if forwarded := c.GetHeader("X-Forwarded-Host"); forwarded != "" {
+		host = forwarded
+	}
+	scheme := "http"
+	if c.Request.TLS != nil || c.GetHeader("X-Forwarded-Proto") == "https" {
+		scheme = "https"
+	}
+	path := fmt.Sprintf("/api/v1/calendar/feeds/%s.ics", resp.Token)
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data": gin.H{
+			"url":        scheme + "://" + host + path,
+			"webcal_url": "webcal://" + host + path,
+		},
+	})
+}
+
+// buildSaveSessionRequest 组装直播请求
+func buildSaveSessionRequest(c *gin.Context, cohortID, sessionID uint, req *SaveLiveSessionRequest) *cohortpb.SaveLiveSessionRequest {
+	return &cohortpb.SaveLiveSessionRequest{
+		CohortId:        uint32(cohortID),
+		SessionId:       uint32(sessionID),
+		UserId:          uint32(c.GetUint("userID")),
+		Title:           req.Title,
+		Description:     req.Description,
+		StartsAt:        req.StartsAt,
+		DurationMinutes: req.DurationMinutes,
+		Timezone:        req.Timezone,
+		JoinUrl:         req.JoinURL,
+	}
+}
+
+// convertCohortToDisplay 转换班期显示数据（protobuf的JSON会省略零值，名额和人数需要显式输出）
+func convertCohortToDisplay(cohort *cohortpb.Cohort) gin.H {
+	if cohort == nil {
+		return gin.H{}
+	}
+	seatsLeft := cohort.Capacity - cohort.EnrolledCount
+	if seatsLeft < 0 {
+		seatsLeft = 0
+	}
+	return gin.H{
+		"id":                cohort.Id,
+		"course_id":         cohort.CourseId,
+		"name":              cohort.Name,
+		"start_date":        cohort.StartDate,
+		"end_date":          cohort.EndDate,
+		"capacity":          cohort.Capacity,
+		"timezone":          cohort.Timezone,
+		"enrolled_count":    cohort.EnrolledCount,
+		"waitlist_count":    cohort.WaitlistCount,
+		"seats_left":        seatsLeft,
+		"is_full":           seatsLeft == 0,
+		"my_status":         cohort.MyStatus,
+		"my_waitlist_order": cohort.MyWaitlistOrder,
+		"is_instructor":     cohort.IsInstructor,
+		"created_at":        cohort.CreatedAt,
+	}
+}
+
+// convertLiveSessionToDisplay 转换直播显示数据
+func convertLiveSessionToDisplay(session *cohortpb.LiveSession) gin.H {
+	if session == nil {
+		return gin.H{}
+	}
+	return gin.H{
+		"id":               session.Id,
+		"cohort_id":        session.CohortId,
+		"course_id":        session.CourseId,
+		"title":            session.Title,
+		"description":      session.Description,
+		"starts_at":        session.StartsAt,
+		"ends_at":          session.EndsAt,
+		"local_starts_at":  session.LocalStartsAt,
+		"duration_minutes": session.DurationMinutes,
+		"timezone":         session.Timezone,
+		"join_url":         session.JoinUrl,
+	}
+}
+
+// parseIDParam 解析路径中的ID参数，失败时直接返回400
+func parseIDParam(c *gin.Context, name, message string) (uint, bool) {
+	id, err := strconv.ParseUint(c.Param(name), 10, 32)
+	if err != nil || id == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    400,
+			"message": message,
+		})
+		return 0, false
+	}
+	return uint(id), true
+}
+
+// respondGRPCError 返回调用微服务失败的响应
+func respondGRPCError(c *gin.Context, action string, err error) {
+	log.Printf("❌ API: %s - %v", action, err)
+	c.JSON(http.StatusInternalServerError, gin.H{
+		"code":    500,
+		"message": action + ": " + err.Error(),
+	})
+}
+
+// respondBusinessError 按业务码返回对应HTTP状态
+func respondBusinessError(c *gin.Context, code int32, message string) {
+	status := http.StatusBadRequest
+	switch code {
+	case 403:
+		status = http.StatusForbidden
+	case 404:
+		status = http.StatusNotFound
+	case 409:
+		status = http.StatusConflict
+	}
+	c.JSON(status, gin.H{
+		"code":    code,
+		"message": message,
+	})
+}
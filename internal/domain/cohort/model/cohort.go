@@ -0,0 +1,117 @@
+package model
+
+import (
+	"time"
+)
+
+// 班期成员状态
+const (
+	MemberStatusEnrolled   = "enrolled"   // 已占用名额
+	MemberStatusWaitlisted = "waitlisted" // 名额已满，排队候补
+	MemberStatusCancelled  = "cancelled"  // 已退出
+)
+
+// 班期与直播的数量限制
+const (
+	MaxCohortCapacity      = 10000
+	MaxSessionMinutes      = 8 * 60
+	DefaultSessionMinutes  = 60
+	DefaultCohortTimezone  = "Asia/Shanghai"
+	CalendarFeedTokenBytes = 24
+)
+
+// Cohort 课程班期，同一课程可以开设多期，每期有独立的开班时间和名额
+type Cohort struct {
+	ID        uint      `gorm:"primarykey" json:"id"` // 主键ID
+	CreatedAt time.Time `json:"created_at"`           // 创建时间
+	UpdatedAt time.Time `json:"updated_at"`           // 更新时间
+
+	CourseID  uint       `gorm:"not null;index" json:"course_id"`                          // 所属课程ID
+	Name      string     `gorm:"not null;size:100" json:"name"`                            // 班期名称
+	StartDate time.Time  `gorm:"not null" json:"start_date"`                               // 开班时间
+	EndDate   *time.Time `json:"end_date,omitempty"`                                       // 结班时间，为空表示不限
+	Capacity  int        `gorm:"not null" json:"capacity"`                                 // 名额
+	Timezone  string     `gorm:"size:64;not null;default:'Asia/Shanghai'" json:"timezone"` // 班期所在时区（IANA名称）
+
+	// 以下字段不入库，由服务层填充
+	EnrolledCount   int    `gorm:"-" json:"enrolled_count"`          // 已占用名额
+	WaitlistCount   int    `gorm:"-" json:"waitlist_count"`          // 候补人数
+	MyStatus        string `gorm:"-" json:"my_status,omitempty"`     // 当前用户的成员状态
+	MyWaitlistOrder int    `gorm:"-" json:"my_waitlist_order"`       // 当前用户的候补顺位，从1开始
+	IsInstructor    bool   `gorm:"-" json:"is_instructor,omitempty"` // 当前用户是否为课程讲师
+}
+
+// TableName 指定表名
+func (Cohort) TableName() string {
+	return "cohorts"
+}
+
+// IsFull 名额是否已满
+func (c *Cohort) IsFull() bool {
+	return c.EnrolledCount >= c.Capacity
+}
+
+// CohortMember 学员加入班期的记录，名额满时进入候补，有人退出后按加入顺序递补
+type CohortMember struct {
+	ID        uint      `gorm:"primarykey" json:"id"` // 主键ID
+	CreatedAt time.Time `json:"created_at"`           // 创建时间
+	UpdatedAt time.Time `json:"updated_at"`           // 更新时间
+
+	CohortID   uint       `gorm:"not null;uniqueIndex:idx_cohort_member" json:"cohort_id"`     // 班期ID
+	UserID     uint       `gorm:"not null;uniqueIndex:idx_cohort_member;index" json:"user_id"` // 学员ID
+	CourseID   uint       `gorm:"not null;index" json:"course_id"`                             // 课程ID（冗余，便于检查同课程的其他班期）
+	Status     string     `gorm:"size:20;not null;default:'enrolled';index" json:"status"`     // 成员状态
+	QueuedAt   time.Time  `gorm:"not null" json:"queued_at"`                                   // 加入（或重新加入）时间，候补按此排序
+	PromotedAt *time.Time `json:"promoted_at,omitempty"`                                       // 从候补递补为正式成员的时间
+}
+
+// TableName 指定表名
+func (CohortMember) TableName() string {
+	return "cohort_members"
+}
+
+// IsActive 是否占用名额或在候补中
+func (m *CohortMember) IsActive() bool {
+	return m.Status == MemberStatusEnrolled || m.Status == MemberStatusWaitlisted
+}
+
+// LiveSession 班期的直播答疑，开始时间以UTC存储，Timezone 用于展示
+type LiveSession struct {
+	ID        uint      `gorm:"primarykey" json:"id"` // 主键ID
+	CreatedAt time.Time `json:"created_at"`           // 创建时间
+	UpdatedAt time.Time `json:"updated_at"`           // 更新时间
+
+	CohortID        uint      `gorm:"not null;index" json:"cohort_id"`             // 班期ID
+	CourseID        uint      `gorm:"not null;index" json:"course_id"`             // 课程ID
+	Title           string    `gorm:"not null;size:200" json:"title"`              // 直播标题
+	Description     string    `gorm:"type:text" json:"description"`                // 直播说明
+	StartsAt        time.Time `gorm:"not null;index" json:"starts_at"`             // 开始时间
+	DurationMinutes int       `gorm:"not null;default:60" json:"duration_minutes"` // 时长（分钟）
+	Timezone        string    `gorm:"size:64;not null" json:"timezone"`            // 时区（IANA名称）
+	JoinURL         string    `gorm:"size:500" json:"join_url,omitempty"`          // 入会链接，仅班期成员和讲师可见
+}
+
+// TableName 指定表名
+func (LiveSession) TableName() string {
+	return "live_sessions"
+}
+
+// EndsAt 结束时间
+func (s *LiveSession) EndsAt() time.Time {
+	return s.StartsAt.Add(time.Duration(s.DurationMinutes) * time.Minute)
+}
+
+// CalendarFeed 用户的日历订阅令牌，订阅地址不需要登录，凭令牌访问
+type CalendarFeed struct {
+	ID        uint      `gorm:"primarykey" json:"id"` // 主键ID
+	CreatedAt time.Time `json:"created_at"`           // 创建时间
+	UpdatedAt time.Time `json:"updated_at"`           // 更新时间
+
+	UserID uint   `gorm:"not null;uniqueIndex" json:"user_id"`   // 用户ID
+	Token  string `gorm:"not null;size:64;uniqueIndex" json:"-"` // 订阅令牌
+}
+
+// TableName 指定表名
+func (CalendarFeed) TableName() string {
+	return "calendar_feeds"
+}
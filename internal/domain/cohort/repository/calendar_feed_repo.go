@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+
+	"course-platform/internal/domain/cohort/model"
+
+	"gorm.io/gorm"
+)
+
+// CalendarFeedRepositoryInterface 日历订阅仓储接口
+type CalendarFeedRepositoryInterface interface {
+	GetByUser(userID uint) (*model.CalendarFeed, error)
+	GetByToken(token string) (*model.CalendarFeed, error)
+	Save(feed *model.CalendarFeed) error
+}
+
+// CalendarFeedRepository 日历订阅仓储实现
+type CalendarFeedRepository struct {
+	db *gorm.DB
+}
+
+// NewCalendarFeedRepository 创建日历订阅仓储实例
+func NewCalendarFeedRepository(db *gorm.DB) CalendarFeedRepositoryInterface {
+	return &CalendarFeedRepository{db: db}
+}
+
+// GetByUser 获取用户的订阅令牌，不存在时返回nil
+func (r *CalendarFeedRepository) GetByUser(userID uint) (*model.CalendarFeed, error) {
+	var feed model.CalendarFeed
+	if err := r.db.Where("user_id = ?", userID).First(&feed).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("查询日历订阅失败: %w", err)
+	}
+	return &feed, nil
+}
+
+// GetByToken 根据令牌获取订阅
+func (r *CalendarFeedRepository) GetByToken(token string) (*model.CalendarFeed, error) {
+	var feed model.CalendarFeed
+	if err := r.db.Where("token = ?", token).First(&feed).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("日历订阅不存在")
+		}
+		return nil, fmt.Errorf("查询日历订阅失败: %w", err)
+	}
+	return &feed, nil
+}
+
+// Save 创建或更新订阅令牌
+func (r *CalendarFeedRepository) Save(feed *model.CalendarFeed) error {
+	if err := r.db.Save(feed).Error; err != nil {
+		return fmt.Errorf("保存日历订阅失败: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,290 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"course-platform/internal/domain/cohort/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// CohortRepositoryInterface 班期仓储接口
+type CohortRepositoryInterface interface {
+	Create(cohort *model.Cohort) error
+	Update(cohort *model.Cohort) ([]*model.CohortMember, error)
+	GetByID(id uint) (*model.Cohort, error)
+	ListByCourse(courseID uint) ([]*model.Cohort, error)
+	ListByCourses(courseIDs []uint) ([]*model.Cohort, error)
+	CountMembers(cohortID uint) (enrolled, waitlisted int64, err error)
+	GetMember(cohortID, userID uint) (*model.CohortMember, error)
+	GetActiveMemberInCourse(courseID, userID uint) (*model.CohortMember, error)
+	ListMembers(cohortID uint) ([]*model.CohortMember, error)
+	ListEnrolledByUser(userID uint) ([]*model.CohortMember, error)
+	WaitlistOrder(member *model.CohortMember) (int, error)
+	Join(member *model.CohortMember) error
+	Leave(member *model.CohortMember) ([]*model.CohortMember, error)
+}
+
+// CohortRepository 班期仓储实现
+type CohortRepository struct {
+	db *gorm.DB
+}
+
+// NewCohortRepository 创建班期仓储实例
+func NewCohortRepository(db *gorm.DB) CohortRepositoryInterface {
+	return &CohortRepository{db: db}
+}
+
+// Create 创建班期
+func (r *CohortRepository) Create(cohort *model.Cohort) error {
+	if err := r.db.Create(cohort).Error; err != nil {
+		log.Printf("❌ Repository: 创建班期失败 - %v", err)
+		return fmt.Errorf("创建班期失败: %w", err)
+	}
+
+	log.Printf("✅ Repository: 班期创建成功 - ID: %d", cohort.ID)
+	return nil
+}
+
+// Update 更新班期，名额增加时按顺序递补候补学员，返回被递补的成员
+func (r *CohortRepository) Update(cohort *model.Cohort) ([]*model.CohortMember, error) {
+	var promoted []*model.CohortMember
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := lockCohort(tx, cohort.ID); err != nil {
+			return err
+		}
+		if err := tx.Save(cohort).Error; err != nil {
+			return err
+		}
+
+		var err error
+		promoted, err = fillSeats(tx, cohort)
+		return err
+	})
+	if err != nil {
+		log.Printf("❌ Repository: 更新班期失败 - %v", err)
+		return nil, fmt.Errorf("更新班期失败: %w", err)
+	}
+	return promoted, nil
+}
+
+// GetByID 根据ID获取班期
+func (r *CohortRepository) GetByID(id uint) (*model.Cohort, error) {
+	var cohort model.Cohort
+	if err := r.db.First(&cohort, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("班期不存在")
+		}
+		return nil, fmt.Errorf("查询班期失败: %w", err)
+	}
+	return &cohort, nil
+}
+
+// ListByCourse 获取课程的全部班期（按开班时间）
+func (r *CohortRepository) ListByCourse(courseID uint) ([]*model.Cohort, error) {
+	return r.ListByCourses([]uint{courseID})
+}
+
+// ListByCourses 获取多门课程的全部班期（按开班时间）
+func (r *CohortRepository) ListByCourses(courseIDs []uint) ([]*model.Cohort, error) {
+	var cohorts []*model.Cohort
+	if len(courseIDs) == 0 {
+		return cohorts, nil
+	}
+	if err := r.db.Where("course_id IN ?", courseIDs).
+		Order("start_date ASC, id ASC").Find(&cohorts).Error; err != nil {
+		log.Printf("❌ Repository: 查询班期列表失败 - %v", err)
+		return nil, fmt.Errorf("查询班期列表失败: %w", err)
+	}
+	return cohorts, nil
+}
+
+// CountMembers 统计班期的正式成员和候补人数
+func (r *CohortRepository) CountMembers(cohortID uint) (enrolled, waitlisted int64, err error) {
+	type statusCount struct {
+		Status string
+		Total  int64
+	}
+	var counts []statusCount
+	if err := r.db.Model(&model.CohortMember{}).
+		Select("status, COUNT(*) AS total").
+		Where("cohort_id = ?", cohortID).
+		Group("status").Scan(&counts).Error; err != nil {
+		return 0, 0, fmt.Errorf("统计班期成员失败: %w", err)
+	}
+
+	for _, c := range counts {
+		switch c.Status {
+		case model.MemberStatusEnrolled:
+			enrolled = c.Total
+		case model.MemberStatusWaitlisted:
+			waitlisted = c.Total
+		}
+	}
+	return enrolled, waitlisted, nil
+}
+
+// GetMember 获取学员在班期中的记录，不存在时返回nil
+func (r *CohortRepository) GetMember(cohortID, userID uint) (*model.CohortMember, error) {
+	var member model.CohortMember
+	err := r.db.Where("cohort_id = ? AND user_id = ?", cohortID, userID).First(&member).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("查询班期成员失败: %w", err)
+	}
+	return &member, nil
+}
+
+// GetActiveMemberInCourse 获取学员在该课程任一班期中的有效记录（正式或候补），不存在时返回nil
+func (r *CohortRepository) GetActiveMemberInCourse(courseID, userID uint) (*model.CohortMember, error) {
+	var member model.CohortMember
+	err := r.db.Where("course_id = ? AND user_id = ? AND status IN ?", courseID, userID,
+		[]string{model.MemberStatusEnrolled, model.MemberStatusWaitlisted}).First(&member).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("查询班期成员失败: %w", err)
+	}
+	return &member, nil
+}
+
+// ListMembers 获取班期的正式成员和候补学员（候补按排队顺序）
+func (r *CohortRepository) ListMembers(cohortID uint) ([]*model.CohortMember, error) {
+	var members []*model.CohortMember
+	if err := r.db.Where("cohort_id = ? AND status IN ?", cohortID,
+		[]string{model.MemberStatusEnrolled, model.MemberStatusWaitlisted}).
+		Order("status ASC, queued_at ASC, id ASC").Find(&members).Error; err != nil {
+		log.Printf("❌ Repository: 查询班期成员失败 - %v", err)
+		return nil, fmt.Errorf("查询班期成员失败: %w", err)
+	}
+	return members, nil
+}
+
+// ListEnrolledByUser 获取学员作为正式成员加入的全部班期记录
+func (r *CohortRepository) ListEnrolledByUser(userID uint) ([]*model.CohortMember, error) {
+	var members []*model.CohortMember
+	if err := r.db.Where("user_id = ? AND status = ?", userID, model.MemberStatusEnrolled).
+		Find(&members).Error; err != nil {
+		return nil, fmt.Errorf("查询学员班期失败: %w", err)
+	}
+	return members, nil
+}
+
+// WaitlistOrder 计算候补学员的排队顺位，从1开始
+func (r *CohortRepository) WaitlistOrder(member *model.CohortMember) (int, error) {
+	var ahead int64
+	if err := r.db.Model(&model.CohortMember{}).
+		Where("cohort_id = ? AND status = ?", member.CohortID, model.MemberStatusWaitlisted).
+		Where("queued_at < ? OR (queued_at = ? AND id < ?)", member.QueuedAt, member.QueuedAt, member.ID).
+		Count(&ahead).Error; err != nil {
+		return 0, fmt.Errorf("查询候补顺位失败: %w", err)
+	}
+	return int(ahead) + 1, nil
+}
+
+// Join 加入班期，锁定班期后根据剩余名额决定成为正式成员还是进入候补
+func (r *CohortRepository) Join(member *model.CohortMember) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := lockCohort(tx, member.CohortID); err != nil {
+			return err
+		}
+
+		var cohort model.Cohort
+		if err := tx.First(&cohort, member.CohortID).Error; err != nil {
+			return err
+		}
+		enrolled, err := countEnrolled(tx, cohort.ID)
+		if err != nil {
+			return err
+		}
+
+		member.Status = model.MemberStatusEnrolled
+		if enrolled >= int64(cohort.Capacity) {
+			member.Status = model.MemberStatusWaitlisted
+		}
+		return tx.Save(member).Error
+	})
+	if err != nil {
+		log.Printf("❌ Repository: 加入班期失败 - %v", err)
+		return fmt.Errorf("加入班期失败: %w", err)
+	}
+	return nil
+}
+
+// Leave 退出班期，空出的名额按顺序递补候补学员，返回被递补的成员
+func (r *CohortRepository) Leave(member *model.CohortMember) ([]*model.CohortMember, error) {
+	var promoted []*model.CohortMember
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := lockCohort(tx, member.CohortID); err != nil {
+			return err
+		}
+
+		member.Status = model.MemberStatusCancelled
+		if err := tx.Save(member).Error; err != nil {
+			return err
+		}
+
+		var cohort model.Cohort
+		if err := tx.First(&cohort, member.CohortID).Error; err != nil {
+			return err
+		}
+		var err error
+		promoted, err = fillSeats(tx, &cohort)
+		return err
+	})
+	if err != nil {
+		log.Printf("❌ Repository: 退出班期失败 - %v", err)
+		return nil, fmt.Errorf("退出班期失败: %w", err)
+	}
+	return promoted, nil
+}
+
+// lockCohort 锁定班期行，串行化同一班期的名额变动
+func lockCohort(tx *gorm.DB, cohortID uint) error {
+	var cohort model.Cohort
+	return tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").First(&cohort, cohortID).Error
+}
+
+// countEnrolled 统计班期已占用的名额
+func countEnrolled(tx *gorm.DB, cohortID uint) (int64, error) {
+	var enrolled int64
+	err := tx.Model(&model.CohortMember{}).
+		Where("cohort_id = ? AND status = ?", cohortID, model.MemberStatusEnrolled).
+		Count(&enrolled).Error
+	return enrolled, err
+}
+
+// fillSeats 用候补学员按排队顺序补满空余名额
+func fillSeats(tx *gorm.DB, cohort *model.Cohort) ([]*model.CohortMember, error) {
+	enrolled, err := countEnrolled(tx, cohort.ID)
+	if err != nil {
+		return nil, err
+	}
+	free := int64(cohort.Capacity) - enrolled
+	if free <= 0 {
+		return nil, nil
+	}
+
+	var waitlisted []*model.CohortMember
+	if err := tx.Where("cohort_id = ? AND status = ?", cohort.ID, model.MemberStatusWaitlisted).
+		Order("queued_at ASC, id ASC").Limit(int(free)).Find(&waitlisted).Error; err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	for _, member := range waitlisted {
+		member.Status = model.MemberStatusEnrolled
+		member.PromotedAt = &now
+		if err := tx.Save(member).Error; err != nil {
+			return nil, err
+		}
+	}
+	return waitlisted, nil
+}
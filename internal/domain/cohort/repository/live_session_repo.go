@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"course-platform/internal/domain/cohort/model"
+
+	"gorm.io/gorm"
+)
+
+// LiveSessionRepositoryInterface 直播仓储接口
+type LiveSessionRepositoryInterface interface {
+	Create(session *model.LiveSession) error
+	Update(session *model.LiveSession) error
+	Delete(id uint) error
+	GetByID(id uint) (*model.LiveSession, error)
+	ListByCohort(cohortID uint) ([]*model.LiveSession, error)
+	ListByCohortsSince(cohortIDs []uint, since time.Time) ([]*model.LiveSession, error)
+}
+
+// LiveSessionRepository 直播仓储实现
+type LiveSessionRepository struct {
+	db *gorm.DB
+}
+
+// NewLiveSessionRepository 创建直播仓储实例
+func NewLiveSessionRepository(db *gorm.DB) LiveSessionRepositoryInterface {
+	return &LiveSessionRepository{db: db}
+}
+
+// Create 创建直播
+func (r *LiveSessionRepository) Create(session *model.LiveSession) error {
+	if err := r.db.Create(session).Error; err != nil {
+		log.Printf("❌ Repository: 创建直播失败 - %v", err)
+		return fmt.Errorf("创建直播失败: %w", err)
+	}
+
+	log.Printf("✅ Repository: 直播创建成功 - ID: %d", session.ID)
+	return nil
+}
+
+// Update 更新直播
+func (r *LiveSessionRepository) Update(session *model.LiveSession) error {
+	if err := r.db.Save(session).Error; err != nil {
+		log.Printf("❌ Repository: 更新直播失败 - %v", err)
+		return fmt.Errorf("更新直播失败: %w", err)
+	}
+	return nil
+}
+
+// Delete 删除直播
+func (r *LiveSessionRepository) Delete(id uint) error {
+	if err := r.db.Delete(&model.LiveSession{}, id).Error; err != nil {
+		log.Printf("❌ Repository: 删除直播失败 - %v", err)
+		return fmt.Errorf("删除直播失败: %w", err)
+	}
+	return nil
+}
+
+// GetByID 根据ID获取直播
+func (r *LiveSessionRepository) GetByID(id uint) (*model.LiveSession, error) {
+	var session model.LiveSession
+	if err := r.db.First(&session, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("直播不存在")
+		}
+		return nil, fmt.Errorf("查询直播失败: %w", err)
+	}
+	return &session, nil
+}
+
+// ListByCohort 获取班期的全部直播（按开始时间）
+func (r *LiveSessionRepository) ListByCohort(cohortID uint) ([]*model.LiveSession, error) {
+	var sessions []*model.LiveSession
+	if err := r.db.Where("cohort_id = ?", cohortID).
+		Order("starts_at ASC, id ASC").Find(&sessions).Error; err != nil {
+		log.Printf("❌ Repository: 查询直播列表失败 - %v", err)
+		return nil, fmt.Errorf("查询直播列表失败: %w", err)
+	}
+	return sessions, nil
+}
+
+// ListByCohortsSince 获取多个班期中在 since 之后开始的直播（按开始时间）
+func (r *LiveSessionRepository) ListByCohortsSince(cohortIDs []uint, since time.Time) ([]*model.LiveSession, error) {
+	var sessions []*model.LiveSession
+	if len(cohortIDs) == 0 {
+		return sessions, nil
+	}
+	if err := r.db.Where("cohort_id IN ? AND starts_at >= ?", cohortIDs, since).
+		Order("starts_at ASC, id ASC").Find(&sessions).Error; err != nil {
+		log.Printf("❌ Repository: 查询直播列表失败 - %v", err)
+		return nil, fmt.Errorf("查询直播列表失败: %w", err)
+	}
+	return sessions, nil
+}
@@ -0,0 +1,608 @@
+package service
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"course-platform/internal/domain/cohort/model"
+	"course-platform/internal/domain/cohort/repository"
+	courseService "course-platform/internal/domain/course/service"
+)
+
+// feedLookback 日历订阅中保留的已结束直播时长
+const feedLookback = 30 * 24 * time.Hour
+
+// CohortServiceInterface 班期与直播服务接口
+type CohortServiceInterface interface {
+	CreateCohort(req *SaveCohortRequest) (*model.Cohort, error)
+	UpdateCohort(cohortID uint, req *SaveCohortRequest) (*model.Cohort, error)
+	GetCohort(cohortID, userID uint) (*model.Cohort, []*model.LiveSession, error)
+	ListCohorts(courseID, userID uint) ([]*model.Cohort, error)
+	EnrollCohort(cohortID, userID uint) (*model.Cohort, error)
+	LeaveCohort(cohortID, userID uint) error
+	ListMembers(cohortID, userID uint) ([]*model.CohortMember, error)
+	CreateSession(req *SaveSessionRequest) (*model.LiveSession, error)
+	UpdateSession(sessionID uint, req *SaveSessionRequest) (*model.LiveSession, error)
+	DeleteSession(cohortID, sessionID, userID uint) error
+	GetFeedToken(userID uint, reset bool) (string, error)
+	RenderCalendarFeed(token string) ([]byte, error)
+}
+
+// SaveCohortRequest 创建或更新班期请求，更新时忽略 CourseID
+type SaveCohortRequest struct {
+	UserID    uint
+	CourseID  uint
+	Name      string
+	StartDate time.Time
+	EndDate   *time.Time
+	Capacity  int
+	Timezone  string
+}
+
+// SaveSessionRequest 创建或更新直播请求，Timezone 为空时使用班期时区
+type SaveSessionRequest struct {
+	UserID          uint
+	CohortID        uint
+	Title           string
+	Description     string
+	StartsAt        time.Time
+	DurationMinutes int
+	Timezone        string
+	JoinURL         string
+}
+
+// CohortService 班期与直播服务实现
+type CohortService struct {
+	cohortRepo    repository.CohortRepositoryInterface
+	sessionRepo   repository.LiveSessionRepositoryInterface
+	feedRepo      repository.CalendarFeedRepositoryInterface
+	courseService courseService.CourseServiceInterface
+}
+
+// NewCohortService 创建班期与直播服务实例
+func NewCohortService(cohortRepo repository.CohortRepositoryInterface, sessionRepo repository.LiveSessionRepositoryInterface, feedRepo repository.CalendarFeedRepositoryInterface, courseService courseService.CourseServiceInterface) CohortServiceInterface {
+	return &CohortService{
+		cohortRepo:    cohortRepo,
+		sessionRepo:   sessionRepo,
+		feedRepo:      feedRepo,
+		courseService: courseService,
+	}
+}
+
+// ParseLocalTime 解析时间：带时区偏移的RFC3339直接解析，否则按 timezone 中的当地时间解析
+func ParseLocalTime(value, timezone string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+
+	loc, err := loadTimezone(timezone)
+	if err != nil {
+		return time.Time{}, err
+	}
+	for _, layout := range []string{"2006-01-02T15:04", "2006-01-02 15:04", "2006-01-02"} {
+		if t, err := time.ParseInLocation(layout, value, loc); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("时间格式无效: %s", value)
+}
+
+// loadTimezone 加载IANA时区，为空时使用默认时区
+func loadTimezone(timezone string) (*time.Location, error) {
+	if timezone == "" {
+		timezone = model.DefaultCohortTimezone
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fmt.Errorf("不支持的时区: %s", timezone)
+	}
+	return loc, nil
+}
+
+// CreateCohort 课程讲师开设新班期
+func (s *CohortService) CreateCohort(req *SaveCohortRequest) (*model.Cohort, error) {
+	log.Printf("🔍 Service: 创建班期 - 课程ID: %d, 名称: %s", req.CourseID, req.Name)
+
+	if err := s.checkInstructor(req.CourseID, req.UserID); err != nil {
+		return nil, err
+	}
+
+	cohort := &model.Cohort{CourseID: req.CourseID}
+	if err := applyCohortRequest(cohort, req, 0); err != nil {
+		return nil, err
+	}
+	if err := s.cohortRepo.Create(cohort); err != nil {
+		return nil, err
+	}
+
+	log.Printf("✅ Service: 班期创建成功 - ID: %d", cohort.ID)
+	return s.fillCohort(cohort, req.UserID, req.UserID)
+}
+
+// UpdateCohort 更新班期信息（仅课程讲师），名额增加时自动递补候补学员
+func (s *CohortService) UpdateCohort(cohortID uint, req *SaveCohortRequest) (*model.Cohort, error) {
+	log.Printf("🔍 Service: 更新班期 - ID: %d", cohortID)
+
+	cohort, err := s.cohortRepo.GetByID(cohortID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkInstructor(cohort.CourseID, req.UserID); err != nil {
+		return nil, err
+	}
+
+	enrolled, _, err := s.cohortRepo.CountMembers(cohortID)
+	if err != nil {
+		return nil, err
+	}
+	if err := applyCohortRequest(cohort, req, int(enrolled)); err != nil {
+		return nil, err
+	}
+
+	promoted, err := s.cohortRepo.Update(cohort)
+	if err != nil {
+		return nil, err
+	}
+	for _, member := range promoted {
+		log.Printf("✅ Service: 候补学员已递补 - 班期ID: %d, 用户ID: %d", cohortID, member.UserID)
+	}
+	return s.fillCohort(cohort, req.UserID, req.UserID)
+}
+
+// applyCohortRequest 校验并写入班期字段，enrolled 为当前已占用名额
+func applyCohortRequest(cohort *model.Cohort, req *SaveCohortRequest, enrolled int) error {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return errors.New("班期名称不能为空")
+	}
+	if len([]rune(name)) > 100 {
+		return errors.New("班期名称不能超过100个字符")
+	}
+	if req.StartDate.IsZero() {
+		return errors.New("请设置开班时间")
+	}
+	if req.EndDate != nil && !req.EndDate.After(req.StartDate) {
+		return errors.New("结班时间必须晚于开班时间")
+	}
+	if req.Capacity < 1 || req.Capacity > model.MaxCohortCapacity {
+		return fmt.Errorf("名额必须在1到%d之间", model.MaxCohortCapacity)
+	}
+	if req.Capacity < enrolled {
+		return fmt.Errorf("名额不能少于已报名人数（%d人）", enrolled)
+	}
+	if _, err := loadTimezone(req.Timezone); err != nil {
+		return err
+	}
+
+	cohort.Name = name
+	cohort.StartDate = req.StartDate
+	cohort.EndDate = req.EndDate
+	cohort.Capacity = req.Capacity
+	cohort.Timezone = req.Timezone
+	if cohort.Timezone == "" {
+		cohort.Timezone = model.DefaultCohortTimezone
+	}
+	return nil
+}
+
+// GetCohort 获取班期详情及直播安排，入会链接仅对正式成员和讲师可见
+func (s *CohortService) GetCohort(cohortID, userID uint) (*model.Cohort, []*model.LiveSession, error) {
+	cohort, err := s.cohortRepo.GetByID(cohortID)
+	if err != nil {
+		return nil, nil, err
+	}
+	course, err := s.courseService.GetCourseByID(cohort.CourseID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if cohort, err = s.fillCohort(cohort, userID, course.InstructorID); err != nil {
+		return nil, nil, err
+	}
+
+	sessions, err := s.sessionRepo.ListByCohort(cohortID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !cohort.IsInstructor && cohort.MyStatus != model.MemberStatusEnrolled {
+		for _, session := range sessions {
+			session.JoinURL = ""
+		}
+	}
+	return cohort, sessions, nil
+}
+
+// ListCohorts 获取课程的全部班期，userID 不为0时附带该用户的成员状态
+func (s *CohortService) ListCohorts(courseID, userID uint) ([]*model.Cohort, error) {
+	course, err := s.courseService.GetCourseByID(courseID)
+	if err != nil {
+		return nil, err
+	}
+
+	cohorts, err := s.cohortRepo.ListByCourse(courseID)
+	if err != nil {
+		return nil, err
+	}
+	for _, cohort := range cohorts {
+		if _, err := s.fillCohort(cohort, userID, course.InstructorID); err != nil {
+			return nil, err
+		}
+	}
+	return cohorts, nil
+}
+
+// EnrollCohort 报名指定班期：尚未报名免费课程时一并报名，名额已满时进入候补
+// 同一课程同时只能加入一个班期
+func (s *CohortService) EnrollCohort(cohortID, userID uint) (*model.Cohort, error) {
+	log.Printf("🔍 Service: 报名班期 - 用户ID: %d, 班期ID: %d", userID, cohortID)
+
+	cohort, err := s.cohortRepo.GetByID(cohortID)
+	if err != nil {
+		return nil, err
+	}
+	if cohort.EndDate != nil && time.Now().After(*cohort.EndDate) {
+		return nil, errors.New("该班期已结束")
+	}
+
+	course, err := s.courseService.GetCourseByID(cohort.CourseID)
+	if err != nil {
+		return nil, err
+	}
+	if course.InstructorID == userID {
+		return nil, errors.New("讲师无需报名自己课程的班期")
+	}
+
+	member, err := s.cohortRepo.GetMember(cohortID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if member != nil && member.IsActive() {
+		return s.fillCohort(cohort, userID, course.InstructorID)
+	}
+
+	other, err := s.cohortRepo.GetActiveMemberInCourse(cohort.CourseID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if other != nil {
+		return nil, errors.New("你已报名本课程的其他班期，请先退出后再报名")
+	}
+
+	// 班期名额只针对有课程权限的学员，免费课程在这里直接报名
+	hasAccess, err := s.courseService.HasCourseAccess(userID, cohort.CourseID)
+	if err != nil {
+		return nil, err
+	}
+	if !hasAccess {
+		if _, err := s.courseService.EnrollCourse(userID, cohort.CourseID); err != nil {
+			return nil, err
+		}
+	}
+
+	if member == nil {
+		member = &model.CohortMember{
+			CohortID: cohortID,
+			UserID:   userID,
+			CourseID: cohort.CourseID,
+		}
+	}
+	member.QueuedAt = time.Now()
+	member.PromotedAt = nil
+	if err := s.cohortRepo.Join(member); err != nil {
+		return nil, err
+	}
+
+	log.Printf("✅ Service: 班期报名成功 - 用户ID: %d, 班期ID: %d, 状态: %s", userID, cohortID, member.Status)
+	return s.fillCohort(cohort, userID, course.InstructorID)
+}
+
+// LeaveCohort 退出班期或取消候补，空出的名额由候补学员按顺序递补
+func (s *CohortService) LeaveCohort(cohortID, userID uint) error {
+	log.Printf("🔍 Service: 退出班期 - 用户ID: %d, 班期ID: %d", userID, cohortID)
+
+	member, err := s.cohortRepo.GetMember(cohortID, userID)
+	if err != nil {
+		return err
+	}
+	if member == nil || !member.IsActive() {
+		return errors.New("你未报名该班期")
+	}
+
+	promoted, err := s.cohortRepo.Leave(member)
+	if err != nil {
+		return err
+	}
+	for _, p := range promoted {
+		log.Printf("✅ Service: 候补学员已递补 - 班期ID: %d, 用户ID: %d", cohortID, p.UserID)
+	}
+	return nil
+}
+
+// ListMembers 获取班期成员和候补名单（仅课程讲师）
+func (s *CohortService) ListMembers(cohortID, userID uint) ([]*model.CohortMember, error) {
+	cohort, err := s.cohortRepo.GetByID(cohortID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkInstructor(cohort.CourseID, userID); err != nil {
+		return nil, err
+	}
+	return s.cohortRepo.ListMembers(cohortID)
+}
+
+// CreateSession 为班期安排直播（仅课程讲师）
+func (s *CohortService) CreateSession(req *SaveSessionRequest) (*model.LiveSession, error) {
+	log.Printf("🔍 Service: 创建直播 - 班期ID: %d, 标题: %s", req.CohortID, req.Title)
+
+	cohort, err := s.cohortRepo.GetByID(req.CohortID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkInstructor(cohort.CourseID, req.UserID); err != nil {
+		return nil, err
+	}
+
+	session := &model.LiveSession{
+		CohortID: cohort.ID,
+		CourseID: cohort.CourseID,
+	}
+	if err := applySessionRequest(session, req, cohort); err != nil {
+		return nil, err
+	}
+	if err := s.sessionRepo.Create(session); err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+// UpdateSession 更新直播安排（仅课程讲师）
+func (s *CohortService) UpdateSession(sessionID uint, req *SaveSessionRequest) (*model.LiveSession, error) {
+	log.Printf("🔍 Service: 更新直播 - ID: %d", sessionID)
+
+	session, cohort, err := s.getOwnedSession(req.CohortID, sessionID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if err := applySessionRequest(session, req, cohort); err != nil {
+		return nil, err
+	}
+	if err := s.sessionRepo.Update(session); err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+// DeleteSession 取消直播（仅课程讲师）
+func (s *CohortService) DeleteSession(cohortID, sessionID, userID uint) error {
+	log.Printf("🔍 Service: 删除直播 - ID: %d", sessionID)
+
+	if _, _, err := s.getOwnedSession(cohortID, sessionID, userID); err != nil {
+		return err
+	}
+	return s.sessionRepo.Delete(sessionID)
+}
+
+// getOwnedSession 获取讲师自己课程班期下的直播
+func (s *CohortService) getOwnedSession(cohortID, sessionID, userID uint) (*model.LiveSession, *model.Cohort, error) {
+	session, err := s.sessionRepo.GetByID(sessionID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if session.CohortID != cohortID {
+		return nil, nil, errors.New("直播不属于该班期")
+	}
+
+	cohort, err := s.cohortRepo.GetByID(cohortID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := s.checkInstructor(cohort.CourseID, userID); err != nil {
+		return nil, nil, err
+	}
+	return session, cohort, nil
+}
+
+// applySessionRequest 校验并写入直播字段
+func applySessionRequest(session *model.LiveSession, req *SaveSessionRequest, cohort *model.Cohort) error {
+	title := strings.TrimSpace(req.Title)
+	if title == "" {
+		return errors.New("直播标题不能为空")
+	}
+	if len([]rune(title)) > 200 {
+		return errors.New("直播标题不能超过200个字符")
+	}
+	if req.StartsAt.IsZero() {
+		return errors.New("请设置直播开始时间")
+	}
+
+	duration := req.DurationMinutes
+	if duration == 0 {
+		duration = model.DefaultSessionMinutes
+	}
+	if duration < 1 || duration > model.MaxSessionMinutes {
+		return fmt.Errorf("直播时长必须在1到%d分钟之间", model.MaxSessionMinutes)
+	}
+
+	timezone := req.Timezone
+	if timezone == "" {
+		timezone = cohort.Timezone
+	}
+	if _, err := loadTimezone(timezone); err != nil {
+		return err
+	}
+
+	joinURL := strings.TrimSpace(req.JoinURL)
+	if joinURL != "" {
+		u, err := url.Parse(joinURL)
+		if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+			return errors.New("入会链接必须是有效的http(s)地址")
+		}
+	}
+
+	session.Title = title
+	session.Description = req.Description
+	session.StartsAt = req.StartsAt.UTC()
+	session.DurationMinutes = duration
+	session.Timezone = timezone
+	session.JoinURL = joinURL
+	return nil
+}
+
+// GetFeedToken 获取用户的日历订阅令牌，reset 为 true 时重新生成（旧订阅地址随即失效）
+func (s *CohortService) GetFeedToken(userID uint, reset bool) (string, error) {
+	if userID == 0 {
+		return "", errors.New("用户ID不能为空")
+	}
+
+	feed, err := s.feedRepo.GetByUser(userID)
+	if err != nil {
+		return "", err
+	}
+	if feed != nil && !reset {
+		return feed.Token, nil
+	}
+
+	token, err := generateFeedToken()
+	if err != nil {
+		return "", err
+	}
+	if feed == nil {
+		feed = &model.CalendarFeed{UserID: userID}
+	}
+	feed.Token = token
+	if err := s.feedRepo.Save(feed); err != nil {
+		return "", err
+	}
+
+	log.Printf("✅ Service: 日历订阅令牌已生成 - 用户ID: %d", userID)
+	return token, nil
+}
+
+// RenderCalendarFeed 根据订阅令牌生成iCalendar内容
+// 包含学员正式加入的班期和讲师本人课程班期中的直播
+func (s *CohortService) RenderCalendarFeed(token string) ([]byte, error) {
+	feed, err := s.feedRepo.GetByToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	cohortIDs, err := s.feedCohortIDs(feed.UserID)
+	if err != nil {
+		return nil, err
+	}
+	sessions, err := s.sessionRepo.ListByCohortsSince(cohortIDs, time.Now().Add(-feedLookback))
+	if err != nil {
+		return nil, err
+	}
+
+	courseTitles := make(map[uint]string)
+	for _, session := range sessions {
+		if _, ok := courseTitles[session.CourseID]; ok {
+			continue
+		}
+		if course, err := s.courseService.GetCourseByID(session.CourseID); err == nil {
+			courseTitles[session.CourseID] = course.Title
+		} else {
+			courseTitles[session.CourseID] = ""
+		}
+	}
+	return renderICS(sessions, courseTitles, time.Now()), nil
+}
+
+// feedCohortIDs 获取用户日历中应包含的班期
+func (s *CohortService) feedCohortIDs(userID uint) ([]uint, error) {
+	seen := make(map[uint]bool)
+	var ids []uint
+
+	members, err := s.cohortRepo.ListEnrolledByUser(userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, member := range members {
+		// 课程权限已取消（如退款）的班期不再出现在日历中
+		hasAccess, err := s.courseService.HasCourseAccess(userID, member.CourseID)
+		if err != nil {
+			return nil, err
+		}
+		if hasAccess && !seen[member.CohortID] {
+			seen[member.CohortID] = true
+			ids = append(ids, member.CohortID)
+		}
+	}
+
+	courses, err := s.courseService.GetCoursesByInstructor(userID)
+	if err != nil {
+		return nil, err
+	}
+	courseIDs := make([]uint, 0, len(courses))
+	for _, course := range courses {
+		courseIDs = append(courseIDs, course.ID)
+	}
+	cohorts, err := s.cohortRepo.ListByCourses(courseIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, cohort := range cohorts {
+		if !seen[cohort.ID] {
+			seen[cohort.ID] = true
+			ids = append(ids, cohort.ID)
+		}
+	}
+	return ids, nil
+}
+
+// fillCohort 填充班期的名额占用情况和当前用户的成员状态
+func (s *CohortService) fillCohort(cohort *model.Cohort, userID, instructorID uint) (*model.Cohort, error) {
+	enrolled, waitlisted, err := s.cohortRepo.CountMembers(cohort.ID)
+	if err != nil {
+		return nil, err
+	}
+	cohort.EnrolledCount = int(enrolled)
+	cohort.WaitlistCount = int(waitlisted)
+	cohort.IsInstructor = userID != 0 && userID == instructorID
+
+	if userID == 0 {
+		return cohort, nil
+	}
+	member, err := s.cohortRepo.GetMember(cohort.ID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if member == nil || !member.IsActive() {
+		return cohort, nil
+	}
+
+	cohort.MyStatus = member.Status
+	if member.Status == model.MemberStatusWaitlisted {
+		if cohort.MyWaitlistOrder, err = s.cohortRepo.WaitlistOrder(member); err != nil {
+			return nil, err
+		}
+	}
+	return cohort, nil
+}
+
+// checkInstructor 确认用户是课程讲师
+func (s *CohortService) checkInstructor(courseID, userID uint) error {
+	course, err := s.courseService.GetCourseByID(courseID)
+	if err != nil {
+		return err
+	}
+	if course.InstructorID != userID {
+		return errors.New("只有课程讲师可以管理班期")
+	}
+	return nil
+}
+
+// generateFeedToken 生成随机订阅令牌
+func generateFeedToken() (string, error) {
+	buf := make([]byte, model.CalendarFeedTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("生成订阅令牌失败: %w", err)
+	}
+	return hex.EncodeToString(buf), nil
+}
@@ -0,0 +1,89 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"course-platform/internal/domain/cohort/model"
+)
+
+// icsTimeLayout iCalendar中的UTC时间格式
+const icsTimeLayout = "20060102T150405Z"
+
+// renderICS 将直播列表生成为iCalendar（RFC 5545）订阅内容
+// 时间统一输出为UTC，由日历应用换算成本地时间；直播设置的时区写在说明中
+func renderICS(sessions []*model.LiveSession, courseTitles map[uint]string, now time.Time) []byte {
+	var b strings.Builder
+	writeICSLine(&b, "BEGIN:VCALENDAR")
+	writeICSLine(&b, "VERSION:2.0")
+	writeICSLine(&b, "PRODID:-//Course Platform//Live Sessions//ZH")
+	writeICSLine(&b, "CALSCALE:GREGORIAN")
+	writeICSLine(&b, "METHOD:PUBLISH")
+	writeICSLine(&b, "X-WR-CALNAME:"+escapeICSText("Course Platform 直播课"))
+	writeICSLine(&b, "REFRESH-INTERVAL;VALUE=DURATION:PT1H")
+	writeICSLine(&b, "X-PUBLISHED-TTL:PT1H")
+
+	for _, session := range sessions {
+		summary := session.Title
+		if title := courseTitles[session.CourseID]; title != "" {
+			summary = title + " · " + session.Title
+		}
+
+		description := session.Description
+		if loc, err := time.LoadLocation(session.Timezone); err == nil {
+			local := session.StartsAt.In(loc)
+			line := fmt.Sprintf("时间：%s（%s）", local.Format("2006-01-02 15:04"), session.Timezone)
+			description = strings.TrimSpace(line + "\n" + description)
+		}
+		if session.JoinURL != "" {
+			description += "\n入会链接：" + session.JoinURL
+		}
+
+		writeICSLine(&b, "BEGIN:VEVENT")
+		writeICSLine(&b, fmt.Sprintf("UID:live-session-%d@course-platform", session.ID))
+		writeICSLine(&b, "DTSTAMP:"+now.UTC().Format(icsTimeLayout))
+		writeICSLine(&b, "LAST-MODIFIED:"+session.UpdatedAt.UTC().Format(icsTimeLayout))
+		writeICSLine(&b, "DTSTART:"+session.StartsAt.UTC().Format(icsTimeLayout))
+		writeICSLine(&b, "DTEND:"+session.EndsAt().UTC().Format(icsTimeLayout))
+		writeICSLine(&b, "SUMMARY:"+escapeICSText(summary))
+		writeICSLine(&b, "DESCRIPTION:"+escapeICSText(description))
+		if session.JoinURL != "" {
+			writeICSLine(&b, "URL:"+session.JoinURL)
+			writeICSLine(&b, "LOCATION:"+escapeICSText(session.JoinURL))
+		}
+		writeICSLine(&b, "END:VEVENT")
+	}
+
+	writeICSLine(&b, "END:VCALENDAR")
+	return []byte(b.String())
+}
+
+// escapeICSText 转义TEXT类型属性值中的特殊字符
+func escapeICSText(s string) string {
+	s = strings.ReplaceAll(s, "\\", "\\\\")
+	s = strings.ReplaceAll(s, ";", "\\;")
+	s = strings.ReplaceAll(s, ",", "\\,")
+	s = strings.ReplaceAll(s, "\r\n", "\\n")
+	s = strings.ReplaceAll(s, "\n", "\\n")
+	return s
+}
+
+// writeICSLine 写入一行内容，超过75字节时按规范折行（不拆开UTF-8字符）
+func writeICSLine(b *strings.Builder, line string) {
+	const maxOctets = 75
+
+	width := 0
+	limit := maxOctets
+	for _, r := range line {
+		size := len(string(r))
+		if width+size > limit {
+			b.WriteString("\r\n ")
+			width = 0
+			limit = maxOctets - 1 // 续行开头的空格占1字节
+		}
+		b.WriteRune(r)
+		width += size
+	}
+	b.WriteString("\r\n")
+}
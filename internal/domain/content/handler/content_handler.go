@@ -5,25 +5,46 @@ import (
 	"io"
 	"log"
 	"net/http"
+	"os"
+	"path"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
+	"course-platform/internal/configs"
+	"course-platform/internal/domain/content/model"
 	service "course-platform/internal/infrastructure/grpc_client"
+	"course-platform/internal/infrastructure/hls"
 	"course-platform/internal/shared/pb/contentpb"
 
 	"github.com/gin-gonic/gin"
 )
 
+// maxFileSize 上传文件大小上限（50MB）
+const maxFileSize = 50 * 1024 * 1024
+
+// allowedExtensions 各文件类型允许的扩展名，为空表示不限制
+var allowedExtensions = map[string][]string{
+	"image":    {".jpg", ".jpeg", ".png", ".gif", ".bmp", ".webp"},
+	"cover":    {".jpg", ".jpeg", ".png", ".gif", ".bmp", ".webp"},
+	"video":    {".mp4", ".avi", ".mov", ".wmv", ".flv", ".webm"},
+	"document": {".pdf", ".doc", ".docx", ".ppt", ".pptx", ".txt", ".md"},
+	"audio":    {".mp3", ".wav", ".flac", ".aac", ".ogg"},
+	"other":    {}, // 允许所有类型
+}
+
 // ContentHandler 内容处理器
 type ContentHandler struct {
 	contentClient *service.ContentGRPCClientService
+	courseClient  *service.CourseGRPCClientService
 }
 
 // NewContentHandler 创建内容处理器实例
-func NewContentHandler(contentClient *service.ContentGRPCClientService) *ContentHandler {
+func NewContentHandler(contentClient *service.ContentGRPCClientService, courseClient *service.CourseGRPCClientService) *ContentHandler {
 	return &ContentHandler{
 		contentClient: contentClient,
+		courseClient:  courseClient,
 	}
 }
 
@@ -36,7 +57,8 @@ func NewContentHandler(contentClient *service.ContentGRPCClientService) *Content
 // @Param Authorization header string true "Bearer token"
 // @Param file formData file true "上传的文件"
 // @Param course_id formData string true "课程ID"
-// @Param file_type formData string true "文件类型 (image, video, document, audio, other)"
+// @Param chapter_id formData string false "所属章节ID，留空表示课程通用资料"
+// @Param file_type formData string true "文件类型 (image, cover, video, document, audio, other)"
 // @Success 200 {object} map[string]interface{} "上传成功"
 // @Failure 400 {object} map[string]interface{} "请求错误"
 // @Failure 401 {object} map[string]interface{} "认证失败"
@@ -74,6 +96,13 @@ func (h *ContentHandler) UploadFile(c *gin.Context) {
 		})
 		return
 	}
+	if model.IsPrivateFileType(fileType) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    "INVALID_FILE_TYPE",
+			"message": "该类型文件不支持直接上传",
+		})
+		return
+	}
 
 	courseID, err := strconv.ParseUint(courseIDStr, 10, 32)
 	if err != nil {
@@ -89,6 +118,29 @@ func (h *ContentHandler) UploadFile(c *gin.Context) {
 		log.Printf("📸 检测到头像上传请求")
 	}
 
+	// 挂在章节下的资料随章节按期开放
+	var chapterID uint64
+	if chapterIDStr := c.PostForm("chapter_id"); chapterIDStr != "" {
+		chapterID, err = strconv.ParseUint(chapterIDStr, 10, 32)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"code":    "INVALID_CHAPTER_ID",
+				"message": "章节ID格式错误",
+			})
+			return
+		}
+	}
+	if chapterID != 0 {
+		chapter, err := h.courseClient.CheckChapterRelease(c.Request.Context(), uint(chapterID), userID.(uint))
+		if err != nil || chapter.CourseId != uint32(courseID) {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"code":    "INVALID_CHAPTER_ID",
+				"message": "章节不存在或不属于该课程",
+			})
+			return
+		}
+	}
+
 	// 获取上传文件
 	fileHeader, err := c.FormFile("file")
 	if err != nil {
@@ -100,7 +152,6 @@ func (h *ContentHandler) UploadFile(c *gin.Context) {
 	}
 
 	// 验证文件大小（限制50MB）
-	const maxFileSize = 50 * 1024 * 1024
 	if fileHeader.Size > maxFileSize {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"code":    "FILE_TOO_LARGE",
@@ -110,32 +161,12 @@ func (h *ContentHandler) UploadFile(c *gin.Context) {
 	}
 
 	// 验证文件类型
-	allowedTypes := map[string][]string{
-		"image":    {".jpg", ".jpeg", ".png", ".gif", ".bmp", ".webp"},
-		"video":    {".mp4", ".avi", ".mov", ".wmv", ".flv", ".webm"},
-		"document": {".pdf", ".doc", ".docx", ".ppt", ".pptx", ".txt", ".md"},
-		"audio":    {".mp3", ".wav", ".flac", ".aac", ".ogg"},
-		"other":    {}, // 允许所有类型
-	}
-
-	if fileType != "other" {
-		ext := strings.ToLower(filepath.Ext(fileHeader.Filename))
-		if validExts, exists := allowedTypes[fileType]; exists && len(validExts) > 0 {
-			isValid := false
-			for _, validExt := range validExts {
-				if ext == validExt {
-					isValid = true
-					break
-				}
-			}
-			if !isValid {
-				c.JSON(http.StatusBadRequest, gin.H{
-					"code":    "INVALID_FILE_TYPE",
-					"message": fmt.Sprintf("文件类型 %s 不支持 %s 格式", fileType, ext),
-				})
-				return
-			}
-		}
+	if err := validateFileExtension(fileType, fileHeader.Filename); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    "INVALID_FILE_TYPE",
+			"message": err.Error(),
+		})
+		return
 	}
 
 	// 读取文件数据
@@ -164,6 +195,7 @@ func (h *ContentHandler) UploadFile(c *gin.Context) {
 		FileData:   fileData,
 		FileType:   fileType,
 		CourseId:   uint32(courseID),
+		ChapterId:  uint32(chapterID),
 		UploaderId: uint32(userID.(uint)),
 	}
 
@@ -187,6 +219,7 @@ func (h *ContentHandler) UploadFile(c *gin.Context) {
 	}
 
 	log.Printf("✅ 文件上传成功: %s", fileHeader.Filename)
+	exposeFileURLs(resp.FileInfo)
 	c.JSON(http.StatusOK, gin.H{
 		"code":    "SUCCESS",
 		"message": "文件上传成功",
@@ -196,7 +229,7 @@ func (h *ContentHandler) UploadFile(c *gin.Context) {
 
 // GetFiles 获取文件列表
 // @Summary 获取文件列表
-// @Description 获取课程文件列表
+// @Description 获取课程文件列表，文件地址为鉴权下载接口，已切片的视频只返回播放列表地址；所属章节尚未开放的文件不返回访问地址
 // @Tags content
 // @Accept json
 // @Produce json
@@ -217,6 +250,14 @@ func (h *ContentHandler) GetFiles(c *gin.Context) {
 	pageStr := c.DefaultQuery("page", "1")
 	pageSizeStr := c.DefaultQuery("page_size", "20")
 
+	if model.IsPrivateFileType(fileType) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    "INVALID_FILE_TYPE",
+			"message": "该类型文件不在课程资料中公开",
+		})
+		return
+	}
+
 	// 转换参数
 	var courseID uint32 = 0
 	if courseIDStr != "" {
@@ -271,6 +312,9 @@ func (h *ContentHandler) GetFiles(c *gin.Context) {
 		return
 	}
 
+	exposeFileURLs(resp.Files...)
+	h.hideUnreleasedFiles(c, resp.Files, c.GetUint("userID"))
+
 	log.Printf("✅ 获取文件列表成功，共 %d 条记录", len(resp.Files))
 	c.JSON(http.StatusOK, gin.H{
 		"code":    "SUCCESS",
@@ -366,3 +410,710 @@ func (h *ContentHandler) DeleteFile(c *gin.Context) {
 		"message": "文件删除成功",
 	})
 }
+
+// GetHLSKey 获取HLS分片解密密钥
+// @Summary 获取HLS解密密钥
+// @Description 返回加密HLS视频的AES-128密钥，仅限上传者、课程讲师和已报名学员
+// @Tags content
+// @Produce octet-stream
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "文件ID"
+// @Success 200 {file} binary "16字节密钥"
+// @Failure 401 {object} map[string]interface{} "认证失败"
+// @Failure 403 {object} map[string]interface{} "权限不足"
+// @Failure 404 {object} map[string]interface{} "密钥不存在"
+// @Router /api/v1/content/files/{id}/hls/key [get]
+func (h *ContentHandler) GetHLSKey(c *gin.Context) {
+	// 获取用户ID
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"code":    "AUTH_REQUIRED",
+			"message": "用户未认证",
+		})
+		return
+	}
+	uid := userID.(uint)
+
+	fileIDStr := c.Param("id")
+	if _, err := strconv.ParseUint(fileIDStr, 10, 32); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    "INVALID_FILE_ID",
+			"message": "文件ID格式错误",
+		})
+		return
+	}
+
+	resp, err := h.contentClient.GetHLSKey(c.Request.Context(), &contentpb.GetHLSKeyRequest{FileId: fileIDStr})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"code":    "GET_KEY_FAILED",
+			"message": "获取密钥失败",
+			"error":   err.Error(),
+		})
+		return
+	}
+	if resp.Code != 200 {
+		status := http.StatusInternalServerError
+		if resp.Code == 404 {
+			status = http.StatusNotFound
+		} else if resp.Code == 400 {
+			status = http.StatusBadRequest
+		}
+		c.JSON(status, gin.H{
+			"code":    "GET_KEY_FAILED",
+			"message": resp.Message,
+		})
+		return
+	}
+
+	// 上传者直接放行，其余用户需有课程访问权限且章节已开放
+	if resp.UploaderId != uint32(uid) {
+		if !h.checkCourseAccess(c, resp.CourseId, uid, "GET_KEY_FAILED") || !h.checkChapterRelease(c, resp.ChapterId, uid) {
+			return
+		}
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.Data(http.StatusOK, "application/octet-stream", resp.Key)
+}
+
+// ReplaceFile 替换文件
+// @Summary 替换文件
+// @Description 上传新内容替换已有文件，文件ID不变，旧内容保留为历史版本（需要认证，只能替换自己上传的文件）
+// @Tags content
+// @Accept multipart/form-data
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "文件ID"
+// @Param file formData file true "新文件"
+// @Success 200 {object} map[string]interface{} "替换成功"
+// @Failure 400 {object} map[string]interface{} "请求错误"
+// @Failure 401 {object} map[string]interface{} "认证失败"
+// @Failure 403 {object} map[string]interface{} "权限不足"
+// @Failure 404 {object} map[string]interface{} "文件不存在"
+// @Router /api/v1/content/files/{id} [put]
+func (h *ContentHandler) ReplaceFile(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"code":    "AUTH_REQUIRED",
+			"message": "用户未认证",
+		})
+		return
+	}
+
+	fileIDStr := c.Param("id")
+	if _, err := strconv.ParseUint(fileIDStr, 10, 32); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    "INVALID_FILE_ID",
+			"message": "文件ID格式错误",
+		})
+		return
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    "MISSING_FILE",
+			"message": "请选择要上传的文件",
+		})
+		return
+	}
+	if fileHeader.Size > maxFileSize {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    "FILE_TOO_LARGE",
+			"message": "文件大小不能超过50MB",
+		})
+		return
+	}
+
+	// 新版本必须与原文件类型一致
+	current, err := h.contentClient.GetFile(c.Request.Context(), &contentpb.GetFileRequest{
+		FileId: fileIDStr,
+		UserId: uint32(userID.(uint)),
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"code":    "REPLACE_FAILED",
+			"message": "查询文件失败",
+			"error":   err.Error(),
+		})
+		return
+	}
+	if current.Code != 200 {
+		c.JSON(httpStatusFromCode(current.Code), gin.H{
+			"code":    "REPLACE_FAILED",
+			"message": current.Message,
+		})
+		return
+	}
+	if err := validateFileExtension(current.FileInfo.FileType, fileHeader.Filename); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    "INVALID_FILE_TYPE",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"code":    "FILE_READ_ERROR",
+			"message": "读取文件失败",
+		})
+		return
+	}
+	defer file.Close()
+
+	fileData, err := io.ReadAll(file)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"code":    "FILE_READ_ERROR",
+			"message": "读取文件数据失败",
+		})
+		return
+	}
+
+	resp, err := h.contentClient.ReplaceFile(c.Request.Context(), &contentpb.ReplaceFileRequest{
+		FileId:   fileIDStr,
+		FileName: fileHeader.Filename,
+		FileData: fileData,
+		UserId:   uint32(userID.(uint)),
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"code":    "REPLACE_FAILED",
+			"message": "文件替换失败",
+			"error":   err.Error(),
+		})
+		return
+	}
+	if resp.Code != 200 {
+		c.JSON(httpStatusFromCode(resp.Code), gin.H{
+			"code":    "REPLACE_FAILED",
+			"message": resp.Message,
+		})
+		return
+	}
+
+	log.Printf("✅ 文件替换成功: 文件ID=%s, 版本=%d", fileIDStr, resp.FileInfo.Version)
+	exposeFileURLs(resp.FileInfo)
+	c.JSON(http.StatusOK, gin.H{
+		"code":    "SUCCESS",
+		"message": "文件替换成功",
+		"data":    resp.FileInfo,
+	})
+}
+
+// ListFileVersions 获取文件版本列表
+// @Summary 获取文件版本列表
+// @Description 获取文件的全部历史版本（新版本在前），仅上传者和课程讲师可查看
+// @Tags content
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "文件ID"
+// @Success 200 {object} map[string]interface{} "获取成功"
+// @Failure 403 {object} map[string]interface{} "权限不足"
+// @Failure 404 {object} map[string]interface{} "文件不存在"
+// @Router /api/v1/content/files/{id}/versions [get]
+func (h *ContentHandler) ListFileVersions(c *gin.Context) {
+	fileIDStr := c.Param("id")
+	fileID, err := strconv.ParseUint(fileIDStr, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    "INVALID_FILE_ID",
+			"message": "文件ID格式错误",
+		})
+		return
+	}
+
+	resp, err := h.contentClient.ListFileVersions(c.Request.Context(), &contentpb.ListFileVersionsRequest{
+		FileId: fileIDStr,
+		UserId: uint32(c.GetUint("userID")),
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"code":    "GET_VERSIONS_FAILED",
+			"message": "获取文件版本失败",
+			"error":   err.Error(),
+		})
+		return
+	}
+	if resp.Code != 200 {
+		c.JSON(httpStatusFromCode(resp.Code), gin.H{
+			"code":    "GET_VERSIONS_FAILED",
+			"message": resp.Message,
+		})
+		return
+	}
+
+	// 历史版本通过下载接口的version参数获取
+	for _, v := range resp.Versions {
+		v.FileUrl = fmt.Sprintf(model.DownloadURLFormat+"?version=%d", fileID, v.Version)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    "SUCCESS",
+		"message": "获取文件版本成功",
+		"data": gin.H{
+			"current_version": resp.CurrentVersion,
+			"versions":        resp.Versions,
+		},
+	})
+}
+
+// RevertFileVersion 回滚文件版本
+// @Summary 回滚文件版本
+// @Description 以指定历史版本的内容生成一个新版本（需要认证，只能回滚自己上传的文件）
+// @Tags content
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "文件ID"
+// @Param version path int true "目标版本号"
+// @Success 200 {object} map[string]interface{} "回滚成功"
+// @Failure 400 {object} map[string]interface{} "请求错误"
+// @Failure 403 {object} map[string]interface{} "权限不足"
+// @Failure 404 {object} map[string]interface{} "版本不存在"
+// @Router /api/v1/content/files/{id}/versions/{version}/revert [post]
+func (h *ContentHandler) RevertFileVersion(c *gin.Context) {
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"code":    "AUTH_REQUIRED",
+			"message": "用户未认证",
+		})
+		return
+	}
+
+	fileIDStr := c.Param("id")
+	if _, err := strconv.ParseUint(fileIDStr, 10, 32); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    "INVALID_FILE_ID",
+			"message": "文件ID格式错误",
+		})
+		return
+	}
+	version, err := strconv.ParseUint(c.Param("version"), 10, 32)
+	if err != nil || version == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    "INVALID_VERSION",
+			"message": "版本号格式错误",
+		})
+		return
+	}
+
+	resp, err := h.contentClient.RevertFileVersion(c.Request.Context(), &contentpb.RevertFileVersionRequest{
+		FileId:  fileIDStr,
+		Version: uint32(version),
+		UserId:  uint32(userID.(uint)),
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"code":    "REVERT_FAILED",
+			"message": "文件回滚失败",
+			"error":   err.Error(),
+		})
+		return
+	}
+	if resp.Code != 200 {
+		c.JSON(httpStatusFromCode(resp.Code), gin.H{
+			"code":    "REVERT_FAILED",
+			"message": resp.Message,
+		})
+		return
+	}
+
+	exposeFileURLs(resp.FileInfo)
+	c.JSON(http.StatusOK, gin.H{
+		"code":    "SUCCESS",
+		"message": "文件回滚成功",
+		"data":    resp.FileInfo,
+	})
+}
+
+// DownloadFile 下载文件
+// @Summary 下载文件
+// @Description 下载文件的最新版本，可通过version参数下载历史版本（需要认证，上传者之外的用户需报名课程且章节已开放）
+// @Tags content
+// @Produce octet-stream
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "文件ID"
+// @Param version query int false "版本号（默认最新版本）"
+// @Success 200 {file} file "文件内容"
+// @Failure 403 {object} map[string]interface{} "未报名或章节未开放"
+// @Failure 404 {object} map[string]interface{} "文件不存在"
+// @Router /api/v1/content/files/{id}/download [get]
+func (h *ContentHandler) DownloadFile(c *gin.Context) {
+	fileIDStr := c.Param("id")
+	if _, err := strconv.ParseUint(fileIDStr, 10, 32); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    "INVALID_FILE_ID",
+			"message": "文件ID格式错误",
+		})
+		return
+	}
+
+	var version uint64
+	if versionStr := c.Query("version"); versionStr != "" {
+		v, err := strconv.ParseUint(versionStr, 10, 32)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"code":    "INVALID_VERSION",
+				"message": "版本号格式错误",
+			})
+			return
+		}
+		version = v
+	}
+
+	uid := c.GetUint("userID")
+	// 私有文件的访问权限由内容服务校验，无权访问时返回文件不存在
+	resp, err := h.contentClient.GetFile(c.Request.Context(), &contentpb.GetFileRequest{
+		FileId:  fileIDStr,
+		Version: uint32(version),
+		UserId:  uint32(uid),
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"code":    "DOWNLOAD_FAILED",
+			"message": "获取文件失败",
+			"error":   err.Error(),
+		})
+		return
+	}
+	if resp.Code != 200 {
+		c.JSON(httpStatusFromCode(resp.Code), gin.H{
+			"code":    "DOWNLOAD_FAILED",
+			"message": resp.Message,
+		})
+		return
+	}
+
+	// 上传者直接放行，其余用户需有课程访问权限且章节已开放
+	if resp.FileInfo.UploaderId != uint32(uid) {
+		if !h.checkCourseAccess(c, resp.FileInfo.CourseId, uid, "DOWNLOAD_FAILED") || !h.checkChapterRelease(c, resp.FileInfo.ChapterId, uid) {
+			return
+		}
+
+		// 已切片的视频只提供HLS播放，源文件和历史版本仅上传者和讲师可下载
+		message := ""
+		if version != 0 {
+			message = "历史版本仅上传者和课程讲师可下载"
+		} else if resp.FileInfo.HlsStatus == model.HLSStatusReady {
+			message = "该视频仅支持在线播放"
+		}
+		if message != "" && !h.isCourseInstructor(c, resp.FileInfo.CourseId, uid) {
+			c.JSON(http.StatusForbidden, gin.H{
+				"code":    "DOWNLOAD_FORBIDDEN",
+				"message": message,
+			})
+			return
+		}
+	}
+
+	serveUploadedFile(c, resp.FileInfo.FileUrl, resp.FileInfo.FileName, "DOWNLOAD_FAILED")
+}
+
+// GetHLSFile 获取HLS播放列表或分片
+// @Summary 获取HLS播放文件
+// @Description 返回已切片视频的m3u8播放列表或TS分片（需要认证，上传者之外的用户需报名课程且章节已开放）
+// @Tags content
+// @Produce octet-stream
+// @Param Authorization header string true "Bearer token"
+// @Param id path string true "文件ID"
+// @Param name path string true "index.m3u8 或分片文件名"
+// @Success 200 {file} file "播放列表或分片"
+// @Failure 403 {object} map[string]interface{} "未报名或章节未开放"
+// @Failure 404 {object} map[string]interface{} "文件不存在"
+// @Router /api/v1/content/files/{id}/hls/{name} [get]
+func (h *ContentHandler) GetHLSFile(c *gin.Context) {
+	fileIDStr := c.Param("id")
+	if _, err := strconv.ParseUint(fileIDStr, 10, 32); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    "INVALID_FILE_ID",
+			"message": "文件ID格式错误",
+		})
+		return
+	}
+	name := c.Param("name")
+	if !hls.IsOutputName(name) {
+		c.JSON(http.StatusNotFound, gin.H{
+			"code":    "GET_HLS_FAILED",
+			"message": "文件不存在",
+		})
+		return
+	}
+
+	uid := c.GetUint("userID")
+	resp, err := h.contentClient.GetFile(c.Request.Context(), &contentpb.GetFileRequest{
+		FileId: fileIDStr,
+		UserId: uint32(uid),
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"code":    "GET_HLS_FAILED",
+			"message": "获取文件失败",
+			"error":   err.Error(),
+		})
+		return
+	}
+	if resp.Code != 200 {
+		c.JSON(httpStatusFromCode(resp.Code), gin.H{
+			"code":    "GET_HLS_FAILED",
+			"message": resp.Message,
+		})
+		return
+	}
+	if resp.FileInfo.HlsStatus != model.HLSStatusReady {
+		c.JSON(http.StatusNotFound, gin.H{
+			"code":    "GET_HLS_FAILED",
+			"message": "视频尚未完成切片",
+		})
+		return
+	}
+
+	// 上传者直接放行，其余用户需有课程访问权限且章节已开放
+	if resp.FileInfo.UploaderId != uint32(uid) {
+		if !h.checkCourseAccess(c, resp.FileInfo.CourseId, uid, "GET_HLS_FAILED") || !h.checkChapterRelease(c, resp.FileInfo.ChapterId, uid) {
+			return
+		}
+	}
+
+	sourcePath, err := configs.GetStaticPathConfig().UploadPath(resp.FileInfo.FileUrl)
+	if err == nil {
+		_, err = os.Stat(filepath.Join(hls.OutputDir(sourcePath), name))
+	}
+	if err != nil {
+		log.Printf("❌ 读取HLS文件失败: %v", err)
+		c.JSON(http.StatusNotFound, gin.H{
+			"code":    "GET_HLS_FAILED",
+			"message": "文件不存在",
+		})
+		return
+	}
+
+	if name == hls.PlaylistName {
+		c.Header("Content-Type", "application/vnd.apple.mpegurl")
+	} else {
+		c.Header("Content-Type", "video/mp2t")
+	}
+	c.Header("Cache-Control", "private, no-store")
+	c.File(filepath.Join(hls.OutputDir(sourcePath), name))
+}
+
+// ServePublicUpload 提供可公开访问的上传文件（头像和课程封面）
+// 课程资料、视频及其分片、私有文件都不经静态目录公开，只能通过鉴权接口获取；
+// 早期上传到课程目录的封面图片仍可访问，但仅限课程当前使用的封面
+func (h *ContentHandler) ServePublicUpload(c *gin.Context) {
+	rel := strings.TrimPrefix(path.Clean("/"+c.Param("filepath")), "/")
+	dir, name := path.Split(rel)
+	dir = strings.TrimSuffix(dir, "/")
+
+	public := false
+	switch {
+	case name == "":
+	case dir == model.AvatarDir || dir == model.CoverDir:
+		public = true
+	case strings.HasPrefix(dir, "course_") && validateFileExtension("image", name) == nil:
+		public = h.isCourseCover(c, dir, rel)
+	}
+	if !public {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+
+	filePath := filepath.Join(configs.GetStaticPathConfig().UploadsDir, filepath.FromSlash(rel))
+	if info, err := os.Stat(filePath); err != nil || info.IsDir() {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+	c.File(filePath)
+}
+
+// isCourseCover 判断课程目录（course_N）下的图片是否为该课程当前的封面
+func (h *ContentHandler) isCourseCover(c *gin.Context, dir, rel string) bool {
+	courseID, err := strconv.ParseUint(strings.TrimPrefix(dir, "course_"), 10, 32)
+	if err != nil || courseID == 0 {
+		return false
+	}
+
+	resp, err := h.courseClient.GetCourse(c.Request.Context(), uint(courseID), 0)
+	if err != nil || resp.Course == nil {
+		return false
+	}
+	return resp.Course.CoverImage != "" && strings.HasSuffix(resp.Course.CoverImage, "/uploads/"+rel)
+}
+
+// isCourseInstructor 判断用户是否为课程讲师
+func (h *ContentHandler) isCourseInstructor(c *gin.Context, courseID uint32, userID uint) bool {
+	if courseID == 0 {
+		return false
+	}
+
+	resp, err := h.courseClient.GetCourse(c.Request.Context(), uint(courseID), userID)
+	if err != nil || resp.Course == nil {
+		if err != nil {
+			log.Printf("❌ 查询课程讲师失败: %v", err)
+		}
+		return false
+	}
+	return resp.Course.InstructorId == uint32(userID)
+}
+
+// exposeFileURLs 将文件地址替换为经鉴权的访问接口，头像和封面保留公开地址
+// 已完成HLS切片的视频不返回源文件地址，只能通过播放列表观看
+func exposeFileURLs(files ...*contentpb.FileInfo) {
+	for _, file := range files {
+		if file == nil || file.FileType == model.FileTypeCover || (file.CourseId == 0 && file.FileType == "image") {
+			continue
+		}
+
+		fileID, err := strconv.ParseUint(file.FileId, 10, 32)
+		if err != nil {
+			continue
+		}
+		file.FileUrl = fmt.Sprintf(model.DownloadURLFormat, fileID)
+		file.HlsPlaylistUrl = ""
+		if file.HlsStatus == model.HLSStatusReady {
+			file.FileUrl = ""
+			file.HlsPlaylistUrl = fmt.Sprintf(model.HLSURLFormat, fileID) + hls.PlaylistName
+		}
+	}
+}
+
+// checkCourseAccess 检查用户是否报名了课程或为课程讲师，无权限时直接返回403
+// 课程ID为0的文件（头像、数据导出）不属于任何课程，由内容服务按上传者校验
+func (h *ContentHandler) checkCourseAccess(c *gin.Context, courseID uint32, userID uint, errorCode string) bool {
+	if courseID == 0 {
+		return true
+	}
+
+	hasAccess, err := h.courseClient.CheckCourseAccess(c.Request.Context(), uint(courseID), userID)
+	if err != nil {
+		log.Printf("❌ 检查课程访问权限失败: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"code":    errorCode,
+			"message": "检查课程访问权限失败",
+		})
+		return false
+	}
+	if !hasAccess {
+		c.JSON(http.StatusForbidden, gin.H{
+			"code":    "NOT_ENROLLED",
+			"message": "请先报名该课程",
+		})
+		return false
+	}
+	return true
+}
+
+// serveUploadedFile 从上传目录读取文件并以附件形式返回，不暴露静态文件地址
+func serveUploadedFile(c *gin.Context, fileURL, fileName, errorCode string) {
+	path, err := configs.GetStaticPathConfig().UploadPath(fileURL)
+	if err == nil {
+		_, err = os.Stat(path)
+	}
+	if err != nil {
+		log.Printf("❌ 读取上传文件失败: %v", err)
+		c.JSON(http.StatusNotFound, gin.H{
+			"code":    errorCode,
+			"message": "文件不存在",
+		})
+		return
+	}
+
+	c.Header("Cache-Control", "private, no-store")
+	c.FileAttachment(path, fileName)
+}
+
+// checkChapterRelease 检查文件所属章节是否已对用户开放，未开放时直接返回403
+func (h *ContentHandler) checkChapterRelease(c *gin.Context, chapterID uint32, userID uint) bool {
+	if chapterID == 0 {
+		return true
+	}
+
+	chapter, err := h.courseClient.CheckChapterRelease(c.Request.Context(), uint(chapterID), userID)
+	if err != nil {
+		log.Printf("❌ 检查章节开放状态失败: %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"code":    "CHECK_RELEASE_FAILED",
+			"message": "检查章节开放状态失败",
+		})
+		return false
+	}
+	if chapter.Released {
+		return true
+	}
+
+	message := "该章节尚未开放"
+	if chapter.UnlockAt != "" {
+		if unlockAt, err := time.Parse(time.RFC3339, chapter.UnlockAt); err == nil {
+			message = fmt.Sprintf("该章节将于 %s 开放", unlockAt.Local().Format("2006-01-02 15:04"))
+		}
+	} else if chapter.ReleaseType == "after_enrollment" {
+		message = fmt.Sprintf("该章节在报名后第 %d 天开放", chapter.ReleaseAfterDays)
+	}
+	c.JSON(http.StatusForbidden, gin.H{
+		"code":    "CHAPTER_LOCKED",
+		"message": message,
+		"data": gin.H{
+			"chapter_id": chapter.Id,
+			"unlock_at":  chapter.UnlockAt,
+		},
+	})
+	return false
+}
+
+// hideUnreleasedFiles 清空尚未开放章节下文件的访问地址（上传者本人除外）
+// 仅用于列表展示，真正的限制在下载、HLS播放列表/分片和密钥接口中校验；
+// 课程目录不经静态路径公开，因此隐藏地址后无法再绕过开放时间直接访问文件
+func (h *ContentHandler) hideUnreleasedFiles(c *gin.Context, files []*contentpb.FileInfo, userID uint) {
+	released := make(map[uint32]bool)
+	for _, file := range files {
+		if file.ChapterId == 0 || file.UploaderId == uint32(userID) {
+			continue
+		}
+
+		ok, checked := released[file.ChapterId]
+		if !checked {
+			chapter, err := h.courseClient.CheckChapterRelease(c.Request.Context(), uint(file.ChapterId), userID)
+			ok = err == nil && chapter.Released
+			released[file.ChapterId] = ok
+		}
+		if !ok {
+			file.FileUrl = ""
+			file.HlsPlaylistUrl = ""
+		}
+	}
+}
+
+// validateFileExtension 校验文件扩展名是否符合文件类型
+func validateFileExtension(fileType, fileName string) error {
+	validExts := allowedExtensions[fileType]
+	if len(validExts) == 0 {
+		return nil
+	}
+
+	ext := strings.ToLower(filepath.Ext(fileName))
+	for _, validExt := range validExts {
+		if ext == validExt {
+			return nil
+		}
+	}
+	return fmt.Errorf("文件类型 %s 不支持 %s 格式", fileType, ext)
+}
+
+// httpStatusFromCode 将内容服务响应码转换为HTTP状态码
+func httpStatusFromCode(code int32) int {
+	switch code {
+	case 400:
+		return http.StatusBadRequest
+	case 403:
+		return http.StatusForbidden
+	case 404:
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -54,16 +54,23 @@ func (f *FileInfo) IsImage() bool {
 // File 课程文件模型 (用于内容服务)
 type File struct {
 	ID         uint      `gorm:"primaryKey" json:"id"`
-	FileName   string    `gorm:"size:255;not null" json:"file_name"` // 文件名
-	FilePath   string    `gorm:"size:500;not null" json:"file_path"` // 文件路径
-	FileURL    string    `gorm:"size:500" json:"file_url"`           // 文件访问URL
-	FileSize   int64     `gorm:"not null" json:"file_size"`          // 文件大小
-	FileType   string    `gorm:"size:50;not null" json:"file_type"`  // 文件类型 (image, video, document, etc.)
-	CourseID   uint      `gorm:"not null;index" json:"course_id"`    // 关联课程ID
-	UploaderID uint      `gorm:"not null;index" json:"uploader_id"`  // 上传者ID
-	UploadTime time.Time `gorm:"autoCreateTime" json:"upload_time"`  // 上传时间
+	FileName   string    `gorm:"size:255;not null" json:"file_name"`         // 文件名
+	FilePath   string    `gorm:"size:500;not null" json:"file_path"`         // 文件路径
+	FileURL    string    `gorm:"size:500" json:"file_url"`                   // 文件访问URL
+	FileSize   int64     `gorm:"not null" json:"file_size"`                  // 文件大小
+	FileType   string    `gorm:"size:50;not null" json:"file_type"`          // 文件类型 (image, video, document, etc.)
+	CourseID   uint      `gorm:"not null;index" json:"course_id"`            // 关联课程ID
+	ChapterID  uint      `gorm:"not null;default:0;index" json:"chapter_id"` // 所属章节ID，0表示课程通用资料（始终开放）
+	UploaderID uint      `gorm:"not null;index" json:"uploader_id"`          // 上传者ID
+	UploadTime time.Time `gorm:"autoCreateTime" json:"upload_time"`          // 上传时间
 	CreatedAt  time.Time `gorm:"autoCreateTime" json:"created_at"`
 	UpdatedAt  time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+	Version    int       `gorm:"not null;default:1" json:"version"` // 当前（最新）版本号
+
+	// HLS切片字段（仅MP4视频）
+	HLSStatus      string `gorm:"size:20;default:'none'" json:"hls_status"` // 切片状态 (none/pending/processing/ready/failed/unsupported)
+	HLSPlaylistURL string `gorm:"size:500" json:"hls_playlist_url"`         // m3u8播放列表URL
+	HLSEncrypted   bool   `gorm:"default:false" json:"hls_encrypted"`       // 分片是否AES-128加密
 }
 
 // TableName 指定表名
@@ -71,6 +78,78 @@ func (File) TableName() string {
 	return "course_files"
 }
 
+// FileVersion 课程文件历史版本
+// 每次上传、替换或回滚都会追加一条记录，File 始终指向最新版本
+type FileVersion struct {
+	ID           uint      `gorm:"primaryKey" json:"id"`
+	FileID       uint      `gorm:"not null;uniqueIndex:idx_file_version" json:"file_id"` // 逻辑文件ID
+	Version      int       `gorm:"not null;uniqueIndex:idx_file_version" json:"version"` // 版本号，从1递增
+	FileName     string    `gorm:"size:255;not null" json:"file_name"`                   // 文件名
+	FilePath     string    `gorm:"size:500;not null" json:"file_path"`                   // 文件路径
+	FileURL      string    `gorm:"size:500" json:"file_url"`                             // 文件访问URL
+	FileSize     int64     `gorm:"not null" json:"file_size"`                            // 文件大小
+	UploaderID   uint      `gorm:"not null" json:"uploader_id"`                          // 操作者ID
+	RevertedFrom int       `gorm:"default:0" json:"reverted_from"`                       // 回滚来源版本，0表示新上传
+	CreatedAt    time.Time `gorm:"autoCreateTime" json:"created_at"`
+}
+
+// TableName 指定表名
+func (FileVersion) TableName() string {
+	return "course_file_versions"
+}
+
+// 系统生成或私有的文件类型，不出现在课程资料列表中
+const (
+	FileTypeSubmission  = "submission"  // 作业附件，仅通过作业提交接口上传
+	FileTypeCertificate = "certificate" // 结业证书，由课程服务生成
+	FileTypeExport      = "export"      // 个人数据导出压缩包，只有本人可以下载
+)
+
+// IsPrivateFileType 判断文件类型是否不属于公开的课程资料
+func IsPrivateFileType(fileType string) bool {
+	return fileType == FileTypeSubmission || fileType == FileTypeCertificate || fileType == FileTypeExport
+}
+
+// FileTypeCover 课程封面图片，存放在公开目录中，无需登录即可访问
+const FileTypeCover = "cover"
+
+// 公开访问的上传子目录，其余文件只能经API网关鉴权后下载
+const (
+	AvatarDir = "avatars" // 用户头像（course_id为0的图片）
+	CoverDir  = "covers"  // 课程封面
+)
+
+// 经API网关鉴权后访问文件的地址模板，%d 为文件ID
+const (
+	DownloadURLFormat = "/api/v1/content/files/%d/download"
+	HLSURLFormat      = "/api/v1/content/files/%d/hls/"
+)
+
+// HLS切片状态
+const (
+	HLSStatusNone        = "none"
+	HLSStatusPending     = "pending"
+	HLSStatusProcessing  = "processing"
+	HLSStatusReady       = "ready"
+	HLSStatusFailed      = "failed"
+	HLSStatusUnsupported = "unsupported"
+)
+
+// HLSKey HLS分片加密密钥
+// 不进入文件缓存，只能通过鉴权后的密钥接口获取
+type HLSKey struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	FileID    uint      `gorm:"not null;uniqueIndex:idx_hls_key_file_version" json:"file_id"` // 关联文件ID
+	Version   int       `gorm:"not null;uniqueIndex:idx_hls_key_file_version" json:"version"` // 对应的文件版本
+	Key       []byte    `gorm:"type:varbinary(16);not null" json:"-"`                         // AES-128密钥
+	CreatedAt time.Time `gorm:"autoCreateTime" json:"created_at"`
+}
+
+// TableName 指定表名
+func (HLSKey) TableName() string {
+	return "hls_keys"
+}
+
 // FileFilter 文件过滤器
 type FileFilter struct {
 	CourseID   uint   `json:"course_id"`
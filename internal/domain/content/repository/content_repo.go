@@ -12,6 +12,7 @@ import (
 
 	"github.com/go-redis/redis/v8"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // ContentRepository 内容仓库接口
@@ -22,6 +23,14 @@ type ContentRepository interface {
 	UpdateFile(ctx context.Context, file *model.File) error
 	DeleteFile(ctx context.Context, id uint) error
 	GetFilesByCourse(ctx context.Context, courseID uint, fileType string, page, pageSize int) ([]model.File, int64, error)
+	SaveHLSKey(ctx context.Context, key *model.HLSKey) error
+	GetHLSKey(ctx context.Context, fileID uint, version int) (*model.HLSKey, error)
+	UpdateFileHLS(ctx context.Context, file *model.File) error
+	CreateFileVersion(ctx context.Context, version *model.FileVersion) error
+	GetFileVersions(ctx context.Context, fileID uint) ([]model.FileVersion, error)
+	GetFileVersion(ctx context.Context, fileID uint, version int) (*model.FileVersion, error)
+	ListFilesByUploader(ctx context.Context, uploaderID uint) ([]model.File, error)
+	TransferUploader(ctx context.Context, courseIDs []uint, fromUserID, toUserID uint) (int64, error)
 }
 
 // contentRepository 内容仓库实现
@@ -75,6 +84,9 @@ func (r *contentRepository) GetFilesByFilter(ctx context.Context, filter *model.
 	}
 	if filter.FileType != "" {
 		query = query.Where("file_type = ?", filter.FileType)
+	} else {
+		// 作业附件、证书和数据导出不属于课程资料
+		query = query.Where("file_type NOT IN ?", []string{model.FileTypeSubmission, model.FileTypeCertificate, model.FileTypeExport})
 	}
 	if filter.UploaderID != 0 {
 		query = query.Where("uploader_id = ?", filter.UploaderID)
@@ -152,6 +164,27 @@ func (r *contentRepository) UpdateFile(ctx context.Context, file *model.File) er
 	return nil
 }
 
+// UpdateFileHLS 更新文件的HLS字段
+// 仅当文件仍处于同一版本时生效，避免旧版本的切片任务覆盖新版本状态
+func (r *contentRepository) UpdateFileHLS(ctx context.Context, file *model.File) error {
+	err := r.db.WithContext(ctx).Model(&model.File{}).
+		Where("id = ? AND version = ?", file.ID, file.Version).
+		Updates(map[string]interface{}{
+			"hls_status":       file.HLSStatus,
+			"hls_playlist_url": file.HLSPlaylistURL,
+			"hls_encrypted":    file.HLSEncrypted,
+		}).Error
+	if err != nil {
+		log.Printf("❌ 更新文件HLS状态失败: %v", err)
+		return fmt.Errorf("更新文件HLS状态失败: %w", err)
+	}
+
+	// 清除缓存
+	r.redis.Del(ctx, fmt.Sprintf("file:%d", file.ID))
+	r.clearFileCache(ctx, file.CourseID)
+	return nil
+}
+
 // DeleteFile 删除文件记录
 func (r *contentRepository) DeleteFile(ctx context.Context, id uint) error {
 	var file model.File
@@ -167,6 +200,14 @@ func (r *contentRepository) DeleteFile(ctx context.Context, id uint) error {
 		return fmt.Errorf("删除文件失败: %w", err)
 	}
 
+	// 同时删除HLS密钥和历史版本
+	if err := r.db.WithContext(ctx).Where("file_id = ?", id).Delete(&model.HLSKey{}).Error; err != nil {
+		log.Printf("⚠️ 删除HLS密钥失败: %v", err)
+	}
+	if err := r.db.WithContext(ctx).Where("file_id = ?", id).Delete(&model.FileVersion{}).Error; err != nil {
+		log.Printf("⚠️ 删除文件历史版本失败: %v", err)
+	}
+
 	// 清除缓存
 	r.redis.Del(ctx, fmt.Sprintf("file:%d", id))
 	r.clearFileCache(ctx, file.CourseID)
@@ -187,6 +228,113 @@ func (r *contentRepository) GetFilesByCourse(ctx context.Context, courseID uint,
 	return r.GetFilesByFilter(ctx, filter)
 }
 
+// SaveHLSKey 保存文件的HLS密钥（已存在则覆盖）
+func (r *contentRepository) SaveHLSKey(ctx context.Context, key *model.HLSKey) error {
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "file_id"}, {Name: "version"}},
+		DoUpdates: clause.AssignmentColumns([]string{"key"}),
+	}).Create(key).Error
+	if err != nil {
+		log.Printf("❌ 保存HLS密钥失败: %v", err)
+		return fmt.Errorf("保存HLS密钥失败: %w", err)
+	}
+	return nil
+}
+
+// GetHLSKey 获取文件指定版本的HLS密钥
+func (r *contentRepository) GetHLSKey(ctx context.Context, fileID uint, version int) (*model.HLSKey, error) {
+	var key model.HLSKey
+	if err := r.db.WithContext(ctx).Where("file_id = ? AND version = ?", fileID, version).First(&key).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("HLS密钥不存在")
+		}
+		return nil, fmt.Errorf("查询HLS密钥失败: %w", err)
+	}
+	return &key, nil
+}
+
+// CreateFileVersion 创建文件版本记录
+func (r *contentRepository) CreateFileVersion(ctx context.Context, version *model.FileVersion) error {
+	if err := r.db.WithContext(ctx).Create(version).Error; err != nil {
+		log.Printf("❌ 创建文件版本失败: %v", err)
+		return fmt.Errorf("创建文件版本失败: %w", err)
+	}
+	return nil
+}
+
+// GetFileVersions 获取文件的全部版本（新版本在前）
+func (r *contentRepository) GetFileVersions(ctx context.Context, fileID uint) ([]model.FileVersion, error) {
+	var versions []model.FileVersion
+	if err := r.db.WithContext(ctx).Where("file_id = ?", fileID).
+		Order("version DESC").Find(&versions).Error; err != nil {
+		log.Printf("❌ 查询文件版本失败: %v", err)
+		return nil, fmt.Errorf("查询文件版本失败: %w", err)
+	}
+	return versions, nil
+}
+
+// GetFileVersion 获取文件的指定版本
+func (r *contentRepository) GetFileVersion(ctx context.Context, fileID uint, version int) (*model.FileVersion, error) {
+	var fileVersion model.FileVersion
+	if err := r.db.WithContext(ctx).Where("file_id = ? AND version = ?", fileID, version).
+		First(&fileVersion).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("文件版本不存在")
+		}
+		return nil, fmt.Errorf("查询文件版本失败: %w", err)
+	}
+	return &fileVersion, nil
+}
+
+// ListFilesByUploader 获取用户上传的全部文件（包含作业附件、证书等私有文件），不走缓存
+func (r *contentRepository) ListFilesByUploader(ctx context.Context, uploaderID uint) ([]model.File, error) {
+	var files []model.File
+	if err := r.db.WithContext(ctx).Where("uploader_id = ?", uploaderID).
+		Order("id ASC").Find(&files).Error; err != nil {
+		log.Printf("❌ 查询用户上传文件失败: %v", err)
+		return nil, fmt.Errorf("查询用户上传文件失败: %w", err)
+	}
+	return files, nil
+}
+
+// TransferUploader 把指定课程中 fromUserID 上传的文件（含历史版本）改为 toUserID 上传
+func (r *contentRepository) TransferUploader(ctx context.Context, courseIDs []uint, fromUserID, toUserID uint) (int64, error) {
+	if len(courseIDs) == 0 {
+		return 0, nil
+	}
+
+	var fileIDs []uint
+	if err := r.db.WithContext(ctx).Model(&model.File{}).
+		Where("course_id IN ? AND uploader_id = ?", courseIDs, fromUserID).
+		Pluck("id", &fileIDs).Error; err != nil {
+		return 0, fmt.Errorf("查询课程文件失败: %w", err)
+	}
+	if len(fileIDs) == 0 {
+		return 0, nil
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&model.File{}).Where("id IN ?", fileIDs).
+			Update("uploader_id", toUserID).Error; err != nil {
+			return err
+		}
+		return tx.Model(&model.FileVersion{}).Where("file_id IN ? AND uploader_id = ?", fileIDs, fromUserID).
+			Update("uploader_id", toUserID).Error
+	})
+	if err != nil {
+		log.Printf("❌ 转交课程文件失败: %v", err)
+		return 0, fmt.Errorf("转交课程文件失败: %w", err)
+	}
+
+	for _, id := range fileIDs {
+		r.redis.Del(ctx, fmt.Sprintf("file:%d", id))
+	}
+	for _, courseID := range courseIDs {
+		r.clearFileCache(ctx, courseID)
+	}
+	return int64(len(fileIDs)), nil
+}
+
 // buildFilterCacheKey 构建过滤器缓存键
 func (r *contentRepository) buildFilterCacheKey(filter *model.FileFilter) string {
 	parts := []string{"files"}
@@ -3,6 +3,8 @@ package service
 import (
 	"context"
 	"crypto/md5"
+	"crypto/rand"
+	"errors"
 	"fmt"
 	"io"
 	"log"
@@ -14,6 +16,8 @@ import (
 
 	"course-platform/internal/domain/content/model"
 	"course-platform/internal/domain/content/repository"
+	courseRepository "course-platform/internal/domain/course/repository"
+	"course-platform/internal/infrastructure/hls"
 )
 
 // ContentService 内容服务接口
@@ -23,6 +27,23 @@ type ContentService interface {
 	GetFileById(ctx context.Context, id uint) (*model.File, error)
 	DeleteFile(ctx context.Context, fileID, userID uint) error
 	GetFilesByCourse(ctx context.Context, courseID uint, fileType string, page, pageSize int) ([]model.File, int64, error)
+	GetHLSKey(ctx context.Context, fileID uint) (*model.File, []byte, error)
+	GetFileVersion(ctx context.Context, fileID uint, version int, userID uint) (*model.File, error)
+	ReplaceFile(ctx context.Context, req *ReplaceFileRequest) (*model.File, error)
+	ListFileVersions(ctx context.Context, fileID, userID uint) (*model.File, []model.FileVersion, error)
+	RevertFileVersion(ctx context.Context, fileID, userID uint, version int) (*model.File, error)
+
+	// 个人数据导出与账号注销
+	BuildUserArchive(ctx context.Context, userID uint, fileName string, entries []ArchiveEntry) (*model.File, int, error)
+	PurgeUserFiles(ctx context.Context, userID uint, transferCourseIDs []uint, transferTo uint) (int, int64, error)
+}
+
+// HLSOptions 视频HLS切片配置
+type HLSOptions struct {
+	Enabled         bool          // 是否在上传MP4后自动切片
+	Encrypt         bool          // 是否使用AES-128加密分片
+	SegmentDuration time.Duration // 目标分片时长
+	KeyURLFormat    string        // 密钥地址模板，%d 为文件ID
 }
 
 // UploadFileRequest 文件上传请求
@@ -32,22 +53,35 @@ type UploadFileRequest struct {
 	FileName   string                // 文件名
 	FileType   string                // 文件类型
 	CourseID   uint                  // 课程ID
+	ChapterID  uint                  // 所属章节ID，0表示课程通用资料
 	UploaderID uint                  // 上传者ID
 }
 
+// ReplaceFileRequest 替换文件请求
+type ReplaceFileRequest struct {
+	FileID   uint   // 逻辑文件ID
+	FileName string // 新文件名
+	FileData []byte // 新文件内容
+	UserID   uint   // 操作者ID
+}
+
 // contentService 内容服务实现
 type contentService struct {
-	repo      repository.ContentRepository
-	uploadDir string // 文件上传目录
-	baseURL   string // 文件访问基础URL
+	repo       repository.ContentRepository
+	courseRepo courseRepository.CourseRepositoryInterface // 查询课程讲师，用于私有文件鉴权
+	uploadDir  string                                     // 文件上传目录
+	baseURL    string                                     // 文件访问基础URL
+	hls        HLSOptions                                 // HLS切片配置
 }
 
 // NewContentService 创建内容服务实例
-func NewContentService(repo repository.ContentRepository, uploadDir, baseURL string) ContentService {
+func NewContentService(repo repository.ContentRepository, courseRepo courseRepository.CourseRepositoryInterface, uploadDir, baseURL string, hlsOptions HLSOptions) ContentService {
 	return &contentService{
-		repo:      repo,
-		uploadDir: uploadDir,
-		baseURL:   baseURL,
+		repo:       repo,
+		courseRepo: courseRepo,
+		uploadDir:  uploadDir,
+		baseURL:    baseURL,
+		hls:        hlsOptions,
 	}
 }
 
@@ -86,7 +120,7 @@ func (s *contentService) UploadFile(ctx context.Context, req *UploadFileRequest)
 	}
 
 	// 生成文件路径和URL
-	filePath, fileURL, err := s.generateFilePath(req.FileName, req.CourseID)
+	filePath, fileURL, err := s.generateFilePath(req.FileName, req.FileType, req.CourseID)
 	if err != nil {
 		return nil, fmt.Errorf("生成文件路径失败: %w", err)
 	}
@@ -104,8 +138,14 @@ func (s *contentService) UploadFile(ctx context.Context, req *UploadFileRequest)
 		FileSize:   fileSize,
 		FileType:   req.FileType,
 		CourseID:   req.CourseID,
+		ChapterID:  req.ChapterID,
 		UploaderID: req.UploaderID,
 		UploadTime: time.Now(),
+		Version:    1,
+		HLSStatus:  model.HLSStatusNone,
+	}
+	if s.shouldPackageHLS(file) {
+		file.HLSStatus = model.HLSStatusPending
 	}
 
 	if err := s.repo.CreateFile(ctx, file); err != nil {
@@ -114,12 +154,27 @@ func (s *contentService) UploadFile(ctx context.Context, req *UploadFileRequest)
 		return nil, fmt.Errorf("保存文件记录失败: %w", err)
 	}
 
+	// 记录首个版本
+	if err := s.repo.CreateFileVersion(ctx, newFileVersion(file, req.UploaderID, 0)); err != nil {
+		log.Printf("⚠️ 记录文件初始版本失败: %v", err)
+	}
+
+	// 后台切片，不阻塞上传请求
+	if file.HLSStatus == model.HLSStatusPending {
+		go s.packageHLS(*file)
+	}
+
 	log.Printf("✅ 成功上传文件: %s, 大小: %d 字节", req.FileName, fileSize)
 	return file, nil
 }
 
 // GetFiles 获取文件列表
 func (s *contentService) GetFiles(ctx context.Context, filter *model.FileFilter) ([]model.File, int64, error) {
+	// 私有文件不通过列表公开，只能由有权限者按ID获取
+	if model.IsPrivateFileType(filter.FileType) {
+		return nil, 0, fmt.Errorf("该类型文件不在课程资料中公开")
+	}
+
 	// 设置默认分页参数
 	if filter.Page <= 0 {
 		filter.Page = 1
@@ -163,15 +218,33 @@ func (s *contentService) DeleteFile(ctx context.Context, fileID, userID uint) er
 		return fmt.Errorf("无权限删除此文件")
 	}
 
+	// 收集所有版本的磁盘文件（需在删除记录前查询）
+	paths := []string{file.FilePath}
+	if versions, err := s.repo.GetFileVersions(ctx, fileID); err == nil {
+		for _, v := range versions {
+			paths = append(paths, v.FilePath)
+		}
+	}
+
 	// 删除数据库记录
 	if err := s.repo.DeleteFile(ctx, fileID); err != nil {
 		return fmt.Errorf("删除文件记录失败: %w", err)
 	}
 
-	// 删除磁盘文件
-	if err := os.Remove(file.FilePath); err != nil {
-		log.Printf("⚠️ 删除磁盘文件失败: %v", err)
-		// 不返回错误，因为数据库记录已删除
+	// 删除磁盘文件（回滚产生的版本会复用旧文件，需去重）
+	removed := make(map[string]bool)
+	for _, path := range paths {
+		if removed[path] {
+			continue
+		}
+		removed[path] = true
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			log.Printf("⚠️ 删除磁盘文件失败: %v", err)
+			// 不返回错误，因为数据库记录已删除
+		}
+		if err := os.RemoveAll(hls.OutputDir(path)); err != nil {
+			log.Printf("⚠️ 删除HLS分片失败: %v", err)
+		}
 	}
 
 	log.Printf("✅ 成功删除文件: %s", file.FileName)
@@ -183,6 +256,316 @@ func (s *contentService) GetFilesByCourse(ctx context.Context, courseID uint, fi
 	return s.repo.GetFilesByCourse(ctx, courseID, fileType, page, pageSize)
 }
 
+// GetHLSKey 获取文件的HLS解密密钥，访问权限由调用方校验
+func (s *contentService) GetHLSKey(ctx context.Context, fileID uint) (*model.File, []byte, error) {
+	file, err := s.repo.GetFileById(ctx, fileID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("查询文件失败: %w", err)
+	}
+	if !file.HLSEncrypted {
+		return nil, nil, fmt.Errorf("文件未启用HLS加密")
+	}
+
+	key, err := s.repo.GetHLSKey(ctx, fileID, file.Version)
+	if err != nil {
+		return nil, nil, err
+	}
+	return file, key.Key, nil
+}
+
+// GetFileVersion 获取文件指定版本的信息，version为0时返回最新版本
+// 作业附件、证书、数据导出等私有文件只对上传者和课程讲师可见
+func (s *contentService) GetFileVersion(ctx context.Context, fileID uint, version int, userID uint) (*model.File, error) {
+	file, err := s.repo.GetFileById(ctx, fileID)
+	if err != nil {
+		return nil, fmt.Errorf("查询文件失败: %w", err)
+	}
+	if model.IsPrivateFileType(file.FileType) && !s.isUploaderOrInstructor(file, userID) {
+		// 对无权访问者表现为文件不存在，避免通过ID枚举私有文件
+		return nil, fmt.Errorf("文件不存在")
+	}
+	if version == 0 || version == file.Version {
+		return file, nil
+	}
+
+	fileVersion, err := s.repo.GetFileVersion(ctx, fileID, version)
+	if err != nil {
+		return nil, err
+	}
+
+	// 历史版本不提供HLS播放
+	file.FileName = fileVersion.FileName
+	file.FilePath = fileVersion.FilePath
+	file.FileURL = fileVersion.FileURL
+	file.FileSize = fileVersion.FileSize
+	file.Version = fileVersion.Version
+	file.UpdatedAt = fileVersion.CreatedAt
+	file.HLSStatus = model.HLSStatusNone
+	file.HLSPlaylistURL = ""
+	file.HLSEncrypted = false
+	return file, nil
+}
+
+// ReplaceFile 替换文件内容，文件ID保持不变，旧内容保留为历史版本
+func (s *contentService) ReplaceFile(ctx context.Context, req *ReplaceFileRequest) (*model.File, error) {
+	if req == nil || req.FileID == 0 {
+		return nil, fmt.Errorf("文件ID不能为空")
+	}
+	if len(req.FileData) == 0 {
+		return nil, fmt.Errorf("未提供有效的文件数据")
+	}
+
+	file, err := s.repo.GetFileById(ctx, req.FileID)
+	if err != nil {
+		return nil, fmt.Errorf("查询文件失败: %w", err)
+	}
+	if file.UploaderID != req.UserID {
+		return nil, fmt.Errorf("无权限替换此文件")
+	}
+	if req.FileName == "" {
+		req.FileName = file.FileName
+	}
+
+	latest, err := s.ensureVersionHistory(ctx, file)
+	if err != nil {
+		return nil, err
+	}
+
+	filePath, fileURL, err := s.generateFilePath(req.FileName, file.FileType, file.CourseID)
+	if err != nil {
+		return nil, fmt.Errorf("生成文件路径失败: %w", err)
+	}
+	if err := s.saveFileToDisk(filePath, req.FileData); err != nil {
+		return nil, fmt.Errorf("保存文件失败: %w", err)
+	}
+
+	file.FileName = req.FileName
+	file.FilePath = filePath
+	file.FileURL = fileURL
+	file.FileSize = int64(len(req.FileData))
+	file.Version = latest + 1
+
+	if err := s.saveNewVersion(ctx, file, req.UserID, 0); err != nil {
+		os.Remove(filePath)
+		return nil, err
+	}
+
+	log.Printf("✅ 成功替换文件: ID=%d, 新版本=%d", file.ID, file.Version)
+	return file, nil
+}
+
+// ListFileVersions 获取文件的版本历史，仅上传者和课程讲师可查看
+// 只读接口不补写历史记录，版本功能上线前的文件返回由当前内容构造的首个版本
+func (s *contentService) ListFileVersions(ctx context.Context, fileID, userID uint) (*model.File, []model.FileVersion, error) {
+	file, err := s.repo.GetFileById(ctx, fileID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("查询文件失败: %w", err)
+	}
+	if !s.isUploaderOrInstructor(file, userID) {
+		return nil, nil, fmt.Errorf("无权限查看此文件的版本")
+	}
+
+	versions, err := s.repo.GetFileVersions(ctx, fileID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(versions) == 0 {
+		if file.Version == 0 {
+			file.Version = 1
+		}
+		initial := newFileVersion(file, file.UploaderID, 0)
+		initial.CreatedAt = file.UploadTime
+		versions = []model.FileVersion{*initial}
+	}
+	return file, versions, nil
+}
+
+// RevertFileVersion 回滚到指定版本
+// 回滚不会改写历史，而是以目标版本的内容追加一个新版本
+func (s *contentService) RevertFileVersion(ctx context.Context, fileID, userID uint, version int) (*model.File, error) {
+	file, err := s.repo.GetFileById(ctx, fileID)
+	if err != nil {
+		return nil, fmt.Errorf("查询文件失败: %w", err)
+	}
+	if file.UploaderID != userID {
+		return nil, fmt.Errorf("无权限回滚此文件")
+	}
+
+	latest, err := s.ensureVersionHistory(ctx, file)
+	if err != nil {
+		return nil, err
+	}
+	if version == latest {
+		return nil, fmt.Errorf("已是当前版本")
+	}
+
+	target, err := s.repo.GetFileVersion(ctx, fileID, version)
+	if err != nil {
+		return nil, err
+	}
+
+	file.FileName = target.FileName
+	file.FilePath = target.FilePath
+	file.FileURL = target.FileURL
+	file.FileSize = target.FileSize
+	file.Version = latest + 1
+
+	if err := s.saveNewVersion(ctx, file, userID, target.Version); err != nil {
+		return nil, err
+	}
+
+	log.Printf("✅ 成功回滚文件: ID=%d, 版本%d -> 新版本%d", file.ID, version, file.Version)
+	return file, nil
+}
+
+// isUploaderOrInstructor 判断用户是否为文件上传者或所属课程的讲师
+func (s *contentService) isUploaderOrInstructor(file *model.File, userID uint) bool {
+	if userID == 0 {
+		return false
+	}
+	if file.UploaderID == userID {
+		return true
+	}
+	if file.CourseID == 0 {
+		return false
+	}
+
+	course, err := s.courseRepo.GetByID(file.CourseID)
+	if err != nil {
+		log.Printf("⚠️ 查询文件所属课程失败: 文件ID=%d, %v", file.ID, err)
+		return false
+	}
+	return course.InstructorID == userID
+}
+
+// ensureVersionHistory 为版本功能上线前上传的文件补齐首个版本，返回最新版本号
+// 只在替换、回滚等写操作中调用
+func (s *contentService) ensureVersionHistory(ctx context.Context, file *model.File) (int, error) {
+	versions, err := s.repo.GetFileVersions(ctx, file.ID)
+	if err != nil {
+		return 0, err
+	}
+	if len(versions) > 0 {
+		return versions[0].Version, nil
+	}
+
+	if file.Version == 0 {
+		file.Version = 1
+	}
+	initial := newFileVersion(file, file.UploaderID, 0)
+	initial.CreatedAt = file.UploadTime
+	if err := s.repo.CreateFileVersion(ctx, initial); err != nil {
+		return 0, err
+	}
+	return file.Version, nil
+}
+
+// saveNewVersion 写入新版本记录并更新文件，视频会重新切片
+func (s *contentService) saveNewVersion(ctx context.Context, file *model.File, userID uint, revertedFrom int) error {
+	if err := s.repo.CreateFileVersion(ctx, newFileVersion(file, userID, revertedFrom)); err != nil {
+		return err
+	}
+
+	file.HLSStatus = model.HLSStatusNone
+	file.HLSPlaylistURL = ""
+	file.HLSEncrypted = false
+	if s.shouldPackageHLS(file) {
+		file.HLSStatus = model.HLSStatusPending
+	}
+
+	if err := s.repo.UpdateFile(ctx, file); err != nil {
+		return fmt.Errorf("更新文件记录失败: %w", err)
+	}
+
+	if file.HLSStatus == model.HLSStatusPending {
+		go s.packageHLS(*file)
+	}
+	return nil
+}
+
+// newFileVersion 根据文件当前内容构造版本记录
+func newFileVersion(file *model.File, userID uint, revertedFrom int) *model.FileVersion {
+	return &model.FileVersion{
+		FileID:       file.ID,
+		Version:      file.Version,
+		FileName:     file.FileName,
+		FilePath:     file.FilePath,
+		FileURL:      file.FileURL,
+		FileSize:     file.FileSize,
+		UploaderID:   userID,
+		RevertedFrom: revertedFrom,
+	}
+}
+
+// shouldPackageHLS 判断上传的文件是否需要HLS切片
+func (s *contentService) shouldPackageHLS(file *model.File) bool {
+	return s.hls.Enabled &&
+		file.FileType == "video" &&
+		strings.EqualFold(filepath.Ext(file.FileName), ".mp4")
+}
+
+// packageHLS 将MP4视频切分为HLS分片并更新文件记录
+// 在后台协程中运行，解析异常文件时的panic只标记切片失败，不影响服务进程
+func (s *contentService) packageHLS(file model.File) {
+	ctx := context.Background()
+	log.Printf("🎬 开始HLS切片: 文件ID=%d, 版本=%d", file.ID, file.Version)
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("❌ HLS切片异常: 文件ID=%d, %v", file.ID, r)
+			os.RemoveAll(hls.OutputDir(file.FilePath))
+			s.finishHLS(ctx, &file, model.HLSStatusFailed)
+		}
+	}()
+
+	file.HLSStatus = model.HLSStatusProcessing
+	if err := s.repo.UpdateFileHLS(ctx, &file); err != nil {
+		log.Printf("❌ 更新HLS状态失败: %v", err)
+		return
+	}
+
+	opts := hls.Options{SegmentDuration: s.hls.SegmentDuration}
+	if s.hls.Encrypt {
+		key := make([]byte, hls.KeySize)
+		if _, err := rand.Read(key); err != nil {
+			log.Printf("❌ 生成HLS密钥失败: %v", err)
+			s.finishHLS(ctx, &file, model.HLSStatusFailed)
+			return
+		}
+		if err := s.repo.SaveHLSKey(ctx, &model.HLSKey{FileID: file.ID, Version: file.Version, Key: key}); err != nil {
+			s.finishHLS(ctx, &file, model.HLSStatusFailed)
+			return
+		}
+		opts.Key = key
+		opts.KeyURI = fmt.Sprintf(s.hls.KeyURLFormat, file.ID)
+	}
+
+	outDir := hls.OutputDir(file.FilePath)
+	result, err := hls.PackageMP4(file.FilePath, outDir, opts)
+	if err != nil {
+		os.RemoveAll(outDir)
+		status := model.HLSStatusFailed
+		if errors.Is(err, hls.ErrUnsupportedCodec) || errors.Is(err, hls.ErrNoVideoTrack) {
+			status = model.HLSStatusUnsupported
+		}
+		log.Printf("❌ HLS切片失败: 文件ID=%d, %v", file.ID, err)
+		s.finishHLS(ctx, &file, status)
+		return
+	}
+
+	file.HLSPlaylistURL = hls.OutputDir(file.FileURL) + "/" + hls.PlaylistName
+	file.HLSEncrypted = opts.Key != nil
+	s.finishHLS(ctx, &file, model.HLSStatusReady)
+	log.Printf("✅ HLS切片完成: 文件ID=%d, 分片数=%d, 时长=%.1fs", file.ID, result.Segments, result.Duration)
+}
+
+// finishHLS 写入最终的HLS状态
+func (s *contentService) finishHLS(ctx context.Context, file *model.File, status string) {
+	file.HLSStatus = status
+	if err := s.repo.UpdateFileHLS(ctx, file); err != nil {
+		log.Printf("❌ 更新HLS状态失败: %v", err)
+	}
+}
+
 // validateUploadRequest 验证上传请求
 func (s *contentService) validateUploadRequest(req *UploadFileRequest) error {
 	if req == nil {
@@ -207,7 +590,7 @@ func (s *contentService) validateUploadRequest(req *UploadFileRequest) error {
 	}
 
 	// 验证文件类型
-	allowedTypes := []string{"image", "video", "document", "audio", "other"}
+	allowedTypes := []string{"image", "video", "document", "audio", "other", model.FileTypeCover, model.FileTypeSubmission, model.FileTypeCertificate}
 	isValidType := false
 	for _, t := range allowedTypes {
 		if req.FileType == t {
@@ -247,7 +630,7 @@ func (s *contentService) readMultipartFile(fileHeader *multipart.FileHeader) ([]
 }
 
 // generateFilePath 生成文件路径和URL
-func (s *contentService) generateFilePath(fileName string, courseID uint) (string, string, error) {
+func (s *contentService) generateFilePath(fileName, fileType string, courseID uint) (string, string, error) {
 	// 获取文件扩展名
 	ext := filepath.Ext(fileName)
 	if ext == "" {
@@ -259,11 +642,14 @@ func (s *contentService) generateFilePath(fileName string, courseID uint) (strin
 	hash := md5.Sum([]byte(fmt.Sprintf("%s_%d_%d", fileName, courseID, time.Now().UnixNano())))
 	uniqueName := fmt.Sprintf("%s_%x%s", timestamp, hash, ext)
 
-	// 按课程ID创建子目录，特殊处理头像上传
+	// 按课程ID创建子目录，头像和封面放在可公开访问的目录
 	var subDir string
-	if courseID == 0 {
-		subDir = "avatars" // 头像专用目录
-	} else {
+	switch {
+	case courseID == 0:
+		subDir = model.AvatarDir // 头像专用目录
+	case fileType == model.FileTypeCover:
+		subDir = model.CoverDir
+	default:
 		subDir = fmt.Sprintf("course_%d", courseID)
 	}
 	dirPath := filepath.Join(s.uploadDir, subDir)
@@ -0,0 +1,211 @@
+package service
+
+import (
+	"archive/zip"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"course-platform/internal/domain/content/model"
+)
+
+// exportSubDir 数据导出压缩包的子目录，文件名随机生成，无法通过枚举访问
+const exportSubDir = "exports"
+
+// ArchiveEntry 压缩包中由调用方提供的附加文件
+type ArchiveEntry struct {
+	Name string // 压缩包内的路径
+	Data []byte // 文件内容
+}
+
+// archivedUpload 压缩包 uploads.json 中的上传文件清单
+type archivedUpload struct {
+	ID         uint      `json:"id"`
+	FileName   string    `json:"file_name"`
+	FileType   string    `json:"file_type"`
+	CourseID   uint      `json:"course_id"`
+	FileSize   int64     `json:"file_size"`
+	UploadedAt time.Time `json:"uploaded_at"`
+	Path       string    `json:"path"` // 压缩包内的路径，为空表示磁盘文件已丢失
+}
+
+// BuildUserArchive 把附加文件和用户上传的全部文件打包为ZIP，保存为只有本人可下载的导出文件
+// 返回导出文件记录和打包的上传文件数量；压缩包直接写入磁盘，不受gRPC消息大小限制
+func (s *contentService) BuildUserArchive(ctx context.Context, userID uint, fileName string, entries []ArchiveEntry) (*model.File, int, error) {
+	if userID == 0 {
+		return nil, 0, fmt.Errorf("用户ID不能为空")
+	}
+	uploads, err := s.repo.ListFilesByUploader(ctx, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	dirPath := filepath.Join(s.uploadDir, exportSubDir)
+	if err := os.MkdirAll(dirPath, 0755); err != nil {
+		return nil, 0, fmt.Errorf("创建导出目录失败: %w", err)
+	}
+	random := make([]byte, 16)
+	if _, err := rand.Read(random); err != nil {
+		return nil, 0, fmt.Errorf("生成文件名失败: %w", err)
+	}
+	uniqueName := fmt.Sprintf("%s_%s.zip", time.Now().Format("20060102150405"), hex.EncodeToString(random))
+	filePath := filepath.Join(dirPath, uniqueName)
+
+	count, err := writeUserArchive(filePath, entries, uploads)
+	if err != nil {
+		os.Remove(filePath)
+		return nil, 0, err
+	}
+	info, err := os.Stat(filePath)
+	if err != nil {
+		os.Remove(filePath)
+		return nil, 0, fmt.Errorf("读取压缩包失败: %w", err)
+	}
+
+	file := &model.File{
+		FileName:   fileName,
+		FilePath:   filePath,
+		FileURL:    fmt.Sprintf("%s/%s/%s", strings.TrimRight(s.baseURL, "/"), exportSubDir, uniqueName),
+		FileSize:   info.Size(),
+		FileType:   model.FileTypeExport,
+		UploaderID: userID,
+		UploadTime: time.Now(),
+		Version:    1,
+		HLSStatus:  model.HLSStatusNone,
+	}
+	if err := s.repo.CreateFile(ctx, file); err != nil {
+		os.Remove(filePath)
+		return nil, 0, fmt.Errorf("保存文件记录失败: %w", err)
+	}
+
+	log.Printf("✅ 已生成数据导出压缩包 - 用户ID: %d, 上传文件: %d 个, 大小: %d 字节", userID, count, file.FileSize)
+	return file, count, nil
+}
+
+// writeUserArchive 写入压缩包，返回成功打包的上传文件数量
+func writeUserArchive(filePath string, entries []ArchiveEntry, uploads []model.File) (int, error) {
+	out, err := os.Create(filePath)
+	if err != nil {
+		return 0, fmt.Errorf("创建压缩包失败: %w", err)
+	}
+	defer out.Close()
+
+	zw := zip.NewWriter(out)
+	for _, entry := range entries {
+		w, err := zw.Create(entry.Name)
+		if err != nil {
+			return 0, fmt.Errorf("写入压缩包失败: %w", err)
+		}
+		if _, err := w.Write(entry.Data); err != nil {
+			return 0, fmt.Errorf("写入压缩包失败: %w", err)
+		}
+	}
+
+	count := 0
+	manifest := make([]archivedUpload, 0, len(uploads))
+	for _, upload := range uploads {
+		// 以前的导出压缩包不再重复打包
+		if upload.FileType == model.FileTypeExport {
+			continue
+		}
+		item := archivedUpload{
+			ID:         upload.ID,
+			FileName:   upload.FileName,
+			FileType:   upload.FileType,
+			CourseID:   upload.CourseID,
+			FileSize:   upload.FileSize,
+			UploadedAt: upload.UploadTime,
+		}
+		path := fmt.Sprintf("uploads/%d_%s", upload.ID, archiveSafeName(upload.FileName))
+		if err := copyIntoArchive(zw, path, upload.FilePath); err != nil {
+			log.Printf("⚠️ 打包上传文件失败 - 文件ID: %d, 错误: %v", upload.ID, err)
+		} else {
+			item.Path = path
+			count++
+		}
+		manifest = append(manifest, item)
+	}
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return 0, err
+	}
+	w, err := zw.Create("uploads.json")
+	if err != nil {
+		return 0, fmt.Errorf("写入压缩包失败: %w", err)
+	}
+	if _, err := w.Write(data); err != nil {
+		return 0, fmt.Errorf("写入压缩包失败: %w", err)
+	}
+
+	if err := zw.Close(); err != nil {
+		return 0, fmt.Errorf("写入压缩包失败: %w", err)
+	}
+	return count, nil
+}
+
+// copyIntoArchive 把磁盘文件写入压缩包
+func copyIntoArchive(zw *zip.Writer, name, diskPath string) error {
+	src, err := os.Open(diskPath)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	w, err := zw.Create(name)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(w, src)
+	return err
+}
+
+// archiveSafeName 去掉文件名中的目录部分，避免解压到压缩包外
+func archiveSafeName(name string) string {
+	name = filepath.Base(strings.ReplaceAll(name, "\\", "/"))
+	if name == "." || name == "/" || name == "" {
+		return "file"
+	}
+	return name
+}
+
+// PurgeUserFiles 清理注销用户的文件：transferCourseIDs 中课程的文件转交给 transferTo，其余全部删除（含历史版本和HLS分片）
+// 单个文件删除失败时继续处理其余文件，最后返回错误以便调用方重试
+func (s *contentService) PurgeUserFiles(ctx context.Context, userID uint, transferCourseIDs []uint, transferTo uint) (int, int64, error) {
+	var transferred int64
+	if transferTo != 0 && len(transferCourseIDs) > 0 {
+		var err error
+		if transferred, err = s.repo.TransferUploader(ctx, transferCourseIDs, userID, transferTo); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	files, err := s.repo.ListFilesByUploader(ctx, userID)
+	if err != nil {
+		return 0, transferred, err
+	}
+	deleted := 0
+	var lastErr error
+	for _, file := range files {
+		if err := s.DeleteFile(ctx, file.ID, userID); err != nil {
+			log.Printf("❌ 删除注销用户文件失败 - 文件ID: %d, 错误: %v", file.ID, err)
+			lastErr = err
+			continue
+		}
+		deleted++
+	}
+	if lastErr != nil {
+		return deleted, transferred, fmt.Errorf("部分文件删除失败: %w", lastErr)
+	}
+
+	log.Printf("✅ 已清理注销用户文件 - 用户ID: %d, 删除: %d 个, 转交: %d 个", userID, deleted, transferred)
+	return deleted, transferred, nil
+}
@@ -0,0 +1,178 @@
+package handler
+
+import (
+	"log"
+	"net/http"
+	"strconv"
+
+	service "course-platform/internal/infrastructure/grpc_client"
+	"course-platform/internal/shared/pb/couponpb"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CouponHandler API Gateway的优惠券处理器
+type CouponHandler struct {
+	couponGRPCClient *service.CouponGRPCClientService
+}
+
+// NewCouponHandler 创建优惠券处理器
+func NewCouponHandler(couponGRPCClient *service.CouponGRPCClientService) *CouponHandler {
+	return &CouponHandler{
+		couponGRPCClient: couponGRPCClient,
+	}
+}
+
+// CreateCouponRequest 创建优惠券请求结构
+// course_id 为0或不传时创建全站通用优惠券（仅平台管理员）
+type CreateCouponRequest struct {
+	Code           string `json:"code" binding:"required"`
+	DiscountType   string `json:"discount_type" binding:"required,oneof=percent fixed"`
+	DiscountValue  int64  `json:"discount_value" binding:"required"`
+	CourseID       uint32 `json:"course_id"`
+	MaxUses        int32  `json:"max_uses"`
+	MaxUsesPerUser int32  `json:"max_uses_per_user"`
+	StartsAt       string `json:"starts_at"`
+	EndsAt         string `json:"ends_at"`
+}
+
+// CreateCoupon 创建优惠券
+// @Summary 创建优惠券
+// @Description 讲师为自己的课程创建优惠券，平台管理员可创建全站通用优惠券；固定立减金额单位为分
+// @Tags 优惠券管理
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param coupon body CreateCouponRequest true "优惠券信息"
+// @Success 200 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Router /api/v1/coupons [post]
+func (h *CouponHandler) CreateCoupon(c *gin.Context) {
+	var req CreateCouponRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    400,
+			"message": "请求参数无效: " + err.Error(),
+		})
+		return
+	}
+
+	resp, err := h.couponGRPCClient.CreateCoupon(c.Request.Context(), &couponpb.CreateCouponRequest{
+		UserId:         uint32(c.GetUint("userID")),
+		Code:           req.Code,
+		DiscountType:   req.DiscountType,
+		DiscountValue:  req.DiscountValue,
+		CourseId:       req.CourseID,
+		MaxUses:        req.MaxUses,
+		MaxUsesPerUser: req.MaxUsesPerUser,
+		StartsAt:       req.StartsAt,
+		EndsAt:         req.EndsAt,
+	})
+	if err != nil {
+		respondGRPCError(c, "创建优惠券失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data":    resp.Coupon,
+	})
+}
+
+// ListCoupons 获取优惠券列表
+// @Summary 优惠券列表
+// @Description 讲师查看课程的优惠券，不传 course_id 时查看全站通用优惠券（仅平台管理员）
+// @Tags 优惠券管理
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param course_id query int false "课程ID"
+// @Success 200 {object} map[string]interface{}
+// @Router /api/v1/coupons [get]
+func (h *CouponHandler) ListCoupons(c *gin.Context) {
+	var courseID uint64
+	if value := c.Query("course_id"); value != "" {
+		id, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"code":    400,
+				"message": "课程ID参数无效",
+			})
+			return
+		}
+		courseID = id
+	}
+
+	resp, err := h.couponGRPCClient.ListCoupons(c.Request.Context(), c.GetUint("userID"), uint(courseID))
+	if err != nil {
+		respondGRPCError(c, "获取优惠券列表失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data":    resp.Coupons,
+	})
+}
+
+// DeactivateCoupon 停用优惠券
+// @Summary 停用优惠券
+// @Description 停用后不能再用于新订单，已下单的订单不受影响
+// @Tags 优惠券管理
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param code path string true "优惠码"
+// @Success 200 {object} map[string]interface{}
+// @Router /api/v1/coupons/{code}/deactivate [post]
+func (h *CouponHandler) DeactivateCoupon(c *gin.Context) {
+	resp, err := h.couponGRPCClient.DeactivateCoupon(c.Request.Context(), c.Param("code"), c.GetUint("userID"))
+	if err != nil {
+		respondGRPCError(c, "停用优惠券失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data":    resp.Coupon,
+	})
+}
+
+// respondGRPCError 返回调用微服务失败的响应
+func respondGRPCError(c *gin.Context, action string, err error) {
+	log.Printf("❌ API: %s - %v", action, err)
+	c.JSON(http.StatusInternalServerError, gin.H{
+		"code":    500,
+		"message": action + ": " + err.Error(),
+	})
+}
+
+// respondBusinessError 按业务码返回对应HTTP状态
+func respondBusinessError(c *gin.Context, code int32, message string) {
+	status := http.StatusBadRequest
+	switch code {
+	case 403:
+		status = http.StatusForbidden
+	case 404:
+		status = http.StatusNotFound
+	case 409:
+		status = http.StatusConflict
+	}
+	c.JSON(status, gin.H{
+		"code":    code,
+		"message": message,
+	})
+}
@@ -0,0 +1,92 @@
+package model
+
+import (
+	"time"
+)
+
+// 优惠方式
+const (
+	DiscountPercent = "percent" // 按百分比折扣，DiscountValue 为 1-100
+	DiscountFixed   = "fixed"   // 固定金额立减，DiscountValue 单位为分
+)
+
+// Coupon 优惠券
+// CourseID 为0表示全站通用，否则仅适用于指定课程；次数限制为0表示不限
+type Coupon struct {
+	ID        uint      `gorm:"primarykey" json:"id"` // 主键ID
+	CreatedAt time.Time `json:"created_at"`           // 创建时间
+	UpdatedAt time.Time `json:"updated_at"`           // 更新时间
+
+	Code           string     `gorm:"uniqueIndex;not null;size:32" json:"code"`    // 优惠码（大写）
+	DiscountType   string     `gorm:"size:10;not null" json:"discount_type"`       // 优惠方式
+	DiscountValue  int64      `gorm:"not null" json:"discount_value"`              // 折扣百分比或立减金额（分）
+	CourseID       uint       `gorm:"not null;default:0;index" json:"course_id"`   // 适用课程ID，0为全站通用
+	CreatorID      uint       `gorm:"not null;index" json:"creator_id"`            // 创建人ID
+	MaxUses        int        `gorm:"not null;default:0" json:"max_uses"`          // 总使用次数上限
+	MaxUsesPerUser int        `gorm:"not null;default:0" json:"max_uses_per_user"` // 每人使用次数上限
+	UsedCount      int        `gorm:"not null;default:0" json:"used_count"`        // 已使用次数
+	StartsAt       *time.Time `json:"starts_at"`                                   // 生效时间，为空表示立即生效
+	EndsAt         *time.Time `json:"ends_at"`                                     // 失效时间，为空表示长期有效
+	Active         bool       `gorm:"not null;default:true" json:"active"`         // 是否启用
+}
+
+// TableName 指定表名
+func (Coupon) TableName() string {
+	return "coupons"
+}
+
+// IsPlatformWide 是否为全站通用优惠券
+func (c *Coupon) IsPlatformWide() bool {
+	return c.CourseID == 0
+}
+
+// AppliesTo 优惠券是否适用于指定课程
+func (c *Coupon) AppliesTo(courseID uint) bool {
+	return c.IsPlatformWide() || c.CourseID == courseID
+}
+
+// InWindow 指定时间是否在有效期内
+func (c *Coupon) InWindow(now time.Time) bool {
+	if c.StartsAt != nil && now.Before(*c.StartsAt) {
+		return false
+	}
+	if c.EndsAt != nil && !now.Before(*c.EndsAt) {
+		return false
+	}
+	return true
+}
+
+// Discount 计算对指定金额（分）的优惠金额，不超过原金额
+func (c *Coupon) Discount(amount int64) int64 {
+	var discount int64
+	switch c.DiscountType {
+	case DiscountPercent:
+		discount = amount * c.DiscountValue / 100
+	case DiscountFixed:
+		discount = c.DiscountValue
+	}
+	if discount > amount {
+		discount = amount
+	}
+	if discount < 0 {
+		discount = 0
+	}
+	return discount
+}
+
+// CouponRedemption 优惠券使用记录，每个订单最多使用一张优惠券
+// 订单取消时删除记录并归还使用次数
+type CouponRedemption struct {
+	ID        uint      `gorm:"primarykey" json:"id"` // 主键ID
+	CreatedAt time.Time `json:"created_at"`           // 使用时间
+
+	CouponID       uint  `gorm:"not null;index:idx_redemption_coupon_user" json:"coupon_id"` // 优惠券ID
+	UserID         uint  `gorm:"not null;index:idx_redemption_coupon_user" json:"user_id"`   // 用户ID
+	OrderID        uint  `gorm:"not null;uniqueIndex" json:"order_id"`                       // 订单ID
+	DiscountAmount int64 `gorm:"not null" json:"discount_amount"`                            // 优惠金额（分）
+}
+
+// TableName 指定表名
+func (CouponRedemption) TableName() string {
+	return "coupon_redemptions"
+}
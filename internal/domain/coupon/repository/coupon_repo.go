@@ -0,0 +1,159 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"course-platform/internal/domain/coupon/model"
+
+	"gorm.io/gorm"
+)
+
+// ErrCouponExhausted 优惠券使用次数已达上限
+var ErrCouponExhausted = errors.New("优惠券已被领完")
+
+// ErrCouponUserLimit 用户使用次数已达上限
+var ErrCouponUserLimit = errors.New("您已达到该优惠券的使用次数上限")
+
+// CouponRepositoryInterface 优惠券仓储接口
+type CouponRepositoryInterface interface {
+	Create(coupon *model.Coupon) error
+	GetByCode(code string) (*model.Coupon, error)
+	ListByCourse(courseID uint) ([]*model.Coupon, error)
+	CountUserRedemptions(couponID, userID uint) (int64, error)
+	Redeem(coupon *model.Coupon, userID, orderID uint, discount int64) error
+	Release(orderID uint) error
+	Deactivate(id uint) error
+}
+
+// CouponRepository 优惠券仓储实现
+type CouponRepository struct {
+	db *gorm.DB
+}
+
+// NewCouponRepository 创建优惠券仓储实例
+func NewCouponRepository(db *gorm.DB) CouponRepositoryInterface {
+	return &CouponRepository{db: db}
+}
+
+// Create 创建优惠券
+func (r *CouponRepository) Create(coupon *model.Coupon) error {
+	if err := r.db.Create(coupon).Error; err != nil {
+		log.Printf("❌ Repository: 创建优惠券失败 - %v", err)
+		return fmt.Errorf("创建优惠券失败: %w", err)
+	}
+
+	log.Printf("✅ Repository: 优惠券创建成功 - 优惠码: %s", coupon.Code)
+	return nil
+}
+
+// GetByCode 根据优惠码获取优惠券
+func (r *CouponRepository) GetByCode(code string) (*model.Coupon, error) {
+	var coupon model.Coupon
+	if err := r.db.Where("code = ?", code).First(&coupon).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("优惠券不存在")
+		}
+		return nil, fmt.Errorf("查询优惠券失败: %w", err)
+	}
+	return &coupon, nil
+}
+
+// ListByCourse 获取课程的优惠券，courseID 为0时返回全站通用优惠券
+func (r *CouponRepository) ListByCourse(courseID uint) ([]*model.Coupon, error) {
+	var coupons []*model.Coupon
+	if err := r.db.Where("course_id = ?", courseID).Order("created_at DESC").Find(&coupons).Error; err != nil {
+		log.Printf("❌ Repository: 查询优惠券列表失败 - %v", err)
+		return nil, fmt.Errorf("查询优惠券列表失败: %w", err)
+	}
+	return coupons, nil
+}
+
+// CountUserRedemptions 统计用户对优惠券的使用次数
+func (r *CouponRepository) CountUserRedemptions(couponID, userID uint) (int64, error) {
+	var count int64
+	err := r.db.Model(&model.CouponRedemption{}).
+		Where("coupon_id = ? AND user_id = ?", couponID, userID).Count(&count).Error
+	if err != nil {
+		return 0, fmt.Errorf("查询优惠券使用记录失败: %w", err)
+	}
+	return count, nil
+}
+
+// Redeem 占用一次优惠券并记录使用
+// 先按条件增加使用次数锁定优惠券行，同一优惠券的并发使用会在此排队，
+// 之后再检查个人使用次数，保证总次数和个人次数都不会超限
+func (r *CouponRepository) Redeem(coupon *model.Coupon, userID, orderID uint, discount int64) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&model.Coupon{}).
+			Where("id = ? AND active = ? AND (max_uses = 0 OR used_count < max_uses)", coupon.ID, true).
+			UpdateColumn("used_count", gorm.Expr("used_count + 1"))
+		if result.Error != nil {
+			return fmt.Errorf("更新优惠券使用次数失败: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return ErrCouponExhausted
+		}
+
+		if coupon.MaxUsesPerUser > 0 {
+			var count int64
+			err := tx.Model(&model.CouponRedemption{}).
+				Where("coupon_id = ? AND user_id = ?", coupon.ID, userID).Count(&count).Error
+			if err != nil {
+				return fmt.Errorf("查询优惠券使用记录失败: %w", err)
+			}
+			if count >= int64(coupon.MaxUsesPerUser) {
+				return ErrCouponUserLimit
+			}
+		}
+
+		redemption := &model.CouponRedemption{
+			CouponID:       coupon.ID,
+			UserID:         userID,
+			OrderID:        orderID,
+			DiscountAmount: discount,
+		}
+		if err := tx.Create(redemption).Error; err != nil {
+			return fmt.Errorf("记录优惠券使用失败: %w", err)
+		}
+		return nil
+	})
+}
+
+// Release 删除订单的优惠券使用记录并归还使用次数，订单未使用优惠券时不做处理
+func (r *CouponRepository) Release(orderID uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var redemption model.CouponRedemption
+		if err := tx.Where("order_id = ?", orderID).First(&redemption).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil
+			}
+			return fmt.Errorf("查询优惠券使用记录失败: %w", err)
+		}
+
+		// 按删除结果判断，避免并发释放重复归还次数
+		result := tx.Delete(&model.CouponRedemption{}, redemption.ID)
+		if result.Error != nil {
+			return fmt.Errorf("删除优惠券使用记录失败: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		err := tx.Model(&model.Coupon{}).Where("id = ? AND used_count > 0", redemption.CouponID).
+			UpdateColumn("used_count", gorm.Expr("used_count - 1")).Error
+		if err != nil {
+			return fmt.Errorf("归还优惠券使用次数失败: %w", err)
+		}
+		return nil
+	})
+}
+
+// Deactivate 停用优惠券
+func (r *CouponRepository) Deactivate(id uint) error {
+	if err := r.db.Model(&model.Coupon{}).Where("id = ?", id).Update("active", false).Error; err != nil {
+		return fmt.Errorf("停用优惠券失败: %w", err)
+	}
+	return nil
+}
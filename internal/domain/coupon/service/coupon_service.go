@@ -0,0 +1,216 @@
+package service
+
+import (
+	"errors"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"course-platform/internal/domain/coupon/model"
+	"course-platform/internal/domain/coupon/repository"
+	courseService "course-platform/internal/domain/course/service"
+	userRepository "course-platform/internal/domain/user/repository"
+)
+
+// 优惠码格式：4-32位大写字母、数字、下划线或连字符
+var couponCodePattern = regexp.MustCompile(`^[A-Z0-9_-]{4,32}$`)
+
+// CouponServiceInterface 优惠券服务接口
+type CouponServiceInterface interface {
+	CreateCoupon(req *CreateCouponRequest) (*model.Coupon, error)
+	ListCoupons(userID, courseID uint) ([]*model.Coupon, error)
+	DeactivateCoupon(code string, userID uint) (*model.Coupon, error)
+	Quote(code string, userID, courseID uint, amount int64) (*model.Coupon, int64, error)
+	Redeem(coupon *model.Coupon, userID, orderID uint, discount int64) error
+	Release(orderID uint) error
+}
+
+// CreateCouponRequest 创建优惠券请求
+// CourseID 为0时创建全站通用优惠券，仅平台管理员可用
+type CreateCouponRequest struct {
+	UserID         uint
+	Code           string
+	DiscountType   string
+	DiscountValue  int64
+	CourseID       uint
+	MaxUses        int
+	MaxUsesPerUser int
+	StartsAt       *time.Time
+	EndsAt         *time.Time
+}
+
+// CouponService 优惠券服务实现
+type CouponService struct {
+	couponRepo    repository.CouponRepositoryInterface
+	courseService courseService.CourseServiceInterface
+	userRepo      userRepository.UserRepositoryInterface
+}
+
+// NewCouponService 创建优惠券服务实例
+func NewCouponService(couponRepo repository.CouponRepositoryInterface, courseService courseService.CourseServiceInterface, userRepo userRepository.UserRepositoryInterface) CouponServiceInterface {
+	return &CouponService{
+		couponRepo:    couponRepo,
+		courseService: courseService,
+		userRepo:      userRepo,
+	}
+}
+
+// NormalizeCode 规范化优惠码（去除空白并转为大写）
+func NormalizeCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// CreateCoupon 创建优惠券
+func (s *CouponService) CreateCoupon(req *CreateCouponRequest) (*model.Coupon, error) {
+	log.Printf("🔍 Service: 创建优惠券 - 优惠码: %s, 课程ID: %d", req.Code, req.CourseID)
+
+	code := NormalizeCode(req.Code)
+	if !couponCodePattern.MatchString(code) {
+		return nil, errors.New("优惠码须为4-32位字母、数字、下划线或连字符")
+	}
+
+	switch req.DiscountType {
+	case model.DiscountPercent:
+		if req.DiscountValue < 1 || req.DiscountValue > 100 {
+			return nil, errors.New("折扣百分比必须在1到100之间")
+		}
+	case model.DiscountFixed:
+		if req.DiscountValue <= 0 {
+			return nil, errors.New("立减金额必须大于0")
+		}
+	default:
+		return nil, errors.New("不支持的优惠方式")
+	}
+
+	if req.MaxUses < 0 || req.MaxUsesPerUser < 0 {
+		return nil, errors.New("使用次数上限不能为负数")
+	}
+	if req.StartsAt != nil && req.EndsAt != nil && !req.EndsAt.After(*req.StartsAt) {
+		return nil, errors.New("失效时间必须晚于生效时间")
+	}
+
+	if err := s.checkManagePermission(req.UserID, req.CourseID); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.couponRepo.GetByCode(code); err == nil {
+		return nil, errors.New("优惠码已存在")
+	}
+
+	coupon := &model.Coupon{
+		Code:           code,
+		DiscountType:   req.DiscountType,
+		DiscountValue:  req.DiscountValue,
+		CourseID:       req.CourseID,
+		CreatorID:      req.UserID,
+		MaxUses:        req.MaxUses,
+		MaxUsesPerUser: req.MaxUsesPerUser,
+		StartsAt:       req.StartsAt,
+		EndsAt:         req.EndsAt,
+		Active:         true,
+	}
+	if err := s.couponRepo.Create(coupon); err != nil {
+		return nil, err
+	}
+
+	log.Printf("✅ Service: 优惠券创建成功 - 优惠码: %s", coupon.Code)
+	return coupon, nil
+}
+
+// ListCoupons 获取课程的优惠券（课程讲师），courseID 为0时获取全站通用优惠券（平台管理员）
+func (s *CouponService) ListCoupons(userID, courseID uint) ([]*model.Coupon, error) {
+	if err := s.checkManagePermission(userID, courseID); err != nil {
+		return nil, err
+	}
+	return s.couponRepo.ListByCourse(courseID)
+}
+
+// DeactivateCoupon 停用优惠券，已下单的订单不受影响
+func (s *CouponService) DeactivateCoupon(code string, userID uint) (*model.Coupon, error) {
+	coupon, err := s.couponRepo.GetByCode(NormalizeCode(code))
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkManagePermission(userID, coupon.CourseID); err != nil {
+		return nil, err
+	}
+
+	if err := s.couponRepo.Deactivate(coupon.ID); err != nil {
+		return nil, err
+	}
+	coupon.Active = false
+
+	log.Printf("✅ Service: 优惠券已停用 - 优惠码: %s", coupon.Code)
+	return coupon, nil
+}
+
+// Quote 校验优惠券能否用于购买课程，返回优惠券和优惠金额（分）
+// 只做校验不占用次数，下单时需调用 Redeem
+func (s *CouponService) Quote(code string, userID, courseID uint, amount int64) (*model.Coupon, int64, error) {
+	coupon, err := s.couponRepo.GetByCode(NormalizeCode(code))
+	if err != nil {
+		return nil, 0, err
+	}
+	if !coupon.Active || !coupon.InWindow(time.Now()) {
+		return nil, 0, errors.New("优惠券已失效或未到使用时间")
+	}
+	if !coupon.AppliesTo(courseID) {
+		return nil, 0, errors.New("优惠券不适用于该课程")
+	}
+	if coupon.MaxUses > 0 && coupon.UsedCount >= coupon.MaxUses {
+		return nil, 0, repository.ErrCouponExhausted
+	}
+	if coupon.MaxUsesPerUser > 0 {
+		count, err := s.couponRepo.CountUserRedemptions(coupon.ID, userID)
+		if err != nil {
+			return nil, 0, err
+		}
+		if count >= int64(coupon.MaxUsesPerUser) {
+			return nil, 0, repository.ErrCouponUserLimit
+		}
+	}
+
+	return coupon, coupon.Discount(amount), nil
+}
+
+// Redeem 为订单占用一次优惠券
+func (s *CouponService) Redeem(coupon *model.Coupon, userID, orderID uint, discount int64) error {
+	if err := s.couponRepo.Redeem(coupon, userID, orderID, discount); err != nil {
+		log.Printf("⚠️ Service: 占用优惠券失败 - 优惠码: %s, 错误: %v", coupon.Code, err)
+		return err
+	}
+	return nil
+}
+
+// Release 订单取消时归还优惠券
+func (s *CouponService) Release(orderID uint) error {
+	return s.couponRepo.Release(orderID)
+}
+
+// checkManagePermission 检查管理优惠券的权限：课程优惠券需课程讲师，全站优惠券需平台管理员
+func (s *CouponService) checkManagePermission(userID, courseID uint) error {
+	if userID == 0 {
+		return errors.New("用户ID不能为空")
+	}
+
+	if courseID == 0 {
+		user, err := s.userRepo.GetByID(userID)
+		if err != nil {
+			return err
+		}
+		if !user.IsAdmin() {
+			return errors.New("只有平台管理员可以管理全站优惠券")
+		}
+		return nil
+	}
+
+	course, err := s.courseService.GetCourseByID(courseID)
+	if err != nil {
+		return err
+	}
+	if course.InstructorID != userID {
+		return errors.New("只有课程讲师可以管理该课程的优惠券")
+	}
+	return nil
+}
@@ -1,12 +1,15 @@
 package handler
 
 import (
+	"fmt"
 	"log"
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	service "course-platform/internal/infrastructure/grpc_client"
+	"course-platform/internal/shared/pb/bundlepb"
 	"course-platform/internal/shared/pb/coursepb"
 
 	"github.com/gin-gonic/gin"
@@ -16,12 +19,16 @@ import (
 // 处理客户端HTTP请求，调用课程微服务完成业务
 type CourseHandler struct {
 	courseGRPCClient *service.CourseGRPCClientService
+	quizGRPCClient   *service.QuizGRPCClientService
+	bundleGRPCClient *service.BundleGRPCClientService
 }
 
 // NewCourseHandler 创建课程处理器
-func NewCourseHandler(courseGRPCClient *service.CourseGRPCClientService) *CourseHandler {
+func NewCourseHandler(courseGRPCClient *service.CourseGRPCClientService, quizGRPCClient *service.QuizGRPCClientService, bundleGRPCClient *service.BundleGRPCClientService) *CourseHandler {
 	return &CourseHandler{
 		courseGRPCClient: courseGRPCClient,
+		quizGRPCClient:   quizGRPCClient,
+		bundleGRPCClient: bundleGRPCClient,
 	}
 }
 
@@ -35,6 +42,21 @@ type CreateCourseRequest struct {
 	CoverImage   string  `json:"cover_image"`
 }
 
+// CreateChapterRequest 创建章节请求结构
+type CreateChapterRequest struct {
+	Title       string `json:"title" binding:"required"`
+	Description string `json:"description"`
+	SortOrder   uint32 `json:"sort_order"`
+}
+
+// SetChapterReleaseRequest 设置章节开放规则请求结构
+// release_at 为RFC3339格式，仅 date 方式需要；release_after_days 仅 after_enrollment 方式生效
+type SetChapterReleaseRequest struct {
+	ReleaseType      string `json:"release_type" binding:"required,oneof=immediate date after_enrollment"`
+	ReleaseAt        string `json:"release_at"`
+	ReleaseAfterDays int32  `json:"release_after_days"`
+}
+
 // UpdateCourseRequest 更新课程请求结构
 type UpdateCourseRequest struct {
 	Title       string  `json:"title"`
@@ -44,6 +66,26 @@ type UpdateCourseRequest struct {
 	CoverImage  string  `json:"cover_image"`
 }
 
+// PrerequisiteRequest 一项先修要求，min_progress_percent 不填时要求全部学完
+type PrerequisiteRequest struct {
+	CourseID           uint32 `json:"course_id" binding:"required"`
+	MinProgressPercent int32  `json:"min_progress_percent"`
+}
+
+// SetPrerequisitesRequest 设置先修课程请求结构，传空列表表示清除
+type SetPrerequisitesRequest struct {
+	Prerequisites []PrerequisiteRequest `json:"prerequisites"`
+}
+
+// SetCourseSaleRequest 设置促销价请求结构
+// clear 为 true 时取消促销；时间为RFC3339格式，留空表示不限
+type SetCourseSaleRequest struct {
+	SalePrice float32 `json:"sale_price"`
+	StartsAt  string  `json:"starts_at"`
+	EndsAt    string  `json:"ends_at"`
+	Clear     bool    `json:"clear"`
+}
+
 // CreateCourse 创建课程接口
 // @Summary 创建课程
 // @Description 创建新的课程
@@ -110,7 +152,11 @@ func (h *CourseHandler) CreateCourse(c *gin.Context) {
 	// 检查微服务响应
 	if resp.Code != 200 {
 		log.Printf("❌ API: 课程微服务返回错误 - Code: %d, Message: %s", resp.Code, resp.Message)
-		c.JSON(http.StatusBadRequest, gin.H{
+		status := http.StatusBadRequest
+		if resp.Code == 403 {
+			status = http.StatusForbidden // 邮箱未验证
+		}
+		c.JSON(status, gin.H{
 			"code":    resp.Code,
 			"message": resp.Message,
 		})
@@ -241,7 +287,7 @@ func (h *CourseHandler) GetCourses(c *gin.Context) {
 
 // GetCourse 获取单个课程接口
 // @Summary 获取课程详情
-// @Description 根据ID获取课程详情
+// @Description 根据ID获取课程详情，prerequisites 为先修关系图（含间接先修），登录时附带当前用户的完成情况
 // @Tags 课程管理
 // @Accept json
 // @Produce json
@@ -265,7 +311,7 @@ func (h *CourseHandler) GetCourse(c *gin.Context) {
 
 	// 调用课程微服务
 	ctx := c.Request.Context()
-	resp, err := h.courseGRPCClient.GetCourse(ctx, uint(courseID))
+	resp, err := h.courseGRPCClient.GetCourse(ctx, uint(courseID), c.GetUint("userID"))
 	if err != nil {
 		log.Printf("❌ API: 获取课程详情失败 - %v", err)
 		c.JSON(http.StatusInternalServerError, gin.H{
@@ -301,6 +347,18 @@ func (h *CourseHandler) GetCourse(c *gin.Context) {
 		"status":        resp.Course.Status,
 		"created_at":    resp.Course.CreatedAt,
 		"updated_at":    resp.Course.UpdatedAt,
+
+		// 价格信息：price 为原价，effective_price 为当前实际售价
+		"effective_price": resp.Course.EffectivePrice,
+		"on_sale":         resp.Course.OnSale,
+
+		// 先修关系：每项表示 course_id 需要先学 required_course_id
+		"prerequisites": convertPrerequisitesToDisplay(resp.Prerequisites),
+	}
+	if resp.Course.HasSale {
+		courseData["sale_price"] = resp.Course.SalePrice
+		courseData["sale_starts_at"] = resp.Course.SaleStartsAt
+		courseData["sale_ends_at"] = resp.Course.SaleEndsAt
 	}
 
 	log.Printf("✅ API: 获取课程详情成功 - 课程ID: %d", resp.Course.Id)
@@ -465,6 +523,400 @@ func (h *CourseHandler) PublishCourse(c *gin.Context) {
 	})
 }
 
+// EnrollCourse 报名课程接口
+// @Summary 报名课程
+// @Description 报名已发布的免费课程
+// @Tags 课程管理
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path int true "课程ID"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Router /api/v1/courses/{id}/enroll [post]
+func (h *CourseHandler) EnrollCourse(c *gin.Context) {
+	// 解析课程ID
+	idStr := c.Param("id")
+	courseID, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    400,
+			"message": "课程ID参数无效",
+		})
+		return
+	}
+
+	userID, exists := c.Get("userID")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"code":    401,
+			"message": "用户未认证",
+		})
+		return
+	}
+
+	resp, err := h.courseGRPCClient.EnrollCourse(c.Request.Context(), uint(courseID), userID.(uint))
+	if err != nil {
+		log.Printf("❌ API: 报名课程失败 - %v", err)
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"code":    500,
+			"message": "报名课程失败: " + err.Error(),
+		})
+		return
+	}
+
+	if resp.Code != 200 {
+		// 先修要求未满足时返回缺少的课程和完成度
+		if len(resp.MissingPrerequisites) > 0 {
+			missing := make([]gin.H, 0, len(resp.MissingPrerequisites))
+			for _, m := range resp.MissingPrerequisites {
+				missing = append(missing, gin.H{
+					"course_id":            m.CourseId,
+					"course_title":         m.CourseTitle,
+					"min_progress_percent": m.MinProgressPercent,
+					"current_percent":      m.CurrentPercent,
+					"enrolled":             m.Enrolled,
+				})
+			}
+			c.JSON(http.StatusForbidden, gin.H{
+				"code":    resp.Code,
+				"message": resp.Message,
+				"data": gin.H{
+					"missing_prerequisites": missing,
+				},
+			})
+			return
+		}
+
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    resp.Code,
+			"message": resp.Message,
+		})
+		return
+	}
+
+	log.Printf("✅ API: 报名课程成功 - 课程ID: %d", courseID)
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": "报名成功",
+		"data":    resp.Enrollment,
+	})
+}
+
+// CreateChapter 创建章节接口
+// @Summary 创建章节
+// @Description 课程讲师为课程添加章节
+// @Tags 课程管理
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path int true "课程ID"
+// @Param chapter body CreateChapterRequest true "章节信息"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Router /api/v1/courses/{id}/chapters [post]
+func (h *CourseHandler) CreateChapter(c *gin.Context) {
+	courseID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    400,
+			"message": "课程ID参数无效",
+		})
+		return
+	}
+
+	var req CreateChapterRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    400,
+			"message": "请求参数无效: " + err.Error(),
+		})
+		return
+	}
+
+	resp, err := h.courseGRPCClient.CreateChapter(c.Request.Context(), &coursepb.CreateChapterRequest{
+		CourseId:    uint32(courseID),
+		UserId:      uint32(c.GetUint("userID")),
+		Title:       req.Title,
+		Description: req.Description,
+		SortOrder:   req.SortOrder,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"code":    500,
+			"message": "创建章节失败: " + err.Error(),
+		})
+		return
+	}
+
+	if resp.Code != 200 {
+		status := http.StatusBadRequest
+		if resp.Code == 403 || resp.Code == 404 {
+			status = int(resp.Code)
+		}
+		c.JSON(status, gin.H{
+			"code":    resp.Code,
+			"message": resp.Message,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": "章节创建成功",
+		"data":    resp.Chapter,
+	})
+}
+
+// GetChapters 获取课程章节列表接口
+// @Summary 获取章节列表
+// @Description 获取课程的章节列表及开放时间，登录学员按本人报名时间计算
+// @Tags 课程管理
+// @Produce json
+// @Param id path int true "课程ID"
+// @Success 200 {object} map[string]interface{}
+// @Router /api/v1/courses/{id}/chapters [get]
+func (h *CourseHandler) GetChapters(c *gin.Context) {
+	courseID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    400,
+			"message": "课程ID参数无效",
+		})
+		return
+	}
+
+	resp, err := h.courseGRPCClient.GetChapters(c.Request.Context(), uint(courseID), c.GetUint("userID"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"code":    500,
+			"message": "获取章节列表失败: " + err.Error(),
+		})
+		return
+	}
+
+	if resp.Code != 200 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    resp.Code,
+			"message": resp.Message,
+		})
+		return
+	}
+
+	chapters := make([]gin.H, 0, len(resp.Chapters))
+	for _, chapter := range resp.Chapters {
+		chapters = append(chapters, convertChapterToDisplay(chapter))
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": "获取成功",
+		"data":    chapters,
+	})
+}
+
+// SetChapterRelease 设置章节开放规则接口
+// @Summary 设置章节开放规则
+// @Description 讲师设置章节的开放方式：立即开放、固定日期开放或报名后第N天开放
+// @Tags 课程管理
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path int true "课程ID"
+// @Param chapter_id path int true "章节ID"
+// @Param release body SetChapterReleaseRequest true "开放规则"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Router /api/v1/courses/{id}/chapters/{chapter_id}/release [put]
+func (h *CourseHandler) SetChapterRelease(c *gin.Context) {
+	courseID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    400,
+			"message": "课程ID参数无效",
+		})
+		return
+	}
+	chapterID, err := strconv.ParseUint(c.Param("chapter_id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    400,
+			"message": "章节ID参数无效",
+		})
+		return
+	}
+
+	var req SetChapterReleaseRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    400,
+			"message": "请求参数无效: " + err.Error(),
+		})
+		return
+	}
+
+	resp, err := h.courseGRPCClient.SetChapterRelease(c.Request.Context(), &coursepb.SetChapterReleaseRequest{
+		CourseId:         uint32(courseID),
+		ChapterId:        uint32(chapterID),
+		UserId:           uint32(c.GetUint("userID")),
+		ReleaseType:      req.ReleaseType,
+		ReleaseAt:        req.ReleaseAt,
+		ReleaseAfterDays: req.ReleaseAfterDays,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"code":    500,
+			"message": "设置章节开放规则失败: " + err.Error(),
+		})
+		return
+	}
+
+	if resp.Code != 200 {
+		status := http.StatusBadRequest
+		if resp.Code == 403 || resp.Code == 404 {
+			status = int(resp.Code)
+		}
+		c.JSON(status, gin.H{
+			"code":    resp.Code,
+			"message": resp.Message,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data":    convertChapterToDisplay(resp.Chapter),
+	})
+}
+
+// convertChapterToDisplay 转换章节信息，字段逐项输出（零值也保留）
+func convertChapterToDisplay(chapter *coursepb.Chapter) gin.H {
+	return gin.H{
+		"id":                 chapter.Id,
+		"course_id":          chapter.CourseId,
+		"title":              chapter.Title,
+		"description":        chapter.Description,
+		"sort_order":         chapter.SortOrder,
+		"created_at":         chapter.CreatedAt,
+		"release_type":       chapter.ReleaseType,
+		"release_at":         chapter.ReleaseAt,
+		"release_after_days": chapter.ReleaseAfterDays,
+		"unlock_at":          chapter.UnlockAt,
+		"released":           chapter.Released,
+	}
+}
+
+// CompleteChapter 完成章节接口
+// @Summary 完成章节
+// @Description 标记章节已学完，学完全部章节时自动颁发结业证书
+// @Tags 课程管理
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path int true "课程ID"
+// @Param chapter_id path int true "章节ID"
+// @Success 200 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Router /api/v1/courses/{id}/chapters/{chapter_id}/complete [post]
+func (h *CourseHandler) CompleteChapter(c *gin.Context) {
+	courseID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    400,
+			"message": "课程ID参数无效",
+		})
+		return
+	}
+	chapterID, err := strconv.ParseUint(c.Param("chapter_id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    400,
+			"message": "章节ID参数无效",
+		})
+		return
+	}
+
+	resp, err := h.courseGRPCClient.CompleteChapter(c.Request.Context(), uint(courseID), uint(chapterID), c.GetUint("userID"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"code":    500,
+			"message": "完成章节失败: " + err.Error(),
+		})
+		return
+	}
+
+	if resp.Code != 200 {
+		status := http.StatusBadRequest
+		if resp.Code == 403 || resp.Code == 404 {
+			status = int(resp.Code)
+		}
+		c.JSON(status, gin.H{
+			"code":    resp.Code,
+			"message": resp.Message,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data": gin.H{
+			"progress":         resp.Progress,
+			"certificate_code": resp.CertificateCode,
+		},
+	})
+}
+
+// GetCourseProgress 获取学习进度接口
+// @Summary 获取学习进度
+// @Description 获取当前用户在课程中已完成的章节
+// @Tags 课程管理
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path int true "课程ID"
+// @Success 200 {object} map[string]interface{}
+// @Router /api/v1/courses/{id}/progress [get]
+func (h *CourseHandler) GetCourseProgress(c *gin.Context) {
+	courseID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    400,
+			"message": "课程ID参数无效",
+		})
+		return
+	}
+
+	resp, err := h.courseGRPCClient.GetCourseProgress(c.Request.Context(), uint(courseID), c.GetUint("userID"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"code":    500,
+			"message": "获取学习进度失败: " + err.Error(),
+		})
+		return
+	}
+
+	if resp.Code != 200 {
+		status := http.StatusBadRequest
+		if resp.Code == 403 || resp.Code == 404 {
+			status = int(resp.Code)
+		}
+		c.JSON(status, gin.H{
+			"code":    resp.Code,
+			"message": resp.Message,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": "获取成功",
+		"data":    resp.Progress,
+	})
+}
+
 // UpdateCourse 更新课程接口
 // @Summary 更新课程
 // @Description 更新课程信息
@@ -552,6 +1004,159 @@ func (h *CourseHandler) UpdateCourse(c *gin.Context) {
 	})
 }
 
+// SetCourseSale 设置课程促销价接口
+// @Summary 设置促销价
+// @Description 讲师为课程设置限时促销价，促销期内按促销价结算
+// @Tags 课程管理
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path int true "课程ID"
+// @Param sale body SetCourseSaleRequest true "促销信息"
+// @Success 200 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Router /api/v1/courses/{id}/sale [put]
+func (h *CourseHandler) SetCourseSale(c *gin.Context) {
+	courseID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    400,
+			"message": "课程ID参数无效",
+		})
+		return
+	}
+
+	var req SetCourseSaleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    400,
+			"message": "请求参数无效: " + err.Error(),
+		})
+		return
+	}
+
+	resp, err := h.courseGRPCClient.SetCourseSale(c.Request.Context(), &coursepb.SetCourseSaleRequest{
+		CourseId:  uint32(courseID),
+		UserId:    uint32(c.GetUint("userID")),
+		SalePrice: req.SalePrice,
+		StartsAt:  req.StartsAt,
+		EndsAt:    req.EndsAt,
+		Clear:     req.Clear,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"code":    500,
+			"message": "设置促销价失败: " + err.Error(),
+		})
+		return
+	}
+
+	if resp.Code != 200 {
+		status := http.StatusBadRequest
+		if resp.Code == 403 || resp.Code == 404 {
+			status = int(resp.Code)
+		}
+		c.JSON(status, gin.H{
+			"code":    resp.Code,
+			"message": resp.Message,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data":    resp.Course,
+	})
+}
+
+// SetCoursePrerequisites 设置先修课程接口
+// @Summary 设置先修课程
+// @Description 讲师设置学员报名前必须达到的先修课程完成度，整体替换原有设置，不能形成循环依赖
+// @Tags 课程管理
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path int true "课程ID"
+// @Param prerequisites body SetPrerequisitesRequest true "先修课程列表"
+// @Success 200 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Router /api/v1/courses/{id}/prerequisites [put]
+func (h *CourseHandler) SetCoursePrerequisites(c *gin.Context) {
+	courseID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    400,
+			"message": "课程ID参数无效",
+		})
+		return
+	}
+
+	var req SetPrerequisitesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    400,
+			"message": "请求参数无效: " + err.Error(),
+		})
+		return
+	}
+
+	prerequisites := make([]*coursepb.CoursePrerequisite, 0, len(req.Prerequisites))
+	for _, p := range req.Prerequisites {
+		prerequisites = append(prerequisites, &coursepb.CoursePrerequisite{
+			RequiredCourseId:   p.CourseID,
+			MinProgressPercent: p.MinProgressPercent,
+		})
+	}
+
+	resp, err := h.courseGRPCClient.SetCoursePrerequisites(c.Request.Context(), &coursepb.SetCoursePrerequisitesRequest{
+		CourseId:      uint32(courseID),
+		UserId:        uint32(c.GetUint("userID")),
+		Prerequisites: prerequisites,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"code":    500,
+			"message": "设置先修课程失败: " + err.Error(),
+		})
+		return
+	}
+
+	if resp.Code != 200 {
+		status := http.StatusBadRequest
+		if resp.Code == 403 || resp.Code == 404 {
+			status = int(resp.Code)
+		}
+		c.JSON(status, gin.H{
+			"code":    resp.Code,
+			"message": resp.Message,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data":    convertPrerequisitesToDisplay(resp.Prerequisites),
+	})
+}
+
+// convertPrerequisitesToDisplay 转换先修关系，字段逐项输出（零值也保留）
+func convertPrerequisitesToDisplay(prerequisites []*coursepb.CoursePrerequisite) []gin.H {
+	result := make([]gin.H, 0, len(prerequisites))
+	for _, p := range prerequisites {
+		result = append(result, gin.H{
+			"course_id":             p.CourseId,
+			"required_course_id":    p.RequiredCourseId,
+			"required_course_title": p.RequiredCourseTitle,
+			"min_progress_percent":  p.MinProgressPercent,
+			"current_percent":       p.CurrentPercent,
+			"satisfied":             p.Satisfied,
+		})
+	}
+	return result
+}
+
 // CoursesListPage 课程列表页面 - 渲染HTML页面
 func (h *CourseHandler) CoursesListPage(c *gin.Context) {
 	log.Printf("📚 渲染课程列表页面")
@@ -599,10 +1204,17 @@ func (h *CourseHandler) CoursesListPage(c *gin.Context) {
 		{"ID": 7, "Name": "数据库"},
 	}
 
+	// 套餐只在未筛选的第一页展示
+	var bundles []gin.H
+	if page == 1 && categoryID == 0 && keyword == "" {
+		bundles = h.loadBundles(c)
+	}
+
 	c.HTML(http.StatusOK, "courses-list.html", gin.H{
 		"SiteName":        "Course Platform",
 		"PageTitle":       "所有课程",
 		"Courses":         courses,
+		"Bundles":         bundles,
 		"Categories":      categories,
 		"CurrentCategory": categoryID,
 		"CurrentPage":     page,
@@ -612,6 +1224,43 @@ func (h *CourseHandler) CoursesListPage(c *gin.Context) {
 	})
 }
 
+// loadBundles 获取已发布的套餐，用于课程列表页展示
+func (h *CourseHandler) loadBundles(c *gin.Context) []gin.H {
+	if h.bundleGRPCClient == nil {
+		return nil
+	}
+
+	bundlesResp, err := h.bundleGRPCClient.ListBundles(c.Request.Context(), 1, 12)
+	if err != nil || bundlesResp.Code != 200 {
+		log.Printf("⚠️ 获取套餐列表失败，课程列表页不展示套餐: %v", err)
+		return nil
+	}
+
+	var bundles []gin.H
+	for _, bundle := range bundlesResp.Bundles {
+		bundles = append(bundles, h.convertBundleToDisplay(bundle))
+	}
+	return bundles
+}
+
+// convertBundleToDisplay 转换套餐数据为显示格式
+func (h *CourseHandler) convertBundleToDisplay(bundle *bundlepb.Bundle) gin.H {
+	kindLabel := "课程套餐"
+	if bundle.Kind == "path" {
+		kindLabel = "学习路径"
+	}
+	return gin.H{
+		"ID":           bundle.Id,
+		"Title":        bundle.Title,
+		"KindLabel":    kindLabel,
+		"CoverImage":   bundle.CoverImage,
+		"Description":  bundle.Description,
+		"Price":        bundle.Price,
+		"CoursesPrice": bundle.CoursesPrice,
+		"CourseCount":  len(bundle.Courses),
+	}
+}
+
 // convertCoursesToDisplay 转换课程数据为显示格式
 func (h *CourseHandler) convertCoursesToDisplay(courses []*coursepb.Course) []gin.H {
 	// 预定义的展示数据
@@ -667,7 +1316,7 @@ func (h *CourseHandler) CourseDetailPage(c *gin.Context) {
 
 	// 获取课程信息
 	ctx := c.Request.Context()
-	courseResp, err := h.courseGRPCClient.GetCourse(ctx, uint(courseID))
+	courseResp, err := h.courseGRPCClient.GetCourse(ctx, uint(courseID), 0)
 	if err != nil {
 		log.Printf("❌ 页面: 获取课程详情失败 - %v", err)
 		// 使用备用数据
@@ -729,9 +1378,105 @@ func (h *CourseHandler) CourseDetailPage(c *gin.Context) {
 		"Course":        courseData,
 		"Lessons":       lessons,
 		"CurrentLesson": currentLesson,
+		"Outline":       h.loadChapterOutline(c, uint(courseID)),
+		"Quizzes":       h.loadChapterQuizzes(c, uint(courseID)),
+		"Prerequisites": splitPrerequisites(courseResp.Course.Id, courseResp.Prerequisites),
 	})
 }
 
+// splitPrerequisites 将先修关系图分为直接先修和间接先修，供详情页展示
+func splitPrerequisites(courseID uint32, graph []*coursepb.CoursePrerequisite) gin.H {
+	if len(graph) == 0 {
+		return nil
+	}
+
+	var direct []*coursepb.CoursePrerequisite
+	var indirect []*coursepb.CoursePrerequisite
+	for _, p := range graph {
+		if p.CourseId == courseID {
+			direct = append(direct, p)
+		} else {
+			indirect = append(indirect, p)
+		}
+	}
+	return gin.H{
+		"Direct":   direct,
+		"Indirect": indirect,
+	}
+}
+
+// loadChapterOutline 获取课程章节及开放时间，用于详情页大纲展示
+func (h *CourseHandler) loadChapterOutline(c *gin.Context, courseID uint) []gin.H {
+	resp, err := h.courseGRPCClient.GetChapters(c.Request.Context(), courseID, c.GetUint("userID"))
+	if err != nil || resp.Code != 200 {
+		return nil
+	}
+
+	outline := make([]gin.H, 0, len(resp.Chapters))
+	for _, chapter := range resp.Chapters {
+		outline = append(outline, gin.H{
+			"Id":           chapter.Id,
+			"Title":        chapter.Title,
+			"Description":  chapter.Description,
+			"Released":     chapter.Released,
+			"ReleaseLabel": chapterReleaseLabel(chapter),
+		})
+	}
+	return outline
+}
+
+// chapterReleaseLabel 生成章节开放时间的展示文字
+func chapterReleaseLabel(chapter *coursepb.Chapter) string {
+	if chapter.Released {
+		return "已开放"
+	}
+	if unlockAt, err := time.Parse(time.RFC3339, chapter.UnlockAt); err == nil {
+		return unlockAt.Local().Format("2006-01-02 15:04") + " 开放"
+	}
+	if chapter.ReleaseType == "after_enrollment" {
+		if chapter.ReleaseAfterDays == 0 {
+			return "报名后开放"
+		}
+		return fmt.Sprintf("报名后第 %d 天开放", chapter.ReleaseAfterDays)
+	}
+	return "暂未开放"
+}
+
+// loadChapterQuizzes 获取课程章节及其测验，用于详情页展示
+func (h *CourseHandler) loadChapterQuizzes(c *gin.Context, courseID uint) []gin.H {
+	if h.quizGRPCClient == nil {
+		return nil
+	}
+
+	ctx := c.Request.Context()
+	quizResp, err := h.quizGRPCClient.ListQuizzes(ctx, courseID, 0)
+	if err != nil || quizResp.Code != 200 || len(quizResp.Quizzes) == 0 {
+		return nil
+	}
+
+	chapterTitles := make(map[uint32]string)
+	if chapterResp, err := h.courseGRPCClient.GetChapters(ctx, courseID, 0); err == nil && chapterResp.Code == 200 {
+		for _, chapter := range chapterResp.Chapters {
+			chapterTitles[chapter.Id] = chapter.Title
+		}
+	}
+
+	quizzes := make([]gin.H, 0, len(quizResp.Quizzes))
+	for _, quiz := range quizResp.Quizzes {
+		quizzes = append(quizzes, gin.H{
+			"Id":               quiz.Id,
+			"Title":            quiz.Title,
+			"ChapterTitle":     chapterTitles[quiz.ChapterId],
+			"QuestionCount":    quiz.QuestionCount,
+			"TotalScore":       quiz.TotalScore,
+			"PassScore":        quiz.PassScore,
+			"TimeLimitMinutes": (quiz.TimeLimitSeconds + 59) / 60,
+			"MaxAttempts":      quiz.MaxAttempts,
+		})
+	}
+	return quizzes
+}
+
 // renderCourseDetailWithFallback 使用备用数据渲染课程详情页面
 func (h *CourseHandler) renderCourseDetailWithFallback(c *gin.Context, courseID uint) {
 	log.Printf("🔄 页面: 使用备用数据渲染课程详情页面")
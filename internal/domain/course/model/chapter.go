@@ -0,0 +1,62 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Chapter 课程章节模型
+// 章节是课程内容的组织单元，测验、作业等学习活动挂载在章节上
+type Chapter struct {
+	ID        uint           `gorm:"primarykey" json:"id"` // 主键ID
+	CreatedAt time.Time      `json:"created_at"`           // 创建时间
+	UpdatedAt time.Time      `json:"updated_at"`           // 更新时间
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`       // 软删除时间
+
+	CourseID    uint   `gorm:"not null;index" json:"course_id"`      // 所属课程ID
+	Title       string `gorm:"not null;size:200" json:"title"`       // 章节标题
+	Description string `gorm:"type:text" json:"description"`         // 章节简介
+	SortOrder   int    `gorm:"not null;default:0" json:"sort_order"` // 排序序号（升序）
+
+	// 开放规则（按期开放的课程逐章解锁）
+	ReleaseType      string     `gorm:"size:20;not null;default:'immediate'" json:"release_type"` // 开放方式 (immediate/date/after_enrollment)
+	ReleaseAt        *time.Time `json:"release_at,omitempty"`                                     // 固定开放时间（date）
+	ReleaseAfterDays int        `gorm:"not null;default:0" json:"release_after_days"`             // 报名后第N天开放（after_enrollment）
+
+	// 学员视角的开放情况（不落库）
+	UnlockAt *time.Time `gorm:"-" json:"unlock_at,omitempty"` // 对该学员的开放时间，未报名时按天数开放的章节为空
+	Released bool       `gorm:"-" json:"released"`            // 对该学员是否已开放
+}
+
+// 章节开放方式
+const (
+	ReleaseImmediate       = "immediate"        // 立即开放
+	ReleaseOnDate          = "date"             // 固定日期开放
+	ReleaseAfterEnrollment = "after_enrollment" // 报名后N天开放
+)
+
+// MaxReleaseAfterDays 报名后开放天数上限
+const MaxReleaseAfterDays = 365
+
+// TableName 指定表名
+func (Chapter) TableName() string {
+	return "chapters"
+}
+
+// UnlockTime 计算章节对学员的开放时间，enrolledAt 为空表示尚未报名
+// 立即开放时返回 nil, true；按报名天数开放而学员未报名时无法确定，返回 nil, false
+func (ch *Chapter) UnlockTime(enrolledAt *time.Time) (*time.Time, bool) {
+	switch ch.ReleaseType {
+	case ReleaseOnDate:
+		return ch.ReleaseAt, true
+	case ReleaseAfterEnrollment:
+		if enrolledAt == nil {
+			return nil, false
+		}
+		unlockAt := enrolledAt.AddDate(0, 0, ch.ReleaseAfterDays)
+		return &unlockAt, true
+	default:
+		return nil, true
+	}
+}
@@ -0,0 +1,46 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+func TestChapterUnlockTime(t *testing.T) {
+	releaseAt := time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC)
+	enrolledAt := time.Date(2026, 1, 30, 20, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		chapter    Chapter
+		enrolledAt *time.Time
+		want       *time.Time
+		wantKnown  bool
+	}{
+		{"立即开放", Chapter{ReleaseType: ReleaseImmediate}, &enrolledAt, nil, true},
+		{"未设置开放方式按立即开放", Chapter{}, nil, nil, true},
+		{"固定日期", Chapter{ReleaseType: ReleaseOnDate, ReleaseAt: &releaseAt}, &enrolledAt, &releaseAt, true},
+		{"固定日期与是否报名无关", Chapter{ReleaseType: ReleaseOnDate, ReleaseAt: &releaseAt}, nil, &releaseAt, true},
+		{"报名当天开放", Chapter{ReleaseType: ReleaseAfterEnrollment}, &enrolledAt, &enrolledAt, true},
+		{"报名后7天", Chapter{ReleaseType: ReleaseAfterEnrollment, ReleaseAfterDays: 7}, &enrolledAt, ptrTime(time.Date(2026, 2, 6, 20, 30, 0, 0, time.UTC)), true},
+		{"跨月按自然日计算", Chapter{ReleaseType: ReleaseAfterEnrollment, ReleaseAfterDays: 30}, &enrolledAt, ptrTime(time.Date(2026, 3, 1, 20, 30, 0, 0, time.UTC)), true},
+		{"未报名无法确定", Chapter{ReleaseType: ReleaseAfterEnrollment, ReleaseAfterDays: 7}, nil, nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, known := tt.chapter.UnlockTime(tt.enrolledAt)
+			if known != tt.wantKnown {
+				t.Fatalf("UnlockTime() known = %v, want %v", known, tt.wantKnown)
+			}
+			switch {
+			case got == nil && tt.want == nil:
+			case got == nil || tt.want == nil || !got.Equal(*tt.want):
+				t.Errorf("UnlockTime() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func ptrTime(t time.Time) *time.Time {
+	return &t
+}
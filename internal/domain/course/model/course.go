@@ -32,6 +32,11 @@ type Course struct {
 	StudentCount int     `gorm:"default:0" json:"student_count"` // 学生数量
 	Rating       float32 `gorm:"default:0" json:"rating"`        // 课程评分
 	ViewCount    int     `gorm:"default:0" json:"view_count"`    // 浏览次数
+
+	// 限时促销 - 未设置促销价时按原价销售
+	SalePrice    *float32   `json:"sale_price"`     // 促销价
+	SaleStartsAt *time.Time `json:"sale_starts_at"` // 促销开始时间，为空表示立即生效
+	SaleEndsAt   *time.Time `json:"sale_ends_at"`   // 促销结束时间，为空表示长期有效
 }
 
 // TableName 指定表名
@@ -77,3 +82,30 @@ func (c *Course) IsPublished() bool {
 func (c *Course) IsDraft() bool {
 	return c.Status == "draft"
 }
+
+// IsArchived 检查课程是否已归档（讲师注销且未转交），归档课程不再出现在课程列表中，已报名学员保留访问权限
+func (c *Course) IsArchived() bool {
+	return c.Status == "archived"
+}
+
+// OnSale 检查指定时间是否处于促销期
+func (c *Course) OnSale(now time.Time) bool {
+	if c.SalePrice == nil || *c.SalePrice >= c.Price {
+		return false
+	}
+	if c.SaleStartsAt != nil && now.Before(*c.SaleStartsAt) {
+		return false
+	}
+	if c.SaleEndsAt != nil && !now.Before(*c.SaleEndsAt) {
+		return false
+	}
+	return true
+}
+
+// EffectivePrice 指定时间的实际售价（促销期内为促销价）
+func (c *Course) EffectivePrice(now time.Time) float32 {
+	if c.OnSale(now) {
+		return *c.SalePrice
+	}
+	return c.Price
+}
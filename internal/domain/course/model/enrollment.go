@@ -0,0 +1,57 @@
+package model
+
+import "time"
+
+// 选课状态
+const (
+	EnrollmentStatusActive  = "active"  // 正常学习中
+	EnrollmentStatusRevoked = "revoked" // 已取消（退款等）
+)
+
+// Enrollment 选课记录模型
+// 同一用户对同一课程只保留一条记录，通过状态切换有效性
+type Enrollment struct {
+	ID        uint      `gorm:"primarykey" json:"id"` // 主键ID
+	CreatedAt time.Time `json:"created_at"`           // 创建时间
+	UpdatedAt time.Time `json:"updated_at"`           // 更新时间
+
+	UserID     uint      `gorm:"not null;uniqueIndex:idx_enrollment_user_course" json:"user_id"`         // 学员ID
+	CourseID   uint      `gorm:"not null;uniqueIndex:idx_enrollment_user_course;index" json:"course_id"` // 课程ID
+	Status     string    `gorm:"size:20;default:'active'" json:"status"`                                 // 选课状态
+	EnrolledAt time.Time `json:"enrolled_at"`                                                            // 报名时间
+}
+
+// TableName 指定表名
+func (Enrollment) TableName() string {
+	return "enrollments"
+}
+
+// IsActive 检查选课记录是否有效
+func (e *Enrollment) IsActive() bool {
+	return e.Status == EnrollmentStatusActive
+}
+
+// 选课来源
+const (
+	EnrollmentSourceFree   = "free"   // 免费报名（含班级报名）
+	EnrollmentSourceOrder  = "order"  // 单课订单，来源ID为订单ID
+	EnrollmentSourceBundle = "bundle" // 课程套餐，来源ID为套餐ID
+)
+
+// EnrollmentGrant 选课来源记录
+// 同一课程可能同时通过多个来源获得（单独购买、免费报名、不同套餐），
+// 撤销时只删除对应来源，其余来源仍在时选课保持有效
+type EnrollmentGrant struct {
+	ID        uint      `gorm:"primarykey" json:"id"` // 主键ID
+	CreatedAt time.Time `json:"created_at"`           // 创建时间
+
+	UserID   uint   `gorm:"not null;uniqueIndex:idx_enrollment_grant" json:"user_id"`        // 学员ID
+	CourseID uint   `gorm:"not null;uniqueIndex:idx_enrollment_grant" json:"course_id"`      // 课程ID
+	Source   string `gorm:"size:20;not null;uniqueIndex:idx_enrollment_grant" json:"source"` // 来源类型
+	SourceID uint   `gorm:"not null;uniqueIndex:idx_enrollment_grant" json:"source_id"`      // 来源ID（订单ID或套餐ID，免费报名为0）
+}
+
+// TableName 指定表名
+func (EnrollmentGrant) TableName() string {
+	return "enrollment_grants"
+}
@@ -0,0 +1,38 @@
+package model
+
+import "time"
+
+// 先修要求限制
+const (
+	DefaultMinProgressPercent = 100 // 默认要求全部学完
+	MaxPrerequisites          = 10  // 每门课程最多设置的先修课程数
+)
+
+// CoursePrerequisite 课程先修要求
+// 学员在先修课程的学习进度达到最低完成度后才能报名本课程
+type CoursePrerequisite struct {
+	ID                 uint      `gorm:"primarykey" json:"id"`                                                                  // 主键ID
+	CourseID           uint      `gorm:"not null;uniqueIndex:idx_prerequisite_course_required" json:"course_id"`                // 课程ID
+	RequiredCourseID   uint      `gorm:"not null;uniqueIndex:idx_prerequisite_course_required;index" json:"required_course_id"` // 先修课程ID
+	MinProgressPercent int       `gorm:"not null;default:100" json:"min_progress_percent"`                                      // 最低完成度（1-100）
+	CreatedAt          time.Time `json:"created_at"`                                                                            // 创建时间
+
+	// 展示信息，不入库
+	RequiredCourseTitle string `gorm:"-" json:"required_course_title"` // 先修课程标题
+	CurrentPercent      int    `gorm:"-" json:"current_percent"`       // 学员当前完成度
+	Satisfied           bool   `gorm:"-" json:"satisfied"`             // 学员是否已满足要求
+}
+
+// TableName 指定表名
+func (CoursePrerequisite) TableName() string {
+	return "course_prerequisites"
+}
+
+// MissingPrerequisite 报名时未满足的先修要求
+type MissingPrerequisite struct {
+	CourseID           uint   // 先修课程ID
+	CourseTitle        string // 先修课程标题
+	MinProgressPercent int    // 要求的完成度
+	CurrentPercent     int    // 当前完成度
+	Enrolled           bool   // 是否已报名先修课程
+}
@@ -0,0 +1,41 @@
+package model
+
+import "time"
+
+// LessonProgress 学员章节完成记录
+type LessonProgress struct {
+	ID          uint      `gorm:"primarykey" json:"id"`                                             // 主键ID
+	UserID      uint      `gorm:"not null;uniqueIndex:idx_progress_user_chapter" json:"user_id"`    // 学员ID
+	ChapterID   uint      `gorm:"not null;uniqueIndex:idx_progress_user_chapter" json:"chapter_id"` // 章节ID
+	CourseID    uint      `gorm:"not null;index" json:"course_id"`                                  // 所属课程ID
+	CompletedAt time.Time `gorm:"not null" json:"completed_at"`                                     // 完成时间
+}
+
+// TableName 指定表名
+func (LessonProgress) TableName() string {
+	return "lesson_progress"
+}
+
+// CourseProgress 学员在课程中的学习进度
+type CourseProgress struct {
+	CourseID            uint   // 课程ID
+	CompletedChapterIDs []uint // 已完成的章节ID
+	TotalChapters       int    // 章节总数
+}
+
+// IsCompleted 课程是否已全部学完
+func (p *CourseProgress) IsCompleted() bool {
+	return p.TotalChapters > 0 && len(p.CompletedChapterIDs) >= p.TotalChapters
+}
+
+// Percent 课程完成百分比（0-100）
+func (p *CourseProgress) Percent() int {
+	if p.TotalChapters == 0 {
+		return 0
+	}
+	percent := len(p.CompletedChapterIDs) * 100 / p.TotalChapters
+	if percent > 100 {
+		percent = 100
+	}
+	return percent
+}
@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"course-platform/internal/domain/course/model"
+
+	"gorm.io/gorm"
+)
+
+// ChapterRepositoryInterface 章节仓储接口
+type ChapterRepositoryInterface interface {
+	Create(chapter *model.Chapter) error
+	GetByID(id uint) (*model.Chapter, error)
+	GetByCourseID(courseID uint) ([]*model.Chapter, error)
+	UpdateRelease(chapter *model.Chapter) error
+}
+
+// ChapterRepository 章节仓储实现
+type ChapterRepository struct {
+	db *gorm.DB
+}
+
+// NewChapterRepository 创建章节仓储实例
+func NewChapterRepository(db *gorm.DB) ChapterRepositoryInterface {
+	return &ChapterRepository{db: db}
+}
+
+// Create 创建章节
+func (r *ChapterRepository) Create(chapter *model.Chapter) error {
+	if err := r.db.Create(chapter).Error; err != nil {
+		log.Printf("❌ Repository: 创建章节失败 - %v", err)
+		return fmt.Errorf("创建章节失败: %w", err)
+	}
+
+	log.Printf("✅ Repository: 章节创建成功 - ID: %d", chapter.ID)
+	return nil
+}
+
+// GetByID 根据ID获取章节
+func (r *ChapterRepository) GetByID(id uint) (*model.Chapter, error) {
+	var chapter model.Chapter
+	if err := r.db.First(&chapter, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("章节不存在")
+		}
+		return nil, fmt.Errorf("查询章节失败: %w", err)
+	}
+	return &chapter, nil
+}
+
+// GetByCourseID 获取课程的全部章节（按排序序号）
+func (r *ChapterRepository) GetByCourseID(courseID uint) ([]*model.Chapter, error) {
+	var chapters []*model.Chapter
+	if err := r.db.Where("course_id = ?", courseID).
+		Order("sort_order ASC, id ASC").Find(&chapters).Error; err != nil {
+		log.Printf("❌ Repository: 查询章节列表失败 - %v", err)
+		return nil, fmt.Errorf("查询章节列表失败: %w", err)
+	}
+	return chapters, nil
+}
+
+// UpdateRelease 更新章节的开放规则（包括清空固定开放时间）
+func (r *ChapterRepository) UpdateRelease(chapter *model.Chapter) error {
+	if err := r.db.Model(chapter).
+		Select("release_type", "release_at", "release_after_days").
+		Updates(chapter).Error; err != nil {
+		log.Printf("❌ Repository: 更新章节开放规则失败 - %v", err)
+		return fmt.Errorf("更新章节开放规则失败: %w", err)
+	}
+	return nil
+}
@@ -22,6 +22,7 @@ type CourseRepositoryInterface interface {
 	Delete(id uint) error
 	ExistsByTitle(title string) (bool, error)
 	GetByInstructorID(instructorID uint) ([]*model.Course, error)
+	GetInstructorIDs() ([]uint, error)
 }
 
 // CourseRepository 课程仓储实现
@@ -213,6 +214,15 @@ func (r *CourseRepository) GetByInstructorID(instructorID uint) ([]*model.Course
 	return courses, nil
 }
 
+// GetInstructorIDs 获取创建过课程的全部讲师ID
+func (r *CourseRepository) GetInstructorIDs() ([]uint, error) {
+	var ids []uint
+	if err := r.db.Model(&model.Course{}).Distinct().Pluck("instructor_id", &ids).Error; err != nil {
+		return nil, fmt.Errorf("获取讲师列表失败: %w", err)
+	}
+	return ids, nil
+}
+
 // 缓存相关方法
 
 // cacheCourse 缓存课程信息
@@ -0,0 +1,148 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"course-platform/internal/domain/course/model"
+
+	"gorm.io/gorm"
+)
+
+// EnrollmentRepositoryInterface 选课记录仓储接口
+type EnrollmentRepositoryInterface interface {
+	Create(enrollment *model.Enrollment) error
+	GetByUserAndCourse(userID, courseID uint) (*model.Enrollment, error)
+	Update(enrollment *model.Enrollment) error
+	CountActiveStudents(courseIDs []uint, since time.Time) (int64, error)
+	ListActiveStudentIDs(courseID uint) ([]uint, error)
+	ListByUser(userID uint) ([]*model.Enrollment, error)
+	AddGrant(grant *model.EnrollmentGrant) error
+	DeleteGrant(userID, courseID uint, source string, sourceID uint) error
+	CountGrants(userID, courseID uint) (int64, error)
+}
+
+// EnrollmentRepository 选课记录仓储实现
+type EnrollmentRepository struct {
+	db *gorm.DB
+}
+
+// NewEnrollmentRepository 创建选课记录仓储实例
+func NewEnrollmentRepository(db *gorm.DB) EnrollmentRepositoryInterface {
+	return &EnrollmentRepository{db: db}
+}
+
+// Create 创建选课记录
+func (r *EnrollmentRepository) Create(enrollment *model.Enrollment) error {
+	if err := r.db.Create(enrollment).Error; err != nil {
+		log.Printf("❌ Repository: 创建选课记录失败 - %v", err)
+		return fmt.Errorf("创建选课记录失败: %w", err)
+	}
+
+	log.Printf("✅ Repository: 选课记录创建成功 - 用户ID: %d, 课程ID: %d", enrollment.UserID, enrollment.CourseID)
+	return nil
+}
+
+// GetByUserAndCourse 获取用户在课程上的选课记录
+func (r *EnrollmentRepository) GetByUserAndCourse(userID, courseID uint) (*model.Enrollment, error) {
+	var enrollment model.Enrollment
+	err := r.db.Where("user_id = ? AND course_id = ?", userID, courseID).First(&enrollment).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("查询选课记录失败: %w", err)
+	}
+	return &enrollment, nil
+}
+
+// Update 更新选课记录
+func (r *EnrollmentRepository) Update(enrollment *model.Enrollment) error {
+	if err := r.db.Save(enrollment).Error; err != nil {
+		log.Printf("❌ Repository: 更新选课记录失败 - %v", err)
+		return fmt.Errorf("更新选课记录失败: %w", err)
+	}
+	return nil
+}
+
+// CountActiveStudents 统计课程中有效选课的学员人数（同一学员只计一次）
+// since 不为零值时只统计该时间之后报名的学员
+func (r *EnrollmentRepository) CountActiveStudents(courseIDs []uint, since time.Time) (int64, error) {
+	if len(courseIDs) == 0 {
+		return 0, nil
+	}
+
+	query := r.db.Model(&model.Enrollment{}).
+		Where("course_id IN ? AND status = ?", courseIDs, model.EnrollmentStatusActive)
+	if !since.IsZero() {
+		query = query.Where("enrolled_at >= ?", since)
+	}
+
+	var count int64
+	if err := query.Distinct("user_id").Count(&count).Error; err != nil {
+		log.Printf("❌ Repository: 统计学员人数失败 - %v", err)
+		return 0, fmt.Errorf("统计学员人数失败: %w", err)
+	}
+	return count, nil
+}
+
+// ListActiveStudentIDs 获取课程中有效选课的全部学员ID
+func (r *EnrollmentRepository) ListActiveStudentIDs(courseID uint) ([]uint, error) {
+	var userIDs []uint
+	if err := r.db.Model(&model.Enrollment{}).
+		Where("course_id = ? AND status = ?", courseID, model.EnrollmentStatusActive).
+		Pluck("user_id", &userIDs).Error; err != nil {
+		log.Printf("❌ Repository: 查询课程学员失败 - %v", err)
+		return nil, fmt.Errorf("查询课程学员失败: %w", err)
+	}
+	return userIDs, nil
+}
+
+// ListByUser 获取用户的全部选课记录（含已取消的），用于个人数据导出
+func (r *EnrollmentRepository) ListByUser(userID uint) ([]*model.Enrollment, error) {
+	var enrollments []*model.Enrollment
+	if err := r.db.Where("user_id = ?", userID).Order("enrolled_at").Find(&enrollments).Error; err != nil {
+		log.Printf("❌ Repository: 查询用户选课记录失败 - %v", err)
+		return nil, fmt.Errorf("查询选课记录失败: %w", err)
+	}
+	return enrollments, nil
+}
+
+// AddGrant 记录选课来源，同一来源重复记录时忽略
+func (r *EnrollmentRepository) AddGrant(grant *model.EnrollmentGrant) error {
+	err := r.db.Where(model.EnrollmentGrant{
+		UserID:   grant.UserID,
+		CourseID: grant.CourseID,
+		Source:   grant.Source,
+		SourceID: grant.SourceID,
+	}).FirstOrCreate(grant).Error
+	if err != nil {
+		log.Printf("❌ Repository: 记录选课来源失败 - %v", err)
+		return fmt.Errorf("记录选课来源失败: %w", err)
+	}
+	return nil
+}
+
+// DeleteGrant 删除一条选课来源
+func (r *EnrollmentRepository) DeleteGrant(userID, courseID uint, source string, sourceID uint) error {
+	err := r.db.Where("user_id = ? AND course_id = ? AND source = ? AND source_id = ?", userID, courseID, source, sourceID).
+		Delete(&model.EnrollmentGrant{}).Error
+	if err != nil {
+		log.Printf("❌ Repository: 删除选课来源失败 - %v", err)
+		return fmt.Errorf("删除选课来源失败: %w", err)
+	}
+	return nil
+}
+
+// CountGrants 统计用户在课程上剩余的选课来源数
+func (r *EnrollmentRepository) CountGrants(userID, courseID uint) (int64, error) {
+	var count int64
+	if err := r.db.Model(&model.EnrollmentGrant{}).
+		Where("user_id = ? AND course_id = ?", userID, courseID).
+		Count(&count).Error; err != nil {
+		return 0, fmt.Errorf("查询选课来源失败: %w", err)
+	}
+	return count, nil
+}
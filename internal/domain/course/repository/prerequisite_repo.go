@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"fmt"
+	"log"
+
+	"course-platform/internal/domain/course/model"
+
+	"gorm.io/gorm"
+)
+
+// PrerequisiteRepositoryInterface 先修课程仓储接口
+type PrerequisiteRepositoryInterface interface {
+	ReplaceForCourse(courseID uint, prerequisites []*model.CoursePrerequisite) error
+	ListByCourse(courseID uint) ([]*model.CoursePrerequisite, error)
+}
+
+// PrerequisiteRepository 先修课程仓储实现
+type PrerequisiteRepository struct {
+	db *gorm.DB
+}
+
+// NewPrerequisiteRepository 创建先修课程仓储实例
+func NewPrerequisiteRepository(db *gorm.DB) PrerequisiteRepositoryInterface {
+	return &PrerequisiteRepository{db: db}
+}
+
+// ReplaceForCourse 用新的列表整体替换课程的先修要求
+func (r *PrerequisiteRepository) ReplaceForCourse(courseID uint, prerequisites []*model.CoursePrerequisite) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("course_id = ?", courseID).Delete(&model.CoursePrerequisite{}).Error; err != nil {
+			return err
+		}
+		if len(prerequisites) == 0 {
+			return nil
+		}
+		return tx.Create(&prerequisites).Error
+	})
+	if err != nil {
+		log.Printf("❌ Repository: 保存先修课程失败 - %v", err)
+		return fmt.Errorf("保存先修课程失败: %w", err)
+	}
+	return nil
+}
+
+// ListByCourse 获取课程的直接先修要求
+func (r *PrerequisiteRepository) ListByCourse(courseID uint) ([]*model.CoursePrerequisite, error) {
+	var prerequisites []*model.CoursePrerequisite
+	err := r.db.Where("course_id = ?", courseID).Order("id ASC").Find(&prerequisites).Error
+	if err != nil {
+		log.Printf("❌ Repository: 查询先修课程失败 - %v", err)
+		return nil, fmt.Errorf("查询先修课程失败: %w", err)
+	}
+	return prerequisites, nil
+}
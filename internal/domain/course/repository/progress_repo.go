@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"fmt"
+	"log"
+
+	"course-platform/internal/domain/course/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ProgressRepositoryInterface 学习进度仓储接口
+type ProgressRepositoryInterface interface {
+	MarkCompleted(progress *model.LessonProgress) error
+	GetCompletedChapterIDs(userID, courseID uint) ([]uint, error)
+	ListByUser(userID uint) ([]*model.LessonProgress, error)
+}
+
+// ProgressRepository 学习进度仓储实现
+type ProgressRepository struct {
+	db *gorm.DB
+}
+
+// NewProgressRepository 创建学习进度仓储实例
+func NewProgressRepository(db *gorm.DB) ProgressRepositoryInterface {
+	return &ProgressRepository{db: db}
+}
+
+// MarkCompleted 记录章节完成，重复完成时保留首次完成时间
+func (r *ProgressRepository) MarkCompleted(progress *model.LessonProgress) error {
+	err := r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(progress).Error
+	if err != nil {
+		log.Printf("❌ Repository: 记录章节完成失败 - %v", err)
+		return fmt.Errorf("记录学习进度失败: %w", err)
+	}
+	return nil
+}
+
+// GetCompletedChapterIDs 获取学员在课程中已完成的章节（不含已删除章节）
+func (r *ProgressRepository) GetCompletedChapterIDs(userID, courseID uint) ([]uint, error) {
+	var ids []uint
+	err := r.db.Model(&model.LessonProgress{}).
+		Joins("JOIN chapters ON chapters.id = lesson_progress.chapter_id AND chapters.deleted_at IS NULL").
+		Where("lesson_progress.user_id = ? AND lesson_progress.course_id = ?", userID, courseID).
+		Order("lesson_progress.chapter_id ASC").
+		Pluck("lesson_progress.chapter_id", &ids).Error
+	if err != nil {
+		log.Printf("❌ Repository: 查询学习进度失败 - %v", err)
+		return nil, fmt.Errorf("查询学习进度失败: %w", err)
+	}
+	return ids, nil
+}
+
+// ListByUser 获取用户的全部章节完成记录，用于个人数据导出
+func (r *ProgressRepository) ListByUser(userID uint) ([]*model.LessonProgress, error) {
+	var progress []*model.LessonProgress
+	if err := r.db.Where("user_id = ?", userID).Order("completed_at").Find(&progress).Error; err != nil {
+		log.Printf("❌ Repository: 查询用户学习进度失败 - %v", err)
+		return nil, fmt.Errorf("查询学习进度失败: %w", err)
+	}
+	return progress, nil
+}
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"errors"
+	"log"
+
+	"course-platform/internal/domain/course/model"
+)
+
+// ListUserEnrollments 获取用户的全部选课记录，用于个人数据导出
+func (s *CourseService) ListUserEnrollments(userID uint) ([]*model.Enrollment, error) {
+	return s.enrollmentRepo.ListByUser(userID)
+}
+
+// ListUserProgress 获取用户的全部章节完成记录，用于个人数据导出
+func (s *CourseService) ListUserProgress(userID uint) ([]*model.LessonProgress, error) {
+	return s.progressRepo.ListByUser(userID)
+}
+
+// HandOverCourses 处理注销讲师的课程：transferTo 不为0时转交给该用户（自动升级为讲师），否则全部下架归档
+// 返回转交和归档的课程ID
+func (s *CourseService) HandOverCourses(userID, transferTo uint) ([]uint, []uint, error) {
+	if userID == 0 {
+		return nil, nil, errors.New("用户ID不能为空")
+	}
+	courses, err := s.courseRepo.GetByInstructorID(userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(courses) == 0 {
+		return nil, nil, nil
+	}
+
+	teacherName := "已注销用户"
+	if transferTo != 0 {
+		recipient, err := s.userRepo.GetByID(transferTo)
+		if err != nil {
+			return nil, nil, errors.New("课程接收人不存在")
+		}
+		teacherName = recipient.Nickname
+	}
+
+	var transferred, archived []uint
+	for _, course := range courses {
+		course.TeacherName = teacherName
+		if transferTo != 0 {
+			course.InstructorID = transferTo
+		} else {
+			course.Status = "archived"
+		}
+		if err := s.courseRepo.Update(course); err != nil {
+			return transferred, archived, err
+		}
+		if transferTo != 0 {
+			transferred = append(transferred, course.ID)
+		} else {
+			archived = append(archived, course.ID)
+		}
+	}
+
+	if len(transferred) > 0 {
+		if _, err := s.userRepo.PromoteToInstructor([]uint{transferTo}); err != nil {
+			log.Printf("⚠️ Service: 升级讲师角色失败 - 用户ID: %d, 错误: %v", transferTo, err)
+		}
+	}
+	log.Printf("✅ Service: 已处理注销讲师的课程 - 用户ID: %d, 转交: %d 门, 归档: %d 门", userID, len(transferred), len(archived))
+	return transferred, archived, nil
+}
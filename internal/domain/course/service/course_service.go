@@ -4,9 +4,11 @@ import (
 	"errors"
 	"log"
 	"strings"
+	"time"
 
 	"course-platform/internal/domain/course/model"
 	"course-platform/internal/domain/course/repository"
+	userModel "course-platform/internal/domain/user/model"
 	userRepository "course-platform/internal/domain/user/repository"
 )
 
@@ -19,19 +21,53 @@ type CourseServiceInterface interface {
 	DeleteCourse(id uint) error
 	PublishCourse(id uint) error
 	GetCoursesByInstructor(instructorID uint) ([]*model.Course, error)
+	EnrollCourse(userID, courseID uint) (*model.Enrollment, error)
+	GrantEnrollment(userID, courseID uint, source string, sourceID uint) (*model.Enrollment, error)
+	RevokeEnrollment(userID, courseID uint) error
+	RevokeEnrollmentSource(userID, courseID uint, source string, sourceID uint) error
+	HasCourseAccess(userID, courseID uint) (bool, error)
+	CreateChapter(courseID, userID uint, title, description string, sortOrder int) (*model.Chapter, error)
+	GetChapters(courseID uint) ([]*model.Chapter, error)
+	GetChapterByID(id uint) (*model.Chapter, error)
+	CompleteChapter(userID, courseID, chapterID uint) (*model.CourseProgress, error)
+	SetChapterRelease(courseID, chapterID, userID uint, releaseType string, releaseAt *time.Time, afterDays int) (*model.Chapter, error)
+	GetChaptersForUser(courseID, userID uint) ([]*model.Chapter, error)
+	CheckChapterRelease(userID, chapterID uint) (*model.Chapter, error)
+	GetCourseProgress(userID, courseID uint) (*model.CourseProgress, error)
+	SetCourseSale(courseID, userID uint, salePrice *float32, startsAt, endsAt *time.Time) (*model.Course, error)
+	CountActiveStudents(courseIDs []uint, since time.Time) (int64, error)
+	ListActiveStudentIDs(courseID uint) ([]uint, error)
+	SetPrerequisites(courseID, userID uint, inputs []PrerequisiteInput) ([]*model.CoursePrerequisite, error)
+	GetPrerequisiteGraph(courseID, userID uint) ([]*model.CoursePrerequisite, error)
+	CheckPrerequisites(userID, courseID uint) error
+	CheckEmailVerified(userID uint) error
+	SyncInstructorRoles() error
+
+	// 个人数据导出与账号注销
+	ListUserEnrollments(userID uint) ([]*model.Enrollment, error)
+	ListUserProgress(userID uint) ([]*model.LessonProgress, error)
+	HandOverCourses(userID, transferTo uint) ([]uint, []uint, error)
 }
 
 // CourseService 课程服务实现
 type CourseService struct {
-	courseRepo repository.CourseRepositoryInterface
-	userRepo   userRepository.UserRepositoryInterface
+	courseRepo       repository.CourseRepositoryInterface
+	userRepo         userRepository.UserRepositoryInterface
+	enrollmentRepo   repository.EnrollmentRepositoryInterface
+	chapterRepo      repository.ChapterRepositoryInterface
+	progressRepo     repository.ProgressRepositoryInterface
+	prerequisiteRepo repository.PrerequisiteRepositoryInterface
 }
 
 // NewCourseService 创建课程服务实例
-func NewCourseService(courseRepo repository.CourseRepositoryInterface, userRepo userRepository.UserRepositoryInterface) CourseServiceInterface {
+func NewCourseService(courseRepo repository.CourseRepositoryInterface, userRepo userRepository.UserRepositoryInterface, enrollmentRepo repository.EnrollmentRepositoryInterface, chapterRepo repository.ChapterRepositoryInterface, progressRepo repository.ProgressRepositoryInterface, prerequisiteRepo repository.PrerequisiteRepositoryInterface) CourseServiceInterface {
 	return &CourseService{
-		courseRepo: courseRepo,
-		userRepo:   userRepo,
+		courseRepo:       courseRepo,
+		userRepo:         userRepo,
+		enrollmentRepo:   enrollmentRepo,
+		chapterRepo:      chapterRepo,
+		progressRepo:     progressRepo,
+		prerequisiteRepo: prerequisiteRepo,
 	}
 }
 
@@ -52,6 +88,9 @@ func (s *CourseService) CreateCourse(title, description string, instructorID, ca
 		// 为了兼容性，如果讲师不存在，使用默认讲师信息
 		teacherName = "默认讲师"
 	} else {
+		if !instructor.IsEmailVerified() {
+			return nil, userModel.ErrEmailNotVerified
+		}
 		teacherName = instructor.Nickname
 		if teacherName == "" {
 			teacherName = instructor.Username
@@ -77,9 +116,32 @@ func (s *CourseService) CreateCourse(title, description string, instructorID, ca
 	}
 
 	log.Printf("✅ Service: 课程创建成功 - ID: %d", course.ID)
+
+	// 第一门课程创建后升级为讲师角色，讲师可能被要求启用两步验证
+	if instructor != nil && instructor.Role == userModel.RoleUser {
+		if _, err := s.userRepo.PromoteToInstructor([]uint{instructorID}); err != nil {
+			log.Printf("⚠️ Service: 升级讲师角色失败 - 用户ID: %d, 错误: %v", instructorID, err)
+		}
+	}
 	return course, nil
 }
 
+// SyncInstructorRoles 将已创建过课程的普通用户升级为讲师，用于补齐引入讲师角色前的数据
+func (s *CourseService) SyncInstructorRoles() error {
+	ids, err := s.courseRepo.GetInstructorIDs()
+	if err != nil {
+		return err
+	}
+	promoted, err := s.userRepo.PromoteToInstructor(ids)
+	if err != nil {
+		return err
+	}
+	if promoted > 0 {
+		log.Printf("✅ Service: 已将 %d 个用户升级为讲师", promoted)
+	}
+	return nil
+}
+
 // GetCourseByID 根据ID获取课程
 func (s *CourseService) GetCourseByID(id uint) (*model.Course, error) {
 	log.Printf("🔍 Service: 获取课程 - ID: %d", id)
@@ -249,6 +311,367 @@ func (s *CourseService) GetCoursesByInstructor(instructorID uint) ([]*model.Cour
 	return courses, nil
 }
 
+// EnrollCourse 报名免费课程
+func (s *CourseService) EnrollCourse(userID, courseID uint) (*model.Enrollment, error) {
+	log.Printf("🔍 Service: 报名课程 - 用户ID: %d, 课程ID: %d", userID, courseID)
+
+	if userID == 0 || courseID == 0 {
+		return nil, errors.New("用户ID和课程ID不能为空")
+	}
+
+	course, err := s.courseRepo.GetByID(courseID)
+	if err != nil {
+		return nil, err
+	}
+	if !course.IsPublished() {
+		return nil, errors.New("课程尚未发布")
+	}
+	if course.Price > 0 {
+		return nil, errors.New("付费课程需要购买后才能学习")
+	}
+
+	// 已报名的学员重复报名时不再检查先修要求
+	enrollment, err := s.enrollmentRepo.GetByUserAndCourse(userID, courseID)
+	if err != nil {
+		return nil, err
+	}
+	if enrollment == nil || !enrollment.IsActive() {
+		if err := s.CheckPrerequisites(userID, courseID); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.activateEnrollmentFrom(userID, course, model.EnrollmentSourceFree, 0)
+}
+
+// GrantEnrollment 为已付款订单或套餐开通课程（不校验价格）
+// source/sourceID 记录开通来源，撤销时只撤销对应来源
+func (s *CourseService) GrantEnrollment(userID, courseID uint, source string, sourceID uint) (*model.Enrollment, error) {
+	log.Printf("🔍 Service: 开通课程 - 用户ID: %d, 课程ID: %d, 来源: %s#%d", userID, courseID, source, sourceID)
+
+	if userID == 0 || courseID == 0 {
+		return nil, errors.New("用户ID和课程ID不能为空")
+	}
+
+	course, err := s.courseRepo.GetByID(courseID)
+	if err != nil {
+		return nil, err
+	}
+	return s.activateEnrollmentFrom(userID, course, source, sourceID)
+}
+
+// activateEnrollmentFrom 开通选课并记录来源；已有有效选课时也要补记来源
+func (s *CourseService) activateEnrollmentFrom(userID uint, course *model.Course, source string, sourceID uint) (*model.Enrollment, error) {
+	enrollment, err := s.activateEnrollment(userID, course)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.enrollmentRepo.AddGrant(&model.EnrollmentGrant{
+		UserID:   userID,
+		CourseID: course.ID,
+		Source:   source,
+		SourceID: sourceID,
+	}); err != nil {
+		return nil, err
+	}
+	return enrollment, nil
+}
+
+// activateEnrollment 创建或恢复选课记录，重复调用直接返回已有记录
+func (s *CourseService) activateEnrollment(userID uint, course *model.Course) (*model.Enrollment, error) {
+	courseID := course.ID
+	enrollment, err := s.enrollmentRepo.GetByUserAndCourse(userID, courseID)
+	if err != nil {
+		return nil, err
+	}
+	if enrollment != nil && enrollment.IsActive() {
+		// 重复报名直接返回已有记录
+		return enrollment, nil
+	}
+
+	if enrollment != nil {
+		enrollment.Status = model.EnrollmentStatusActive
+		enrollment.EnrolledAt = time.Now()
+		err = s.enrollmentRepo.Update(enrollment)
+	} else {
+		enrollment = &model.Enrollment{
+			UserID:     userID,
+			CourseID:   courseID,
+			Status:     model.EnrollmentStatusActive,
+			EnrolledAt: time.Now(),
+		}
+		err = s.enrollmentRepo.Create(enrollment)
+	}
+	if err != nil {
+		log.Printf("❌ Service: 报名课程失败 - %v", err)
+		return nil, err
+	}
+
+	// 更新学生数量
+	course.StudentCount++
+	if err := s.courseRepo.Update(course); err != nil {
+		log.Printf("⚠️ Service: 更新学生数量失败 - %v", err)
+	}
+
+	log.Printf("✅ Service: 报名课程成功 - 用户ID: %d, 课程ID: %d", userID, courseID)
+	return enrollment, nil
+}
+
+// RevokeEnrollment 取消学员的选课（如退款），之后无法再访问课程内容
+// 没有有效选课记录时不做处理，便于重复调用
+func (s *CourseService) RevokeEnrollment(userID, courseID uint) error {
+	log.Printf("🔍 Service: 取消选课 - 用户ID: %d, 课程ID: %d", userID, courseID)
+
+	enrollment, err := s.enrollmentRepo.GetByUserAndCourse(userID, courseID)
+	if err != nil {
+		return err
+	}
+	if enrollment == nil || !enrollment.IsActive() {
+		return nil
+	}
+
+	enrollment.Status = model.EnrollmentStatusRevoked
+	if err := s.enrollmentRepo.Update(enrollment); err != nil {
+		log.Printf("❌ Service: 取消选课失败 - %v", err)
+		return err
+	}
+
+	// 更新学生数量
+	course, err := s.courseRepo.GetByID(courseID)
+	if err == nil && course.StudentCount > 0 {
+		course.StudentCount--
+		if err := s.courseRepo.Update(course); err != nil {
+			log.Printf("⚠️ Service: 更新学生数量失败 - %v", err)
+		}
+	}
+
+	log.Printf("✅ Service: 选课已取消 - 用户ID: %d, 课程ID: %d", userID, courseID)
+	return nil
+}
+
+// RevokeEnrollmentSource 撤销某一来源开通的选课
+// 学员仍通过其他来源（单独购买、免费报名、其他套餐）持有课程时保留选课；
+// 没有任何来源记录的旧数据按原方式直接取消
+func (s *CourseService) RevokeEnrollmentSource(userID, courseID uint, source string, sourceID uint) error {
+	log.Printf("🔍 Service: 撤销选课来源 - 用户ID: %d, 课程ID: %d, 来源: %s#%d", userID, courseID, source, sourceID)
+
+	count, err := s.enrollmentRepo.CountGrants(userID, courseID)
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return s.RevokeEnrollment(userID, courseID)
+	}
+
+	if err := s.enrollmentRepo.DeleteGrant(userID, courseID, source, sourceID); err != nil {
+		return err
+	}
+	remaining, err := s.enrollmentRepo.CountGrants(userID, courseID)
+	if err != nil {
+		return err
+	}
+	if remaining > 0 {
+		log.Printf("✅ Service: 学员仍有其他选课来源，保留选课 - 用户ID: %d, 课程ID: %d", userID, courseID)
+		return nil
+	}
+	return s.RevokeEnrollment(userID, courseID)
+}
+
+// CountActiveStudents 统计课程中有效选课的学员人数，since 为零值表示不限报名时间
+func (s *CourseService) CountActiveStudents(courseIDs []uint, since time.Time) (int64, error) {
+	return s.enrollmentRepo.CountActiveStudents(courseIDs, since)
+}
+
+// ListActiveStudentIDs 获取课程中有效选课的全部学员ID
+func (s *CourseService) ListActiveStudentIDs(courseID uint) ([]uint, error) {
+	return s.enrollmentRepo.ListActiveStudentIDs(courseID)
+}
+
+// CheckEmailVerified 检查用户邮箱是否已验证，未验证时不能购买课程
+func (s *CourseService) CheckEmailVerified(userID uint) error {
+	user, err := s.userRepo.GetByID(userID)
+	if err != nil {
+		return err
+	}
+	if !user.IsEmailVerified() {
+		return userModel.ErrEmailNotVerified
+	}
+	return nil
+}
+
+// HasCourseAccess 检查用户是否可以访问课程内容（讲师或有效报名的学员）
+func (s *CourseService) HasCourseAccess(userID, courseID uint) (bool, error) {
+	if userID == 0 || courseID == 0 {
+		return false, nil
+	}
+
+	course, err := s.courseRepo.GetByID(courseID)
+	if err != nil {
+		return false, err
+	}
+	if course.InstructorID == userID {
+		return true, nil
+	}
+
+	enrollment, err := s.enrollmentRepo.GetByUserAndCourse(userID, courseID)
+	if err != nil {
+		return false, err
+	}
+	return enrollment != nil && enrollment.IsActive(), nil
+}
+
+// CreateChapter 创建章节（仅课程讲师）
+func (s *CourseService) CreateChapter(courseID, userID uint, title, description string, sortOrder int) (*model.Chapter, error) {
+	log.Printf("🔍 Service: 创建章节 - 课程ID: %d, 标题: %s", courseID, title)
+
+	if strings.TrimSpace(title) == "" {
+		return nil, errors.New("章节标题不能为空")
+	}
+	if len(title) > 200 {
+		return nil, errors.New("章节标题不能超过200个字符")
+	}
+
+	course, err := s.courseRepo.GetByID(courseID)
+	if err != nil {
+		return nil, err
+	}
+	if course.InstructorID != userID {
+		return nil, errors.New("只有课程讲师可以管理章节")
+	}
+
+	chapter := &model.Chapter{
+		CourseID:    courseID,
+		Title:       strings.TrimSpace(title),
+		Description: description,
+		SortOrder:   sortOrder,
+		ReleaseType: model.ReleaseImmediate,
+		Released:    true,
+	}
+	if err := s.chapterRepo.Create(chapter); err != nil {
+		return nil, err
+	}
+
+	log.Printf("✅ Service: 章节创建成功 - ID: %d", chapter.ID)
+	return chapter, nil
+}
+
+// GetChapters 获取课程章节列表
+func (s *CourseService) GetChapters(courseID uint) ([]*model.Chapter, error) {
+	if courseID == 0 {
+		return nil, errors.New("课程ID不能为空")
+	}
+	return s.chapterRepo.GetByCourseID(courseID)
+}
+
+// GetChapterByID 根据ID获取章节
+func (s *CourseService) GetChapterByID(id uint) (*model.Chapter, error) {
+	if id == 0 {
+		return nil, errors.New("章节ID不能为空")
+	}
+	return s.chapterRepo.GetByID(id)
+}
+
+// CompleteChapter 标记章节已学完（需有课程访问权限），返回最新学习进度
+func (s *CourseService) CompleteChapter(userID, courseID, chapterID uint) (*model.CourseProgress, error) {
+	log.Printf("🔍 Service: 完成章节 - 用户ID: %d, 课程ID: %d, 章节ID: %d", userID, courseID, chapterID)
+
+	chapter, err := s.GetChapterByID(chapterID)
+	if err != nil {
+		return nil, err
+	}
+	if chapter.CourseID != courseID {
+		return nil, errors.New("章节不属于该课程")
+	}
+
+	hasAccess, err := s.HasCourseAccess(userID, courseID)
+	if err != nil {
+		return nil, err
+	}
+	if !hasAccess {
+		return nil, errors.New("请先报名该课程")
+	}
+
+	// 未开放的章节不能标记完成
+	if err := s.fillRelease(courseID, userID, []*model.Chapter{chapter}); err != nil {
+		return nil, err
+	}
+	if !chapter.Released {
+		return nil, chapterLockedError(chapter)
+	}
+
+	if err := s.progressRepo.MarkCompleted(&model.LessonProgress{
+		UserID:      userID,
+		ChapterID:   chapterID,
+		CourseID:    courseID,
+		CompletedAt: time.Now(),
+	}); err != nil {
+		return nil, err
+	}
+
+	return s.GetCourseProgress(userID, courseID)
+}
+
+// GetCourseProgress 获取学员的课程学习进度
+func (s *CourseService) GetCourseProgress(userID, courseID uint) (*model.CourseProgress, error) {
+	chapters, err := s.GetChapters(courseID)
+	if err != nil {
+		return nil, err
+	}
+
+	completed, err := s.progressRepo.GetCompletedChapterIDs(userID, courseID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.CourseProgress{
+		CourseID:            courseID,
+		CompletedChapterIDs: completed,
+		TotalChapters:       len(chapters),
+	}, nil
+}
+
+// SetCourseSale 设置或取消限时促销价（仅课程讲师），salePrice 为空时取消促销
+func (s *CourseService) SetCourseSale(courseID, userID uint, salePrice *float32, startsAt, endsAt *time.Time) (*model.Course, error) {
+	log.Printf("🔍 Service: 设置课程促销 - 课程ID: %d", courseID)
+
+	course, err := s.courseRepo.GetByID(courseID)
+	if err != nil {
+		return nil, err
+	}
+	if course.InstructorID != userID {
+		return nil, errors.New("只有课程讲师可以设置促销价")
+	}
+
+	if salePrice == nil {
+		course.SalePrice = nil
+		course.SaleStartsAt = nil
+		course.SaleEndsAt = nil
+	} else {
+		// 促销价必须大于0，免费课程走报名流程而非订单
+		if *salePrice <= 0 || *salePrice >= course.Price {
+			return nil, errors.New("促销价必须大于0且低于课程原价")
+		}
+		if startsAt != nil && endsAt != nil && !endsAt.After(*startsAt) {
+			return nil, errors.New("促销结束时间必须晚于开始时间")
+		}
+		if endsAt != nil && !endsAt.After(time.Now()) {
+			return nil, errors.New("促销结束时间必须晚于当前时间")
+		}
+		course.SalePrice = salePrice
+		course.SaleStartsAt = startsAt
+		course.SaleEndsAt = endsAt
+	}
+
+	if err := s.courseRepo.Update(course); err != nil {
+		log.Printf("❌ Service: 设置课程促销失败 - %v", err)
+		return nil, err
+	}
+
+	log.Printf("✅ Service: 课程促销已更新 - 课程ID: %d", courseID)
+	return course, nil
+}
+
 // 私有验证方法
 
 // validateCourseInput 验证课程创建输入
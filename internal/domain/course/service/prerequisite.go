@@ -0,0 +1,204 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	"course-platform/internal/domain/course/model"
+)
+
+// PrerequisiteInput 设置先修课程的输入，MinProgressPercent 为0时要求全部学完
+type PrerequisiteInput struct {
+	RequiredCourseID   uint
+	MinProgressPercent int
+}
+
+// PrerequisiteError 报名时先修要求未满足，Missing 列出每一项缺口
+type PrerequisiteError struct {
+	CourseID uint
+	Missing  []model.MissingPrerequisite
+}
+
+// Error 汇总未满足的先修要求
+func (e *PrerequisiteError) Error() string {
+	parts := make([]string, 0, len(e.Missing))
+	for _, m := range e.Missing {
+		if m.MinProgressPercent >= model.DefaultMinProgressPercent {
+			parts = append(parts, fmt.Sprintf("《%s》需学完（当前%d%%）", m.CourseTitle, m.CurrentPercent))
+		} else {
+			parts = append(parts, fmt.Sprintf("《%s》需完成%d%%（当前%d%%）", m.CourseTitle, m.MinProgressPercent, m.CurrentPercent))
+		}
+	}
+	return "尚未满足先修要求：" + strings.Join(parts, "；")
+}
+
+// SetPrerequisites 设置课程的先修要求（仅课程讲师），整体替换原有设置
+func (s *CourseService) SetPrerequisites(courseID, userID uint, inputs []PrerequisiteInput) ([]*model.CoursePrerequisite, error) {
+	log.Printf("🔍 Service: 设置先修课程 - 课程ID: %d, 数量: %d", courseID, len(inputs))
+
+	course, err := s.courseRepo.GetByID(courseID)
+	if err != nil {
+		return nil, err
+	}
+	if course.InstructorID != userID {
+		return nil, errors.New("只有课程讲师可以设置先修课程")
+	}
+	if len(inputs) > model.MaxPrerequisites {
+		return nil, fmt.Errorf("每门课程最多设置%d门先修课程", model.MaxPrerequisites)
+	}
+
+	prerequisites := make([]*model.CoursePrerequisite, 0, len(inputs))
+	seen := make(map[uint]bool, len(inputs))
+	for _, input := range inputs {
+		if input.RequiredCourseID == courseID {
+			return nil, errors.New("不能把课程自身设为先修课程")
+		}
+		if seen[input.RequiredCourseID] {
+			return nil, fmt.Errorf("先修课程 %d 重复出现", input.RequiredCourseID)
+		}
+		seen[input.RequiredCourseID] = true
+
+		percent := input.MinProgressPercent
+		if percent == 0 {
+			percent = model.DefaultMinProgressPercent
+		}
+		if percent < 1 || percent > 100 {
+			return nil, errors.New("最低完成度必须在1到100之间")
+		}
+
+		required, err := s.courseRepo.GetByID(input.RequiredCourseID)
+		if err != nil {
+			return nil, fmt.Errorf("先修课程 %d 不存在", input.RequiredCourseID)
+		}
+
+		// 先修课程自身（直接或间接）依赖本课程时会形成循环
+		if err := s.checkNoCycle(courseID, required.ID); err != nil {
+			return nil, err
+		}
+
+		prerequisites = append(prerequisites, &model.CoursePrerequisite{
+			CourseID:            courseID,
+			RequiredCourseID:    required.ID,
+			MinProgressPercent:  percent,
+			RequiredCourseTitle: required.Title,
+		})
+	}
+
+	if err := s.prerequisiteRepo.ReplaceForCourse(courseID, prerequisites); err != nil {
+		return nil, err
+	}
+
+	log.Printf("✅ Service: 先修课程已更新 - 课程ID: %d", courseID)
+	return prerequisites, nil
+}
+
+// GetPrerequisiteGraph 获取课程的先修关系图（含间接先修），按广度优先顺序返回每条依赖
+// userID 不为0时同时给出该学员在每门先修课程上的完成度
+func (s *CourseService) GetPrerequisiteGraph(courseID, userID uint) ([]*model.CoursePrerequisite, error) {
+	var graph []*model.CoursePrerequisite
+	visited := map[uint]bool{courseID: true}
+	queue := []uint{courseID}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		edges, err := s.prerequisiteRepo.ListByCourse(current)
+		if err != nil {
+			return nil, err
+		}
+		for _, edge := range edges {
+			if err := s.fillPrerequisite(edge, userID); err != nil {
+				return nil, err
+			}
+			graph = append(graph, edge)
+
+			if !visited[edge.RequiredCourseID] {
+				visited[edge.RequiredCourseID] = true
+				queue = append(queue, edge.RequiredCourseID)
+			}
+		}
+	}
+	return graph, nil
+}
+
+// CheckPrerequisites 检查学员是否满足课程的直接先修要求，未满足时返回 *PrerequisiteError
+func (s *CourseService) CheckPrerequisites(userID, courseID uint) error {
+	edges, err := s.prerequisiteRepo.ListByCourse(courseID)
+	if err != nil {
+		return err
+	}
+
+	var missing []model.MissingPrerequisite
+	for _, edge := range edges {
+		if err := s.fillPrerequisite(edge, userID); err != nil {
+			return err
+		}
+		if edge.Satisfied {
+			continue
+		}
+
+		enrolled, err := s.enrollmentRepo.GetByUserAndCourse(userID, edge.RequiredCourseID)
+		if err != nil {
+			return err
+		}
+		missing = append(missing, model.MissingPrerequisite{
+			CourseID:           edge.RequiredCourseID,
+			CourseTitle:        edge.RequiredCourseTitle,
+			MinProgressPercent: edge.MinProgressPercent,
+			CurrentPercent:     edge.CurrentPercent,
+			Enrolled:           enrolled != nil && enrolled.IsActive(),
+		})
+	}
+
+	if len(missing) > 0 {
+		return &PrerequisiteError{CourseID: courseID, Missing: missing}
+	}
+	return nil
+}
+
+// fillPrerequisite 补充先修课程标题和学员完成情况
+func (s *CourseService) fillPrerequisite(edge *model.CoursePrerequisite, userID uint) error {
+	if required, err := s.courseRepo.GetByID(edge.RequiredCourseID); err == nil {
+		edge.RequiredCourseTitle = required.Title
+	}
+	if userID == 0 {
+		return nil
+	}
+
+	progress, err := s.GetCourseProgress(userID, edge.RequiredCourseID)
+	if err != nil {
+		return err
+	}
+	edge.CurrentPercent = progress.Percent()
+	edge.Satisfied = edge.CurrentPercent >= edge.MinProgressPercent
+	return nil
+}
+
+// checkNoCycle 确认 requiredID 及其所有先修课程中不包含 courseID
+func (s *CourseService) checkNoCycle(courseID, requiredID uint) error {
+	visited := map[uint]bool{requiredID: true}
+	queue := []uint{requiredID}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		edges, err := s.prerequisiteRepo.ListByCourse(current)
+		if err != nil {
+			return err
+		}
+		for _, edge := range edges {
+			if edge.RequiredCourseID == courseID {
+				return errors.New("设置后会形成循环依赖，请检查先修课程")
+			}
+			if !visited[edge.RequiredCourseID] {
+				visited[edge.RequiredCourseID] = true
+				queue = append(queue, edge.RequiredCourseID)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package service
+
+import (
+	"testing"
+
+	"course-platform/internal/domain/course/model"
+)
+
+// graphPrerequisiteRepo 以邻接表表示的先修关系，key 为课程ID，value 为其先修课程ID
+type graphPrerequisiteRepo map[uint][]uint
+
+func (g graphPrerequisiteRepo) ReplaceForCourse(courseID uint, prerequisites []*model.CoursePrerequisite) error {
+	return nil
+}
+
+func (g graphPrerequisiteRepo) ListByCourse(courseID uint) ([]*model.CoursePrerequisite, error) {
+	var edges []*model.CoursePrerequisite
+	for _, required := range g[courseID] {
+		edges = append(edges, &model.CoursePrerequisite{CourseID: courseID, RequiredCourseID: required})
+	}
+	return edges, nil
+}
+
+func TestCheckNoCycle(t *testing.T) {
+	tests := []struct {
+		name       string
+		graph      graphPrerequisiteRepo
+		courseID   uint
+		requiredID uint
+		wantErr    bool
+	}{
+		{"无先修关系", graphPrerequisiteRepo{}, 1, 2, false},
+		{"直接反向依赖", graphPrerequisiteRepo{2: {1}}, 1, 2, true},
+		{"间接依赖形成环", graphPrerequisiteRepo{2: {3}, 3: {4}, 4: {1}}, 1, 2, true},
+		{"链式依赖无环", graphPrerequisiteRepo{2: {3}, 3: {4}}, 1, 2, false},
+		{"菱形依赖无环", graphPrerequisiteRepo{2: {3, 4}, 3: {5}, 4: {5}}, 1, 2, false},
+		{"菱形分支中存在环", graphPrerequisiteRepo{2: {3, 4}, 3: {5}, 4: {1}}, 1, 2, true},
+		{"已有环但不经过当前课程", graphPrerequisiteRepo{2: {3}, 3: {2}}, 1, 2, false},
+		{"其他课程依赖当前课程不影响", graphPrerequisiteRepo{5: {1}, 2: {3}}, 1, 2, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &CourseService{prerequisiteRepo: tt.graph}
+			err := s.checkNoCycle(tt.courseID, tt.requiredID)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("checkNoCycle(%d, %d) error = %v, wantErr %v", tt.courseID, tt.requiredID, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,593 @@
+package handler
+
+import (
+	"log"
+	"net/http"
+	"strconv"
+	"unicode/utf8"
+
+	service "course-platform/internal/infrastructure/grpc_client"
+	"course-platform/internal/shared/pb/discussionpb"
+
+	"github.com/gin-gonic/gin"
+)
+
+// excerptLength 列表中正文摘要的长度
+const excerptLength = 140
+
+// DiscussionHandler API Gateway的课程讨论区处理器
+type DiscussionHandler struct {
+	discussionGRPCClient *service.DiscussionGRPCClientService
+}
+
+// NewDiscussionHandler 创建课程讨论区处理器
+func NewDiscussionHandler(discussionGRPCClient *service.DiscussionGRPCClientService) *DiscussionHandler {
+	return &DiscussionHandler{
+		discussionGRPCClient: discussionGRPCClient,
+	}
+}
+
+// CreateThreadRequest 发帖请求结构，chapter_id 为空表示课程整体的讨论
+type CreateThreadRequest struct {
+	Title     string `json:"title" binding:"required"`
+	Body      string `json:"body"`
+	ChapterID uint32 `json:"chapter_id"`
+}
+
+// CreateReplyRequest 回复请求结构，parent_id 为空表示直接回复帖子
+type CreateReplyRequest struct {
+	Body     string `json:"body" binding:"required"`
+	ParentID uint32 `json:"parent_id"`
+}
+
+// ModerateThreadRequest 管理讨论帖请求结构
+type ModerateThreadRequest struct {
+	Action string `json:"action" binding:"required,oneof=pin unpin lock unlock"`
+}
+
+// MarkAnswerRequest 标记最佳回答请求结构，reply_id 为0表示取消标记
+type MarkAnswerRequest struct {
+	ReplyID uint32 `json:"reply_id"`
+}
+
+// ListThreads 获取课程讨论帖列表
+// @Summary 讨论帖列表
+// @Description 分页获取课程讨论帖，置顶帖排在最前；需报名课程
+// @Tags 课程讨论
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path int true "课程ID"
+// @Param chapter_id query int false "章节ID，不传表示全部"
+// @Param sort query string false "排序：latest（默认）/top/unanswered"
+// @Param page query int false "页码，默认1"
+// @Param page_size query int false "每页数量，默认20"
+// @Success 200 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Router /api/v1/courses/{id}/discussions [get]
+func (h *DiscussionHandler) ListThreads(c *gin.Context) {
+	courseID, ok := parseIDParam(c, "id", "课程ID参数无效")
+	if !ok {
+		return
+	}
+	chapterID, _ := strconv.ParseUint(c.DefaultQuery("chapter_id", "0"), 10, 32)
+	page, pageSize := parsePage(c)
+
+	resp, err := h.discussionGRPCClient.ListThreads(c.Request.Context(), &discussionpb.ListThreadsRequest{
+		CourseId:  uint32(courseID),
+		ChapterId: uint32(chapterID),
+		UserId:    uint32(c.GetUint("userID")),
+		Sort:      c.Query("sort"),
+		Page:      uint32(page),
+		PageSize:  uint32(pageSize),
+	})
+	if err != nil {
+		respondGRPCError(c, "获取讨论帖列表失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	threads := make([]gin.H, 0, len(resp.Threads))
+	for _, thread := range resp.Threads {
+		data := convertThreadToDisplay(thread)
+		data["body"] = excerpt(thread.Body)
+		threads = append(threads, data)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data": gin.H{
+			"threads":   threads,
+			"total":     resp.Total,
+			"page":      page,
+			"page_size": pageSize,
+		},
+	})
+}
+
+// CreateThread 发布讨论帖
+// @Summary 发布讨论帖
+// @Description 在课程或指定章节下发帖提问；需报名课程
+// @Tags 课程讨论
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path int true "课程ID"
+// @Param thread body CreateThreadRequest true "帖子内容"
+// @Success 200 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Router /api/v1/courses/{id}/discussions [post]
+func (h *DiscussionHandler) CreateThread(c *gin.Context) {
+	courseID, ok := parseIDParam(c, "id", "课程ID参数无效")
+	if !ok {
+		return
+	}
+
+	var req CreateThreadRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    400,
+			"message": "请求参数无效: " + err.Error(),
+		})
+		return
+	}
+
+	resp, err := h.discussionGRPCClient.CreateThread(c.Request.Context(), &discussionpb.CreateThreadRequest{
+		CourseId:  uint32(courseID),
+		ChapterId: req.ChapterID,
+		UserId:    uint32(c.GetUint("userID")),
+		Title:     req.Title,
+		Body:      req.Body,
+	})
+	if err != nil {
+		respondGRPCError(c, "发布讨论帖失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data":    convertThreadToDisplay(resp.Thread),
+	})
+}
+
+// GetThread 获取讨论帖详情
+// @Summary 讨论帖详情
+// @Description 获取讨论帖和回复，回复按顶层回复分页并以树形结构返回；需报名课程
+// @Tags 课程讨论
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path int true "讨论帖ID"
+// @Param page query int false "页码，默认1"
+// @Param page_size query int false "每页顶层回复数量，默认20"
+// @Success 200 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Router /api/v1/discussions/{id} [get]
+func (h *DiscussionHandler) GetThread(c *gin.Context) {
+	threadID, ok := parseIDParam(c, "id", "讨论帖ID参数无效")
+	if !ok {
+		return
+	}
+	page, pageSize := parsePage(c)
+
+	resp, err := h.discussionGRPCClient.GetThread(c.Request.Context(), threadID, c.GetUint("userID"), uint(page), uint(pageSize))
+	if err != nil {
+		respondGRPCError(c, "获取讨论帖失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data": gin.H{
+			"thread":        convertThreadToDisplay(resp.Thread),
+			"replies":       buildReplyTree(resp.Replies),
+			"total_replies": resp.TotalReplies,
+			"page":          page,
+			"page_size":     pageSize,
+		},
+	})
+}
+
+// CreateReply 回复讨论帖
+// @Summary 回复讨论帖
+// @Description 回复帖子或其中的某条回复；帖子锁定后只有讲师和管理员可以回复
+// @Tags 课程讨论
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path int true "讨论帖ID"
+// @Param reply body CreateReplyRequest true "回复内容"
+// @Success 200 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Router /api/v1/discussions/{id}/replies [post]
+func (h *DiscussionHandler) CreateReply(c *gin.Context) {
+	threadID, ok := parseIDParam(c, "id", "讨论帖ID参数无效")
+	if !ok {
+		return
+	}
+
+	var req CreateReplyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    400,
+			"message": "请求参数无效: " + err.Error(),
+		})
+		return
+	}
+
+	resp, err := h.discussionGRPCClient.CreateReply(c.Request.Context(), threadID, uint(req.ParentID), c.GetUint("userID"), req.Body)
+	if err != nil {
+		respondGRPCError(c, "回复失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data":    convertReplyToDisplay(resp.Reply),
+	})
+}
+
+// UpvoteThread 点赞讨论帖
+// @Summary 点赞讨论帖
+// @Description POST 点赞，DELETE 取消点赞，重复操作不会重复计数
+// @Tags 课程讨论
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path int true "讨论帖ID"
+// @Success 200 {object} map[string]interface{}
+// @Router /api/v1/discussions/{id}/upvote [post]
+func (h *DiscussionHandler) UpvoteThread(c *gin.Context) {
+	h.vote(c, "thread", "id", "讨论帖ID参数无效")
+}
+
+// UpvoteReply 点赞回复
+// @Summary 点赞回复
+// @Description POST 点赞，DELETE 取消点赞，重复操作不会重复计数
+// @Tags 课程讨论
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param reply_id path int true "回复ID"
+// @Success 200 {object} map[string]interface{}
+// @Router /api/v1/discussions/replies/{reply_id}/upvote [post]
+func (h *DiscussionHandler) UpvoteReply(c *gin.Context) {
+	h.vote(c, "reply", "reply_id", "回复ID参数无效")
+}
+
+// ModerateThread 管理讨论帖
+// @Summary 管理讨论帖
+// @Description 讲师和管理员置顶（pin）、取消置顶（unpin）、锁定（lock）或解锁（unlock）讨论帖
+// @Tags 课程讨论
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path int true "讨论帖ID"
+// @Param action body ModerateThreadRequest true "管理操作"
+// @Success 200 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Router /api/v1/discussions/{id}/moderate [post]
+func (h *DiscussionHandler) ModerateThread(c *gin.Context) {
+	threadID, ok := parseIDParam(c, "id", "讨论帖ID参数无效")
+	if !ok {
+		return
+	}
+
+	var req ModerateThreadRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    400,
+			"message": "请求参数无效: " + err.Error(),
+		})
+		return
+	}
+
+	resp, err := h.discussionGRPCClient.ModerateThread(c.Request.Context(), threadID, c.GetUint("userID"), req.Action)
+	if err != nil {
+		respondGRPCError(c, "管理讨论帖失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data":    convertThreadToDisplay(resp.Thread),
+	})
+}
+
+// MarkAnswer 标记最佳回答
+// @Summary 标记最佳回答
+// @Description 讲师和管理员把某条回复标记为最佳回答，帖子随之标记为讲师已回答；reply_id 为0时取消标记
+// @Tags 课程讨论
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path int true "讨论帖ID"
+// @Param answer body MarkAnswerRequest true "最佳回答"
+// @Success 200 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Router /api/v1/discussions/{id}/answer [put]
+func (h *DiscussionHandler) MarkAnswer(c *gin.Context) {
+	threadID, ok := parseIDParam(c, "id", "讨论帖ID参数无效")
+	if !ok {
+		return
+	}
+
+	var req MarkAnswerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    400,
+			"message": "请求参数无效: " + err.Error(),
+		})
+		return
+	}
+
+	resp, err := h.discussionGRPCClient.MarkAnswer(c.Request.Context(), threadID, uint(req.ReplyID), c.GetUint("userID"))
+	if err != nil {
+		respondGRPCError(c, "标记最佳回答失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data":    convertThreadToDisplay(resp.Thread),
+	})
+}
+
+// DeleteThread 删除讨论帖
+// @Summary 删除讨论帖
+// @Description 作者本人、讲师或管理员删除讨论帖（软删除，管理者仍可查看）
+// @Tags 课程讨论
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path int true "讨论帖ID"
+// @Success 200 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Router /api/v1/discussions/{id} [delete]
+func (h *DiscussionHandler) DeleteThread(c *gin.Context) {
+	threadID, ok := parseIDParam(c, "id", "讨论帖ID参数无效")
+	if !ok {
+		return
+	}
+
+	resp, err := h.discussionGRPCClient.DeleteThread(c.Request.Context(), threadID, c.GetUint("userID"))
+	if err != nil {
+		respondGRPCError(c, "删除讨论帖失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	log.Printf("✅ API: 讨论帖已删除 - ID: %d", threadID)
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+	})
+}
+
+// DeleteReply 删除回复
+// @Summary 删除回复
+// @Description 作者本人、讲师或管理员删除回复（软删除，下级回复保留）
+// @Tags 课程讨论
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param reply_id path int true "回复ID"
+// @Success 200 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Router /api/v1/discussions/replies/{reply_id} [delete]
+func (h *DiscussionHandler) DeleteReply(c *gin.Context) {
+	replyID, ok := parseIDParam(c, "reply_id", "回复ID参数无效")
+	if !ok {
+		return
+	}
+
+	resp, err := h.discussionGRPCClient.DeleteReply(c.Request.Context(), replyID, c.GetUint("userID"))
+	if err != nil {
+		respondGRPCError(c, "删除回复失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+	})
+}
+
+// vote 点赞或取消点赞，DELETE 请求表示取消
+func (h *DiscussionHandler) vote(c *gin.Context, targetType, param, invalidMessage string) {
+	targetID, ok := parseIDParam(c, param, invalidMessage)
+	if !ok {
+		return
+	}
+	upvote := c.Request.Method != http.MethodDelete
+
+	resp, err := h.discussionGRPCClient.Vote(c.Request.Context(), targetType, targetID, c.GetUint("userID"), upvote)
+	if err != nil {
+		respondGRPCError(c, "点赞失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data": gin.H{
+			"upvotes": resp.Upvotes,
+			"upvoted": resp.Upvoted,
+		},
+	})
+}
+
+// replyNode 回复树节点
+type replyNode struct {
+	data     gin.H
+	children []*replyNode
+}
+
+// buildReplyTree 将按时间排序的回复列表组装为树形结构
+func buildReplyTree(replies []*discussionpb.Reply) []gin.H {
+	nodes := make(map[uint32]*replyNode, len(replies))
+	var roots []*replyNode
+	for _, reply := range replies {
+		node := &replyNode{data: convertReplyToDisplay(reply)}
+		nodes[reply.Id] = node
+		if parent, ok := nodes[reply.ParentId]; ok && reply.ParentId != 0 {
+			parent.children = append(parent.children, node)
+		} else {
+			roots = append(roots, node)
+		}
+	}
+	return renderReplyNodes(roots)
+}
+
+// renderReplyNodes 输出回复树
+func renderReplyNodes(nodes []*replyNode) []gin.H {
+	result := make([]gin.H, 0, len(nodes))
+	for _, node := range nodes {
+		node.data["replies"] = renderReplyNodes(node.children)
+		result = append(result, node.data)
+	}
+	return result
+}
+
+// convertThreadToDisplay 转换讨论帖显示数据（protobuf的JSON会省略零值，布尔标记需要显式输出）
+func convertThreadToDisplay(thread *discussionpb.Thread) gin.H {
+	if thread == nil {
+		return gin.H{}
+	}
+	return gin.H{
+		"id":                   thread.Id,
+		"course_id":            thread.CourseId,
+		"chapter_id":           thread.ChapterId,
+		"author_id":            thread.AuthorId,
+		"author_name":          thread.AuthorName,
+		"author_is_instructor": thread.AuthorIsInstructor,
+		"title":                thread.Title,
+		"body":                 thread.Body,
+		"pinned":               thread.Pinned,
+		"locked":               thread.Locked,
+		"instructor_answered":  thread.InstructorAnswered,
+		"answer_reply_id":      thread.AnswerReplyId,
+		"reply_count":          thread.ReplyCount,
+		"upvotes":              thread.Upvotes,
+		"upvoted":              thread.Upvoted,
+		"deleted":              thread.Deleted,
+		"created_at":           thread.CreatedAt,
+		"last_activity_at":     thread.LastActivityAt,
+	}
+}
+
+// convertReplyToDisplay 转换回复显示数据
+func convertReplyToDisplay(reply *discussionpb.Reply) gin.H {
+	if reply == nil {
+		return gin.H{}
+	}
+	return gin.H{
+		"id":            reply.Id,
+		"thread_id":     reply.ThreadId,
+		"parent_id":     reply.ParentId,
+		"depth":         reply.Depth,
+		"author_id":     reply.AuthorId,
+		"author_name":   reply.AuthorName,
+		"body":          reply.Body,
+		"by_instructor": reply.ByInstructor,
+		"is_answer":     reply.IsAnswer,
+		"upvotes":       reply.Upvotes,
+		"upvoted":       reply.Upvoted,
+		"deleted":       reply.Deleted,
+		"created_at":    reply.CreatedAt,
+	}
+}
+
+// excerpt 截取正文摘要
+func excerpt(body string) string {
+	if utf8.RuneCountInString(body) <= excerptLength {
+		return body
+	}
+	return string([]rune(body)[:excerptLength]) + "…"
+}
+
+// parsePage 解析分页参数
+func parsePage(c *gin.Context) (int, int) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "20"))
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 50 {
+		pageSize = 20
+	}
+	return page, pageSize
+}
+
+// parseIDParam 解析路径中的ID参数，失败时直接返回400
+func parseIDParam(c *gin.Context, name, message string) (uint, bool) {
+	id, err := strconv.ParseUint(c.Param(name), 10, 32)
+	if err != nil || id == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    400,
+			"message": message,
+		})
+		return 0, false
+	}
+	return uint(id), true
+}
+
+// respondGRPCError 返回调用微服务失败的响应
+func respondGRPCError(c *gin.Context, action string, err error) {
+	log.Printf("❌ API: %s - %v", action, err)
+	c.JSON(http.StatusInternalServerError, gin.H{
+		"code":    500,
+		"message": action + ": " + err.Error(),
+	})
+}
+
+// respondBusinessError 按业务码返回对应HTTP状态
+func respondBusinessError(c *gin.Context, code int32, message string) {
+	status := http.StatusBadRequest
+	switch code {
+	case 403:
+		status = http.StatusForbidden
+	case 404:
+		status = http.StatusNotFound
+	case 409:
+		status = http.StatusConflict
+	}
+	c.JSON(status, gin.H{
+		"code":    code,
+		"message": message,
+	})
+}
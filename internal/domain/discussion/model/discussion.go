@@ -0,0 +1,120 @@
+package model
+
+import (
+	"time"
+)
+
+// 点赞对象类型
+const (
+	TargetThread = "thread"
+	TargetReply  = "reply"
+)
+
+// 讨论帖管理操作
+const (
+	ActionPin    = "pin"
+	ActionUnpin  = "unpin"
+	ActionLock   = "lock"
+	ActionUnlock = "unlock"
+)
+
+// 讨论区排序方式
+const (
+	SortLatest     = "latest"     // 按最后活跃时间
+	SortTop        = "top"        // 按点赞数
+	SortUnanswered = "unanswered" // 只看讲师未回答的
+)
+
+// 讨论区的长度与层级限制
+const (
+	MaxThreadTitleLength = 200
+	MaxPostBodyLength    = 10000
+	MaxReplyDepth        = 5 // 回复的最大嵌套层级，超过后挂到上一层
+)
+
+// Thread 课程讨论帖，ChapterID 为0表示课程整体的讨论
+type Thread struct {
+	ID        uint      `gorm:"primarykey" json:"id"` // 主键ID
+	CreatedAt time.Time `json:"created_at"`           // 创建时间
+	UpdatedAt time.Time `json:"updated_at"`           // 更新时间
+
+	CourseID           uint       `gorm:"not null;index:idx_thread_course" json:"course_id"`        // 所属课程ID
+	ChapterID          uint       `gorm:"not null;default:0;index" json:"chapter_id"`               // 所属章节ID，0表示不限章节
+	AuthorID           uint       `gorm:"not null;index" json:"author_id"`                          // 发帖人ID
+	Title              string     `gorm:"size:200;not null" json:"title"`                           // 标题
+	Body               string     `gorm:"type:text" json:"body"`                                    // 正文
+	Pinned             bool       `gorm:"not null;default:false" json:"pinned"`                     // 是否置顶
+	Locked             bool       `gorm:"not null;default:false" json:"locked"`                     // 是否锁定（锁定后学员不能回复）
+	InstructorAnswered bool       `gorm:"not null;default:false" json:"instructor_answered"`        // 讲师是否已回答
+	AnswerReplyID      uint       `gorm:"not null;default:0" json:"answer_reply_id"`                // 讲师标记的最佳回答
+	ReplyCount         int        `gorm:"not null;default:0" json:"reply_count"`                    // 回复数（不含已删除）
+	Upvotes            int        `gorm:"not null;default:0" json:"upvotes"`                        // 点赞数
+	LastActivityAt     time.Time  `gorm:"not null;index:idx_thread_course" json:"last_activity_at"` // 最后活跃时间
+	DeletedAt          *time.Time `gorm:"index" json:"deleted_at,omitempty"`                        // 删除时间（软删除，保留记录）
+	DeletedBy          uint       `gorm:"not null;default:0" json:"deleted_by,omitempty"`           // 删除人ID
+
+	// 以下字段不入库，由服务层填充
+	AuthorName         string `gorm:"-" json:"author_name"`                    // 发帖人名称
+	AuthorIsInstructor bool   `gorm:"-" json:"author_is_instructor,omitempty"` // 发帖人是否为课程讲师
+	Upvoted            bool   `gorm:"-" json:"upvoted,omitempty"`              // 当前用户是否已点赞
+}
+
+// TableName 指定表名
+func (Thread) TableName() string {
+	return "discussion_threads"
+}
+
+// IsDeleted 是否已被删除
+func (t *Thread) IsDeleted() bool {
+	return t.DeletedAt != nil
+}
+
+// Reply 讨论帖的回复，ParentID 为0表示直接回复帖子
+// RootID 为所在顶层回复的ID（顶层回复为自身），用于按顶层回复分页加载整棵回复树
+type Reply struct {
+	ID        uint      `gorm:"primarykey" json:"id"` // 主键ID
+	CreatedAt time.Time `json:"created_at"`           // 创建时间
+	UpdatedAt time.Time `json:"updated_at"`           // 更新时间
+
+	ThreadID     uint       `gorm:"not null;index:idx_reply_thread" json:"thread_id"`           // 所属讨论帖ID
+	CourseID     uint       `gorm:"not null;index" json:"course_id"`                            // 所属课程ID（冗余，便于权限检查）
+	ParentID     uint       `gorm:"not null;default:0;index:idx_reply_thread" json:"parent_id"` // 上级回复ID
+	RootID       uint       `gorm:"not null;default:0;index" json:"root_id"`                    // 顶层回复ID
+	Depth        int        `gorm:"not null;default:0" json:"depth"`                            // 嵌套层级，顶层回复为0
+	AuthorID     uint       `gorm:"not null;index" json:"author_id"`                            // 回复人ID
+	Body         string     `gorm:"type:text" json:"body"`                                      // 回复内容
+	ByInstructor bool       `gorm:"not null;default:false" json:"by_instructor"`                // 是否为讲师回复
+	Upvotes      int        `gorm:"not null;default:0" json:"upvotes"`                          // 点赞数
+	DeletedAt    *time.Time `gorm:"index" json:"deleted_at,omitempty"`                          // 删除时间（软删除，保留回复树结构）
+	DeletedBy    uint       `gorm:"not null;default:0" json:"deleted_by,omitempty"`             // 删除人ID
+
+	// 以下字段不入库，由服务层填充
+	AuthorName string `gorm:"-" json:"author_name"`       // 回复人名称
+	IsAnswer   bool   `gorm:"-" json:"is_answer"`         // 是否为讲师标记的最佳回答
+	Upvoted    bool   `gorm:"-" json:"upvoted,omitempty"` // 当前用户是否已点赞
+}
+
+// TableName 指定表名
+func (Reply) TableName() string {
+	return "discussion_replies"
+}
+
+// IsDeleted 是否已被删除
+func (r *Reply) IsDeleted() bool {
+	return r.DeletedAt != nil
+}
+
+// Vote 点赞记录，每人对同一帖子或回复只能点赞一次
+type Vote struct {
+	ID        uint      `gorm:"primarykey" json:"id"` // 主键ID
+	CreatedAt time.Time `json:"created_at"`           // 创建时间
+
+	UserID     uint   `gorm:"not null;uniqueIndex:idx_discussion_vote" json:"user_id"`             // 点赞人ID
+	TargetType string `gorm:"size:10;not null;uniqueIndex:idx_discussion_vote" json:"target_type"` // 点赞对象类型
+	TargetID   uint   `gorm:"not null;uniqueIndex:idx_discussion_vote" json:"target_id"`           // 点赞对象ID
+}
+
+// TableName 指定表名
+func (Vote) TableName() string {
+	return "discussion_votes"
+}
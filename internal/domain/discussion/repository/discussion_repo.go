@@ -0,0 +1,330 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"course-platform/internal/domain/discussion/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ThreadFilter 讨论帖查询条件
+type ThreadFilter struct {
+	CourseID       uint
+	ChapterID      uint   // 0表示不限章节
+	Sort           string // latest/top/unanswered
+	IncludeDeleted bool   // 管理者可以看到已删除的帖子
+}
+
+// DiscussionRepositoryInterface 讨论区仓储接口
+type DiscussionRepositoryInterface interface {
+	CreateThread(thread *model.Thread) error
+	UpdateThread(thread *model.Thread) error
+	GetThread(id uint) (*model.Thread, error)
+	ListThreads(filter ThreadFilter, offset, limit int) ([]*model.Thread, int64, error)
+	DeleteThread(thread *model.Thread, userID uint) error
+	CreateReply(reply *model.Reply) error
+	GetReply(id uint) (*model.Reply, error)
+	ListReplies(threadID uint, offset, limit int) ([]*model.Reply, int64, error)
+	DeleteReply(reply *model.Reply, userID uint) error
+	AddVote(vote *model.Vote) (bool, error)
+	RemoveVote(userID uint, targetType string, targetID uint) (bool, error)
+	VotedIDs(userID uint, targetType string, targetIDs []uint) (map[uint]bool, error)
+	ListThreadsByAuthor(authorID uint) ([]*model.Thread, error)
+	ListRepliesByAuthor(authorID uint) ([]*model.Reply, error)
+	AnonymizeAuthor(authorID uint) error
+}
+
+// DiscussionRepository 讨论区仓储实现
+type DiscussionRepository struct {
+	db *gorm.DB
+}
+
+// NewDiscussionRepository 创建讨论区仓储实例
+func NewDiscussionRepository(db *gorm.DB) DiscussionRepositoryInterface {
+	return &DiscussionRepository{db: db}
+}
+
+// CreateThread 创建讨论帖
+func (r *DiscussionRepository) CreateThread(thread *model.Thread) error {
+	if err := r.db.Create(thread).Error; err != nil {
+		log.Printf("❌ Repository: 创建讨论帖失败 - %v", err)
+		return fmt.Errorf("创建讨论帖失败: %w", err)
+	}
+
+	log.Printf("✅ Repository: 讨论帖创建成功 - ID: %d", thread.ID)
+	return nil
+}
+
+// UpdateThread 更新讨论帖的管理状态（置顶、锁定、最佳回答）
+func (r *DiscussionRepository) UpdateThread(thread *model.Thread) error {
+	if err := r.db.Model(thread).
+		Select("pinned", "locked", "answer_reply_id", "instructor_answered").
+		Updates(thread).Error; err != nil {
+		return fmt.Errorf("更新讨论帖失败: %w", err)
+	}
+	return nil
+}
+
+// GetThread 根据ID获取讨论帖（包括已删除的）
+func (r *DiscussionRepository) GetThread(id uint) (*model.Thread, error) {
+	var thread model.Thread
+	if err := r.db.First(&thread, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("讨论帖不存在")
+		}
+		return nil, fmt.Errorf("查询讨论帖失败: %w", err)
+	}
+	return &thread, nil
+}
+
+// ListThreads 分页获取课程讨论帖，置顶帖始终排在最前
+func (r *DiscussionRepository) ListThreads(filter ThreadFilter, offset, limit int) ([]*model.Thread, int64, error) {
+	query := r.db.Model(&model.Thread{}).Where("course_id = ?", filter.CourseID)
+	if filter.ChapterID > 0 {
+		query = query.Where("chapter_id = ?", filter.ChapterID)
+	}
+	if !filter.IncludeDeleted {
+		query = query.Where("deleted_at IS NULL")
+	}
+	if filter.Sort == model.SortUnanswered {
+		query = query.Where("instructor_answered = ?", false)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("统计讨论帖失败: %w", err)
+	}
+
+	order := "pinned DESC, last_activity_at DESC, id DESC"
+	if filter.Sort == model.SortTop {
+		order = "pinned DESC, upvotes DESC, last_activity_at DESC, id DESC"
+	}
+
+	var threads []*model.Thread
+	if err := query.Order(order).Offset(offset).Limit(limit).Find(&threads).Error; err != nil {
+		log.Printf("❌ Repository: 查询讨论帖列表失败 - %v", err)
+		return nil, 0, fmt.Errorf("查询讨论帖列表失败: %w", err)
+	}
+	return threads, total, nil
+}
+
+// DeleteThread 软删除讨论帖
+func (r *DiscussionRepository) DeleteThread(thread *model.Thread, userID uint) error {
+	now := time.Now()
+	if err := r.db.Model(thread).Updates(map[string]interface{}{
+		"deleted_at": now,
+		"deleted_by": userID,
+	}).Error; err != nil {
+		return fmt.Errorf("删除讨论帖失败: %w", err)
+	}
+	thread.DeletedAt = &now
+	thread.DeletedBy = userID
+	return nil
+}
+
+// CreateReply 创建回复，同时更新帖子的回复数、活跃时间和讲师回答标记
+func (r *DiscussionRepository) CreateReply(reply *model.Reply) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(reply).Error; err != nil {
+			return err
+		}
+		if reply.ParentID == 0 {
+			reply.RootID = reply.ID
+			if err := tx.Model(reply).Update("root_id", reply.ID).Error; err != nil {
+				return err
+			}
+		}
+
+		updates := map[string]interface{}{
+			"reply_count":      gorm.Expr("reply_count + 1"),
+			"last_activity_at": reply.CreatedAt,
+		}
+		if reply.ByInstructor {
+			updates["instructor_answered"] = true
+		}
+		return tx.Model(&model.Thread{}).Where("id = ?", reply.ThreadID).Updates(updates).Error
+	})
+	if err != nil {
+		log.Printf("❌ Repository: 创建回复失败 - %v", err)
+		return fmt.Errorf("创建回复失败: %w", err)
+	}
+	return nil
+}
+
+// GetReply 根据ID获取回复（包括已删除的）
+func (r *DiscussionRepository) GetReply(id uint) (*model.Reply, error) {
+	var reply model.Reply
+	if err := r.db.First(&reply, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("回复不存在")
+		}
+		return nil, fmt.Errorf("查询回复失败: %w", err)
+	}
+	return &reply, nil
+}
+
+// ListReplies 按顶层回复分页，返回本页顶层回复及其下全部嵌套回复（按时间顺序），total 为顶层回复总数
+func (r *DiscussionRepository) ListReplies(threadID uint, offset, limit int) ([]*model.Reply, int64, error) {
+	topLevel := r.db.Model(&model.Reply{}).Where("thread_id = ? AND parent_id = 0", threadID)
+
+	var total int64
+	if err := topLevel.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("统计回复失败: %w", err)
+	}
+
+	var rootIDs []uint
+	if err := topLevel.Order("id ASC").Offset(offset).Limit(limit).Pluck("id", &rootIDs).Error; err != nil {
+		return nil, 0, fmt.Errorf("查询回复失败: %w", err)
+	}
+
+	var replies []*model.Reply
+	if len(rootIDs) == 0 {
+		return replies, total, nil
+	}
+	if err := r.db.Where("thread_id = ? AND root_id IN ?", threadID, rootIDs).
+		Order("id ASC").Find(&replies).Error; err != nil {
+		log.Printf("❌ Repository: 查询回复列表失败 - %v", err)
+		return nil, 0, fmt.Errorf("查询回复列表失败: %w", err)
+	}
+	return replies, total, nil
+}
+
+// DeleteReply 软删除回复，保留记录以维持回复树结构
+func (r *DiscussionRepository) DeleteReply(reply *model.Reply, userID uint) error {
+	now := time.Now()
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&model.Reply{}).Where("id = ? AND deleted_at IS NULL", reply.ID).
+			Updates(map[string]interface{}{
+				"deleted_at": now,
+				"deleted_by": userID,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		return tx.Model(&model.Thread{}).Where("id = ? AND reply_count > 0", reply.ThreadID).
+			Update("reply_count", gorm.Expr("reply_count - 1")).Error
+	})
+	if err != nil {
+		return fmt.Errorf("删除回复失败: %w", err)
+	}
+	reply.DeletedAt = &now
+	reply.DeletedBy = userID
+	return nil
+}
+
+// AddVote 点赞，已点赞过时返回false
+func (r *DiscussionRepository) AddVote(vote *model.Vote) (bool, error) {
+	added := false
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(vote)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		added = true
+		return tx.Model(voteTarget(vote.TargetType)).Where("id = ?", vote.TargetID).
+			Update("upvotes", gorm.Expr("upvotes + 1")).Error
+	})
+	if err != nil {
+		return false, fmt.Errorf("点赞失败: %w", err)
+	}
+	return added, nil
+}
+
+// RemoveVote 取消点赞，未点赞过时返回false
+func (r *DiscussionRepository) RemoveVote(userID uint, targetType string, targetID uint) (bool, error) {
+	removed := false
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("user_id = ? AND target_type = ? AND target_id = ?", userID, targetType, targetID).
+			Delete(&model.Vote{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		removed = true
+		return tx.Model(voteTarget(targetType)).Where("id = ? AND upvotes > 0", targetID).
+			Update("upvotes", gorm.Expr("upvotes - 1")).Error
+	})
+	if err != nil {
+		return false, fmt.Errorf("取消点赞失败: %w", err)
+	}
+	return removed, nil
+}
+
+// VotedIDs 获取用户已点赞的对象ID
+func (r *DiscussionRepository) VotedIDs(userID uint, targetType string, targetIDs []uint) (map[uint]bool, error) {
+	voted := make(map[uint]bool)
+	if userID == 0 || len(targetIDs) == 0 {
+		return voted, nil
+	}
+
+	var ids []uint
+	if err := r.db.Model(&model.Vote{}).
+		Where("user_id = ? AND target_type = ? AND target_id IN ?", userID, targetType, targetIDs).
+		Pluck("target_id", &ids).Error; err != nil {
+		return nil, fmt.Errorf("查询点赞记录失败: %w", err)
+	}
+	for _, id := range ids {
+		voted[id] = true
+	}
+	return voted, nil
+}
+
+// ListThreadsByAuthor 获取用户发布的全部讨论帖（含已删除的）
+func (r *DiscussionRepository) ListThreadsByAuthor(authorID uint) ([]*model.Thread, error) {
+	var threads []*model.Thread
+	if err := r.db.Where("author_id = ?", authorID).Order("id ASC").Find(&threads).Error; err != nil {
+		log.Printf("❌ Repository: 查询用户讨论帖失败 - %v", err)
+		return nil, fmt.Errorf("查询讨论帖失败: %w", err)
+	}
+	return threads, nil
+}
+
+// ListRepliesByAuthor 获取用户发布的全部回复（含已删除的）
+func (r *DiscussionRepository) ListRepliesByAuthor(authorID uint) ([]*model.Reply, error) {
+	var replies []*model.Reply
+	if err := r.db.Where("author_id = ?", authorID).Order("id ASC").Find(&replies).Error; err != nil {
+		log.Printf("❌ Repository: 查询用户回复失败 - %v", err)
+		return nil, fmt.Errorf("查询回复失败: %w", err)
+	}
+	return replies, nil
+}
+
+// AnonymizeAuthor 把用户的讨论帖和回复改为匿名（作者ID置0）并删除点赞记录
+// 已计入的点赞数保持不变，内容和回复树结构保留
+func (r *DiscussionRepository) AnonymizeAuthor(authorID uint) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&model.Thread{}).Where("author_id = ?", authorID).Update("author_id", 0).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&model.Reply{}).Where("author_id = ?", authorID).Update("author_id", 0).Error; err != nil {
+			return err
+		}
+		return tx.Where("user_id = ?", authorID).Delete(&model.Vote{}).Error
+	})
+	if err != nil {
+		log.Printf("❌ Repository: 匿名化讨论内容失败 - %v", err)
+		return fmt.Errorf("匿名化讨论内容失败: %w", err)
+	}
+	return nil
+}
+
+// voteTarget 点赞对象对应的模型
+func voteTarget(targetType string) interface{} {
+	if targetType == model.TargetReply {
+		return &model.Reply{}
+	}
+	return &model.Thread{}
+}
@@ -0,0 +1,579 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	courseModel "course-platform/internal/domain/course/model"
+	courseService "course-platform/internal/domain/course/service"
+	"course-platform/internal/domain/discussion/model"
+	"course-platform/internal/domain/discussion/repository"
+	notificationModel "course-platform/internal/domain/notification/model"
+	notificationService "course-platform/internal/domain/notification/service"
+	userRepository "course-platform/internal/domain/user/repository"
+)
+
+// deletedAuthorName 作者已注销（作者ID为0）时显示的名称
+const deletedAuthorName = "已注销用户"
+
+// 分页默认值
+const (
+	defaultPageSize = 20
+	maxPageSize     = 50
+)
+
+// DiscussionServiceInterface 课程讨论区服务接口
+type DiscussionServiceInterface interface {
+	CreateThread(req *CreateThreadRequest) (*model.Thread, error)
+	ListThreads(req *ListThreadsRequest) ([]*model.Thread, int64, error)
+	GetThread(threadID, userID uint, page, pageSize int) (*model.Thread, []*model.Reply, int64, error)
+	CreateReply(threadID, parentID, userID uint, body string) (*model.Reply, error)
+	Vote(userID uint, targetType string, targetID uint, upvote bool) (int, error)
+	ModerateThread(threadID, userID uint, action string) (*model.Thread, error)
+	MarkAnswer(threadID, replyID, userID uint) (*model.Thread, error)
+	DeleteThread(threadID, userID uint) error
+	DeleteReply(replyID, userID uint) error
+
+	// 个人数据导出与账号注销
+	ListUserPosts(userID uint) ([]*model.Thread, []*model.Reply, error)
+	AnonymizeUser(userID uint) error
+}
+
+// CreateThreadRequest 发帖请求，ChapterID 为0表示课程整体的讨论
+type CreateThreadRequest struct {
+	CourseID  uint
+	ChapterID uint
+	UserID    uint
+	Title     string
+	Body      string
+}
+
+// ListThreadsRequest 讨论帖列表请求
+type ListThreadsRequest struct {
+	CourseID  uint
+	ChapterID uint
+	UserID    uint
+	Sort      string
+	Page      int
+	PageSize  int
+}
+
+// DiscussionService 课程讨论区服务实现
+type DiscussionService struct {
+	discussionRepo  repository.DiscussionRepositoryInterface
+	courseService   courseService.CourseServiceInterface
+	userRepo        userRepository.UserRepositoryInterface
+	notificationSvc notificationService.NotificationServiceInterface
+}
+
+// NewDiscussionService 创建课程讨论区服务实例
+func NewDiscussionService(discussionRepo repository.DiscussionRepositoryInterface, courseService courseService.CourseServiceInterface, userRepo userRepository.UserRepositoryInterface, notificationSvc notificationService.NotificationServiceInterface) DiscussionServiceInterface {
+	return &DiscussionService{
+		discussionRepo:  discussionRepo,
+		courseService:   courseService,
+		userRepo:        userRepo,
+		notificationSvc: notificationSvc,
+	}
+}
+
+// CreateThread 发帖（需报名课程）
+func (s *DiscussionService) CreateThread(req *CreateThreadRequest) (*model.Thread, error) {
+	log.Printf("🔍 Service: 发布讨论帖 - 课程ID: %d, 用户ID: %d", req.CourseID, req.UserID)
+
+	course, _, err := s.checkAccess(req.CourseID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	title := strings.TrimSpace(req.Title)
+	body := strings.TrimSpace(req.Body)
+	if title == "" {
+		return nil, errors.New("标题不能为空")
+	}
+	if utf8.RuneCountInString(title) > model.MaxThreadTitleLength {
+		return nil, fmt.Errorf("标题不能超过%d个字", model.MaxThreadTitleLength)
+	}
+	if utf8.RuneCountInString(body) > model.MaxPostBodyLength {
+		return nil, fmt.Errorf("正文不能超过%d个字", model.MaxPostBodyLength)
+	}
+
+	if req.ChapterID > 0 {
+		chapter, err := s.courseService.GetChapterByID(req.ChapterID)
+		if err != nil || chapter.CourseID != req.CourseID {
+			return nil, errors.New("章节不存在")
+		}
+	}
+
+	thread := &model.Thread{
+		CourseID:       req.CourseID,
+		ChapterID:      req.ChapterID,
+		AuthorID:       req.UserID,
+		Title:          title,
+		Body:           body,
+		LastActivityAt: time.Now(),
+	}
+	if err := s.discussionRepo.CreateThread(thread); err != nil {
+		return nil, err
+	}
+	s.fillThreads([]*model.Thread{thread}, req.UserID, course, true)
+	return thread, nil
+}
+
+// ListThreads 分页获取课程讨论帖（需报名课程），管理者可以看到已删除的帖子
+func (s *DiscussionService) ListThreads(req *ListThreadsRequest) ([]*model.Thread, int64, error) {
+	course, moderator, err := s.checkAccess(req.CourseID, req.UserID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	sort := req.Sort
+	switch sort {
+	case "", model.SortLatest:
+		sort = model.SortLatest
+	case model.SortTop, model.SortUnanswered:
+	default:
+		return nil, 0, errors.New("排序方式无效")
+	}
+
+	page, pageSize := normalizePage(req.Page, req.PageSize)
+	threads, total, err := s.discussionRepo.ListThreads(repository.ThreadFilter{
+		CourseID:       req.CourseID,
+		ChapterID:      req.ChapterID,
+		Sort:           sort,
+		IncludeDeleted: moderator,
+	}, (page-1)*pageSize, pageSize)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	s.fillThreads(threads, req.UserID, course, moderator)
+	return threads, total, nil
+}
+
+// GetThread 获取讨论帖及分页的回复（按顶层回复分页，包含其下全部嵌套回复）
+func (s *DiscussionService) GetThread(threadID, userID uint, page, pageSize int) (*model.Thread, []*model.Reply, int64, error) {
+	thread, err := s.discussionRepo.GetThread(threadID)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	course, moderator, err := s.checkAccess(thread.CourseID, userID)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	if thread.IsDeleted() && !moderator {
+		return nil, nil, 0, errors.New("讨论帖不存在")
+	}
+
+	page, pageSize = normalizePage(page, pageSize)
+	replies, total, err := s.discussionRepo.ListReplies(threadID, (page-1)*pageSize, pageSize)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	s.fillThreads([]*model.Thread{thread}, userID, course, moderator)
+	s.fillReplies(replies, thread, userID, moderator)
+	return thread, replies, total, nil
+}
+
+// CreateReply 回复讨论帖或其中的回复，帖子锁定后只有管理者可以回复
+func (s *DiscussionService) CreateReply(threadID, parentID, userID uint, body string) (*model.Reply, error) {
+	log.Printf("🔍 Service: 回复讨论帖 - 帖子ID: %d, 用户ID: %d", threadID, userID)
+
+	thread, err := s.discussionRepo.GetThread(threadID)
+	if err != nil {
+		return nil, err
+	}
+	course, moderator, err := s.checkAccess(thread.CourseID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if thread.IsDeleted() {
+		return nil, errors.New("讨论帖不存在")
+	}
+	if thread.Locked && !moderator {
+		return nil, errors.New("讨论帖已锁定，无法回复")
+	}
+
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return nil, errors.New("回复内容不能为空")
+	}
+	if utf8.RuneCountInString(body) > model.MaxPostBodyLength {
+		return nil, fmt.Errorf("回复不能超过%d个字", model.MaxPostBodyLength)
+	}
+
+	reply := &model.Reply{
+		ThreadID:     threadID,
+		CourseID:     thread.CourseID,
+		AuthorID:     userID,
+		Body:         body,
+		ByInstructor: course.InstructorID == userID,
+	}
+	// 帖子作者和被回复的人会收到通知
+	recipients := []uint{thread.AuthorID}
+	if parentID > 0 {
+		parent, err := s.discussionRepo.GetReply(parentID)
+		if err != nil || parent.ThreadID != threadID {
+			return nil, errors.New("回复不存在")
+		}
+		if parent.IsDeleted() {
+			return nil, errors.New("不能回复已删除的内容")
+		}
+		// 超过最大层级时挂到上级回复的同一层，避免无限嵌套
+		if parent.Depth+1 > model.MaxReplyDepth {
+			parentID = parent.ParentID
+			reply.Depth = parent.Depth
+		} else {
+			reply.Depth = parent.Depth + 1
+		}
+		reply.ParentID = parentID
+		reply.RootID = parent.RootID
+		recipients = append(recipients, parent.AuthorID)
+	}
+
+	if err := s.discussionRepo.CreateReply(reply); err != nil {
+		return nil, err
+	}
+	s.fillReplies([]*model.Reply{reply}, thread, userID, moderator)
+	s.notifyReply(thread, reply, recipients)
+	return reply, nil
+}
+
+// Vote 点赞或取消点赞，返回最新点赞数
+func (s *DiscussionService) Vote(userID uint, targetType string, targetID uint, upvote bool) (int, error) {
+	var courseID uint
+	switch targetType {
+	case model.TargetThread:
+		thread, err := s.discussionRepo.GetThread(targetID)
+		if err != nil {
+			return 0, err
+		}
+		if thread.IsDeleted() {
+			return 0, errors.New("讨论帖不存在")
+		}
+		courseID = thread.CourseID
+	case model.TargetReply:
+		reply, err := s.discussionRepo.GetReply(targetID)
+		if err != nil {
+			return 0, err
+		}
+		if reply.IsDeleted() {
+			return 0, errors.New("回复不存在")
+		}
+		courseID = reply.CourseID
+	default:
+		return 0, errors.New("点赞对象类型无效")
+	}
+
+	if _, _, err := s.checkAccess(courseID, userID); err != nil {
+		return 0, err
+	}
+
+	if upvote {
+		_, err := s.discussionRepo.AddVote(&model.Vote{
+			UserID:     userID,
+			TargetType: targetType,
+			TargetID:   targetID,
+		})
+		if err != nil {
+			return 0, err
+		}
+	} else if _, err := s.discussionRepo.RemoveVote(userID, targetType, targetID); err != nil {
+		return 0, err
+	}
+
+	if targetType == model.TargetThread {
+		thread, err := s.discussionRepo.GetThread(targetID)
+		if err != nil {
+			return 0, err
+		}
+		return thread.Upvotes, nil
+	}
+	reply, err := s.discussionRepo.GetReply(targetID)
+	if err != nil {
+		return 0, err
+	}
+	return reply.Upvotes, nil
+}
+
+// ModerateThread 置顶、取消置顶、锁定或解锁讨论帖（讲师和管理员）
+func (s *DiscussionService) ModerateThread(threadID, userID uint, action string) (*model.Thread, error) {
+	log.Printf("🔍 Service: 管理讨论帖 - 帖子ID: %d, 操作: %s", threadID, action)
+
+	thread, course, err := s.moderatedThread(threadID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch action {
+	case model.ActionPin:
+		thread.Pinned = true
+	case model.ActionUnpin:
+		thread.Pinned = false
+	case model.ActionLock:
+		thread.Locked = true
+	case model.ActionUnlock:
+		thread.Locked = false
+	default:
+		return nil, errors.New("管理操作无效")
+	}
+
+	if err := s.discussionRepo.UpdateThread(thread); err != nil {
+		return nil, err
+	}
+	s.fillThreads([]*model.Thread{thread}, userID, course, true)
+	return thread, nil
+}
+
+// MarkAnswer 讲师标记最佳回答，replyID 为0表示取消标记
+// 取消标记不会清除讲师已回答的状态（讲师本人的回复同样计为已回答）
+func (s *DiscussionService) MarkAnswer(threadID, replyID, userID uint) (*model.Thread, error) {
+	thread, course, err := s.moderatedThread(threadID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if replyID > 0 {
+		reply, err := s.discussionRepo.GetReply(replyID)
+		if err != nil || reply.ThreadID != threadID || reply.IsDeleted() {
+			return nil, errors.New("回复不存在")
+		}
+		thread.InstructorAnswered = true
+	}
+	thread.AnswerReplyID = replyID
+
+	if err := s.discussionRepo.UpdateThread(thread); err != nil {
+		return nil, err
+	}
+	s.fillThreads([]*model.Thread{thread}, userID, course, true)
+	return thread, nil
+}
+
+// DeleteThread 删除讨论帖（作者本人、讲师或管理员），只做软删除
+func (s *DiscussionService) DeleteThread(threadID, userID uint) error {
+	thread, err := s.discussionRepo.GetThread(threadID)
+	if err != nil {
+		return err
+	}
+	_, moderator, err := s.checkAccess(thread.CourseID, userID)
+	if err != nil {
+		return err
+	}
+	if thread.IsDeleted() {
+		return errors.New("讨论帖不存在")
+	}
+	if thread.AuthorID != userID && !moderator {
+		return errors.New("无权删除该讨论帖")
+	}
+
+	log.Printf("🔍 Service: 删除讨论帖 - 帖子ID: %d, 操作人: %d", threadID, userID)
+	return s.discussionRepo.DeleteThread(thread, userID)
+}
+
+// DeleteReply 删除回复（作者本人、讲师或管理员），只做软删除，下级回复保留
+func (s *DiscussionService) DeleteReply(replyID, userID uint) error {
+	reply, err := s.discussionRepo.GetReply(replyID)
+	if err != nil {
+		return err
+	}
+	_, moderator, err := s.checkAccess(reply.CourseID, userID)
+	if err != nil {
+		return err
+	}
+	if reply.IsDeleted() {
+		return errors.New("回复不存在")
+	}
+	if reply.AuthorID != userID && !moderator {
+		return errors.New("无权删除该回复")
+	}
+
+	log.Printf("🔍 Service: 删除回复 - 回复ID: %d, 操作人: %d", replyID, userID)
+	return s.discussionRepo.DeleteReply(reply, userID)
+}
+
+// notifyReply 通知帖子作者和被回复的人（不通知回复者本人和已注销用户），发送失败只记录日志
+func (s *DiscussionService) notifyReply(thread *model.Thread, reply *model.Reply, recipients []uint) {
+	userIDs := make([]uint, 0, len(recipients))
+	for _, id := range recipients {
+		if id != 0 && id != reply.AuthorID {
+			userIDs = append(userIDs, id)
+		}
+	}
+	if len(userIDs) == 0 {
+		return
+	}
+
+	body := []rune(reply.Body)
+	if len(body) > 100 {
+		body = append(body[:100], []rune("…")...)
+	}
+	if _, err := s.notificationSvc.Notify(userIDs, &notificationService.Message{
+		Type:  notificationModel.TypeDiscussionReply,
+		Title: fmt.Sprintf("%s 回复了「%s」", reply.AuthorName, thread.Title),
+		Body:  string(body),
+		Link:  fmt.Sprintf("/course/%d", thread.CourseID),
+		RefID: thread.ID,
+	}); err != nil {
+		log.Printf("⚠️ Service: 回复通知发送失败 - 帖子ID: %d, 错误: %v", thread.ID, err)
+	}
+}
+
+// checkAccess 检查用户能否参与课程讨论，返回课程和是否为管理者（课程讲师或平台管理员）
+func (s *DiscussionService) checkAccess(courseID, userID uint) (*courseModel.Course, bool, error) {
+	if userID == 0 {
+		return nil, false, errors.New("需要报名课程后才能参与讨论")
+	}
+
+	course, err := s.courseService.GetCourseByID(courseID)
+	if err != nil {
+		return nil, false, err
+	}
+	if course.InstructorID == userID || s.isAdmin(userID) {
+		return course, true, nil
+	}
+
+	hasAccess, err := s.courseService.HasCourseAccess(userID, courseID)
+	if err != nil {
+		return nil, false, err
+	}
+	if !hasAccess {
+		return nil, false, errors.New("需要报名课程后才能参与讨论")
+	}
+	return course, false, nil
+}
+
+// moderatedThread 获取讨论帖并检查管理权限
+func (s *DiscussionService) moderatedThread(threadID, userID uint) (*model.Thread, *courseModel.Course, error) {
+	thread, err := s.discussionRepo.GetThread(threadID)
+	if err != nil {
+		return nil, nil, err
+	}
+	course, moderator, err := s.checkAccess(thread.CourseID, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !moderator {
+		return nil, nil, errors.New("无权管理讨论区，只有课程讲师和管理员可以操作")
+	}
+	if thread.IsDeleted() {
+		return nil, nil, errors.New("讨论帖不存在")
+	}
+	return thread, course, nil
+}
+
+// isAdmin 检查用户是否为平台管理员
+func (s *DiscussionService) isAdmin(userID uint) bool {
+	user, err := s.userRepo.GetByID(userID)
+	return err == nil && user.IsAdmin()
+}
+
+// fillThreads 填充发帖人名称和当前用户的点赞状态
+func (s *DiscussionService) fillThreads(threads []*model.Thread, userID uint, course *courseModel.Course, moderator bool) {
+	ids := make([]uint, 0, len(threads))
+	authorIDs := make([]uint, 0, len(threads))
+	for _, thread := range threads {
+		ids = append(ids, thread.ID)
+		authorIDs = append(authorIDs, thread.AuthorID)
+	}
+	voted, err := s.discussionRepo.VotedIDs(userID, model.TargetThread, ids)
+	if err != nil {
+		log.Printf("⚠️ Service: 查询点赞记录失败 - %v", err)
+	}
+	names := s.authorNames(authorIDs)
+
+	for _, thread := range threads {
+		thread.AuthorName = names[thread.AuthorID]
+		thread.AuthorIsInstructor = thread.AuthorID == course.InstructorID
+		thread.Upvoted = voted[thread.ID]
+		if thread.IsDeleted() && !moderator {
+			thread.Body = ""
+		}
+	}
+}
+
+// fillReplies 填充回复人名称、最佳回答标记和点赞状态，已删除回复的内容只对管理者可见
+func (s *DiscussionService) fillReplies(replies []*model.Reply, thread *model.Thread, userID uint, moderator bool) {
+	ids := make([]uint, 0, len(replies))
+	authorIDs := make([]uint, 0, len(replies))
+	for _, reply := range replies {
+		ids = append(ids, reply.ID)
+		authorIDs = append(authorIDs, reply.AuthorID)
+	}
+	voted, err := s.discussionRepo.VotedIDs(userID, model.TargetReply, ids)
+	if err != nil {
+		log.Printf("⚠️ Service: 查询点赞记录失败 - %v", err)
+	}
+	names := s.authorNames(authorIDs)
+
+	for _, reply := range replies {
+		reply.AuthorName = names[reply.AuthorID]
+		reply.IsAnswer = thread.AnswerReplyID == reply.ID
+		reply.Upvoted = voted[reply.ID]
+		if reply.IsDeleted() && !moderator {
+			reply.Body = ""
+			reply.AuthorName = ""
+		}
+	}
+}
+
+// authorNames 获取作者显示名称，未设置昵称时不显示用户名（用户名即邮箱）
+func (s *DiscussionService) authorNames(userIDs []uint) map[uint]string {
+	names := make(map[uint]string, len(userIDs))
+	for _, id := range userIDs {
+		if _, ok := names[id]; ok {
+			continue
+		}
+		if id == 0 {
+			names[id] = deletedAuthorName
+			continue
+		}
+		name := fmt.Sprintf("学员%d", id)
+		if user, err := s.userRepo.GetByID(id); err == nil {
+			if nickname := strings.TrimSpace(user.Nickname); nickname != "" && nickname != "新用户" {
+				name = nickname
+			}
+		}
+		names[id] = name
+	}
+	return names
+}
+
+// normalizePage 规范分页参数
+func normalizePage(page, pageSize int) (int, int) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > maxPageSize {
+		pageSize = defaultPageSize
+	}
+	return page, pageSize
+}
+
+// ListUserPosts 获取用户发布的全部讨论帖和回复，用于个人数据导出
+func (s *DiscussionService) ListUserPosts(userID uint) ([]*model.Thread, []*model.Reply, error) {
+	threads, err := s.discussionRepo.ListThreadsByAuthor(userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	replies, err := s.discussionRepo.ListRepliesByAuthor(userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return threads, replies, nil
+}
+
+// AnonymizeUser 注销账号时匿名化用户的讨论内容，帖子和回复保留，作者显示为已注销用户
+func (s *DiscussionService) AnonymizeUser(userID uint) error {
+	if userID == 0 {
+		return errors.New("用户ID不能为空")
+	}
+	if err := s.discussionRepo.AnonymizeAuthor(userID); err != nil {
+		return err
+	}
+	log.Printf("✅ Service: 已匿名化讨论内容 - 用户ID: %d", userID)
+	return nil
+}
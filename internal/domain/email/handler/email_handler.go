@@ -0,0 +1,211 @@
+package handler
+
+import (
+	"log"
+	"net/http"
+
+	service "course-platform/internal/infrastructure/grpc_client"
+	"course-platform/internal/shared/pb/emailpb"
+
+	"github.com/gin-gonic/gin"
+)
+
+// EmailHandler API Gateway的邮件订阅处理器
+type EmailHandler struct {
+	emailGRPCClient *service.EmailGRPCClientService
+}
+
+// NewEmailHandler 创建邮件订阅处理器
+func NewEmailHandler(emailGRPCClient *service.EmailGRPCClientService) *EmailHandler {
+	return &EmailHandler{
+		emailGRPCClient: emailGRPCClient,
+	}
+}
+
+// UpdateEmailPreferencesRequest 更新邮件订阅请求结构，键为邮件类别
+type UpdateEmailPreferencesRequest struct {
+	Preferences map[string]bool `json:"preferences" binding:"required"`
+}
+
+// UnsubscribeRequest 退订请求结构
+type UnsubscribeRequest struct {
+	Token string `json:"token" form:"token"`
+}
+
+// GetEmailPreferences 获取邮件订阅状态
+// @Summary 获取邮件订阅状态
+// @Description 获取各类邮件的订阅状态，未设置过的类别默认订阅，账户与安全类邮件不可退订
+// @Tags 邮件订阅
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Success 200 {object} map[string]interface{}
+// @Router /api/v1/email/preferences [get]
+func (h *EmailHandler) GetEmailPreferences(c *gin.Context) {
+	resp, err := h.emailGRPCClient.GetEmailPreferences(c.Request.Context(), c.GetUint("userID"))
+	if err != nil {
+		respondGRPCError(c, "获取邮件订阅状态失败", err)
+		return
+	}
+	respondPreferences(c, resp)
+}
+
+// UpdateEmailPreferences 更新邮件订阅状态
+// @Summary 更新邮件订阅状态
+// @Description 订阅或退订某类邮件
+// @Tags 邮件订阅
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param request body UpdateEmailPreferencesRequest true "邮件类别与订阅状态"
+// @Success 200 {object} map[string]interface{}
+// @Router /api/v1/email/preferences [put]
+func (h *EmailHandler) UpdateEmailPreferences(c *gin.Context) {
+	var req UpdateEmailPreferencesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    400,
+			"message": "请求参数无效: " + err.Error(),
+		})
+		return
+	}
+
+	resp, err := h.emailGRPCClient.UpdateEmailPreferences(c.Request.Context(), c.GetUint("userID"), req.Preferences)
+	if err != nil {
+		respondGRPCError(c, "更新邮件订阅状态失败", err)
+		return
+	}
+	respondPreferences(c, resp)
+}
+
+// Unsubscribe 一键退订
+// @Summary 一键退订
+// @Description 邮件客户端按 List-Unsubscribe-Post 发起的一键退订（RFC 8058），令牌可放在查询参数、表单或JSON中，无需登录
+// @Tags 邮件订阅
+// @Accept json
+// @Produce json
+// @Param token query string false "退订令牌"
+// @Param request body UnsubscribeRequest false "退订令牌"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Router /api/v1/email/unsubscribe [post]
+func (h *EmailHandler) Unsubscribe(c *gin.Context) {
+	token := c.Query("token")
+	if token == "" {
+		var req UnsubscribeRequest
+		if err := c.ShouldBind(&req); err == nil {
+			token = req.Token
+		}
+	}
+	if token == "" {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    400,
+			"message": "缺少退订令牌",
+		})
+		return
+	}
+
+	resp, err := h.emailGRPCClient.Unsubscribe(c.Request.Context(), token)
+	if err != nil {
+		respondGRPCError(c, "退订邮件失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data": gin.H{
+			"category_label": resp.CategoryLabel,
+		},
+	})
+}
+
+// UnsubscribePage 退订确认页面，GET 显示确认按钮，POST 执行退订
+// 邮件安全扫描会预先访问链接，所以 GET 不直接退订
+func (h *EmailHandler) UnsubscribePage(c *gin.Context) {
+	token := c.Query("token")
+	if c.Request.Method == http.MethodGet {
+		data := gin.H{
+			"SiteName": "Course Platform",
+			"Token":    token,
+		}
+		if token == "" {
+			data["Error"] = "退订链接无效"
+		}
+		c.HTML(http.StatusOK, "unsubscribe.html", data)
+		return
+	}
+
+	token = c.PostForm("token")
+	resp, err := h.emailGRPCClient.Unsubscribe(c.Request.Context(), token)
+	if err != nil {
+		log.Printf("❌ API: 退订邮件失败 - %v", err)
+		c.HTML(http.StatusInternalServerError, "unsubscribe.html", gin.H{
+			"SiteName": "Course Platform",
+			"Error":    "服务暂时不可用，请稍后重试",
+		})
+		return
+	}
+	if resp.Code != 200 {
+		c.HTML(http.StatusBadRequest, "unsubscribe.html", gin.H{
+			"SiteName": "Course Platform",
+			"Error":    resp.Message,
+		})
+		return
+	}
+
+	c.HTML(http.StatusOK, "unsubscribe.html", gin.H{
+		"SiteName": "Course Platform",
+		"Label":    resp.CategoryLabel,
+	})
+}
+
+// respondPreferences 返回邮件订阅状态
+func respondPreferences(c *gin.Context, resp *emailpb.EmailPreferencesResponse) {
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	preferences := make([]gin.H, 0, len(resp.Preferences))
+	for _, p := range resp.Preferences {
+		preferences = append(preferences, gin.H{
+			"category":   p.Category,
+			"label":      p.Label,
+			"required":   p.Required,
+			"subscribed": p.Subscribed,
+		})
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data":    preferences,
+	})
+}
+
+// respondGRPCError 返回调用微服务失败的响应
+func respondGRPCError(c *gin.Context, action string, err error) {
+	log.Printf("❌ API: %s - %v", action, err)
+	c.JSON(http.StatusInternalServerError, gin.H{
+		"code":    500,
+		"message": action + ": " + err.Error(),
+	})
+}
+
+// respondBusinessError 按业务码返回对应HTTP状态
+func respondBusinessError(c *gin.Context, code int32, message string) {
+	status := http.StatusBadRequest
+	switch code {
+	case 403:
+		status = http.StatusForbidden
+	case 404:
+		status = http.StatusNotFound
+	}
+	c.JSON(status, gin.H{
+		"code":    code,
+		"message": message,
+	})
+}
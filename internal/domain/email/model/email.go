@@ -0,0 +1,94 @@
+package model
+
+import (
+	"time"
+)
+
+// 邮件状态
+const (
+	StatusPending = "pending" // 等待发送（包括等待重试）
+	StatusSending = "sending" // 已被发送任务领取
+	StatusSent    = "sent"    // 已发送
+	StatusFailed  = "failed"  // 超过重试次数，放弃发送
+)
+
+// 邮件类别，退订和偏好设置以类别为单位
+const (
+	CategoryAccount      = "account"      // 账户相关（注册、找回密码等），不可退订
+	CategoryOrder        = "order"        // 购买凭证
+	CategoryAnnouncement = "announcement" // 课程公告
+)
+
+// Categories 邮件类别及显示名称，Required 的类别不可退订
+var Categories = []struct {
+	Category string
+	Label    string
+	Required bool
+}{
+	{CategoryAccount, "账户与安全", true},
+	{CategoryOrder, "购买凭证", false},
+	{CategoryAnnouncement, "课程公告", false},
+}
+
+// IsRequiredCategory 该类别是否不可退订
+func IsRequiredCategory(category string) bool {
+	for _, c := range Categories {
+		if c.Category == category {
+			return c.Required
+		}
+	}
+	return false
+}
+
+// IsValidCategory 是否为已知的邮件类别
+func IsValidCategory(category string) bool {
+	for _, c := range Categories {
+		if c.Category == category {
+			return true
+		}
+	}
+	return false
+}
+
+// Outbox 待发送邮件队列，入队时完成模板渲染，由发送任务按 NextAttemptAt 领取发送
+type Outbox struct {
+	ID        uint      `gorm:"primarykey" json:"id"` // 主键ID
+	CreatedAt time.Time `json:"created_at"`           // 入队时间
+	UpdatedAt time.Time `json:"updated_at"`           // 更新时间
+
+	UserID    uint   `gorm:"not null;default:0;index" json:"user_id"` // 收件用户ID，发给非注册用户时为0
+	ToAddress string `gorm:"size:100;not null" json:"to_address"`     // 收件地址
+	ToName    string `gorm:"size:100" json:"to_name"`                 // 收件人名称
+	Category  string `gorm:"size:30;not null" json:"category"`        // 邮件类别
+	Template  string `gorm:"size:50;not null" json:"template"`        // 模板名称
+	Locale    string `gorm:"size:10;not null" json:"locale"`          // 模板语言
+	Subject   string `gorm:"size:255;not null" json:"subject"`        // 邮件标题
+	HTMLBody  string `gorm:"type:mediumtext" json:"-"`                // 渲染后的 HTML 正文
+	UnsubURL  string `gorm:"size:500" json:"-"`                       // 退订链接，写入 List-Unsubscribe 头
+
+	Status        string     `gorm:"size:20;not null;index:idx_outbox_due" json:"status"`  // 状态
+	Attempts      int        `gorm:"not null;default:0" json:"attempts"`                   // 已尝试次数
+	NextAttemptAt time.Time  `gorm:"not null;index:idx_outbox_due" json:"next_attempt_at"` // 下次尝试时间
+	LastError     string     `gorm:"size:500" json:"last_error"`                           // 最近一次失败原因
+	SentAt        *time.Time `json:"sent_at"`                                              // 发送成功时间
+}
+
+// TableName 指定表名
+func (Outbox) TableName() string {
+	return "email_outbox"
+}
+
+// Preference 用户的邮件订阅偏好，没有记录的类别默认订阅
+type Preference struct {
+	ID        uint      `gorm:"primarykey" json:"id"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	UserID     uint   `gorm:"not null;uniqueIndex:idx_email_pref" json:"user_id"`          // 用户ID
+	Category   string `gorm:"size:30;not null;uniqueIndex:idx_email_pref" json:"category"` // 邮件类别
+	Subscribed bool   `gorm:"not null" json:"subscribed"`                                  // 是否订阅
+}
+
+// TableName 指定表名
+func (Preference) TableName() string {
+	return "email_preferences"
+}
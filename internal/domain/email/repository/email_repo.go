@@ -0,0 +1,167 @@
+package repository
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"course-platform/internal/domain/email/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// outboxBatchSize 批量入队时每批的条数
+const outboxBatchSize = 500
+
+// EmailRepositoryInterface 邮件仓储接口
+type EmailRepositoryInterface interface {
+	Enqueue(messages []*model.Outbox) error
+	ClaimDue(limit int) ([]*model.Outbox, error)
+	MarkSent(id uint) error
+	MarkRetry(id uint, attempts int, nextAttemptAt time.Time, lastError string) error
+	MarkFailed(id uint, attempts int, lastError string) error
+	ReleaseStale(before time.Time) (int64, error)
+	ListPreferences(userID uint) ([]*model.Preference, error)
+	SavePreferences(preferences []*model.Preference) error
+	UnsubscribedUserIDs(userIDs []uint, category string) (map[uint]bool, error)
+}
+
+// EmailRepository 邮件仓储实现
+type EmailRepository struct {
+	db *gorm.DB
+}
+
+// NewEmailRepository 创建邮件仓储实例
+func NewEmailRepository(db *gorm.DB) EmailRepositoryInterface {
+	return &EmailRepository{db: db}
+}
+
+// Enqueue 邮件入队
+func (r *EmailRepository) Enqueue(messages []*model.Outbox) error {
+	if len(messages) == 0 {
+		return nil
+	}
+	if err := r.db.CreateInBatches(messages, outboxBatchSize).Error; err != nil {
+		log.Printf("❌ Repository: 邮件入队失败 - %v", err)
+		return fmt.Errorf("邮件入队失败: %w", err)
+	}
+	return nil
+}
+
+// ClaimDue 领取到期待发送的邮件并标记为发送中
+// 通过带状态条件的更新领取，多个发送任务同时运行时同一封邮件只会被领取一次
+func (r *EmailRepository) ClaimDue(limit int) ([]*model.Outbox, error) {
+	var candidates []*model.Outbox
+	if err := r.db.Where("status = ? AND next_attempt_at <= ?", model.StatusPending, time.Now()).
+		Order("next_attempt_at ASC").Limit(limit).Find(&candidates).Error; err != nil {
+		return nil, fmt.Errorf("查询待发送邮件失败: %w", err)
+	}
+
+	claimed := make([]*model.Outbox, 0, len(candidates))
+	for _, message := range candidates {
+		result := r.db.Model(&model.Outbox{}).
+			Where("id = ? AND status = ?", message.ID, model.StatusPending).
+			Updates(map[string]interface{}{"status": model.StatusSending, "updated_at": time.Now()})
+		if result.Error != nil {
+			return claimed, fmt.Errorf("领取邮件失败: %w", result.Error)
+		}
+		if result.RowsAffected == 1 {
+			message.Status = model.StatusSending
+			claimed = append(claimed, message)
+		}
+	}
+	return claimed, nil
+}
+
+// MarkSent 标记发送成功
+func (r *EmailRepository) MarkSent(id uint) error {
+	now := time.Now()
+	if err := r.db.Model(&model.Outbox{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"status":     model.StatusSent,
+		"attempts":   gorm.Expr("attempts + 1"),
+		"sent_at":    &now,
+		"last_error": "",
+	}).Error; err != nil {
+		return fmt.Errorf("更新邮件状态失败: %w", err)
+	}
+	return nil
+}
+
+// MarkRetry 发送失败，放回队列等待重试
+func (r *EmailRepository) MarkRetry(id uint, attempts int, nextAttemptAt time.Time, lastError string) error {
+	if err := r.db.Model(&model.Outbox{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"status":          model.StatusPending,
+		"attempts":        attempts,
+		"next_attempt_at": nextAttemptAt,
+		"last_error":      lastError,
+	}).Error; err != nil {
+		return fmt.Errorf("更新邮件状态失败: %w", err)
+	}
+	return nil
+}
+
+// MarkFailed 超过重试次数，标记为发送失败
+func (r *EmailRepository) MarkFailed(id uint, attempts int, lastError string) error {
+	if err := r.db.Model(&model.Outbox{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"status":     model.StatusFailed,
+		"attempts":   attempts,
+		"last_error": lastError,
+	}).Error; err != nil {
+		return fmt.Errorf("更新邮件状态失败: %w", err)
+	}
+	return nil
+}
+
+// ReleaseStale 将长时间停留在发送中的邮件放回队列（发送任务在发送过程中退出时会出现）
+func (r *EmailRepository) ReleaseStale(before time.Time) (int64, error) {
+	result := r.db.Model(&model.Outbox{}).
+		Where("status = ? AND updated_at < ?", model.StatusSending, before).
+		Update("status", model.StatusPending)
+	if result.Error != nil {
+		return 0, fmt.Errorf("恢复超时邮件失败: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+// ListPreferences 获取用户保存过的邮件偏好
+func (r *EmailRepository) ListPreferences(userID uint) ([]*model.Preference, error) {
+	var preferences []*model.Preference
+	if err := r.db.Where("user_id = ?", userID).Find(&preferences).Error; err != nil {
+		return nil, fmt.Errorf("查询邮件偏好失败: %w", err)
+	}
+	return preferences, nil
+}
+
+// SavePreferences 保存邮件偏好（按用户和类别覆盖）
+func (r *EmailRepository) SavePreferences(preferences []*model.Preference) error {
+	if len(preferences) == 0 {
+		return nil
+	}
+	if err := r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "category"}},
+		DoUpdates: clause.AssignmentColumns([]string{"subscribed", "updated_at"}),
+	}).Create(&preferences).Error; err != nil {
+		return fmt.Errorf("保存邮件偏好失败: %w", err)
+	}
+	return nil
+}
+
+// UnsubscribedUserIDs 查询退订了某类邮件的用户
+func (r *EmailRepository) UnsubscribedUserIDs(userIDs []uint, category string) (map[uint]bool, error) {
+	unsubscribed := make(map[uint]bool)
+	if len(userIDs) == 0 {
+		return unsubscribed, nil
+	}
+
+	var ids []uint
+	if err := r.db.Model(&model.Preference{}).
+		Where("user_id IN ? AND category = ? AND subscribed = ?", userIDs, category, false).
+		Pluck("user_id", &ids).Error; err != nil {
+		return nil, fmt.Errorf("查询邮件偏好失败: %w", err)
+	}
+	for _, id := range ids {
+		unsubscribed[id] = true
+	}
+	return unsubscribed, nil
+}
@@ -0,0 +1,279 @@
+package service
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"course-platform/internal/domain/email/model"
+	"course-platform/internal/domain/email/repository"
+	userModel "course-platform/internal/domain/user/model"
+	userRepository "course-platform/internal/domain/user/repository"
+	"course-platform/internal/shared/utils"
+)
+
+// 邮件模板名称
+const (
+	TemplateWelcome         = "welcome"
+	TemplateVerifyEmail     = "verify_email"
+	TemplatePasswordReset   = "password_reset"
+	TemplatePurchaseReceipt = "purchase_receipt"
+	TemplateAnnouncement    = "announcement"
+	TemplateLoginLocked     = "login_locked"
+
+	TemplateDataExportReady          = "data_export_ready"
+	TemplateAccountDeletionScheduled = "account_deletion_scheduled"
+)
+
+// EmailServiceInterface 邮件服务接口，发送接口只负责渲染并入队，由 Worker 异步发送
+type EmailServiceInterface interface {
+	SendToUser(userID uint, category, template string, data map[string]interface{}) error
+	SendToUsers(userIDs []uint, category, template string, data map[string]interface{}) (int, error)
+	GetPreferences(userID uint) ([]*PreferenceView, error)
+	UpdatePreferences(userID uint, subscribed map[string]bool) ([]*PreferenceView, error)
+	Unsubscribe(token string) (string, error)
+}
+
+// Options 邮件服务配置
+type Options struct {
+	SiteURL           string // 网站地址，用于生成邮件中的链接
+	UnsubscribeSecret string // 退订链接签名密钥
+}
+
+// PreferenceView 某类邮件的订阅状态（包含未保存过的默认值）
+type PreferenceView struct {
+	Category   string
+	Label      string
+	Required   bool
+	Subscribed bool
+}
+
+// EmailService 邮件服务实现
+type EmailService struct {
+	emailRepo repository.EmailRepositoryInterface
+	userRepo  userRepository.UserRepositoryInterface
+	renderer  *Renderer
+	siteURL   string
+	secret    []byte
+}
+
+// NewEmailService 创建邮件服务实例
+func NewEmailService(emailRepo repository.EmailRepositoryInterface, userRepo userRepository.UserRepositoryInterface, renderer *Renderer, options Options) EmailServiceInterface {
+	return &EmailService{
+		emailRepo: emailRepo,
+		userRepo:  userRepo,
+		renderer:  renderer,
+		siteURL:   strings.TrimRight(options.SiteURL, "/"),
+		secret:    []byte(options.UnsubscribeSecret),
+	}
+}
+
+// SendToUser 给单个用户发送邮件
+func (s *EmailService) SendToUser(userID uint, category, template string, data map[string]interface{}) error {
+	sent, err := s.SendToUsers([]uint{userID}, category, template, data)
+	if err != nil {
+		return err
+	}
+	if sent == 0 {
+		log.Printf("🔍 Service: 用户未订阅此类邮件或没有邮箱 - 用户ID: %d, 类别: %s", userID, category)
+	}
+	return nil
+}
+
+// SendToUsers 按每个用户的语言渲染邮件并入队，跳过退订了该类邮件的用户，返回入队数量
+// data 中的键可在模板中直接使用，Name、SiteURL、UnsubscribeURL 由服务自动填充，站内链接只需传路径
+func (s *EmailService) SendToUsers(userIDs []uint, category, template string, data map[string]interface{}) (int, error) {
+	if !model.IsValidCategory(category) {
+		return 0, fmt.Errorf("未知的邮件类别: %s", category)
+	}
+
+	unsubscribed := map[uint]bool{}
+	if !model.IsRequiredCategory(category) {
+		var err error
+		if unsubscribed, err = s.emailRepo.UnsubscribedUserIDs(userIDs, category); err != nil {
+			return 0, err
+		}
+	}
+	users, err := s.userRepo.GetByIDs(userIDs)
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now()
+	messages := make([]*model.Outbox, 0, len(users))
+	for _, user := range users {
+		if unsubscribed[user.ID] || user.Email == "" {
+			continue
+		}
+		message, err := s.render(user, category, template, data)
+		if err != nil {
+			return 0, err
+		}
+		message.Status = model.StatusPending
+		message.NextAttemptAt = now
+		messages = append(messages, message)
+	}
+
+	if err := s.emailRepo.Enqueue(messages); err != nil {
+		return 0, err
+	}
+	log.Printf("✅ Service: 邮件已入队 - 模板: %s, 数量: %d", template, len(messages))
+	return len(messages), nil
+}
+
+// GetPreferences 获取用户的邮件订阅状态，未设置过的类别默认订阅
+func (s *EmailService) GetPreferences(userID uint) ([]*PreferenceView, error) {
+	saved, err := s.emailRepo.ListPreferences(userID)
+	if err != nil {
+		return nil, err
+	}
+	subscribed := make(map[string]bool, len(saved))
+	for _, preference := range saved {
+		subscribed[preference.Category] = preference.Subscribed
+	}
+
+	views := make([]*PreferenceView, 0, len(model.Categories))
+	for _, c := range model.Categories {
+		view := &PreferenceView{Category: c.Category, Label: c.Label, Required: c.Required, Subscribed: true}
+		if value, ok := subscribed[c.Category]; ok && !c.Required {
+			view.Subscribed = value
+		}
+		views = append(views, view)
+	}
+	return views, nil
+}
+
+// UpdatePreferences 更新用户的邮件订阅状态，只需传入要修改的类别
+func (s *EmailService) UpdatePreferences(userID uint, subscribed map[string]bool) ([]*PreferenceView, error) {
+	preferences := make([]*model.Preference, 0, len(subscribed))
+	for category, value := range subscribed {
+		if !model.IsValidCategory(category) {
+			return nil, fmt.Errorf("未知的邮件类别: %s", category)
+		}
+		if model.IsRequiredCategory(category) {
+			if !value {
+				return nil, errors.New("账户与安全类邮件不可退订")
+			}
+			continue
+		}
+		preferences = append(preferences, &model.Preference{
+			UserID:     userID,
+			Category:   category,
+			Subscribed: value,
+		})
+	}
+
+	if err := s.emailRepo.SavePreferences(preferences); err != nil {
+		return nil, err
+	}
+	log.Printf("✅ Service: 邮件偏好已更新 - 用户ID: %d", userID)
+	return s.GetPreferences(userID)
+}
+
+// Unsubscribe 通过邮件中的退订链接退订，返回退订的类别名称
+func (s *EmailService) Unsubscribe(token string) (string, error) {
+	userID, category, err := s.parseUnsubscribeToken(token)
+	if err != nil {
+		return "", err
+	}
+	if model.IsRequiredCategory(category) {
+		return "", errors.New("账户与安全类邮件不可退订")
+	}
+
+	if err := s.emailRepo.SavePreferences([]*model.Preference{{
+		UserID:     userID,
+		Category:   category,
+		Subscribed: false,
+	}}); err != nil {
+		return "", err
+	}
+
+	log.Printf("✅ Service: 用户已退订邮件 - 用户ID: %d, 类别: %s", userID, category)
+	for _, c := range model.Categories {
+		if c.Category == category {
+			return c.Label, nil
+		}
+	}
+	return category, nil
+}
+
+// render 为单个用户渲染邮件
+func (s *EmailService) render(user *userModel.User, category, template string, data map[string]interface{}) (*model.Outbox, error) {
+	locale := utils.NormalizeLocale(user.Locale)
+	name := user.Nickname
+	if name == "" {
+		name = user.Email
+	}
+
+	values := make(map[string]interface{}, len(data)+3)
+	for key, value := range data {
+		values[key] = value
+	}
+	values["Name"] = name
+	values["SiteURL"] = s.siteURL
+
+	message := &model.Outbox{
+		UserID:    user.ID,
+		ToAddress: user.Email,
+		ToName:    name,
+		Category:  category,
+		Template:  template,
+		Locale:    locale,
+	}
+	if !model.IsRequiredCategory(category) {
+		token := s.unsubscribeToken(user.ID, category)
+		values["UnsubscribeURL"] = s.siteURL + "/email/unsubscribe?token=" + url.QueryEscape(token)
+		message.UnsubURL = s.siteURL + "/api/v1/email/unsubscribe?token=" + url.QueryEscape(token)
+	}
+
+	subject, body, err := s.renderer.Render(locale, template, values)
+	if err != nil {
+		return nil, err
+	}
+	message.Subject = subject
+	message.HTMLBody = body
+	return message, nil
+}
+
+// unsubscribeToken 生成退订令牌：base64(用户ID.类别) + "." + HMAC签名，长期有效
+func (s *EmailService) unsubscribeToken(userID uint, category string) string {
+	payload := base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatUint(uint64(userID), 10) + "." + category))
+	return payload + "." + s.sign(payload)
+}
+
+// parseUnsubscribeToken 校验并解析退订令牌
+func (s *EmailService) parseUnsubscribeToken(token string) (uint, string, error) {
+	invalid := errors.New("退订链接无效")
+
+	payload, signature, ok := strings.Cut(token, ".")
+	if !ok || !hmac.Equal([]byte(signature), []byte(s.sign(payload))) {
+		return 0, "", invalid
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return 0, "", invalid
+	}
+	idText, category, ok := strings.Cut(string(raw), ".")
+	if !ok || !model.IsValidCategory(category) {
+		return 0, "", invalid
+	}
+	userID, err := strconv.ParseUint(idText, 10, 32)
+	if err != nil || userID == 0 {
+		return 0, "", invalid
+	}
+	return uint(userID), category, nil
+}
+
+// sign 计算令牌签名
+func (s *EmailService) sign(payload string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil)[:16])
+}
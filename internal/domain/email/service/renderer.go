@@ -0,0 +1,86 @@
+package service
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"html/template"
+	"io/fs"
+	"path"
+	"strings"
+
+	"course-platform/internal/domain/email/templates"
+	"course-platform/internal/shared/utils"
+)
+
+// Renderer 邮件模板渲染器，启动时解析全部模板
+type Renderer struct {
+	templates map[string]*template.Template // 键为 "语言/模板名"
+}
+
+// templateFuncs 邮件模板可用的函数
+var templateFuncs = template.FuncMap{
+	// paragraphs 按空行或换行拆分为段落，用于渲染纯文本正文
+	"paragraphs": func(text string) []string {
+		var result []string
+		for _, line := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n") {
+			if line = strings.TrimSpace(line); line != "" {
+				result = append(result, line)
+			}
+		}
+		return result
+	},
+}
+
+// NewRenderer 解析内嵌的邮件模板
+func NewRenderer() (*Renderer, error) {
+	r := &Renderer{templates: make(map[string]*template.Template)}
+
+	locales, err := fs.ReadDir(templates.FS, ".")
+	if err != nil {
+		return nil, fmt.Errorf("读取邮件模板失败: %w", err)
+	}
+	for _, locale := range locales {
+		if !locale.IsDir() {
+			continue
+		}
+		files, err := fs.Glob(templates.FS, path.Join(locale.Name(), "*.html"))
+		if err != nil {
+			return nil, fmt.Errorf("读取邮件模板失败: %w", err)
+		}
+		layout := path.Join(locale.Name(), "layout.html")
+		for _, file := range files {
+			if file == layout {
+				continue
+			}
+			tmpl, err := template.New(path.Base(file)).Funcs(templateFuncs).ParseFS(templates.FS, layout, file)
+			if err != nil {
+				return nil, fmt.Errorf("解析邮件模板 %s 失败: %w", file, err)
+			}
+			name := strings.TrimSuffix(path.Base(file), ".html")
+			r.templates[locale.Name()+"/"+name] = tmpl
+		}
+	}
+	return r, nil
+}
+
+// Render 渲染邮件标题和正文，没有对应语言的模板时使用默认语言
+func (r *Renderer) Render(locale, name string, data map[string]interface{}) (string, string, error) {
+	tmpl, ok := r.templates[locale+"/"+name]
+	if !ok {
+		tmpl, ok = r.templates[utils.DefaultLocale+"/"+name]
+	}
+	if !ok {
+		return "", "", fmt.Errorf("邮件模板不存在: %s", name)
+	}
+
+	var subject, body bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return "", "", fmt.Errorf("渲染邮件标题失败: %w", err)
+	}
+	if err := tmpl.ExecuteTemplate(&body, "layout", data); err != nil {
+		return "", "", fmt.Errorf("渲染邮件正文失败: %w", err)
+	}
+	// 标题按 HTML 模板渲染会被转义，还原为纯文本
+	return strings.TrimSpace(html.UnescapeString(subject.String())), body.String(), nil
+}
@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"course-platform/internal/domain/email/model"
+	"course-platform/internal/domain/email/repository"
+	"course-platform/internal/infrastructure/mail"
+)
+
+// 发送任务参数
+const (
+	workerInterval     = 5 * time.Second  // 轮询队列的间隔
+	workerBatchSize    = 20               // 每次领取的邮件数量
+	retryBaseDelay     = time.Minute      // 首次重试的等待时间，之后按指数增长
+	retryMaxDelay      = time.Hour        // 重试等待时间上限
+	staleSendingAfter  = 10 * time.Minute // 发送中超过此时间视为发送任务已退出，重新入队
+	defaultMaxAttempts = 5
+)
+
+// Worker 邮件发送任务，从队列领取到期的邮件发送，失败时按指数退避重试
+type Worker struct {
+	emailRepo   repository.EmailRepositoryInterface
+	sender      mail.Sender
+	maxAttempts int
+}
+
+// NewWorker 创建邮件发送任务，maxAttempts 不大于0时使用默认值
+func NewWorker(emailRepo repository.EmailRepositoryInterface, sender mail.Sender, maxAttempts int) *Worker {
+	if maxAttempts <= 0 {
+		maxAttempts = defaultMaxAttempts
+	}
+	return &Worker{
+		emailRepo:   emailRepo,
+		sender:      sender,
+		maxAttempts: maxAttempts,
+	}
+}
+
+// Run 持续处理邮件队列，直到 ctx 结束
+func (w *Worker) Run(ctx context.Context) {
+	log.Printf("✅ 邮件发送任务已启动，最多尝试 %d 次", w.maxAttempts)
+	ticker := time.NewTicker(workerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.processBatch(ctx)
+		}
+	}
+}
+
+// processBatch 处理一批到期的邮件
+func (w *Worker) processBatch(ctx context.Context) {
+	if released, err := w.emailRepo.ReleaseStale(time.Now().Add(-staleSendingAfter)); err != nil {
+		log.Printf("⚠️ 邮件发送任务: %v", err)
+	} else if released > 0 {
+		log.Printf("⚠️ 邮件发送任务: %d 封超时邮件已重新入队", released)
+	}
+
+	messages, err := w.emailRepo.ClaimDue(workerBatchSize)
+	if err != nil {
+		log.Printf("❌ 邮件发送任务: %v", err)
+	}
+	for _, message := range messages {
+		if ctx.Err() != nil {
+			return
+		}
+		w.send(ctx, message)
+	}
+}
+
+// send 发送单封邮件并记录结果
+func (w *Worker) send(ctx context.Context, message *model.Outbox) {
+	err := w.sender.Send(ctx, &mail.Message{
+		To:             message.ToAddress,
+		ToName:         message.ToName,
+		Subject:        message.Subject,
+		HTMLBody:       message.HTMLBody,
+		UnsubscribeURL: message.UnsubURL,
+	})
+	if err == nil {
+		if err := w.emailRepo.MarkSent(message.ID); err != nil {
+			log.Printf("❌ 邮件发送任务: %v", err)
+		}
+		log.Printf("✅ 邮件已发送 - ID: %d, 收件人: %s", message.ID, message.ToAddress)
+		return
+	}
+
+	attempts := message.Attempts + 1
+	lastError := []rune(err.Error())
+	if len(lastError) > 500 {
+		lastError = lastError[:500]
+	}
+	if attempts >= w.maxAttempts {
+		log.Printf("❌ 邮件发送失败，不再重试 - ID: %d, 收件人: %s, 错误: %v", message.ID, message.ToAddress, err)
+		if err := w.emailRepo.MarkFailed(message.ID, attempts, string(lastError)); err != nil {
+			log.Printf("❌ 邮件发送任务: %v", err)
+		}
+		return
+	}
+
+	delay := retryBaseDelay << (attempts - 1)
+	if delay > retryMaxDelay {
+		delay = retryMaxDelay
+	}
+	log.Printf("⚠️ 邮件发送失败，%v 后重试 - ID: %d, 第 %d 次, 错误: %v", delay, message.ID, attempts, err)
+	if err := w.emailRepo.MarkRetry(message.ID, attempts, time.Now().Add(delay), string(lastError)); err != nil {
+		log.Printf("❌ 邮件发送任务: %v", err)
+	}
+}
@@ -0,0 +1,10 @@
+// Package templates 邮件模板，按语言分目录存放
+// 每个模板定义 subject 和 content 两部分，发送时由同目录的 layout.html 套上统一的页眉页脚
+package templates
+
+import "embed"
+
+// FS 全部邮件模板
+//
+//go:embed */*.html
+var FS embed.FS
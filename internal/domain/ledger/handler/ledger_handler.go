@@ -0,0 +1,146 @@
+package handler
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+
+	service "course-platform/internal/infrastructure/grpc_client"
+
+	"github.com/gin-gonic/gin"
+)
+
+// utf8BOM 让 Excel 正确识别 CSV 中的中文
+const utf8BOM = "\xef\xbb\xbf"
+
+// LedgerHandler API Gateway的收入分账处理器
+type LedgerHandler struct {
+	ledgerGRPCClient *service.LedgerGRPCClientService
+}
+
+// NewLedgerHandler 创建收入分账处理器
+func NewLedgerHandler(ledgerGRPCClient *service.LedgerGRPCClientService) *LedgerHandler {
+	return &LedgerHandler{
+		ledgerGRPCClient: ledgerGRPCClient,
+	}
+}
+
+// GetCreatorStats 获取创作者统计信息
+// @Summary 获取创作者统计
+// @Description 获取当前用户的课程数量、学员数量和按月收入，金额单位为分，收入已扣除平台分成和退款
+// @Tags 创作者
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param months query int false "按月收入的月数，默认12，最多36"
+// @Success 200 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Router /api/v1/creator/stats [get]
+func (h *LedgerHandler) GetCreatorStats(c *gin.Context) {
+	months, _ := strconv.Atoi(c.Query("months"))
+
+	resp, err := h.ledgerGRPCClient.GetCreatorStats(c.Request.Context(), c.GetUint("userID"), months)
+	if err != nil {
+		respondGRPCError(c, "获取创作者统计失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	// 逐项输出，金额为0的字段也保留
+	stats := resp.Stats
+	monthly := make([]gin.H, 0, len(stats.Monthly))
+	for _, m := range stats.Monthly {
+		monthly = append(monthly, gin.H{
+			"month":         m.Month,
+			"gross_amount":  m.GrossAmount,
+			"platform_fee":  m.PlatformFee,
+			"refund_amount": m.RefundAmount,
+			"net_amount":    m.NetAmount,
+			"sales":         m.Sales,
+			"refunds":       m.Refunds,
+		})
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data": gin.H{
+			"total_courses":          stats.TotalCourses,
+			"active_courses":         stats.ActiveCourses,
+			"draft_courses":          stats.DraftCourses,
+			"total_students":         stats.TotalStudents,
+			"this_month_students":    stats.ThisMonthStudents,
+			"gross_revenue":          stats.GrossRevenue,
+			"platform_fee":           stats.PlatformFee,
+			"refund_amount":          stats.RefundAmount,
+			"total_revenue":          stats.TotalRevenue,
+			"this_month_revenue":     stats.ThisMonthRevenue,
+			"platform_share_percent": stats.PlatformSharePercent,
+			"currency":               stats.Currency,
+			"monthly":                monthly,
+		},
+	})
+}
+
+// ExportCreatorLedger 导出收入明细CSV
+// @Summary 导出收入明细
+// @Description 导出当前用户课程的订单收入和退款明细，每笔业务一行，金额单位为元
+// @Tags 创作者
+// @Produce text/csv
+// @Param Authorization header string true "Bearer token"
+// @Param from query string false "起始月份 YYYY-MM"
+// @Param to query string false "结束月份 YYYY-MM"
+// @Success 200 {file} file
+// @Router /api/v1/creator/revenue/export [get]
+func (h *LedgerHandler) ExportCreatorLedger(c *gin.Context) {
+	from, to := c.Query("from"), c.Query("to")
+
+	resp, err := h.ledgerGRPCClient.ExportCreatorLedger(c.Request.Context(), c.GetUint("userID"), from, to)
+	if err != nil {
+		respondGRPCError(c, "导出收入明细失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	filename := "revenue"
+	if from != "" {
+		filename += "-" + from
+	}
+	if to != "" {
+		filename += "-" + to
+	}
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.csv"`, filename))
+	c.Data(http.StatusOK, "text/csv; charset=utf-8", append([]byte(utf8BOM), resp.Content...))
+}
+
+// respondGRPCError 返回调用微服务失败的响应
+func respondGRPCError(c *gin.Context, action string, err error) {
+	log.Printf("❌ API: %s - %v", action, err)
+	c.JSON(http.StatusInternalServerError, gin.H{
+		"code":    500,
+		"message": action + ": " + err.Error(),
+	})
+}
+
+// respondBusinessError 按业务码返回对应HTTP状态
+func respondBusinessError(c *gin.Context, code int32, message string) {
+	status := http.StatusBadRequest
+	switch code {
+	case 403:
+		status = http.StatusForbidden
+	case 404:
+		status = http.StatusNotFound
+	case 409:
+		status = http.StatusConflict
+	}
+	c.JSON(status, gin.H{
+		"code":    code,
+		"message": message,
+	})
+}
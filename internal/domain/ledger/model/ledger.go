@@ -0,0 +1,44 @@
+package model
+
+import (
+	"time"
+)
+
+// 记账科目
+const (
+	AccountCustomerPayments   = "customer_payments"   // 学员付款（借方增加，退款时贷记）
+	AccountPlatformRevenue    = "platform_revenue"    // 平台分成收入
+	AccountInstructorEarnings = "instructor_earnings" // 讲师应得收入
+)
+
+// 业务类型
+const (
+	EntryKindSale   = "sale"   // 订单支付
+	EntryKindRefund = "refund" // 订单退款
+)
+
+// LedgerEntry 收入分账分录（复式记账），只追加不修改
+// Amount 单位为分，正数记借方、负数记贷方；同一 Reference 下的分录金额之和为0
+// 每笔业务的所有分录都冗余记录讲师ID和课程ID，便于按讲师汇总
+type LedgerEntry struct {
+	ID        uint      `gorm:"primarykey" json:"id"` // 主键ID
+	CreatedAt time.Time `json:"created_at"`           // 记账时间
+
+	Reference    string    `gorm:"size:64;not null;uniqueIndex:idx_ledger_reference_account" json:"reference"` // 业务单号，如 order:<订单号>、refund:<退款ID>
+	Account      string    `gorm:"size:32;not null;uniqueIndex:idx_ledger_reference_account" json:"account"`   // 记账科目
+	Kind         string    `gorm:"size:20;not null" json:"kind"`                                               // 业务类型
+	Amount       int64     `gorm:"not null" json:"amount"`                                                     // 金额（分），借正贷负
+	Currency     string    `gorm:"size:8;not null" json:"currency"`                                            // 币种
+	InstructorID uint      `gorm:"not null;index" json:"instructor_id"`                                        // 讲师ID
+	CourseID     uint      `gorm:"not null;index" json:"course_id"`                                            // 课程ID，套餐订单为0
+	BundleID     uint      `gorm:"not null;default:0" json:"bundle_id"`                                        // 套餐ID
+	ItemTitle    string    `gorm:"size:200" json:"item_title"`                                                 // 下单时的课程或套餐标题
+	OrderID      uint      `gorm:"not null;index" json:"order_id"`                                             // 订单ID
+	OrderNo      string    `gorm:"size:32;not null" json:"order_no"`                                           // 订单号
+	OccurredAt   time.Time `gorm:"not null;index" json:"occurred_at"`                                          // 业务发生时间
+}
+
+// TableName 指定表名
+func (LedgerEntry) TableName() string {
+	return "ledger_entries"
+}
@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"course-platform/internal/domain/ledger/model"
+
+	"gorm.io/gorm"
+)
+
+// LedgerRepositoryInterface 分账仓储接口
+type LedgerRepositoryInterface interface {
+	CreateTransaction(entries []*model.LedgerEntry) (bool, error)
+	ListByReference(reference string) ([]*model.LedgerEntry, error)
+	ListByInstructor(instructorID uint, from, to time.Time) ([]*model.LedgerEntry, error)
+}
+
+// LedgerRepository 分账仓储实现
+type LedgerRepository struct {
+	db *gorm.DB
+}
+
+// NewLedgerRepository 创建分账仓储实例
+func NewLedgerRepository(db *gorm.DB) LedgerRepositoryInterface {
+	return &LedgerRepository{db: db}
+}
+
+// CreateTransaction 在同一事务中写入一笔业务的全部分录
+// 分录借贷必须平衡；同一业务单号已记账时不重复写入，返回false
+func (r *LedgerRepository) CreateTransaction(entries []*model.LedgerEntry) (bool, error) {
+	if len(entries) < 2 {
+		return false, errors.New("一笔业务至少需要两条分录")
+	}
+	reference := entries[0].Reference
+	var balance int64
+	for _, entry := range entries {
+		if entry.Reference != reference {
+			return false, errors.New("分录业务单号不一致")
+		}
+		balance += entry.Amount
+	}
+	if balance != 0 {
+		return false, fmt.Errorf("分录借贷不平衡: %d", balance)
+	}
+
+	created := false
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var count int64
+		if err := tx.Model(&model.LedgerEntry{}).Where("reference = ?", reference).Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return nil
+		}
+		if err := tx.Create(&entries).Error; err != nil {
+			return err
+		}
+		created = true
+		return nil
+	})
+	if err != nil {
+		log.Printf("❌ Repository: 写入分账分录失败 - 业务单号: %s, 错误: %v", reference, err)
+		return false, fmt.Errorf("写入分账分录失败: %w", err)
+	}
+
+	if created {
+		log.Printf("✅ Repository: 分账分录写入成功 - 业务单号: %s", reference)
+	}
+	return created, nil
+}
+
+// ListByReference 获取一笔业务的全部分录
+func (r *LedgerRepository) ListByReference(reference string) ([]*model.LedgerEntry, error) {
+	var entries []*model.LedgerEntry
+	if err := r.db.Where("reference = ?", reference).Order("id ASC").Find(&entries).Error; err != nil {
+		return nil, fmt.Errorf("查询分账分录失败: %w", err)
+	}
+	return entries, nil
+}
+
+// ListByInstructor 获取讲师在时间范围内的分录，时间为零值表示不限制
+func (r *LedgerRepository) ListByInstructor(instructorID uint, from, to time.Time) ([]*model.LedgerEntry, error) {
+	query := r.db.Where("instructor_id = ?", instructorID)
+	if !from.IsZero() {
+		query = query.Where("occurred_at >= ?", from)
+	}
+	if !to.IsZero() {
+		query = query.Where("occurred_at < ?", to)
+	}
+
+	var entries []*model.LedgerEntry
+	if err := query.Order("occurred_at ASC, id ASC").Find(&entries).Error; err != nil {
+		log.Printf("❌ Repository: 查询讲师分账分录失败 - %v", err)
+		return nil, fmt.Errorf("查询分账分录失败: %w", err)
+	}
+	return entries, nil
+}
@@ -0,0 +1,391 @@
+package service
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	courseService "course-platform/internal/domain/course/service"
+	"course-platform/internal/domain/ledger/model"
+	"course-platform/internal/domain/ledger/repository"
+	orderModel "course-platform/internal/domain/order/model"
+)
+
+// 月份格式
+const monthLayout = "2006-01"
+
+// 默认统计最近12个月，最多36个月
+const (
+	defaultStatsMonths = 12
+	maxStatsMonths     = 36
+)
+
+// LedgerServiceInterface 收入分账服务接口
+type LedgerServiceInterface interface {
+	RecordSale(order *orderModel.Order) error
+	RecordRefund(order *orderModel.Order, refundID uint, amount int64, refundedAt time.Time) error
+	GetCreatorStats(instructorID uint, months int) (*CreatorStats, error)
+	ExportCreatorLedger(instructorID uint, fromMonth, toMonth string) ([]byte, error)
+}
+
+// MonthlyRevenue 讲师月度收入（分）
+// NetAmount 为讲师实得收入：学员实付 - 平台分成 - 退款中讲师承担的部分
+type MonthlyRevenue struct {
+	Month        string
+	GrossAmount  int64
+	PlatformFee  int64
+	RefundAmount int64
+	NetAmount    int64
+	Sales        int
+	Refunds      int
+}
+
+// CreatorStats 讲师经营数据，金额单位为分
+type CreatorStats struct {
+	TotalCourses         int
+	ActiveCourses        int
+	DraftCourses         int
+	TotalStudents        int64
+	ThisMonthStudents    int64
+	GrossRevenue         int64
+	PlatformFee          int64
+	RefundAmount         int64
+	TotalRevenue         int64
+	ThisMonthRevenue     int64
+	PlatformSharePercent int
+	Currency             string
+	Monthly              []*MonthlyRevenue
+}
+
+// LedgerService 收入分账服务实现
+type LedgerService struct {
+	ledgerRepo           repository.LedgerRepositoryInterface
+	courseService        courseService.CourseServiceInterface
+	platformSharePercent int
+}
+
+// NewLedgerService 创建收入分账服务实例
+func NewLedgerService(ledgerRepo repository.LedgerRepositoryInterface, courseService courseService.CourseServiceInterface, platformSharePercent int) LedgerServiceInterface {
+	if platformSharePercent < 0 || platformSharePercent > 100 {
+		log.Printf("⚠️ Service: 平台抽成比例 %d%% 无效，按0处理", platformSharePercent)
+		platformSharePercent = 0
+	}
+	return &LedgerService{
+		ledgerRepo:           ledgerRepo,
+		courseService:        courseService,
+		platformSharePercent: platformSharePercent,
+	}
+}
+
+// RecordSale 订单支付成功后记账：借记学员付款，按平台抽成比例贷记平台收入和讲师收入
+// 同一订单重复调用只记账一次；0元订单不产生分录
+func (s *LedgerService) RecordSale(order *orderModel.Order) error {
+	if order.Amount <= 0 {
+		return nil
+	}
+
+	instructorID, err := s.instructorOf(order)
+	if err != nil {
+		return err
+	}
+
+	occurredAt := time.Now()
+	if order.PaidAt != nil {
+		occurredAt = *order.PaidAt
+	}
+	platformFee := splitAmount(order.Amount, int64(s.platformSharePercent), 100)
+
+	entry := func(account string, amount int64) *model.LedgerEntry {
+		return &model.LedgerEntry{
+			Reference:    saleReference(order.OrderNo),
+			Account:      account,
+			Kind:         model.EntryKindSale,
+			Amount:       amount,
+			Currency:     order.Currency,
+			InstructorID: instructorID,
+			CourseID:     order.CourseID,
+			BundleID:     order.BundleID,
+			ItemTitle:    order.CourseTitle,
+			OrderID:      order.ID,
+			OrderNo:      order.OrderNo,
+			OccurredAt:   occurredAt,
+		}
+	}
+	_, err = s.ledgerRepo.CreateTransaction([]*model.LedgerEntry{
+		entry(model.AccountCustomerPayments, order.Amount),
+		entry(model.AccountPlatformRevenue, -platformFee),
+		entry(model.AccountInstructorEarnings, -(order.Amount - platformFee)),
+	})
+	return err
+}
+
+// RecordRefund 退款成功后记冲销分录，平台和讲师按原订单的分成比例承担退款
+func (s *LedgerService) RecordRefund(order *orderModel.Order, refundID uint, amount int64, refundedAt time.Time) error {
+	if amount <= 0 {
+		return nil
+	}
+
+	saleEntries, err := s.ledgerRepo.ListByReference(saleReference(order.OrderNo))
+	if err != nil {
+		return err
+	}
+
+	var instructorID uint
+	var saleAmount, salePlatformFee int64
+	for _, e := range saleEntries {
+		instructorID = e.InstructorID
+		switch e.Account {
+		case model.AccountCustomerPayments:
+			saleAmount = e.Amount
+		case model.AccountPlatformRevenue:
+			salePlatformFee = -e.Amount
+		}
+	}
+
+	var platformShare int64
+	if saleAmount > 0 {
+		platformShare = splitAmount(amount, salePlatformFee, saleAmount)
+	} else {
+		// 订单支付时尚未记账，按当前抽成比例冲销
+		if instructorID, err = s.instructorOf(order); err != nil {
+			return err
+		}
+		platformShare = splitAmount(amount, int64(s.platformSharePercent), 100)
+	}
+
+	entry := func(account string, amount int64) *model.LedgerEntry {
+		return &model.LedgerEntry{
+			Reference:    fmt.Sprintf("refund:%d", refundID),
+			Account:      account,
+			Kind:         model.EntryKindRefund,
+			Amount:       amount,
+			Currency:     order.Currency,
+			InstructorID: instructorID,
+			CourseID:     order.CourseID,
+			BundleID:     order.BundleID,
+			ItemTitle:    order.CourseTitle,
+			OrderID:      order.ID,
+			OrderNo:      order.OrderNo,
+			OccurredAt:   refundedAt,
+		}
+	}
+	_, err = s.ledgerRepo.CreateTransaction([]*model.LedgerEntry{
+		entry(model.AccountCustomerPayments, -amount),
+		entry(model.AccountPlatformRevenue, platformShare),
+		entry(model.AccountInstructorEarnings, amount-platformShare),
+	})
+	return err
+}
+
+// GetCreatorStats 获取讲师的课程、学员和收入统计，Monthly 按月份升序包含最近 months 个月
+func (s *LedgerService) GetCreatorStats(instructorID uint, months int) (*CreatorStats, error) {
+	log.Printf("🔍 Service: 获取讲师统计 - 讲师ID: %d", instructorID)
+
+	if instructorID == 0 {
+		return nil, errors.New("讲师ID不能为空")
+	}
+	if months <= 0 {
+		months = defaultStatsMonths
+	}
+	if months > maxStatsMonths {
+		months = maxStatsMonths
+	}
+
+	courses, err := s.courseService.GetCoursesByInstructor(instructorID)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &CreatorStats{
+		TotalCourses:         len(courses),
+		PlatformSharePercent: s.platformSharePercent,
+		Currency:             "CNY",
+	}
+	courseIDs := make([]uint, 0, len(courses))
+	for _, course := range courses {
+		courseIDs = append(courseIDs, course.ID)
+		switch {
+		case course.IsPublished():
+			stats.ActiveCourses++
+		case course.IsDraft():
+			stats.DraftCourses++
+		}
+	}
+
+	now := time.Now()
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+	if stats.TotalStudents, err = s.courseService.CountActiveStudents(courseIDs, time.Time{}); err != nil {
+		return nil, err
+	}
+	if stats.ThisMonthStudents, err = s.courseService.CountActiveStudents(courseIDs, monthStart); err != nil {
+		return nil, err
+	}
+
+	entries, err := s.ledgerRepo.ListByInstructor(instructorID, time.Time{}, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+
+	// 预先生成最近 months 个月，没有收入的月份也返回0
+	byMonth := make(map[string]*MonthlyRevenue, months)
+	for i := months - 1; i >= 0; i-- {
+		month := &MonthlyRevenue{Month: monthStart.AddDate(0, -i, 0).Format(monthLayout)}
+		byMonth[month.Month] = month
+		stats.Monthly = append(stats.Monthly, month)
+	}
+
+	var total MonthlyRevenue
+	for _, e := range entries {
+		if e.Currency != "" {
+			stats.Currency = e.Currency
+		}
+		applyEntry(&total, e)
+		if month, ok := byMonth[e.OccurredAt.In(now.Location()).Format(monthLayout)]; ok {
+			applyEntry(month, e)
+		}
+	}
+
+	stats.GrossRevenue = total.GrossAmount
+	stats.PlatformFee = total.PlatformFee
+	stats.RefundAmount = total.RefundAmount
+	stats.TotalRevenue = total.NetAmount
+	stats.ThisMonthRevenue = stats.Monthly[len(stats.Monthly)-1].NetAmount
+
+	log.Printf("✅ Service: 讲师统计完成 - 讲师ID: %d, 课程数: %d, 累计收入: %d", instructorID, stats.TotalCourses, stats.TotalRevenue)
+	return stats, nil
+}
+
+// ExportCreatorLedger 导出讲师收入明细CSV，每笔订单或退款一行
+// 月份格式为 2006-01，留空表示不限制，两端均包含
+func (s *LedgerService) ExportCreatorLedger(instructorID uint, fromMonth, toMonth string) ([]byte, error) {
+	log.Printf("🔍 Service: 导出讲师收入明细 - 讲师ID: %d, 月份: %s ~ %s", instructorID, fromMonth, toMonth)
+
+	if instructorID == 0 {
+		return nil, errors.New("讲师ID不能为空")
+	}
+
+	var from, to time.Time
+	if fromMonth != "" {
+		t, err := time.ParseInLocation(monthLayout, fromMonth, time.Local)
+		if err != nil {
+			return nil, errors.New("起始月份格式应为 YYYY-MM")
+		}
+		from = t
+	}
+	if toMonth != "" {
+		t, err := time.ParseInLocation(monthLayout, toMonth, time.Local)
+		if err != nil {
+			return nil, errors.New("结束月份格式应为 YYYY-MM")
+		}
+		to = t.AddDate(0, 1, 0)
+	}
+	if !from.IsZero() && !to.IsZero() && !from.Before(to) {
+		return nil, errors.New("起始月份不能晚于结束月份")
+	}
+
+	entries, err := s.ledgerRepo.ListByInstructor(instructorID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	// 按业务单号合并分录，保持发生时间顺序
+	type row struct {
+		entry *model.LedgerEntry
+		sum   MonthlyRevenue
+	}
+	var rows []*row
+	byReference := make(map[string]*row)
+	for _, e := range entries {
+		r, ok := byReference[e.Reference]
+		if !ok {
+			r = &row{entry: e}
+			byReference[e.Reference] = r
+			rows = append(rows, r)
+		}
+		applyEntry(&r.sum, e)
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	_ = w.Write([]string{"date", "type", "reference", "order_no", "course_id", "bundle_id", "item_title", "gross_amount", "refund_amount", "platform_fee", "net_amount", "currency"})
+	for _, r := range rows {
+		_ = w.Write([]string{
+			r.entry.OccurredAt.Format("2006-01-02 15:04:05"),
+			r.entry.Kind,
+			r.entry.Reference,
+			r.entry.OrderNo,
+			strconv.FormatUint(uint64(r.entry.CourseID), 10),
+			strconv.FormatUint(uint64(r.entry.BundleID), 10),
+			r.entry.ItemTitle,
+			formatCents(r.sum.GrossAmount),
+			formatCents(r.sum.RefundAmount),
+			formatCents(r.sum.PlatformFee),
+			formatCents(r.sum.NetAmount),
+			r.entry.Currency,
+		})
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, fmt.Errorf("生成CSV失败: %w", err)
+	}
+
+	log.Printf("✅ Service: 收入明细导出完成 - 讲师ID: %d, 记录数: %d", instructorID, len(rows))
+	return buf.Bytes(), nil
+}
+
+// instructorOf 获取订单的讲师ID，早期订单未记录讲师时按课程查询
+func (s *LedgerService) instructorOf(order *orderModel.Order) (uint, error) {
+	if order.InstructorID != 0 {
+		return order.InstructorID, nil
+	}
+	course, err := s.courseService.GetCourseByID(order.CourseID)
+	if err != nil {
+		return 0, err
+	}
+	return course.InstructorID, nil
+}
+
+// applyEntry 将分录计入汇总
+func applyEntry(sum *MonthlyRevenue, e *model.LedgerEntry) {
+	switch e.Account {
+	case model.AccountCustomerPayments:
+		if e.Kind == model.EntryKindRefund {
+			sum.RefundAmount -= e.Amount
+			sum.Refunds++
+		} else {
+			sum.GrossAmount += e.Amount
+			sum.Sales++
+		}
+	case model.AccountPlatformRevenue:
+		sum.PlatformFee -= e.Amount
+	case model.AccountInstructorEarnings:
+		sum.NetAmount -= e.Amount
+	}
+}
+
+// splitAmount 计算 amount * numerator / denominator，四舍五入到分
+func splitAmount(amount, numerator, denominator int64) int64 {
+	if denominator == 0 {
+		return 0
+	}
+	return (amount*numerator*2 + denominator) / (denominator * 2)
+}
+
+// saleReference 订单支付的业务单号
+func saleReference(orderNo string) string {
+	return "order:" + orderNo
+}
+
+// formatCents 将分格式化为元，如 1234 -> 12.34
+func formatCents(cents int64) string {
+	sign := ""
+	if cents < 0 {
+		sign = "-"
+		cents = -cents
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, cents/100, cents%100)
+}
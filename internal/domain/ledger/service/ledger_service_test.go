@@ -0,0 +1,257 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"course-platform/internal/domain/ledger/model"
+	orderModel "course-platform/internal/domain/order/model"
+)
+
+// memoryLedgerRepo 内存分账仓储，按业务单号去重
+type memoryLedgerRepo struct {
+	entries []*model.LedgerEntry
+}
+
+func (r *memoryLedgerRepo) CreateTransaction(entries []*model.LedgerEntry) (bool, error) {
+	for _, e := range r.entries {
+		if e.Reference == entries[0].Reference {
+			return false, nil
+		}
+	}
+	r.entries = append(r.entries, entries...)
+	return true, nil
+}
+
+func (r *memoryLedgerRepo) ListByReference(reference string) ([]*model.LedgerEntry, error) {
+	var result []*model.LedgerEntry
+	for _, e := range r.entries {
+		if e.Reference == reference {
+			result = append(result, e)
+		}
+	}
+	return result, nil
+}
+
+func (r *memoryLedgerRepo) ListByInstructor(instructorID uint, from, to time.Time) ([]*model.LedgerEntry, error) {
+	return nil, nil
+}
+
+// postings 按科目汇总指定业务单号的分录金额
+func postings(t *testing.T, repo *memoryLedgerRepo, reference string) map[string]int64 {
+	t.Helper()
+	entries, _ := repo.ListByReference(reference)
+	result := make(map[string]int64)
+	var balance int64
+	for _, e := range entries {
+		result[e.Account] += e.Amount
+		balance += e.Amount
+	}
+	if len(entries) > 0 && balance != 0 {
+		t.Errorf("%s 借贷不平衡: %d", reference, balance)
+	}
+	return result
+}
+
+func newTestOrder(amount int64) *orderModel.Order {
+	paidAt := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	return &orderModel.Order{
+		ID:           1,
+		OrderNo:      "ORD001",
+		Amount:       amount,
+		Currency:     "CNY",
+		CourseID:     7,
+		InstructorID: 3,
+		PaidAt:       &paidAt,
+	}
+}
+
+func TestRecordSale(t *testing.T) {
+	tests := []struct {
+		name         string
+		sharePercent int
+		amount       int64
+		want         map[string]int64
+	}{
+		{"平台抽成30%", 30, 10000, map[string]int64{
+			model.AccountCustomerPayments:   10000,
+			model.AccountPlatformRevenue:    -3000,
+			model.AccountInstructorEarnings: -7000,
+		}},
+		{"抽成四舍五入到分", 30, 999, map[string]int64{
+			model.AccountCustomerPayments:   999,
+			model.AccountPlatformRevenue:    -300,
+			model.AccountInstructorEarnings: -699,
+		}},
+		{"不抽成", 0, 5000, map[string]int64{
+			model.AccountCustomerPayments:   5000,
+			model.AccountPlatformRevenue:    0,
+			model.AccountInstructorEarnings: -5000,
+		}},
+		{"无效比例按0处理", 150, 5000, map[string]int64{
+			model.AccountCustomerPayments:   5000,
+			model.AccountPlatformRevenue:    0,
+			model.AccountInstructorEarnings: -5000,
+		}},
+		{"0元订单不记账", 30, 0, map[string]int64{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &memoryLedgerRepo{}
+			svc := NewLedgerService(repo, nil, tt.sharePercent)
+			if err := svc.RecordSale(newTestOrder(tt.amount)); err != nil {
+				t.Fatalf("RecordSale() error = %v", err)
+			}
+
+			got := postings(t, repo, saleReference("ORD001"))
+			if len(got) != len(tt.want) {
+				t.Fatalf("分录 = %v, want %v", got, tt.want)
+			}
+			for account, amount := range tt.want {
+				if got[account] != amount {
+					t.Errorf("%s = %d, want %d", account, got[account], amount)
+				}
+			}
+		})
+	}
+}
+
+func TestRecordSaleOnce(t *testing.T) {
+	repo := &memoryLedgerRepo{}
+	svc := NewLedgerService(repo, nil, 30)
+	order := newTestOrder(10000)
+	for i := 0; i < 2; i++ {
+		if err := svc.RecordSale(order); err != nil {
+			t.Fatalf("RecordSale() error = %v", err)
+		}
+	}
+	if len(repo.entries) != 3 {
+		t.Errorf("重复记账: 分录数 = %d, want 3", len(repo.entries))
+	}
+}
+
+func TestRecordRefund(t *testing.T) {
+	tests := []struct {
+		name         string
+		saleShare    int // 支付时的抽成比例，-1 表示支付时未记账
+		currentShare int
+		orderAmount  int64
+		refundAmount int64
+		want         map[string]int64
+	}{
+		{"全额退款", 30, 30, 10000, 10000, map[string]int64{
+			model.AccountCustomerPayments:   -10000,
+			model.AccountPlatformRevenue:    3000,
+			model.AccountInstructorEarnings: 7000,
+		}},
+		{"部分退款按原比例分摊", 30, 30, 10000, 2500, map[string]int64{
+			model.AccountCustomerPayments:   -2500,
+			model.AccountPlatformRevenue:    750,
+			model.AccountInstructorEarnings: 1750,
+		}},
+		{"抽成比例调整后仍按原订单比例", 30, 10, 10000, 5000, map[string]int64{
+			model.AccountCustomerPayments:   -5000,
+			model.AccountPlatformRevenue:    1500,
+			model.AccountInstructorEarnings: 3500,
+		}},
+		{"支付时未记账按当前比例", -1, 20, 10000, 5000, map[string]int64{
+			model.AccountCustomerPayments:   -5000,
+			model.AccountPlatformRevenue:    1000,
+			model.AccountInstructorEarnings: 4000,
+		}},
+		{"0元退款不记账", 30, 30, 10000, 0, map[string]int64{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &memoryLedgerRepo{}
+			order := newTestOrder(tt.orderAmount)
+			if tt.saleShare >= 0 {
+				if err := NewLedgerService(repo, nil, tt.saleShare).RecordSale(order); err != nil {
+					t.Fatalf("RecordSale() error = %v", err)
+				}
+			}
+
+			svc := NewLedgerService(repo, nil, tt.currentShare)
+			if err := svc.RecordRefund(order, 9, tt.refundAmount, time.Now()); err != nil {
+				t.Fatalf("RecordRefund() error = %v", err)
+			}
+
+			got := postings(t, repo, "refund:9")
+			if len(got) != len(tt.want) {
+				t.Fatalf("分录 = %v, want %v", got, tt.want)
+			}
+			for account, amount := range tt.want {
+				if got[account] != amount {
+					t.Errorf("%s = %d, want %d", account, got[account], amount)
+				}
+			}
+		})
+	}
+}
+
+func TestApplyEntry(t *testing.T) {
+	repo := &memoryLedgerRepo{}
+	svc := NewLedgerService(repo, nil, 30)
+	order := newTestOrder(10000)
+	if err := svc.RecordSale(order); err != nil {
+		t.Fatal(err)
+	}
+	if err := svc.RecordRefund(order, 9, 2500, time.Now()); err != nil {
+		t.Fatal(err)
+	}
+
+	sum := &MonthlyRevenue{}
+	for _, e := range repo.entries {
+		applyEntry(sum, e)
+	}
+	if sum.GrossAmount != 10000 || sum.Sales != 1 {
+		t.Errorf("销售额 = %d (%d笔), want 10000 (1笔)", sum.GrossAmount, sum.Sales)
+	}
+	if sum.RefundAmount != 2500 || sum.Refunds != 1 {
+		t.Errorf("退款额 = %d (%d笔), want 2500 (1笔)", sum.RefundAmount, sum.Refunds)
+	}
+	if sum.PlatformFee != 2250 {
+		t.Errorf("平台分成 = %d, want 2250", sum.PlatformFee)
+	}
+	if sum.NetAmount != 5250 {
+		t.Errorf("讲师净收入 = %d, want 5250", sum.NetAmount)
+	}
+}
+
+func TestSplitAmount(t *testing.T) {
+	tests := []struct {
+		amount, numerator, denominator, want int64
+	}{
+		{10000, 30, 100, 3000},
+		{999, 30, 100, 300},
+		{1, 50, 100, 1},
+		{1, 49, 100, 0},
+		{2500, 3000, 10000, 750},
+		{100, 1, 0, 0},
+	}
+	for _, tt := range tests {
+		if got := splitAmount(tt.amount, tt.numerator, tt.denominator); got != tt.want {
+			t.Errorf("splitAmount(%d, %d, %d) = %d, want %d", tt.amount, tt.numerator, tt.denominator, got, tt.want)
+		}
+	}
+}
+
+func TestFormatCents(t *testing.T) {
+	tests := []struct {
+		cents int64
+		want  string
+	}{
+		{0, "0.00"},
+		{5, "0.05"},
+		{1234, "12.34"},
+		{-1234, "-12.34"},
+		{100000, "1000.00"},
+	}
+	for _, tt := range tests {
+		if got := formatCents(tt.cents); got != tt.want {
+			t.Errorf("formatCents(%d) = %q, want %q", tt.cents, got, tt.want)
+		}
+	}
+}
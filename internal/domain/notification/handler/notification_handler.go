@@ -0,0 +1,300 @@
+package handler
+
+import (
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	service "course-platform/internal/infrastructure/grpc_client"
+	"course-platform/internal/infrastructure/realtime"
+	"course-platform/internal/shared/pb/notificationpb"
+
+	"github.com/gin-gonic/gin"
+)
+
+// streamHeartbeatInterval 推送连接的心跳间隔，避免代理因空闲断开连接
+const streamHeartbeatInterval = 25 * time.Second
+
+// NotificationHandler API Gateway的站内通知处理器
+type NotificationHandler struct {
+	notificationGRPCClient *service.NotificationGRPCClientService
+	hub                    *realtime.NotificationHub
+}
+
+// NewNotificationHandler 创建站内通知处理器，hub 为空时不提供实时推送
+func NewNotificationHandler(notificationGRPCClient *service.NotificationGRPCClientService, hub *realtime.NotificationHub) *NotificationHandler {
+	return &NotificationHandler{
+		notificationGRPCClient: notificationGRPCClient,
+		hub:                    hub,
+	}
+}
+
+// MarkReadRequest 标记已读请求结构
+type MarkReadRequest struct {
+	IDs []uint `json:"ids" binding:"required,min=1"`
+}
+
+// UpdatePreferencesRequest 更新通知偏好请求结构，键为通知类型
+type UpdatePreferencesRequest struct {
+	Preferences map[string]bool `json:"preferences" binding:"required"`
+}
+
+// ListNotifications 获取我的通知
+// @Summary 通知列表
+// @Description 分页获取当前用户的站内通知（最新的在前），同时返回未读数
+// @Tags 站内通知
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param unread_only query bool false "只看未读"
+// @Param page query int false "页码，默认1"
+// @Param page_size query int false "每页数量，默认20"
+// @Success 200 {object} map[string]interface{}
+// @Router /api/v1/notifications [get]
+func (h *NotificationHandler) ListNotifications(c *gin.Context) {
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	pageSize, _ := strconv.Atoi(c.DefaultQuery("page_size", "20"))
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+	unreadOnly := c.Query("unread_only") == "true"
+
+	resp, err := h.notificationGRPCClient.ListNotifications(c.Request.Context(), c.GetUint("userID"), unreadOnly, uint(page), uint(pageSize))
+	if err != nil {
+		respondGRPCError(c, "获取通知列表失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	notifications := make([]gin.H, 0, len(resp.Notifications))
+	for _, n := range resp.Notifications {
+		notifications = append(notifications, convertNotificationToDisplay(n))
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data": gin.H{
+			"notifications": notifications,
+			"unread_count":  resp.UnreadCount,
+			"total":         resp.Total,
+			"page":          page,
+			"page_size":     pageSize,
+		},
+	})
+}
+
+// MarkRead 标记通知已读
+// @Summary 标记通知已读
+// @Description 将指定的通知标记为已读，返回剩余未读数
+// @Tags 站内通知
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param request body MarkReadRequest true "通知ID列表"
+// @Success 200 {object} map[string]interface{}
+// @Router /api/v1/notifications/read [post]
+func (h *NotificationHandler) MarkRead(c *gin.Context) {
+	var req MarkReadRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    400,
+			"message": "请求参数无效: " + err.Error(),
+		})
+		return
+	}
+
+	h.markRead(c, req.IDs, false)
+}
+
+// MarkAllRead 全部标记已读
+// @Summary 全部标记已读
+// @Description 将当前用户的全部通知标记为已读
+// @Tags 站内通知
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Success 200 {object} map[string]interface{}
+// @Router /api/v1/notifications/read-all [post]
+func (h *NotificationHandler) MarkAllRead(c *gin.Context) {
+	h.markRead(c, nil, true)
+}
+
+// markRead 调用通知服务标记已读并返回剩余未读数
+func (h *NotificationHandler) markRead(c *gin.Context, ids []uint, all bool) {
+	resp, err := h.notificationGRPCClient.MarkNotificationsRead(c.Request.Context(), c.GetUint("userID"), ids, all)
+	if err != nil {
+		respondGRPCError(c, "标记通知已读失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data": gin.H{
+			"unread_count": resp.UnreadCount,
+		},
+	})
+}
+
+// GetPreferences 获取通知偏好
+// @Summary 获取通知偏好
+// @Description 获取各类通知的开关状态，未设置过的类型默认开启
+// @Tags 站内通知
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Success 200 {object} map[string]interface{}
+// @Router /api/v1/notifications/preferences [get]
+func (h *NotificationHandler) GetPreferences(c *gin.Context) {
+	resp, err := h.notificationGRPCClient.GetPreferences(c.Request.Context(), c.GetUint("userID"))
+	if err != nil {
+		respondGRPCError(c, "获取通知偏好失败", err)
+		return
+	}
+	respondPreferences(c, resp)
+}
+
+// UpdatePreferences 更新通知偏好
+// @Summary 更新通知偏好
+// @Description 开启或关闭某类通知，关闭后不再收到该类通知
+// @Tags 站内通知
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param request body UpdatePreferencesRequest true "通知类型与开关"
+// @Success 200 {object} map[string]interface{}
+// @Router /api/v1/notifications/preferences [put]
+func (h *NotificationHandler) UpdatePreferences(c *gin.Context) {
+	var req UpdatePreferencesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    400,
+			"message": "请求参数无效: " + err.Error(),
+		})
+		return
+	}
+
+	resp, err := h.notificationGRPCClient.UpdatePreferences(c.Request.Context(), c.GetUint("userID"), req.Preferences)
+	if err != nil {
+		respondGRPCError(c, "更新通知偏好失败", err)
+		return
+	}
+	respondPreferences(c, resp)
+}
+
+// Stream 实时通知推送
+// @Summary 实时通知推送
+// @Description Server-Sent Events 长连接，收到新通知时推送 notification 事件，其他页面标记已读时推送 read 事件
+// @Tags 站内通知
+// @Produce text/event-stream
+// @Param Authorization header string true "Bearer token"
+// @Success 200 {string} string "事件流"
+// @Failure 503 {object} map[string]interface{}
+// @Router /api/v1/notifications/stream [get]
+func (h *NotificationHandler) Stream(c *gin.Context) {
+	if h.hub == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{
+			"code":    503,
+			"message": "实时推送暂不可用，请定时刷新通知列表",
+		})
+		return
+	}
+
+	userID := c.GetUint("userID")
+	events, unsubscribe := h.hub.Subscribe(userID)
+	defer unsubscribe()
+	log.Printf("🔍 API: 实时通知连接建立 - 用户ID: %d", userID)
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+
+	heartbeat := time.NewTicker(streamHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	c.SSEvent("ready", "ok")
+	c.Writer.Flush()
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case <-c.Request.Context().Done():
+			return false
+		case message := <-events:
+			c.SSEvent(message.Event, string(message.Payload))
+		case <-heartbeat.C:
+			c.SSEvent("ping", "")
+		}
+		return true
+	})
+	log.Printf("🔍 API: 实时通知连接关闭 - 用户ID: %d", userID)
+}
+
+// respondPreferences 返回通知偏好
+func respondPreferences(c *gin.Context, resp *notificationpb.PreferencesResponse) {
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	preferences := make([]gin.H, 0, len(resp.Preferences))
+	for _, p := range resp.Preferences {
+		preferences = append(preferences, gin.H{
+			"type":    p.Type,
+			"label":   p.Label,
+			"enabled": p.Enabled,
+		})
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data":    preferences,
+	})
+}
+
+// convertNotificationToDisplay 转换通知显示数据
+func convertNotificationToDisplay(n *notificationpb.Notification) gin.H {
+	return gin.H{
+		"id":         n.Id,
+		"type":       n.Type,
+		"title":      n.Title,
+		"body":       n.Body,
+		"link":       n.Link,
+		"ref_id":     n.RefId,
+		"read":       n.Read,
+		"created_at": n.CreatedAt,
+	}
+}
+
+// respondGRPCError 返回调用微服务失败的响应
+func respondGRPCError(c *gin.Context, action string, err error) {
+	log.Printf("❌ API: %s - %v", action, err)
+	c.JSON(http.StatusInternalServerError, gin.H{
+		"code":    500,
+		"message": action + ": " + err.Error(),
+	})
+}
+
+// respondBusinessError 按业务码返回对应HTTP状态
+func respondBusinessError(c *gin.Context, code int32, message string) {
+	status := http.StatusBadRequest
+	switch code {
+	case 403:
+		status = http.StatusForbidden
+	case 404:
+		status = http.StatusNotFound
+	}
+	c.JSON(status, gin.H{
+		"code":    code,
+		"message": message,
+	})
+}
@@ -0,0 +1,89 @@
+package model
+
+import (
+	"time"
+)
+
+// 通知类型
+const (
+	TypeAnnouncement     = "announcement"      // 课程公告
+	TypeAssignmentGraded = "assignment_graded" // 作业已批改
+	TypeDiscussionReply  = "discussion_reply"  // 讨论区收到回复
+)
+
+// TypeLabels 可在偏好设置中开关的通知类型及显示名称，按展示顺序排列
+var TypeLabels = []struct {
+	Type  string
+	Label string
+}{
+	{TypeAnnouncement, "课程公告"},
+	{TypeAssignmentGraded, "作业批改结果"},
+	{TypeDiscussionReply, "讨论区回复"},
+}
+
+// IsValidType 是否为已知的通知类型
+func IsValidType(notificationType string) bool {
+	for _, t := range TypeLabels {
+		if t.Type == notificationType {
+			return true
+		}
+	}
+	return false
+}
+
+// RealtimeChannel 实时推送使用的 Redis 发布订阅频道，课程服务发布、各网关实例订阅
+const RealtimeChannel = "notifications:realtime"
+
+// Notification 站内通知，每个接收人一条记录
+// Type + RefID 指向触发通知的业务对象（如公告），用于按业务对象同步已读状态
+type Notification struct {
+	ID        uint      `gorm:"primarykey" json:"id"` // 主键ID
+	CreatedAt time.Time `json:"created_at"`           // 创建时间
+
+	UserID uint       `gorm:"not null;index:idx_notification_user" json:"user_id"`         // 接收人ID
+	Type   string     `gorm:"size:30;not null;index:idx_notification_ref" json:"type"`     // 通知类型
+	Title  string     `gorm:"size:200;not null" json:"title"`                              // 标题
+	Body   string     `gorm:"type:text" json:"body"`                                       // 内容
+	Link   string     `gorm:"size:500" json:"link"`                                        // 点击后跳转的页面
+	RefID  uint       `gorm:"not null;default:0;index:idx_notification_ref" json:"ref_id"` // 关联业务对象ID
+	ReadAt *time.Time `gorm:"index:idx_notification_user" json:"read_at,omitempty"`        // 阅读时间，为空表示未读
+}
+
+// TableName 指定表名
+func (Notification) TableName() string {
+	return "notifications"
+}
+
+// IsRead 是否已读
+func (n *Notification) IsRead() bool {
+	return n.ReadAt != nil
+}
+
+// Preference 用户的通知偏好，没有记录的类型默认开启
+type Preference struct {
+	ID        uint      `gorm:"primarykey" json:"id"`
+	UpdatedAt time.Time `json:"updated_at"`
+
+	UserID  uint   `gorm:"not null;uniqueIndex:idx_notification_pref" json:"user_id"`      // 用户ID
+	Type    string `gorm:"size:30;not null;uniqueIndex:idx_notification_pref" json:"type"` // 通知类型
+	Enabled bool   `gorm:"not null" json:"enabled"`                                        // 是否接收该类通知
+}
+
+// TableName 指定表名
+func (Preference) TableName() string {
+	return "notification_preferences"
+}
+
+// 实时事件类型
+const (
+	EventCreated = "created" // 收到新通知
+	EventRead    = "read"    // 通知被标记已读（同步其他已打开的页面）
+)
+
+// RealtimeEvent 通过 Redis 发布的实时通知事件
+type RealtimeEvent struct {
+	Event        string        `json:"event"`
+	UserID       uint          `json:"user_id"`
+	Notification *Notification `json:"notification,omitempty"`
+	UnreadCount  int64         `json:"unread_count"` // 仅已读事件携带
+}
@@ -0,0 +1,177 @@
+package repository
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"course-platform/internal/domain/notification/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// notificationBatchSize 批量写入通知时每批的条数
+const notificationBatchSize = 500
+
+// NotificationRepositoryInterface 通知仓储接口
+type NotificationRepositoryInterface interface {
+	CreateBatch(notifications []*model.Notification) error
+	ReadStateByRef(userID uint, notificationType string, refIDs []uint) (map[uint]bool, error)
+	MarkRefRead(userID uint, notificationType string, refID uint) error
+	List(userID uint, unreadOnly bool, offset, limit int) ([]*model.Notification, int64, error)
+	CountUnread(userID uint) (int64, error)
+	MarkRead(userID uint, ids []uint) (int64, error)
+	MarkAllRead(userID uint) (int64, error)
+	ListPreferences(userID uint) ([]*model.Preference, error)
+	SavePreferences(preferences []*model.Preference) error
+	DisabledUserIDs(userIDs []uint, notificationType string) (map[uint]bool, error)
+}
+
+// NotificationRepository 通知仓储实现
+type NotificationRepository struct {
+	db *gorm.DB
+}
+
+// NewNotificationRepository 创建通知仓储实例
+func NewNotificationRepository(db *gorm.DB) NotificationRepositoryInterface {
+	return &NotificationRepository{db: db}
+}
+
+// CreateBatch 分批写入通知
+func (r *NotificationRepository) CreateBatch(notifications []*model.Notification) error {
+	if len(notifications) == 0 {
+		return nil
+	}
+	if err := r.db.CreateInBatches(notifications, notificationBatchSize).Error; err != nil {
+		log.Printf("❌ Repository: 写入通知失败 - %v", err)
+		return fmt.Errorf("写入通知失败: %w", err)
+	}
+	return nil
+}
+
+// ReadStateByRef 按业务对象查询用户收到的通知是否已读，没有收到通知的对象不在结果中
+func (r *NotificationRepository) ReadStateByRef(userID uint, notificationType string, refIDs []uint) (map[uint]bool, error) {
+	state := make(map[uint]bool)
+	if userID == 0 || len(refIDs) == 0 {
+		return state, nil
+	}
+
+	var notifications []*model.Notification
+	if err := r.db.Select("ref_id", "read_at").
+		Where("user_id = ? AND type = ? AND ref_id IN ?", userID, notificationType, refIDs).
+		Find(&notifications).Error; err != nil {
+		return nil, fmt.Errorf("查询通知状态失败: %w", err)
+	}
+	for _, n := range notifications {
+		state[n.RefID] = n.IsRead()
+	}
+	return state, nil
+}
+
+// MarkRefRead 将用户关于某个业务对象的通知标记为已读
+func (r *NotificationRepository) MarkRefRead(userID uint, notificationType string, refID uint) error {
+	if err := r.db.Model(&model.Notification{}).
+		Where("user_id = ? AND type = ? AND ref_id = ? AND read_at IS NULL", userID, notificationType, refID).
+		Update("read_at", time.Now()).Error; err != nil {
+		return fmt.Errorf("标记通知已读失败: %w", err)
+	}
+	return nil
+}
+
+// List 分页获取用户的通知，最新的在前
+func (r *NotificationRepository) List(userID uint, unreadOnly bool, offset, limit int) ([]*model.Notification, int64, error) {
+	query := r.db.Model(&model.Notification{}).Where("user_id = ?", userID)
+	if unreadOnly {
+		query = query.Where("read_at IS NULL")
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("统计通知失败: %w", err)
+	}
+
+	var notifications []*model.Notification
+	if err := query.Order("id DESC").Offset(offset).Limit(limit).Find(&notifications).Error; err != nil {
+		return nil, 0, fmt.Errorf("查询通知失败: %w", err)
+	}
+	return notifications, total, nil
+}
+
+// CountUnread 统计用户的未读通知数
+func (r *NotificationRepository) CountUnread(userID uint) (int64, error) {
+	var count int64
+	if err := r.db.Model(&model.Notification{}).
+		Where("user_id = ? AND read_at IS NULL", userID).
+		Count(&count).Error; err != nil {
+		return 0, fmt.Errorf("统计未读通知失败: %w", err)
+	}
+	return count, nil
+}
+
+// MarkRead 将用户的指定通知标记为已读，返回实际更新的条数
+func (r *NotificationRepository) MarkRead(userID uint, ids []uint) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	result := r.db.Model(&model.Notification{}).
+		Where("user_id = ? AND id IN ? AND read_at IS NULL", userID, ids).
+		Update("read_at", time.Now())
+	if result.Error != nil {
+		return 0, fmt.Errorf("标记通知已读失败: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+// MarkAllRead 将用户的全部通知标记为已读
+func (r *NotificationRepository) MarkAllRead(userID uint) (int64, error) {
+	result := r.db.Model(&model.Notification{}).
+		Where("user_id = ? AND read_at IS NULL", userID).
+		Update("read_at", time.Now())
+	if result.Error != nil {
+		return 0, fmt.Errorf("标记通知已读失败: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+// ListPreferences 获取用户保存过的通知偏好
+func (r *NotificationRepository) ListPreferences(userID uint) ([]*model.Preference, error) {
+	var preferences []*model.Preference
+	if err := r.db.Where("user_id = ?", userID).Find(&preferences).Error; err != nil {
+		return nil, fmt.Errorf("查询通知偏好失败: %w", err)
+	}
+	return preferences, nil
+}
+
+// SavePreferences 保存通知偏好（按用户和类型覆盖）
+func (r *NotificationRepository) SavePreferences(preferences []*model.Preference) error {
+	if len(preferences) == 0 {
+		return nil
+	}
+	if err := r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "type"}},
+		DoUpdates: clause.AssignmentColumns([]string{"enabled", "updated_at"}),
+	}).Create(&preferences).Error; err != nil {
+		return fmt.Errorf("保存通知偏好失败: %w", err)
+	}
+	return nil
+}
+
+// DisabledUserIDs 查询关闭了某类通知的用户
+func (r *NotificationRepository) DisabledUserIDs(userIDs []uint, notificationType string) (map[uint]bool, error) {
+	disabled := make(map[uint]bool)
+	if len(userIDs) == 0 {
+		return disabled, nil
+	}
+
+	var ids []uint
+	if err := r.db.Model(&model.Preference{}).
+		Where("user_id IN ? AND type = ? AND enabled = ?", userIDs, notificationType, false).
+		Pluck("user_id", &ids).Error; err != nil {
+		return nil, fmt.Errorf("查询通知偏好失败: %w", err)
+	}
+	for _, id := range ids {
+		disabled[id] = true
+	}
+	return disabled, nil
+}
@@ -0,0 +1,252 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+
+	"course-platform/internal/domain/notification/model"
+	"course-platform/internal/domain/notification/repository"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// 通知列表分页限制
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+// NotificationServiceInterface 站内通知服务接口
+type NotificationServiceInterface interface {
+	Notify(userIDs []uint, message *Message) (int, error)
+	ReadStateByRef(userID uint, notificationType string, refIDs []uint) (map[uint]bool, error)
+	MarkRefRead(userID uint, notificationType string, refID uint) error
+	ListNotifications(userID uint, unreadOnly bool, page, pageSize int) ([]*model.Notification, int64, int64, error)
+	MarkRead(userID uint, ids []uint) (int64, error)
+	MarkAllRead(userID uint) (int64, error)
+	GetPreferences(userID uint) ([]*PreferenceView, error)
+	UpdatePreferences(userID uint, enabled map[string]bool) ([]*PreferenceView, error)
+}
+
+// Message 要发送的通知内容
+type Message struct {
+	Type  string
+	Title string
+	Body  string
+	Link  string
+	RefID uint
+}
+
+// PreferenceView 某类通知的偏好（包含未保存过的默认值）
+type PreferenceView struct {
+	Type    string
+	Label   string
+	Enabled bool
+}
+
+// NotificationService 站内通知服务实现
+type NotificationService struct {
+	notificationRepo repository.NotificationRepositoryInterface
+	redis            *redis.Client
+}
+
+// NewNotificationService 创建站内通知服务实例，redis 为空时只落库不做实时推送
+func NewNotificationService(notificationRepo repository.NotificationRepositoryInterface, redis *redis.Client) NotificationServiceInterface {
+	return &NotificationService{
+		notificationRepo: notificationRepo,
+		redis:            redis,
+	}
+}
+
+// Notify 向多个用户发送同一条通知（重复的用户只发一次，关闭了该类通知的用户跳过），返回实际接收人数
+func (s *NotificationService) Notify(userIDs []uint, message *Message) (int, error) {
+	disabled, err := s.notificationRepo.DisabledUserIDs(userIDs, message.Type)
+	if err != nil {
+		return 0, err
+	}
+
+	seen := make(map[uint]bool, len(userIDs))
+	notifications := make([]*model.Notification, 0, len(userIDs))
+	for _, userID := range userIDs {
+		if userID == 0 || seen[userID] || disabled[userID] {
+			continue
+		}
+		seen[userID] = true
+		notifications = append(notifications, &model.Notification{
+			UserID: userID,
+			Type:   message.Type,
+			Title:  message.Title,
+			Body:   message.Body,
+			Link:   message.Link,
+			RefID:  message.RefID,
+		})
+	}
+
+	if err := s.notificationRepo.CreateBatch(notifications); err != nil {
+		return 0, err
+	}
+
+	events := make([]*model.RealtimeEvent, 0, len(notifications))
+	for _, notification := range notifications {
+		events = append(events, &model.RealtimeEvent{
+			Event:        model.EventCreated,
+			UserID:       notification.UserID,
+			Notification: notification,
+		})
+	}
+	s.publish(events...)
+
+	log.Printf("✅ Service: 通知已发送 - 类型: %s, 接收人数: %d", message.Type, len(notifications))
+	return len(notifications), nil
+}
+
+// ReadStateByRef 按业务对象查询用户的通知已读状态
+func (s *NotificationService) ReadStateByRef(userID uint, notificationType string, refIDs []uint) (map[uint]bool, error) {
+	return s.notificationRepo.ReadStateByRef(userID, notificationType, refIDs)
+}
+
+// MarkRefRead 将用户关于某个业务对象的通知标记为已读
+func (s *NotificationService) MarkRefRead(userID uint, notificationType string, refID uint) error {
+	if err := s.notificationRepo.MarkRefRead(userID, notificationType, refID); err != nil {
+		return err
+	}
+	s.publishUnreadCount(userID)
+	return nil
+}
+
+// ListNotifications 分页获取用户的通知，同时返回总数和未读数
+func (s *NotificationService) ListNotifications(userID uint, unreadOnly bool, page, pageSize int) ([]*model.Notification, int64, int64, error) {
+	if userID == 0 {
+		return nil, 0, 0, errors.New("用户ID不能为空")
+	}
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	notifications, total, err := s.notificationRepo.List(userID, unreadOnly, (page-1)*pageSize, pageSize)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	unread, err := s.notificationRepo.CountUnread(userID)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	return notifications, total, unread, nil
+}
+
+// MarkRead 将指定通知标记为已读，返回剩余未读数
+func (s *NotificationService) MarkRead(userID uint, ids []uint) (int64, error) {
+	if len(ids) == 0 {
+		return 0, errors.New("请指定要标记的通知")
+	}
+	updated, err := s.notificationRepo.MarkRead(userID, ids)
+	if err != nil {
+		return 0, err
+	}
+	return s.afterRead(userID, updated)
+}
+
+// MarkAllRead 将用户的全部通知标记为已读
+func (s *NotificationService) MarkAllRead(userID uint) (int64, error) {
+	updated, err := s.notificationRepo.MarkAllRead(userID)
+	if err != nil {
+		return 0, err
+	}
+	return s.afterRead(userID, updated)
+}
+
+// GetPreferences 获取用户的通知偏好，未设置过的类型默认开启
+func (s *NotificationService) GetPreferences(userID uint) ([]*PreferenceView, error) {
+	saved, err := s.notificationRepo.ListPreferences(userID)
+	if err != nil {
+		return nil, err
+	}
+	enabled := make(map[string]bool, len(saved))
+	for _, preference := range saved {
+		enabled[preference.Type] = preference.Enabled
+	}
+
+	views := make([]*PreferenceView, 0, len(model.TypeLabels))
+	for _, t := range model.TypeLabels {
+		view := &PreferenceView{Type: t.Type, Label: t.Label, Enabled: true}
+		if value, ok := enabled[t.Type]; ok {
+			view.Enabled = value
+		}
+		views = append(views, view)
+	}
+	return views, nil
+}
+
+// UpdatePreferences 更新用户的通知偏好，只需传入要修改的类型
+func (s *NotificationService) UpdatePreferences(userID uint, enabled map[string]bool) ([]*PreferenceView, error) {
+	preferences := make([]*model.Preference, 0, len(enabled))
+	for notificationType, value := range enabled {
+		if !model.IsValidType(notificationType) {
+			return nil, fmt.Errorf("未知的通知类型: %s", notificationType)
+		}
+		preferences = append(preferences, &model.Preference{
+			UserID:  userID,
+			Type:    notificationType,
+			Enabled: value,
+		})
+	}
+
+	if err := s.notificationRepo.SavePreferences(preferences); err != nil {
+		return nil, err
+	}
+	log.Printf("✅ Service: 通知偏好已更新 - 用户ID: %d", userID)
+	return s.GetPreferences(userID)
+}
+
+// afterRead 标记已读后查询剩余未读数，并通知用户其他已打开的页面
+func (s *NotificationService) afterRead(userID uint, updated int64) (int64, error) {
+	unread, err := s.notificationRepo.CountUnread(userID)
+	if err != nil {
+		return 0, err
+	}
+	if updated > 0 {
+		s.publish(&model.RealtimeEvent{Event: model.EventRead, UserID: userID, UnreadCount: unread})
+	}
+	return unread, nil
+}
+
+// publishUnreadCount 推送用户最新的未读数
+func (s *NotificationService) publishUnreadCount(userID uint) {
+	if s.redis == nil {
+		return
+	}
+	unread, err := s.notificationRepo.CountUnread(userID)
+	if err != nil {
+		return
+	}
+	s.publish(&model.RealtimeEvent{Event: model.EventRead, UserID: userID, UnreadCount: unread})
+}
+
+// publish 将事件发布到 Redis 频道，由各网关实例转发给在线用户；推送失败不影响通知落库
+func (s *NotificationService) publish(events ...*model.RealtimeEvent) {
+	if s.redis == nil || len(events) == 0 {
+		return
+	}
+
+	ctx := context.Background()
+	pipe := s.redis.Pipeline()
+	for _, event := range events {
+		payload, err := json.Marshal(event)
+		if err != nil {
+			continue
+		}
+		pipe.Publish(ctx, model.RealtimeChannel, payload)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		log.Printf("⚠️ Service: 实时通知推送失败 - %v", err)
+	}
+}
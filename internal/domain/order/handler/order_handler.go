@@ -0,0 +1,347 @@
+package handler
+
+import (
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+
+	service "course-platform/internal/infrastructure/grpc_client"
+
+	"github.com/gin-gonic/gin"
+)
+
+// 支付回调请求体上限
+const maxWebhookBodySize = 64 << 10
+
+// OrderHandler API Gateway的订单处理器
+type OrderHandler struct {
+	orderGRPCClient *service.OrderGRPCClientService
+}
+
+// NewOrderHandler 创建订单处理器
+func NewOrderHandler(orderGRPCClient *service.OrderGRPCClientService) *OrderHandler {
+	return &OrderHandler{
+		orderGRPCClient: orderGRPCClient,
+	}
+}
+
+// CreateOrderRequest 创建订单请求结构
+// course_id 和 bundle_id 二选一；幂等键也可以通过 Idempotency-Key 请求头传递
+type CreateOrderRequest struct {
+	CourseID       uint32 `json:"course_id"`
+	BundleID       uint32 `json:"bundle_id"`
+	IdempotencyKey string `json:"idempotency_key"`
+	CouponCode     string `json:"coupon_code"`
+}
+
+// SimulatePaymentRequest 模拟支付请求结构
+type SimulatePaymentRequest struct {
+	Succeed *bool `json:"succeed"`
+}
+
+// CreateOrder 创建订单
+// @Summary 创建订单
+// @Description 为付费课程或套餐创建订单并发起支付，相同幂等键重复提交返回同一订单
+// @Tags 订单管理
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param Idempotency-Key header string false "幂等键"
+// @Param order body CreateOrderRequest true "订单信息"
+// @Success 200 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Router /api/v1/orders [post]
+func (h *OrderHandler) CreateOrder(c *gin.Context) {
+	var req CreateOrderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    400,
+			"message": "请求参数无效: " + err.Error(),
+		})
+		return
+	}
+	if (req.CourseID == 0) == (req.BundleID == 0) {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    400,
+			"message": "course_id 和 bundle_id 必须且只能填写一个",
+		})
+		return
+	}
+
+	idempotencyKey := c.GetHeader("Idempotency-Key")
+	if idempotencyKey == "" {
+		idempotencyKey = req.IdempotencyKey
+	}
+
+	resp, err := h.orderGRPCClient.CreateOrder(c.Request.Context(), c.GetUint("userID"), uint(req.CourseID), uint(req.BundleID), idempotencyKey, req.CouponCode)
+	if err != nil {
+		respondGRPCError(c, "创建订单失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data":    resp.Order,
+	})
+}
+
+// PreviewCheckout 预览课程结算价格
+// @Summary 结算预览
+// @Description 返回课程原价、促销价、优惠券优惠和应付金额（单位：分）
+// @Tags 订单管理
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path int true "课程ID"
+// @Param coupon query string false "优惠码"
+// @Success 200 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Router /api/v1/courses/{id}/checkout [get]
+func (h *OrderHandler) PreviewCheckout(c *gin.Context) {
+	courseID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    400,
+			"message": "课程ID参数无效",
+		})
+		return
+	}
+
+	h.previewCheckout(c, uint(courseID), 0)
+}
+
+// PreviewBundleCheckout 预览套餐结算价格
+// @Summary 套餐结算预览
+// @Description 返回套餐价格、优惠券优惠和应付金额（单位：分），套餐仅可使用全平台优惠券
+// @Tags 订单管理
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path int true "套餐ID"
+// @Param coupon query string false "优惠码"
+// @Success 200 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Router /api/v1/bundles/{id}/checkout [get]
+func (h *OrderHandler) PreviewBundleCheckout(c *gin.Context) {
+	bundleID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    400,
+			"message": "套餐ID参数无效",
+		})
+		return
+	}
+
+	h.previewCheckout(c, 0, uint(bundleID))
+}
+
+// previewCheckout 查询课程或套餐的结算价格
+func (h *OrderHandler) previewCheckout(c *gin.Context, courseID, bundleID uint) {
+	resp, err := h.orderGRPCClient.PreviewOrder(c.Request.Context(), c.GetUint("userID"), courseID, bundleID, c.Query("coupon"))
+	if err != nil {
+		respondGRPCError(c, "获取结算价格失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data":    resp.Quote,
+	})
+}
+
+// ListMyOrders 获取本人的订单列表
+// @Summary 我的订单
+// @Description 获取当前用户的全部订单
+// @Tags 订单管理
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Success 200 {object} map[string]interface{}
+// @Router /api/v1/orders [get]
+func (h *OrderHandler) ListMyOrders(c *gin.Context) {
+	resp, err := h.orderGRPCClient.ListMyOrders(c.Request.Context(), c.GetUint("userID"))
+	if err != nil {
+		respondGRPCError(c, "获取订单列表失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data":    resp.Orders,
+	})
+}
+
+// GetOrder 获取订单详情
+// @Summary 订单详情
+// @Description 获取本人订单详情，可用于轮询支付结果
+// @Tags 订单管理
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param order_no path string true "订单号"
+// @Success 200 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Router /api/v1/orders/{order_no} [get]
+func (h *OrderHandler) GetOrder(c *gin.Context) {
+	resp, err := h.orderGRPCClient.GetOrder(c.Request.Context(), c.Param("order_no"), c.GetUint("userID"))
+	if err != nil {
+		respondGRPCError(c, "获取订单失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data":    resp.Order,
+	})
+}
+
+// CancelOrder 取消订单
+// @Summary 取消订单
+// @Description 取消待支付的订单
+// @Tags 订单管理
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param order_no path string true "订单号"
+// @Success 200 {object} map[string]interface{}
+// @Router /api/v1/orders/{order_no}/cancel [post]
+func (h *OrderHandler) CancelOrder(c *gin.Context) {
+	resp, err := h.orderGRPCClient.CancelOrder(c.Request.Context(), c.Param("order_no"), c.GetUint("userID"))
+	if err != nil {
+		respondGRPCError(c, "取消订单失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data":    resp.Order,
+	})
+}
+
+// SimulatePayment 模拟支付
+// @Summary 模拟支付
+// @Description 使用本地模拟支付渠道完成或拒绝支付，仅开发和测试环境可用
+// @Tags 订单管理
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param order_no path string true "订单号"
+// @Param payment body SimulatePaymentRequest false "支付结果，默认成功"
+// @Success 200 {object} map[string]interface{}
+// @Router /api/v1/orders/{order_no}/simulate-payment [post]
+func (h *OrderHandler) SimulatePayment(c *gin.Context) {
+	var req SimulatePaymentRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"code":    400,
+				"message": "请求参数无效: " + err.Error(),
+			})
+			return
+		}
+	}
+	succeed := req.Succeed == nil || *req.Succeed
+
+	resp, err := h.orderGRPCClient.SimulatePayment(c.Request.Context(), c.Param("order_no"), c.GetUint("userID"), succeed)
+	if err != nil {
+		respondGRPCError(c, "模拟支付失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data":    resp.Order,
+	})
+}
+
+// PaymentWebhook 接收支付渠道回调
+// @Summary 支付回调
+// @Description 支付渠道通知支付结果，签名通过 X-Payment-Signature 请求头传递
+// @Tags 订单管理
+// @Accept json
+// @Produce json
+// @Param X-Payment-Signature header string true "回调签名"
+// @Success 200 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Router /api/v1/payments/webhook [post]
+func (h *OrderHandler) PaymentWebhook(c *gin.Context) {
+	// 签名基于回调原文计算，必须原样转发
+	payload, err := io.ReadAll(io.LimitReader(c.Request.Body, maxWebhookBodySize))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    400,
+			"message": "读取回调内容失败",
+		})
+		return
+	}
+
+	resp, err := h.orderGRPCClient.HandlePaymentWebhook(c.Request.Context(), payload, c.GetHeader("X-Payment-Signature"))
+	if err != nil {
+		respondGRPCError(c, "处理支付回调失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		log.Printf("⚠️ API: 支付回调处理失败 - %s", resp.Message)
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+	})
+}
+
+// respondGRPCError 返回调用微服务失败的响应
+func respondGRPCError(c *gin.Context, action string, err error) {
+	log.Printf("❌ API: %s - %v", action, err)
+	c.JSON(http.StatusInternalServerError, gin.H{
+		"code":    500,
+		"message": action + ": " + err.Error(),
+	})
+}
+
+// respondBusinessError 按业务码返回对应HTTP状态
+func respondBusinessError(c *gin.Context, code int32, message string) {
+	status := http.StatusBadRequest
+	switch code {
+	case 401:
+		status = http.StatusUnauthorized
+	case 403:
+		status = http.StatusForbidden
+	case 404:
+		status = http.StatusNotFound
+	case 409:
+		status = http.StatusConflict
+	}
+	c.JSON(status, gin.H{
+		"code":    code,
+		"message": message,
+	})
+}
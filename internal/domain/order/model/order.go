@@ -0,0 +1,68 @@
+package model
+
+import (
+	"time"
+)
+
+// 订单状态
+const (
+	OrderStatusPending   = "pending"   // 待支付
+	OrderStatusPaid      = "paid"      // 已支付
+	OrderStatusRefunded  = "refunded"  // 已退款
+	OrderStatusCancelled = "cancelled" // 已取消（用户取消或支付失败）
+)
+
+// Order 课程订单
+// 金额以分为单位保存，避免浮点误差；只有已支付的订单会开通课程
+// 购买套餐时 CourseID 为0，BundleID 为套餐ID，CourseTitle 保存套餐标题
+type Order struct {
+	ID        uint      `gorm:"primarykey" json:"id"` // 主键ID
+	CreatedAt time.Time `json:"created_at"`           // 创建时间
+	UpdatedAt time.Time `json:"updated_at"`           // 更新时间
+
+	OrderNo        string  `gorm:"uniqueIndex;not null;size:32" json:"order_no"`                    // 订单号
+	UserID         uint    `gorm:"not null;index;uniqueIndex:idx_order_idempotency" json:"user_id"` // 购买人ID
+	CourseID       uint    `gorm:"not null;index" json:"course_id"`                                 // 课程ID
+	CourseTitle    string  `gorm:"size:200" json:"course_title"`                                    // 下单时的课程标题
+	Amount         int64   `gorm:"not null" json:"amount"`                                          // 订单金额（分）
+	Currency       string  `gorm:"size:3;not null;default:'CNY'" json:"currency"`                   // 币种
+	Status         string  `gorm:"size:20;not null;default:'pending';index" json:"status"`          // 订单状态
+	IdempotencyKey *string `gorm:"size:64;uniqueIndex:idx_order_idempotency" json:"-"`              // 幂等键（同一用户唯一，未提供时为NULL）
+	Provider       string  `gorm:"size:20" json:"provider"`                                         // 支付渠道
+	PaymentID      string  `gorm:"size:64;index" json:"payment_id"`                                 // 支付渠道的支付单号
+	CheckoutURL    string  `gorm:"size:500" json:"checkout_url"`                                    // 支付页地址
+
+	// 价格明细（分）：Amount = OriginalAmount - 促销优惠 - DiscountAmount
+	OriginalAmount int64  `gorm:"not null;default:0" json:"original_amount"` // 课程原价
+	DiscountAmount int64  `gorm:"not null;default:0" json:"discount_amount"` // 优惠券优惠金额
+	CouponCode     string `gorm:"size:32" json:"coupon_code"`                // 使用的优惠码
+	RefundedAmount int64  `gorm:"not null;default:0" json:"refunded_amount"` // 已退款金额，可小于订单金额（部分退款）
+
+	// 商品信息
+	BundleID     uint `gorm:"not null;default:0;index" json:"bundle_id"`     // 套餐ID，购买单门课程时为0
+	InstructorID uint `gorm:"not null;default:0;index" json:"instructor_id"` // 课程或套餐的讲师ID，用于收入分账
+
+	PaidAt      *time.Time `json:"paid_at"`      // 支付时间
+	CancelledAt *time.Time `json:"cancelled_at"` // 取消时间
+	RefundedAt  *time.Time `json:"refunded_at"`  // 退款时间
+}
+
+// TableName 指定表名
+func (Order) TableName() string {
+	return "orders"
+}
+
+// IsPending 订单是否待支付
+func (o *Order) IsPending() bool {
+	return o.Status == OrderStatusPending
+}
+
+// IsBundle 是否为套餐订单
+func (o *Order) IsBundle() bool {
+	return o.BundleID != 0
+}
+
+// IsPaid 订单是否已支付
+func (o *Order) IsPaid() bool {
+	return o.Status == OrderStatusPaid
+}
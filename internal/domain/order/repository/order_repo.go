@@ -0,0 +1,200 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"course-platform/internal/domain/order/model"
+
+	"gorm.io/gorm"
+)
+
+// OrderRepositoryInterface 订单仓储接口
+type OrderRepositoryInterface interface {
+	Create(order *model.Order) error
+	GetByOrderNo(orderNo string) (*model.Order, error)
+	GetByPaymentID(paymentID string) (*model.Order, error)
+	GetByIdempotencyKey(userID uint, key string) (*model.Order, error)
+	GetPendingByUserAndItem(userID, courseID, bundleID uint) (*model.Order, error)
+	ListByUser(userID uint) ([]*model.Order, error)
+	UpdatePayment(id uint, provider, paymentID, checkoutURL string) error
+	UpdateStatus(id uint, from, to string, at time.Time) (bool, error)
+	MarkRefunded(id uint, from string, amount int64, at time.Time) (bool, error)
+	ApplyRefund(id uint, refunded, amount int64, full bool, at time.Time) (bool, error)
+	RevertRefund(id uint, refunded, amount int64) error
+}
+
+// OrderRepository 订单仓储实现
+type OrderRepository struct {
+	db *gorm.DB
+}
+
+// NewOrderRepository 创建订单仓储实例
+func NewOrderRepository(db *gorm.DB) OrderRepositoryInterface {
+	return &OrderRepository{db: db}
+}
+
+// Create 创建订单
+func (r *OrderRepository) Create(order *model.Order) error {
+	if err := r.db.Create(order).Error; err != nil {
+		log.Printf("❌ Repository: 创建订单失败 - %v", err)
+		return fmt.Errorf("创建订单失败: %w", err)
+	}
+
+	log.Printf("✅ Repository: 订单创建成功 - 订单号: %s", order.OrderNo)
+	return nil
+}
+
+// GetByOrderNo 根据订单号获取订单
+func (r *OrderRepository) GetByOrderNo(orderNo string) (*model.Order, error) {
+	var order model.Order
+	if err := r.db.Where("order_no = ?", orderNo).First(&order).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("订单不存在")
+		}
+		return nil, fmt.Errorf("查询订单失败: %w", err)
+	}
+	return &order, nil
+}
+
+// GetByPaymentID 根据支付单号获取订单
+func (r *OrderRepository) GetByPaymentID(paymentID string) (*model.Order, error) {
+	var order model.Order
+	if err := r.db.Where("payment_id = ?", paymentID).First(&order).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("订单不存在")
+		}
+		return nil, fmt.Errorf("查询订单失败: %w", err)
+	}
+	return &order, nil
+}
+
+// GetByIdempotencyKey 根据幂等键获取订单，不存在时返回nil
+func (r *OrderRepository) GetByIdempotencyKey(userID uint, key string) (*model.Order, error) {
+	var order model.Order
+	err := r.db.Where("user_id = ? AND idempotency_key = ?", userID, key).First(&order).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("查询订单失败: %w", err)
+	}
+	return &order, nil
+}
+
+// GetPendingByUserAndItem 获取用户对课程或套餐的待支付订单，不存在时返回nil
+func (r *OrderRepository) GetPendingByUserAndItem(userID, courseID, bundleID uint) (*model.Order, error) {
+	var order model.Order
+	err := r.db.Where("user_id = ? AND course_id = ? AND bundle_id = ? AND status = ?", userID, courseID, bundleID, model.OrderStatusPending).
+		Order("created_at DESC").First(&order).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("查询订单失败: %w", err)
+	}
+	return &order, nil
+}
+
+// ListByUser 获取用户的全部订单
+func (r *OrderRepository) ListByUser(userID uint) ([]*model.Order, error) {
+	var orders []*model.Order
+	if err := r.db.Where("user_id = ?", userID).Order("created_at DESC").Find(&orders).Error; err != nil {
+		log.Printf("❌ Repository: 查询订单列表失败 - %v", err)
+		return nil, fmt.Errorf("查询订单列表失败: %w", err)
+	}
+	return orders, nil
+}
+
+// UpdatePayment 记录支付渠道创建的支付单
+func (r *OrderRepository) UpdatePayment(id uint, provider, paymentID, checkoutURL string) error {
+	err := r.db.Model(&model.Order{}).Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"provider":     provider,
+			"payment_id":   paymentID,
+			"checkout_url": checkoutURL,
+		}).Error
+	if err != nil {
+		return fmt.Errorf("更新订单支付信息失败: %w", err)
+	}
+	return nil
+}
+
+// UpdateStatus 仅当订单处于 from 状态时切换到 to 状态，并记录对应时间
+// 返回是否更新成功，用于防止重复回调或并发操作重复处理同一订单
+func (r *OrderRepository) UpdateStatus(id uint, from, to string, at time.Time) (bool, error) {
+	updates := map[string]interface{}{"status": to}
+	switch to {
+	case model.OrderStatusPaid:
+		updates["paid_at"] = at
+	case model.OrderStatusCancelled:
+		updates["cancelled_at"] = at
+	case model.OrderStatusRefunded:
+		updates["refunded_at"] = at
+	}
+
+	result := r.db.Model(&model.Order{}).Where("id = ? AND status = ?", id, from).Updates(updates)
+	if result.Error != nil {
+		log.Printf("❌ Repository: 更新订单状态失败 - %v", result.Error)
+		return false, fmt.Errorf("更新订单状态失败: %w", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// MarkRefunded 将订单标记为已退款并记录退款金额，仅当订单处于 from 状态时生效
+func (r *OrderRepository) MarkRefunded(id uint, from string, amount int64, at time.Time) (bool, error) {
+	result := r.db.Model(&model.Order{}).Where("id = ? AND status = ?", id, from).
+		Updates(map[string]interface{}{
+			"status":          model.OrderStatusRefunded,
+			"refunded_amount": amount,
+			"refunded_at":     at,
+		})
+	if result.Error != nil {
+		log.Printf("❌ Repository: 更新订单退款状态失败 - %v", result.Error)
+		return false, fmt.Errorf("更新订单退款状态失败: %w", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// ApplyRefund 在已支付订单上累加退款金额，refunded 为读取订单时的已退款金额，
+// full 为true时订单标记为已退款，部分退款时订单保持已支付；订单已变化时返回false
+func (r *OrderRepository) ApplyRefund(id uint, refunded, amount int64, full bool, at time.Time) (bool, error) {
+	status := model.OrderStatusPaid
+	if full {
+		status = model.OrderStatusRefunded
+	}
+
+	result := r.db.Model(&model.Order{}).
+		Where("id = ? AND status = ? AND refunded_amount = ?", id, model.OrderStatusPaid, refunded).
+		Updates(map[string]interface{}{
+			"status":          status,
+			"refunded_amount": refunded + amount,
+			"refunded_at":     at,
+		})
+	if result.Error != nil {
+		log.Printf("❌ Repository: 记录订单退款失败 - %v", result.Error)
+		return false, fmt.Errorf("记录订单退款失败: %w", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// RevertRefund 撤销 ApplyRefund 记录的退款（支付渠道退款失败时），订单恢复为已支付
+func (r *OrderRepository) RevertRefund(id uint, refunded, amount int64) error {
+	updates := map[string]interface{}{
+		"status":          model.OrderStatusPaid,
+		"refunded_amount": refunded,
+	}
+	if refunded == 0 {
+		updates["refunded_at"] = nil
+	}
+	err := r.db.Model(&model.Order{}).
+		Where("id = ? AND refunded_amount = ?", id, refunded+amount).
+		Updates(updates).Error
+	if err != nil {
+		log.Printf("❌ Repository: 撤销订单退款失败 - %v", err)
+		return fmt.Errorf("撤销订单退款失败: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,620 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"math/big"
+	"strings"
+	"time"
+
+	bundleService "course-platform/internal/domain/bundle/service"
+	couponModel "course-platform/internal/domain/coupon/model"
+	couponService "course-platform/internal/domain/coupon/service"
+	courseModel "course-platform/internal/domain/course/model"
+	courseService "course-platform/internal/domain/course/service"
+	emailModel "course-platform/internal/domain/email/model"
+	emailService "course-platform/internal/domain/email/service"
+	ledgerService "course-platform/internal/domain/ledger/service"
+	"course-platform/internal/domain/order/model"
+	"course-platform/internal/domain/order/repository"
+	"course-platform/internal/infrastructure/payment"
+)
+
+// 默认结算币种
+const defaultCurrency = "CNY"
+
+// OrderServiceInterface 订单服务接口
+type OrderServiceInterface interface {
+	CreateOrder(ctx context.Context, req *CreateOrderRequest) (*model.Order, error)
+	PreviewOrder(userID, courseID, bundleID uint, couponCode string) (*OrderQuote, error)
+	GetOrder(orderNo string, userID uint) (*model.Order, error)
+	ListOrders(userID uint) ([]*model.Order, error)
+	CancelOrder(orderNo string, userID uint) (*model.Order, error)
+	HandleWebhook(payload []byte, signature string) (*model.Order, error)
+	SimulatePayment(orderNo string, userID uint, succeed bool) (*model.Order, error)
+}
+
+// CreateOrderRequest 创建订单请求，CourseID 和 BundleID 二选一
+// IdempotencyKey 由客户端生成，重复提交同一个键时返回第一次创建的订单
+type CreateOrderRequest struct {
+	UserID         uint
+	CourseID       uint
+	BundleID       uint
+	IdempotencyKey string
+	CouponCode     string
+}
+
+// OrderQuote 结算价格明细（分）
+// OriginalAmount 为课程原价，EffectiveAmount 为促销后价格，FinalAmount 为再扣除优惠券后的应付金额
+// 购买套餐时 CourseID 为0，CourseTitle 为套餐标题；套餐只能使用全平台优惠券
+type OrderQuote struct {
+	CourseID        uint
+	BundleID        uint
+	CourseTitle     string
+	Currency        string
+	OriginalAmount  int64
+	EffectiveAmount int64
+	DiscountAmount  int64
+	FinalAmount     int64
+	OnSale          bool
+	CouponCode      string
+	InstructorID    uint
+}
+
+// OrderService 订单服务实现
+type OrderService struct {
+	orderRepo     repository.OrderRepositoryInterface
+	courseService courseService.CourseServiceInterface
+	couponService couponService.CouponServiceInterface
+	bundleService bundleService.BundleServiceInterface
+	ledgerService ledgerService.LedgerServiceInterface
+	emailService  emailService.EmailServiceInterface
+	provider      payment.Provider
+}
+
+// NewOrderService 创建订单服务实例
+func NewOrderService(orderRepo repository.OrderRepositoryInterface, courseService courseService.CourseServiceInterface, couponService couponService.CouponServiceInterface, bundleService bundleService.BundleServiceInterface, ledgerService ledgerService.LedgerServiceInterface, emailService emailService.EmailServiceInterface, provider payment.Provider) OrderServiceInterface {
+	return &OrderService{
+		orderRepo:     orderRepo,
+		courseService: courseService,
+		couponService: couponService,
+		bundleService: bundleService,
+		ledgerService: ledgerService,
+		emailService:  emailService,
+		provider:      provider,
+	}
+}
+
+// CreateOrder 为付费课程或套餐创建订单并发起支付
+func (s *OrderService) CreateOrder(ctx context.Context, req *CreateOrderRequest) (*model.Order, error) {
+	log.Printf("🔍 Service: 创建订单 - 用户ID: %d, 课程ID: %d, 套餐ID: %d", req.UserID, req.CourseID, req.BundleID)
+
+	if err := checkItem(req.UserID, req.CourseID, req.BundleID); err != nil {
+		return nil, err
+	}
+	if err := s.courseService.CheckEmailVerified(req.UserID); err != nil {
+		return nil, err
+	}
+	req.IdempotencyKey = strings.TrimSpace(req.IdempotencyKey)
+	if len(req.IdempotencyKey) > 64 {
+		return nil, errors.New("幂等键不能超过64个字符")
+	}
+	req.CouponCode = couponService.NormalizeCode(req.CouponCode)
+
+	// 同一幂等键重复提交，直接返回已创建的订单
+	if req.IdempotencyKey != "" {
+		existing, err := s.orderRepo.GetByIdempotencyKey(req.UserID, req.IdempotencyKey)
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil {
+			if existing.CourseID != req.CourseID || existing.BundleID != req.BundleID || existing.CouponCode != req.CouponCode {
+				return nil, errors.New("幂等键已用于其他订单")
+			}
+			return existing, nil
+		}
+	}
+
+	item, err := s.getPurchasableItem(req.CourseID, req.BundleID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkNotOwned(req.UserID, req.CourseID, req.BundleID); err != nil {
+		return nil, err
+	}
+	if err := s.checkPrerequisites(req.UserID, req.CourseID); err != nil {
+		return nil, err
+	}
+
+	// 已有待支付订单时继续使用，避免重复下单；更换优惠码时取消旧订单重新下单
+	pending, err := s.orderRepo.GetPendingByUserAndItem(req.UserID, req.CourseID, req.BundleID)
+	if err != nil {
+		return nil, err
+	}
+	if pending != nil {
+		if pending.CouponCode == req.CouponCode {
+			return pending, nil
+		}
+		if _, err := s.cancelPending(pending); err != nil {
+			return nil, err
+		}
+	}
+
+	quote, coupon, err := s.quote(req.UserID, item, req.CouponCode)
+	if err != nil {
+		return nil, err
+	}
+
+	orderNo, err := generateOrderNo()
+	if err != nil {
+		return nil, err
+	}
+	order := &model.Order{
+		OrderNo:        orderNo,
+		UserID:         req.UserID,
+		CourseID:       req.CourseID,
+		CourseTitle:    quote.CourseTitle,
+		Amount:         quote.FinalAmount,
+		Currency:       quote.Currency,
+		Status:         model.OrderStatusPending,
+		OriginalAmount: quote.OriginalAmount,
+		DiscountAmount: quote.DiscountAmount,
+		CouponCode:     quote.CouponCode,
+		BundleID:       req.BundleID,
+		InstructorID:   quote.InstructorID,
+	}
+	if req.IdempotencyKey != "" {
+		order.IdempotencyKey = &req.IdempotencyKey
+	}
+
+	if err := s.orderRepo.Create(order); err != nil {
+		// 并发提交同一幂等键时，以先写入的订单为准
+		if req.IdempotencyKey != "" {
+			if existing, _ := s.orderRepo.GetByIdempotencyKey(req.UserID, req.IdempotencyKey); existing != nil {
+				return existing, nil
+			}
+		}
+		return nil, err
+	}
+
+	if coupon != nil {
+		if err := s.couponService.Redeem(coupon, req.UserID, order.ID, quote.DiscountAmount); err != nil {
+			if _, cancelErr := s.orderRepo.UpdateStatus(order.ID, model.OrderStatusPending, model.OrderStatusCancelled, time.Now()); cancelErr != nil {
+				log.Printf("⚠️ Service: 取消订单失败 - %v", cancelErr)
+			}
+			return nil, err
+		}
+	}
+
+	// 优惠后无需支付，直接完成订单
+	if order.Amount == 0 {
+		log.Printf("🔍 Service: 订单优惠后金额为0，直接开通 - 订单号: %s", order.OrderNo)
+		return s.markPaid(order)
+	}
+
+	pay, err := s.provider.CreatePayment(ctx, &payment.PaymentRequest{
+		OrderNo:     order.OrderNo,
+		Amount:      order.Amount,
+		Currency:    order.Currency,
+		Description: quote.CourseTitle,
+	})
+	if err != nil {
+		log.Printf("❌ Service: 创建支付失败 - 订单号: %s, 错误: %v", order.OrderNo, err)
+		if _, cancelErr := s.cancelPending(order); cancelErr != nil {
+			log.Printf("⚠️ Service: 取消订单失败 - %v", cancelErr)
+		}
+		return nil, errors.New("创建支付失败，请稍后重试")
+	}
+
+	if err := s.orderRepo.UpdatePayment(order.ID, s.provider.Name(), pay.PaymentID, pay.CheckoutURL); err != nil {
+		return nil, err
+	}
+	order.Provider = s.provider.Name()
+	order.PaymentID = pay.PaymentID
+	order.CheckoutURL = pay.CheckoutURL
+
+	log.Printf("✅ Service: 订单创建成功 - 订单号: %s, 金额: %d", order.OrderNo, order.Amount)
+	return order, nil
+}
+
+// PreviewOrder 预览结算价格，展示原价、促销价和优惠券优惠
+func (s *OrderService) PreviewOrder(userID, courseID, bundleID uint, couponCode string) (*OrderQuote, error) {
+	if err := checkItem(userID, courseID, bundleID); err != nil {
+		return nil, err
+	}
+
+	item, err := s.getPurchasableItem(courseID, bundleID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkPrerequisites(userID, courseID); err != nil {
+		return nil, err
+	}
+
+	quote, _, err := s.quote(userID, item, couponService.NormalizeCode(couponCode))
+	if err != nil {
+		return nil, err
+	}
+	return quote, nil
+}
+
+// GetOrder 获取订单详情（仅购买人）
+func (s *OrderService) GetOrder(orderNo string, userID uint) (*model.Order, error) {
+	order, err := s.orderRepo.GetByOrderNo(orderNo)
+	if err != nil {
+		return nil, err
+	}
+	if order.UserID != userID {
+		return nil, errors.New("无权查看该订单")
+	}
+	return order, nil
+}
+
+// ListOrders 获取用户的全部订单
+func (s *OrderService) ListOrders(userID uint) ([]*model.Order, error) {
+	if userID == 0 {
+		return nil, errors.New("用户ID不能为空")
+	}
+	return s.orderRepo.ListByUser(userID)
+}
+
+// CancelOrder 取消待支付订单
+func (s *OrderService) CancelOrder(orderNo string, userID uint) (*model.Order, error) {
+	order, err := s.GetOrder(orderNo, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !order.IsPending() {
+		return nil, errors.New("只能取消待支付的订单")
+	}
+
+	ok, err := s.cancelPending(order)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.New("订单状态已变化，请刷新后重试")
+	}
+
+	log.Printf("✅ Service: 订单已取消 - 订单号: %s", order.OrderNo)
+	return s.orderRepo.GetByOrderNo(orderNo)
+}
+
+// HandleWebhook 处理支付渠道回调，支付成功时开通课程
+// 回调可能重复或乱序到达，处理逻辑需保证幂等
+func (s *OrderService) HandleWebhook(payload []byte, signature string) (*model.Order, error) {
+	event, err := s.provider.ParseWebhook(payload, signature)
+	if err != nil {
+		log.Printf("❌ Service: 支付回调校验失败 - %v", err)
+		return nil, err
+	}
+	log.Printf("🔍 Service: 收到支付回调 - 类型: %s, 支付单号: %s", event.Type, event.PaymentID)
+
+	order, err := s.orderRepo.GetByPaymentID(event.PaymentID)
+	if err != nil {
+		return nil, err
+	}
+	if event.OrderNo != "" && event.OrderNo != order.OrderNo {
+		return nil, errors.New("支付回调的订单号与支付单不符")
+	}
+
+	switch event.Type {
+	case payment.EventPaymentSucceeded:
+		return s.confirmPayment(order, event)
+	case payment.EventPaymentFailed:
+		if _, err := s.cancelPending(order); err != nil {
+			return nil, err
+		}
+		log.Printf("⚠️ Service: 支付失败，订单已取消 - 订单号: %s", order.OrderNo)
+	default:
+		log.Printf("⚠️ Service: 忽略未知的支付回调类型 - %s", event.Type)
+	}
+	return s.orderRepo.GetByOrderNo(order.OrderNo)
+}
+
+// confirmPayment 标记订单已支付并开通课程
+func (s *OrderService) confirmPayment(order *model.Order, event *payment.WebhookEvent) (*model.Order, error) {
+	if event.Amount != order.Amount {
+		return nil, fmt.Errorf("支付金额与订单不符: 实付 %d, 应付 %d", event.Amount, order.Amount)
+	}
+	return s.markPaid(order)
+}
+
+// markPaid 将订单标记为已支付并开通课程，重复调用时只补开通课程
+func (s *OrderService) markPaid(order *model.Order) (*model.Order, error) {
+	newlyPaid := false
+	switch order.Status {
+	case model.OrderStatusCancelled:
+		return s.refundLatePayment(order)
+	case model.OrderStatusRefunded:
+		// 已退款订单（含取消后到账被自动退回的付款）的重复回调，不再开通课程
+		log.Printf("⚠️ Service: 忽略已退款订单的支付回调 - 订单号: %s", order.OrderNo)
+		return order, nil
+	case model.OrderStatusPending:
+		ok, err := s.orderRepo.UpdateStatus(order.ID, order.Status, model.OrderStatusPaid, time.Now())
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			// 并发回调已处理，重新读取状态
+			if order, err = s.orderRepo.GetByOrderNo(order.OrderNo); err != nil {
+				return nil, err
+			}
+			if !order.IsPaid() {
+				return nil, errors.New("订单状态已变化，无法确认支付")
+			}
+		}
+		newlyPaid = ok
+	case model.OrderStatusPaid:
+		// 重复回调，确保课程已开通即可
+	default:
+		return nil, fmt.Errorf("订单状态为 %s，无法确认支付", order.Status)
+	}
+
+	// 开通失败时返回错误，由支付渠道重试回调
+	if err := s.grant(order); err != nil {
+		log.Printf("❌ Service: 开通课程失败 - 订单号: %s, 错误: %v", order.OrderNo, err)
+		return nil, err
+	}
+
+	paid, err := s.orderRepo.GetByOrderNo(order.OrderNo)
+	if err != nil {
+		return nil, err
+	}
+
+	// 记账失败不影响支付结果，重复回调时会再次尝试
+	if err := s.ledgerService.RecordSale(paid); err != nil {
+		log.Printf("⚠️ Service: 订单收入记账失败 - 订单号: %s, 错误: %v", paid.OrderNo, err)
+	}
+	// 购买凭证只在首次确认支付时发送，重复回调不再发送
+	if newlyPaid {
+		s.sendReceipt(paid)
+	}
+
+	log.Printf("✅ Service: 订单支付成功 - 订单号: %s", order.OrderNo)
+	return paid, nil
+}
+
+// refundLatePayment 订单取消后才到账的付款不开通课程，直接原路退回
+// 取消时优惠券已归还，用户也可能已重新下单，按已支付处理会造成重复购买
+// 先将订单改为已退款防止并发回调重复退款，渠道退款失败时恢复状态并返回错误，由支付渠道重试回调
+func (s *OrderService) refundLatePayment(order *model.Order) (*model.Order, error) {
+	log.Printf("⚠️ Service: 已取消订单收到付款，自动退款 - 订单号: %s", order.OrderNo)
+
+	ok, err := s.orderRepo.MarkRefunded(order.ID, model.OrderStatusCancelled, order.Amount, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		// 并发回调已处理，重新读取状态
+		return s.orderRepo.GetByOrderNo(order.OrderNo)
+	}
+
+	result, err := s.provider.Refund(context.Background(), &payment.RefundRequest{
+		PaymentID: order.PaymentID,
+		OrderNo:   order.OrderNo,
+		Amount:    order.Amount,
+		Reason:    "订单已取消，自动退回付款",
+	})
+	if err != nil {
+		log.Printf("❌ Service: 已取消订单退款失败 - 订单号: %s, 错误: %v", order.OrderNo, err)
+		if _, rollbackErr := s.orderRepo.UpdateStatus(order.ID, model.OrderStatusRefunded, model.OrderStatusCancelled, time.Now()); rollbackErr != nil {
+			log.Printf("⚠️ Service: 恢复订单取消状态失败 - %v", rollbackErr)
+		}
+		return nil, fmt.Errorf("已取消订单的付款退款失败: %w", err)
+	}
+
+	log.Printf("✅ Service: 已取消订单的付款已退回 - 订单号: %s, 退款单号: %s", order.OrderNo, result.RefundID)
+	return s.orderRepo.GetByOrderNo(order.OrderNo)
+}
+
+// sendReceipt 发送购买凭证邮件，入队失败只记录日志
+func (s *OrderService) sendReceipt(order *model.Order) {
+	coursePath := fmt.Sprintf("/course/%d", order.CourseID)
+	if order.IsBundle() {
+		coursePath = fmt.Sprintf("/bundle/%d", order.BundleID)
+	}
+	paidAt := time.Now()
+	if order.PaidAt != nil {
+		paidAt = *order.PaidAt
+	}
+
+	data := map[string]interface{}{
+		"OrderNo":     order.OrderNo,
+		"CourseTitle": order.CourseTitle,
+		"Amount":      formatPrice(order.Currency, order.Amount),
+		"PaidAt":      paidAt.Format("2006-01-02 15:04"),
+		"CoursePath":  coursePath,
+	}
+	if order.DiscountAmount > 0 {
+		data["Discount"] = formatPrice(order.Currency, order.DiscountAmount)
+	}
+	if err := s.emailService.SendToUser(order.UserID, emailModel.CategoryOrder, emailService.TemplatePurchaseReceipt, data); err != nil {
+		log.Printf("⚠️ Service: 购买凭证邮件入队失败 - 订单号: %s, 错误: %v", order.OrderNo, err)
+	}
+}
+
+// formatPrice 将分格式化为带币种的金额，如 CNY 1234 -> ¥12.34
+func formatPrice(currency string, cents int64) string {
+	symbol := currency + " "
+	if currency == defaultCurrency {
+		symbol = "¥"
+	}
+	return fmt.Sprintf("%s%d.%02d", symbol, cents/100, cents%100)
+}
+
+// SimulatePayment 使用模拟支付渠道完成支付（仅开发和测试环境）
+func (s *OrderService) SimulatePayment(orderNo string, userID uint, succeed bool) (*model.Order, error) {
+	fake, ok := s.provider.(*payment.FakeProvider)
+	if !ok {
+		return nil, errors.New("当前支付渠道不支持模拟支付")
+	}
+
+	order, err := s.GetOrder(orderNo, userID)
+	if err != nil {
+		return nil, err
+	}
+	if order.PaymentID == "" {
+		return nil, errors.New("订单尚未创建支付单")
+	}
+
+	eventType := payment.EventPaymentSucceeded
+	if !succeed {
+		eventType = payment.EventPaymentFailed
+	}
+	payload, signature, err := fake.BuildWebhook(&payment.WebhookEvent{
+		Type:      eventType,
+		PaymentID: order.PaymentID,
+		OrderNo:   order.OrderNo,
+		Amount:    order.Amount,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.HandleWebhook(payload, signature)
+}
+
+// checkItem 校验下单参数，课程和套餐必须且只能指定一个
+func checkItem(userID, courseID, bundleID uint) error {
+	if userID == 0 {
+		return errors.New("用户ID不能为空")
+	}
+	if (courseID == 0) == (bundleID == 0) {
+		return errors.New("请指定要购买的课程或套餐")
+	}
+	return nil
+}
+
+// getPurchasableItem 获取可购买的付费课程或套餐，返回不含优惠券的价格
+func (s *OrderService) getPurchasableItem(courseID, bundleID uint) (*OrderQuote, error) {
+	if bundleID != 0 {
+		bundle, err := s.bundleService.GetBundle(bundleID, 0)
+		if err != nil {
+			return nil, err
+		}
+		if bundle.IsFree() {
+			return nil, errors.New("免费套餐无需购买，请直接报名")
+		}
+		amount := toCents(bundle.Price)
+		return &OrderQuote{
+			BundleID:        bundle.ID,
+			CourseTitle:     bundle.Title,
+			Currency:        defaultCurrency,
+			OriginalAmount:  amount,
+			EffectiveAmount: amount,
+			InstructorID:    bundle.CreatorID,
+		}, nil
+	}
+
+	course, err := s.courseService.GetCourseByID(courseID)
+	if err != nil {
+		return nil, err
+	}
+	if !course.IsPublished() {
+		return nil, errors.New("课程尚未发布")
+	}
+	if course.Price <= 0 {
+		return nil, errors.New("免费课程无需购买，请直接报名")
+	}
+	now := time.Now()
+	return &OrderQuote{
+		CourseID:        course.ID,
+		CourseTitle:     course.Title,
+		Currency:        defaultCurrency,
+		OriginalAmount:  toCents(course.Price),
+		EffectiveAmount: toCents(course.EffectivePrice(now)),
+		OnSale:          course.OnSale(now),
+		InstructorID:    course.InstructorID,
+	}, nil
+}
+
+// checkNotOwned 检查用户是否已拥有课程或套餐
+func (s *OrderService) checkNotOwned(userID, courseID, bundleID uint) error {
+	if bundleID != 0 {
+		enrolled, err := s.bundleService.IsEnrolled(userID, bundleID)
+		if err != nil {
+			return err
+		}
+		if enrolled {
+			return errors.New("您已拥有该套餐")
+		}
+		return nil
+	}
+
+	hasAccess, err := s.courseService.HasCourseAccess(userID, courseID)
+	if err != nil {
+		return err
+	}
+	if hasAccess {
+		return errors.New("您已拥有该课程")
+	}
+	return nil
+}
+
+// checkPrerequisites 单独购买课程需满足先修要求；套餐按整体开通，不逐门检查
+func (s *OrderService) checkPrerequisites(userID, courseID uint) error {
+	if courseID == 0 {
+		return nil
+	}
+	return s.courseService.CheckPrerequisites(userID, courseID)
+}
+
+// grant 为已支付订单开通课程或套餐
+func (s *OrderService) grant(order *model.Order) error {
+	if order.IsBundle() {
+		_, err := s.bundleService.GrantBundle(order.UserID, order.BundleID)
+		return err
+	}
+	_, err := s.courseService.GrantEnrollment(order.UserID, order.CourseID, courseModel.EnrollmentSourceOrder, order.ID)
+	return err
+}
+
+// quote 在商品价格基础上计算优惠券优惠，couponCode 为空时不使用优惠券
+func (s *OrderService) quote(userID uint, item *OrderQuote, couponCode string) (*OrderQuote, *couponModel.Coupon, error) {
+	quote := *item
+	quote.FinalAmount = quote.EffectiveAmount
+	if couponCode == "" {
+		return &quote, nil, nil
+	}
+
+	coupon, discount, err := s.couponService.Quote(couponCode, userID, quote.CourseID, quote.EffectiveAmount)
+	if err != nil {
+		return nil, nil, err
+	}
+	quote.CouponCode = coupon.Code
+	quote.DiscountAmount = discount
+	quote.FinalAmount -= discount
+	return &quote, coupon, nil
+}
+
+// cancelPending 取消待支付订单并归还使用的优惠券，返回是否取消成功
+func (s *OrderService) cancelPending(order *model.Order) (bool, error) {
+	ok, err := s.orderRepo.UpdateStatus(order.ID, model.OrderStatusPending, model.OrderStatusCancelled, time.Now())
+	if err != nil || !ok {
+		return ok, err
+	}
+	if order.CouponCode != "" {
+		if err := s.couponService.Release(order.ID); err != nil {
+			log.Printf("⚠️ Service: 归还优惠券失败 - 订单号: %s, 错误: %v", order.OrderNo, err)
+		}
+	}
+	return true, nil
+}
+
+// toCents 将课程价格（元）转换为分
+func toCents(price float32) int64 {
+	return int64(math.Round(float64(price) * 100))
+}
+
+// generateOrderNo 生成订单号：时间戳 + 8位随机数
+func generateOrderNo() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(100000000))
+	if err != nil {
+		return "", fmt.Errorf("生成订单号失败: %w", err)
+	}
+	return fmt.Sprintf("%s%08d", time.Now().Format("20060102150405"), n.Int64()), nil
+}
@@ -0,0 +1,330 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	courseModel "course-platform/internal/domain/course/model"
+	courseService "course-platform/internal/domain/course/service"
+	emailService "course-platform/internal/domain/email/service"
+	ledgerService "course-platform/internal/domain/ledger/service"
+	"course-platform/internal/domain/order/model"
+	"course-platform/internal/infrastructure/payment"
+)
+
+const testCourseID = 7
+
+// memoryOrderRepo 内存订单仓储，状态切换与数据库一样按原状态条件更新
+type memoryOrderRepo struct {
+	orders []*model.Order
+}
+
+func (r *memoryOrderRepo) Create(order *model.Order) error {
+	order.ID = uint(len(r.orders) + 1)
+	r.orders = append(r.orders, order)
+	return nil
+}
+
+func (r *memoryOrderRepo) find(match func(*model.Order) bool) *model.Order {
+	for _, order := range r.orders {
+		if match(order) {
+			copied := *order
+			return &copied
+		}
+	}
+	return nil
+}
+
+func (r *memoryOrderRepo) GetByOrderNo(orderNo string) (*model.Order, error) {
+	if order := r.find(func(o *model.Order) bool { return o.OrderNo == orderNo }); order != nil {
+		return order, nil
+	}
+	return nil, errors.New("订单不存在")
+}
+
+func (r *memoryOrderRepo) GetByPaymentID(paymentID string) (*model.Order, error) {
+	if order := r.find(func(o *model.Order) bool { return o.PaymentID == paymentID }); order != nil {
+		return order, nil
+	}
+	return nil, errors.New("订单不存在")
+}
+
+func (r *memoryOrderRepo) GetByIdempotencyKey(userID uint, key string) (*model.Order, error) {
+	return r.find(func(o *model.Order) bool {
+		return o.UserID == userID && o.IdempotencyKey != nil && *o.IdempotencyKey == key
+	}), nil
+}
+
+func (r *memoryOrderRepo) GetPendingByUserAndItem(userID, courseID, bundleID uint) (*model.Order, error) {
+	return r.find(func(o *model.Order) bool {
+		return o.UserID == userID && o.CourseID == courseID && o.BundleID == bundleID && o.IsPending()
+	}), nil
+}
+
+func (r *memoryOrderRepo) ListByUser(userID uint) ([]*model.Order, error) {
+	return nil, nil
+}
+
+func (r *memoryOrderRepo) UpdatePayment(id uint, provider, paymentID, checkoutURL string) error {
+	order := r.orders[id-1]
+	order.Provider, order.PaymentID, order.CheckoutURL = provider, paymentID, checkoutURL
+	return nil
+}
+
+func (r *memoryOrderRepo) UpdateStatus(id uint, from, to string, at time.Time) (bool, error) {
+	order := r.orders[id-1]
+	if order.Status != from {
+		return false, nil
+	}
+	order.Status = to
+	if to == model.OrderStatusPaid {
+		order.PaidAt = &at
+	}
+	return true, nil
+}
+
+func (r *memoryOrderRepo) MarkRefunded(id uint, from string, amount int64, at time.Time) (bool, error) {
+	order := r.orders[id-1]
+	if order.Status != from {
+		return false, nil
+	}
+	order.Status = model.OrderStatusRefunded
+	order.RefundedAmount = amount
+	return true, nil
+}
+
+func (r *memoryOrderRepo) ApplyRefund(id uint, refunded, amount int64, full bool, at time.Time) (bool, error) {
+	return false, errors.New("not implemented")
+}
+
+func (r *memoryOrderRepo) RevertRefund(id uint, refunded, amount int64) error {
+	return errors.New("not implemented")
+}
+
+// stubCourseService 课程服务桩，一门已发布的付费课程，记录开通次数
+type stubCourseService struct {
+	courseService.CourseServiceInterface
+	granted int
+}
+
+func (s *stubCourseService) GetCourseByID(id uint) (*courseModel.Course, error) {
+	return &courseModel.Course{ID: id, Title: "Go 入门", Price: 99, Status: "published", InstructorID: 3}, nil
+}
+
+func (s *stubCourseService) CheckEmailVerified(userID uint) error {
+	return nil
+}
+
+func (s *stubCourseService) HasCourseAccess(userID, courseID uint) (bool, error) {
+	return false, nil
+}
+
+func (s *stubCourseService) CheckPrerequisites(userID, courseID uint) error {
+	return nil
+}
+
+func (s *stubCourseService) GrantEnrollment(userID, courseID uint, source string, sourceID uint) (*courseModel.Enrollment, error) {
+	s.granted++
+	return &courseModel.Enrollment{UserID: userID, CourseID: courseID}, nil
+}
+
+// stubLedgerService 分账服务桩
+type stubLedgerService struct {
+	ledgerService.LedgerServiceInterface
+}
+
+func (s *stubLedgerService) RecordSale(order *model.Order) error {
+	return nil
+}
+
+// stubEmailService 邮件服务桩，记录发送的邮件数
+type stubEmailService struct {
+	emailService.EmailServiceInterface
+	sent int
+}
+
+func (s *stubEmailService) SendToUser(userID uint, category, template string, data map[string]interface{}) error {
+	s.sent++
+	return nil
+}
+
+// countingProvider 模拟支付渠道，记录退款次数，refundErr 不为空时退款失败
+type countingProvider struct {
+	*payment.FakeProvider
+	refunds   int
+	refundErr error
+}
+
+func (p *countingProvider) Refund(ctx context.Context, req *payment.RefundRequest) (*payment.RefundResult, error) {
+	if p.refundErr != nil {
+		return nil, p.refundErr
+	}
+	p.refunds++
+	return p.FakeProvider.Refund(ctx, req)
+}
+
+// orderFixture 订单服务及其依赖
+type orderFixture struct {
+	service  OrderServiceInterface
+	orders   *memoryOrderRepo
+	courses  *stubCourseService
+	emails   *stubEmailService
+	provider *countingProvider
+}
+
+func newOrderFixture() *orderFixture {
+	f := &orderFixture{
+		orders:   &memoryOrderRepo{},
+		courses:  &stubCourseService{},
+		emails:   &stubEmailService{},
+		provider: &countingProvider{FakeProvider: payment.NewFakeProvider("test-secret")},
+	}
+	f.service = NewOrderService(f.orders, f.courses, nil, nil, &stubLedgerService{}, f.emails, f.provider)
+	return f
+}
+
+// create 下单购买测试课程
+func (f *orderFixture) create(t *testing.T, userID uint, key string) *model.Order {
+	t.Helper()
+	order, err := f.service.CreateOrder(context.Background(), &CreateOrderRequest{UserID: userID, CourseID: testCourseID, IdempotencyKey: key})
+	if err != nil {
+		t.Fatalf("创建订单失败: %v", err)
+	}
+	return order
+}
+
+// pay 模拟支付渠道发送支付成功回调
+func (f *orderFixture) pay(order *model.Order, amount int64) (*model.Order, error) {
+	payload, signature, err := f.provider.BuildWebhook(&payment.WebhookEvent{
+		Type:      payment.EventPaymentSucceeded,
+		PaymentID: order.PaymentID,
+		OrderNo:   order.OrderNo,
+		Amount:    amount,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return f.service.HandleWebhook(payload, signature)
+}
+
+func TestCreateOrderIdempotency(t *testing.T) {
+	tests := []struct {
+		name     string
+		second   *CreateOrderRequest
+		wantSame bool
+		wantErr  bool
+	}{
+		{"同一幂等键重复提交返回原订单", &CreateOrderRequest{UserID: 9, CourseID: testCourseID, IdempotencyKey: "key-1"}, true, false},
+		{"幂等键前后空白不影响", &CreateOrderRequest{UserID: 9, CourseID: testCourseID, IdempotencyKey: " key-1 "}, true, false},
+		{"同一幂等键购买其他课程", &CreateOrderRequest{UserID: 9, CourseID: testCourseID + 1, IdempotencyKey: "key-1"}, false, true},
+		{"同一幂等键使用优惠码", &CreateOrderRequest{UserID: 9, CourseID: testCourseID, IdempotencyKey: "key-1", CouponCode: "SAVE10"}, false, true},
+		{"其他用户使用相同幂等键", &CreateOrderRequest{UserID: 10, CourseID: testCourseID, IdempotencyKey: "key-1"}, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newOrderFixture()
+			first := f.create(t, 9, "key-1")
+
+			second, err := f.service.CreateOrder(context.Background(), tt.second)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if same := second.OrderNo == first.OrderNo; same != tt.wantSame {
+				t.Errorf("返回原订单 = %v, want %v", same, tt.wantSame)
+			}
+			wantOrders := 2
+			if tt.wantSame {
+				wantOrders = 1
+			}
+			if len(f.orders.orders) != wantOrders {
+				t.Errorf("订单数 = %d, want %d", len(f.orders.orders), wantOrders)
+			}
+		})
+	}
+}
+
+func TestHandleWebhookPayment(t *testing.T) {
+	f := newOrderFixture()
+	order := f.create(t, 9, "")
+
+	if _, err := f.pay(order, order.Amount-1); err == nil {
+		t.Fatal("支付金额与订单不符时应失败")
+	}
+	if f.courses.granted != 0 {
+		t.Fatalf("金额不符时不应开通课程")
+	}
+
+	for i := 0; i < 2; i++ {
+		paid, err := f.pay(order, order.Amount)
+		if err != nil {
+			t.Fatalf("第%d次回调失败: %v", i+1, err)
+		}
+		if !paid.IsPaid() {
+			t.Fatalf("订单状态 = %s, want paid", paid.Status)
+		}
+	}
+	if f.emails.sent != 1 {
+		t.Errorf("购买凭证发送 %d 次, want 1", f.emails.sent)
+	}
+}
+
+func TestHandleWebhookLatePayment(t *testing.T) {
+	tests := []struct {
+		name        string
+		refundErr   error
+		wantErr     bool
+		wantStatus  string
+		wantRefunds int
+	}{
+		{"取消后到账自动退款", nil, false, model.OrderStatusRefunded, 1},
+		{"渠道退款失败时恢复取消状态", errors.New("渠道超时"), true, model.OrderStatusCancelled, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newOrderFixture()
+			order := f.create(t, 9, "")
+			if _, err := f.service.CancelOrder(order.OrderNo, 9); err != nil {
+				t.Fatalf("取消订单失败: %v", err)
+			}
+			f.provider.refundErr = tt.refundErr
+
+			_, err := f.pay(order, order.Amount)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			stored, _ := f.orders.GetByOrderNo(order.OrderNo)
+			if stored.Status != tt.wantStatus {
+				t.Errorf("订单状态 = %s, want %s", stored.Status, tt.wantStatus)
+			}
+			if f.provider.refunds != tt.wantRefunds {
+				t.Errorf("退款 %d 次, want %d", f.provider.refunds, tt.wantRefunds)
+			}
+			if f.courses.granted != 0 {
+				t.Errorf("已取消订单的付款不应开通课程")
+			}
+
+			// 支付渠道重试回调：失败的退款会再次尝试，已退款的不会重复退款
+			f.provider.refundErr = nil
+			if _, err := f.pay(order, order.Amount); err != nil {
+				t.Fatalf("重试回调失败: %v", err)
+			}
+			stored, _ = f.orders.GetByOrderNo(order.OrderNo)
+			if stored.Status != model.OrderStatusRefunded || stored.RefundedAmount != order.Amount {
+				t.Errorf("订单 = (%s, %d), want (refunded, %d)", stored.Status, stored.RefundedAmount, order.Amount)
+			}
+			if f.provider.refunds != 1 {
+				t.Errorf("累计退款 %d 次, want 1", f.provider.refunds)
+			}
+			if f.courses.granted != 0 || f.emails.sent != 0 {
+				t.Errorf("已取消订单的付款不应开通课程或发送凭证")
+			}
+		})
+	}
+}
@@ -0,0 +1,214 @@
+package handler
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+
+	"course-platform/internal/configs"
+	service "course-platform/internal/infrastructure/grpc_client"
+	"course-platform/internal/shared/pb/privacypb"
+
+	"github.com/gin-gonic/gin"
+)
+
+// PrivacyHandler API Gateway的个人数据处理器（数据导出与账号注销）
+type PrivacyHandler struct {
+	privacyGRPCClient *service.PrivacyGRPCClientService
+}
+
+// NewPrivacyHandler 创建个人数据处理器
+func NewPrivacyHandler(privacyGRPCClient *service.PrivacyGRPCClientService) *PrivacyHandler {
+	return &PrivacyHandler{
+		privacyGRPCClient: privacyGRPCClient,
+	}
+}
+
+// RequestAccountDeletionRequest 注销账号请求结构
+// confirm_email 必须与账号邮箱一致；transfer_to_email 为课程接收人，不传表示课程下架归档
+type RequestAccountDeletionRequest struct {
+	ConfirmEmail    string `json:"confirm_email" binding:"required"`
+	TransferToEmail string `json:"transfer_to_email"`
+}
+
+// GetPrivacyStatus 获取数据导出记录和注销状态
+// @Summary 个人数据状态
+// @Description 获取本人的数据导出记录、进行中的注销申请和注销宽限天数
+// @Tags 个人数据
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Success 200 {object} map[string]interface{}
+// @Router /api/v1/me/privacy [get]
+func (h *PrivacyHandler) GetPrivacyStatus(c *gin.Context) {
+	resp, err := h.privacyGRPCClient.GetPrivacyStatus(c.Request.Context(), c.GetUint("userID"))
+	if err != nil {
+		respondGRPCError(c, "获取个人数据状态失败", err)
+		return
+	}
+	respondStatus(c, resp)
+}
+
+// RequestDataExport 申请导出个人数据
+// @Summary 申请数据导出
+// @Description 后台打包本人的资料、选课、学习进度、讨论和上传文件，完成后邮件通知；24小时内最多申请3次
+// @Tags 个人数据
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Success 200 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Failure 429 {object} map[string]interface{}
+// @Router /api/v1/me/exports [post]
+func (h *PrivacyHandler) RequestDataExport(c *gin.Context) {
+	resp, err := h.privacyGRPCClient.RequestDataExport(c.Request.Context(), c.GetUint("userID"))
+	if err != nil {
+		respondGRPCError(c, "申请数据导出失败", err)
+		return
+	}
+	respondStatus(c, resp)
+}
+
+// DownloadDataExport 下载数据导出文件
+// @Summary 下载数据导出
+// @Description 返回导出的ZIP文件；只能下载本人且未过期的导出
+// @Tags 个人数据
+// @Produce application/zip
+// @Param Authorization header string true "Bearer token"
+// @Param id path int true "导出ID"
+// @Success 200 {file} file "ZIP文件"
+// @Failure 404 {object} map[string]interface{}
+// @Failure 410 {object} map[string]interface{}
+// @Router /api/v1/me/exports/{id}/download [get]
+func (h *PrivacyHandler) DownloadDataExport(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    400,
+			"message": "无效的导出ID",
+		})
+		return
+	}
+
+	resp, err := h.privacyGRPCClient.GetDataExport(c.Request.Context(), c.GetUint("userID"), uint(id))
+	if err != nil {
+		respondGRPCError(c, "获取数据导出失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	// 压缩包不在静态目录中公开，由网关从上传目录读取后返回
+	filePath, err := configs.GetStaticPathConfig().UploadPath(resp.FileUrl)
+	if err == nil {
+		_, err = os.Stat(filePath)
+	}
+	if err != nil {
+		log.Printf("❌ 读取数据导出文件失败: %v", err)
+		respondBusinessError(c, 404, "导出文件不存在")
+		return
+	}
+
+	c.Header("Cache-Control", "private, no-store")
+	c.FileAttachment(filePath, fmt.Sprintf("data-export-%d.zip", id))
+}
+
+// RequestAccountDeletion 申请注销账号
+// @Summary 申请注销账号
+// @Description 宽限期结束后删除账号：课程转交给接收人或下架归档，讨论内容匿名保留，上传文件和个人资料删除；宽限期内可撤销
+// @Tags 个人数据
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param deletion body RequestAccountDeletionRequest true "注销信息"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Router /api/v1/me/deletion [post]
+func (h *PrivacyHandler) RequestAccountDeletion(c *gin.Context) {
+	var req RequestAccountDeletionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    400,
+			"message": "请求参数无效: " + err.Error(),
+		})
+		return
+	}
+
+	resp, err := h.privacyGRPCClient.RequestAccountDeletion(c.Request.Context(), c.GetUint("userID"), req.ConfirmEmail, req.TransferToEmail)
+	if err != nil {
+		respondGRPCError(c, "申请注销账号失败", err)
+		return
+	}
+	respondStatus(c, resp)
+}
+
+// CancelAccountDeletion 撤销注销申请
+// @Summary 撤销注销
+// @Description 宽限期内撤销注销申请，账号恢复正常
+// @Tags 个人数据
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Success 200 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Router /api/v1/me/deletion [delete]
+func (h *PrivacyHandler) CancelAccountDeletion(c *gin.Context) {
+	resp, err := h.privacyGRPCClient.CancelAccountDeletion(c.Request.Context(), c.GetUint("userID"))
+	if err != nil {
+		respondGRPCError(c, "撤销注销申请失败", err)
+		return
+	}
+	respondStatus(c, resp)
+}
+
+// respondStatus 返回个人数据状态
+func respondStatus(c *gin.Context, resp *privacypb.PrivacyStatusResponse) {
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	exports := resp.Exports
+	if exports == nil {
+		exports = []*privacypb.DataExport{}
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data": gin.H{
+			"exports":    exports,
+			"deletion":   resp.Deletion,
+			"grace_days": resp.GraceDays,
+		},
+	})
+}
+
+// respondGRPCError 返回调用微服务失败的响应
+func respondGRPCError(c *gin.Context, action string, err error) {
+	log.Printf("❌ API: %s - %v", action, err)
+	c.JSON(http.StatusInternalServerError, gin.H{
+		"code":    500,
+		"message": action + ": " + err.Error(),
+	})
+}
+
+// respondBusinessError 按业务码返回对应HTTP状态
+func respondBusinessError(c *gin.Context, code int32, message string) {
+	status := http.StatusBadRequest
+	switch code {
+	case 404:
+		status = http.StatusNotFound
+	case 409:
+		status = http.StatusConflict
+	case 410:
+		status = http.StatusGone
+	case 429:
+		status = http.StatusTooManyRequests
+	}
+	c.JSON(status, gin.H{
+		"code":    code,
+		"message": message,
+	})
+}
@@ -0,0 +1,110 @@
+package model
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 数据导出状态
+const (
+	ExportStatusPending    = "pending"    // 等待后台任务打包
+	ExportStatusProcessing = "processing" // 正在打包
+	ExportStatusReady      = "ready"      // 已完成，可在有效期内下载
+	ExportStatusFailed     = "failed"     // 打包失败，可重新申请
+	ExportStatusExpired    = "expired"    // 已过期，压缩包已删除
+)
+
+// 账号注销状态
+const (
+	DeletionStatusScheduled  = "scheduled"  // 宽限期中，可撤销
+	DeletionStatusProcessing = "processing" // 宽限期已结束，正在执行（失败时自动重试），不可撤销
+	DeletionStatusCancelled  = "cancelled"  // 用户已撤销
+	DeletionStatusCompleted  = "completed"  // 已完成，账号已清除
+	DeletionStatusFailed     = "failed"     // 超过重试次数，需要人工处理
+)
+
+// DataExport 个人数据导出记录，压缩包保存在内容服务，只有本人可以下载
+type DataExport struct {
+	ID        uint      `gorm:"primarykey" json:"id"` // 主键ID
+	CreatedAt time.Time `json:"created_at"`           // 申请时间
+	UpdatedAt time.Time `json:"updated_at"`           // 更新时间
+
+	UserID      uint       `gorm:"not null;index" json:"user_id"`                          // 申请人ID
+	Status      string     `gorm:"size:20;not null;default:'pending';index" json:"status"` // 导出状态
+	FileID      uint       `gorm:"not null;default:0" json:"-"`                            // 内容服务中的压缩包文件ID
+	FileURL     string     `gorm:"size:500" json:"-"`                                      // 压缩包下载地址，只通过下载接口返回给本人
+	FileSize    int64      `gorm:"not null;default:0" json:"file_size"`                    // 压缩包大小（字节）
+	FileCount   int        `gorm:"not null;default:0" json:"file_count"`                   // 打包的上传文件数量
+	Error       string     `gorm:"size:500" json:"error"`                                  // 失败原因
+	StartedAt   *time.Time `json:"started_at"`                                             // 开始打包时间，用于回收中断的任务
+	CompletedAt *time.Time `json:"completed_at"`                                           // 完成时间
+	ExpiresAt   *time.Time `gorm:"index" json:"expires_at"`                                // 下载有效期
+}
+
+// TableName 指定表名
+func (DataExport) TableName() string {
+	return "data_exports"
+}
+
+// IsInProgress 是否仍在排队或打包中
+func (e *DataExport) IsInProgress() bool {
+	return e.Status == ExportStatusPending || e.Status == ExportStatusProcessing
+}
+
+// IsDownloadable 指定时间是否可以下载
+func (e *DataExport) IsDownloadable(now time.Time) bool {
+	return e.Status == ExportStatusReady && e.FileURL != "" && e.ExpiresAt != nil && now.Before(*e.ExpiresAt)
+}
+
+// AccountDeletion 账号注销申请，宽限期结束后由后台任务执行
+// 执行步骤均可重复执行，中途失败时按 NextAttemptAt 重试；转交的课程ID在第一步完成后保存，重试时用于转交文件
+type AccountDeletion struct {
+	ID        uint      `gorm:"primarykey" json:"id"` // 主键ID
+	CreatedAt time.Time `json:"created_at"`           // 申请时间
+	UpdatedAt time.Time `json:"updated_at"`           // 更新时间
+
+	UserID               uint       `gorm:"not null;index" json:"user_id"`                         // 申请人ID
+	Status               string     `gorm:"size:20;not null;index:idx_deletion_due" json:"status"` // 注销状态
+	ScheduledFor         time.Time  `gorm:"not null" json:"scheduled_for"`                         // 宽限期结束时间
+	NextAttemptAt        time.Time  `gorm:"not null;index:idx_deletion_due" json:"-"`              // 下次执行时间
+	TransferToID         uint       `gorm:"not null;default:0" json:"transfer_to_id"`              // 课程接收人ID，0表示课程归档
+	TransferToEmail      string     `gorm:"size:100" json:"transfer_to_email"`                     // 课程接收人邮箱
+	CoursesHandedOver    bool       `gorm:"not null;default:false" json:"courses_handed_over"`     // 课程是否已转交或归档
+	TransferredCourseIDs string     `gorm:"type:text" json:"-"`                                    // 已转交的课程ID，逗号分隔
+	ArchivedCourseIDs    string     `gorm:"type:text" json:"-"`                                    // 已归档的课程ID，逗号分隔
+	Attempts             int        `gorm:"not null;default:0" json:"attempts"`                    // 已执行次数
+	LastError            string     `gorm:"size:500" json:"last_error"`                            // 最近一次失败原因
+	CancelledAt          *time.Time `json:"cancelled_at"`                                          // 撤销时间
+	CompletedAt          *time.Time `json:"completed_at"`                                          // 完成时间
+}
+
+// TableName 指定表名
+func (AccountDeletion) TableName() string {
+	return "account_deletions"
+}
+
+// IsActive 是否为进行中的注销申请（宽限期中或正在执行）
+func (d *AccountDeletion) IsActive() bool {
+	return d.Status == DeletionStatusScheduled || d.Status == DeletionStatusProcessing
+}
+
+// JoinIDs 把ID列表保存为逗号分隔的字符串
+func JoinIDs(ids []uint) string {
+	parts := make([]string, len(ids))
+	for i, id := range ids {
+		parts[i] = strconv.FormatUint(uint64(id), 10)
+	}
+	return strings.Join(parts, ",")
+}
+
+// SplitIDs 解析逗号分隔的ID列表
+func SplitIDs(value string) []uint {
+	var ids []uint
+	for _, part := range strings.Split(value, ",") {
+		if id, err := strconv.ParseUint(strings.TrimSpace(part), 10, 32); err == nil && id > 0 {
+			ids = append(ids, uint(id))
+		}
+	}
+	return ids
+}
@@ -0,0 +1,223 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"course-platform/internal/domain/privacy/model"
+
+	"gorm.io/gorm"
+)
+
+// PrivacyRepositoryInterface 个人数据导出与账号注销仓储接口
+type PrivacyRepositoryInterface interface {
+	// 数据导出
+	CreateExport(export *model.DataExport) error
+	GetExport(id uint) (*model.DataExport, error)
+	ListExports(userID uint, limit int) ([]*model.DataExport, error)
+	CountExportsSince(userID uint, since time.Time) (int64, error)
+	ReleaseStaleExports(startedBefore time.Time) (int64, error)
+	ClaimPendingExports(limit int) ([]*model.DataExport, error)
+	UpdateExport(export *model.DataExport) error
+	ListExpiredExports(now time.Time, limit int) ([]*model.DataExport, error)
+	ExpireUserExports(userID uint) error
+
+	// 账号注销
+	CreateDeletion(deletion *model.AccountDeletion) error
+	GetActiveDeletion(userID uint) (*model.AccountDeletion, error)
+	CancelDeletion(userID uint, at time.Time) (bool, error)
+	ClaimDueDeletions(limit int, lease time.Duration) ([]*model.AccountDeletion, error)
+	UpdateDeletion(deletion *model.AccountDeletion) error
+}
+
+// PrivacyRepository 个人数据导出与账号注销仓储实现
+type PrivacyRepository struct {
+	db *gorm.DB
+}
+
+// NewPrivacyRepository 创建个人数据仓储实例
+func NewPrivacyRepository(db *gorm.DB) PrivacyRepositoryInterface {
+	return &PrivacyRepository{db: db}
+}
+
+// CreateExport 保存导出申请
+func (r *PrivacyRepository) CreateExport(export *model.DataExport) error {
+	if err := r.db.Create(export).Error; err != nil {
+		log.Printf("❌ Repository: 保存数据导出申请失败 - %v", err)
+		return fmt.Errorf("保存数据导出申请失败: %w", err)
+	}
+	return nil
+}
+
+// GetExport 获取导出记录
+func (r *PrivacyRepository) GetExport(id uint) (*model.DataExport, error) {
+	var export model.DataExport
+	if err := r.db.First(&export, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("导出记录不存在")
+		}
+		return nil, fmt.Errorf("查询导出记录失败: %w", err)
+	}
+	return &export, nil
+}
+
+// ListExports 获取用户最近的导出记录
+func (r *PrivacyRepository) ListExports(userID uint, limit int) ([]*model.DataExport, error) {
+	var exports []*model.DataExport
+	if err := r.db.Where("user_id = ?", userID).Order("id DESC").Limit(limit).Find(&exports).Error; err != nil {
+		return nil, fmt.Errorf("查询导出记录失败: %w", err)
+	}
+	return exports, nil
+}
+
+// CountExportsSince 统计用户在指定时间之后的导出申请数量
+func (r *PrivacyRepository) CountExportsSince(userID uint, since time.Time) (int64, error) {
+	var count int64
+	if err := r.db.Model(&model.DataExport{}).
+		Where("user_id = ? AND created_at >= ?", userID, since).
+		Count(&count).Error; err != nil {
+		return 0, fmt.Errorf("统计导出申请失败: %w", err)
+	}
+	return count, nil
+}
+
+// ReleaseStaleExports 把打包时间过长（后台任务中断）的导出放回队列
+func (r *PrivacyRepository) ReleaseStaleExports(startedBefore time.Time) (int64, error) {
+	result := r.db.Model(&model.DataExport{}).
+		Where("status = ? AND started_at < ?", model.ExportStatusProcessing, startedBefore).
+		Updates(map[string]interface{}{"status": model.ExportStatusPending, "started_at": nil})
+	if result.Error != nil {
+		return 0, fmt.Errorf("回收中断的导出任务失败: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+// ClaimPendingExports 领取等待打包的导出，按申请顺序处理
+func (r *PrivacyRepository) ClaimPendingExports(limit int) ([]*model.DataExport, error) {
+	var candidates []*model.DataExport
+	if err := r.db.Where("status = ?", model.ExportStatusPending).
+		Order("id ASC").Limit(limit).Find(&candidates).Error; err != nil {
+		return nil, fmt.Errorf("查询待打包的导出失败: %w", err)
+	}
+
+	claimed := make([]*model.DataExport, 0, len(candidates))
+	for _, export := range candidates {
+		now := time.Now()
+		result := r.db.Model(&model.DataExport{}).
+			Where("id = ? AND status = ?", export.ID, model.ExportStatusPending).
+			Updates(map[string]interface{}{"status": model.ExportStatusProcessing, "started_at": now})
+		if result.Error != nil {
+			return claimed, fmt.Errorf("领取导出任务失败: %w", result.Error)
+		}
+		if result.RowsAffected == 1 {
+			export.Status = model.ExportStatusProcessing
+			export.StartedAt = &now
+			claimed = append(claimed, export)
+		}
+	}
+	return claimed, nil
+}
+
+// UpdateExport 保存导出记录
+func (r *PrivacyRepository) UpdateExport(export *model.DataExport) error {
+	if err := r.db.Save(export).Error; err != nil {
+		log.Printf("❌ Repository: 更新导出记录失败 - %v", err)
+		return fmt.Errorf("更新导出记录失败: %w", err)
+	}
+	return nil
+}
+
+// ListExpiredExports 获取已过有效期但压缩包尚未删除的导出
+func (r *PrivacyRepository) ListExpiredExports(now time.Time, limit int) ([]*model.DataExport, error) {
+	var exports []*model.DataExport
+	if err := r.db.Where("status = ? AND expires_at <= ?", model.ExportStatusReady, now).
+		Order("expires_at ASC").Limit(limit).Find(&exports).Error; err != nil {
+		return nil, fmt.Errorf("查询过期导出失败: %w", err)
+	}
+	return exports, nil
+}
+
+// ExpireUserExports 把用户全部未过期的导出标记为过期，账号注销时压缩包随上传文件一起删除
+func (r *PrivacyRepository) ExpireUserExports(userID uint) error {
+	if err := r.db.Model(&model.DataExport{}).
+		Where("user_id = ? AND status <> ?", userID, model.ExportStatusExpired).
+		Updates(map[string]interface{}{"status": model.ExportStatusExpired, "file_url": ""}).Error; err != nil {
+		return fmt.Errorf("更新导出记录失败: %w", err)
+	}
+	return nil
+}
+
+// CreateDeletion 保存注销申请
+func (r *PrivacyRepository) CreateDeletion(deletion *model.AccountDeletion) error {
+	if err := r.db.Create(deletion).Error; err != nil {
+		log.Printf("❌ Repository: 保存注销申请失败 - %v", err)
+		return fmt.Errorf("保存注销申请失败: %w", err)
+	}
+	return nil
+}
+
+// GetActiveDeletion 获取用户进行中的注销申请，没有时返回 nil
+func (r *PrivacyRepository) GetActiveDeletion(userID uint) (*model.AccountDeletion, error) {
+	var deletion model.AccountDeletion
+	err := r.db.Where("user_id = ? AND status IN ?", userID,
+		[]string{model.DeletionStatusScheduled, model.DeletionStatusProcessing}).
+		Order("id DESC").First(&deletion).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("查询注销申请失败: %w", err)
+	}
+	return &deletion, nil
+}
+
+// CancelDeletion 撤销宽限期中的注销申请，已开始执行的不能撤销
+func (r *PrivacyRepository) CancelDeletion(userID uint, at time.Time) (bool, error) {
+	result := r.db.Model(&model.AccountDeletion{}).
+		Where("user_id = ? AND status = ?", userID, model.DeletionStatusScheduled).
+		Updates(map[string]interface{}{"status": model.DeletionStatusCancelled, "cancelled_at": at})
+	if result.Error != nil {
+		log.Printf("❌ Repository: 撤销注销申请失败 - %v", result.Error)
+		return false, fmt.Errorf("撤销注销申请失败: %w", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// ClaimDueDeletions 领取到期的注销申请并改为执行中，NextAttemptAt 顺延 lease，任务中断时到期后会被重新领取
+func (r *PrivacyRepository) ClaimDueDeletions(limit int, lease time.Duration) ([]*model.AccountDeletion, error) {
+	now := time.Now()
+	var candidates []*model.AccountDeletion
+	if err := r.db.Where("status IN ? AND next_attempt_at <= ?",
+		[]string{model.DeletionStatusScheduled, model.DeletionStatusProcessing}, now).
+		Order("next_attempt_at ASC").Limit(limit).Find(&candidates).Error; err != nil {
+		return nil, fmt.Errorf("查询到期的注销申请失败: %w", err)
+	}
+
+	claimed := make([]*model.AccountDeletion, 0, len(candidates))
+	for _, deletion := range candidates {
+		until := now.Add(lease)
+		result := r.db.Model(&model.AccountDeletion{}).
+			Where("id = ? AND status = ? AND next_attempt_at = ?", deletion.ID, deletion.Status, deletion.NextAttemptAt).
+			Updates(map[string]interface{}{"status": model.DeletionStatusProcessing, "next_attempt_at": until})
+		if result.Error != nil {
+			return claimed, fmt.Errorf("领取注销申请失败: %w", result.Error)
+		}
+		if result.RowsAffected == 1 {
+			deletion.Status = model.DeletionStatusProcessing
+			deletion.NextAttemptAt = until
+			claimed = append(claimed, deletion)
+		}
+	}
+	return claimed, nil
+}
+
+// UpdateDeletion 保存注销申请
+func (r *PrivacyRepository) UpdateDeletion(deletion *model.AccountDeletion) error {
+	if err := r.db.Save(deletion).Error; err != nil {
+		log.Printf("❌ Repository: 更新注销申请失败 - %v", err)
+		return fmt.Errorf("更新注销申请失败: %w", err)
+	}
+	return nil
+}
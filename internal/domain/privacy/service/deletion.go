@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"course-platform/internal/domain/privacy/model"
+)
+
+// 注销任务参数
+const (
+	deletionBatchSize      = 5                // 每次领取的注销申请数量
+	deletionLease          = 30 * time.Minute // 执行中超过此时间视为任务已中断，重新领取
+	deletionRetryBaseDelay = 10 * time.Minute // 首次重试的等待时间，之后按指数增长
+	deletionRetryMaxDelay  = 6 * time.Hour    // 重试等待时间上限
+	maxDeletionAttempts    = 5                // 超过后标记为失败，需要人工处理
+)
+
+// ProcessDeletions 执行宽限期已结束的账号注销
+func (s *PrivacyService) ProcessDeletions(ctx context.Context) {
+	deletions, err := s.repo.ClaimDueDeletions(deletionBatchSize, deletionLease)
+	if err != nil {
+		log.Printf("❌ 个人数据任务: %v", err)
+	}
+	for _, deletion := range deletions {
+		if ctx.Err() != nil {
+			return
+		}
+		s.runDeletion(ctx, deletion)
+	}
+}
+
+// runDeletion 执行单个注销申请并记录结果，失败时按指数退避重试
+func (s *PrivacyService) runDeletion(ctx context.Context, deletion *model.AccountDeletion) {
+	deletion.Attempts++
+	err := s.executeDeletion(ctx, deletion)
+	if err == nil {
+		now := time.Now()
+		deletion.Status = model.DeletionStatusCompleted
+		deletion.CompletedAt = &now
+		deletion.LastError = ""
+		if err := s.repo.UpdateDeletion(deletion); err != nil {
+			log.Printf("❌ 个人数据任务: %v", err)
+		}
+		log.Printf("✅ 账号注销完成 - 用户ID: %d", deletion.UserID)
+		return
+	}
+
+	lastError := []rune(err.Error())
+	if len(lastError) > 500 {
+		lastError = lastError[:500]
+	}
+	deletion.LastError = string(lastError)
+	if deletion.Attempts >= maxDeletionAttempts {
+		log.Printf("❌ 账号注销失败，不再重试 - 用户ID: %d, 错误: %v", deletion.UserID, err)
+		deletion.Status = model.DeletionStatusFailed
+	} else {
+		delay := deletionRetryBaseDelay << (deletion.Attempts - 1)
+		if delay > deletionRetryMaxDelay {
+			delay = deletionRetryMaxDelay
+		}
+		log.Printf("⚠️ 账号注销失败，%v 后重试 - 用户ID: %d, 第 %d 次, 错误: %v", delay, deletion.UserID, deletion.Attempts, err)
+		deletion.NextAttemptAt = time.Now().Add(delay)
+	}
+	if err := s.repo.UpdateDeletion(deletion); err != nil {
+		log.Printf("❌ 个人数据任务: %v", err)
+	}
+}
+
+// executeDeletion 依次处理课程、文件、讨论内容和账号，每一步都可以重复执行
+func (s *PrivacyService) executeDeletion(ctx context.Context, deletion *model.AccountDeletion) error {
+	// 1. 转交或归档课程，结果保存后重试时不再重复处理
+	if !deletion.CoursesHandedOver {
+		if deletion.TransferToID != 0 {
+			if _, err := s.userRepo.GetByID(deletion.TransferToID); err != nil {
+				log.Printf("⚠️ 课程接收人已不存在，课程改为归档 - 用户ID: %d, 接收人ID: %d", deletion.UserID, deletion.TransferToID)
+				deletion.TransferToID = 0
+			}
+		}
+		transferred, archived, err := s.courseService.HandOverCourses(deletion.UserID, deletion.TransferToID)
+		if err != nil {
+			return err
+		}
+		deletion.CoursesHandedOver = true
+		deletion.TransferredCourseIDs = model.JoinIDs(transferred)
+		deletion.ArchivedCourseIDs = model.JoinIDs(archived)
+		if err := s.repo.UpdateDeletion(deletion); err != nil {
+			return err
+		}
+	}
+
+	// 2. 转交课程中的文件改为新讲师上传，其余上传文件（含数据导出）全部删除
+	if err := s.fileStore.PurgeUserFiles(ctx, deletion.UserID, model.SplitIDs(deletion.TransferredCourseIDs), deletion.TransferToID); err != nil {
+		return err
+	}
+	if err := s.repo.ExpireUserExports(deletion.UserID); err != nil {
+		return err
+	}
+
+	// 3. 讨论内容保留，作者改为已注销用户
+	if err := s.discussionService.AnonymizeUser(deletion.UserID); err != nil {
+		return err
+	}
+
+	// 4. 清除账号个人信息，所有登录方式失效
+	return s.eraser.EraseAccount(deletion.UserID)
+}
@@ -0,0 +1,193 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	courseModel "course-platform/internal/domain/course/model"
+	discussionModel "course-platform/internal/domain/discussion/model"
+	emailModel "course-platform/internal/domain/email/model"
+	emailService "course-platform/internal/domain/email/service"
+	"course-platform/internal/domain/privacy/model"
+)
+
+// 导出任务参数
+const (
+	exportBatchSize    = 2                // 每次领取的导出数量
+	staleExportAfter   = 30 * time.Minute // 打包超过此时间视为任务已中断，重新排队
+	expireBatchSize    = 50               // 每次清理的过期导出数量
+	exportReadmeFormat = `Course Platform 个人数据导出 / Personal data export
+
+导出时间 / Exported at: %s
+
+profile.json      账号资料 / Profile
+enrollments.json  选课记录 / Enrollments
+progress.json     学习进度 / Learning progress
+discussions.json  讨论帖和回复 / Discussion threads and replies
+uploads.json      上传文件清单 / Uploaded files
+uploads/          上传的文件 / Uploaded files
+`
+)
+
+// exportedEnrollment 导出的选课记录，附带课程标题
+type exportedEnrollment struct {
+	*courseModel.Enrollment
+	CourseTitle string `json:"course_title"`
+}
+
+// exportedDiscussions 导出的讨论内容
+type exportedDiscussions struct {
+	Threads []*discussionModel.Thread `json:"threads"`
+	Replies []*discussionModel.Reply  `json:"replies"`
+}
+
+// ProcessExports 打包等待中的数据导出
+func (s *PrivacyService) ProcessExports(ctx context.Context) {
+	if released, err := s.repo.ReleaseStaleExports(time.Now().Add(-staleExportAfter)); err != nil {
+		log.Printf("⚠️ 个人数据任务: %v", err)
+	} else if released > 0 {
+		log.Printf("⚠️ 个人数据任务: %d 个中断的导出已重新排队", released)
+	}
+
+	exports, err := s.repo.ClaimPendingExports(exportBatchSize)
+	if err != nil {
+		log.Printf("❌ 个人数据任务: %v", err)
+	}
+	for _, export := range exports {
+		if ctx.Err() != nil {
+			return
+		}
+		s.buildExport(ctx, export)
+	}
+}
+
+// buildExport 收集用户数据并打包，完成后邮件通知；失败时记录原因，用户可重新申请
+func (s *PrivacyService) buildExport(ctx context.Context, export *model.DataExport) {
+	now := time.Now()
+	entries, err := s.collectUserData(export.UserID, now)
+	var result *ArchiveResult
+	if err == nil {
+		fileName := fmt.Sprintf("course-platform-export-%d-%s.zip", export.UserID, now.Format("20060102"))
+		result, err = s.fileStore.BuildArchive(ctx, export.UserID, fileName, entries)
+	}
+	if err != nil {
+		log.Printf("❌ 数据导出失败 - 导出ID: %d, 用户ID: %d, 错误: %v", export.ID, export.UserID, err)
+		message := []rune(err.Error())
+		if len(message) > 500 {
+			message = message[:500]
+		}
+		export.Status = model.ExportStatusFailed
+		export.Error = string(message)
+		if err := s.repo.UpdateExport(export); err != nil {
+			log.Printf("❌ 个人数据任务: %v", err)
+		}
+		return
+	}
+
+	completedAt := time.Now()
+	expiresAt := completedAt.Add(s.exportTTL)
+	export.Status = model.ExportStatusReady
+	export.FileID = result.FileID
+	export.FileURL = result.FileURL
+	export.FileSize = result.FileSize
+	export.FileCount = result.FileCount
+	export.Error = ""
+	export.CompletedAt = &completedAt
+	export.ExpiresAt = &expiresAt
+	if err := s.repo.UpdateExport(export); err != nil {
+		log.Printf("❌ 个人数据任务: %v", err)
+		return
+	}
+	log.Printf("✅ 数据导出完成 - 导出ID: %d, 用户ID: %d, 大小: %d 字节", export.ID, export.UserID, export.FileSize)
+
+	if s.emailSvc != nil {
+		if err := s.emailSvc.SendToUser(export.UserID, emailModel.CategoryAccount, emailService.TemplateDataExportReady, map[string]interface{}{
+			"ExpiresAt":   expiresAt.Format("2006-01-02 15:04 MST"),
+			"PrivacyPath": privacyPath,
+		}); err != nil {
+			log.Printf("⚠️ 数据导出完成邮件发送失败 - 用户ID: %d, 错误: %v", export.UserID, err)
+		}
+	}
+}
+
+// collectUserData 收集账号资料、选课记录、学习进度和讨论内容，上传的文件由内容服务打包
+func (s *PrivacyService) collectUserData(userID uint, now time.Time) ([]ArchiveEntry, error) {
+	user, err := s.userRepo.GetByID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	enrollments, err := s.courseService.ListUserEnrollments(userID)
+	if err != nil {
+		return nil, err
+	}
+	titles := make(map[uint]string)
+	exportedEnrollments := make([]exportedEnrollment, 0, len(enrollments))
+	for _, enrollment := range enrollments {
+		title, ok := titles[enrollment.CourseID]
+		if !ok {
+			if course, err := s.courseService.GetCourseByID(enrollment.CourseID); err == nil {
+				title = course.Title
+			}
+			titles[enrollment.CourseID] = title
+		}
+		exportedEnrollments = append(exportedEnrollments, exportedEnrollment{Enrollment: enrollment, CourseTitle: title})
+	}
+
+	progress, err := s.courseService.ListUserProgress(userID)
+	if err != nil {
+		return nil, err
+	}
+	threads, replies, err := s.discussionService.ListUserPosts(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	files := []struct {
+		name string
+		data interface{}
+	}{
+		{"profile.json", user},
+		{"enrollments.json", exportedEnrollments},
+		{"progress.json", progress},
+		{"discussions.json", exportedDiscussions{Threads: threads, Replies: replies}},
+	}
+	entries := []ArchiveEntry{{Name: "README.txt", Data: []byte(fmt.Sprintf(exportReadmeFormat, now.Format(time.RFC3339)))}}
+	for _, file := range files {
+		data, err := json.MarshalIndent(file.data, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("生成 %s 失败: %w", file.name, err)
+		}
+		entries = append(entries, ArchiveEntry{Name: file.name, Data: data})
+	}
+	return entries, nil
+}
+
+// ExpireExports 删除过期的导出压缩包
+func (s *PrivacyService) ExpireExports(ctx context.Context) {
+	exports, err := s.repo.ListExpiredExports(time.Now(), expireBatchSize)
+	if err != nil {
+		log.Printf("❌ 个人数据任务: %v", err)
+		return
+	}
+	for _, export := range exports {
+		if ctx.Err() != nil {
+			return
+		}
+		if err := s.fileStore.DeleteArchive(ctx, export.FileID, export.UserID); err != nil {
+			log.Printf("⚠️ 删除过期导出失败 - 导出ID: %d, 错误: %v", export.ID, err)
+			continue
+		}
+		export.Status = model.ExportStatusExpired
+		export.FileURL = ""
+		if err := s.repo.UpdateExport(export); err != nil {
+			log.Printf("❌ 个人数据任务: %v", err)
+		}
+	}
+	if len(exports) > 0 {
+		log.Printf("✅ 已清理过期的数据导出 - 数量: %d", len(exports))
+	}
+}
@@ -0,0 +1,234 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	courseService "course-platform/internal/domain/course/service"
+	discussionService "course-platform/internal/domain/discussion/service"
+	emailModel "course-platform/internal/domain/email/model"
+	emailService "course-platform/internal/domain/email/service"
+	"course-platform/internal/domain/privacy/model"
+	"course-platform/internal/domain/privacy/repository"
+	userModel "course-platform/internal/domain/user/model"
+	userRepository "course-platform/internal/domain/user/repository"
+)
+
+// 默认配置和限制
+const (
+	defaultDeletionGraceDays = 14
+	defaultExportTTLDays     = 7
+	maxExportsPerDay         = 3  // 每个用户每24小时最多申请导出的次数
+	exportHistoryLimit       = 10 // 状态接口返回的导出记录数量
+	privacyPath              = "/dashboard#privacy"
+)
+
+// PrivacyServiceInterface 个人数据导出与账号注销服务接口
+type PrivacyServiceInterface interface {
+	GetStatus(userID uint) (*Status, error)
+	RequestDataExport(userID uint) (*model.DataExport, error)
+	GetDataExportURL(userID, exportID uint) (string, error)
+	RequestAccountDeletion(userID uint, confirmEmail, transferToEmail string) (*model.AccountDeletion, error)
+	CancelAccountDeletion(userID uint) error
+
+	// 后台任务
+	ProcessExports(ctx context.Context)
+	ExpireExports(ctx context.Context)
+	ProcessDeletions(ctx context.Context)
+}
+
+// Options 个人数据服务配置，为0时使用默认值
+type Options struct {
+	DeletionGraceDays int // 注销宽限期天数
+	ExportTTLDays     int // 导出压缩包保留天数
+}
+
+// Status 用户的导出记录和进行中的注销申请
+type Status struct {
+	Exports   []*model.DataExport
+	Deletion  *model.AccountDeletion // 没有进行中的注销申请时为 nil
+	GraceDays int
+}
+
+// PrivacyService 个人数据导出与账号注销服务实现
+type PrivacyService struct {
+	repo              repository.PrivacyRepositoryInterface
+	userRepo          userRepository.UserRepositoryInterface
+	courseService     courseService.CourseServiceInterface
+	discussionService discussionService.DiscussionServiceInterface
+	emailSvc          emailService.EmailServiceInterface
+	fileStore         UserFileStore
+	eraser            AccountEraser
+	graceDays         int
+	exportTTL         time.Duration
+}
+
+// NewPrivacyService 创建个人数据服务实例
+func NewPrivacyService(repo repository.PrivacyRepositoryInterface, userRepo userRepository.UserRepositoryInterface, courseService courseService.CourseServiceInterface, discussionService discussionService.DiscussionServiceInterface, emailSvc emailService.EmailServiceInterface, fileStore UserFileStore, eraser AccountEraser, options Options) PrivacyServiceInterface {
+	if options.DeletionGraceDays <= 0 {
+		options.DeletionGraceDays = defaultDeletionGraceDays
+	}
+	if options.ExportTTLDays <= 0 {
+		options.ExportTTLDays = defaultExportTTLDays
+	}
+	return &PrivacyService{
+		repo:              repo,
+		userRepo:          userRepo,
+		courseService:     courseService,
+		discussionService: discussionService,
+		emailSvc:          emailSvc,
+		fileStore:         fileStore,
+		eraser:            eraser,
+		graceDays:         options.DeletionGraceDays,
+		exportTTL:         time.Duration(options.ExportTTLDays) * 24 * time.Hour,
+	}
+}
+
+// GetStatus 获取用户最近的导出记录和进行中的注销申请
+func (s *PrivacyService) GetStatus(userID uint) (*Status, error) {
+	exports, err := s.repo.ListExports(userID, exportHistoryLimit)
+	if err != nil {
+		return nil, err
+	}
+	deletion, err := s.repo.GetActiveDeletion(userID)
+	if err != nil {
+		return nil, err
+	}
+	return &Status{Exports: exports, Deletion: deletion, GraceDays: s.graceDays}, nil
+}
+
+// RequestDataExport 申请导出个人数据，由后台任务异步打包，完成后邮件通知
+func (s *PrivacyService) RequestDataExport(userID uint) (*model.DataExport, error) {
+	log.Printf("🔍 Service: 申请导出个人数据 - 用户ID: %d", userID)
+
+	exports, err := s.repo.ListExports(userID, exportHistoryLimit)
+	if err != nil {
+		return nil, err
+	}
+	for _, export := range exports {
+		if export.IsInProgress() {
+			return nil, errors.New("已有正在处理的导出申请，请等待完成")
+		}
+	}
+	count, err := s.repo.CountExportsSince(userID, time.Now().Add(-24*time.Hour))
+	if err != nil {
+		return nil, err
+	}
+	if count >= maxExportsPerDay {
+		return nil, fmt.Errorf("24小时内最多申请 %d 次导出，请稍后再试", maxExportsPerDay)
+	}
+
+	export := &model.DataExport{
+		UserID: userID,
+		Status: model.ExportStatusPending,
+	}
+	if err := s.repo.CreateExport(export); err != nil {
+		return nil, err
+	}
+	log.Printf("✅ Service: 数据导出已排队 - 用户ID: %d, 导出ID: %d", userID, export.ID)
+	return export, nil
+}
+
+// GetDataExportURL 获取导出压缩包的下载地址，只有本人可以下载，过期后不可下载
+func (s *PrivacyService) GetDataExportURL(userID, exportID uint) (string, error) {
+	export, err := s.repo.GetExport(exportID)
+	if err != nil {
+		return "", err
+	}
+	if export.UserID != userID {
+		return "", errors.New("导出记录不存在")
+	}
+	if !export.IsDownloadable(time.Now()) {
+		if export.Status == model.ExportStatusReady || export.Status == model.ExportStatusExpired {
+			return "", errors.New("下载链接已过期，请重新申请导出")
+		}
+		return "", errors.New("导出尚未完成")
+	}
+	return export.FileURL, nil
+}
+
+// RequestAccountDeletion 申请注销账号，confirmEmail 必须与账号邮箱一致
+// transferToEmail 不为空时，课程在注销时转交给该用户（需已验证邮箱），否则下架归档
+func (s *PrivacyService) RequestAccountDeletion(userID uint, confirmEmail, transferToEmail string) (*model.AccountDeletion, error) {
+	log.Printf("🔍 Service: 申请注销账号 - 用户ID: %d", userID)
+
+	user, err := s.userRepo.GetByID(userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.Role == userModel.RoleAdmin {
+		return nil, errors.New("管理员账号不能自助注销，请联系其他管理员处理")
+	}
+	if !strings.EqualFold(strings.TrimSpace(confirmEmail), user.Email) {
+		return nil, errors.New("确认邮箱与账号邮箱不一致")
+	}
+
+	existing, err := s.repo.GetActiveDeletion(userID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, errors.New("已有进行中的注销申请")
+	}
+
+	deletion := &model.AccountDeletion{
+		UserID: userID,
+		Status: model.DeletionStatusScheduled,
+	}
+	if transferToEmail = strings.TrimSpace(transferToEmail); transferToEmail != "" {
+		recipient, err := s.userRepo.GetByEmail(strings.ToLower(transferToEmail))
+		if err != nil {
+			return nil, errors.New("课程接收人不存在")
+		}
+		if recipient.ID == userID {
+			return nil, errors.New("不能把课程转交给自己")
+		}
+		if recipient.EmailStatus != userModel.EmailStatusVerified {
+			return nil, errors.New("课程接收人尚未验证邮箱")
+		}
+		if pending, err := s.repo.GetActiveDeletion(recipient.ID); err != nil {
+			return nil, err
+		} else if pending != nil {
+			return nil, errors.New("课程接收人的账号正在注销中")
+		}
+		deletion.TransferToID = recipient.ID
+		deletion.TransferToEmail = recipient.Email
+	}
+
+	now := time.Now()
+	deletion.ScheduledFor = now.AddDate(0, 0, s.graceDays)
+	deletion.NextAttemptAt = deletion.ScheduledFor
+	if err := s.repo.CreateDeletion(deletion); err != nil {
+		return nil, err
+	}
+
+	if s.emailSvc != nil {
+		if err := s.emailSvc.SendToUser(userID, emailModel.CategoryAccount, emailService.TemplateAccountDeletionScheduled, map[string]interface{}{
+			"ScheduledFor": deletion.ScheduledFor.Format("2006-01-02 15:04 MST"),
+			"TransferTo":   deletion.TransferToEmail,
+			"PrivacyPath":  privacyPath,
+		}); err != nil {
+			log.Printf("⚠️ Service: 注销确认邮件发送失败 - 用户ID: %d, 错误: %v", userID, err)
+		}
+	}
+
+	log.Printf("✅ Service: 账号注销已安排 - 用户ID: %d, 执行时间: %s", userID, deletion.ScheduledFor.Format(time.RFC3339))
+	return deletion, nil
+}
+
+// CancelAccountDeletion 撤销宽限期中的注销申请
+func (s *PrivacyService) CancelAccountDeletion(userID uint) error {
+	cancelled, err := s.repo.CancelDeletion(userID, time.Now())
+	if err != nil {
+		return err
+	}
+	if !cancelled {
+		return errors.New("没有可撤销的注销申请，宽限期结束后注销无法撤销")
+	}
+	log.Printf("✅ Service: 已撤销账号注销 - 用户ID: %d", userID)
+	return nil
+}
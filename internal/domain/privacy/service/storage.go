@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	grpcClient "course-platform/internal/infrastructure/grpc_client"
+	"course-platform/internal/shared/pb/contentpb"
+)
+
+// ArchiveEntry 导出压缩包中的数据文件
+type ArchiveEntry struct {
+	Name string
+	Data []byte
+}
+
+// ArchiveResult 打包结果
+type ArchiveResult struct {
+	FileID    uint
+	FileURL   string
+	FileSize  int64
+	FileCount int // 打包的上传文件数量
+}
+
+// UserFileStore 用户文件存储，打包导出和注销清理都由内容服务完成
+type UserFileStore interface {
+	BuildArchive(ctx context.Context, userID uint, fileName string, entries []ArchiveEntry) (*ArchiveResult, error)
+	DeleteArchive(ctx context.Context, fileID, userID uint) error
+	PurgeUserFiles(ctx context.Context, userID uint, transferCourseIDs []uint, transferTo uint) error
+}
+
+// AccountEraser 清除账号个人信息，由用户服务完成
+type AccountEraser interface {
+	EraseAccount(userID uint) error
+}
+
+// contentFileStore 基于内容服务的用户文件存储
+type contentFileStore struct {
+	client *grpcClient.ContentGRPCClientService
+}
+
+// NewContentFileStore 创建基于内容服务的用户文件存储
+func NewContentFileStore(client *grpcClient.ContentGRPCClientService) UserFileStore {
+	return &contentFileStore{client: client}
+}
+
+// BuildArchive 把数据文件和用户上传的全部文件打包为ZIP
+func (s *contentFileStore) BuildArchive(ctx context.Context, userID uint, fileName string, entries []ArchiveEntry) (*ArchiveResult, error) {
+	pbEntries := make([]*contentpb.ArchiveEntry, len(entries))
+	for i, entry := range entries {
+		pbEntries[i] = &contentpb.ArchiveEntry{Name: entry.Name, Data: entry.Data}
+	}
+	resp, err := s.client.BuildUserArchive(ctx, &contentpb.BuildUserArchiveRequest{
+		UserId:   uint32(userID),
+		FileName: fileName,
+		Entries:  pbEntries,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Code != 200 || resp.FileInfo == nil {
+		return nil, errors.New(resp.Message)
+	}
+
+	fileID, err := strconv.ParseUint(resp.FileInfo.FileId, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("文件ID格式错误: %s", resp.FileInfo.FileId)
+	}
+	return &ArchiveResult{
+		FileID:    uint(fileID),
+		FileURL:   resp.FileInfo.FileUrl,
+		FileSize:  resp.FileInfo.FileSize,
+		FileCount: int(resp.FileCount),
+	}, nil
+}
+
+// DeleteArchive 删除过期的导出压缩包，文件已不存在时视为成功
+func (s *contentFileStore) DeleteArchive(ctx context.Context, fileID, userID uint) error {
+	resp, err := s.client.DeleteFile(ctx, &contentpb.DeleteFileRequest{
+		FileId: strconv.FormatUint(uint64(fileID), 10),
+		UserId: uint32(userID),
+	})
+	if err != nil {
+		return err
+	}
+	if resp.Code != 200 && resp.Code != 404 {
+		return errors.New(resp.Message)
+	}
+	return nil
+}
+
+// PurgeUserFiles 删除注销用户的文件，转交课程中的文件改为新上传者
+func (s *contentFileStore) PurgeUserFiles(ctx context.Context, userID uint, transferCourseIDs []uint, transferTo uint) error {
+	courseIDs := make([]uint32, len(transferCourseIDs))
+	for i, id := range transferCourseIDs {
+		courseIDs[i] = uint32(id)
+	}
+	resp, err := s.client.PurgeUserFiles(ctx, &contentpb.PurgeUserFilesRequest{
+		UserId:            uint32(userID),
+		TransferCourseIds: courseIDs,
+		TransferTo:        uint32(transferTo),
+	})
+	if err != nil {
+		return err
+	}
+	if resp.Code != 200 {
+		return errors.New(resp.Message)
+	}
+	return nil
+}
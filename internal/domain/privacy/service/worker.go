@@ -0,0 +1,38 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// workerInterval 后台任务轮询间隔
+const workerInterval = 30 * time.Second
+
+// Worker 个人数据后台任务，打包数据导出、清理过期导出并执行到期的账号注销
+type Worker struct {
+	service PrivacyServiceInterface
+}
+
+// NewWorker 创建个人数据后台任务
+func NewWorker(service PrivacyServiceInterface) *Worker {
+	return &Worker{service: service}
+}
+
+// Run 持续处理导出和注销，直到 ctx 结束
+func (w *Worker) Run(ctx context.Context) {
+	log.Printf("✅ 个人数据后台任务已启动")
+	ticker := time.NewTicker(workerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.service.ProcessExports(ctx)
+			w.service.ExpireExports(ctx)
+			w.service.ProcessDeletions(ctx)
+		}
+	}
+}
@@ -0,0 +1,419 @@
+package handler
+
+import (
+	"log"
+	"net/http"
+	"strconv"
+
+	service "course-platform/internal/infrastructure/grpc_client"
+	"course-platform/internal/shared/pb/quizpb"
+
+	"github.com/gin-gonic/gin"
+)
+
+// QuizHandler API Gateway的测验处理器
+type QuizHandler struct {
+	quizGRPCClient *service.QuizGRPCClientService
+}
+
+// NewQuizHandler 创建测验处理器
+func NewQuizHandler(quizGRPCClient *service.QuizGRPCClientService) *QuizHandler {
+	return &QuizHandler{
+		quizGRPCClient: quizGRPCClient,
+	}
+}
+
+// CreateQuestionRequest 创建题目请求结构
+// 选择题的答案为选项下标（从0开始），判断题为 true/false，填空题为可接受的答案列表
+type CreateQuestionRequest struct {
+	Type        string   `json:"type" binding:"required"`
+	Content     string   `json:"content" binding:"required"`
+	Options     []string `json:"options"`
+	Answers     []string `json:"answers" binding:"required"`
+	Explanation string   `json:"explanation"`
+	Score       uint32   `json:"score"`
+}
+
+// CreateQuizRequest 创建测验请求结构
+type CreateQuizRequest struct {
+	CourseID         uint32   `json:"course_id" binding:"required"`
+	ChapterID        uint32   `json:"chapter_id" binding:"required"`
+	Title            string   `json:"title" binding:"required"`
+	Description      string   `json:"description"`
+	TimeLimitSeconds uint32   `json:"time_limit_seconds"`
+	MaxAttempts      uint32   `json:"max_attempts"`
+	PassScore        uint32   `json:"pass_score"`
+	QuestionIDs      []uint32 `json:"question_ids" binding:"required"`
+}
+
+// SubmitAttemptRequest 提交答卷请求结构
+type SubmitAttemptRequest struct {
+	Answers []struct {
+		QuestionID uint32   `json:"question_id"`
+		Answers    []string `json:"answers"`
+	} `json:"answers"`
+}
+
+// CreateQuestion 向课程题库添加题目
+// @Summary 创建题目
+// @Description 课程讲师向题库添加单选、多选、判断或填空题
+// @Tags 测验管理
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path int true "课程ID"
+// @Param question body CreateQuestionRequest true "题目信息"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Router /api/v1/courses/{id}/questions [post]
+func (h *QuizHandler) CreateQuestion(c *gin.Context) {
+	courseID, ok := parseIDParam(c, "id", "课程ID参数无效")
+	if !ok {
+		return
+	}
+
+	var req CreateQuestionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    400,
+			"message": "请求参数无效: " + err.Error(),
+		})
+		return
+	}
+
+	resp, err := h.quizGRPCClient.CreateQuestion(c.Request.Context(), &quizpb.CreateQuestionRequest{
+		CourseId:    uint32(courseID),
+		UserId:      uint32(c.GetUint("userID")),
+		Type:        req.Type,
+		Content:     req.Content,
+		Options:     req.Options,
+		Answers:     req.Answers,
+		Explanation: req.Explanation,
+		Score:       req.Score,
+	})
+	if err != nil {
+		respondGRPCError(c, "创建题目失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data":    resp.Question,
+	})
+}
+
+// ListQuestions 获取课程题库（仅讲师）
+// @Summary 获取题库
+// @Description 获取课程题库，包含标准答案，仅课程讲师可访问
+// @Tags 测验管理
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path int true "课程ID"
+// @Success 200 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Router /api/v1/courses/{id}/questions [get]
+func (h *QuizHandler) ListQuestions(c *gin.Context) {
+	courseID, ok := parseIDParam(c, "id", "课程ID参数无效")
+	if !ok {
+		return
+	}
+
+	resp, err := h.quizGRPCClient.ListQuestions(c.Request.Context(), courseID, c.GetUint("userID"))
+	if err != nil {
+		respondGRPCError(c, "获取题库失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": "获取成功",
+		"data":    resp.Questions,
+	})
+}
+
+// CreateQuiz 创建测验
+// @Summary 创建测验
+// @Description 课程讲师从题库选题，为章节创建测验
+// @Tags 测验管理
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param quiz body CreateQuizRequest true "测验信息"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Router /api/v1/quizzes [post]
+func (h *QuizHandler) CreateQuiz(c *gin.Context) {
+	var req CreateQuizRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    400,
+			"message": "请求参数无效: " + err.Error(),
+		})
+		return
+	}
+
+	resp, err := h.quizGRPCClient.CreateQuiz(c.Request.Context(), &quizpb.CreateQuizRequest{
+		CourseId:         req.CourseID,
+		ChapterId:        req.ChapterID,
+		UserId:           uint32(c.GetUint("userID")),
+		Title:            req.Title,
+		Description:      req.Description,
+		TimeLimitSeconds: req.TimeLimitSeconds,
+		MaxAttempts:      req.MaxAttempts,
+		PassScore:        req.PassScore,
+		QuestionIds:      req.QuestionIDs,
+	})
+	if err != nil {
+		respondGRPCError(c, "创建测验失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	log.Printf("✅ API: 创建测验成功 - 测验ID: %d", resp.Quiz.Id)
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data":    resp.Quiz,
+	})
+}
+
+// ListQuizzes 获取测验列表
+// @Summary 获取测验列表
+// @Description 按课程或章节获取测验列表
+// @Tags 测验管理
+// @Produce json
+// @Param course_id query int false "课程ID"
+// @Param chapter_id query int false "章节ID"
+// @Success 200 {object} map[string]interface{}
+// @Router /api/v1/quizzes [get]
+func (h *QuizHandler) ListQuizzes(c *gin.Context) {
+	courseID, _ := strconv.ParseUint(c.Query("course_id"), 10, 32)
+	chapterID, _ := strconv.ParseUint(c.Query("chapter_id"), 10, 32)
+
+	resp, err := h.quizGRPCClient.ListQuizzes(c.Request.Context(), uint(courseID), uint(chapterID))
+	if err != nil {
+		respondGRPCError(c, "获取测验列表失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": "获取成功",
+		"data":    resp.Quizzes,
+	})
+}
+
+// GetQuiz 获取测验详情
+// @Summary 获取测验详情
+// @Description 获取测验及题目，需已报名且所属章节已开放（讲师除外）；标准答案仅对课程讲师可见
+// @Tags 测验管理
+// @Produce json
+// @Param id path int true "测验ID"
+// @Success 200 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Router /api/v1/quizzes/{id} [get]
+func (h *QuizHandler) GetQuiz(c *gin.Context) {
+	quizID, ok := parseIDParam(c, "id", "测验ID参数无效")
+	if !ok {
+		return
+	}
+
+	resp, err := h.quizGRPCClient.GetQuiz(c.Request.Context(), quizID, c.GetUint("userID"))
+	if err != nil {
+		respondGRPCError(c, "获取测验失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": "获取成功",
+		"data":    resp.Quiz,
+	})
+}
+
+// StartAttempt 开始答题
+// @Summary 开始答题
+// @Description 开始一次限时答题，若存在未完成的答题记录则继续该记录
+// @Tags 测验管理
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path int true "测验ID"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Router /api/v1/quizzes/{id}/attempts [post]
+func (h *QuizHandler) StartAttempt(c *gin.Context) {
+	quizID, ok := parseIDParam(c, "id", "测验ID参数无效")
+	if !ok {
+		return
+	}
+
+	resp, err := h.quizGRPCClient.StartAttempt(c.Request.Context(), quizID, c.GetUint("userID"))
+	if err != nil {
+		respondGRPCError(c, "开始答题失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data": gin.H{
+			"attempt": resp.Attempt,
+			"quiz":    resp.Quiz,
+		},
+	})
+}
+
+// SubmitAttempt 提交答卷
+// @Summary 提交答卷
+// @Description 提交答卷并由服务端自动评分，超时提交记为0分
+// @Tags 测验管理
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param attempt_id path int true "答题记录ID"
+// @Param answers body SubmitAttemptRequest true "答案"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Router /api/v1/quizzes/attempts/{attempt_id}/submit [post]
+func (h *QuizHandler) SubmitAttempt(c *gin.Context) {
+	attemptID, ok := parseIDParam(c, "attempt_id", "答题记录ID参数无效")
+	if !ok {
+		return
+	}
+
+	var req SubmitAttemptRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    400,
+			"message": "请求参数无效: " + err.Error(),
+		})
+		return
+	}
+
+	answers := make([]*quizpb.QuestionAnswer, len(req.Answers))
+	for i, a := range req.Answers {
+		answers[i] = &quizpb.QuestionAnswer{
+			QuestionId: a.QuestionID,
+			Answers:    a.Answers,
+		}
+	}
+
+	resp, err := h.quizGRPCClient.SubmitAttempt(c.Request.Context(), &quizpb.SubmitAttemptRequest{
+		AttemptId: uint32(attemptID),
+		UserId:    uint32(c.GetUint("userID")),
+		Answers:   answers,
+	})
+	if err != nil {
+		respondGRPCError(c, "提交答卷失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data":    resp.Attempt,
+	})
+}
+
+// ListAttempts 获取答题历史
+// @Summary 获取答题历史
+// @Description 学员查看自己的答题历史；讲师可通过 student_id 查看指定学员的记录
+// @Tags 测验管理
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path int true "测验ID"
+// @Param student_id query int false "学员ID（仅讲师）"
+// @Success 200 {object} map[string]interface{}
+// @Router /api/v1/quizzes/{id}/attempts [get]
+func (h *QuizHandler) ListAttempts(c *gin.Context) {
+	quizID, ok := parseIDParam(c, "id", "测验ID参数无效")
+	if !ok {
+		return
+	}
+	studentID, _ := strconv.ParseUint(c.Query("student_id"), 10, 32)
+
+	resp, err := h.quizGRPCClient.ListAttempts(c.Request.Context(), quizID, c.GetUint("userID"), uint(studentID))
+	if err != nil {
+		respondGRPCError(c, "获取答题历史失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": "获取成功",
+		"data":    resp.Attempts,
+	})
+}
+
+// parseIDParam 解析路径中的ID参数，失败时直接返回400
+func parseIDParam(c *gin.Context, name, message string) (uint, bool) {
+	id, err := strconv.ParseUint(c.Param(name), 10, 32)
+	if err != nil || id == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    400,
+			"message": message,
+		})
+		return 0, false
+	}
+	return uint(id), true
+}
+
+// respondGRPCError 返回调用微服务失败的响应
+func respondGRPCError(c *gin.Context, action string, err error) {
+	log.Printf("❌ API: %s - %v", action, err)
+	c.JSON(http.StatusInternalServerError, gin.H{
+		"code":    500,
+		"message": action + ": " + err.Error(),
+	})
+}
+
+// respondBusinessError 按业务码返回对应HTTP状态
+func respondBusinessError(c *gin.Context, code int32, message string) {
+	status := http.StatusBadRequest
+	switch code {
+	case 403:
+		status = http.StatusForbidden
+	case 404:
+		status = http.StatusNotFound
+	}
+	c.JSON(status, gin.H{
+		"code":    code,
+		"message": message,
+	})
+}
@@ -0,0 +1,136 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// 题目类型
+const (
+	QuestionTypeSingleChoice   = "single_choice"   // 单选题
+	QuestionTypeMultipleChoice = "multiple_choice" // 多选题
+	QuestionTypeTrueFalse      = "true_false"      // 判断题
+	QuestionTypeFillIn         = "fill_in"         // 填空题
+)
+
+// 答题状态
+const (
+	AttemptStatusInProgress = "in_progress" // 答题中
+	AttemptStatusSubmitted  = "submitted"   // 已提交并判分
+	AttemptStatusExpired    = "expired"     // 超时未提交
+)
+
+// Question 题库中的题目
+// 选择题的答案保存为选项下标（从0开始），判断题为 "true"/"false"，填空题为可接受答案列表
+type Question struct {
+	ID        uint           `gorm:"primarykey" json:"id"` // 主键ID
+	CreatedAt time.Time      `json:"created_at"`           // 创建时间
+	UpdatedAt time.Time      `json:"updated_at"`           // 更新时间
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`       // 软删除时间
+
+	CourseID    uint     `gorm:"not null;index" json:"course_id"`          // 所属课程题库
+	CreatorID   uint     `gorm:"not null" json:"creator_id"`               // 出题人ID
+	Type        string   `gorm:"size:20;not null" json:"type"`             // 题目类型
+	Content     string   `gorm:"type:text;not null" json:"content"`        // 题干
+	Options     []string `gorm:"type:text;serializer:json" json:"options"` // 选项（选择题）
+	Answers     []string `gorm:"type:text;serializer:json" json:"answers"` // 标准答案
+	Explanation string   `gorm:"type:text" json:"explanation"`             // 答案解析
+	Score       int      `gorm:"not null;default:1" json:"score"`          // 分值
+}
+
+// TableName 指定表名
+func (Question) TableName() string {
+	return "quiz_questions"
+}
+
+// Quiz 测验，挂载在课程章节上
+type Quiz struct {
+	ID        uint           `gorm:"primarykey" json:"id"` // 主键ID
+	CreatedAt time.Time      `json:"created_at"`           // 创建时间
+	UpdatedAt time.Time      `json:"updated_at"`           // 更新时间
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`       // 软删除时间
+
+	CourseID         uint   `gorm:"not null;index" json:"course_id"`              // 所属课程ID
+	ChapterID        uint   `gorm:"not null;index" json:"chapter_id"`             // 所属章节ID
+	CreatorID        uint   `gorm:"not null" json:"creator_id"`                   // 创建者ID
+	Title            string `gorm:"not null;size:200" json:"title"`               // 测验标题
+	Description      string `gorm:"type:text" json:"description"`                 // 测验说明
+	TimeLimitSeconds int    `gorm:"not null;default:0" json:"time_limit_seconds"` // 答题时限（秒），0表示不限时
+	MaxAttempts      int    `gorm:"not null;default:0" json:"max_attempts"`       // 最大答题次数，0表示不限
+	PassScore        int    `gorm:"not null;default:60" json:"pass_score"`        // 及格线（得分百分比）
+
+	Items []QuizItem `gorm:"foreignKey:QuizID" json:"items"` // 测验题目
+}
+
+// TableName 指定表名
+func (Quiz) TableName() string {
+	return "quizzes"
+}
+
+// TotalScore 计算测验总分
+func (q *Quiz) TotalScore() int {
+	total := 0
+	for _, item := range q.Items {
+		total += item.Question.Score
+	}
+	return total
+}
+
+// QuizItem 测验与题目的关联（保留题目顺序）
+type QuizItem struct {
+	ID         uint     `gorm:"primarykey" json:"id"`
+	QuizID     uint     `gorm:"not null;index" json:"quiz_id"`         // 测验ID
+	QuestionID uint     `gorm:"not null" json:"question_id"`           // 题目ID
+	SortOrder  int      `gorm:"not null;default:0" json:"sort_order"`  // 题目顺序
+	Question   Question `gorm:"foreignKey:QuestionID" json:"question"` // 关联题目
+}
+
+// TableName 指定表名
+func (QuizItem) TableName() string {
+	return "quiz_items"
+}
+
+// QuizAttempt 学员的一次答题记录
+type QuizAttempt struct {
+	ID        uint      `gorm:"primarykey" json:"id"` // 主键ID
+	CreatedAt time.Time `json:"created_at"`           // 创建时间
+	UpdatedAt time.Time `json:"updated_at"`           // 更新时间
+
+	QuizID      uint       `gorm:"not null;index:idx_attempt_quiz_user" json:"quiz_id"` // 测验ID
+	UserID      uint       `gorm:"not null;index:idx_attempt_quiz_user" json:"user_id"` // 学员ID
+	Status      string     `gorm:"size:20;not null" json:"status"`                      // 答题状态
+	Score       int        `gorm:"not null;default:0" json:"score"`                     // 得分
+	MaxScore    int        `gorm:"not null;default:0" json:"max_score"`                 // 满分
+	Passed      bool       `gorm:"not null;default:false" json:"passed"`                // 是否及格
+	StartedAt   time.Time  `gorm:"not null" json:"started_at"`                          // 开始时间
+	ExpiresAt   *time.Time `json:"expires_at"`                                          // 截止时间，不限时为空
+	SubmittedAt *time.Time `json:"submitted_at"`                                        // 提交时间
+
+	Answers []AttemptAnswer `gorm:"foreignKey:AttemptID" json:"answers"` // 作答明细
+}
+
+// TableName 指定表名
+func (QuizAttempt) TableName() string {
+	return "quiz_attempts"
+}
+
+// IsExpired 检查答题是否已超过时限（含宽限时间）
+func (a *QuizAttempt) IsExpired(now time.Time, grace time.Duration) bool {
+	return a.ExpiresAt != nil && now.After(a.ExpiresAt.Add(grace))
+}
+
+// AttemptAnswer 单题作答与判分结果
+type AttemptAnswer struct {
+	ID         uint     `gorm:"primarykey" json:"id"`
+	AttemptID  uint     `gorm:"not null;index" json:"attempt_id"`         // 答题记录ID
+	QuestionID uint     `gorm:"not null" json:"question_id"`              // 题目ID
+	Answers    []string `gorm:"type:text;serializer:json" json:"answers"` // 学员答案
+	Correct    bool     `gorm:"not null;default:false" json:"correct"`    // 是否正确
+	Score      int      `gorm:"not null;default:0" json:"score"`          // 得分
+}
+
+// TableName 指定表名
+func (AttemptAnswer) TableName() string {
+	return "quiz_attempt_answers"
+}
@@ -0,0 +1,217 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"course-platform/internal/domain/quiz/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// QuizRepositoryInterface 测验仓储接口
+type QuizRepositoryInterface interface {
+	CreateQuestion(question *model.Question) error
+	GetQuestionsByIDs(ids []uint) ([]*model.Question, error)
+	GetQuestionsByCourse(courseID uint) ([]*model.Question, error)
+	CreateQuiz(quiz *model.Quiz) error
+	GetQuizByID(id uint) (*model.Quiz, error)
+	ListQuizzes(courseID, chapterID uint) ([]*model.Quiz, error)
+	CreateAttempt(attempt *model.QuizAttempt) error
+	GetAttemptByID(id uint) (*model.QuizAttempt, error)
+	GetInProgressAttempt(quizID, userID uint) (*model.QuizAttempt, error)
+	CountAttempts(quizID, userID uint) (int64, error)
+	ListAttempts(quizID, userID uint) ([]*model.QuizAttempt, error)
+	SaveAttemptResult(attempt *model.QuizAttempt) error
+}
+
+// QuizRepository 测验仓储实现
+type QuizRepository struct {
+	db *gorm.DB
+}
+
+// NewQuizRepository 创建测验仓储实例
+func NewQuizRepository(db *gorm.DB) QuizRepositoryInterface {
+	return &QuizRepository{db: db}
+}
+
+// CreateQuestion 创建题目
+func (r *QuizRepository) CreateQuestion(question *model.Question) error {
+	if err := r.db.Create(question).Error; err != nil {
+		log.Printf("❌ Repository: 创建题目失败 - %v", err)
+		return fmt.Errorf("创建题目失败: %w", err)
+	}
+
+	log.Printf("✅ Repository: 题目创建成功 - ID: %d", question.ID)
+	return nil
+}
+
+// GetQuestionsByIDs 批量获取题目
+func (r *QuizRepository) GetQuestionsByIDs(ids []uint) ([]*model.Question, error) {
+	var questions []*model.Question
+	if err := r.db.Where("id IN ?", ids).Find(&questions).Error; err != nil {
+		return nil, fmt.Errorf("查询题目失败: %w", err)
+	}
+	return questions, nil
+}
+
+// GetQuestionsByCourse 获取课程题库
+func (r *QuizRepository) GetQuestionsByCourse(courseID uint) ([]*model.Question, error) {
+	var questions []*model.Question
+	if err := r.db.Where("course_id = ?", courseID).Order("id ASC").Find(&questions).Error; err != nil {
+		log.Printf("❌ Repository: 查询题库失败 - %v", err)
+		return nil, fmt.Errorf("查询题库失败: %w", err)
+	}
+	return questions, nil
+}
+
+// CreateQuiz 创建测验及其题目关联
+func (r *QuizRepository) CreateQuiz(quiz *model.Quiz) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Items").Create(quiz).Error; err != nil {
+			return err
+		}
+		if len(quiz.Items) == 0 {
+			return nil
+		}
+
+		// 题目已存在，只创建关联记录
+		for i := range quiz.Items {
+			quiz.Items[i].QuizID = quiz.ID
+		}
+		return tx.Omit(clause.Associations).Create(&quiz.Items).Error
+	})
+	if err != nil {
+		log.Printf("❌ Repository: 创建测验失败 - %v", err)
+		return fmt.Errorf("创建测验失败: %w", err)
+	}
+
+	log.Printf("✅ Repository: 测验创建成功 - ID: %d", quiz.ID)
+	return nil
+}
+
+// GetQuizByID 获取测验（包含题目）
+func (r *QuizRepository) GetQuizByID(id uint) (*model.Quiz, error) {
+	var quiz model.Quiz
+	err := r.db.Preload("Items", func(db *gorm.DB) *gorm.DB {
+		return db.Order("sort_order ASC, id ASC")
+	}).Preload("Items.Question").First(&quiz, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("测验不存在")
+		}
+		return nil, fmt.Errorf("查询测验失败: %w", err)
+	}
+	return &quiz, nil
+}
+
+// ListQuizzes 获取课程或章节下的测验
+func (r *QuizRepository) ListQuizzes(courseID, chapterID uint) ([]*model.Quiz, error) {
+	query := r.db.Model(&model.Quiz{})
+	if courseID != 0 {
+		query = query.Where("course_id = ?", courseID)
+	}
+	if chapterID != 0 {
+		query = query.Where("chapter_id = ?", chapterID)
+	}
+
+	var quizzes []*model.Quiz
+	err := query.Preload("Items").Preload("Items.Question").
+		Order("chapter_id ASC, id ASC").Find(&quizzes).Error
+	if err != nil {
+		log.Printf("❌ Repository: 查询测验列表失败 - %v", err)
+		return nil, fmt.Errorf("查询测验列表失败: %w", err)
+	}
+	return quizzes, nil
+}
+
+// CreateAttempt 创建答题记录
+func (r *QuizRepository) CreateAttempt(attempt *model.QuizAttempt) error {
+	if err := r.db.Create(attempt).Error; err != nil {
+		log.Printf("❌ Repository: 创建答题记录失败 - %v", err)
+		return fmt.Errorf("创建答题记录失败: %w", err)
+	}
+	return nil
+}
+
+// GetAttemptByID 获取答题记录（包含作答明细）
+func (r *QuizRepository) GetAttemptByID(id uint) (*model.QuizAttempt, error) {
+	var attempt model.QuizAttempt
+	if err := r.db.Preload("Answers").First(&attempt, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("答题记录不存在")
+		}
+		return nil, fmt.Errorf("查询答题记录失败: %w", err)
+	}
+	return &attempt, nil
+}
+
+// GetInProgressAttempt 获取学员未提交的答题记录
+func (r *QuizRepository) GetInProgressAttempt(quizID, userID uint) (*model.QuizAttempt, error) {
+	var attempt model.QuizAttempt
+	err := r.db.Where("quiz_id = ? AND user_id = ? AND status = ?", quizID, userID, model.AttemptStatusInProgress).
+		Order("id DESC").First(&attempt).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("查询答题记录失败: %w", err)
+	}
+	return &attempt, nil
+}
+
+// CountAttempts 统计学员的答题次数
+func (r *QuizRepository) CountAttempts(quizID, userID uint) (int64, error) {
+	var count int64
+	if err := r.db.Model(&model.QuizAttempt{}).
+		Where("quiz_id = ? AND user_id = ?", quizID, userID).Count(&count).Error; err != nil {
+		return 0, fmt.Errorf("统计答题次数失败: %w", err)
+	}
+	return count, nil
+}
+
+// ListAttempts 获取学员的答题历史（新记录在前）
+func (r *QuizRepository) ListAttempts(quizID, userID uint) ([]*model.QuizAttempt, error) {
+	var attempts []*model.QuizAttempt
+	if err := r.db.Preload("Answers").
+		Where("quiz_id = ? AND user_id = ?", quizID, userID).
+		Order("id DESC").Find(&attempts).Error; err != nil {
+		log.Printf("❌ Repository: 查询答题历史失败 - %v", err)
+		return nil, fmt.Errorf("查询答题历史失败: %w", err)
+	}
+	return attempts, nil
+}
+
+// SaveAttemptResult 保存判分结果
+// 仅当记录仍处于答题中时更新，防止重复提交
+func (r *QuizRepository) SaveAttemptResult(attempt *model.QuizAttempt) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&model.QuizAttempt{}).
+			Where("id = ? AND status = ?", attempt.ID, model.AttemptStatusInProgress).
+			Updates(map[string]interface{}{
+				"status":       attempt.Status,
+				"score":        attempt.Score,
+				"max_score":    attempt.MaxScore,
+				"passed":       attempt.Passed,
+				"submitted_at": attempt.SubmittedAt,
+			})
+		if result.Error != nil {
+			return fmt.Errorf("保存判分结果失败: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return errors.New("答卷已提交")
+		}
+
+		for i := range attempt.Answers {
+			attempt.Answers[i].AttemptID = attempt.ID
+		}
+		if len(attempt.Answers) > 0 {
+			if err := tx.Create(&attempt.Answers).Error; err != nil {
+				return fmt.Errorf("保存作答明细失败: %w", err)
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,146 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"course-platform/internal/domain/quiz/model"
+)
+
+// validateQuestion 校验题目的选项与标准答案，并规范化答案格式
+func validateQuestion(q *model.Question) error {
+	if strings.TrimSpace(q.Content) == "" {
+		return errors.New("题干不能为空")
+	}
+	if q.Score <= 0 {
+		return errors.New("题目分值必须大于0")
+	}
+
+	switch q.Type {
+	case model.QuestionTypeSingleChoice, model.QuestionTypeMultipleChoice:
+		if len(q.Options) < 2 {
+			return errors.New("选择题至少需要两个选项")
+		}
+		answers, err := normalizeChoices(q.Answers, len(q.Options))
+		if err != nil {
+			return err
+		}
+		if len(answers) == 0 {
+			return errors.New("请设置标准答案")
+		}
+		if q.Type == model.QuestionTypeSingleChoice && len(answers) != 1 {
+			return errors.New("单选题只能有一个正确答案")
+		}
+		q.Answers = answers
+	case model.QuestionTypeTrueFalse:
+		if len(q.Answers) != 1 {
+			return errors.New("判断题需要一个标准答案")
+		}
+		answer, ok := normalizeBool(q.Answers[0])
+		if !ok {
+			return errors.New("判断题答案必须为 true 或 false")
+		}
+		q.Options = []string{"true", "false"}
+		q.Answers = []string{answer}
+	case model.QuestionTypeFillIn:
+		var answers []string
+		for _, a := range q.Answers {
+			if strings.TrimSpace(a) != "" {
+				answers = append(answers, strings.TrimSpace(a))
+			}
+		}
+		if len(answers) == 0 {
+			return errors.New("填空题至少需要一个可接受的答案")
+		}
+		q.Options = nil
+		q.Answers = answers
+	default:
+		return fmt.Errorf("不支持的题目类型: %s", q.Type)
+	}
+	return nil
+}
+
+// gradeQuestion 判分，返回是否正确及得分（全对得分，否则0分）
+func gradeQuestion(q *model.Question, answers []string) (bool, int) {
+	var correct bool
+	switch q.Type {
+	case model.QuestionTypeSingleChoice, model.QuestionTypeMultipleChoice:
+		given, err := normalizeChoices(answers, len(q.Options))
+		correct = err == nil && equalStrings(given, q.Answers)
+	case model.QuestionTypeTrueFalse:
+		if len(answers) == 1 {
+			given, ok := normalizeBool(answers[0])
+			correct = ok && len(q.Answers) == 1 && given == q.Answers[0]
+		}
+	case model.QuestionTypeFillIn:
+		if len(answers) == 1 {
+			given := normalizeText(answers[0])
+			for _, accepted := range q.Answers {
+				if given != "" && given == normalizeText(accepted) {
+					correct = true
+					break
+				}
+			}
+		}
+	}
+
+	if correct {
+		return true, q.Score
+	}
+	return false, 0
+}
+
+// normalizeChoices 将选项下标去重排序，校验下标范围
+func normalizeChoices(answers []string, optionCount int) ([]string, error) {
+	seen := make(map[int]bool)
+	var indexes []int
+	for _, a := range answers {
+		idx, err := strconv.Atoi(strings.TrimSpace(a))
+		if err != nil || idx < 0 || idx >= optionCount {
+			return nil, fmt.Errorf("无效的选项: %s", a)
+		}
+		if !seen[idx] {
+			seen[idx] = true
+			indexes = append(indexes, idx)
+		}
+	}
+	sort.Ints(indexes)
+
+	result := make([]string, len(indexes))
+	for i, idx := range indexes {
+		result[i] = strconv.Itoa(idx)
+	}
+	return result, nil
+}
+
+// normalizeBool 规范化判断题答案
+func normalizeBool(answer string) (string, bool) {
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "true", "t", "1", "对", "正确":
+		return "true", true
+	case "false", "f", "0", "错", "错误":
+		return "false", true
+	}
+	return "", false
+}
+
+// normalizeText 规范化填空题答案：去除首尾空白、合并连续空白、忽略大小写
+func normalizeText(answer string) string {
+	return strings.ToLower(strings.Join(strings.Fields(answer), " "))
+}
+
+// equalStrings 比较两个字符串切片是否完全一致
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,151 @@
+package service
+
+import (
+	"testing"
+
+	"course-platform/internal/domain/quiz/model"
+)
+
+func TestGradeQuestion(t *testing.T) {
+	single := &model.Question{Type: model.QuestionTypeSingleChoice, Score: 2, Options: []string{"A", "B", "C"}, Answers: []string{"1"}}
+	multiple := &model.Question{Type: model.QuestionTypeMultipleChoice, Score: 3, Options: []string{"A", "B", "C", "D"}, Answers: []string{"0", "2"}}
+	trueFalse := &model.Question{Type: model.QuestionTypeTrueFalse, Score: 1, Options: []string{"true", "false"}, Answers: []string{"false"}}
+	fillIn := &model.Question{Type: model.QuestionTypeFillIn, Score: 5, Answers: []string{"Go Routine", "协程"}}
+
+	tests := []struct {
+		name      string
+		question  *model.Question
+		answers   []string
+		wantOK    bool
+		wantScore int
+	}{
+		{"单选正确", single, []string{"1"}, true, 2},
+		{"单选带空白", single, []string{" 1 "}, true, 2},
+		{"单选错误", single, []string{"0"}, false, 0},
+		{"单选越界", single, []string{"3"}, false, 0},
+		{"单选未作答", single, nil, false, 0},
+		{"多选正确", multiple, []string{"0", "2"}, true, 3},
+		{"多选顺序和重复不影响", multiple, []string{"2", "0", "2"}, true, 3},
+		{"多选少选", multiple, []string{"0"}, false, 0},
+		{"多选多选", multiple, []string{"0", "1", "2"}, false, 0},
+		{"多选非数字", multiple, []string{"A"}, false, 0},
+		{"判断正确", trueFalse, []string{"false"}, true, 1},
+		{"判断中文答案", trueFalse, []string{"错"}, true, 1},
+		{"判断错误", trueFalse, []string{"true"}, false, 0},
+		{"判断无效答案", trueFalse, []string{"maybe"}, false, 0},
+		{"判断多个答案", trueFalse, []string{"false", "true"}, false, 0},
+		{"填空正确", fillIn, []string{"go routine"}, true, 5},
+		{"填空合并空白", fillIn, []string{"  Go   routine "}, true, 5},
+		{"填空备选答案", fillIn, []string{"协程"}, true, 5},
+		{"填空错误", fillIn, []string{"thread"}, false, 0},
+		{"填空空白答案", fillIn, []string{"  "}, false, 0},
+		{"填空多个答案", fillIn, []string{"协程", "协程"}, false, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ok, score := gradeQuestion(tt.question, tt.answers)
+			if ok != tt.wantOK || score != tt.wantScore {
+				t.Errorf("gradeQuestion(%v) = (%v, %d), want (%v, %d)", tt.answers, ok, score, tt.wantOK, tt.wantScore)
+			}
+		})
+	}
+}
+
+func TestValidateQuestion(t *testing.T) {
+	tests := []struct {
+		name        string
+		question    model.Question
+		wantErr     bool
+		wantOptions []string
+		wantAnswers []string
+	}{
+		{
+			name:        "单选题",
+			question:    model.Question{Type: model.QuestionTypeSingleChoice, Content: "题干", Score: 1, Options: []string{"A", "B"}, Answers: []string{" 1"}},
+			wantOptions: []string{"A", "B"},
+			wantAnswers: []string{"1"},
+		},
+		{
+			name:        "多选题答案去重排序",
+			question:    model.Question{Type: model.QuestionTypeMultipleChoice, Content: "题干", Score: 1, Options: []string{"A", "B", "C"}, Answers: []string{"2", "0", "2"}},
+			wantOptions: []string{"A", "B", "C"},
+			wantAnswers: []string{"0", "2"},
+		},
+		{
+			name:        "判断题规范化",
+			question:    model.Question{Type: model.QuestionTypeTrueFalse, Content: "题干", Score: 1, Answers: []string{"正确"}},
+			wantOptions: []string{"true", "false"},
+			wantAnswers: []string{"true"},
+		},
+		{
+			name:        "填空题去除空答案",
+			question:    model.Question{Type: model.QuestionTypeFillIn, Content: "题干", Score: 1, Options: []string{"x"}, Answers: []string{" a ", "", "b"}},
+			wantAnswers: []string{"a", "b"},
+		},
+		{
+			name:     "题干为空",
+			question: model.Question{Type: model.QuestionTypeSingleChoice, Content: " ", Score: 1, Options: []string{"A", "B"}, Answers: []string{"0"}},
+			wantErr:  true,
+		},
+		{
+			name:     "分值为0",
+			question: model.Question{Type: model.QuestionTypeSingleChoice, Content: "题干", Options: []string{"A", "B"}, Answers: []string{"0"}},
+			wantErr:  true,
+		},
+		{
+			name:     "选项不足",
+			question: model.Question{Type: model.QuestionTypeSingleChoice, Content: "题干", Score: 1, Options: []string{"A"}, Answers: []string{"0"}},
+			wantErr:  true,
+		},
+		{
+			name:     "单选题多个答案",
+			question: model.Question{Type: model.QuestionTypeSingleChoice, Content: "题干", Score: 1, Options: []string{"A", "B"}, Answers: []string{"0", "1"}},
+			wantErr:  true,
+		},
+		{
+			name:     "答案越界",
+			question: model.Question{Type: model.QuestionTypeMultipleChoice, Content: "题干", Score: 1, Options: []string{"A", "B"}, Answers: []string{"2"}},
+			wantErr:  true,
+		},
+		{
+			name:     "选择题无答案",
+			question: model.Question{Type: model.QuestionTypeMultipleChoice, Content: "题干", Score: 1, Options: []string{"A", "B"}},
+			wantErr:  true,
+		},
+		{
+			name:     "判断题无效答案",
+			question: model.Question{Type: model.QuestionTypeTrueFalse, Content: "题干", Score: 1, Answers: []string{"也许"}},
+			wantErr:  true,
+		},
+		{
+			name:     "填空题无答案",
+			question: model.Question{Type: model.QuestionTypeFillIn, Content: "题干", Score: 1, Answers: []string{" "}},
+			wantErr:  true,
+		},
+		{
+			name:     "未知题型",
+			question: model.Question{Type: "essay", Content: "题干", Score: 1},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := tt.question
+			err := validateQuestion(&q)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("validateQuestion() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !equalStrings(q.Options, tt.wantOptions) {
+				t.Errorf("Options = %v, want %v", q.Options, tt.wantOptions)
+			}
+			if !equalStrings(q.Answers, tt.wantAnswers) {
+				t.Errorf("Answers = %v, want %v", q.Answers, tt.wantAnswers)
+			}
+		})
+	}
+}
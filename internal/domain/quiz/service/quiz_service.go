@@ -0,0 +1,373 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	courseService "course-platform/internal/domain/course/service"
+	"course-platform/internal/domain/quiz/model"
+	"course-platform/internal/domain/quiz/repository"
+)
+
+// submitGracePeriod 限时测验提交的宽限时间，容忍网络延迟
+const submitGracePeriod = 30 * time.Second
+
+// QuizServiceInterface 测验服务接口
+type QuizServiceInterface interface {
+	CreateQuestion(question *model.Question) (*model.Question, error)
+	ListQuestions(courseID, userID uint) ([]*model.Question, error)
+	CreateQuiz(req *CreateQuizRequest) (*model.Quiz, error)
+	GetQuiz(quizID, userID uint) (*model.Quiz, bool, error)
+	ListQuizzes(courseID, chapterID uint) ([]*model.Quiz, error)
+	StartAttempt(quizID, userID uint) (*model.QuizAttempt, *model.Quiz, error)
+	SubmitAttempt(attemptID, userID uint, answers map[uint][]string) (*model.QuizAttempt, *model.Quiz, error)
+	ListAttempts(quizID, userID, studentID uint) ([]*model.QuizAttempt, *model.Quiz, error)
+}
+
+// CreateQuizRequest 创建测验请求
+type CreateQuizRequest struct {
+	CourseID         uint
+	ChapterID        uint
+	UserID           uint
+	Title            string
+	Description      string
+	TimeLimitSeconds int
+	MaxAttempts      int
+	PassScore        int
+	QuestionIDs      []uint
+}
+
+// QuizService 测验服务实现
+type QuizService struct {
+	quizRepo      repository.QuizRepositoryInterface
+	courseService courseService.CourseServiceInterface
+}
+
+// NewQuizService 创建测验服务实例
+func NewQuizService(quizRepo repository.QuizRepositoryInterface, courseService courseService.CourseServiceInterface) QuizServiceInterface {
+	return &QuizService{
+		quizRepo:      quizRepo,
+		courseService: courseService,
+	}
+}
+
+// CreateQuestion 向课程题库添加题目（仅课程讲师）
+func (s *QuizService) CreateQuestion(question *model.Question) (*model.Question, error) {
+	log.Printf("🔍 Service: 创建题目 - 课程ID: %d, 类型: %s", question.CourseID, question.Type)
+
+	if err := s.checkInstructor(question.CourseID, question.CreatorID); err != nil {
+		return nil, err
+	}
+	if question.Score == 0 {
+		question.Score = 1
+	}
+	if err := validateQuestion(question); err != nil {
+		return nil, err
+	}
+
+	if err := s.quizRepo.CreateQuestion(question); err != nil {
+		return nil, err
+	}
+	return question, nil
+}
+
+// ListQuestions 获取课程题库（仅课程讲师，包含答案）
+func (s *QuizService) ListQuestions(courseID, userID uint) ([]*model.Question, error) {
+	if err := s.checkInstructor(courseID, userID); err != nil {
+		return nil, err
+	}
+	return s.quizRepo.GetQuestionsByCourse(courseID)
+}
+
+// CreateQuiz 在章节下创建测验（仅课程讲师）
+func (s *QuizService) CreateQuiz(req *CreateQuizRequest) (*model.Quiz, error) {
+	log.Printf("🔍 Service: 创建测验 - 课程ID: %d, 章节ID: %d, 标题: %s", req.CourseID, req.ChapterID, req.Title)
+
+	if strings.TrimSpace(req.Title) == "" {
+		return nil, errors.New("测验标题不能为空")
+	}
+	if len(req.QuestionIDs) == 0 {
+		return nil, errors.New("测验至少需要一道题目")
+	}
+	if req.PassScore < 0 || req.PassScore > 100 {
+		return nil, errors.New("及格线必须在0到100之间")
+	}
+	if req.TimeLimitSeconds < 0 || req.MaxAttempts < 0 {
+		return nil, errors.New("答题时限和次数不能为负数")
+	}
+
+	if err := s.checkInstructor(req.CourseID, req.UserID); err != nil {
+		return nil, err
+	}
+
+	chapter, err := s.courseService.GetChapterByID(req.ChapterID)
+	if err != nil {
+		return nil, err
+	}
+	if chapter.CourseID != req.CourseID {
+		return nil, errors.New("章节不属于该课程")
+	}
+
+	// 题目必须来自本课程题库
+	questions, err := s.quizRepo.GetQuestionsByIDs(req.QuestionIDs)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[uint]*model.Question, len(questions))
+	for _, q := range questions {
+		if q.CourseID != req.CourseID {
+			return nil, errors.New("只能使用本课程题库中的题目")
+		}
+		byID[q.ID] = q
+	}
+
+	quiz := &model.Quiz{
+		CourseID:         req.CourseID,
+		ChapterID:        req.ChapterID,
+		CreatorID:        req.UserID,
+		Title:            strings.TrimSpace(req.Title),
+		Description:      req.Description,
+		TimeLimitSeconds: req.TimeLimitSeconds,
+		MaxAttempts:      req.MaxAttempts,
+		PassScore:        req.PassScore,
+	}
+	seen := make(map[uint]bool)
+	for i, id := range req.QuestionIDs {
+		q, ok := byID[id]
+		if !ok {
+			return nil, errors.New("题目不存在")
+		}
+		if seen[id] {
+			return nil, errors.New("测验中存在重复题目")
+		}
+		seen[id] = true
+		quiz.Items = append(quiz.Items, model.QuizItem{QuestionID: id, SortOrder: i, Question: *q})
+	}
+
+	if err := s.quizRepo.CreateQuiz(quiz); err != nil {
+		return nil, err
+	}
+
+	log.Printf("✅ Service: 测验创建成功 - ID: %d", quiz.ID)
+	return quiz, nil
+}
+
+// GetQuiz 获取测验详情，第二个返回值表示调用者是否为讲师（可查看答案）
+// 题目内容只对已报名且章节已开放的学员返回，讲师不受限制
+func (s *QuizService) GetQuiz(quizID, userID uint) (*model.Quiz, bool, error) {
+	quiz, err := s.quizRepo.GetQuizByID(quizID)
+	if err != nil {
+		return nil, false, err
+	}
+	if s.checkInstructor(quiz.CourseID, userID) == nil {
+		return quiz, true, nil
+	}
+	if err := s.checkQuizAccess(quiz, userID); err != nil {
+		return nil, false, err
+	}
+	return quiz, false, nil
+}
+
+// ListQuizzes 获取课程或章节下的测验
+func (s *QuizService) ListQuizzes(courseID, chapterID uint) ([]*model.Quiz, error) {
+	if courseID == 0 && chapterID == 0 {
+		return nil, errors.New("课程ID和章节ID不能同时为空")
+	}
+	return s.quizRepo.ListQuizzes(courseID, chapterID)
+}
+
+// StartAttempt 开始答题，存在未超时的答题记录时直接返回（断点续答）
+func (s *QuizService) StartAttempt(quizID, userID uint) (*model.QuizAttempt, *model.Quiz, error) {
+	log.Printf("🔍 Service: 开始答题 - 测验ID: %d, 用户ID: %d", quizID, userID)
+
+	quiz, err := s.quizRepo.GetQuizByID(quizID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := s.checkQuizAccess(quiz, userID); err != nil {
+		return nil, nil, err
+	}
+
+	now := time.Now()
+	current, err := s.quizRepo.GetInProgressAttempt(quizID, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if current != nil {
+		if !current.IsExpired(now, submitGracePeriod) {
+			return current, quiz, nil
+		}
+		s.expireAttempt(current, quiz, now)
+	}
+
+	if quiz.MaxAttempts > 0 {
+		count, err := s.quizRepo.CountAttempts(quizID, userID)
+		if err != nil {
+			return nil, nil, err
+		}
+		if count >= int64(quiz.MaxAttempts) {
+			return nil, nil, errors.New("已达到最大答题次数")
+		}
+	}
+
+	attempt := &model.QuizAttempt{
+		QuizID:    quizID,
+		UserID:    userID,
+		Status:    model.AttemptStatusInProgress,
+		MaxScore:  quiz.TotalScore(),
+		StartedAt: now,
+	}
+	if quiz.TimeLimitSeconds > 0 {
+		expiresAt := now.Add(time.Duration(quiz.TimeLimitSeconds) * time.Second)
+		attempt.ExpiresAt = &expiresAt
+	}
+	if err := s.quizRepo.CreateAttempt(attempt); err != nil {
+		return nil, nil, err
+	}
+
+	log.Printf("✅ Service: 答题开始 - 记录ID: %d", attempt.ID)
+	return attempt, quiz, nil
+}
+
+// SubmitAttempt 提交答卷并在服务端判分
+func (s *QuizService) SubmitAttempt(attemptID, userID uint, answers map[uint][]string) (*model.QuizAttempt, *model.Quiz, error) {
+	log.Printf("🔍 Service: 提交答卷 - 记录ID: %d, 用户ID: %d", attemptID, userID)
+
+	attempt, err := s.quizRepo.GetAttemptByID(attemptID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if attempt.UserID != userID {
+		return nil, nil, errors.New("无权限提交此答卷")
+	}
+	if attempt.Status != model.AttemptStatusInProgress {
+		return nil, nil, errors.New("答卷已提交")
+	}
+
+	quiz, err := s.quizRepo.GetQuizByID(attempt.QuizID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	now := time.Now()
+	if attempt.IsExpired(now, submitGracePeriod) {
+		// 超时提交不判分
+		s.expireAttempt(attempt, quiz, now)
+		return attempt, quiz, nil
+	}
+
+	attempt.Score = 0
+	attempt.MaxScore = 0
+	attempt.Answers = nil
+	for _, item := range quiz.Items {
+		question := item.Question
+		given := answers[question.ID]
+		correct, score := gradeQuestion(&question, given)
+
+		attempt.MaxScore += question.Score
+		attempt.Score += score
+		attempt.Answers = append(attempt.Answers, model.AttemptAnswer{
+			QuestionID: question.ID,
+			Answers:    given,
+			Correct:    correct,
+			Score:      score,
+		})
+	}
+	attempt.Status = model.AttemptStatusSubmitted
+	attempt.SubmittedAt = &now
+	attempt.Passed = attempt.MaxScore > 0 && attempt.Score*100 >= quiz.PassScore*attempt.MaxScore
+
+	if err := s.quizRepo.SaveAttemptResult(attempt); err != nil {
+		return nil, nil, err
+	}
+
+	log.Printf("✅ Service: 判分完成 - 记录ID: %d, 得分: %d/%d", attempt.ID, attempt.Score, attempt.MaxScore)
+	return attempt, quiz, nil
+}
+
+// ListAttempts 获取答题历史，studentID为0时查看自己的记录，查看他人记录需要讲师权限
+func (s *QuizService) ListAttempts(quizID, userID, studentID uint) ([]*model.QuizAttempt, *model.Quiz, error) {
+	quiz, err := s.quizRepo.GetQuizByID(quizID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if studentID == 0 {
+		studentID = userID
+	}
+	if studentID != userID {
+		if err := s.checkInstructor(quiz.CourseID, userID); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	attempts, err := s.quizRepo.ListAttempts(quizID, studentID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// 顺带结算已超时但未提交的记录
+	now := time.Now()
+	for _, attempt := range attempts {
+		if attempt.Status == model.AttemptStatusInProgress && attempt.IsExpired(now, submitGracePeriod) {
+			s.expireAttempt(attempt, quiz, now)
+		}
+	}
+	return attempts, quiz, nil
+}
+
+// expireAttempt 将超时的答题记录标记为过期（0分）
+func (s *QuizService) expireAttempt(attempt *model.QuizAttempt, quiz *model.Quiz, now time.Time) {
+	attempt.Status = model.AttemptStatusExpired
+	attempt.Score = 0
+	attempt.MaxScore = quiz.TotalScore()
+	attempt.Passed = false
+	attempt.SubmittedAt = &now
+	attempt.Answers = nil
+	if err := s.quizRepo.SaveAttemptResult(attempt); err != nil {
+		log.Printf("⚠️ Service: 标记答题超时失败 - %v", err)
+	}
+}
+
+// checkQuizAccess 校验学员能否查看和作答测验：需报名课程，章节测验还需章节已对其开放
+// 讲师同时满足两项检查，不受开放时间限制
+func (s *QuizService) checkQuizAccess(quiz *model.Quiz, userID uint) error {
+	hasAccess, err := s.courseService.HasCourseAccess(userID, quiz.CourseID)
+	if err != nil {
+		return err
+	}
+	if !hasAccess {
+		return errors.New("请先报名该课程")
+	}
+	if quiz.ChapterID == 0 {
+		return nil
+	}
+
+	chapter, err := s.courseService.CheckChapterRelease(userID, quiz.ChapterID)
+	if err != nil {
+		return err
+	}
+	if chapter.Released {
+		return nil
+	}
+	if chapter.UnlockAt != nil {
+		return fmt.Errorf("测验所属章节尚未开放，将于 %s 开放", chapter.UnlockAt.Format("2006-01-02 15:04"))
+	}
+	return fmt.Errorf("测验所属章节尚未开放，报名后第%d天开放", chapter.ReleaseAfterDays)
+}
+
+// checkInstructor 校验用户是否为课程讲师
+func (s *QuizService) checkInstructor(courseID, userID uint) error {
+	course, err := s.courseService.GetCourseByID(courseID)
+	if err != nil {
+		return err
+	}
+	if userID == 0 || course.InstructorID != userID {
+		return errors.New("只有课程讲师可以管理测验")
+	}
+	return nil
+}
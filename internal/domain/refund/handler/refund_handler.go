@@ -0,0 +1,292 @@
+package handler
+
+import (
+	"log"
+	"net/http"
+	"strconv"
+
+	service "course-platform/internal/infrastructure/grpc_client"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RefundHandler API Gateway的退款处理器
+type RefundHandler struct {
+	refundGRPCClient *service.RefundGRPCClientService
+}
+
+// NewRefundHandler 创建退款处理器
+func NewRefundHandler(refundGRPCClient *service.RefundGRPCClientService) *RefundHandler {
+	return &RefundHandler{
+		refundGRPCClient: refundGRPCClient,
+	}
+}
+
+// RequestRefundRequest 退款申请请求结构，amount 单位为分，不传表示全额退款
+type RequestRefundRequest struct {
+	Amount int64  `json:"amount"`
+	Reason string `json:"reason" binding:"required"`
+}
+
+// ReviewRefundRequest 退款审核请求结构
+// 审核通过时 amount 可小于申请金额（部分退款），不传表示按申请金额退款
+type ReviewRefundRequest struct {
+	Amount int64  `json:"amount"`
+	Note   string `json:"note"`
+}
+
+// RequestRefund 提交退款申请
+// @Summary 申请退款
+// @Description 学员为已支付订单申请退款，需符合退款政策（支付后天数和学习进度）
+// @Tags 退款管理
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param order_no path string true "订单号"
+// @Param refund body RequestRefundRequest true "退款信息"
+// @Success 200 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Router /api/v1/orders/{order_no}/refunds [post]
+func (h *RefundHandler) RequestRefund(c *gin.Context) {
+	var req RequestRefundRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    400,
+			"message": "请求参数无效: " + err.Error(),
+		})
+		return
+	}
+
+	resp, err := h.refundGRPCClient.RequestRefund(c.Request.Context(), c.GetUint("userID"), c.Param("order_no"), req.Amount, req.Reason)
+	if err != nil {
+		respondGRPCError(c, "提交退款申请失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data":    resp.Refund,
+	})
+}
+
+// ListMyRefunds 获取本人的退款申请
+// @Summary 我的退款申请
+// @Tags 退款管理
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Success 200 {object} map[string]interface{}
+// @Router /api/v1/refunds [get]
+func (h *RefundHandler) ListMyRefunds(c *gin.Context) {
+	resp, err := h.refundGRPCClient.ListMyRefunds(c.Request.Context(), c.GetUint("userID"))
+	if err != nil {
+		respondGRPCError(c, "获取退款申请失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data":    resp.Refunds,
+	})
+}
+
+// ListRefundQueue 获取退款审核列表
+// @Summary 退款审核列表
+// @Description 讲师查看自己课程的退款申请，平台管理员查看全部
+// @Tags 退款管理
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param status query string false "状态过滤，默认 pending，传 all 返回全部"
+// @Success 200 {object} map[string]interface{}
+// @Router /api/v1/refunds/review [get]
+func (h *RefundHandler) ListRefundQueue(c *gin.Context) {
+	status := c.DefaultQuery("status", "pending")
+	if status == "all" {
+		status = ""
+	}
+
+	resp, err := h.refundGRPCClient.ListRefundQueue(c.Request.Context(), c.GetUint("userID"), status)
+	if err != nil {
+		respondGRPCError(c, "获取退款审核列表失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data":    resp.Refunds,
+	})
+}
+
+// GetRefund 获取退款申请详情
+// @Summary 退款申请详情
+// @Description 返回退款申请及完整的审计记录
+// @Tags 退款管理
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path int true "退款申请ID"
+// @Success 200 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Router /api/v1/refunds/{id} [get]
+func (h *RefundHandler) GetRefund(c *gin.Context) {
+	refundID, ok := parseIDParam(c, "id", "退款申请ID参数无效")
+	if !ok {
+		return
+	}
+
+	resp, err := h.refundGRPCClient.GetRefund(c.Request.Context(), refundID, c.GetUint("userID"))
+	if err != nil {
+		respondGRPCError(c, "获取退款申请失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data": gin.H{
+			"refund":     resp.Refund,
+			"audit_logs": resp.AuditLogs,
+		},
+	})
+}
+
+// ApproveRefund 审核通过退款申请
+// @Summary 批准退款
+// @Description 课程讲师或平台管理员批准退款，退款成功后自动取消学员选课
+// @Tags 退款管理
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path int true "退款申请ID"
+// @Param review body ReviewRefundRequest false "审核信息"
+// @Success 200 {object} map[string]interface{}
+// @Router /api/v1/refunds/{id}/approve [post]
+func (h *RefundHandler) ApproveRefund(c *gin.Context) {
+	refundID, ok := parseIDParam(c, "id", "退款申请ID参数无效")
+	if !ok {
+		return
+	}
+	var req ReviewRefundRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"code":    400,
+				"message": "请求参数无效: " + err.Error(),
+			})
+			return
+		}
+	}
+
+	resp, err := h.refundGRPCClient.ApproveRefund(c.Request.Context(), refundID, c.GetUint("userID"), req.Amount, req.Note)
+	if err != nil {
+		respondGRPCError(c, "审核退款失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data":    resp.Refund,
+	})
+}
+
+// RejectRefund 拒绝退款申请
+// @Summary 拒绝退款
+// @Tags 退款管理
+// @Accept json
+// @Produce json
+// @Param Authorization header string true "Bearer token"
+// @Param id path int true "退款申请ID"
+// @Param review body ReviewRefundRequest true "审核信息（note 必填）"
+// @Success 200 {object} map[string]interface{}
+// @Router /api/v1/refunds/{id}/reject [post]
+func (h *RefundHandler) RejectRefund(c *gin.Context) {
+	refundID, ok := parseIDParam(c, "id", "退款申请ID参数无效")
+	if !ok {
+		return
+	}
+	var req ReviewRefundRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    400,
+			"message": "请求参数无效: " + err.Error(),
+		})
+		return
+	}
+
+	resp, err := h.refundGRPCClient.RejectRefund(c.Request.Context(), refundID, c.GetUint("userID"), req.Note)
+	if err != nil {
+		respondGRPCError(c, "拒绝退款失败", err)
+		return
+	}
+	if resp.Code != 200 {
+		respondBusinessError(c, resp.Code, resp.Message)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"code":    200,
+		"message": resp.Message,
+		"data":    resp.Refund,
+	})
+}
+
+// parseIDParam 解析路径中的ID参数，失败时直接返回400
+func parseIDParam(c *gin.Context, name, message string) (uint, bool) {
+	id, err := strconv.ParseUint(c.Param(name), 10, 32)
+	if err != nil || id == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"code":    400,
+			"message": message,
+		})
+		return 0, false
+	}
+	return uint(id), true
+}
+
+// respondGRPCError 返回调用微服务失败的响应
+func respondGRPCError(c *gin.Context, action string, err error) {
+	log.Printf("❌ API: %s - %v", action, err)
+	c.JSON(http.StatusInternalServerError, gin.H{
+		"code":    500,
+		"message": action + ": " + err.Error(),
+	})
+}
+
+// respondBusinessError 按业务码返回对应HTTP状态
+func respondBusinessError(c *gin.Context, code int32, message string) {
+	status := http.StatusBadRequest
+	switch code {
+	case 403:
+		status = http.StatusForbidden
+	case 404:
+		status = http.StatusNotFound
+	case 409:
+		status = http.StatusConflict
+	}
+	c.JSON(status, gin.H{
+		"code":    code,
+		"message": message,
+	})
+}
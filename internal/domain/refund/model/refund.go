@@ -0,0 +1,79 @@
+package model
+
+import (
+	"time"
+)
+
+// 退款申请状态
+const (
+	RefundStatusPending  = "pending"  // 待审核
+	RefundStatusApproved = "approved" // 已批准，正在向支付渠道退款
+	RefundStatusRefunded = "refunded" // 已退款
+	RefundStatusRejected = "rejected" // 已拒绝
+)
+
+// 审计操作类型
+const (
+	AuditActionRequested         = "requested"          // 学员提交申请
+	AuditActionApproved          = "approved"           // 审核通过
+	AuditActionRejected          = "rejected"           // 审核拒绝
+	AuditActionRefundFailed      = "refund_failed"      // 支付渠道退款失败，申请退回待审核
+	AuditActionRefunded          = "refunded"           // 支付渠道退款成功
+	AuditActionEnrollmentRevoked = "enrollment_revoked" // 已取消选课
+)
+
+// Refund 退款申请
+// 金额单位为分；批准金额可小于申请金额（部分退款），同一订单可多次部分退款，
+// 部分退款不影响选课，累计退款达到订单金额后取消选课
+// 套餐订单的 CourseID 为0，退款成功后取消套餐中全部课程的选课
+type Refund struct {
+	ID        uint      `gorm:"primarykey" json:"id"` // 主键ID
+	CreatedAt time.Time `json:"created_at"`           // 申请时间
+	UpdatedAt time.Time `json:"updated_at"`           // 更新时间
+
+	OrderID          uint       `gorm:"not null;index" json:"order_id"`                         // 订单ID
+	OrderNo          string     `gorm:"not null;size:32" json:"order_no"`                       // 订单号
+	UserID           uint       `gorm:"not null;index" json:"user_id"`                          // 申请人ID
+	CourseID         uint       `gorm:"not null;index" json:"course_id"`                        // 课程ID
+	BundleID         uint       `gorm:"not null;default:0" json:"bundle_id"`                    // 套餐ID
+	InstructorID     uint       `gorm:"not null;index" json:"instructor_id"`                    // 课程讲师ID（审核人）
+	OrderAmount      int64      `gorm:"not null" json:"order_amount"`                           // 订单实付金额
+	RequestedAmount  int64      `gorm:"not null" json:"requested_amount"`                       // 申请退款金额
+	ApprovedAmount   int64      `gorm:"not null;default:0" json:"approved_amount"`              // 批准退款金额
+	Reason           string     `gorm:"size:500" json:"reason"`                                 // 退款原因
+	ProgressPercent  int        `gorm:"not null;default:0" json:"progress_percent"`             // 申请时的学习进度
+	Status           string     `gorm:"size:20;not null;default:'pending';index" json:"status"` // 申请状态
+	ReviewerID       uint       `gorm:"not null;default:0" json:"reviewer_id"`                  // 审核人ID
+	ReviewNote       string     `gorm:"size:500" json:"review_note"`                            // 审核意见
+	ReviewedAt       *time.Time `json:"reviewed_at"`                                            // 审核时间
+	ProviderRefundID string     `gorm:"size:64" json:"provider_refund_id"`                      // 支付渠道退款单号
+	RefundedAt       *time.Time `json:"refunded_at"`                                            // 退款完成时间
+}
+
+// TableName 指定表名
+func (Refund) TableName() string {
+	return "refunds"
+}
+
+// IsPending 是否待审核
+func (r *Refund) IsPending() bool {
+	return r.Status == RefundStatusPending
+}
+
+// RefundAuditLog 退款审计记录，只追加不修改
+// ActorID 为0表示系统操作
+type RefundAuditLog struct {
+	ID        uint      `gorm:"primarykey" json:"id"` // 主键ID
+	CreatedAt time.Time `json:"created_at"`           // 操作时间
+
+	RefundID uint   `gorm:"not null;index" json:"refund_id"` // 退款申请ID
+	OrderID  uint   `gorm:"not null;index" json:"order_id"`  // 订单ID
+	ActorID  uint   `gorm:"not null" json:"actor_id"`        // 操作人ID
+	Action   string `gorm:"size:30;not null" json:"action"`  // 操作类型
+	Detail   string `gorm:"size:500" json:"detail"`          // 操作详情
+}
+
+// TableName 指定表名
+func (RefundAuditLog) TableName() string {
+	return "refund_audit_logs"
+}
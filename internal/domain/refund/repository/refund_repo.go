@@ -0,0 +1,127 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"course-platform/internal/domain/refund/model"
+
+	"gorm.io/gorm"
+)
+
+// RefundRepositoryInterface 退款仓储接口
+type RefundRepositoryInterface interface {
+	Create(refund *model.Refund) error
+	GetByID(id uint) (*model.Refund, error)
+	GetOpenByOrder(orderID uint) (*model.Refund, error)
+	ListByUser(userID uint) ([]*model.Refund, error)
+	ListForReview(instructorID uint, status string) ([]*model.Refund, error)
+	UpdateStatus(id uint, from string, updates map[string]interface{}) (bool, error)
+	AddAuditLog(auditLog *model.RefundAuditLog) error
+	ListAuditLogs(refundID uint) ([]*model.RefundAuditLog, error)
+}
+
+// RefundRepository 退款仓储实现
+type RefundRepository struct {
+	db *gorm.DB
+}
+
+// NewRefundRepository 创建退款仓储实例
+func NewRefundRepository(db *gorm.DB) RefundRepositoryInterface {
+	return &RefundRepository{db: db}
+}
+
+// Create 创建退款申请
+func (r *RefundRepository) Create(refund *model.Refund) error {
+	if err := r.db.Create(refund).Error; err != nil {
+		log.Printf("❌ Repository: 创建退款申请失败 - %v", err)
+		return fmt.Errorf("创建退款申请失败: %w", err)
+	}
+
+	log.Printf("✅ Repository: 退款申请创建成功 - ID: %d", refund.ID)
+	return nil
+}
+
+// GetByID 根据ID获取退款申请
+func (r *RefundRepository) GetByID(id uint) (*model.Refund, error) {
+	var refund model.Refund
+	if err := r.db.First(&refund, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("退款申请不存在")
+		}
+		return nil, fmt.Errorf("查询退款申请失败: %w", err)
+	}
+	return &refund, nil
+}
+
+// GetOpenByOrder 获取订单待审核或正在退款的申请，不存在时返回nil
+// 已拒绝或已退款的申请不计入，部分退款后学员可以就剩余金额再次申请
+func (r *RefundRepository) GetOpenByOrder(orderID uint) (*model.Refund, error) {
+	var refund model.Refund
+	err := r.db.Where("order_id = ? AND status IN ?", orderID, []string{model.RefundStatusPending, model.RefundStatusApproved}).
+		Order("created_at DESC").First(&refund).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("查询退款申请失败: %w", err)
+	}
+	return &refund, nil
+}
+
+// ListByUser 获取学员的全部退款申请
+func (r *RefundRepository) ListByUser(userID uint) ([]*model.Refund, error) {
+	var refunds []*model.Refund
+	if err := r.db.Where("user_id = ?", userID).Order("created_at DESC").Find(&refunds).Error; err != nil {
+		log.Printf("❌ Repository: 查询退款申请失败 - %v", err)
+		return nil, fmt.Errorf("查询退款申请失败: %w", err)
+	}
+	return refunds, nil
+}
+
+// ListForReview 获取待审核列表，instructorID 为0时返回全部课程（平台管理员），status 为空时不过滤状态
+func (r *RefundRepository) ListForReview(instructorID uint, status string) ([]*model.Refund, error) {
+	query := r.db.Model(&model.Refund{})
+	if instructorID > 0 {
+		query = query.Where("instructor_id = ?", instructorID)
+	}
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	var refunds []*model.Refund
+	if err := query.Order("created_at ASC").Find(&refunds).Error; err != nil {
+		log.Printf("❌ Repository: 查询退款审核列表失败 - %v", err)
+		return nil, fmt.Errorf("查询退款审核列表失败: %w", err)
+	}
+	return refunds, nil
+}
+
+// UpdateStatus 仅当申请处于 from 状态时更新，返回是否更新成功，用于防止重复审核
+func (r *RefundRepository) UpdateStatus(id uint, from string, updates map[string]interface{}) (bool, error) {
+	result := r.db.Model(&model.Refund{}).Where("id = ? AND status = ?", id, from).Updates(updates)
+	if result.Error != nil {
+		log.Printf("❌ Repository: 更新退款申请失败 - %v", result.Error)
+		return false, fmt.Errorf("更新退款申请失败: %w", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// AddAuditLog 追加审计记录
+func (r *RefundRepository) AddAuditLog(auditLog *model.RefundAuditLog) error {
+	if err := r.db.Create(auditLog).Error; err != nil {
+		log.Printf("❌ Repository: 写入退款审计记录失败 - %v", err)
+		return fmt.Errorf("写入退款审计记录失败: %w", err)
+	}
+	return nil
+}
+
+// ListAuditLogs 获取退款申请的审计记录
+func (r *RefundRepository) ListAuditLogs(refundID uint) ([]*model.RefundAuditLog, error) {
+	var logs []*model.RefundAuditLog
+	if err := r.db.Where("refund_id = ?", refundID).Order("id ASC").Find(&logs).Error; err != nil {
+		return nil, fmt.Errorf("查询退款审计记录失败: %w", err)
+	}
+	return logs, nil
+}
@@ -0,0 +1,430 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	bundleService "course-platform/internal/domain/bundle/service"
+	courseModel "course-platform/internal/domain/course/model"
+	courseService "course-platform/internal/domain/course/service"
+	ledgerService "course-platform/internal/domain/ledger/service"
+	orderModel "course-platform/internal/domain/order/model"
+	orderRepository "course-platform/internal/domain/order/repository"
+	"course-platform/internal/domain/refund/model"
+	"course-platform/internal/domain/refund/repository"
+	userRepository "course-platform/internal/domain/user/repository"
+	"course-platform/internal/infrastructure/payment"
+)
+
+// RefundServiceInterface 退款服务接口
+type RefundServiceInterface interface {
+	RequestRefund(req *RequestRefundRequest) (*model.Refund, error)
+	ApproveRefund(ctx context.Context, refundID, reviewerID uint, amount int64, note string) (*model.Refund, error)
+	RejectRefund(refundID, reviewerID uint, note string) (*model.Refund, error)
+	GetRefund(refundID, userID uint) (*model.Refund, []*model.RefundAuditLog, error)
+	ListMyRefunds(userID uint) ([]*model.Refund, error)
+	ListReviewQueue(reviewerID uint, status string) ([]*model.Refund, error)
+}
+
+// Policy 退款政策，字段为0表示不限制
+type Policy struct {
+	WindowDays         int // 支付后可申请退款的天数
+	MaxProgressPercent int // 学习进度达到此百分比后不可退款
+}
+
+// Check 检查订单是否符合退款政策
+func (p Policy) Check(paidAt time.Time, progressPercent int, now time.Time) error {
+	if p.WindowDays > 0 && now.Sub(paidAt) > time.Duration(p.WindowDays)*24*time.Hour {
+		return fmt.Errorf("已超过%d天退款期限", p.WindowDays)
+	}
+	if p.MaxProgressPercent > 0 && progressPercent >= p.MaxProgressPercent {
+		return fmt.Errorf("学习进度已达%d%%，进度达到%d%%后不可退款", progressPercent, p.MaxProgressPercent)
+	}
+	return nil
+}
+
+// RequestRefundRequest 退款申请请求，Amount 为0时申请全额退款
+type RequestRefundRequest struct {
+	UserID  uint
+	OrderNo string
+	Amount  int64
+	Reason  string
+}
+
+// RefundService 退款服务实现
+type RefundService struct {
+	refundRepo    repository.RefundRepositoryInterface
+	orderRepo     orderRepository.OrderRepositoryInterface
+	courseService courseService.CourseServiceInterface
+	bundleService bundleService.BundleServiceInterface
+	userRepo      userRepository.UserRepositoryInterface
+	ledgerService ledgerService.LedgerServiceInterface
+	provider      payment.Provider
+	policy        Policy
+}
+
+// NewRefundService 创建退款服务实例
+func NewRefundService(refundRepo repository.RefundRepositoryInterface, orderRepo orderRepository.OrderRepositoryInterface, courseService courseService.CourseServiceInterface, bundleService bundleService.BundleServiceInterface, userRepo userRepository.UserRepositoryInterface, ledgerService ledgerService.LedgerServiceInterface, provider payment.Provider, policy Policy) RefundServiceInterface {
+	return &RefundService{
+		refundRepo:    refundRepo,
+		orderRepo:     orderRepo,
+		courseService: courseService,
+		bundleService: bundleService,
+		userRepo:      userRepo,
+		ledgerService: ledgerService,
+		provider:      provider,
+		policy:        policy,
+	}
+}
+
+// RequestRefund 学员为已支付订单提交退款申请，需符合退款政策
+// 金额不能超过订单实付金额减去已退款金额，Amount 为0时申请退还剩余全部金额
+func (s *RefundService) RequestRefund(req *RequestRefundRequest) (*model.Refund, error) {
+	log.Printf("🔍 Service: 提交退款申请 - 用户ID: %d, 订单号: %s", req.UserID, req.OrderNo)
+
+	reason := strings.TrimSpace(req.Reason)
+	if reason == "" {
+		return nil, errors.New("请填写退款原因")
+	}
+	if len([]rune(reason)) > 500 {
+		return nil, errors.New("退款原因不能超过500个字符")
+	}
+
+	order, err := s.orderRepo.GetByOrderNo(req.OrderNo)
+	if err != nil {
+		return nil, err
+	}
+	if order.UserID != req.UserID {
+		return nil, errors.New("无权为该订单申请退款")
+	}
+	if !order.IsPaid() || order.PaidAt == nil {
+		return nil, errors.New("只有已支付的订单可以申请退款")
+	}
+	if order.Amount <= 0 {
+		return nil, errors.New("订单实付金额为0，无需退款")
+	}
+
+	remaining := order.Amount - order.RefundedAmount
+	if remaining <= 0 {
+		return nil, errors.New("订单已全额退款")
+	}
+	amount := req.Amount
+	if amount == 0 {
+		amount = remaining
+	}
+	if amount < 0 || amount > remaining {
+		return nil, fmt.Errorf("退款金额必须大于0且不超过可退金额 %d 分", remaining)
+	}
+
+	open, err := s.refundRepo.GetOpenByOrder(order.ID)
+	if err != nil {
+		return nil, err
+	}
+	if open != nil {
+		return nil, errors.New("该订单已有退款申请")
+	}
+
+	courseIDs, instructorID, err := s.orderScope(order)
+	if err != nil {
+		return nil, err
+	}
+	progressPercent, err := s.progressPercent(order.UserID, courseIDs)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.policy.Check(*order.PaidAt, progressPercent, time.Now()); err != nil {
+		return nil, fmt.Errorf("不符合退款政策: %w", err)
+	}
+
+	refund := &model.Refund{
+		OrderID:         order.ID,
+		OrderNo:         order.OrderNo,
+		UserID:          order.UserID,
+		CourseID:        order.CourseID,
+		BundleID:        order.BundleID,
+		InstructorID:    instructorID,
+		OrderAmount:     order.Amount,
+		RequestedAmount: amount,
+		Reason:          reason,
+		ProgressPercent: progressPercent,
+		Status:          model.RefundStatusPending,
+	}
+	if err := s.refundRepo.Create(refund); err != nil {
+		return nil, err
+	}
+	s.audit(refund, req.UserID, model.AuditActionRequested, fmt.Sprintf("申请退款 %d 分，学习进度 %d%%：%s", amount, progressPercent, reason))
+
+	log.Printf("✅ Service: 退款申请已提交 - ID: %d", refund.ID)
+	return refund, nil
+}
+
+// ApproveRefund 审核通过并执行退款，amount 为0时按申请金额退款
+// 累计退款达到订单金额后订单标记为已退款并取消学员选课；
+// 部分退款时订单保持已支付、累加已退款金额，课程仍可访问
+func (s *RefundService) ApproveRefund(ctx context.Context, refundID, reviewerID uint, amount int64, note string) (*model.Refund, error) {
+	log.Printf("🔍 Service: 审核通过退款申请 - ID: %d, 审核人: %d", refundID, reviewerID)
+
+	refund, err := s.getForReview(refundID, reviewerID)
+	if err != nil {
+		return nil, err
+	}
+	if amount == 0 {
+		amount = refund.RequestedAmount
+	}
+
+	order, err := s.orderRepo.GetByOrderNo(refund.OrderNo)
+	if err != nil {
+		return nil, err
+	}
+	if !order.IsPaid() {
+		return nil, errors.New("订单不是已支付状态，无法退款")
+	}
+	remaining := order.Amount - order.RefundedAmount
+	if amount <= 0 || amount > remaining {
+		return nil, fmt.Errorf("退款金额必须大于0且不超过可退金额 %d 分", remaining)
+	}
+	// 累计退款达到订单金额时视为全额退款
+	full := amount == remaining
+
+	// 先抢占申请状态，防止重复审核导致重复退款
+	now := time.Now()
+	ok, err := s.refundRepo.UpdateStatus(refund.ID, model.RefundStatusPending, map[string]interface{}{
+		"status":          model.RefundStatusApproved,
+		"approved_amount": amount,
+		"reviewer_id":     reviewerID,
+		"review_note":     strings.TrimSpace(note),
+		"reviewed_at":     now,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.New("退款申请状态已变化，请刷新后重试")
+	}
+	s.audit(refund, reviewerID, model.AuditActionApproved, fmt.Sprintf("批准退款 %d 分：%s", amount, strings.TrimSpace(note)))
+
+	// 再抢占订单，订单已退款或状态变化时不再向支付渠道退款
+	refundedAt := time.Now()
+	ok, err = s.orderRepo.ApplyRefund(order.ID, order.RefundedAmount, amount, full, refundedAt)
+	if err == nil && !ok {
+		err = errors.New("订单状态已变化，无法退款")
+	}
+	if err != nil {
+		log.Printf("❌ Service: 记录订单退款失败 - 订单号: %s, 错误: %v", order.OrderNo, err)
+		s.reopen(refund)
+		return nil, err
+	}
+
+	result, err := s.provider.Refund(ctx, &payment.RefundRequest{
+		PaymentID: order.PaymentID,
+		OrderNo:   order.OrderNo,
+		Amount:    amount,
+		Reason:    refund.Reason,
+	})
+	if err != nil {
+		log.Printf("❌ Service: 支付渠道退款失败 - ID: %d, 错误: %v", refund.ID, err)
+		// 订单和申请都退回原状态，允许稍后重试
+		if rollbackErr := s.orderRepo.RevertRefund(order.ID, order.RefundedAmount, amount); rollbackErr != nil {
+			log.Printf("⚠️ Service: 恢复订单状态失败 - 订单号: %s, 错误: %v", order.OrderNo, rollbackErr)
+		}
+		s.reopen(refund)
+		s.audit(refund, 0, model.AuditActionRefundFailed, err.Error())
+		return nil, errors.New("支付渠道退款失败，请稍后重试")
+	}
+
+	if _, err := s.refundRepo.UpdateStatus(refund.ID, model.RefundStatusApproved, map[string]interface{}{
+		"status":             model.RefundStatusRefunded,
+		"provider_refund_id": result.RefundID,
+		"refunded_at":        refundedAt,
+	}); err != nil {
+		return nil, err
+	}
+	s.audit(refund, 0, model.AuditActionRefunded, fmt.Sprintf("支付渠道退款成功，退款单号 %s", result.RefundID))
+	if err := s.ledgerService.RecordRefund(order, refund.ID, amount, refundedAt); err != nil {
+		log.Printf("⚠️ Service: 退款记账失败 - ID: %d, 错误: %v", refund.ID, err)
+	}
+
+	if !full {
+		log.Printf("✅ Service: 部分退款完成，保留课程访问 - ID: %d, 金额: %d", refund.ID, amount)
+		return s.refundRepo.GetByID(refund.ID)
+	}
+	if err := s.revokeAccess(refund); err != nil {
+		log.Printf("⚠️ Service: 取消选课失败 - 用户ID: %d, 课程ID: %d, 套餐ID: %d, 错误: %v", refund.UserID, refund.CourseID, refund.BundleID, err)
+	} else {
+		s.audit(refund, 0, model.AuditActionEnrollmentRevoked, "已取消选课，课程内容不可再访问")
+	}
+
+	log.Printf("✅ Service: 退款完成 - ID: %d, 金额: %d", refund.ID, amount)
+	return s.refundRepo.GetByID(refund.ID)
+}
+
+// RejectRefund 拒绝退款申请
+func (s *RefundService) RejectRefund(refundID, reviewerID uint, note string) (*model.Refund, error) {
+	note = strings.TrimSpace(note)
+	if note == "" {
+		return nil, errors.New("请填写拒绝原因")
+	}
+
+	refund, err := s.getForReview(refundID, reviewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	ok, err := s.refundRepo.UpdateStatus(refund.ID, model.RefundStatusPending, map[string]interface{}{
+		"status":      model.RefundStatusRejected,
+		"reviewer_id": reviewerID,
+		"review_note": note,
+		"reviewed_at": time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.New("退款申请状态已变化，请刷新后重试")
+	}
+	s.audit(refund, reviewerID, model.AuditActionRejected, note)
+
+	log.Printf("✅ Service: 退款申请已拒绝 - ID: %d", refund.ID)
+	return s.refundRepo.GetByID(refund.ID)
+}
+
+// GetRefund 获取退款申请及审计记录（申请人、课程讲师或平台管理员）
+func (s *RefundService) GetRefund(refundID, userID uint) (*model.Refund, []*model.RefundAuditLog, error) {
+	refund, err := s.refundRepo.GetByID(refundID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if refund.UserID != userID && !s.canReview(refund, userID) {
+		return nil, nil, errors.New("无权查看该退款申请")
+	}
+
+	logs, err := s.refundRepo.ListAuditLogs(refund.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return refund, logs, nil
+}
+
+// ListMyRefunds 获取学员本人的退款申请
+func (s *RefundService) ListMyRefunds(userID uint) ([]*model.Refund, error) {
+	if userID == 0 {
+		return nil, errors.New("用户ID不能为空")
+	}
+	return s.refundRepo.ListByUser(userID)
+}
+
+// ListReviewQueue 获取审核列表：讲师看到自己课程的申请，平台管理员看到全部
+func (s *RefundService) ListReviewQueue(reviewerID uint, status string) ([]*model.Refund, error) {
+	if reviewerID == 0 {
+		return nil, errors.New("用户ID不能为空")
+	}
+	instructorID := reviewerID
+	if s.isAdmin(reviewerID) {
+		instructorID = 0
+	}
+	return s.refundRepo.ListForReview(instructorID, status)
+}
+
+// getForReview 获取待审核的退款申请并校验审核权限
+func (s *RefundService) getForReview(refundID, reviewerID uint) (*model.Refund, error) {
+	refund, err := s.refundRepo.GetByID(refundID)
+	if err != nil {
+		return nil, err
+	}
+	if !s.canReview(refund, reviewerID) {
+		return nil, errors.New("无权审核该退款申请")
+	}
+	if !refund.IsPending() {
+		return nil, errors.New("退款申请已处理")
+	}
+	return refund, nil
+}
+
+// canReview 课程讲师和平台管理员可以审核退款
+func (s *RefundService) canReview(refund *model.Refund, userID uint) bool {
+	if userID == 0 {
+		return false
+	}
+	return refund.InstructorID == userID || s.isAdmin(userID)
+}
+
+// isAdmin 检查用户是否为平台管理员
+func (s *RefundService) isAdmin(userID uint) bool {
+	user, err := s.userRepo.GetByID(userID)
+	return err == nil && user.IsAdmin()
+}
+
+// orderScope 获取订单包含的课程和讲师，套餐订单包含套餐中的全部课程
+func (s *RefundService) orderScope(order *orderModel.Order) ([]uint, uint, error) {
+	if order.IsBundle() {
+		bundle, err := s.bundleService.GetBundle(order.BundleID, order.InstructorID)
+		if err != nil {
+			return nil, 0, err
+		}
+		return bundle.CourseIDs(), bundle.CreatorID, nil
+	}
+
+	course, err := s.courseService.GetCourseByID(order.CourseID)
+	if err != nil {
+		return nil, 0, err
+	}
+	return []uint{course.ID}, course.InstructorID, nil
+}
+
+// progressPercent 计算学员的学习进度百分比，多门课程时取平均值
+func (s *RefundService) progressPercent(userID uint, courseIDs []uint) (int, error) {
+	if len(courseIDs) == 0 {
+		return 0, nil
+	}
+
+	total := 0
+	for _, courseID := range courseIDs {
+		progress, err := s.courseService.GetCourseProgress(userID, courseID)
+		if err != nil {
+			return 0, err
+		}
+		if progress.TotalChapters > 0 {
+			total += len(progress.CompletedChapterIDs) * 100 / progress.TotalChapters
+		}
+	}
+	return total / len(courseIDs), nil
+}
+
+// reopen 将已批准的申请退回待审核状态
+func (s *RefundService) reopen(refund *model.Refund) {
+	if _, err := s.refundRepo.UpdateStatus(refund.ID, model.RefundStatusApproved, map[string]interface{}{
+		"status": model.RefundStatusPending,
+	}); err != nil {
+		log.Printf("⚠️ Service: 恢复退款申请状态失败 - %v", err)
+	}
+}
+
+// revokeAccess 全额退款后取消该订单开通的课程或套餐选课
+// 只撤销该订单（或套餐）开通的来源，学员通过其他途径获得的课程不受影响
+func (s *RefundService) revokeAccess(refund *model.Refund) error {
+	if refund.BundleID != 0 {
+		return s.bundleService.RevokeBundle(refund.UserID, refund.BundleID)
+	}
+	return s.courseService.RevokeEnrollmentSource(refund.UserID, refund.CourseID, courseModel.EnrollmentSourceOrder, refund.OrderID)
+}
+
+// audit 追加审计记录，写入失败只记录日志，不影响主流程
+func (s *RefundService) audit(refund *model.Refund, actorID uint, action, detail string) {
+	if len([]rune(detail)) > 500 {
+		detail = string([]rune(detail)[:500])
+	}
+	err := s.refundRepo.AddAuditLog(&model.RefundAuditLog{
+		RefundID: refund.ID,
+		OrderID:  refund.OrderID,
+		ActorID:  actorID,
+		Action:   action,
+		Detail:   detail,
+	})
+	if err != nil {
+		log.Printf("⚠️ Service: 写入退款审计记录失败 - ID: %d, 操作: %s, 错误: %v", refund.ID, action, err)
+	}
+}
@@ -0,0 +1,341 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	courseModel "course-platform/internal/domain/course/model"
+	courseService "course-platform/internal/domain/course/service"
+	ledgerService "course-platform/internal/domain/ledger/service"
+	orderModel "course-platform/internal/domain/order/model"
+	orderRepository "course-platform/internal/domain/order/repository"
+	"course-platform/internal/domain/refund/model"
+	userModel "course-platform/internal/domain/user/model"
+	userRepository "course-platform/internal/domain/user/repository"
+	"course-platform/internal/infrastructure/payment"
+)
+
+const (
+	testInstructorID = 3
+	testStudentID    = 9
+	testCourseID     = 7
+)
+
+// memoryRefundRepo 内存退款仓储，UpdateStatus 与数据库一样按原状态条件更新
+type memoryRefundRepo struct {
+	refunds []*model.Refund
+	logs    []*model.RefundAuditLog
+}
+
+func (r *memoryRefundRepo) Create(refund *model.Refund) error {
+	refund.ID = uint(len(r.refunds) + 1)
+	r.refunds = append(r.refunds, refund)
+	return nil
+}
+
+func (r *memoryRefundRepo) GetByID(id uint) (*model.Refund, error) {
+	for _, refund := range r.refunds {
+		if refund.ID == id {
+			copied := *refund
+			return &copied, nil
+		}
+	}
+	return nil, errors.New("退款申请不存在")
+}
+
+func (r *memoryRefundRepo) GetOpenByOrder(orderID uint) (*model.Refund, error) {
+	for _, refund := range r.refunds {
+		if refund.OrderID == orderID && (refund.Status == model.RefundStatusPending || refund.Status == model.RefundStatusApproved) {
+			return refund, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *memoryRefundRepo) ListByUser(userID uint) ([]*model.Refund, error) {
+	return nil, nil
+}
+
+func (r *memoryRefundRepo) ListForReview(instructorID uint, status string) ([]*model.Refund, error) {
+	return nil, nil
+}
+
+func (r *memoryRefundRepo) UpdateStatus(id uint, from string, updates map[string]interface{}) (bool, error) {
+	for _, refund := range r.refunds {
+		if refund.ID != id || refund.Status != from {
+			continue
+		}
+		refund.Status = updates["status"].(string)
+		if amount, ok := updates["approved_amount"].(int64); ok {
+			refund.ApprovedAmount = amount
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+func (r *memoryRefundRepo) AddAuditLog(auditLog *model.RefundAuditLog) error {
+	r.logs = append(r.logs, auditLog)
+	return nil
+}
+
+func (r *memoryRefundRepo) ListAuditLogs(refundID uint) ([]*model.RefundAuditLog, error) {
+	return r.logs, nil
+}
+
+// memoryOrderRepo 内存订单仓储，只实现退款流程用到的方法
+type memoryOrderRepo struct {
+	orderRepository.OrderRepositoryInterface
+	order *orderModel.Order
+}
+
+func (r *memoryOrderRepo) GetByOrderNo(orderNo string) (*orderModel.Order, error) {
+	copied := *r.order
+	return &copied, nil
+}
+
+func (r *memoryOrderRepo) ApplyRefund(id uint, refunded, amount int64, full bool, at time.Time) (bool, error) {
+	if r.order.Status != orderModel.OrderStatusPaid || r.order.RefundedAmount != refunded {
+		return false, nil
+	}
+	r.order.RefundedAmount = refunded + amount
+	if full {
+		r.order.Status = orderModel.OrderStatusRefunded
+	}
+	return true, nil
+}
+
+func (r *memoryOrderRepo) RevertRefund(id uint, refunded, amount int64) error {
+	if r.order.RefundedAmount == refunded+amount {
+		r.order.Status = orderModel.OrderStatusPaid
+		r.order.RefundedAmount = refunded
+	}
+	return nil
+}
+
+// stubCourseService 课程服务桩，记录被撤销的选课来源
+type stubCourseService struct {
+	courseService.CourseServiceInterface
+	revoked int
+}
+
+func (s *stubCourseService) GetCourseByID(id uint) (*courseModel.Course, error) {
+	return &courseModel.Course{ID: id, InstructorID: testInstructorID}, nil
+}
+
+func (s *stubCourseService) GetCourseProgress(userID, courseID uint) (*courseModel.CourseProgress, error) {
+	return &courseModel.CourseProgress{CourseID: courseID, TotalChapters: 10}, nil
+}
+
+func (s *stubCourseService) RevokeEnrollmentSource(userID, courseID uint, source string, sourceID uint) error {
+	s.revoked++
+	return nil
+}
+
+// stubUserRepo 用户仓储桩，没有平台管理员
+type stubUserRepo struct {
+	userRepository.UserRepositoryInterface
+}
+
+func (r *stubUserRepo) GetByID(id uint) (*userModel.User, error) {
+	return &userModel.User{ID: id, Role: userModel.RoleUser}, nil
+}
+
+// stubLedgerService 分账服务桩，累计退款记账金额
+type stubLedgerService struct {
+	ledgerService.LedgerServiceInterface
+	refunded int64
+}
+
+func (s *stubLedgerService) RecordRefund(order *orderModel.Order, refundID uint, amount int64, refundedAt time.Time) error {
+	s.refunded += amount
+	return nil
+}
+
+// stubProvider 支付渠道桩，err 不为空时退款失败
+type stubProvider struct {
+	payment.Provider
+	calls int
+	err   error
+}
+
+func (p *stubProvider) Refund(ctx context.Context, req *payment.RefundRequest) (*payment.RefundResult, error) {
+	p.calls++
+	if p.err != nil {
+		return nil, p.err
+	}
+	return &payment.RefundResult{RefundID: "RF001"}, nil
+}
+
+// refundFixture 一笔已支付订单及其退款服务
+type refundFixture struct {
+	service  RefundServiceInterface
+	refunds  *memoryRefundRepo
+	orders   *memoryOrderRepo
+	courses  *stubCourseService
+	ledger   *stubLedgerService
+	provider *stubProvider
+}
+
+func newRefundFixture(amount, refunded int64) *refundFixture {
+	paidAt := time.Now().Add(-24 * time.Hour)
+	f := &refundFixture{
+		refunds: &memoryRefundRepo{},
+		orders: &memoryOrderRepo{order: &orderModel.Order{
+			ID:             1,
+			OrderNo:        "ORD001",
+			UserID:         testStudentID,
+			CourseID:       testCourseID,
+			InstructorID:   testInstructorID,
+			Amount:         amount,
+			RefundedAmount: refunded,
+			Status:         orderModel.OrderStatusPaid,
+			PaidAt:         &paidAt,
+		}},
+		courses:  &stubCourseService{},
+		ledger:   &stubLedgerService{},
+		provider: &stubProvider{},
+	}
+	f.service = NewRefundService(f.refunds, f.orders, f.courses, nil, &stubUserRepo{}, f.ledger, f.provider, Policy{WindowDays: 7})
+	return f
+}
+
+// request 以学员身份申请退款
+func (f *refundFixture) request(t *testing.T, amount int64) *model.Refund {
+	t.Helper()
+	refund, err := f.service.RequestRefund(&RequestRefundRequest{UserID: testStudentID, OrderNo: "ORD001", Amount: amount, Reason: "课程不合适"})
+	if err != nil {
+		t.Fatalf("申请退款失败: %v", err)
+	}
+	return refund
+}
+
+func TestRequestRefund(t *testing.T) {
+	tests := []struct {
+		name       string
+		refunded   int64
+		amount     int64
+		wantAmount int64
+		wantErr    bool
+	}{
+		{"不填金额时申请全额", 0, 0, 10000, false},
+		{"部分退款", 0, 3000, 3000, false},
+		{"不填金额时申请剩余金额", 4000, 0, 6000, false},
+		{"超过剩余可退金额", 8000, 3000, 0, true},
+		{"已全额退款", 10000, 0, 0, true},
+		{"负数金额", 0, -1, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newRefundFixture(10000, tt.refunded)
+			refund, err := f.service.RequestRefund(&RequestRefundRequest{UserID: testStudentID, OrderNo: "ORD001", Amount: tt.amount, Reason: "课程不合适"})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && refund.RequestedAmount != tt.wantAmount {
+				t.Errorf("申请金额 = %d, want %d", refund.RequestedAmount, tt.wantAmount)
+			}
+		})
+	}
+}
+
+func TestApproveRefund(t *testing.T) {
+	tests := []struct {
+		name         string
+		refunded     int64 // 申请前已退款金额
+		requested    int64
+		approve      int64 // 审核时批准的金额，0 表示按申请金额
+		providerErr  error
+		wantErr      bool
+		wantRefunded int64 // 订单累计退款金额
+		wantStatus   string
+		wantRevoked  int
+	}{
+		{"部分退款保留选课", 0, 3000, 0, nil, false, 3000, orderModel.OrderStatusPaid, 0},
+		{"一次退还全部金额", 0, 0, 0, nil, false, 10000, orderModel.OrderStatusRefunded, 1},
+		{"累计退款达到订单金额视为全额", 7000, 3000, 0, nil, false, 10000, orderModel.OrderStatusRefunded, 1},
+		{"审核时减少批准金额", 0, 5000, 2000, nil, false, 2000, orderModel.OrderStatusPaid, 0},
+		{"批准金额超过剩余可退金额", 7000, 3000, 4000, nil, true, 7000, orderModel.OrderStatusPaid, 0},
+		{"支付渠道失败时恢复订单", 0, 3000, 0, errors.New("渠道超时"), true, 0, orderModel.OrderStatusPaid, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newRefundFixture(10000, tt.refunded)
+			f.provider.err = tt.providerErr
+			refund := f.request(t, tt.requested)
+
+			_, err := f.service.ApproveRefund(context.Background(), refund.ID, testInstructorID, tt.approve, "")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			order := f.orders.order
+			if order.RefundedAmount != tt.wantRefunded || order.Status != tt.wantStatus {
+				t.Errorf("订单 = (%d, %s), want (%d, %s)", order.RefundedAmount, order.Status, tt.wantRefunded, tt.wantStatus)
+			}
+			if f.courses.revoked != tt.wantRevoked {
+				t.Errorf("撤销选课 %d 次, want %d", f.courses.revoked, tt.wantRevoked)
+			}
+
+			stored, _ := f.refunds.GetByID(refund.ID)
+			wantRefundStatus := model.RefundStatusRefunded
+			if tt.wantErr {
+				// 失败后申请回到待审核，可以重新审核
+				wantRefundStatus = model.RefundStatusPending
+			}
+			if stored.Status != wantRefundStatus {
+				t.Errorf("申请状态 = %s, want %s", stored.Status, wantRefundStatus)
+			}
+			if wantLedger := tt.wantRefunded - tt.refunded; f.ledger.refunded != wantLedger {
+				t.Errorf("退款记账 %d, want %d", f.ledger.refunded, wantLedger)
+			}
+		})
+	}
+}
+
+func TestApproveRefundTwice(t *testing.T) {
+	f := newRefundFixture(10000, 0)
+	refund := f.request(t, 3000)
+
+	if _, err := f.service.ApproveRefund(context.Background(), refund.ID, testInstructorID, 0, ""); err != nil {
+		t.Fatalf("第一次审核失败: %v", err)
+	}
+	if _, err := f.service.ApproveRefund(context.Background(), refund.ID, testInstructorID, 0, ""); err == nil {
+		t.Fatal("重复审核应被拒绝")
+	}
+	if f.provider.calls != 1 {
+		t.Errorf("支付渠道退款 %d 次, want 1", f.provider.calls)
+	}
+	if f.orders.order.RefundedAmount != 3000 {
+		t.Errorf("订单累计退款 = %d, want 3000", f.orders.order.RefundedAmount)
+	}
+}
+
+func TestApproveRefundStaleOrder(t *testing.T) {
+	f := newRefundFixture(10000, 0)
+	refund := f.request(t, 6000)
+
+	// 申请提交后订单又发生了一笔退款，审核时按最新的剩余金额校验
+	f.orders.order.RefundedAmount = 5000
+	if _, err := f.service.ApproveRefund(context.Background(), refund.ID, testInstructorID, 0, ""); err == nil {
+		t.Fatal("批准金额超过最新的剩余金额时应失败")
+	}
+	if f.provider.calls != 0 {
+		t.Errorf("支付渠道退款 %d 次, want 0", f.provider.calls)
+	}
+}
+
+func TestApproveRefundPermission(t *testing.T) {
+	f := newRefundFixture(10000, 0)
+	refund := f.request(t, 3000)
+
+	if _, err := f.service.ApproveRefund(context.Background(), refund.ID, testStudentID, 0, ""); err == nil {
+		t.Fatal("学员不能审核自己的退款申请")
+	}
+	if f.provider.calls != 0 {
+		t.Errorf("支付渠道退款 %d 次, want 0", f.provider.calls)
+	}
+}
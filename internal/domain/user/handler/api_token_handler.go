@@ -0,0 +1,146 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"course-platform/internal/domain/user/model"
+	"course-platform/internal/shared/pb/userpb"
+
+	"github.com/gin-gonic/gin"
+)
+
+// CreateAPITokenRequest 创建个人访问令牌请求结构体
+type CreateAPITokenRequest struct {
+	Name          string   `json:"name" binding:"required" example:"CI 上传课件"`
+	Scopes        []string `json:"scopes" binding:"required" example:"content:upload"`
+	ExpiresInDays int      `json:"expires_in_days" example:"30"` // 1-365，默认30天
+}
+
+// ListAPITokens 获取个人访问令牌
+// @Summary 个人访问令牌列表
+// @Description 获取当前账号未吊销的个人访问令牌及可授予的权限，不返回令牌明文
+// @Tags 个人访问令牌
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} map[string]interface{} "令牌列表和可选权限"
+// @Router /me/tokens [get]
+func (h *UserHandler) ListAPITokens(c *gin.Context) {
+	resp, err := h.UserGRPCService.ListAPITokens(c.GetUint("userID"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "获取个人访问令牌失败",
+		})
+		return
+	}
+	respondAPITokens(c, resp)
+}
+
+// CreateAPIToken 创建个人访问令牌
+// @Summary 创建个人访问令牌
+// @Description 创建带权限范围和有效期的个人访问令牌，供脚本以 Authorization: Bearer <token> 调用接口；令牌明文只在本次响应中返回
+// @Tags 个人访问令牌
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body CreateAPITokenRequest true "令牌名称、权限和有效期"
+// @Success 201 {object} map[string]interface{} "令牌明文和令牌信息"
+// @Failure 400 {object} ErrorResponse "参数错误或令牌数量超过上限"
+// @Router /me/tokens [post]
+func (h *UserHandler) CreateAPIToken(c *gin.Context) {
+	var req CreateAPITokenRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "请填写令牌名称并选择权限",
+		})
+		return
+	}
+
+	resp, err := h.UserGRPCService.CreateAPIToken(c.GetUint("userID"), req.Name, req.Scopes, req.ExpiresInDays)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "创建个人访问令牌失败",
+		})
+		return
+	}
+	if resp.Code != 200 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": resp.Message,
+		})
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	c.JSON(http.StatusCreated, gin.H{
+		"message":   resp.Message,
+		"token":     resp.Token,
+		"api_token": apiTokenJSON(resp.ApiToken),
+	})
+}
+
+// RevokeAPIToken 吊销个人访问令牌
+// @Summary 吊销个人访问令牌
+// @Description 吊销指定令牌，使用该令牌的请求立即失效
+// @Tags 个人访问令牌
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "令牌ID"
+// @Success 200 {object} map[string]interface{} "令牌列表"
+// @Failure 400 {object} ErrorResponse "令牌不存在或已吊销"
+// @Router /me/tokens/{id} [delete]
+func (h *UserHandler) RevokeAPIToken(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "无效的令牌ID",
+		})
+		return
+	}
+
+	resp, err := h.UserGRPCService.RevokeAPIToken(c.GetUint("userID"), uint(id))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "吊销个人访问令牌失败",
+		})
+		return
+	}
+	respondAPITokens(c, resp)
+}
+
+// respondAPITokens 返回个人访问令牌列表和可授予的权限
+func respondAPITokens(c *gin.Context, resp *userpb.APITokensResponse) {
+	if resp.Code != 200 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": resp.Message,
+		})
+		return
+	}
+
+	tokens := make([]gin.H, 0, len(resp.Tokens))
+	for _, token := range resp.Tokens {
+		tokens = append(tokens, apiTokenJSON(token))
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"message": resp.Message,
+		"tokens":  tokens,
+		"scopes":  model.APITokenScopes,
+	})
+}
+
+// apiTokenJSON 转换个人访问令牌为响应格式
+func apiTokenJSON(token *userpb.APIToken) gin.H {
+	if token == nil {
+		return nil
+	}
+	return gin.H{
+		"id":           token.Id,
+		"name":         token.Name,
+		"prefix":       token.Prefix,
+		"scopes":       token.Scopes,
+		"created_at":   token.CreatedAt,
+		"expires_at":   token.ExpiresAt,
+		"last_used_at": token.LastUsedAt,
+		"last_used_ip": token.LastUsedIp,
+		"expired":      token.Expired,
+	}
+}
@@ -0,0 +1,413 @@
+package handler
+
+import (
+	"net/http"
+
+	"course-platform/internal/shared/pb/userpb"
+
+	"github.com/gin-gonic/gin"
+)
+
+// MFALoginRequest 登录第二步请求结构体
+type MFALoginRequest struct {
+	MFAToken string `json:"mfa_token" binding:"required"`
+	Code     string `json:"code" binding:"required" example:"123456"`
+}
+
+// MFASetupRequest 登录中绑定身份验证器请求结构体
+type MFASetupRequest struct {
+	MFAToken string `json:"mfa_token" binding:"required"`
+}
+
+// MFACodeRequest 验证码请求结构体
+type MFACodeRequest struct {
+	Code string `json:"code" binding:"required" example:"123456"`
+}
+
+// DisableMFARequest 关闭两步验证请求结构体
+type DisableMFARequest struct {
+	Password string `json:"password" binding:"required"`
+	Code     string `json:"code" binding:"required" example:"123456"`
+}
+
+// MFAPolicyRequest 设置两步验证策略请求结构体
+type MFAPolicyRequest struct {
+	Required *bool `json:"required" binding:"required"`
+}
+
+// VerifyMFALogin 登录第二步
+// @Summary 两步验证登录
+// @Description 提交登录返回的 mfa_token 和身份验证器中的验证码（或恢复码），通过后返回登录令牌
+// @Tags 两步验证
+// @Accept json
+// @Produce json
+// @Param request body MFALoginRequest true "挑战令牌和验证码"
+// @Success 200 {object} LoginResponse "登录成功"
+// @Failure 401 {object} ErrorResponse "验证码错误或已过期"
+// @Router /login/mfa [post]
+func (h *UserHandler) VerifyMFALogin(c *gin.Context) {
+	var req MFALoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "请输入验证码",
+		})
+		return
+	}
+
+	resp, err := h.UserGRPCService.VerifyMFALogin(req.MFAToken, req.Code, c.ClientIP(), c.Request.UserAgent())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "两步验证失败",
+		})
+		return
+	}
+	if resp.Code != 200 {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": resp.Message,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": resp.Message,
+		"token":   resp.Token,
+		"user":    convertLoginUser(resp.User),
+	})
+}
+
+// BeginMFALoginSetup 登录中绑定身份验证器
+// @Summary 登录时绑定身份验证器
+// @Description 账号类型要求两步验证但尚未启用时，用登录返回的 mfa_token 获取绑定二维码
+// @Tags 两步验证
+// @Accept json
+// @Produce json
+// @Param request body MFASetupRequest true "挑战令牌"
+// @Success 200 {object} map[string]interface{} "密钥和 otpauth 地址"
+// @Failure 401 {object} ErrorResponse "挑战令牌已过期"
+// @Router /login/mfa/setup [post]
+func (h *UserHandler) BeginMFALoginSetup(c *gin.Context) {
+	var req MFASetupRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "请重新登录",
+		})
+		return
+	}
+	h.beginTOTPSetup(c, 0, req.MFAToken)
+}
+
+// EnableMFALogin 登录中确认绑定
+// @Summary 登录时确认绑定
+// @Description 提交身份验证器中的验证码完成绑定，返回恢复码和登录令牌
+// @Tags 两步验证
+// @Accept json
+// @Produce json
+// @Param request body MFALoginRequest true "挑战令牌和验证码"
+// @Success 200 {object} map[string]interface{} "恢复码和登录令牌"
+// @Failure 400 {object} ErrorResponse "验证码错误"
+// @Router /login/mfa/enable [post]
+func (h *UserHandler) EnableMFALogin(c *gin.Context) {
+	var req MFALoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "请输入验证码",
+		})
+		return
+	}
+	h.enableTOTP(c, 0, req.MFAToken, req.Code)
+}
+
+// GetMFAStatus 获取两步验证状态
+// @Summary 两步验证状态
+// @Description 获取当前用户是否启用两步验证、账号类型是否强制启用以及剩余恢复码数量
+// @Tags 两步验证
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} map[string]interface{} "两步验证状态"
+// @Router /mfa [get]
+func (h *UserHandler) GetMFAStatus(c *gin.Context) {
+	resp, err := h.UserGRPCService.GetMFAStatus(c.GetUint("userID"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "获取两步验证状态失败",
+		})
+		return
+	}
+	if resp.Code != 200 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": resp.Message,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":             resp.Message,
+		"enabled":             resp.Enabled,
+		"required":            resp.Required,
+		"recovery_codes_left": resp.RecoveryCodesLeft,
+	})
+}
+
+// BeginTOTPSetup 开始绑定身份验证器
+// @Summary 获取绑定二维码
+// @Description 生成新的TOTP密钥，返回密钥和 otpauth 地址，前端据此生成二维码
+// @Tags 两步验证
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} map[string]interface{} "密钥和 otpauth 地址"
+// @Failure 400 {object} ErrorResponse "已启用两步验证"
+// @Router /mfa/totp/setup [post]
+func (h *UserHandler) BeginTOTPSetup(c *gin.Context) {
+	h.beginTOTPSetup(c, c.GetUint("userID"), "")
+}
+
+// EnableTOTP 确认绑定并启用两步验证
+// @Summary 启用两步验证
+// @Description 提交身份验证器中的验证码确认绑定，返回10个恢复码（只显示这一次）
+// @Tags 两步验证
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body MFACodeRequest true "验证码"
+// @Success 200 {object} map[string]interface{} "恢复码"
+// @Failure 400 {object} ErrorResponse "验证码错误"
+// @Router /mfa/totp/enable [post]
+func (h *UserHandler) EnableTOTP(c *gin.Context) {
+	var req MFACodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "请输入验证码",
+		})
+		return
+	}
+	h.enableTOTP(c, c.GetUint("userID"), "", req.Code)
+}
+
+// DisableTOTP 关闭两步验证
+// @Summary 关闭两步验证
+// @Description 需要当前密码和验证码（或恢复码），账号类型强制两步验证时不能关闭
+// @Tags 两步验证
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body DisableMFARequest true "密码和验证码"
+// @Success 200 {object} map[string]interface{} "已关闭"
+// @Failure 400 {object} ErrorResponse "密码或验证码错误"
+// @Router /mfa/totp/disable [post]
+func (h *UserHandler) DisableTOTP(c *gin.Context) {
+	var req DisableMFARequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "请输入密码和验证码",
+		})
+		return
+	}
+
+	resp, err := h.UserGRPCService.DisableTOTP(c.GetUint("userID"), req.Password, req.Code)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "关闭两步验证失败",
+		})
+		return
+	}
+	if resp.Code != 200 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": resp.Message,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": resp.Message,
+	})
+}
+
+// RegenerateRecoveryCodes 重新生成恢复码
+// @Summary 重新生成恢复码
+// @Description 提交身份验证器中的验证码，生成10个新恢复码，旧恢复码全部失效
+// @Tags 两步验证
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body MFACodeRequest true "验证码"
+// @Success 200 {object} map[string]interface{} "恢复码"
+// @Failure 400 {object} ErrorResponse "验证码错误"
+// @Router /mfa/recovery-codes [post]
+func (h *UserHandler) RegenerateRecoveryCodes(c *gin.Context) {
+	var req MFACodeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "请输入验证码",
+		})
+		return
+	}
+
+	resp, err := h.UserGRPCService.RegenerateRecoveryCodes(c.GetUint("userID"), req.Code)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "生成恢复码失败",
+		})
+		return
+	}
+	if resp.Code != 200 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": resp.Message,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":        resp.Message,
+		"recovery_codes": resp.RecoveryCodes,
+	})
+}
+
+// ListMFAPolicies 获取两步验证策略
+// @Summary 两步验证策略
+// @Description 管理员查看各角色（user、instructor、admin）是否强制两步验证
+// @Tags 两步验证
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} map[string]interface{} "策略列表"
+// @Failure 403 {object} ErrorResponse "不是管理员"
+// @Router /admin/mfa/policies [get]
+func (h *UserHandler) ListMFAPolicies(c *gin.Context) {
+	resp, err := h.UserGRPCService.ListMFAPolicies(c.GetUint("userID"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "获取两步验证策略失败",
+		})
+		return
+	}
+	respondMFAPolicies(c, resp)
+}
+
+// SetMFAPolicy 设置两步验证策略
+// @Summary 设置两步验证策略
+// @Description 管理员设置某个角色是否强制两步验证，该角色未启用的用户下次登录时必须先绑定身份验证器
+// @Tags 两步验证
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param role path string true "角色：user、instructor、admin"
+// @Param request body MFAPolicyRequest true "是否强制"
+// @Success 200 {object} map[string]interface{} "策略列表"
+// @Failure 403 {object} ErrorResponse "不是管理员"
+// @Router /admin/mfa/policies/{role} [put]
+func (h *UserHandler) SetMFAPolicy(c *gin.Context) {
+	var req MFAPolicyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "请求格式错误",
+		})
+		return
+	}
+
+	resp, err := h.UserGRPCService.SetMFAPolicy(c.GetUint("userID"), c.Param("role"), *req.Required)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "设置两步验证策略失败",
+		})
+		return
+	}
+	respondMFAPolicies(c, resp)
+}
+
+// beginTOTPSetup 获取绑定二维码，已登录用户传 userID，登录中的用户传 mfaToken
+func (h *UserHandler) beginTOTPSetup(c *gin.Context, userID uint, mfaToken string) {
+	resp, err := h.UserGRPCService.BeginTOTPSetup(userID, mfaToken)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "获取绑定二维码失败",
+		})
+		return
+	}
+	if resp.Code != 200 {
+		c.JSON(mfaErrorStatus(resp.Code), gin.H{
+			"error": resp.Message,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":          resp.Message,
+		"secret":           resp.Secret,
+		"provisioning_uri": resp.ProvisioningUri,
+	})
+}
+
+// enableTOTP 确认绑定，登录中绑定时同时返回登录令牌
+func (h *UserHandler) enableTOTP(c *gin.Context, userID uint, mfaToken, code string) {
+	resp, err := h.UserGRPCService.EnableTOTP(userID, mfaToken, code, c.ClientIP(), c.Request.UserAgent())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "启用两步验证失败",
+		})
+		return
+	}
+	if resp.Code != 200 {
+		c.JSON(mfaErrorStatus(resp.Code), gin.H{
+			"error": resp.Message,
+		})
+		return
+	}
+
+	result := gin.H{
+		"message":        resp.Message,
+		"recovery_codes": resp.RecoveryCodes,
+	}
+	if resp.Token != "" {
+		result["token"] = resp.Token
+		result["user"] = convertLoginUser(resp.User)
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+// respondMFAPolicies 返回两步验证策略列表
+func respondMFAPolicies(c *gin.Context, resp *userpb.MFAPoliciesResponse) {
+	if resp.Code != 200 {
+		c.JSON(mfaErrorStatus(resp.Code), gin.H{
+			"error": resp.Message,
+		})
+		return
+	}
+
+	policies := make([]gin.H, 0, len(resp.Policies))
+	for _, policy := range resp.Policies {
+		policies = append(policies, gin.H{
+			"role":       policy.Role,
+			"required":   policy.Required,
+			"updated_at": policy.UpdatedAt,
+		})
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"message":  resp.Message,
+		"policies": policies,
+	})
+}
+
+// mfaErrorStatus 按业务码返回HTTP状态
+func mfaErrorStatus(code int32) int {
+	switch code {
+	case 401:
+		return http.StatusUnauthorized
+	case 403:
+		return http.StatusForbidden
+	default:
+		return http.StatusBadRequest
+	}
+}
+
+// convertLoginUser 登录成功后返回给前端的用户信息
+func convertLoginUser(user *userpb.User) gin.H {
+	return gin.H{
+		"id":          user.Id,
+		"username":    user.Username,
+		"email":       user.Email,
+		"nickname":    user.Nickname,
+		"avatar":      user.Avatar,
+		"role":        user.Role,
+		"mfa_enabled": user.MfaEnabled,
+		"created_at":  user.CreatedAt,
+	}
+}
@@ -0,0 +1,345 @@
+package handler
+
+import (
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+
+	grpcClient "course-platform/internal/infrastructure/grpc_client"
+	"course-platform/internal/infrastructure/oidc"
+	"course-platform/internal/shared/pb/userpb"
+
+	"github.com/gin-gonic/gin"
+)
+
+// 回调页面通过 sessionStorage 把结果交给登录页和用户中心的键
+const (
+	oidcLoginResultKey = "oidcLoginResult"
+	oidcLinkResultKey  = "oidcLinkResult"
+)
+
+// OIDCHandler 单点登录处理器，网关作为 OIDC 依赖方完成授权码 + PKCE 流程，账号的查找、创建和绑定由用户服务完成
+type OIDCHandler struct {
+	UserGRPCService *grpcClient.UserGRPCClientService
+	providers       []*oidc.Provider
+	states          oidc.StateStore
+}
+
+// NewOIDCHandler 创建单点登录处理器
+func NewOIDCHandler(userGRPCService *grpcClient.UserGRPCClientService, providers []*oidc.Provider, states oidc.StateStore) *OIDCHandler {
+	return &OIDCHandler{
+		UserGRPCService: userGRPCService,
+		providers:       providers,
+		states:          states,
+	}
+}
+
+// ListProviders 获取可用的身份提供者
+// @Summary 单点登录提供者
+// @Description 获取已配置的身份提供者，登录页据此显示单点登录按钮
+// @Tags 单点登录
+// @Produce json
+// @Success 200 {object} map[string]interface{} "提供者列表"
+// @Router /oidc/providers [get]
+func (h *OIDCHandler) ListProviders(c *gin.Context) {
+	providers := make([]gin.H, 0, len(h.providers))
+	for _, p := range h.providers {
+		providers = append(providers, gin.H{
+			"name":         p.Name(),
+			"display_name": p.DisplayName(),
+		})
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"message":   "获取成功",
+		"providers": providers,
+	})
+}
+
+// Login 跳转到身份提供者登录
+// @Summary 单点登录
+// @Description 浏览器访问后跳转到身份提供者，登录完成后回到回调地址
+// @Tags 单点登录
+// @Param provider path string true "提供者名称"
+// @Param redirect query string false "登录后跳转的站内地址"
+// @Success 302 {string} string "跳转到身份提供者"
+// @Router /auth/oidc/{provider}/login [get]
+func (h *OIDCHandler) Login(c *gin.Context) {
+	provider := h.provider(c.Param("provider"))
+	if provider == nil {
+		redirectLoginError(c, "不支持的登录方式")
+		return
+	}
+
+	authURL, err := h.authCodeURL(c, provider, &oidc.AuthRequest{
+		Mode:     oidc.ModeLogin,
+		Redirect: safeRedirect(c.Query("redirect")),
+	})
+	if err != nil {
+		log.Printf("❌ API Gateway: 单点登录跳转失败 - %v", err)
+		redirectLoginError(c, "暂时无法连接身份提供者，请稍后重试")
+		return
+	}
+	c.Redirect(http.StatusFound, authURL)
+}
+
+// BeginLink 开始绑定身份提供者账号
+// @Summary 绑定单点登录
+// @Description 返回身份提供者的授权地址，前端跳转过去登录后自动绑定到当前账号
+// @Tags 单点登录
+// @Produce json
+// @Security BearerAuth
+// @Param provider path string true "提供者名称"
+// @Success 200 {object} map[string]interface{} "授权地址"
+// @Router /oidc/{provider}/link [post]
+func (h *OIDCHandler) BeginLink(c *gin.Context) {
+	provider := h.provider(c.Param("provider"))
+	if provider == nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "不支持的登录方式",
+		})
+		return
+	}
+
+	authURL, err := h.authCodeURL(c, provider, &oidc.AuthRequest{
+		Mode:   oidc.ModeLink,
+		UserID: c.GetUint("userID"),
+	})
+	if err != nil {
+		log.Printf("❌ API Gateway: 单点登录绑定跳转失败 - %v", err)
+		c.JSON(http.StatusBadGateway, gin.H{
+			"error": "暂时无法连接身份提供者，请稍后重试",
+		})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"message":       "请在身份提供者页面完成登录",
+		"authorize_url": authURL,
+	})
+}
+
+// Callback 身份提供者回调
+// @Summary 单点登录回调
+// @Description 校验 state、用授权码和 PKCE code_verifier 换取 ID Token，校验通过后登录或绑定账号
+// @Tags 单点登录
+// @Param provider path string true "提供者名称"
+// @Param code query string false "授权码"
+// @Param state query string true "请求标识"
+// @Success 200 {string} string "回调页面"
+// @Router /auth/oidc/{provider}/callback [get]
+func (h *OIDCHandler) Callback(c *gin.Context) {
+	req, err := h.states.Take(c.Request.Context(), c.Query("state"))
+	if err != nil {
+		redirectLoginError(c, err.Error())
+		return
+	}
+	provider := h.provider(c.Param("provider"))
+	if provider == nil || provider.Name() != req.Provider {
+		h.finishWithError(c, req, "登录请求无效，请重新登录")
+		return
+	}
+	if idpError := c.Query("error"); idpError != "" {
+		log.Printf("⚠️ API Gateway: 身份提供者返回错误 - %s %s", idpError, c.Query("error_description"))
+		message := "身份提供者登录失败"
+		if idpError == "access_denied" {
+			message = "已取消登录"
+		}
+		h.finishWithError(c, req, message)
+		return
+	}
+
+	claims, err := provider.Exchange(c.Request.Context(), c.Query("code"), req.CodeVerifier, req.Nonce)
+	if err != nil {
+		log.Printf("❌ API Gateway: 单点登录校验失败 - %v", err)
+		h.finishWithError(c, req, "身份校验失败，请重新登录")
+		return
+	}
+	profile := &userpb.OIDCProfile{
+		Provider:          provider.Name(),
+		Subject:           claims.Subject,
+		Email:             claims.Email,
+		EmailVerified:     claims.EmailVerified,
+		Name:              claims.Name,
+		PreferredUsername: claims.PreferredUsername,
+		Picture:           claims.Picture,
+		TrustEmail:        provider.TrustEmail(),
+	}
+
+	if req.Mode == oidc.ModeLink {
+		h.finishLink(c, req, profile)
+		return
+	}
+	h.finishLogin(c, req, profile)
+}
+
+// ListIdentities 获取已绑定的身份
+// @Summary 已绑定的单点登录
+// @Description 获取当前账号绑定的身份提供者账号
+// @Tags 单点登录
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} map[string]interface{} "绑定列表"
+// @Router /oidc/identities [get]
+func (h *OIDCHandler) ListIdentities(c *gin.Context) {
+	resp, err := h.UserGRPCService.ListOIDCIdentities(c.GetUint("userID"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "获取单点登录绑定失败",
+		})
+		return
+	}
+	respondOIDCIdentities(c, resp)
+}
+
+// Unlink 解除绑定
+// @Summary 解除单点登录绑定
+// @Description 解除当前账号与身份提供者账号的绑定，通过单点登录自动创建的账号可先用找回密码设置密码
+// @Tags 单点登录
+// @Produce json
+// @Security BearerAuth
+// @Param provider path string true "提供者名称"
+// @Success 200 {object} map[string]interface{} "绑定列表"
+// @Router /oidc/identities/{provider} [delete]
+func (h *OIDCHandler) Unlink(c *gin.Context) {
+	resp, err := h.UserGRPCService.UnlinkOIDCIdentity(c.GetUint("userID"), c.Param("provider"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "解除绑定失败",
+		})
+		return
+	}
+	respondOIDCIdentities(c, resp)
+}
+
+// provider 按名称查找身份提供者
+func (h *OIDCHandler) provider(name string) *oidc.Provider {
+	for _, p := range h.providers {
+		if p.Name() == name {
+			return p
+		}
+	}
+	return nil
+}
+
+// authCodeURL 生成 state、nonce 和 PKCE code_verifier，保存后返回授权地址
+func (h *OIDCHandler) authCodeURL(c *gin.Context, provider *oidc.Provider, req *oidc.AuthRequest) (string, error) {
+	state, err := oidc.RandomString()
+	if err != nil {
+		return "", err
+	}
+	if req.Nonce, err = oidc.RandomString(); err != nil {
+		return "", err
+	}
+	if req.CodeVerifier, err = oidc.RandomString(); err != nil {
+		return "", err
+	}
+	req.Provider = provider.Name()
+
+	authURL, err := provider.AuthCodeURL(c.Request.Context(), state, req.Nonce, req.CodeVerifier)
+	if err != nil {
+		return "", err
+	}
+	if err := h.states.Save(c.Request.Context(), state, req); err != nil {
+		return "", err
+	}
+	return authURL, nil
+}
+
+// finishLogin 由用户服务完成登录，结果交给登录页处理（与密码登录的响应相同，可能需要两步验证）
+func (h *OIDCHandler) finishLogin(c *gin.Context, req *oidc.AuthRequest, profile *userpb.OIDCProfile) {
+	resp, err := h.UserGRPCService.LoginWithOIDC(profile, c.ClientIP(), c.Request.UserAgent())
+	if err != nil {
+		redirectLoginError(c, "登录失败，请稍后重试")
+		return
+	}
+	if resp.Code != 200 {
+		redirectLoginError(c, resp.Message)
+		return
+	}
+
+	result := gin.H{"message": resp.Message}
+	if resp.MfaToken != "" {
+		result["mfa_required"] = true
+		result["mfa_token"] = resp.MfaToken
+		result["mfa_setup_required"] = resp.MfaSetupRequired
+	} else {
+		log.Printf("✅ API Gateway: 单点登录成功 - 用户: %s", resp.User.Username)
+		result["token"] = resp.Token
+		result["user"] = convertLoginUser(resp.User)
+	}
+
+	loginURL := "/login"
+	if req.Redirect != "" {
+		loginURL += "?redirect=" + url.QueryEscape(req.Redirect)
+	}
+	renderOIDCResult(c, oidcLoginResultKey, result, loginURL)
+}
+
+// finishLink 绑定到发起请求的账号，结果交给用户中心显示
+func (h *OIDCHandler) finishLink(c *gin.Context, req *oidc.AuthRequest, profile *userpb.OIDCProfile) {
+	resp, err := h.UserGRPCService.LinkOIDCIdentity(req.UserID, profile)
+	if err != nil {
+		h.finishWithError(c, req, "绑定失败，请稍后重试")
+		return
+	}
+	if resp.Code != 200 {
+		h.finishWithError(c, req, resp.Message)
+		return
+	}
+	renderOIDCResult(c, oidcLinkResultKey, gin.H{"message": resp.Message, "provider": profile.Provider}, "/dashboard")
+}
+
+// finishWithError 按请求用途返回错误：登录回到登录页，绑定回到用户中心
+func (h *OIDCHandler) finishWithError(c *gin.Context, req *oidc.AuthRequest, message string) {
+	if req.Mode == oidc.ModeLink {
+		renderOIDCResult(c, oidcLinkResultKey, gin.H{"error": message}, "/dashboard")
+		return
+	}
+	redirectLoginError(c, message)
+}
+
+// renderOIDCResult 渲染回调页面，页面把结果存入 sessionStorage 后跳转，令牌不出现在地址栏中
+func renderOIDCResult(c *gin.Context, storageKey string, result gin.H, redirect string) {
+	c.HTML(http.StatusOK, "oidc-callback.html", gin.H{
+		"StorageKey": storageKey,
+		"Result":     result,
+		"Redirect":   redirect,
+	})
+}
+
+// respondOIDCIdentities 返回已绑定的身份
+func respondOIDCIdentities(c *gin.Context, resp *userpb.OIDCIdentitiesResponse) {
+	if resp.Code != 200 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": resp.Message,
+		})
+		return
+	}
+
+	identities := make([]gin.H, 0, len(resp.Identities))
+	for _, identity := range resp.Identities {
+		identities = append(identities, gin.H{
+			"provider":      identity.Provider,
+			"email":         identity.Email,
+			"linked_at":     identity.LinkedAt,
+			"last_login_at": identity.LastLoginAt,
+		})
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"message":    resp.Message,
+		"identities": identities,
+	})
+}
+
+// redirectLoginError 回到登录页并显示错误
+func redirectLoginError(c *gin.Context, message string) {
+	c.Redirect(http.StatusFound, "/login?error="+url.QueryEscape(message))
+}
+
+// safeRedirect 只允许跳转到站内地址，防止被利用为开放重定向
+func safeRedirect(redirect string) string {
+	if !strings.HasPrefix(redirect, "/") || strings.HasPrefix(redirect, "//") || strings.HasPrefix(redirect, "/\\") {
+		return ""
+	}
+	return redirect
+}
@@ -0,0 +1,104 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"course-platform/internal/shared/pb/userpb"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ListSessions 获取登录设备
+// @Summary 登录设备列表
+// @Description 获取当前账号所有有效的登录会话，包含设备、IP和最近活动时间，current 标记当前设备
+// @Tags 登录设备
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} map[string]interface{} "会话列表"
+// @Router /me/sessions [get]
+func (h *UserHandler) ListSessions(c *gin.Context) {
+	resp, err := h.UserGRPCService.ListSessions(c.GetUint("userID"), c.GetString("sessionID"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "获取登录设备失败",
+		})
+		return
+	}
+	respondSessions(c, resp)
+}
+
+// RevokeSession 注销指定登录设备
+// @Summary 注销登录设备
+// @Description 注销指定会话，该设备上的登录令牌立即失效；注销当前会话相当于退出登录
+// @Tags 登录设备
+// @Produce json
+// @Security BearerAuth
+// @Param id path int true "会话ID"
+// @Success 200 {object} map[string]interface{} "会话列表"
+// @Failure 400 {object} ErrorResponse "会话不存在或已注销"
+// @Router /me/sessions/{id} [delete]
+func (h *UserHandler) RevokeSession(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "无效的会话ID",
+		})
+		return
+	}
+
+	resp, err := h.UserGRPCService.RevokeSession(c.GetUint("userID"), uint(id), c.GetString("sessionID"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "注销登录设备失败",
+		})
+		return
+	}
+	respondSessions(c, resp)
+}
+
+// RevokeOtherSessions 注销其他登录设备
+// @Summary 注销其他设备
+// @Description 注销除当前设备外的全部会话
+// @Tags 登录设备
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} map[string]interface{} "会话列表"
+// @Router /me/sessions/revoke-others [post]
+func (h *UserHandler) RevokeOtherSessions(c *gin.Context) {
+	resp, err := h.UserGRPCService.RevokeOtherSessions(c.GetUint("userID"), c.GetString("sessionID"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "注销其他设备失败",
+		})
+		return
+	}
+	respondSessions(c, resp)
+}
+
+// respondSessions 返回登录会话列表
+func respondSessions(c *gin.Context, resp *userpb.SessionsResponse) {
+	if resp.Code != 200 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": resp.Message,
+		})
+		return
+	}
+
+	sessions := make([]gin.H, 0, len(resp.Sessions))
+	for _, session := range resp.Sessions {
+		sessions = append(sessions, gin.H{
+			"id":           session.Id,
+			"device":       session.Device,
+			"user_agent":   session.UserAgent,
+			"ip":           session.Ip,
+			"created_at":   session.CreatedAt,
+			"last_seen_at": session.LastSeenAt,
+			"current":      session.Current,
+		})
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"message":  resp.Message,
+		"sessions": sessions,
+	})
+}
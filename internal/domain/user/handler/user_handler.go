@@ -1,7 +1,10 @@
 ﻿package handler
 
 import (
+	"errors"
 	"net/http"
+	"strconv"
+	"time"
 
 	"course-platform/internal/domain/user/service"
 	grpcClient "course-platform/internal/infrastructure/grpc_client"
@@ -62,6 +65,22 @@ type UpdateProfileRequest struct {
 	Bio      string `json:"bio" example:"这是我的个人简介"`
 }
 
+// VerifyEmailRequest 验证邮箱请求结构体
+type VerifyEmailRequest struct {
+	Token string `json:"token" binding:"required"`
+}
+
+// ForgotPasswordRequest 申请重置密码请求结构体
+type ForgotPasswordRequest struct {
+	Email string `json:"email" binding:"required" example:"user@example.com"`
+}
+
+// ResetPasswordRequest 重置密码请求结构体
+type ResetPasswordRequest struct {
+	Token       string `json:"token" binding:"required"`
+	NewPassword string `json:"new_password" binding:"required,min=8,max=30" example:"newpassword123"`
+}
+
 // ChangePasswordRequest 修改密码请求结构体
 type ChangePasswordRequest struct {
 	CurrentPassword string `json:"currentPassword" binding:"required" example:"oldpassword123"`
@@ -104,8 +123,8 @@ func (h *UserHandler) Register(c *gin.Context) {
 		return
 	}
 
-	// 呼叫gRPC服務進行註冊
-	user, err := h.UserGRPCService.Register(req.Username, req.Email, req.Password, req.Nickname)
+	// 呼叫gRPC服務進行註冊，郵件語言取自瀏覽器的 Accept-Language
+	user, err := h.UserGRPCService.Register(req.Username, req.Email, req.Password, req.Nickname, c.GetHeader("Accept-Language"))
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error": err.Error(),
@@ -136,6 +155,8 @@ func (h *UserHandler) Register(c *gin.Context) {
 // @Success 200 {object} LoginResponse "登入成功"
 // @Failure 400 {object} ErrorResponse "請求錯誤"
 // @Failure 401 {object} ErrorResponse "認證失敗"
+// @Failure 423 {object} map[string]interface{} "賬號因多次登入失敗暫時鎖定"
+// @Failure 429 {object} map[string]interface{} "來源IP登入失敗過多，暫時限制"
 // @Router /login [post]
 func (h *UserHandler) Login(c *gin.Context) {
 	var req LoginRequest
@@ -153,7 +174,12 @@ func (h *UserHandler) Login(c *gin.Context) {
 	log.Printf("🌐 API Gateway: 处理登录请求 - 标识符: %s", req.Identifier)
 
 	// 調用gRPC服務進行登入 (使用identifier作为username)
-	token, user, err := h.UserGRPCService.Login(req.Identifier, req.Password)
+	resp, err := h.UserGRPCService.Login(req.Identifier, req.Password, c.ClientIP(), c.Request.UserAgent())
+	var locked *grpcClient.LoginLockedError
+	if errors.As(err, &locked) {
+		respondLoginLocked(c, locked)
+		return
+	}
 	if err != nil {
 		log.Printf("❌ API Gateway: 登录失败 - %v", err)
 		c.JSON(http.StatusUnauthorized, gin.H{
@@ -162,22 +188,47 @@ func (h *UserHandler) Login(c *gin.Context) {
 		return
 	}
 
-	log.Printf("✅ API Gateway: 登录成功 - 用户: %s", user.Username)
+	// 啟用兩步驗證的賬號需要再提交驗證碼
+	if resp.MfaToken != "" {
+		c.JSON(http.StatusOK, gin.H{
+			"message":            resp.Message,
+			"mfa_required":       true,
+			"mfa_token":          resp.MfaToken,
+			"mfa_setup_required": resp.MfaSetupRequired,
+		})
+		return
+	}
+
+	log.Printf("✅ API Gateway: 登录成功 - 用户: %s", resp.User.Username)
 
 	// 返回成功結果
 	c.JSON(http.StatusOK, gin.H{
 		"message": "登入成功",
-		"token":   token,
-		"user": gin.H{
-			"id":         user.ID,
-			"username":   user.Username,
-			"nickname":   user.Nickname,
-			"avatar":     user.Avatar,
-			"created_at": user.CreatedAt,
-		},
+		"token":   resp.Token,
+		"user":    convertLoginUser(resp.User),
 	})
 }
 
+// respondLoginLocked 登入被限制：賬號鎖定返回 423，來源IP受限返回 429，並帶上 Retry-After
+func respondLoginLocked(c *gin.Context, locked *grpcClient.LoginLockedError) {
+	status := http.StatusTooManyRequests
+	if locked.AccountLocked {
+		status = http.StatusLocked
+	}
+
+	body := gin.H{
+		"error": locked.Message,
+		"code":  locked.Reason,
+	}
+	if !locked.LockedUntil.IsZero() {
+		retryAfter := int(time.Until(locked.LockedUntil).Seconds()) + 1
+		c.Header("Retry-After", strconv.Itoa(max(retryAfter, 1)))
+		body["locked_until"] = locked.LockedUntil
+		body["retry_after"] = max(retryAfter, 1)
+	}
+	c.JSON(status, body)
+}
+
 // GetUserResponse 獲取用戶響應結構體
 type GetUserResponse struct {
 	Message string   `json:"message" example:"獲取用戶信息成功"`
@@ -263,15 +314,18 @@ func (h *UserHandler) GetMe(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{
 		"message": "获取当前用户信息成功",
 		"user": gin.H{
-			"id":         user.ID,
-			"username":   user.Username,
-			"email":      user.Email,
-			"nickname":   user.Nickname,
-			"avatar":     user.Avatar,
-			"phone":      user.Phone,
-			"bio":        user.Bio,
-			"created_at": user.CreatedAt,
-			"updated_at": user.UpdatedAt,
+			"id":             user.ID,
+			"username":       user.Username,
+			"email":          user.Email,
+			"nickname":       user.Nickname,
+			"avatar":         user.Avatar,
+			"phone":          user.Phone,
+			"bio":            user.Bio,
+			"email_verified": user.IsEmailVerified(),
+			"mfa_enabled":    user.MFAEnabled,
+			"role":           user.Role,
+			"created_at":     user.CreatedAt,
+			"updated_at":     user.UpdatedAt,
 		},
 		"auth_info": gin.H{
 			"token_username": username,
@@ -304,6 +358,59 @@ func (h *UserHandler) DashboardPage(c *gin.Context) {
 	})
 }
 
+// VerifyEmailPage 邮箱验证页面，打开邮件中的链接即完成验证
+func (h *UserHandler) VerifyEmailPage(c *gin.Context) {
+	token := c.Query("token")
+	if token == "" {
+		c.HTML(http.StatusBadRequest, "verify-email.html", gin.H{
+			"Title": "邮箱验证",
+			"Error": "验证链接无效",
+		})
+		return
+	}
+
+	resp, err := h.UserGRPCService.VerifyEmail(token)
+	if err != nil {
+		c.HTML(http.StatusInternalServerError, "verify-email.html", gin.H{
+			"Title": "邮箱验证",
+			"Error": "服务暂时不可用，请稍后重试",
+		})
+		return
+	}
+	if resp.Code != 200 {
+		c.HTML(http.StatusBadRequest, "verify-email.html", gin.H{
+			"Title": "邮箱验证",
+			"Error": resp.Message,
+		})
+		return
+	}
+
+	c.HTML(http.StatusOK, "verify-email.html", gin.H{
+		"Title": "邮箱验证",
+		"Email": resp.User.Email,
+	})
+}
+
+// ForgotPasswordPage 找回密码页面
+func (h *UserHandler) ForgotPasswordPage(c *gin.Context) {
+	c.HTML(http.StatusOK, "password-reset.html", gin.H{
+		"Title": "找回密码",
+	})
+}
+
+// ResetPasswordPage 重置密码页面，令牌在提交时才校验
+func (h *UserHandler) ResetPasswordPage(c *gin.Context) {
+	token := c.Query("token")
+	if token == "" {
+		c.Redirect(http.StatusFound, "/forgot-password")
+		return
+	}
+	c.HTML(http.StatusOK, "password-reset.html", gin.H{
+		"Title": "重置密码",
+		"Token": token,
+	})
+}
+
 // CreatorDashboardPage 渲染创作者工作台页面
 func (h *UserHandler) CreatorDashboardPage(c *gin.Context) {
 	c.HTML(http.StatusOK, "creator-dashboard.html", gin.H{
@@ -347,6 +454,163 @@ func (h *UserHandler) ValidateToken(c *gin.Context) {
 	})
 }
 
+// VerifyEmail 验证邮箱
+// @Summary 验证邮箱
+// @Description 使用验证邮件中的令牌验证邮箱，无需登录
+// @Tags 用户管理
+// @Accept json
+// @Produce json
+// @Param request body VerifyEmailRequest true "验证令牌"
+// @Success 200 {object} map[string]interface{} "验证成功"
+// @Failure 400 {object} ErrorResponse "链接无效或已过期"
+// @Router /verify-email [post]
+func (h *UserHandler) VerifyEmail(c *gin.Context) {
+	var req VerifyEmailRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "请求格式错误",
+		})
+		return
+	}
+
+	resp, err := h.UserGRPCService.VerifyEmail(req.Token)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "验证邮箱失败",
+		})
+		return
+	}
+	if resp.Code != 200 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": resp.Message,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": resp.Message,
+		"user": gin.H{
+			"id":             resp.User.Id,
+			"email":          resp.User.Email,
+			"email_verified": resp.User.EmailVerified,
+		},
+	})
+}
+
+// ResendVerification 重新发送验证邮件
+// @Summary 重新发送验证邮件
+// @Description 给当前用户重新发送邮箱验证邮件，两次发送至少间隔2分钟
+// @Tags 用户管理
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} map[string]interface{} "发送成功"
+// @Failure 400 {object} ErrorResponse "邮箱已验证"
+// @Failure 429 {object} ErrorResponse "发送过于频繁"
+// @Router /verify-email/resend [post]
+func (h *UserHandler) ResendVerification(c *gin.Context) {
+	resp, err := h.UserGRPCService.ResendVerification(c.GetUint("userID"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "发送验证邮件失败",
+		})
+		return
+	}
+
+	switch resp.Code {
+	case 200:
+		c.JSON(http.StatusOK, gin.H{
+			"message": resp.Message,
+		})
+	case 429:
+		c.Header("Retry-After", strconv.Itoa(int(resp.RetryAfterSeconds)))
+		c.JSON(http.StatusTooManyRequests, gin.H{
+			"error":       resp.Message,
+			"retry_after": resp.RetryAfterSeconds,
+		})
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": resp.Message,
+		})
+	}
+}
+
+// ForgotPassword 申请重置密码
+// @Summary 申请重置密码
+// @Description 向邮箱发送一次性重置链接（30分钟内有效），无论邮箱是否注册都返回相同结果
+// @Tags 用户管理
+// @Accept json
+// @Produce json
+// @Param request body ForgotPasswordRequest true "注册邮箱"
+// @Success 200 {object} map[string]interface{} "已受理"
+// @Failure 400 {object} ErrorResponse "请求错误"
+// @Router /password/forgot [post]
+func (h *UserHandler) ForgotPassword(c *gin.Context) {
+	var req ForgotPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "请输入邮箱地址",
+		})
+		return
+	}
+
+	resp, err := h.UserGRPCService.ForgotPassword(req.Email)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "申请重置密码失败",
+		})
+		return
+	}
+	if resp.Code != 200 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": resp.Message,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": resp.Message,
+	})
+}
+
+// ResetPassword 重置密码
+// @Summary 重置密码
+// @Description 使用重置邮件中的令牌设置新密码，令牌只能使用一次，成功后该账号已登录的会话全部失效
+// @Tags 用户管理
+// @Accept json
+// @Produce json
+// @Param request body ResetPasswordRequest true "重置令牌和新密码"
+// @Success 200 {object} map[string]interface{} "重置成功"
+// @Failure 400 {object} ErrorResponse "链接无效或已过期"
+// @Router /password/reset [post]
+func (h *UserHandler) ResetPassword(c *gin.Context) {
+	var req ResetPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error":   "请求格式错误",
+			"details": err.Error(),
+		})
+		return
+	}
+
+	resp, err := h.UserGRPCService.ResetPassword(req.Token, req.NewPassword)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "重置密码失败",
+		})
+		return
+	}
+	if resp.Code != 200 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": resp.Message,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": resp.Message,
+	})
+}
+
 // Analytics 处理用户行为分析数据
 // @Summary 用户行为分析
 // @Description 记录用户行为分析数据
@@ -471,39 +735,3 @@ func (h *UserHandler) ChangePassword(c *gin.Context) {
 		"message": "密码修改成功",
 	})
 }
-
-// GetCreatorStats 获取创作者统计信息
-// @Summary 获取创作者统计
-// @Description 获取当前用户的创作者统计信息，包括课程数量、学员数量、收入等
-// @Tags 创作者
-// @Accept json
-// @Produce json
-// @Security BearerAuth
-// @Success 200 {object} map[string]interface{} "统计信息"
-// @Failure 401 {object} ErrorResponse "未授权"
-// @Router /creator/stats [get]
-func (h *UserHandler) GetCreatorStats(c *gin.Context) {
-	// 从中间件上下文中获取用户信息
-	userID, _, exists := middleware.GetUserFromContext(c)
-	if !exists {
-		c.JSON(http.StatusUnauthorized, gin.H{
-			"error": "获取用户身份失败",
-		})
-		return
-	}
-
-	// 模拟创作者统计数据（实际项目中应该从数据库获取）
-	stats := gin.H{
-		"total_courses":       3,
-		"total_students":      128,
-		"total_revenue":       2450.50,
-		"active_courses":      2,
-		"draft_courses":       1,
-		"this_month_students": 25,
-		"this_month_revenue":  320.00,
-	}
-
-	log.Printf("📊 获取创作者统计信息成功 - 用户ID: %d", userID)
-
-	c.JSON(http.StatusOK, stats)
-}
@@ -0,0 +1,71 @@
+package model
+
+import (
+	"strings"
+	"time"
+)
+
+// APITokenPrefix 个人访问令牌的前缀，认证中间件据此区分个人访问令牌和登录令牌
+const APITokenPrefix = "cpat_"
+
+// 个人访问令牌可授予的权限
+const (
+	ScopeCoursesRead   = "courses:read"   // 查看课程和章节
+	ScopeCoursesWrite  = "courses:write"  // 创建、修改、发布课程和章节
+	ScopeContentRead   = "content:read"   // 查看和下载课程文件
+	ScopeContentUpload = "content:upload" // 上传、替换、删除课程文件
+)
+
+// APITokenScope 权限及说明，用于校验和前端展示
+type APITokenScope struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// APITokenScopes 全部可授予的权限
+var APITokenScopes = []APITokenScope{
+	{Name: ScopeCoursesRead, Description: "查看课程和章节"},
+	{Name: ScopeCoursesWrite, Description: "创建、修改、发布课程和章节"},
+	{Name: ScopeContentRead, Description: "查看和下载课程文件"},
+	{Name: ScopeContentUpload, Description: "上传、替换、删除课程文件"},
+}
+
+// IsValidAPITokenScope 检查权限名称是否有效
+func IsValidAPITokenScope(scope string) bool {
+	for _, s := range APITokenScopes {
+		if s.Name == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// APIToken 个人访问令牌，供脚本和持续集成调用接口，数据库中只保存令牌哈希
+type APIToken struct {
+	ID         uint       `gorm:"primarykey" json:"id"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UserID     uint       `gorm:"not null;index" json:"user_id"`
+	Name       string     `gorm:"size:100;not null" json:"name"`         // 用户填写的用途说明
+	Prefix     string     `gorm:"size:16;not null" json:"prefix"`        // 令牌开头几位，便于用户辨认
+	TokenHash  string     `gorm:"size:64;not null;uniqueIndex" json:"-"` // 令牌的 SHA-256 哈希
+	Scopes     string     `gorm:"size:255;not null" json:"scopes"`       // 以空格分隔的权限
+	ExpiresAt  time.Time  `gorm:"not null" json:"expires_at"`            // 过期时间，必须设置
+	LastUsedAt *time.Time `json:"last_used_at"`                          // 最近一次使用时间，按分钟级间隔更新
+	LastUsedIP string     `gorm:"size:64" json:"last_used_ip"`           // 最近一次使用的来源IP
+	RevokedAt  *time.Time `json:"revoked_at"`                            // 吊销时间，为空表示未吊销
+}
+
+// TableName 指定表名
+func (APIToken) TableName() string {
+	return "api_tokens"
+}
+
+// ScopeList 权限列表
+func (t *APIToken) ScopeList() []string {
+	return strings.Fields(t.Scopes)
+}
+
+// IsActive 令牌是否可用
+func (t *APIToken) IsActive(now time.Time) bool {
+	return t.RevokedAt == nil && now.Before(t.ExpiresAt)
+}
@@ -0,0 +1,9 @@
+package model
+
+// 登录因失败次数过多被限制时，gRPC 错误详情（ErrorInfo）中的原因和字段
+const (
+	LoginLockDomain          = "course-platform.user"
+	LoginReasonAccountLocked = "ACCOUNT_LOCKED"     // 账号连续登录失败，暂时锁定
+	LoginReasonRateLimited   = "LOGIN_RATE_LIMITED" // 同一来源IP登录失败过多，暂时限制
+	LoginLockedUntilKey      = "locked_until"       // 解除限制的时间（RFC3339）
+)
@@ -0,0 +1,49 @@
+package model
+
+import "time"
+
+// 两步验证挑战令牌的用途
+const (
+	MFAPurposeVerify = "mfa_verify" // 已启用两步验证，登录时输入验证码
+	MFAPurposeSetup  = "mfa_setup"  // 角色要求两步验证但尚未启用，登录前必须先完成绑定
+)
+
+// MFARoles 可以设置两步验证策略的角色
+var MFARoles = []string{RoleUser, RoleInstructor, RoleAdmin}
+
+// RecoveryCode 两步验证恢复码，手机丢失时代替验证码登录，只保存哈希，每个只能使用一次
+type RecoveryCode struct {
+	ID        uint       `gorm:"primarykey" json:"id"`
+	CreatedAt time.Time  `json:"created_at"`
+	UserID    uint       `gorm:"not null;index" json:"user_id"`
+	CodeHash  string     `gorm:"size:64;not null" json:"-"` // 恢复码哈希（十六进制）
+	UsedAt    *time.Time `json:"used_at"`                   // 使用时间，未使用时为空
+}
+
+// TableName 指定表名
+func (RecoveryCode) TableName() string {
+	return "mfa_recovery_codes"
+}
+
+// MFAPolicy 按角色设置的两步验证策略，没有记录的角色不强制
+type MFAPolicy struct {
+	Role      string    `gorm:"primarykey;size:20" json:"role"`
+	Required  bool      `gorm:"not null" json:"required"` // 是否强制启用两步验证
+	UpdatedBy uint      `json:"updated_by"`               // 最后修改的管理员
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TableName 指定表名
+func (MFAPolicy) TableName() string {
+	return "mfa_policies"
+}
+
+// IsValidMFARole 是否为可设置策略的角色
+func IsValidMFARole(role string) bool {
+	for _, r := range MFARoles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
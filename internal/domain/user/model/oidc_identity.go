@@ -0,0 +1,19 @@
+package model
+
+import "time"
+
+// OIDCIdentity 用户绑定的单点登录身份，同一身份提供者内 Subject 唯一，每个用户在同一提供者只能绑定一个身份
+type OIDCIdentity struct {
+	ID          uint       `gorm:"primarykey" json:"id"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UserID      uint       `gorm:"not null;uniqueIndex:idx_oidc_user_provider" json:"user_id"`
+	Provider    string     `gorm:"size:50;not null;uniqueIndex:idx_oidc_subject;uniqueIndex:idx_oidc_user_provider" json:"provider"` // 配置中的提供者名称
+	Subject     string     `gorm:"size:255;not null;uniqueIndex:idx_oidc_subject" json:"subject"`                                    // ID Token 的 sub
+	Email       string     `gorm:"size:100" json:"email"`                                                                            // 绑定时提供者返回的邮箱，仅用于展示
+	LastLoginAt *time.Time `json:"last_login_at"`                                                                                    // 最近一次通过此身份登录的时间
+}
+
+// TableName 指定表名
+func (OIDCIdentity) TableName() string {
+	return "oidc_identities"
+}
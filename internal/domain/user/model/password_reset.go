@@ -0,0 +1,23 @@
+package model
+
+import "time"
+
+// PasswordReset 密码重置令牌，只保存令牌的 SHA-256 哈希，使用一次后作废
+type PasswordReset struct {
+	ID        uint       `gorm:"primarykey" json:"id"`
+	CreatedAt time.Time  `json:"created_at"`
+	UserID    uint       `gorm:"not null;index" json:"user_id"`
+	TokenHash string     `gorm:"size:64;not null;uniqueIndex" json:"-"` // 令牌哈希（十六进制）
+	ExpiresAt time.Time  `gorm:"not null" json:"expires_at"`            // 过期时间
+	UsedAt    *time.Time `json:"used_at"`                               // 使用时间，未使用时为空
+}
+
+// TableName 指定表名
+func (PasswordReset) TableName() string {
+	return "password_resets"
+}
+
+// IsUsable 令牌是否仍可使用
+func (r *PasswordReset) IsUsable(now time.Time) bool {
+	return r.UsedAt == nil && now.Before(r.ExpiresAt)
+}
@@ -0,0 +1,28 @@
+package model
+
+import "time"
+
+// UserSession 登录会话，每次签发登录令牌时创建，令牌中的 sid 对应 SessionID
+// 注销会话后该令牌立即失效，不必等到过期
+type UserSession struct {
+	ID         uint       `gorm:"primarykey" json:"id"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UserID     uint       `gorm:"not null;index" json:"user_id"`
+	SessionID  string     `gorm:"size:64;not null;uniqueIndex" json:"-"`
+	Device     string     `gorm:"size:100" json:"device"`     // 从 User-Agent 识别的浏览器和系统
+	UserAgent  string     `gorm:"size:500" json:"user_agent"` // 登录时的 User-Agent
+	IP         string     `gorm:"size:64" json:"ip"`          // 最近一次活动的来源IP
+	LastSeenAt time.Time  `json:"last_seen_at"`               // 最近一次活动时间，按分钟级间隔更新
+	ExpiresAt  time.Time  `gorm:"index" json:"expires_at"`    // 与登录令牌的过期时间一致
+	RevokedAt  *time.Time `json:"revoked_at"`                 // 注销时间，为空表示有效
+}
+
+// TableName 指定表名
+func (UserSession) TableName() string {
+	return "user_sessions"
+}
+
+// IsActive 会话是否仍然有效
+func (s *UserSession) IsActive(now time.Time) bool {
+	return s.RevokedAt == nil && now.Before(s.ExpiresAt)
+}
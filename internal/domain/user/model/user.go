@@ -1,11 +1,28 @@
 package model
 
 import (
+	"errors"
 	"time"
 
 	"gorm.io/gorm"
 )
 
+// 用户角色
+const (
+	RoleUser       = "user"       // 普通用户（学员）
+	RoleInstructor = "instructor" // 讲师，创建第一门课程时自动升级
+	RoleAdmin      = "admin"      // 平台管理员，目前需直接在数据库中设置
+)
+
+// 邮箱验证状态，新增字段前注册的用户默认视为已验证
+const (
+	EmailStatusPending  = "pending"  // 已注册，等待点击验证链接
+	EmailStatusVerified = "verified" // 邮箱已验证
+)
+
+// ErrEmailNotVerified 邮箱未验证时不能创建课程或购买
+var ErrEmailNotVerified = errors.New("请先验证邮箱后再进行此操作")
+
 // User 用户模型
 // 遵循swagger.yaml中的用户字段定义，同时保持向后兼容
 type User struct {
@@ -19,12 +36,31 @@ type User struct {
 	PasswordHash string `gorm:"not null;size:255" json:"-"`                    // 密码哈希值，不返回给前端
 
 	// 新字段 - 基于swagger.yaml设计
-	Email     string `gorm:"uniqueIndex;not null;size:100" json:"email"` // 邮箱地址，唯一
-	Nickname  string `gorm:"size:100" json:"nickname"`                   // 用户昵称
-	AvatarURL string `gorm:"size:500" json:"avatar_url"`                 // 头像URL
-	Avatar    string `gorm:"size:500" json:"avatar"`                     // 头像URL（兼容字段）
-	Phone     string `gorm:"size:20" json:"phone"`                       // 手机号
-	Bio       string `gorm:"size:500" json:"bio"`                        // 个人简介
+	Email     string `gorm:"uniqueIndex;not null;size:100" json:"email"`     // 邮箱地址，唯一
+	Nickname  string `gorm:"size:100" json:"nickname"`                       // 用户昵称
+	AvatarURL string `gorm:"size:500" json:"avatar_url"`                     // 头像URL
+	Avatar    string `gorm:"size:500" json:"avatar"`                         // 头像URL（兼容字段）
+	Phone     string `gorm:"size:20" json:"phone"`                           // 手机号
+	Bio       string `gorm:"size:500" json:"bio"`                            // 个人简介
+	Locale    string `gorm:"size:10;not null;default:'zh-CN'" json:"locale"` // 界面和邮件语言
+
+	// 邮箱验证
+	EmailStatus        string     `gorm:"size:20;not null;default:'verified'" json:"email_status"` // 邮箱验证状态
+	EmailVerifiedAt    *time.Time `json:"email_verified_at"`                                       // 邮箱验证时间
+	VerificationSentAt *time.Time `json:"-"`                                                       // 最近一次发送验证邮件的时间，用于限制重发频率
+
+	// 登录令牌吊销时间，早于此时间签发的令牌全部失效（如重置密码后）
+	TokensRevokedAt *time.Time `json:"-"`
+
+	// 两步验证（TOTP），密钥加密保存
+	MFAEnabled        bool       `gorm:"not null" json:"mfa_enabled"` // 是否已启用两步验证
+	MFAEnabledAt      *time.Time `json:"mfa_enabled_at"`              // 启用时间
+	TOTPSecret        string     `gorm:"size:255" json:"-"`           // 已启用的TOTP密钥
+	TOTPPendingSecret string     `gorm:"size:255" json:"-"`           // 绑定中尚未确认的TOTP密钥
+	TOTPLastStep      int64      `gorm:"not null" json:"-"`           // 最近一次使用的时间步，防止验证码重放
+
+	// 权限
+	Role string `gorm:"size:20;not null;default:'user'" json:"role"` // 用户角色
 }
 
 // TableName 指定表名
@@ -74,3 +110,13 @@ func (u *User) BeforeUpdate(tx *gorm.DB) error {
 
 	return nil
 }
+
+// IsEmailVerified 邮箱是否已验证
+func (u *User) IsEmailVerified() bool {
+	return u.EmailStatus != EmailStatusPending
+}
+
+// IsAdmin 是否为平台管理员
+func (u *User) IsAdmin() bool {
+	return u.Role == RoleAdmin
+}
@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"course-platform/internal/domain/user/model"
+
+	"github.com/go-redis/redis/v8"
+	"gorm.io/gorm"
+)
+
+// apiTokenTouchInterval 最近使用时间的最小更新间隔
+const apiTokenTouchInterval = time.Minute
+
+// APITokenRepositoryInterface 个人访问令牌仓储接口
+type APITokenRepositoryInterface interface {
+	Create(token *model.APIToken) error
+	CountActive(userID uint) (int64, error)
+	ListByUser(userID uint) ([]*model.APIToken, error)
+	GetByHash(tokenHash string) (*model.APIToken, error)
+	Revoke(userID, id uint, at time.Time) (bool, error)
+	RevokeAll(userID uint, at time.Time) error
+	TouchUsed(id uint, ip string, at time.Time) error
+}
+
+// APITokenRepository 个人访问令牌仓储实现
+type APITokenRepository struct {
+	db    *gorm.DB
+	redis *redis.Client // 用于限制最近使用时间的更新频率，为空时直接按时间条件更新
+}
+
+// NewAPITokenRepository 创建个人访问令牌仓储实例
+func NewAPITokenRepository(db *gorm.DB, redis *redis.Client) APITokenRepositoryInterface {
+	return &APITokenRepository{db: db, redis: redis}
+}
+
+// Create 保存新令牌
+func (r *APITokenRepository) Create(token *model.APIToken) error {
+	if err := r.db.Create(token).Error; err != nil {
+		log.Printf("❌ Repository: 保存个人访问令牌失败 - %v", err)
+		return fmt.Errorf("保存个人访问令牌失败: %w", err)
+	}
+	return nil
+}
+
+// CountActive 统计用户未吊销且未过期的令牌数量
+func (r *APITokenRepository) CountActive(userID uint) (int64, error) {
+	var count int64
+	err := r.db.Model(&model.APIToken{}).
+		Where("user_id = ? AND revoked_at IS NULL AND expires_at > ?", userID, time.Now()).
+		Count(&count).Error
+	if err != nil {
+		return 0, fmt.Errorf("统计个人访问令牌失败: %w", err)
+	}
+	return count, nil
+}
+
+// ListByUser 获取用户未吊销的令牌（包含已过期的），最新创建的在前
+func (r *APITokenRepository) ListByUser(userID uint) ([]*model.APIToken, error) {
+	var tokens []*model.APIToken
+	err := r.db.Where("user_id = ? AND revoked_at IS NULL", userID).Order("id DESC").Find(&tokens).Error
+	if err != nil {
+		return nil, fmt.Errorf("查询个人访问令牌失败: %w", err)
+	}
+	return tokens, nil
+}
+
+// GetByHash 按令牌哈希查找，不存在时返回 nil
+func (r *APITokenRepository) GetByHash(tokenHash string) (*model.APIToken, error) {
+	var token model.APIToken
+	err := r.db.Where("token_hash = ?", tokenHash).First(&token).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("查询个人访问令牌失败: %w", err)
+	}
+	return &token, nil
+}
+
+// Revoke 吊销用户的指定令牌，令牌不存在或已吊销时返回 false
+func (r *APITokenRepository) Revoke(userID, id uint, at time.Time) (bool, error) {
+	result := r.db.Model(&model.APIToken{}).
+		Where("id = ? AND user_id = ? AND revoked_at IS NULL", id, userID).
+		Update("revoked_at", at)
+	if result.Error != nil {
+		log.Printf("❌ Repository: 吊销个人访问令牌失败 - %v", result.Error)
+		return false, fmt.Errorf("吊销个人访问令牌失败: %w", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// RevokeAll 吊销用户的全部令牌，用于注销账号
+func (r *APITokenRepository) RevokeAll(userID uint, at time.Time) error {
+	err := r.db.Model(&model.APIToken{}).
+		Where("user_id = ? AND revoked_at IS NULL", userID).
+		Update("revoked_at", at).Error
+	if err != nil {
+		log.Printf("❌ Repository: 吊销个人访问令牌失败 - %v", err)
+		return fmt.Errorf("吊销个人访问令牌失败: %w", err)
+	}
+	return nil
+}
+
+// TouchUsed 记录令牌的最近使用时间和来源IP，间隔不足 apiTokenTouchInterval 时跳过
+func (r *APITokenRepository) TouchUsed(id uint, ip string, at time.Time) error {
+	if r.redis != nil {
+		key := "user:api_token_used:" + strconv.FormatUint(uint64(id), 10)
+		first, err := r.redis.SetNX(context.Background(), key, 1, apiTokenTouchInterval).Result()
+		if err == nil && !first {
+			return nil
+		}
+	}
+	err := r.db.Model(&model.APIToken{}).
+		Where("id = ? AND (last_used_at IS NULL OR last_used_at < ?)", id, at.Add(-apiTokenTouchInterval)).
+		Updates(map[string]interface{}{"last_used_at": at, "last_used_ip": ip}).Error
+	if err != nil {
+		return fmt.Errorf("更新令牌使用时间失败: %w", err)
+	}
+	return nil
+}
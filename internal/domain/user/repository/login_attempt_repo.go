@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// LoginAttemptRepositoryInterface 登录失败次数与临时锁定仓储接口
+// key 由调用方区分维度，例如 "user:12"、"name:alice"、"ip:1.2.3.4"
+type LoginAttemptRepositoryInterface interface {
+	RecordFailure(key string, window time.Duration) (int64, error)
+	ClearFailures(key string) error
+	Lock(key string, until time.Time) error
+	GetLock(key string) (time.Time, error)
+}
+
+// LoginAttemptRepository 登录失败次数仓储实现，数据只保存在 Redis 中
+type LoginAttemptRepository struct {
+	redis *redis.Client // 为空时不限制登录
+}
+
+// NewLoginAttemptRepository 创建登录失败次数仓储实例
+func NewLoginAttemptRepository(redis *redis.Client) LoginAttemptRepositoryInterface {
+	return &LoginAttemptRepository{redis: redis}
+}
+
+// RecordFailure 记录一次登录失败，返回累计次数
+// 每次失败都会顺延过期时间，window 内没有新的失败才会清零
+func (r *LoginAttemptRepository) RecordFailure(key string, window time.Duration) (int64, error) {
+	if r.redis == nil {
+		return 0, nil
+	}
+	ctx := context.Background()
+	failuresKey := loginFailuresKey(key)
+	count, err := r.redis.Incr(ctx, failuresKey).Result()
+	if err != nil {
+		return 0, fmt.Errorf("记录登录失败次数失败: %w", err)
+	}
+	r.redis.Expire(ctx, failuresKey, window)
+	return count, nil
+}
+
+// ClearFailures 清除失败次数和锁定
+func (r *LoginAttemptRepository) ClearFailures(key string) error {
+	if r.redis == nil {
+		return nil
+	}
+	return r.redis.Del(context.Background(), loginFailuresKey(key), loginLockKey(key)).Err()
+}
+
+// Lock 锁定到 until，锁定到期后自动解除
+func (r *LoginAttemptRepository) Lock(key string, until time.Time) error {
+	if r.redis == nil {
+		return nil
+	}
+	ttl := time.Until(until)
+	if ttl <= 0 {
+		return nil
+	}
+	if err := r.redis.Set(context.Background(), loginLockKey(key), until.Unix(), ttl).Err(); err != nil {
+		return fmt.Errorf("记录登录锁定失败: %w", err)
+	}
+	return nil
+}
+
+// GetLock 获取锁定的解除时间，未锁定时返回零值
+func (r *LoginAttemptRepository) GetLock(key string) (time.Time, error) {
+	if r.redis == nil {
+		return time.Time{}, nil
+	}
+	value, err := r.redis.Get(context.Background(), loginLockKey(key)).Result()
+	if err == redis.Nil {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, fmt.Errorf("查询登录锁定失败: %w", err)
+	}
+	unix, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return time.Time{}, nil
+	}
+	return time.Unix(unix, 0), nil
+}
+
+// loginFailuresKey 登录失败次数的缓存键
+func loginFailuresKey(key string) string {
+	return "user:login_failures:" + key
+}
+
+// loginLockKey 登录锁定的缓存键
+func loginLockKey(key string) string {
+	return "user:login_lock:" + key
+}
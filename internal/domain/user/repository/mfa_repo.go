@@ -0,0 +1,176 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"course-platform/internal/domain/user/model"
+
+	"github.com/go-redis/redis/v8"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// MFARepositoryInterface 两步验证仓储接口
+type MFARepositoryInterface interface {
+	// 恢复码
+	ReplaceRecoveryCodes(userID uint, codeHashes []string) error
+	UseRecoveryCode(userID uint, codeHash string) (bool, error)
+	CountRecoveryCodes(userID uint) (int64, error)
+	DeleteRecoveryCodes(userID uint) error
+
+	// 按角色的策略
+	GetPolicy(role string) (*model.MFAPolicy, error)
+	ListPolicies() ([]*model.MFAPolicy, error)
+	SavePolicy(policy *model.MFAPolicy) error
+
+	// 验证码错误次数
+	CountFailures(userID uint) (int64, error)
+	RecordFailure(userID uint, window time.Duration) (int64, error)
+	ClearFailures(userID uint) error
+}
+
+// MFARepository 两步验证仓储实现
+type MFARepository struct {
+	db    *gorm.DB
+	redis *redis.Client // 记录验证码错误次数，为空时不限制
+}
+
+// NewMFARepository 创建两步验证仓储实例
+func NewMFARepository(db *gorm.DB, redis *redis.Client) MFARepositoryInterface {
+	return &MFARepository{db: db, redis: redis}
+}
+
+// ReplaceRecoveryCodes 用新的恢复码替换用户全部恢复码
+func (r *MFARepository) ReplaceRecoveryCodes(userID uint, codeHashes []string) error {
+	codes := make([]*model.RecoveryCode, 0, len(codeHashes))
+	for _, hash := range codeHashes {
+		codes = append(codes, &model.RecoveryCode{UserID: userID, CodeHash: hash})
+	}
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", userID).Delete(&model.RecoveryCode{}).Error; err != nil {
+			return err
+		}
+		return tx.Create(&codes).Error
+	})
+	if err != nil {
+		log.Printf("❌ Repository: 保存恢复码失败 - %v", err)
+		return fmt.Errorf("保存恢复码失败: %w", err)
+	}
+	return nil
+}
+
+// UseRecoveryCode 使用一个恢复码，恢复码不存在或已使用时返回 false
+func (r *MFARepository) UseRecoveryCode(userID uint, codeHash string) (bool, error) {
+	result := r.db.Model(&model.RecoveryCode{}).
+		Where("user_id = ? AND code_hash = ? AND used_at IS NULL", userID, codeHash).
+		Limit(1).
+		Update("used_at", time.Now())
+	if result.Error != nil {
+		return false, fmt.Errorf("使用恢复码失败: %w", result.Error)
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// CountRecoveryCodes 统计用户剩余可用的恢复码
+func (r *MFARepository) CountRecoveryCodes(userID uint) (int64, error) {
+	var count int64
+	err := r.db.Model(&model.RecoveryCode{}).
+		Where("user_id = ? AND used_at IS NULL", userID).
+		Count(&count).Error
+	if err != nil {
+		return 0, fmt.Errorf("统计恢复码失败: %w", err)
+	}
+	return count, nil
+}
+
+// DeleteRecoveryCodes 删除用户全部恢复码
+func (r *MFARepository) DeleteRecoveryCodes(userID uint) error {
+	if err := r.db.Where("user_id = ?", userID).Delete(&model.RecoveryCode{}).Error; err != nil {
+		return fmt.Errorf("删除恢复码失败: %w", err)
+	}
+	return nil
+}
+
+// GetPolicy 获取角色的两步验证策略，未设置时返回 nil
+func (r *MFARepository) GetPolicy(role string) (*model.MFAPolicy, error) {
+	var policy model.MFAPolicy
+	err := r.db.Where("role = ?", role).First(&policy).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("查询两步验证策略失败: %w", err)
+	}
+	return &policy, nil
+}
+
+// ListPolicies 获取已设置的全部策略
+func (r *MFARepository) ListPolicies() ([]*model.MFAPolicy, error) {
+	var policies []*model.MFAPolicy
+	if err := r.db.Find(&policies).Error; err != nil {
+		return nil, fmt.Errorf("查询两步验证策略失败: %w", err)
+	}
+	return policies, nil
+}
+
+// SavePolicy 保存角色的策略，已存在时覆盖
+func (r *MFARepository) SavePolicy(policy *model.MFAPolicy) error {
+	err := r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "role"}},
+		DoUpdates: clause.AssignmentColumns([]string{"required", "updated_by", "updated_at"}),
+	}).Create(policy).Error
+	if err != nil {
+		log.Printf("❌ Repository: 保存两步验证策略失败 - %v", err)
+		return fmt.Errorf("保存两步验证策略失败: %w", err)
+	}
+	return nil
+}
+
+// CountFailures 获取当前的验证码错误次数
+func (r *MFARepository) CountFailures(userID uint) (int64, error) {
+	if r.redis == nil {
+		return 0, nil
+	}
+	count, err := r.redis.Get(context.Background(), mfaFailuresKey(userID)).Int64()
+	if err == redis.Nil {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("查询验证码错误次数失败: %w", err)
+	}
+	return count, nil
+}
+
+// RecordFailure 记录一次验证码错误，返回 window 内的累计次数
+func (r *MFARepository) RecordFailure(userID uint, window time.Duration) (int64, error) {
+	if r.redis == nil {
+		return 0, nil
+	}
+	ctx := context.Background()
+	key := mfaFailuresKey(userID)
+	count, err := r.redis.Incr(ctx, key).Result()
+	if err != nil {
+		return 0, fmt.Errorf("记录验证码错误次数失败: %w", err)
+	}
+	if count == 1 {
+		r.redis.Expire(ctx, key, window)
+	}
+	return count, nil
+}
+
+// ClearFailures 验证成功后清除错误次数
+func (r *MFARepository) ClearFailures(userID uint) error {
+	if r.redis == nil {
+		return nil
+	}
+	return r.redis.Del(context.Background(), mfaFailuresKey(userID)).Err()
+}
+
+// mfaFailuresKey 验证码错误次数的缓存键
+func mfaFailuresKey(userID uint) string {
+	return fmt.Sprintf("user:mfa_failures:%d", userID)
+}
@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"course-platform/internal/domain/user/model"
+
+	"gorm.io/gorm"
+)
+
+// OIDCIdentityRepositoryInterface 单点登录身份仓储接口
+type OIDCIdentityRepositoryInterface interface {
+	Create(identity *model.OIDCIdentity) error
+	GetBySubject(provider, subject string) (*model.OIDCIdentity, error)
+	GetByUserProvider(userID uint, provider string) (*model.OIDCIdentity, error)
+	ListByUser(userID uint) ([]*model.OIDCIdentity, error)
+	Delete(userID uint, provider string) (bool, error)
+	TouchLogin(id uint, at time.Time) error
+}
+
+// OIDCIdentityRepository 单点登录身份仓储实现
+type OIDCIdentityRepository struct {
+	db *gorm.DB
+}
+
+// NewOIDCIdentityRepository 创建单点登录身份仓储实例
+func NewOIDCIdentityRepository(db *gorm.DB) OIDCIdentityRepositoryInterface {
+	return &OIDCIdentityRepository{db: db}
+}
+
+// Create 保存绑定关系
+func (r *OIDCIdentityRepository) Create(identity *model.OIDCIdentity) error {
+	if err := r.db.Create(identity).Error; err != nil {
+		log.Printf("❌ Repository: 保存单点登录身份失败 - %v", err)
+		return fmt.Errorf("保存单点登录身份失败: %w", err)
+	}
+	return nil
+}
+
+// GetBySubject 按提供者和 subject 查找，未绑定时返回 nil
+func (r *OIDCIdentityRepository) GetBySubject(provider, subject string) (*model.OIDCIdentity, error) {
+	var identity model.OIDCIdentity
+	err := r.db.Where("provider = ? AND subject = ?", provider, subject).First(&identity).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("查询单点登录身份失败: %w", err)
+	}
+	return &identity, nil
+}
+
+// GetByUserProvider 查找用户在某个提供者绑定的身份，未绑定时返回 nil
+func (r *OIDCIdentityRepository) GetByUserProvider(userID uint, provider string) (*model.OIDCIdentity, error) {
+	var identity model.OIDCIdentity
+	err := r.db.Where("user_id = ? AND provider = ?", userID, provider).First(&identity).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("查询单点登录身份失败: %w", err)
+	}
+	return &identity, nil
+}
+
+// ListByUser 获取用户绑定的全部身份
+func (r *OIDCIdentityRepository) ListByUser(userID uint) ([]*model.OIDCIdentity, error) {
+	var identities []*model.OIDCIdentity
+	if err := r.db.Where("user_id = ?", userID).Order("id").Find(&identities).Error; err != nil {
+		return nil, fmt.Errorf("查询单点登录身份失败: %w", err)
+	}
+	return identities, nil
+}
+
+// Delete 解除用户在某个提供者的绑定，没有绑定时返回 false
+func (r *OIDCIdentityRepository) Delete(userID uint, provider string) (bool, error) {
+	result := r.db.Where("user_id = ? AND provider = ?", userID, provider).Delete(&model.OIDCIdentity{})
+	if result.Error != nil {
+		return false, fmt.Errorf("解除单点登录绑定失败: %w", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// TouchLogin 记录最近一次登录时间
+func (r *OIDCIdentityRepository) TouchLogin(id uint, at time.Time) error {
+	if err := r.db.Model(&model.OIDCIdentity{}).Where("id = ?", id).Update("last_login_at", at).Error; err != nil {
+		return fmt.Errorf("更新单点登录时间失败: %w", err)
+	}
+	return nil
+}
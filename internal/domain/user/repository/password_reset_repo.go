@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"course-platform/internal/domain/user/model"
+
+	"gorm.io/gorm"
+)
+
+// PasswordResetRepositoryInterface 密码重置令牌仓储接口
+type PasswordResetRepositoryInterface interface {
+	Create(reset *model.PasswordReset) error
+	GetByTokenHash(tokenHash string) (*model.PasswordReset, error)
+	GetLatestByUser(userID uint) (*model.PasswordReset, error)
+	MarkUsed(id uint, usedAt time.Time) (bool, error)
+	InvalidateByUser(userID uint, at time.Time) error
+}
+
+// PasswordResetRepository 密码重置令牌仓储实现
+type PasswordResetRepository struct {
+	db *gorm.DB
+}
+
+// NewPasswordResetRepository 创建密码重置令牌仓储实例
+func NewPasswordResetRepository(db *gorm.DB) PasswordResetRepositoryInterface {
+	return &PasswordResetRepository{db: db}
+}
+
+// Create 保存新的重置令牌
+func (r *PasswordResetRepository) Create(reset *model.PasswordReset) error {
+	if err := r.db.Create(reset).Error; err != nil {
+		log.Printf("❌ Repository: 保存密码重置令牌失败 - %v", err)
+		return fmt.Errorf("保存密码重置令牌失败: %w", err)
+	}
+	return nil
+}
+
+// GetByTokenHash 根据令牌哈希查找，不存在时返回 nil
+func (r *PasswordResetRepository) GetByTokenHash(tokenHash string) (*model.PasswordReset, error) {
+	var reset model.PasswordReset
+	err := r.db.Where("token_hash = ?", tokenHash).First(&reset).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("查询密码重置令牌失败: %w", err)
+	}
+	return &reset, nil
+}
+
+// GetLatestByUser 获取用户最近一次申请的令牌，没有时返回 nil
+func (r *PasswordResetRepository) GetLatestByUser(userID uint) (*model.PasswordReset, error) {
+	var reset model.PasswordReset
+	err := r.db.Where("user_id = ?", userID).Order("id DESC").First(&reset).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("查询密码重置令牌失败: %w", err)
+	}
+	return &reset, nil
+}
+
+// MarkUsed 将未使用的令牌标记为已使用，令牌已被使用时返回 false（并发提交只有一次成功）
+func (r *PasswordResetRepository) MarkUsed(id uint, usedAt time.Time) (bool, error) {
+	result := r.db.Model(&model.PasswordReset{}).
+		Where("id = ? AND used_at IS NULL", id).
+		Update("used_at", usedAt)
+	if result.Error != nil {
+		return false, fmt.Errorf("更新密码重置令牌失败: %w", result.Error)
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// InvalidateByUser 作废用户全部未使用的令牌
+func (r *PasswordResetRepository) InvalidateByUser(userID uint, at time.Time) error {
+	err := r.db.Model(&model.PasswordReset{}).
+		Where("user_id = ? AND used_at IS NULL", userID).
+		Update("used_at", at).Error
+	if err != nil {
+		return fmt.Errorf("作废密码重置令牌失败: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,170 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"course-platform/internal/domain/user/model"
+
+	"github.com/go-redis/redis/v8"
+	"gorm.io/gorm"
+)
+
+// 会话缓存参数
+const (
+	sessionCacheTTL      = 10 * time.Minute // 会话是否有效的缓存时长，数据库为准
+	sessionTouchInterval = 5 * time.Minute  // 最近活动时间的最小更新间隔
+)
+
+// SessionRepositoryInterface 登录会话仓储接口
+type SessionRepositoryInterface interface {
+	Create(session *model.UserSession) error
+	ListActive(userID uint) ([]*model.UserSession, error)
+	IsActive(sessionID string) (bool, error)
+	Revoke(userID, id uint, at time.Time) (bool, error)
+	RevokeOthers(userID uint, keepSessionID string, at time.Time) (int64, error)
+	RevokeAll(userID uint, at time.Time) error
+	Touch(sessionID, ip string, at time.Time) error
+}
+
+// SessionRepository 登录会话仓储实现，会话保存在数据库，有效状态缓存在 Redis 中
+type SessionRepository struct {
+	db    *gorm.DB
+	redis *redis.Client
+}
+
+// NewSessionRepository 创建登录会话仓储实例
+func NewSessionRepository(db *gorm.DB, redis *redis.Client) SessionRepositoryInterface {
+	return &SessionRepository{db: db, redis: redis}
+}
+
+// Create 保存新会话
+func (r *SessionRepository) Create(session *model.UserSession) error {
+	if err := r.db.Create(session).Error; err != nil {
+		log.Printf("❌ Repository: 保存登录会话失败 - %v", err)
+		return fmt.Errorf("保存登录会话失败: %w", err)
+	}
+	return nil
+}
+
+// ListActive 获取用户未注销且未过期的会话，最近活动的在前
+func (r *SessionRepository) ListActive(userID uint) ([]*model.UserSession, error) {
+	var sessions []*model.UserSession
+	err := r.db.Where("user_id = ? AND revoked_at IS NULL AND expires_at > ?", userID, time.Now()).
+		Order("last_seen_at DESC").Find(&sessions).Error
+	if err != nil {
+		return nil, fmt.Errorf("查询登录会话失败: %w", err)
+	}
+	return sessions, nil
+}
+
+// IsActive 判断会话是否有效，每个需要登录的请求都会调用，优先读取 Redis 缓存
+func (r *SessionRepository) IsActive(sessionID string) (bool, error) {
+	ctx := context.Background()
+	if r.redis != nil {
+		if active, err := r.redis.Get(ctx, sessionActiveKey(sessionID)).Result(); err == nil {
+			return active == "1", nil
+		}
+	}
+
+	var session model.UserSession
+	err := r.db.Select("id", "expires_at", "revoked_at").Where("session_id = ?", sessionID).First(&session).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, fmt.Errorf("查询登录会话失败: %w", err)
+	}
+	active := err == nil && session.IsActive(time.Now())
+	r.cacheActive(ctx, sessionID, active)
+	return active, nil
+}
+
+// Revoke 注销用户的指定会话，会话不存在或已注销时返回 false
+func (r *SessionRepository) Revoke(userID, id uint, at time.Time) (bool, error) {
+	var session model.UserSession
+	err := r.db.Where("id = ? AND user_id = ? AND revoked_at IS NULL", id, userID).First(&session).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("查询登录会话失败: %w", err)
+	}
+	if err := r.db.Model(&session).Update("revoked_at", at).Error; err != nil {
+		log.Printf("❌ Repository: 注销登录会话失败 - %v", err)
+		return false, fmt.Errorf("注销登录会话失败: %w", err)
+	}
+	r.cacheActive(context.Background(), session.SessionID, false)
+	return true, nil
+}
+
+// RevokeOthers 注销用户除 keepSessionID 外的全部会话，返回注销的数量
+func (r *SessionRepository) RevokeOthers(userID uint, keepSessionID string, at time.Time) (int64, error) {
+	return r.revokeWhere(r.db.Where("user_id = ? AND session_id <> ? AND revoked_at IS NULL", userID, keepSessionID), at)
+}
+
+// RevokeAll 注销用户的全部会话，用于重置密码等场景
+func (r *SessionRepository) RevokeAll(userID uint, at time.Time) error {
+	_, err := r.revokeWhere(r.db.Where("user_id = ? AND revoked_at IS NULL", userID), at)
+	return err
+}
+
+// revokeWhere 注销满足条件的会话并同步缓存
+func (r *SessionRepository) revokeWhere(query *gorm.DB, at time.Time) (int64, error) {
+	var sessionIDs []string
+	if err := query.Session(&gorm.Session{}).Model(&model.UserSession{}).Pluck("session_id", &sessionIDs).Error; err != nil {
+		return 0, fmt.Errorf("查询登录会话失败: %w", err)
+	}
+	if len(sessionIDs) == 0 {
+		return 0, nil
+	}
+	result := r.db.Model(&model.UserSession{}).Where("session_id IN ?", sessionIDs).Update("revoked_at", at)
+	if result.Error != nil {
+		log.Printf("❌ Repository: 注销登录会话失败 - %v", result.Error)
+		return 0, fmt.Errorf("注销登录会话失败: %w", result.Error)
+	}
+	ctx := context.Background()
+	for _, sessionID := range sessionIDs {
+		r.cacheActive(ctx, sessionID, false)
+	}
+	return result.RowsAffected, nil
+}
+
+// Touch 记录会话的最近活动时间和来源IP，间隔不足 sessionTouchInterval 时跳过
+func (r *SessionRepository) Touch(sessionID, ip string, at time.Time) error {
+	if r.redis != nil {
+		first, err := r.redis.SetNX(context.Background(), sessionSeenKey(sessionID), 1, sessionTouchInterval).Result()
+		if err == nil && !first {
+			return nil
+		}
+	}
+	err := r.db.Model(&model.UserSession{}).
+		Where("session_id = ? AND last_seen_at < ?", sessionID, at.Add(-sessionTouchInterval)).
+		Updates(map[string]interface{}{"last_seen_at": at, "ip": ip}).Error
+	if err != nil {
+		return fmt.Errorf("更新会话活动时间失败: %w", err)
+	}
+	return nil
+}
+
+// cacheActive 缓存会话是否有效
+func (r *SessionRepository) cacheActive(ctx context.Context, sessionID string, active bool) {
+	if r.redis == nil {
+		return
+	}
+	value := "0"
+	if active {
+		value = "1"
+	}
+	r.redis.Set(ctx, sessionActiveKey(sessionID), value, sessionCacheTTL)
+}
+
+// sessionActiveKey 会话是否有效的缓存键
+func sessionActiveKey(sessionID string) string {
+	return "user:session_active:" + sessionID
+}
+
+// sessionSeenKey 会话最近活动的节流键
+func sessionSeenKey(sessionID string) string {
+	return "user:session_seen:" + sessionID
+}
@@ -19,6 +19,7 @@ type UserRepositoryInterface interface {
 	// 基础CRUD操作
 	Create(user *model.User) error
 	GetByID(id uint) (*model.User, error)
+	GetByIDs(ids []uint) ([]*model.User, error)
 	GetByEmail(email string) (*model.User, error)
 	GetByUsername(username string) (*model.User, error)
 	Update(user *model.User) error
@@ -26,8 +27,19 @@ type UserRepositoryInterface interface {
 
 	// 业务查询方法
 	ExistsByEmail(email string) (bool, error)
+	ExistsByUsername(username string) (bool, error)
 	GetUserList(offset, limit int) ([]*model.User, int64, error)
 
+	// 角色
+	PromoteToInstructor(userIDs []uint) (int64, error)
+
+	// 登录令牌吊销
+	RevokeTokens(userID uint, at time.Time) error
+	GetTokensRevokedAt(userID uint) (time.Time, error)
+
+	// 两步验证
+	AdvanceTOTPStep(userID uint, step int64) (bool, error)
+
 	// 缓存相关方法
 	SetUserCache(user *model.User) error
 	GetUserFromCache(email string) (*model.User, error)
@@ -126,6 +138,19 @@ func (r *UserRepository) GetByID(id uint) (*model.User, error) {
 	return &user, nil
 }
 
+// GetByIDs 批量获取用户（不走缓存），不存在的ID会被忽略
+func (r *UserRepository) GetByIDs(ids []uint) ([]*model.User, error) {
+	var users []*model.User
+	if len(ids) == 0 {
+		return users, nil
+	}
+	if err := r.db.Where("id IN ?", ids).Find(&users).Error; err != nil {
+		log.Printf("❌ Repository: 批量查询用户失败 - %v", err)
+		return nil, fmt.Errorf("查询用户失败: %w", err)
+	}
+	return users, nil
+}
+
 // GetByUsername 根据用户名获取用户（兼容性方法）
 func (r *UserRepository) GetByUsername(username string) (*model.User, error) {
 	log.Printf("🔍 Repository: 根据用户名获取用户 - %s", username)
@@ -252,6 +277,79 @@ func (r *UserRepository) GetUserList(offset, limit int) ([]*model.User, int64, e
 	return users, total, nil
 }
 
+// PromoteToInstructor 将普通用户升级为讲师，管理员等其他角色保持不变，返回实际升级的数量
+func (r *UserRepository) PromoteToInstructor(userIDs []uint) (int64, error) {
+	if len(userIDs) == 0 {
+		return 0, nil
+	}
+	result := r.db.Model(&model.User{}).
+		Where("id IN ? AND role = ?", userIDs, model.RoleUser).
+		Update("role", model.RoleInstructor)
+	if result.Error != nil {
+		return 0, fmt.Errorf("升级讲师角色失败: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
+
+// RevokeTokens 吊销用户在 at 之前签发的全部登录令牌，同时刷新缓存
+func (r *UserRepository) RevokeTokens(userID uint, at time.Time) error {
+	err := r.db.Model(&model.User{}).Where("id = ?", userID).Update("tokens_revoked_at", at).Error
+	if err != nil {
+		log.Printf("❌ Repository: 吊销登录令牌失败 - %v", err)
+		return fmt.Errorf("吊销登录令牌失败: %w", err)
+	}
+	if r.redis != nil {
+		r.redis.Set(context.Background(), tokensRevokedKey(userID), at.Unix(), tokensRevokedCacheTTL)
+	}
+	log.Printf("✅ Repository: 已吊销用户登录令牌 - ID: %d", userID)
+	return nil
+}
+
+// AdvanceTOTPStep 记录已使用的验证码时间步，只有 step 大于已记录的值时才更新
+// 返回 false 表示该时间步已被使用（并发请求重放同一验证码时只有一个能成功）
+func (r *UserRepository) AdvanceTOTPStep(userID uint, step int64) (bool, error) {
+	result := r.db.Model(&model.User{}).
+		Where("id = ? AND totp_last_step < ?", userID, step).
+		Update("totp_last_step", step)
+	if result.Error != nil {
+		log.Printf("❌ Repository: 记录验证码时间步失败 - %v", result.Error)
+		return false, fmt.Errorf("记录验证码使用失败: %w", result.Error)
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// GetTokensRevokedAt 获取用户登录令牌的吊销时间，从未吊销时返回零值
+// 每个需要登录的请求都会调用，优先读取 Redis 缓存；已注销（软删除）的用户同样按吊销时间判断
+func (r *UserRepository) GetTokensRevokedAt(userID uint) (time.Time, error) {
+	ctx := context.Background()
+	if r.redis != nil {
+		if unix, err := r.redis.Get(ctx, tokensRevokedKey(userID)).Int64(); err == nil {
+			if unix == 0 {
+				return time.Time{}, nil
+			}
+			return time.Unix(unix, 0), nil
+		}
+	}
+
+	var user model.User
+	err := r.db.Unscoped().Select("id", "tokens_revoked_at").First(&user, userID).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return time.Time{}, fmt.Errorf("查询令牌吊销时间失败: %w", err)
+	}
+	var revokedAt time.Time
+	if user.TokensRevokedAt != nil {
+		revokedAt = *user.TokensRevokedAt
+	}
+	if r.redis != nil {
+		var unix int64
+		if !revokedAt.IsZero() {
+			unix = revokedAt.Unix()
+		}
+		r.redis.Set(ctx, tokensRevokedKey(userID), unix, tokensRevokedCacheTTL)
+	}
+	return revokedAt, nil
+}
+
 // SetUserCache 设置用户缓存
 func (r *UserRepository) SetUserCache(user *model.User) error {
 	if r.redis == nil {
@@ -307,6 +405,14 @@ func (r *UserRepository) ExistsByUsername(username string) (bool, error) {
 
 // 缓存相关方法
 
+// tokensRevokedCacheTTL 令牌吊销时间的缓存时长，数据库为准
+const tokensRevokedCacheTTL = 10 * time.Minute
+
+// tokensRevokedKey 令牌吊销时间的缓存键
+func tokensRevokedKey(userID uint) string {
+	return fmt.Sprintf("user:tokens_revoked_at:%d", userID)
+}
+
 // cacheUser 缓存用户信息
 func (r *UserRepository) cacheUser(user *model.User) {
 	if r.redis == nil {
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"time"
+
+	"course-platform/internal/domain/user/model"
+	"course-platform/internal/shared/utils"
+)
+
+// deletedUserNickname 已注销账号显示的昵称
+const deletedUserNickname = "已注销用户"
+
+// EraseAccount 清除账号的个人信息并软删除账号，由账号注销任务在宽限期结束后调用
+// 登录令牌、会话、个人访问令牌和单点登录绑定全部失效，用户名和邮箱改为占位值以释放原邮箱；重复调用时直接返回成功
+func (s *UserService) EraseAccount(userID uint) error {
+	user, err := s.userRepo.GetByID(userID)
+	if err != nil {
+		if err.Error() == "用户不存在" {
+			log.Printf("⚠️ Service: 账号已清除，跳过 - 用户ID: %d", userID)
+			return nil
+		}
+		return err
+	}
+
+	now := time.Now()
+	if err := s.userRepo.RevokeTokens(userID, now); err != nil {
+		return err
+	}
+	if s.sessionRepo != nil {
+		if err := s.sessionRepo.RevokeAll(userID, now); err != nil {
+			return err
+		}
+	}
+	if s.apiTokenRepo != nil {
+		if err := s.apiTokenRepo.RevokeAll(userID, now); err != nil {
+			return err
+		}
+	}
+	if s.oidcRepo != nil {
+		identities, err := s.oidcRepo.ListByUser(userID)
+		if err != nil {
+			return err
+		}
+		for _, identity := range identities {
+			if _, err := s.oidcRepo.Delete(userID, identity.Provider); err != nil {
+				return err
+			}
+		}
+	}
+	if s.mfaRepo != nil {
+		if err := s.mfaRepo.DeleteRecoveryCodes(userID); err != nil {
+			return err
+		}
+	}
+	if s.resetRepo != nil {
+		if err := s.resetRepo.InvalidateByUser(userID, now); err != nil {
+			return err
+		}
+	}
+
+	// 随机密码，账号无法再登录
+	random := make([]byte, 32)
+	if _, err := rand.Read(random); err != nil {
+		return fmt.Errorf("生成随机密码失败: %w", err)
+	}
+	passwordHash, err := utils.HashPassword(hex.EncodeToString(random))
+	if err != nil {
+		return fmt.Errorf("生成随机密码失败: %w", err)
+	}
+
+	originalEmail := user.Email
+	user.Username = fmt.Sprintf("deleted_%d", user.ID)
+	user.Email = fmt.Sprintf("deleted_%d@deleted.invalid", user.ID)
+	user.PasswordHash = passwordHash
+	user.Nickname = deletedUserNickname
+	user.AvatarURL = ""
+	user.Avatar = ""
+	user.Phone = ""
+	user.Bio = ""
+	user.EmailVerifiedAt = nil
+	user.VerificationSentAt = nil
+	user.MFAEnabled = false
+	user.MFAEnabledAt = nil
+	user.TOTPSecret = ""
+	user.TOTPPendingSecret = ""
+	user.Role = model.RoleUser
+	if err := s.userRepo.Update(user); err != nil {
+		return err
+	}
+	s.userRepo.DeleteUserCache(originalEmail)
+
+	if err := s.userRepo.Delete(userID); err != nil {
+		return err
+	}
+
+	log.Printf("✅ Service: 已清除账号 - 用户ID: %d", userID)
+	return nil
+}
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"course-platform/internal/domain/user/model"
+)
+
+// 个人访问令牌限制
+const (
+	apiTokenDefaultDays = 30  // 未指定有效期时的默认天数
+	apiTokenMaxDays     = 365 // 有效期上限
+	apiTokenMaxActive   = 20  // 每个用户同时有效的令牌数量上限
+)
+
+// CreateAPIToken 创建个人访问令牌，返回令牌记录和明文令牌（明文只在此时返回一次）
+func (s *UserService) CreateAPIToken(userID uint, name string, scopes []string, expiresInDays int) (*model.APIToken, string, error) {
+	if s.apiTokenRepo == nil {
+		return nil, "", errors.New("个人访问令牌功能未配置")
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > 100 {
+		return nil, "", errors.New("令牌名称不能为空且不能超过100个字符")
+	}
+	scopes, err := normalizeScopes(scopes)
+	if err != nil {
+		return nil, "", err
+	}
+	if expiresInDays == 0 {
+		expiresInDays = apiTokenDefaultDays
+	}
+	if expiresInDays < 1 || expiresInDays > apiTokenMaxDays {
+		return nil, "", fmt.Errorf("有效期必须在1到%d天之间", apiTokenMaxDays)
+	}
+
+	count, err := s.apiTokenRepo.CountActive(userID)
+	if err != nil {
+		return nil, "", err
+	}
+	if count >= apiTokenMaxActive {
+		return nil, "", fmt.Errorf("最多同时保留%d个有效令牌，请先吊销不再使用的令牌", apiTokenMaxActive)
+	}
+
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, "", fmt.Errorf("生成令牌失败: %w", err)
+	}
+	plaintext := model.APITokenPrefix + base64.RawURLEncoding.EncodeToString(buf)
+
+	token := &model.APIToken{
+		UserID:    userID,
+		Name:      name,
+		Prefix:    plaintext[:len(model.APITokenPrefix)+6],
+		TokenHash: hashAPIToken(plaintext),
+		Scopes:    strings.Join(scopes, " "),
+		ExpiresAt: time.Now().AddDate(0, 0, expiresInDays),
+	}
+	if err := s.apiTokenRepo.Create(token); err != nil {
+		return nil, "", err
+	}
+	log.Printf("✅ Service: 已创建个人访问令牌 - 用户ID: %d, 令牌ID: %d, 权限: %s", userID, token.ID, token.Scopes)
+	return token, plaintext, nil
+}
+
+// ListAPITokens 获取用户未吊销的个人访问令牌
+func (s *UserService) ListAPITokens(userID uint) ([]*model.APIToken, error) {
+	if s.apiTokenRepo == nil {
+		return nil, errors.New("个人访问令牌功能未配置")
+	}
+	return s.apiTokenRepo.ListByUser(userID)
+}
+
+// RevokeAPIToken 吊销用户的指定个人访问令牌
+func (s *UserService) RevokeAPIToken(userID, id uint) error {
+	if s.apiTokenRepo == nil {
+		return errors.New("个人访问令牌功能未配置")
+	}
+	revoked, err := s.apiTokenRepo.Revoke(userID, id, time.Now())
+	if err != nil {
+		return err
+	}
+	if !revoked {
+		return errors.New("令牌不存在或已吊销")
+	}
+	log.Printf("✅ Service: 已吊销个人访问令牌 - 用户ID: %d, 令牌ID: %d", userID, id)
+	return nil
+}
+
+// AuthenticateAPIToken 校验个人访问令牌，返回所属用户和权限，由认证中间件调用
+func (s *UserService) AuthenticateAPIToken(plaintext, clientIP string) (uint, []string, error) {
+	if s.apiTokenRepo == nil {
+		return 0, nil, errors.New("个人访问令牌功能未配置")
+	}
+	token, err := s.apiTokenRepo.GetByHash(hashAPIToken(plaintext))
+	if err != nil {
+		return 0, nil, err
+	}
+	now := time.Now()
+	if token == nil || !token.IsActive(now) {
+		return 0, nil, errors.New("令牌无效、已过期或已吊销")
+	}
+	if err := s.apiTokenRepo.TouchUsed(token.ID, clientIP, now); err != nil {
+		log.Printf("⚠️ Service: %v", err)
+	}
+	return token.UserID, token.ScopeList(), nil
+}
+
+// normalizeScopes 校验并去重权限列表
+func normalizeScopes(scopes []string) ([]string, error) {
+	seen := make(map[string]bool, len(scopes))
+	result := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		scope = strings.TrimSpace(scope)
+		if !model.IsValidAPITokenScope(scope) {
+			return nil, fmt.Errorf("无效的权限: %s", scope)
+		}
+		if !seen[scope] {
+			seen[scope] = true
+			result = append(result, scope)
+		}
+	}
+	if len(result) == 0 {
+		return nil, errors.New("至少选择一项权限")
+	}
+	return result, nil
+}
+
+// hashAPIToken 计算令牌的 SHA-256 哈希，数据库只保存哈希
+func hashAPIToken(plaintext string) string {
+	sum := sha256.Sum256([]byte(plaintext))
+	return hex.EncodeToString(sum[:])
+}
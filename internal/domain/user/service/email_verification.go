@@ -0,0 +1,140 @@
+package service
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	emailModel "course-platform/internal/domain/email/model"
+	emailService "course-platform/internal/domain/email/service"
+	"course-platform/internal/domain/user/model"
+)
+
+// 邮箱验证参数
+const (
+	verificationTTL            = 24 * time.Hour  // 验证链接有效期
+	verificationResendInterval = 2 * time.Minute // 两次发送验证邮件的最小间隔
+)
+
+// ResendTooSoonError 重发验证邮件过于频繁
+type ResendTooSoonError struct {
+	RetryAfter time.Duration
+}
+
+// Error 实现 error 接口
+func (e *ResendTooSoonError) Error() string {
+	return fmt.Sprintf("发送过于频繁，请 %d 秒后再试", int(e.RetryAfter.Seconds())+1)
+}
+
+// VerifyEmail 通过邮件中的验证链接验证邮箱，首次验证成功后发送欢迎邮件
+func (s *UserService) VerifyEmail(token string) (*model.User, error) {
+	invalid := errors.New("验证链接无效或已过期，请重新发送验证邮件")
+
+	payload, signature, ok := strings.Cut(token, ".")
+	if !ok {
+		return nil, invalid
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return nil, invalid
+	}
+	idText, expiresText, ok := strings.Cut(string(raw), ".")
+	if !ok {
+		return nil, invalid
+	}
+	userID, err := strconv.ParseUint(idText, 10, 32)
+	if err != nil {
+		return nil, invalid
+	}
+	expires, err := strconv.ParseInt(expiresText, 10, 64)
+	if err != nil || time.Now().Unix() > expires {
+		return nil, invalid
+	}
+
+	user, err := s.userRepo.GetByID(uint(userID))
+	if err != nil {
+		return nil, invalid
+	}
+	// 签名包含邮箱地址，修改邮箱后旧链接自动失效
+	if !hmac.Equal([]byte(signature), []byte(s.signVerification(payload, user.Email))) {
+		return nil, invalid
+	}
+	if user.IsEmailVerified() {
+		return user, nil
+	}
+
+	now := time.Now()
+	user.EmailStatus = model.EmailStatusVerified
+	user.EmailVerifiedAt = &now
+	if err := s.userRepo.Update(user); err != nil {
+		return nil, fmt.Errorf("更新邮箱验证状态失败: %w", err)
+	}
+	log.Printf("✅ Service: 邮箱验证成功 - 用户ID: %d", user.ID)
+
+	if s.emailSvc != nil {
+		if err := s.emailSvc.SendToUser(user.ID, emailModel.CategoryAccount, emailService.TemplateWelcome, nil); err != nil {
+			log.Printf("⚠️ Service: 欢迎邮件入队失败 - 用户ID: %d, 错误: %v", user.ID, err)
+		}
+	}
+	return user, nil
+}
+
+// ResendVerification 重新发送验证邮件，同一用户两次发送至少间隔 verificationResendInterval
+func (s *UserService) ResendVerification(userID uint) error {
+	user, err := s.userRepo.GetByID(userID)
+	if err != nil {
+		return err
+	}
+	if user.IsEmailVerified() {
+		return errors.New("邮箱已验证，无需重复验证")
+	}
+	if user.VerificationSentAt != nil {
+		if wait := verificationResendInterval - time.Since(*user.VerificationSentAt); wait > 0 {
+			return &ResendTooSoonError{RetryAfter: wait}
+		}
+	}
+	return s.sendVerification(user)
+}
+
+// sendVerification 生成验证链接并发送验证邮件，记录发送时间
+func (s *UserService) sendVerification(user *model.User) error {
+	if s.emailSvc == nil {
+		return errors.New("邮件服务未配置")
+	}
+
+	now := time.Now()
+	user.VerificationSentAt = &now
+	if err := s.userRepo.Update(user); err != nil {
+		return fmt.Errorf("记录验证邮件发送时间失败: %w", err)
+	}
+
+	token := s.verificationToken(user, now.Add(verificationTTL))
+	if err := s.emailSvc.SendToUser(user.ID, emailModel.CategoryAccount, emailService.TemplateVerifyEmail, map[string]interface{}{
+		"VerifyPath":   "/verify-email?token=" + url.QueryEscape(token),
+		"ExpiresHours": int(verificationTTL.Hours()),
+	}); err != nil {
+		return fmt.Errorf("发送验证邮件失败: %w", err)
+	}
+	log.Printf("✅ Service: 验证邮件已发送 - 用户ID: %d", user.ID)
+	return nil
+}
+
+// verificationToken 生成邮箱验证令牌：base64(用户ID.过期时间) + "." + HMAC签名
+func (s *UserService) verificationToken(user *model.User, expires time.Time) string {
+	payload := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%d.%d", user.ID, expires.Unix())))
+	return payload + "." + s.signVerification(payload, user.Email)
+}
+
+// signVerification 计算验证令牌签名，密钥与登录令牌区分用途
+func (s *UserService) signVerification(payload, email string) string {
+	mac := hmac.New(sha256.New, []byte(s.jwtSecret+":email-verification"))
+	mac.Write([]byte(payload + "." + strings.ToLower(email)))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil)[:16])
+}
@@ -0,0 +1,151 @@
+package service
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	emailModel "course-platform/internal/domain/email/model"
+	emailService "course-platform/internal/domain/email/service"
+	"course-platform/internal/domain/user/model"
+)
+
+// 登录防暴力破解参数
+const (
+	loginFailureWindow  = time.Hour        // 最后一次失败后经过此时间，失败次数清零
+	accountFreeAttempts = 5                // 同一账号允许连续失败的次数，超过后开始锁定
+	ipFreeAttempts      = 20               // 同一来源IP允许失败的次数（跨账号累计）
+	loginBackoffBase    = 30 * time.Second // 首次锁定时长，之后每失败一次翻倍
+	loginBackoffMax     = 30 * time.Minute // 单次锁定时长上限
+)
+
+// LoginLockedError 登录失败次数过多，暂时不允许登录
+type LoginLockedError struct {
+	Until         time.Time // 解除时间
+	AccountLocked bool      // true 为账号锁定，false 为来源IP受限
+}
+
+// Error 返回带剩余等待时间的提示
+func (e *LoginLockedError) Error() string {
+	wait := formatLoginWait(time.Until(e.Until))
+	if e.AccountLocked {
+		return fmt.Sprintf("登录失败次数过多，账号已临时锁定，请%s后再试，或通过找回密码重置", wait)
+	}
+	return fmt.Sprintf("登录尝试过于频繁，请%s后再试", wait)
+}
+
+// loginAccountKey 按账号统计失败次数的键
+// 账号不存在时按输入的标识符统计，避免通过是否会被锁定来探测账号是否存在
+func loginAccountKey(user *model.User, identifier string) string {
+	if user != nil {
+		return fmt.Sprintf("user:%d", user.ID)
+	}
+	return "name:" + strings.ToLower(strings.TrimSpace(identifier))
+}
+
+// loginIPKey 按来源IP统计失败次数的键，没有IP时不统计
+func loginIPKey(clientIP string) string {
+	if clientIP == "" {
+		return ""
+	}
+	return "ip:" + clientIP
+}
+
+// checkLoginLock 检查是否处于锁定期，Redis 不可用时不阻止登录
+func (s *UserService) checkLoginLock(key string, accountLocked bool) error {
+	if s.attemptRepo == nil || key == "" {
+		return nil
+	}
+	until, err := s.attemptRepo.GetLock(key)
+	if err != nil {
+		log.Printf("⚠️ Service: %v", err)
+		return nil
+	}
+	if time.Now().Before(until) {
+		return &LoginLockedError{Until: until, AccountLocked: accountLocked}
+	}
+	return nil
+}
+
+// recordLoginFailure 记录一次登录失败，账号和来源IP超过允许次数后按指数退避锁定
+// 本次失败触发锁定时返回 LoginLockedError，账号首次被锁定时邮件提醒用户
+func (s *UserService) recordLoginFailure(user *model.User, accountKey, ipKey string) error {
+	if s.attemptRepo == nil {
+		return nil
+	}
+
+	var locked error
+	if _, until := s.addLoginFailure(ipKey, ipFreeAttempts); !until.IsZero() {
+		log.Printf("⚠️ Service: 来源登录失败过多，已限制 - %s, 解除时间: %s", ipKey, until.Format(time.RFC3339))
+		locked = &LoginLockedError{Until: until}
+	}
+
+	failures, until := s.addLoginFailure(accountKey, accountFreeAttempts)
+	if until.IsZero() {
+		return locked
+	}
+	log.Printf("⚠️ Service: 账号登录失败过多，已锁定 - %s, 失败次数: %d, 解除时间: %s", accountKey, failures, until.Format(time.RFC3339))
+	if user != nil && failures == accountFreeAttempts+1 {
+		s.notifyLoginLocked(user, failures, strings.TrimPrefix(ipKey, "ip:"), until)
+	}
+	return &LoginLockedError{Until: until, AccountLocked: true}
+}
+
+// addLoginFailure 累计失败次数，超过 freeAttempts 时锁定并返回解除时间
+func (s *UserService) addLoginFailure(key string, freeAttempts int64) (int64, time.Time) {
+	if key == "" {
+		return 0, time.Time{}
+	}
+	failures, err := s.attemptRepo.RecordFailure(key, loginFailureWindow)
+	if err != nil {
+		log.Printf("⚠️ Service: %v", err)
+		return 0, time.Time{}
+	}
+	if failures <= freeAttempts {
+		return failures, time.Time{}
+	}
+
+	delay := loginBackoffMax
+	if over := failures - freeAttempts - 1; over < 16 {
+		delay = min(loginBackoffBase<<over, loginBackoffMax)
+	}
+	until := time.Now().Add(delay)
+	if err := s.attemptRepo.Lock(key, until); err != nil {
+		log.Printf("⚠️ Service: %v", err)
+	}
+	return failures, until
+}
+
+// clearLoginFailures 登录成功或重置密码后清除账号的失败次数和锁定
+func (s *UserService) clearLoginFailures(accountKey string) {
+	if s.attemptRepo == nil {
+		return
+	}
+	if err := s.attemptRepo.ClearFailures(accountKey); err != nil {
+		log.Printf("⚠️ Service: 清除登录失败次数失败 - %v", err)
+	}
+}
+
+// notifyLoginLocked 邮件提醒用户账号因多次登录失败被锁定
+func (s *UserService) notifyLoginLocked(user *model.User, failures int64, clientIP string, until time.Time) {
+	if s.emailSvc == nil {
+		return
+	}
+	if err := s.emailSvc.SendToUser(user.ID, emailModel.CategoryAccount, emailService.TemplateLoginLocked, map[string]interface{}{
+		"Attempts":    failures,
+		"IP":          clientIP,
+		"LockedUntil": until.Format("2006-01-02 15:04 MST"),
+		"ResetPath":   "/forgot-password",
+	}); err != nil {
+		log.Printf("⚠️ Service: 账号锁定提醒邮件发送失败 - 用户ID: %d, 错误: %v", user.ID, err)
+	}
+}
+
+// formatLoginWait 把剩余等待时间转换为提示文字
+func formatLoginWait(wait time.Duration) string {
+	if wait < time.Minute {
+		return fmt.Sprintf("%d秒", max(int(wait.Seconds()+0.5), 1))
+	}
+	return fmt.Sprintf("%d分钟", int((wait+time.Minute-1)/time.Minute))
+}
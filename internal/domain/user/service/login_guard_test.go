@@ -0,0 +1,176 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	emailService "course-platform/internal/domain/email/service"
+)
+
+// memoryAttemptRepo 内存登录失败次数仓储，不处理统计窗口过期
+type memoryAttemptRepo struct {
+	failures map[string]int64
+	locks    map[string]time.Time
+}
+
+func newMemoryAttemptRepo() *memoryAttemptRepo {
+	return &memoryAttemptRepo{failures: make(map[string]int64), locks: make(map[string]time.Time)}
+}
+
+func (r *memoryAttemptRepo) RecordFailure(key string, window time.Duration) (int64, error) {
+	r.failures[key]++
+	return r.failures[key], nil
+}
+
+func (r *memoryAttemptRepo) ClearFailures(key string) error {
+	delete(r.failures, key)
+	delete(r.locks, key)
+	return nil
+}
+
+func (r *memoryAttemptRepo) Lock(key string, until time.Time) error {
+	r.locks[key] = until
+	return nil
+}
+
+func (r *memoryAttemptRepo) GetLock(key string) (time.Time, error) {
+	return r.locks[key], nil
+}
+
+func newLoginTestService(t *testing.T) (*UserService, *memoryAttemptRepo, *stubEmailService) {
+	attempts := newMemoryAttemptRepo()
+	emails := &stubEmailService{}
+	service := NewUserService(newMemoryUserRepo(t), &memoryResetRepo{}, nil, attempts, nil, nil, nil, emails).(*UserService)
+	return service, attempts, emails
+}
+
+// accountLocked 登录错误是否为账号锁定
+func accountLocked(err error) bool {
+	var locked *LoginLockedError
+	return errors.As(err, &locked) && locked.AccountLocked
+}
+
+func TestLoginLockout(t *testing.T) {
+	service, _, emails := newLoginTestService(t)
+	client := ClientInfo{IP: "10.0.0.1"}
+
+	// 允许的失败次数内只提示密码错误
+	for i := 1; i <= accountFreeAttempts; i++ {
+		_, err := service.Login(testEmail, "wrong-password", client)
+		if err == nil || accountLocked(err) {
+			t.Fatalf("第%d次失败: err = %v, want 密码错误", i, err)
+		}
+	}
+
+	// 超过后锁定，锁定期内正确密码也不能登录
+	if _, err := service.Login(testEmail, "wrong-password", client); !accountLocked(err) {
+		t.Fatalf("超过允许次数: err = %v, want 账号锁定", err)
+	}
+	if _, err := service.Login(testEmail, testPassword, client); !accountLocked(err) {
+		t.Fatalf("锁定期内正确密码: err = %v, want 账号锁定", err)
+	}
+	if len(emails.templates) != 1 || emails.templates[0] != emailService.TemplateLoginLocked {
+		t.Errorf("邮件 = %v, want [%s]", emails.templates, emailService.TemplateLoginLocked)
+	}
+}
+
+func TestLoginLockoutBackoff(t *testing.T) {
+	service, attempts, emails := newLoginTestService(t)
+	key := "user:1"
+
+	var previous time.Duration
+	for i := 1; i <= accountFreeAttempts+3; i++ {
+		// 锁定期已过，继续尝试
+		delete(attempts.locks, key)
+		_, err := service.Login(testEmail, "wrong-password", ClientInfo{})
+		if i <= accountFreeAttempts {
+			continue
+		}
+		if !accountLocked(err) {
+			t.Fatalf("第%d次失败: err = %v, want 账号锁定", i, err)
+		}
+		wait := time.Until(attempts.locks[key])
+		if wait <= previous || wait > loginBackoffMax {
+			t.Errorf("第%d次失败锁定 %v, 上次 %v", i, wait, previous)
+		}
+		previous = wait
+	}
+	// 只在首次锁定时提醒
+	if len(emails.templates) != 1 {
+		t.Errorf("发送了 %d 封锁定提醒, want 1", len(emails.templates))
+	}
+}
+
+func TestLoginFailuresCleared(t *testing.T) {
+	tests := []struct {
+		name  string
+		clear func(t *testing.T, service *UserService, attempts *memoryAttemptRepo, emails *stubEmailService)
+	}{
+		{"登录成功后清零", func(t *testing.T, service *UserService, attempts *memoryAttemptRepo, emails *stubEmailService) {
+			if _, err := service.Login(testEmail, testPassword, ClientInfo{}); err != nil {
+				t.Fatalf("登录失败: %v", err)
+			}
+		}},
+		{"重置密码后解除锁定", func(t *testing.T, service *UserService, attempts *memoryAttemptRepo, emails *stubEmailService) {
+			for i := 0; i < 2; i++ {
+				service.Login(testEmail, "wrong-password", ClientInfo{})
+			}
+			if _, err := service.Login(testEmail, testPassword, ClientInfo{}); !accountLocked(err) {
+				t.Fatalf("err = %v, want 账号锁定", err)
+			}
+			if err := service.sendPasswordReset(testEmail); err != nil {
+				t.Fatalf("发送重置邮件失败: %v", err)
+			}
+			if err := service.ResetPassword(resetTokenFromEmail(t, emails), testPassword); err != nil {
+				t.Fatalf("重置密码失败: %v", err)
+			}
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, attempts, emails := newLoginTestService(t)
+			for i := 0; i < accountFreeAttempts-1; i++ {
+				service.Login(testEmail, "wrong-password", ClientInfo{})
+			}
+			tt.clear(t, service, attempts, emails)
+
+			// 清零后重新获得全部允许次数
+			for i := 1; i <= accountFreeAttempts; i++ {
+				if _, err := service.Login(testEmail, "wrong-password", ClientInfo{}); accountLocked(err) {
+					t.Fatalf("清零后第%d次失败即被锁定", i)
+				}
+			}
+			if _, err := service.Login(testEmail, testPassword, ClientInfo{}); err != nil {
+				t.Fatalf("登录失败: %v", err)
+			}
+		})
+	}
+}
+
+func TestLoginIPLimit(t *testing.T) {
+	service, _, _ := newLoginTestService(t)
+	client := ClientInfo{IP: "10.0.0.2"}
+
+	// 同一来源跨账号尝试，每个账号都未超过允许次数
+	for i := 0; i < ipFreeAttempts; i++ {
+		if _, err := service.Login(fmt.Sprintf("guess%d@example.com", i), "wrong-password", client); err == nil || accountLocked(err) {
+			t.Fatalf("第%d次失败: err = %v", i+1, err)
+		}
+	}
+
+	var locked *LoginLockedError
+	_, err := service.Login("guess@example.com", "wrong-password", client)
+	if !errors.As(err, &locked) || locked.AccountLocked {
+		t.Fatalf("err = %v, want 来源IP受限", err)
+	}
+	// 来源受限时正确密码也被拒绝，其他来源不受影响
+	if _, err := service.Login(testEmail, testPassword, client); !errors.As(err, &locked) {
+		t.Fatalf("err = %v, want 来源IP受限", err)
+	}
+	if _, err := service.Login(testEmail, testPassword, ClientInfo{IP: "10.0.0.3"}); err != nil {
+		t.Fatalf("其他来源登录失败: %v", err)
+	}
+}
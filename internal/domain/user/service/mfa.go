@@ -0,0 +1,502 @@
+package service
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"math/big"
+	"strings"
+	"time"
+
+	"course-platform/internal/domain/user/model"
+	"course-platform/internal/shared/utils"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// 两步验证参数
+const (
+	mfaIssuer         = "Course Platform" // 身份验证器中显示的服务名
+	mfaChallengeTTL   = 5 * time.Minute   // 登录挑战令牌有效期
+	mfaMaxFailures    = 5                 // 窗口内允许的验证码错误次数
+	mfaFailureWindow  = 15 * time.Minute  // 错误次数统计窗口
+	recoveryCodeCount = 10                // 每次生成的恢复码数量
+)
+
+// recoveryAlphabet 恢复码字符集，去掉了易混淆的 i、l、o、0、1
+const recoveryAlphabet = "abcdefghjkmnpqrstuvwxyz23456789"
+
+// ErrNotAdmin 只有管理员可以查看和设置两步验证策略
+var ErrNotAdmin = errors.New("只有管理员可以管理两步验证策略")
+
+// LoginResult 登录结果，需要两步验证时只返回挑战令牌，验证通过后才签发登录令牌
+type LoginResult struct {
+	Token            string
+	User             *model.User
+	MFAToken         string // 两步验证挑战令牌
+	MFASetupRequired bool   // 角色要求两步验证但尚未启用，需先用挑战令牌完成绑定
+}
+
+// MFAStatus 用户的两步验证状态
+type MFAStatus struct {
+	Enabled           bool
+	Required          bool  // 所属角色是否强制启用
+	RecoveryCodesLeft int64 // 剩余可用恢复码
+}
+
+// TOTPSetup 绑定身份验证器所需的信息
+type TOTPSetup struct {
+	Secret          string // 无法扫码时手动输入的密钥
+	ProvisioningURI string // otpauth:// 地址，前端生成二维码
+}
+
+// mfaClaims 两步验证挑战令牌，签名密钥与登录令牌不同，不能当作登录令牌使用
+type mfaClaims struct {
+	UserID  uint   `json:"user_id"`
+	Purpose string `json:"purpose"`
+	jwt.RegisteredClaims
+}
+
+// GetMFAStatus 获取用户的两步验证状态
+func (s *UserService) GetMFAStatus(userID uint) (*MFAStatus, error) {
+	user, err := s.userRepo.GetByID(userID)
+	if err != nil {
+		return nil, err
+	}
+	status := &MFAStatus{
+		Enabled:  user.MFAEnabled,
+		Required: s.isMFARequired(user.Role),
+	}
+	if user.MFAEnabled {
+		if status.RecoveryCodesLeft, err = s.mfaRepo.CountRecoveryCodes(userID); err != nil {
+			return nil, err
+		}
+	}
+	return status, nil
+}
+
+// BeginTOTPSetup 生成新的TOTP密钥，用户在身份验证器中添加后需用 EnableTOTP 确认
+func (s *UserService) BeginTOTPSetup(userID uint) (*TOTPSetup, error) {
+	user, err := s.userRepo.GetByID(userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.MFAEnabled {
+		return nil, errors.New("已启用两步验证，如需更换设备请先关闭")
+	}
+
+	secret, err := utils.GenerateTOTPSecret()
+	if err != nil {
+		return nil, err
+	}
+	if user.TOTPPendingSecret, err = s.encryptSecret(secret); err != nil {
+		return nil, err
+	}
+	if err := s.userRepo.Update(user); err != nil {
+		return nil, fmt.Errorf("保存TOTP密钥失败: %w", err)
+	}
+
+	return &TOTPSetup{
+		Secret:          secret,
+		ProvisioningURI: utils.TOTPProvisioningURI(mfaIssuer, user.Email, secret),
+	}, nil
+}
+
+// EnableTOTP 用身份验证器生成的验证码确认绑定，成功后返回恢复码（只显示这一次）
+func (s *UserService) EnableTOTP(userID uint, code string) ([]string, error) {
+	user, err := s.userRepo.GetByID(userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.MFAEnabled {
+		return nil, errors.New("已启用两步验证")
+	}
+	if user.TOTPPendingSecret == "" {
+		return nil, errors.New("请先获取绑定二维码")
+	}
+	if err := s.checkMFAFailures(userID); err != nil {
+		return nil, err
+	}
+
+	secret, err := s.decryptSecret(user.TOTPPendingSecret)
+	if err != nil {
+		return nil, err
+	}
+	step, ok := utils.ValidateTOTP(secret, code, time.Now())
+	if !ok {
+		return nil, s.recordMFAFailure(userID)
+	}
+
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	user.MFAEnabled = true
+	user.MFAEnabledAt = &now
+	user.TOTPSecret = user.TOTPPendingSecret
+	user.TOTPPendingSecret = ""
+	user.TOTPLastStep = step
+	if err := s.userRepo.Update(user); err != nil {
+		return nil, fmt.Errorf("启用两步验证失败: %w", err)
+	}
+	if err := s.mfaRepo.ReplaceRecoveryCodes(userID, hashes); err != nil {
+		return nil, err
+	}
+	s.clearMFAFailures(userID)
+
+	log.Printf("✅ Service: 已启用两步验证 - 用户ID: %d", userID)
+	return codes, nil
+}
+
+// DisableTOTP 关闭两步验证，需要密码和验证码（或恢复码），角色强制启用时不能关闭
+func (s *UserService) DisableTOTP(userID uint, password, code string) error {
+	user, err := s.userRepo.GetByID(userID)
+	if err != nil {
+		return err
+	}
+	if !user.MFAEnabled {
+		return errors.New("未启用两步验证")
+	}
+	if s.isMFARequired(user.Role) {
+		return errors.New("你的账号类型要求启用两步验证，不能关闭")
+	}
+	if !utils.CheckPasswordHash(password, user.PasswordHash) {
+		return errors.New("密码不正确")
+	}
+	if err := s.verifySecondFactor(user, code, true); err != nil {
+		return err
+	}
+
+	user.MFAEnabled = false
+	user.MFAEnabledAt = nil
+	user.TOTPSecret = ""
+	user.TOTPLastStep = 0
+	if err := s.userRepo.Update(user); err != nil {
+		return fmt.Errorf("关闭两步验证失败: %w", err)
+	}
+	if err := s.mfaRepo.DeleteRecoveryCodes(userID); err != nil {
+		return err
+	}
+
+	log.Printf("✅ Service: 已关闭两步验证 - 用户ID: %d", userID)
+	return nil
+}
+
+// RegenerateRecoveryCodes 重新生成恢复码，旧恢复码全部作废，需要身份验证器的验证码
+func (s *UserService) RegenerateRecoveryCodes(userID uint, code string) ([]string, error) {
+	user, err := s.userRepo.GetByID(userID)
+	if err != nil {
+		return nil, err
+	}
+	if !user.MFAEnabled {
+		return nil, errors.New("未启用两步验证")
+	}
+	if err := s.verifySecondFactor(user, code, false); err != nil {
+		return nil, err
+	}
+
+	codes, hashes, err := newRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.mfaRepo.ReplaceRecoveryCodes(userID, hashes); err != nil {
+		return nil, err
+	}
+	log.Printf("✅ Service: 已重新生成恢复码 - 用户ID: %d", userID)
+	return codes, nil
+}
+
+// VerifyMFALogin 登录第二步，校验挑战令牌和验证码（或恢复码）后签发登录令牌
+func (s *UserService) VerifyMFALogin(mfaToken, code string, client ClientInfo) (*LoginResult, error) {
+	userID, err := s.parseMFAToken(mfaToken, model.MFAPurposeVerify)
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.userRepo.GetByID(userID)
+	if err != nil {
+		return nil, err
+	}
+	if !user.MFAEnabled {
+		return nil, errors.New("未启用两步验证，请重新登录")
+	}
+	if err := s.verifySecondFactor(user, code, true); err != nil {
+		return nil, err
+	}
+
+	token, err := s.GenerateToken(user.ID, client)
+	if err != nil {
+		return nil, fmt.Errorf("生成访问令牌失败: %w", err)
+	}
+	return &LoginResult{Token: token, User: user}, nil
+}
+
+// MFASetupUserID 解析强制绑定用的挑战令牌，返回用户ID
+func (s *UserService) MFASetupUserID(mfaToken string) (uint, error) {
+	return s.parseMFAToken(mfaToken, model.MFAPurposeSetup)
+}
+
+// ListMFAPolicies 获取每个角色的两步验证策略，未设置的角色默认不强制
+func (s *UserService) ListMFAPolicies(adminID uint) ([]*model.MFAPolicy, error) {
+	admin, err := s.userRepo.GetByID(adminID)
+	if err != nil || !admin.IsAdmin() {
+		return nil, ErrNotAdmin
+	}
+	saved, err := s.mfaRepo.ListPolicies()
+	if err != nil {
+		return nil, err
+	}
+	byRole := make(map[string]*model.MFAPolicy, len(saved))
+	for _, policy := range saved {
+		byRole[policy.Role] = policy
+	}
+
+	policies := make([]*model.MFAPolicy, 0, len(model.MFARoles))
+	for _, role := range model.MFARoles {
+		if policy, ok := byRole[role]; ok {
+			policies = append(policies, policy)
+		} else {
+			policies = append(policies, &model.MFAPolicy{Role: role})
+		}
+	}
+	return policies, nil
+}
+
+// SetMFAPolicy 管理员设置某个角色是否强制两步验证，已登录的用户在下次登录时生效
+func (s *UserService) SetMFAPolicy(adminID uint, role string, required bool) (*model.MFAPolicy, error) {
+	admin, err := s.userRepo.GetByID(adminID)
+	if err != nil || !admin.IsAdmin() {
+		return nil, ErrNotAdmin
+	}
+	if !model.IsValidMFARole(role) {
+		return nil, fmt.Errorf("未知的角色: %s", role)
+	}
+
+	policy := &model.MFAPolicy{
+		Role:      role,
+		Required:  required,
+		UpdatedBy: adminID,
+		UpdatedAt: time.Now(),
+	}
+	if err := s.mfaRepo.SavePolicy(policy); err != nil {
+		return nil, err
+	}
+	log.Printf("✅ Service: 两步验证策略已更新 - 角色: %s, 强制: %v, 管理员ID: %d", role, required, adminID)
+	return policy, nil
+}
+
+// loginChallenge 密码校验通过后判断是否需要两步验证，需要时返回挑战令牌
+func (s *UserService) loginChallenge(user *model.User) (*LoginResult, error) {
+	purpose := ""
+	switch {
+	case user.MFAEnabled:
+		purpose = model.MFAPurposeVerify
+	case s.isMFARequired(user.Role):
+		purpose = model.MFAPurposeSetup
+	default:
+		return nil, nil
+	}
+
+	mfaToken, err := s.signMFAToken(user.ID, purpose)
+	if err != nil {
+		return nil, err
+	}
+	return &LoginResult{
+		User:             user,
+		MFAToken:         mfaToken,
+		MFASetupRequired: purpose == model.MFAPurposeSetup,
+	}, nil
+}
+
+// isMFARequired 角色是否强制两步验证，查询失败时按不强制处理，避免策略表异常导致无法登录
+func (s *UserService) isMFARequired(role string) bool {
+	if s.mfaRepo == nil {
+		return false
+	}
+	policy, err := s.mfaRepo.GetPolicy(role)
+	if err != nil {
+		log.Printf("⚠️ Service: %v", err)
+		return false
+	}
+	return policy != nil && policy.Required
+}
+
+// verifySecondFactor 校验身份验证器验证码，allowRecovery 为 true 时也接受恢复码
+func (s *UserService) verifySecondFactor(user *model.User, code string, allowRecovery bool) error {
+	if err := s.checkMFAFailures(user.ID); err != nil {
+		return err
+	}
+	code = strings.TrimSpace(code)
+
+	if len(code) == utils.TOTPDigits {
+		secret, err := s.decryptSecret(user.TOTPSecret)
+		if err != nil {
+			return err
+		}
+		// 同一时间步的验证码只能使用一次，以条件更新判断，并发重放时只有一个请求能通过
+		if step, ok := utils.ValidateTOTP(secret, code, time.Now()); ok && step > user.TOTPLastStep {
+			advanced, err := s.userRepo.AdvanceTOTPStep(user.ID, step)
+			if err != nil {
+				return err
+			}
+			if advanced {
+				user.TOTPLastStep = step
+				s.clearMFAFailures(user.ID)
+				return nil
+			}
+		}
+	} else if allowRecovery && code != "" {
+		used, err := s.mfaRepo.UseRecoveryCode(user.ID, hashRecoveryCode(code))
+		if err != nil {
+			return err
+		}
+		if used {
+			log.Printf("⚠️ Service: 使用恢复码登录 - 用户ID: %d", user.ID)
+			s.clearMFAFailures(user.ID)
+			return nil
+		}
+	}
+	return s.recordMFAFailure(user.ID)
+}
+
+// checkMFAFailures 错误次数过多时暂时拒绝验证
+func (s *UserService) checkMFAFailures(userID uint) error {
+	count, err := s.mfaRepo.CountFailures(userID)
+	if err != nil {
+		log.Printf("⚠️ Service: %v", err)
+		return nil
+	}
+	if count >= mfaMaxFailures {
+		return fmt.Errorf("验证码错误次数过多，请 %d 分钟后再试", int(mfaFailureWindow.Minutes()))
+	}
+	return nil
+}
+
+// recordMFAFailure 记录一次验证码错误并返回对应的错误信息
+func (s *UserService) recordMFAFailure(userID uint) error {
+	if _, err := s.mfaRepo.RecordFailure(userID, mfaFailureWindow); err != nil {
+		log.Printf("⚠️ Service: %v", err)
+	}
+	return errors.New("验证码不正确")
+}
+
+// clearMFAFailures 验证成功后清除错误次数
+func (s *UserService) clearMFAFailures(userID uint) {
+	if err := s.mfaRepo.ClearFailures(userID); err != nil {
+		log.Printf("⚠️ Service: 清除验证码错误次数失败 - %v", err)
+	}
+}
+
+// signMFAToken 签发两步验证挑战令牌
+func (s *UserService) signMFAToken(userID uint, purpose string) (string, error) {
+	now := time.Now()
+	claims := mfaClaims{
+		UserID:  userID,
+		Purpose: purpose,
+		RegisteredClaims: jwt.RegisteredClaims{
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(mfaChallengeTTL)),
+		},
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(s.mfaKey())
+	if err != nil {
+		return "", fmt.Errorf("签发两步验证令牌失败: %w", err)
+	}
+	return token, nil
+}
+
+// parseMFAToken 校验挑战令牌的签名、有效期和用途
+func (s *UserService) parseMFAToken(tokenString, purpose string) (uint, error) {
+	claims := &mfaClaims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, jwt.ErrSignatureInvalid
+		}
+		return s.mfaKey(), nil
+	})
+	if err != nil || !token.Valid || claims.Purpose != purpose || claims.UserID == 0 {
+		return 0, errors.New("验证已过期，请重新登录")
+	}
+	return claims.UserID, nil
+}
+
+// mfaKey 挑战令牌的签名密钥，与登录令牌区分用途
+func (s *UserService) mfaKey() []byte {
+	return []byte(s.jwtSecret + ":mfa")
+}
+
+// encryptSecret 使用 AES-GCM 加密TOTP密钥后保存
+func (s *UserService) encryptSecret(secret string) (string, error) {
+	gcm, err := s.secretCipher()
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", fmt.Errorf("加密TOTP密钥失败: %w", err)
+	}
+	sealed := gcm.Seal(nonce, nonce, []byte(secret), nil)
+	return base64.RawStdEncoding.EncodeToString(sealed), nil
+}
+
+// decryptSecret 解密保存的TOTP密钥
+func (s *UserService) decryptSecret(encrypted string) (string, error) {
+	gcm, err := s.secretCipher()
+	if err != nil {
+		return "", err
+	}
+	sealed, err := base64.RawStdEncoding.DecodeString(encrypted)
+	if err != nil || len(sealed) < gcm.NonceSize() {
+		return "", errors.New("TOTP密钥已损坏，请联系管理员")
+	}
+	plain, err := gcm.Open(nil, sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():], nil)
+	if err != nil {
+		return "", errors.New("TOTP密钥已损坏，请联系管理员")
+	}
+	return string(plain), nil
+}
+
+// secretCipher 由服务密钥派生TOTP密钥的加密算法
+func (s *UserService) secretCipher() (cipher.AEAD, error) {
+	key := sha256.Sum256([]byte(s.jwtSecret + ":totp"))
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, fmt.Errorf("初始化加密算法失败: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
+
+// newRecoveryCodes 生成一组恢复码，返回明文（格式 xxxxx-xxxxx）和对应哈希
+func newRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, 0, recoveryCodeCount)
+	hashes := make([]string, 0, recoveryCodeCount)
+	alphabetSize := big.NewInt(int64(len(recoveryAlphabet)))
+	for i := 0; i < recoveryCodeCount; i++ {
+		chars := make([]byte, 10)
+		for j := range chars {
+			n, err := rand.Int(rand.Reader, alphabetSize)
+			if err != nil {
+				return nil, nil, fmt.Errorf("生成恢复码失败: %w", err)
+			}
+			chars[j] = recoveryAlphabet[n.Int64()]
+		}
+		code := string(chars[:5]) + "-" + string(chars[5:])
+		codes = append(codes, code)
+		hashes = append(hashes, hashRecoveryCode(code))
+	}
+	return codes, hashes, nil
+}
+
+// hashRecoveryCode 计算恢复码哈希，忽略大小写、空格和连字符
+func hashRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,255 @@
+package service
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"strings"
+	"time"
+
+	"course-platform/internal/domain/user/model"
+	"course-platform/internal/shared/utils"
+)
+
+// 单点登录自动创建账号时的用户名长度上限
+const oidcUsernameMaxLen = 30
+
+// OIDCProfile 身份提供者返回的用户信息，ID Token 已由网关校验
+type OIDCProfile struct {
+	Provider          string
+	Subject           string
+	Email             string
+	EmailVerified     bool
+	Name              string
+	PreferredUsername string
+	Picture           string
+	TrustEmail        bool // 信任该提供者已验证的邮箱，首次登录时自动绑定同邮箱的已有账号
+}
+
+// LoginWithOIDC 单点登录：已绑定的身份直接登录，未绑定时按邮箱绑定已有账号或自动创建账号
+// 启用了两步验证的账号同样返回挑战令牌
+func (s *UserService) LoginWithOIDC(profile *OIDCProfile, client ClientInfo) (*LoginResult, error) {
+	if s.oidcRepo == nil {
+		return nil, errors.New("单点登录功能未配置")
+	}
+	if profile.Provider == "" || profile.Subject == "" {
+		return nil, errors.New("身份信息不完整")
+	}
+
+	identity, err := s.oidcRepo.GetBySubject(profile.Provider, profile.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	var user *model.User
+	if identity != nil {
+		if user, err = s.userRepo.GetByID(identity.UserID); err != nil {
+			return nil, fmt.Errorf("绑定的账号不存在: %w", err)
+		}
+	} else {
+		if user, err = s.provisionOIDCUser(profile); err != nil {
+			return nil, err
+		}
+		if identity, err = s.createOIDCIdentity(user.ID, profile); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.oidcRepo.TouchLogin(identity.ID, time.Now()); err != nil {
+		log.Printf("⚠️ Service: %v", err)
+	}
+	log.Printf("✅ Service: 单点登录身份校验通过 - 用户ID: %d, 提供者: %s", user.ID, profile.Provider)
+
+	challenge, err := s.loginChallenge(user)
+	if err != nil || challenge != nil {
+		return challenge, err
+	}
+	token, err := s.GenerateToken(user.ID, client)
+	if err != nil {
+		return nil, fmt.Errorf("生成访问令牌失败: %w", err)
+	}
+	return &LoginResult{Token: token, User: user}, nil
+}
+
+// LinkOIDCIdentity 已登录用户绑定身份提供者账号
+func (s *UserService) LinkOIDCIdentity(userID uint, profile *OIDCProfile) error {
+	if s.oidcRepo == nil {
+		return errors.New("单点登录功能未配置")
+	}
+	if profile.Provider == "" || profile.Subject == "" {
+		return errors.New("身份信息不完整")
+	}
+
+	existing, err := s.oidcRepo.GetBySubject(profile.Provider, profile.Subject)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		if existing.UserID == userID {
+			return nil
+		}
+		return errors.New("该身份已绑定其他账号")
+	}
+	linked, err := s.oidcRepo.GetByUserProvider(userID, profile.Provider)
+	if err != nil {
+		return err
+	}
+	if linked != nil {
+		return errors.New("你已绑定该身份提供者的其他账号，请先解除绑定")
+	}
+
+	if _, err := s.createOIDCIdentity(userID, profile); err != nil {
+		return err
+	}
+	log.Printf("✅ Service: 已绑定单点登录身份 - 用户ID: %d, 提供者: %s", userID, profile.Provider)
+	return nil
+}
+
+// ListOIDCIdentities 获取用户绑定的身份
+func (s *UserService) ListOIDCIdentities(userID uint) ([]*model.OIDCIdentity, error) {
+	if s.oidcRepo == nil {
+		return nil, errors.New("单点登录功能未配置")
+	}
+	return s.oidcRepo.ListByUser(userID)
+}
+
+// UnlinkOIDCIdentity 解除绑定，自动创建的账号解除后可通过找回密码设置密码登录
+func (s *UserService) UnlinkOIDCIdentity(userID uint, provider string) error {
+	if s.oidcRepo == nil {
+		return errors.New("单点登录功能未配置")
+	}
+	deleted, err := s.oidcRepo.Delete(userID, provider)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return errors.New("未绑定该身份提供者")
+	}
+	log.Printf("✅ Service: 已解除单点登录绑定 - 用户ID: %d, 提供者: %s", userID, provider)
+	return nil
+}
+
+// provisionOIDCUser 首次单点登录：信任的提供者按已验证邮箱绑定已有账号，邮箱未注册时自动创建账号
+func (s *UserService) provisionOIDCUser(profile *OIDCProfile) (*model.User, error) {
+	email := strings.TrimSpace(profile.Email)
+	if email == "" {
+		return nil, errors.New("身份提供者没有返回邮箱，无法创建账号")
+	}
+
+	exists, err := s.userRepo.ExistsByEmail(email)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		// 只有信任的提供者确认过邮箱才自动绑定，否则任何人都能用同名邮箱接管账号
+		if !profile.TrustEmail || !profile.EmailVerified {
+			return nil, errors.New("该邮箱已注册，请先用密码登录，再在账户安全中绑定单点登录")
+		}
+		user, err := s.userRepo.GetByEmail(email)
+		if err != nil {
+			return nil, err
+		}
+		log.Printf("🔍 Service: 单点登录按邮箱绑定已有账号 - 用户ID: %d, 提供者: %s", user.ID, profile.Provider)
+		return user, nil
+	}
+
+	username, err := s.uniqueOIDCUsername(profile)
+	if err != nil {
+		return nil, err
+	}
+	// 自动创建的账号没有可用密码，需要时可通过找回密码设置
+	randomPassword := make([]byte, 32)
+	if _, err := rand.Read(randomPassword); err != nil {
+		return nil, fmt.Errorf("生成随机密码失败: %w", err)
+	}
+	hashedPassword, err := utils.HashPassword(base64.RawURLEncoding.EncodeToString(randomPassword))
+	if err != nil {
+		return nil, fmt.Errorf("密码加密失败: %w", err)
+	}
+
+	nickname := strings.TrimSpace(profile.Name)
+	if nickname == "" {
+		nickname = username
+	}
+	user := &model.User{
+		Username:     username,
+		Email:        email,
+		PasswordHash: hashedPassword,
+		Nickname:     nickname,
+		Avatar:       profile.Picture,
+		AvatarURL:    profile.Picture,
+		Locale:       utils.NormalizeLocale(""),
+		EmailStatus:  model.EmailStatusPending,
+	}
+	now := time.Now()
+	if profile.EmailVerified {
+		user.EmailStatus = model.EmailStatusVerified
+		user.EmailVerifiedAt = &now
+	}
+	if err := s.userRepo.Create(user); err != nil {
+		return nil, fmt.Errorf("创建用户失败: %w", err)
+	}
+	log.Printf("✅ Service: 单点登录自动创建账号 - 用户ID: %d, 提供者: %s", user.ID, profile.Provider)
+
+	if !user.IsEmailVerified() {
+		if err := s.sendVerification(user); err != nil {
+			log.Printf("⚠️ Service: 验证邮件发送失败 - 用户ID: %d, 错误: %v", user.ID, err)
+		}
+	}
+	return user, nil
+}
+
+// createOIDCIdentity 保存绑定关系
+func (s *UserService) createOIDCIdentity(userID uint, profile *OIDCProfile) (*model.OIDCIdentity, error) {
+	identity := &model.OIDCIdentity{
+		UserID:   userID,
+		Provider: profile.Provider,
+		Subject:  profile.Subject,
+		Email:    profile.Email,
+	}
+	if err := s.oidcRepo.Create(identity); err != nil {
+		return nil, err
+	}
+	return identity, nil
+}
+
+// uniqueOIDCUsername 根据 preferred_username 或邮箱前缀生成未被占用的用户名
+func (s *UserService) uniqueOIDCUsername(profile *OIDCProfile) (string, error) {
+	base := profile.PreferredUsername
+	if base == "" || strings.Contains(base, "@") {
+		base, _, _ = strings.Cut(profile.Email, "@")
+	}
+	base = strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '_', r == '-', r == '.':
+			return r
+		}
+		return -1
+	}, base)
+	if len(base) < 3 {
+		base = "user"
+	}
+	if len(base) > oidcUsernameMaxLen-5 {
+		base = base[:oidcUsernameMaxLen-5]
+	}
+
+	candidate := base
+	for i := 0; i < 5; i++ {
+		exists, err := s.userRepo.ExistsByUsername(candidate)
+		if err != nil {
+			return "", err
+		}
+		if !exists {
+			return candidate, nil
+		}
+		suffix, err := rand.Int(rand.Reader, big.NewInt(10000))
+		if err != nil {
+			return "", fmt.Errorf("生成用户名失败: %w", err)
+		}
+		candidate = fmt.Sprintf("%s_%04d", base, suffix.Int64())
+	}
+	return "", errors.New("无法生成可用的用户名，请稍后重试")
+}
@@ -0,0 +1,174 @@
+package service
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	emailModel "course-platform/internal/domain/email/model"
+	emailService "course-platform/internal/domain/email/service"
+	"course-platform/internal/domain/user/model"
+	"course-platform/internal/shared/utils"
+)
+
+// 找回密码参数
+const (
+	passwordResetTTL      = 30 * time.Minute // 重置链接有效期
+	passwordResetInterval = time.Minute      // 同一账号两次申请的最小间隔
+)
+
+// ForgotPassword 申请重置密码，向账号邮箱发送一次性重置链接
+// 无论邮箱是否注册都返回成功，避免通过此接口探测账号是否存在；
+// 查询账号和发信在后台进行，失败只记录日志，响应内容和耗时都不随账号是否存在而变化
+func (s *UserService) ForgotPassword(email string) error {
+	if s.resetRepo == nil || s.emailSvc == nil {
+		return errors.New("找回密码功能未配置")
+	}
+	email = strings.TrimSpace(email)
+	if email == "" {
+		return errors.New("邮箱不能为空")
+	}
+
+	go func() {
+		if err := s.sendPasswordReset(email); err != nil {
+			log.Printf("❌ Service: 发送重置密码邮件失败 - %v", err)
+		}
+	}()
+	return nil
+}
+
+// sendPasswordReset 为已注册的邮箱生成重置令牌并发送邮件，未注册或申请过于频繁时静默忽略
+func (s *UserService) sendPasswordReset(email string) error {
+	user, err := s.userRepo.GetByEmail(email)
+	if err != nil {
+		log.Printf("🔍 Service: 申请重置密码的邮箱未注册")
+		return nil
+	}
+
+	latest, err := s.resetRepo.GetLatestByUser(user.ID)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	if latest != nil && now.Sub(latest.CreatedAt) < passwordResetInterval {
+		log.Printf("⚠️ Service: 重置密码申请过于频繁 - 用户ID: %d", user.ID)
+		return nil
+	}
+
+	token, err := newResetToken()
+	if err != nil {
+		return err
+	}
+	// 新链接发出后旧链接全部作废
+	if err := s.resetRepo.InvalidateByUser(user.ID, now); err != nil {
+		return err
+	}
+	if err := s.resetRepo.Create(&model.PasswordReset{
+		UserID:    user.ID,
+		TokenHash: hashResetToken(token),
+		ExpiresAt: now.Add(passwordResetTTL),
+	}); err != nil {
+		return err
+	}
+
+	if err := s.emailSvc.SendToUser(user.ID, emailModel.CategoryAccount, emailService.TemplatePasswordReset, map[string]interface{}{
+		"ResetPath":      "/reset-password?token=" + url.QueryEscape(token),
+		"ExpiresMinutes": int(passwordResetTTL.Minutes()),
+	}); err != nil {
+		return fmt.Errorf("用户ID %d: %w", user.ID, err)
+	}
+	log.Printf("✅ Service: 重置密码邮件已发送 - 用户ID: %d", user.ID)
+	return nil
+}
+
+// ResetPassword 使用重置链接中的令牌设置新密码，令牌只能使用一次
+// 重置成功后吊销该用户已签发的全部登录令牌
+func (s *UserService) ResetPassword(token, newPassword string) error {
+	if s.resetRepo == nil {
+		return errors.New("找回密码功能未配置")
+	}
+	if len(newPassword) < 8 {
+		return fmt.Errorf("新密码长度不能少于8位")
+	}
+	if len(newPassword) > 30 {
+		return fmt.Errorf("新密码长度不能超过30位")
+	}
+
+	invalid := errors.New("重置链接无效或已过期，请重新申请")
+	if token == "" {
+		return invalid
+	}
+	reset, err := s.resetRepo.GetByTokenHash(hashResetToken(token))
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	if reset == nil || !reset.IsUsable(now) {
+		return invalid
+	}
+	user, err := s.userRepo.GetByID(reset.UserID)
+	if err != nil {
+		return invalid
+	}
+
+	hashedPassword, err := utils.HashPassword(newPassword)
+	if err != nil {
+		return fmt.Errorf("新密码加密失败: %w", err)
+	}
+	// 先占用令牌，并发提交同一链接时只有一次成功
+	claimed, err := s.resetRepo.MarkUsed(reset.ID, now)
+	if err != nil {
+		return err
+	}
+	if !claimed {
+		return invalid
+	}
+
+	user.PasswordHash = hashedPassword
+	// 能收到重置邮件说明邮箱属于本人，顺便完成邮箱验证
+	if !user.IsEmailVerified() {
+		user.EmailStatus = model.EmailStatusVerified
+		user.EmailVerifiedAt = &now
+	}
+	if err := s.userRepo.Update(user); err != nil {
+		return fmt.Errorf("更新密码失败: %w", err)
+	}
+	if err := s.userRepo.RevokeTokens(user.ID, now); err != nil {
+		return err
+	}
+	if s.sessionRepo != nil {
+		if err := s.sessionRepo.RevokeAll(user.ID, now); err != nil {
+			log.Printf("⚠️ Service: 注销登录会话失败 - 用户ID: %d, 错误: %v", user.ID, err)
+		}
+	}
+	if err := s.resetRepo.InvalidateByUser(user.ID, now); err != nil {
+		log.Printf("⚠️ Service: 作废其余重置链接失败 - 用户ID: %d, 错误: %v", user.ID, err)
+	}
+	// 重置密码后解除因多次登录失败导致的锁定
+	s.clearLoginFailures(loginAccountKey(user, ""))
+
+	log.Printf("✅ Service: 密码已重置 - 用户ID: %d", user.ID)
+	return nil
+}
+
+// newResetToken 生成32字节随机重置令牌
+func newResetToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("生成重置令牌失败: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// hashResetToken 计算令牌哈希，数据库中只保存哈希，泄露后也无法还原链接
+func hashResetToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
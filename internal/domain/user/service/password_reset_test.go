@@ -0,0 +1,279 @@
+package service
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	emailService "course-platform/internal/domain/email/service"
+	"course-platform/internal/domain/user/model"
+	"course-platform/internal/domain/user/repository"
+	"course-platform/internal/shared/utils"
+)
+
+const (
+	testEmail    = "alice@example.com"
+	testPassword = "old-password"
+)
+
+// memoryUserRepo 内存用户仓储，只实现账号安全相关流程用到的方法
+type memoryUserRepo struct {
+	repository.UserRepositoryInterface
+	users     map[uint]*model.User
+	revokedAt map[uint]time.Time
+	err       error // 不为空时查询令牌吊销时间失败
+}
+
+func newMemoryUserRepo(t *testing.T) *memoryUserRepo {
+	t.Helper()
+	hash, err := utils.HashPassword(testPassword)
+	if err != nil {
+		t.Fatalf("密码加密失败: %v", err)
+	}
+	return &memoryUserRepo{
+		users: map[uint]*model.User{
+			1: {ID: 1, Email: testEmail, Username: testEmail, PasswordHash: hash, Role: model.RoleUser, EmailStatus: model.EmailStatusVerified},
+		},
+		revokedAt: make(map[uint]time.Time),
+	}
+}
+
+func (r *memoryUserRepo) GetByID(id uint) (*model.User, error) {
+	if user, ok := r.users[id]; ok {
+		copied := *user
+		return &copied, nil
+	}
+	return nil, errors.New("用户不存在")
+}
+
+func (r *memoryUserRepo) GetByEmail(email string) (*model.User, error) {
+	for _, user := range r.users {
+		if user.Email == email {
+			copied := *user
+			return &copied, nil
+		}
+	}
+	return nil, errors.New("用户不存在")
+}
+
+func (r *memoryUserRepo) GetByUsername(username string) (*model.User, error) {
+	for _, user := range r.users {
+		if user.Username == username {
+			copied := *user
+			return &copied, nil
+		}
+	}
+	return nil, errors.New("用户不存在")
+}
+
+func (r *memoryUserRepo) Update(user *model.User) error {
+	copied := *user
+	r.users[user.ID] = &copied
+	return nil
+}
+
+func (r *memoryUserRepo) RevokeTokens(userID uint, at time.Time) error {
+	r.revokedAt[userID] = at
+	return nil
+}
+
+func (r *memoryUserRepo) GetTokensRevokedAt(userID uint) (time.Time, error) {
+	if r.err != nil {
+		return time.Time{}, r.err
+	}
+	return r.revokedAt[userID], nil
+}
+
+// memoryResetRepo 内存密码重置令牌仓储
+type memoryResetRepo struct {
+	resets []*model.PasswordReset
+}
+
+func (r *memoryResetRepo) Create(reset *model.PasswordReset) error {
+	reset.ID = uint(len(r.resets) + 1)
+	if reset.CreatedAt.IsZero() {
+		reset.CreatedAt = time.Now()
+	}
+	r.resets = append(r.resets, reset)
+	return nil
+}
+
+func (r *memoryResetRepo) GetByTokenHash(tokenHash string) (*model.PasswordReset, error) {
+	for _, reset := range r.resets {
+		if reset.TokenHash == tokenHash {
+			copied := *reset
+			return &copied, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *memoryResetRepo) GetLatestByUser(userID uint) (*model.PasswordReset, error) {
+	for i := len(r.resets) - 1; i >= 0; i-- {
+		if r.resets[i].UserID == userID {
+			return r.resets[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *memoryResetRepo) MarkUsed(id uint, usedAt time.Time) (bool, error) {
+	reset := r.resets[id-1]
+	if reset.UsedAt != nil {
+		return false, nil
+	}
+	reset.UsedAt = &usedAt
+	return true, nil
+}
+
+func (r *memoryResetRepo) InvalidateByUser(userID uint, at time.Time) error {
+	for _, reset := range r.resets {
+		if reset.UserID == userID && reset.UsedAt == nil {
+			reset.UsedAt = &at
+		}
+	}
+	return nil
+}
+
+// stubEmailService 邮件服务桩，记录发送的模板和数据
+type stubEmailService struct {
+	emailService.EmailServiceInterface
+	templates []string
+	data      []map[string]interface{}
+}
+
+func (s *stubEmailService) SendToUser(userID uint, category, template string, data map[string]interface{}) error {
+	s.templates = append(s.templates, template)
+	s.data = append(s.data, data)
+	return nil
+}
+
+// resetTokenFromEmail 从最近一封重置密码邮件的链接中取出令牌
+func resetTokenFromEmail(t *testing.T, emails *stubEmailService) string {
+	t.Helper()
+	if len(emails.data) == 0 {
+		t.Fatal("没有发送重置密码邮件")
+	}
+	path := emails.data[len(emails.data)-1]["ResetPath"].(string)
+	link, err := url.Parse(path)
+	if err != nil {
+		t.Fatalf("重置链接格式错误: %v", err)
+	}
+	return link.Query().Get("token")
+}
+
+func newResetTestService(t *testing.T) (*UserService, *memoryUserRepo, *memoryResetRepo, *stubEmailService) {
+	users := newMemoryUserRepo(t)
+	resets := &memoryResetRepo{}
+	emails := &stubEmailService{}
+	service := NewUserService(users, resets, nil, nil, nil, nil, nil, emails).(*UserService)
+	return service, users, resets, emails
+}
+
+func TestSendPasswordReset(t *testing.T) {
+	service, _, resets, emails := newResetTestService(t)
+
+	if err := service.sendPasswordReset("nobody@example.com"); err != nil {
+		t.Fatalf("未注册邮箱应静默忽略: %v", err)
+	}
+	if len(emails.templates) != 0 {
+		t.Fatal("未注册邮箱不应发送邮件")
+	}
+
+	if err := service.sendPasswordReset(testEmail); err != nil {
+		t.Fatalf("发送重置邮件失败: %v", err)
+	}
+	if len(emails.templates) != 1 || emails.templates[0] != emailService.TemplatePasswordReset {
+		t.Fatalf("邮件 = %v, want [%s]", emails.templates, emailService.TemplatePasswordReset)
+	}
+	token := resetTokenFromEmail(t, emails)
+	if resets.resets[0].TokenHash != hashResetToken(token) {
+		t.Error("数据库中应只保存令牌哈希")
+	}
+
+	// 间隔内重复申请不再发送
+	if err := service.sendPasswordReset(testEmail); err != nil {
+		t.Fatalf("重复申请失败: %v", err)
+	}
+	if len(emails.templates) != 1 {
+		t.Errorf("间隔内重复申请发送了 %d 封邮件, want 1", len(emails.templates))
+	}
+}
+
+func TestResetPassword(t *testing.T) {
+	tests := []struct {
+		name    string
+		prepare func(t *testing.T, service *UserService, resets *memoryResetRepo, token string) string // 返回提交的令牌
+		wantErr bool
+	}{
+		{"有效令牌", func(t *testing.T, service *UserService, resets *memoryResetRepo, token string) string {
+			return token
+		}, false},
+		{"令牌不能重复使用", func(t *testing.T, service *UserService, resets *memoryResetRepo, token string) string {
+			if err := service.ResetPassword(token, "first-password"); err != nil {
+				t.Fatalf("第一次重置失败: %v", err)
+			}
+			return token
+		}, true},
+		{"令牌已过期", func(t *testing.T, service *UserService, resets *memoryResetRepo, token string) string {
+			resets.resets[0].ExpiresAt = time.Now().Add(-time.Second)
+			return token
+		}, true},
+		{"重新申请后旧令牌作废", func(t *testing.T, service *UserService, resets *memoryResetRepo, token string) string {
+			resets.resets[0].CreatedAt = time.Now().Add(-passwordResetInterval)
+			if err := service.sendPasswordReset(testEmail); err != nil {
+				t.Fatalf("重新申请失败: %v", err)
+			}
+			return token
+		}, true},
+		{"未知令牌", func(t *testing.T, service *UserService, resets *memoryResetRepo, token string) string {
+			return token + "x"
+		}, true},
+		{"空令牌", func(t *testing.T, service *UserService, resets *memoryResetRepo, token string) string {
+			return ""
+		}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, users, resets, emails := newResetTestService(t)
+			if err := service.sendPasswordReset(testEmail); err != nil {
+				t.Fatalf("发送重置邮件失败: %v", err)
+			}
+			token := tt.prepare(t, service, resets, resetTokenFromEmail(t, emails))
+			before := *users.users[1]
+
+			err := service.ResetPassword(token, "new-password")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			changed := utils.CheckPasswordHash("new-password", users.users[1].PasswordHash)
+			if changed == tt.wantErr {
+				t.Errorf("密码已修改 = %v, want %v", changed, !tt.wantErr)
+			}
+			if tt.wantErr && users.users[1].PasswordHash != before.PasswordHash {
+				t.Error("重置失败时密码不应变化")
+			}
+			if !tt.wantErr && users.revokedAt[1].IsZero() {
+				t.Error("重置成功后应吊销已签发的登录令牌")
+			}
+		})
+	}
+}
+
+func TestResetPasswordLength(t *testing.T) {
+	service, _, _, emails := newResetTestService(t)
+	if err := service.sendPasswordReset(testEmail); err != nil {
+		t.Fatalf("发送重置邮件失败: %v", err)
+	}
+	token := resetTokenFromEmail(t, emails)
+
+	if err := service.ResetPassword(token, "short"); err == nil {
+		t.Fatal("过短的密码应被拒绝")
+	}
+	// 密码不合规时不消耗令牌
+	if err := service.ResetPassword(token, "new-password"); err != nil {
+		t.Fatalf("令牌应仍可使用: %v", err)
+	}
+}
@@ -0,0 +1,179 @@
+package service
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"course-platform/internal/domain/user/model"
+)
+
+// 登录会话参数
+const (
+	tokenTTL             = 7 * 24 * time.Hour // 登录令牌和会话的有效期
+	sessionTouchThrottle = time.Minute        // 同一会话两次记录活动的最小间隔
+)
+
+// ClientInfo 发起登录的客户端信息，记录在登录会话中
+type ClientInfo struct {
+	IP        string
+	UserAgent string
+}
+
+// createSession 为新签发的登录令牌创建会话，返回令牌中的 sid
+func (s *UserService) createSession(userID uint, client ClientInfo, now time.Time) (string, error) {
+	sessionID, err := newSessionID()
+	if err != nil {
+		return "", err
+	}
+	userAgent := client.UserAgent
+	if len(userAgent) > 500 {
+		userAgent = userAgent[:500]
+	}
+	session := &model.UserSession{
+		UserID:     userID,
+		SessionID:  sessionID,
+		Device:     describeDevice(client.UserAgent),
+		UserAgent:  userAgent,
+		IP:         client.IP,
+		LastSeenAt: now,
+		ExpiresAt:  now.Add(tokenTTL),
+	}
+	if err := s.sessionRepo.Create(session); err != nil {
+		return "", err
+	}
+	log.Printf("✅ Service: 已创建登录会话 - 用户ID: %d, 设备: %s, IP: %s", userID, session.Device, client.IP)
+	return sessionID, nil
+}
+
+// ListSessions 获取用户有效的登录会话
+func (s *UserService) ListSessions(userID uint) ([]*model.UserSession, error) {
+	if s.sessionRepo == nil {
+		return nil, errors.New("会话管理功能未配置")
+	}
+	return s.sessionRepo.ListActive(userID)
+}
+
+// RevokeSession 注销用户的指定会话
+func (s *UserService) RevokeSession(userID, id uint) error {
+	if s.sessionRepo == nil {
+		return errors.New("会话管理功能未配置")
+	}
+	revoked, err := s.sessionRepo.Revoke(userID, id, time.Now())
+	if err != nil {
+		return err
+	}
+	if !revoked {
+		return errors.New("会话不存在或已注销")
+	}
+	log.Printf("✅ Service: 已注销登录会话 - 用户ID: %d, 会话ID: %d", userID, id)
+	return nil
+}
+
+// RevokeOtherSessions 注销除当前会话外的全部会话，返回注销的数量
+func (s *UserService) RevokeOtherSessions(userID uint, currentSessionID string) (int64, error) {
+	if s.sessionRepo == nil {
+		return 0, errors.New("会话管理功能未配置")
+	}
+	if currentSessionID == "" {
+		return 0, errors.New("无法识别当前会话，请重新登录")
+	}
+	count, err := s.sessionRepo.RevokeOthers(userID, currentSessionID, time.Now())
+	if err != nil {
+		return 0, err
+	}
+	log.Printf("✅ Service: 已注销其他登录会话 - 用户ID: %d, 数量: %d", userID, count)
+	return count, nil
+}
+
+// TouchSession 记录会话的最近活动，由认证中间件在每个已登录请求后调用
+// 同一会话在 sessionTouchThrottle 内只写一次，避免每个请求都访问数据库
+func (s *UserService) TouchSession(sessionID, clientIP string) {
+	if s.sessionRepo == nil || sessionID == "" {
+		return
+	}
+	now := time.Now()
+	if last, ok := s.sessionTouched.Load(sessionID); ok && now.Sub(last.(time.Time)) < sessionTouchThrottle {
+		return
+	}
+	s.sessionTouched.Store(sessionID, now)
+	if err := s.sessionRepo.Touch(sessionID, clientIP, now); err != nil {
+		log.Printf("⚠️ Service: %v", err)
+	}
+}
+
+// isSessionRevoked 判断令牌对应的会话是否已注销，启用会话管理后没有 sid 的旧令牌一律视为失效
+// 查询失败时视为已注销并返回错误，由调用方决定如何响应
+func (s *UserService) isSessionRevoked(sessionID string) (bool, error) {
+	if s.sessionRepo == nil {
+		return false, nil
+	}
+	if sessionID == "" {
+		return true, nil
+	}
+	active, err := s.sessionRepo.IsActive(sessionID)
+	if err != nil {
+		log.Printf("❌ Service: 查询登录会话失败 - %v", err)
+		return true, err
+	}
+	return !active, nil
+}
+
+// newSessionID 生成24字节随机会话标识
+func newSessionID() (string, error) {
+	buf := make([]byte, 24)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("生成会话标识失败: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// describeDevice 从 User-Agent 识别浏览器和操作系统，用于会话列表展示
+func describeDevice(userAgent string) string {
+	if userAgent == "" {
+		return "未知设备"
+	}
+
+	browser := "未知浏览器"
+	switch {
+	case strings.Contains(userAgent, "Edg/"):
+		browser = "Edge"
+	case strings.Contains(userAgent, "OPR/"):
+		browser = "Opera"
+	case strings.Contains(userAgent, "Firefox/"):
+		browser = "Firefox"
+	case strings.Contains(userAgent, "Chrome/"):
+		browser = "Chrome"
+	case strings.Contains(userAgent, "Safari/"):
+		browser = "Safari"
+	case strings.Contains(userAgent, "curl/"):
+		browser = "curl"
+	}
+
+	system := ""
+	switch {
+	case strings.Contains(userAgent, "Android"):
+		system = "Android"
+	case strings.Contains(userAgent, "iPhone"):
+		system = "iPhone"
+	case strings.Contains(userAgent, "iPad"):
+		system = "iPad"
+	case strings.Contains(userAgent, "Windows"):
+		system = "Windows"
+	case strings.Contains(userAgent, "Mac OS X"), strings.Contains(userAgent, "Macintosh"):
+		system = "macOS"
+	case strings.Contains(userAgent, "CrOS"):
+		system = "ChromeOS"
+	case strings.Contains(userAgent, "Linux"):
+		system = "Linux"
+	}
+
+	if system == "" {
+		return browser
+	}
+	return browser + " · " + system
+}
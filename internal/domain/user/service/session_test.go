@@ -0,0 +1,223 @@
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"course-platform/internal/domain/user/model"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// memorySessionRepo 内存登录会话仓储，err 不为空时查询会话失败
+type memorySessionRepo struct {
+	sessions []*model.UserSession
+	touches  int
+	err      error
+}
+
+func (r *memorySessionRepo) Create(session *model.UserSession) error {
+	session.ID = uint(len(r.sessions) + 1)
+	r.sessions = append(r.sessions, session)
+	return nil
+}
+
+func (r *memorySessionRepo) ListActive(userID uint) ([]*model.UserSession, error) {
+	var active []*model.UserSession
+	for _, session := range r.sessions {
+		if session.UserID == userID && session.RevokedAt == nil {
+			active = append(active, session)
+		}
+	}
+	return active, nil
+}
+
+func (r *memorySessionRepo) IsActive(sessionID string) (bool, error) {
+	if r.err != nil {
+		return false, r.err
+	}
+	for _, session := range r.sessions {
+		if session.SessionID == sessionID {
+			return session.RevokedAt == nil && time.Now().Before(session.ExpiresAt), nil
+		}
+	}
+	return false, nil
+}
+
+func (r *memorySessionRepo) Revoke(userID, id uint, at time.Time) (bool, error) {
+	for _, session := range r.sessions {
+		if session.ID == id && session.UserID == userID && session.RevokedAt == nil {
+			session.RevokedAt = &at
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *memorySessionRepo) RevokeOthers(userID uint, keepSessionID string, at time.Time) (int64, error) {
+	var count int64
+	for _, session := range r.sessions {
+		if session.UserID == userID && session.SessionID != keepSessionID && session.RevokedAt == nil {
+			session.RevokedAt = &at
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *memorySessionRepo) RevokeAll(userID uint, at time.Time) error {
+	_, err := r.RevokeOthers(userID, "", at)
+	return err
+}
+
+func (r *memorySessionRepo) Touch(sessionID, ip string, at time.Time) error {
+	r.touches++
+	return nil
+}
+
+func newSessionTestService(t *testing.T) (*UserService, *memoryUserRepo, *memorySessionRepo) {
+	users := newMemoryUserRepo(t)
+	sessions := &memorySessionRepo{}
+	service := NewUserService(users, &memoryResetRepo{}, nil, nil, nil, sessions, nil, &stubEmailService{}).(*UserService)
+	return service, users, sessions
+}
+
+// sessionIDOf 取出登录令牌中的会话标识
+func sessionIDOf(t *testing.T, token string) string {
+	t.Helper()
+	claims := jwt.MapClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(token, claims); err != nil {
+		t.Fatalf("解析令牌失败: %v", err)
+	}
+	sid, _ := claims["sid"].(string)
+	return sid
+}
+
+func TestValidateTokenSession(t *testing.T) {
+	lookupErr := errors.New("数据库连接失败")
+	tests := []struct {
+		name    string
+		prepare func(t *testing.T, service *UserService, users *memoryUserRepo, sessions *memorySessionRepo, token string) string // 返回要校验的令牌
+		wantErr bool
+	}{
+		{"有效会话", func(t *testing.T, service *UserService, users *memoryUserRepo, sessions *memorySessionRepo, token string) string {
+			return token
+		}, false},
+		{"注销当前会话", func(t *testing.T, service *UserService, users *memoryUserRepo, sessions *memorySessionRepo, token string) string {
+			if err := service.RevokeSession(1, sessions.sessions[0].ID); err != nil {
+				t.Fatalf("注销会话失败: %v", err)
+			}
+			return token
+		}, true},
+		{"注销其他会话不影响当前会话", func(t *testing.T, service *UserService, users *memoryUserRepo, sessions *memorySessionRepo, token string) string {
+			other, err := service.GenerateToken(1, ClientInfo{})
+			if err != nil {
+				t.Fatalf("签发令牌失败: %v", err)
+			}
+			if _, err := service.RevokeOtherSessions(1, sessionIDOf(t, token)); err != nil {
+				t.Fatalf("注销其他会话失败: %v", err)
+			}
+			if _, err := service.ValidateToken(other); err == nil {
+				t.Error("其他会话的令牌应已失效")
+			}
+			return token
+		}, false},
+		{"重置密码注销全部会话", func(t *testing.T, service *UserService, users *memoryUserRepo, sessions *memorySessionRepo, token string) string {
+			if err := sessions.RevokeAll(1, time.Now()); err != nil {
+				t.Fatalf("注销全部会话失败: %v", err)
+			}
+			return token
+		}, true},
+		{"会话已过期", func(t *testing.T, service *UserService, users *memoryUserRepo, sessions *memorySessionRepo, token string) string {
+			sessions.sessions[0].ExpiresAt = time.Now().Add(-time.Second)
+			return token
+		}, true},
+		{"没有会话标识的令牌", func(t *testing.T, service *UserService, users *memoryUserRepo, sessions *memorySessionRepo, token string) string {
+			legacy, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+				"user_id": 1,
+				"exp":     time.Now().Add(time.Hour).Unix(),
+				"iat":     time.Now().Unix(),
+			}).SignedString([]byte(service.jwtSecret))
+			if err != nil {
+				t.Fatalf("签发令牌失败: %v", err)
+			}
+			return legacy
+		}, true},
+		{"会话查询失败", func(t *testing.T, service *UserService, users *memoryUserRepo, sessions *memorySessionRepo, token string) string {
+			sessions.err = lookupErr
+			return token
+		}, true},
+		{"吊销时间查询失败", func(t *testing.T, service *UserService, users *memoryUserRepo, sessions *memorySessionRepo, token string) string {
+			users.err = lookupErr
+			return token
+		}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, users, sessions := newSessionTestService(t)
+			token, err := service.GenerateToken(1, ClientInfo{IP: "10.0.0.1", UserAgent: "Mozilla/5.0 (Macintosh) Chrome/120.0"})
+			if err != nil {
+				t.Fatalf("签发令牌失败: %v", err)
+			}
+
+			userID, err := service.ValidateToken(tt.prepare(t, service, users, sessions, token))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && userID != 1 {
+				t.Errorf("userID = %d, want 1", userID)
+			}
+		})
+	}
+}
+
+func TestIsTokenRevokedFailsClosed(t *testing.T) {
+	service, users, sessions := newSessionTestService(t)
+	token, err := service.GenerateToken(1, ClientInfo{})
+	if err != nil {
+		t.Fatalf("签发令牌失败: %v", err)
+	}
+	sid := sessionIDOf(t, token)
+
+	sessions.err = errors.New("缓存超时")
+	if revoked, err := service.IsTokenRevoked(1, sid, time.Now()); !revoked || err == nil {
+		t.Errorf("会话查询失败: (%v, %v), want (true, error)", revoked, err)
+	}
+
+	sessions.err = nil
+	users.err = errors.New("数据库连接失败")
+	if revoked, err := service.IsTokenRevoked(1, sid, time.Now()); !revoked || err == nil {
+		t.Errorf("吊销时间查询失败: (%v, %v), want (true, error)", revoked, err)
+	}
+
+	users.err = nil
+	users.revokedAt[1] = time.Now().Add(time.Minute)
+	if revoked, err := service.IsTokenRevoked(1, sid, time.Now()); !revoked || err != nil {
+		t.Errorf("吊销时间之前签发: (%v, %v), want (true, nil)", revoked, err)
+	}
+}
+
+func TestTouchSessionThrottle(t *testing.T) {
+	service, _, sessions := newSessionTestService(t)
+
+	service.TouchSession("sid-1", "10.0.0.1")
+	service.TouchSession("sid-1", "10.0.0.1")
+	service.TouchSession("sid-2", "10.0.0.1")
+	if sessions.touches != 2 {
+		t.Fatalf("写入 %d 次, want 2", sessions.touches)
+	}
+
+	// 超过间隔后再次写入
+	service.sessionTouched.Store("sid-1", time.Now().Add(-sessionTouchThrottle))
+	service.TouchSession("sid-1", "10.0.0.1")
+	if sessions.touches != 3 {
+		t.Errorf("写入 %d 次, want 3", sessions.touches)
+	}
+
+	service.TouchSession("", "10.0.0.1")
+	if sessions.touches != 3 {
+		t.Errorf("没有会话标识时不应写入")
+	}
+}
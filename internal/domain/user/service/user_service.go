@@ -2,8 +2,13 @@
 
 import (
 	"fmt"
+	"log"
+	"net/mail"
+	"strings"
+	"sync"
 	"time"
 
+	emailService "course-platform/internal/domain/email/service"
 	"course-platform/internal/domain/user/model"
 	"course-platform/internal/domain/user/repository"
 	"course-platform/internal/shared/utils"
@@ -15,8 +20,8 @@ import (
 // 定义用户业务逻辑的标准方法
 type UserServiceInterface interface {
 	// 核心业务方法
-	Register(username, email, password, nickname string) (*model.User, error)
-	Login(identifier, password string) (string, *model.User, error) // 支持用户名或邮箱登录
+	Register(username, email, password, nickname, locale string) (*model.User, error)
+	Login(identifier, password string, client ClientInfo) (*LoginResult, error) // 支持用户名或邮箱登录，启用两步验证时返回挑战令牌，失败过多时返回 LoginLockedError
 	GetUserByID(userID uint) (*model.User, error)
 	GetUserByEmail(email string) (*model.User, error)
 	GetUserByUsername(username string) (*model.User, error)
@@ -24,28 +29,85 @@ type UserServiceInterface interface {
 	UpdateProfileComplete(userID uint, nickname, avatarURL, phone, bio string) (*model.User, error)
 	ChangePassword(userID uint, oldPassword, newPassword string) error
 
+	// 邮箱验证
+	VerifyEmail(token string) (*model.User, error)
+	ResendVerification(userID uint) error
+
+	// 找回密码
+	ForgotPassword(email string) error
+	ResetPassword(token, newPassword string) error
+
+	// 两步验证
+	VerifyMFALogin(mfaToken, code string, client ClientInfo) (*LoginResult, error)
+	MFASetupUserID(mfaToken string) (uint, error)
+	GetMFAStatus(userID uint) (*MFAStatus, error)
+	BeginTOTPSetup(userID uint) (*TOTPSetup, error)
+	EnableTOTP(userID uint, code string) ([]string, error)
+	DisableTOTP(userID uint, password, code string) error
+	RegenerateRecoveryCodes(userID uint, code string) ([]string, error)
+	ListMFAPolicies(adminID uint) ([]*model.MFAPolicy, error)
+	SetMFAPolicy(adminID uint, role string, required bool) (*model.MFAPolicy, error)
+
+	// 单点登录（OIDC）
+	LoginWithOIDC(profile *OIDCProfile, client ClientInfo) (*LoginResult, error)
+	LinkOIDCIdentity(userID uint, profile *OIDCProfile) error
+	ListOIDCIdentities(userID uint) ([]*model.OIDCIdentity, error)
+	UnlinkOIDCIdentity(userID uint, provider string) error
+
+	// 登录会话
+	ListSessions(userID uint) ([]*model.UserSession, error)
+	RevokeSession(userID, id uint) error
+	RevokeOtherSessions(userID uint, currentSessionID string) (int64, error)
+	TouchSession(sessionID, clientIP string)
+
+	// 个人访问令牌
+	CreateAPIToken(userID uint, name string, scopes []string, expiresInDays int) (*model.APIToken, string, error)
+	ListAPITokens(userID uint) ([]*model.APIToken, error)
+	RevokeAPIToken(userID, id uint) error
+	AuthenticateAPIToken(plaintext, clientIP string) (uint, []string, error)
+
+	// 账号注销
+	EraseAccount(userID uint) error
+
 	// JWT相关方法
-	GenerateToken(userID uint) (string, error)
+	GenerateToken(userID uint, client ClientInfo) (string, error) // 同时创建登录会话
 	ValidateToken(tokenString string) (uint, error)
+	IsTokenRevoked(userID uint, sessionID string, issuedAt time.Time) (bool, error)
 }
 
 // UserService 用户服务实现
 type UserService struct {
-	userRepo  repository.UserRepositoryInterface // 用户仓储接口
-	jwtSecret string                             // JWT密钥
+	userRepo     repository.UserRepositoryInterface          // 用户仓储接口
+	resetRepo    repository.PasswordResetRepositoryInterface // 密码重置令牌仓储，为空时不支持找回密码
+	mfaRepo      repository.MFARepositoryInterface           // 两步验证仓储，为空时登录不检查两步验证
+	attemptRepo  repository.LoginAttemptRepositoryInterface  // 登录失败次数仓储，为空时不限制登录尝试
+	oidcRepo     repository.OIDCIdentityRepositoryInterface  // 单点登录身份仓储，为空时不支持单点登录
+	sessionRepo  repository.SessionRepositoryInterface       // 登录会话仓储，为空时令牌不关联会话
+	apiTokenRepo repository.APITokenRepositoryInterface      // 个人访问令牌仓储，为空时不支持个人访问令牌
+	emailSvc     emailService.EmailServiceInterface          // 邮件服务，为空时不发送邮件（网关内只用于查询和修改资料）
+	jwtSecret    string                                      // JWT密钥
+
+	sessionTouched sync.Map // 会话ID -> 最近一次记录活动的时间，用于限制写入频率
 }
 
 // NewUserService 创建用户服务实例
-func NewUserService(userRepo repository.UserRepositoryInterface) UserServiceInterface {
+func NewUserService(userRepo repository.UserRepositoryInterface, resetRepo repository.PasswordResetRepositoryInterface, mfaRepo repository.MFARepositoryInterface, attemptRepo repository.LoginAttemptRepositoryInterface, oidcRepo repository.OIDCIdentityRepositoryInterface, sessionRepo repository.SessionRepositoryInterface, apiTokenRepo repository.APITokenRepositoryInterface, emailSvc emailService.EmailServiceInterface) UserServiceInterface {
 	return &UserService{
-		userRepo:  userRepo,
-		jwtSecret: "course-platform-secret-key-2024", // 实际项目中应从配置文件读取
+		userRepo:     userRepo,
+		resetRepo:    resetRepo,
+		mfaRepo:      mfaRepo,
+		attemptRepo:  attemptRepo,
+		oidcRepo:     oidcRepo,
+		sessionRepo:  sessionRepo,
+		apiTokenRepo: apiTokenRepo,
+		emailSvc:     emailSvc,
+		jwtSecret:    "course-platform-secret-key-2024", // 实际项目中应从配置文件读取
 	}
 }
 
 // Register 用户注册
-// 处理用户注册业务逻辑，包括参数验证、密码加密、用户创建
-func (s *UserService) Register(username, email, password, nickname string) (*model.User, error) {
+// 处理用户注册业务逻辑，包括参数验证、密码加密、用户创建，新用户需验证邮箱
+func (s *UserService) Register(username, email, password, nickname, locale string) (*model.User, error) {
 	// 1. 参数验证
 	if err := s.validateRegisterParams(email, password); err != nil {
 		return nil, err
@@ -72,6 +134,8 @@ func (s *UserService) Register(username, email, password, nickname string) (*mod
 		Email:        email,
 		PasswordHash: hashedPassword,
 		Nickname:     nickname,
+		Locale:       utils.NormalizeLocale(locale),
+		EmailStatus:  model.EmailStatusPending,
 	}
 
 	// 5. 保存到数据库
@@ -79,24 +143,35 @@ func (s *UserService) Register(username, email, password, nickname string) (*mod
 		return nil, fmt.Errorf("创建用户失败: %w", err)
 	}
 
+	// 6. 发送验证邮件，失败不影响注册，用户可在个人中心重新发送
+	if err := s.sendVerification(user); err != nil {
+		log.Printf("⚠️ Service: 验证邮件发送失败 - 用户ID: %d, 错误: %v", user.ID, err)
+	}
+
 	return user, nil
 }
 
 // Login 用户登录
 // 处理用户登录业务逻辑，包括身份验证、JWT生成
-// 支持用户名或邮箱登录
-func (s *UserService) Login(identifier, password string) (string, *model.User, error) {
+// 支持用户名或邮箱登录，同一账号或来源IP失败次数过多时暂时锁定
+func (s *UserService) Login(identifier, password string, client ClientInfo) (*LoginResult, error) {
 	// 1. 参数验证
 	if identifier == "" || password == "" {
-		return "", nil, fmt.Errorf("用户名/邮箱和密码不能为空")
+		return nil, fmt.Errorf("用户名/邮箱和密码不能为空")
+	}
+
+	// 2. 来源IP失败过多时直接拒绝
+	ipKey := loginIPKey(client.IP)
+	if err := s.checkLoginLock(ipKey, false); err != nil {
+		return nil, err
 	}
 
-	// 2. 根据标识符查找用户（先尝试用户名，再尝试邮箱）
+	// 3. 根据标识符查找用户（先尝试用户名，再尝试邮箱）
 	var user *model.User
 	var err error
 
 	// 检查是否是邮箱格式
-	if contains(identifier, "@") && contains(identifier, ".") {
+	if strings.Contains(identifier, "@") && strings.Contains(identifier, ".") {
 		// 尝试用邮箱查找
 		user, err = s.userRepo.GetByEmail(identifier)
 		if err != nil {
@@ -113,21 +188,37 @@ func (s *UserService) Login(identifier, password string) (string, *model.User, e
 	}
 
 	if err != nil {
-		return "", nil, fmt.Errorf("用户不存在或密码错误")
+		user = nil
+	}
+
+	// 4. 账号锁定期内即使密码正确也不允许登录
+	accountKey := loginAccountKey(user, identifier)
+	if err := s.checkLoginLock(accountKey, true); err != nil {
+		return nil, err
+	}
+
+	// 5. 验证密码，失败时累计次数
+	if user == nil || !utils.CheckPasswordHash(password, user.PasswordHash) {
+		if err := s.recordLoginFailure(user, accountKey, ipKey); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("用户不存在或密码错误")
 	}
+	s.clearLoginFailures(accountKey)
 
-	// 3. 验证密码
-	if !utils.CheckPasswordHash(password, user.PasswordHash) {
-		return "", nil, fmt.Errorf("用户不存在或密码错误")
+	// 6. 需要两步验证时只返回挑战令牌
+	challenge, err := s.loginChallenge(user)
+	if err != nil || challenge != nil {
+		return challenge, err
 	}
 
-	// 4. 生成JWT令牌
-	token, err := s.GenerateToken(user.ID)
+	// 7. 生成JWT令牌
+	token, err := s.GenerateToken(user.ID, client)
 	if err != nil {
-		return "", nil, fmt.Errorf("生成访问令牌失败: %w", err)
+		return nil, fmt.Errorf("生成访问令牌失败: %w", err)
 	}
 
-	return token, user, nil
+	return &LoginResult{Token: token, User: user}, nil
 }
 
 // GetUserByID 根据ID获取用户信息
@@ -262,13 +353,36 @@ func (s *UserService) ChangePassword(userID uint, oldPassword, newPassword strin
 	return nil
 }
 
-// GenerateToken 生成JWT令牌
-func (s *UserService) GenerateToken(userID uint) (string, error) {
+// IsTokenRevoked 判断登录令牌是否已被吊销（签发时间早于用户的令牌吊销时间，或所属会话已注销）
+// 查询失败时无法确认令牌有效，返回 true 和错误，认证中间件据此返回503而不是放行
+func (s *UserService) IsTokenRevoked(userID uint, sessionID string, issuedAt time.Time) (bool, error) {
+	if revoked, err := s.isSessionRevoked(sessionID); revoked || err != nil {
+		return true, err
+	}
+	revokedAt, err := s.userRepo.GetTokensRevokedAt(userID)
+	if err != nil {
+		log.Printf("❌ Service: 查询令牌吊销时间失败 - 用户ID: %d, 错误: %v", userID, err)
+		return true, err
+	}
+	// iat 精确到秒，吊销时间同样按秒比较
+	return !revokedAt.IsZero() && issuedAt.Unix() < revokedAt.Unix(), nil
+}
+
+// GenerateToken 生成JWT令牌，并创建对应的登录会话
+func (s *UserService) GenerateToken(userID uint, client ClientInfo) (string, error) {
+	now := time.Now()
 	// 创建JWT声明
 	claims := jwt.MapClaims{
 		"user_id": userID,
-		"exp":     time.Now().Add(time.Hour * 24 * 7).Unix(), // 7天过期
-		"iat":     time.Now().Unix(),
+		"exp":     now.Add(tokenTTL).Unix(), // 7天过期
+		"iat":     now.Unix(),
+	}
+	if s.sessionRepo != nil {
+		sessionID, err := s.createSession(userID, client, now)
+		if err != nil {
+			return "", err
+		}
+		claims["sid"] = sessionID
 	}
 
 	// 创建token
@@ -301,6 +415,18 @@ func (s *UserService) ValidateToken(tokenString string) (uint, error) {
 	// 验证并提取claims
 	if claims, ok := token.Claims.(jwt.MapClaims); ok && token.Valid {
 		if userID, ok := claims["user_id"].(float64); ok {
+			issuedAt, _ := claims.GetIssuedAt()
+			sessionID, _ := claims["sid"].(string)
+			if issuedAt == nil {
+				return 0, fmt.Errorf("JWT令牌已失效，请重新登录")
+			}
+			revoked, err := s.IsTokenRevoked(uint(userID), sessionID, issuedAt.Time)
+			if err != nil {
+				return 0, fmt.Errorf("校验登录状态失败: %w", err)
+			}
+			if revoked {
+				return 0, fmt.Errorf("JWT令牌已失效，请重新登录")
+			}
 			return uint(userID), nil
 		}
 		return 0, fmt.Errorf("JWT令牌中缺少用户ID")
@@ -315,8 +441,9 @@ func (s *UserService) validateRegisterParams(email, password string) error {
 		return fmt.Errorf("邮箱不能为空")
 	}
 
-	// 简单的邮箱格式验证
-	if len(email) < 5 || !contains(email, "@") || !contains(email, ".") {
+	// 邮箱格式验证，只接受不带显示名的纯地址，且域名需包含点
+	address, err := mail.ParseAddress(email)
+	if err != nil || address.Address != email || !strings.Contains(email[strings.LastIndex(email, "@"):], ".") {
 		return fmt.Errorf("邮箱格式不正确")
 	}
 
@@ -334,13 +461,3 @@ func (s *UserService) validateRegisterParams(email, password string) error {
 
 	return nil
 }
-
-// contains 检查字符串是否包含子字符串
-func contains(s, substr string) bool {
-	for i := 0; i <= len(s)-len(substr); i++ {
-		if s[i:i+len(substr)] == substr {
-			return true
-		}
-	}
-	return false
-}
@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"course-platform/internal/shared/pb/announcementpb"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// AnnouncementGRPCClientService 课程公告服务gRPC客户端（公告服务与课程服务同进程部署）
+type AnnouncementGRPCClientService struct {
+	client announcementpb.AnnouncementServiceClient
+	conn   *grpc.ClientConn
+}
+
+// NewAnnouncementGRPCClientService 创建课程公告服务gRPC客户端
+func NewAnnouncementGRPCClientService(address string) (*AnnouncementGRPCClientService, error) {
+	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("连接课程公告服务失败: %w", err)
+	}
+
+	log.Printf("✅ 课程公告服务gRPC客户端已连接: %s", address)
+	return &AnnouncementGRPCClientService{
+		client: announcementpb.NewAnnouncementServiceClient(conn),
+		conn:   conn,
+	}, nil
+}
+
+// Close 关闭连接
+func (s *AnnouncementGRPCClientService) Close() error {
+	return s.conn.Close()
+}
+
+// CreateAnnouncement 发布公告
+func (s *AnnouncementGRPCClientService) CreateAnnouncement(ctx context.Context, courseID, userID uint, title, body string) (*announcementpb.CreateAnnouncementResponse, error) {
+	log.Printf("🔍 gRPC Client: 发布公告 - 课程ID: %d, 标题: %s", courseID, title)
+
+	resp, err := s.client.CreateAnnouncement(ctx, &announcementpb.CreateAnnouncementRequest{
+		CourseId: uint32(courseID),
+		UserId:   uint32(userID),
+		Title:    title,
+		Body:     body,
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 发布公告失败 - %v", err)
+		return nil, fmt.Errorf("发布公告失败: %w", err)
+	}
+	return resp, nil
+}
+
+// ListAnnouncements 分页获取课程公告
+func (s *AnnouncementGRPCClientService) ListAnnouncements(ctx context.Context, courseID, userID, page, pageSize uint) (*announcementpb.ListAnnouncementsResponse, error) {
+	resp, err := s.client.ListAnnouncements(ctx, &announcementpb.ListAnnouncementsRequest{
+		CourseId: uint32(courseID),
+		UserId:   uint32(userID),
+		Page:     uint32(page),
+		PageSize: uint32(pageSize),
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 获取公告列表失败 - %v", err)
+		return nil, fmt.Errorf("获取公告列表失败: %w", err)
+	}
+	return resp, nil
+}
+
+// MarkAnnouncementRead 标记公告已读
+func (s *AnnouncementGRPCClientService) MarkAnnouncementRead(ctx context.Context, announcementID, userID uint) (*announcementpb.MarkAnnouncementReadResponse, error) {
+	resp, err := s.client.MarkAnnouncementRead(ctx, &announcementpb.MarkAnnouncementReadRequest{
+		AnnouncementId: uint32(announcementID),
+		UserId:         uint32(userID),
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 标记公告已读失败 - %v", err)
+		return nil, fmt.Errorf("标记公告已读失败: %w", err)
+	}
+	return resp, nil
+}
+
+// DeleteAnnouncement 删除公告
+func (s *AnnouncementGRPCClientService) DeleteAnnouncement(ctx context.Context, announcementID, userID uint) (*announcementpb.DeleteAnnouncementResponse, error) {
+	resp, err := s.client.DeleteAnnouncement(ctx, &announcementpb.DeleteAnnouncementRequest{
+		AnnouncementId: uint32(announcementID),
+		UserId:         uint32(userID),
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 删除公告失败 - %v", err)
+		return nil, fmt.Errorf("删除公告失败: %w", err)
+	}
+	return resp, nil
+}
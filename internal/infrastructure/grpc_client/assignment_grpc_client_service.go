@@ -0,0 +1,133 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"course-platform/internal/shared/pb/assignmentpb"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// AssignmentGRPCClientService 作业服务gRPC客户端（作业服务与课程服务同进程部署）
+type AssignmentGRPCClientService struct {
+	client assignmentpb.AssignmentServiceClient
+	conn   *grpc.ClientConn
+}
+
+// NewAssignmentGRPCClientService 创建作业服务gRPC客户端
+func NewAssignmentGRPCClientService(address string) (*AssignmentGRPCClientService, error) {
+	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("连接作业服务失败: %w", err)
+	}
+
+	log.Printf("✅ 作业服务gRPC客户端已连接: %s", address)
+	return &AssignmentGRPCClientService{
+		client: assignmentpb.NewAssignmentServiceClient(conn),
+		conn:   conn,
+	}, nil
+}
+
+// Close 关闭连接
+func (s *AssignmentGRPCClientService) Close() error {
+	return s.conn.Close()
+}
+
+// CreateAssignment 创建作业
+func (s *AssignmentGRPCClientService) CreateAssignment(ctx context.Context, req *assignmentpb.CreateAssignmentRequest) (*assignmentpb.CreateAssignmentResponse, error) {
+	log.Printf("🔍 gRPC Client: 创建作业 - 课程ID: %d", req.CourseId)
+
+	resp, err := s.client.CreateAssignment(ctx, req)
+	if err != nil {
+		log.Printf("❌ gRPC Client: 创建作业失败 - %v", err)
+		return nil, fmt.Errorf("创建作业失败: %w", err)
+	}
+	return resp, nil
+}
+
+// GetAssignment 获取作业详情
+func (s *AssignmentGRPCClientService) GetAssignment(ctx context.Context, assignmentID uint) (*assignmentpb.GetAssignmentResponse, error) {
+	resp, err := s.client.GetAssignment(ctx, &assignmentpb.GetAssignmentRequest{AssignmentId: uint32(assignmentID)})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 获取作业失败 - %v", err)
+		return nil, fmt.Errorf("获取作业失败: %w", err)
+	}
+	return resp, nil
+}
+
+// ListAssignments 获取课程作业列表
+func (s *AssignmentGRPCClientService) ListAssignments(ctx context.Context, courseID uint) (*assignmentpb.ListAssignmentsResponse, error) {
+	resp, err := s.client.ListAssignments(ctx, &assignmentpb.ListAssignmentsRequest{CourseId: uint32(courseID)})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 获取作业列表失败 - %v", err)
+		return nil, fmt.Errorf("获取作业列表失败: %w", err)
+	}
+	return resp, nil
+}
+
+// SubmitAssignment 提交作业
+func (s *AssignmentGRPCClientService) SubmitAssignment(ctx context.Context, req *assignmentpb.SubmitAssignmentRequest) (*assignmentpb.SubmitAssignmentResponse, error) {
+	log.Printf("🔍 gRPC Client: 提交作业 - 作业ID: %d, 用户ID: %d", req.AssignmentId, req.UserId)
+
+	resp, err := s.client.SubmitAssignment(ctx, req)
+	if err != nil {
+		log.Printf("❌ gRPC Client: 提交作业失败 - %v", err)
+		return nil, fmt.Errorf("提交作业失败: %w", err)
+	}
+	return resp, nil
+}
+
+// GetMySubmission 获取本人的作业提交
+func (s *AssignmentGRPCClientService) GetMySubmission(ctx context.Context, assignmentID, userID uint) (*assignmentpb.GetMySubmissionResponse, error) {
+	resp, err := s.client.GetMySubmission(ctx, &assignmentpb.GetMySubmissionRequest{
+		AssignmentId: uint32(assignmentID),
+		UserId:       uint32(userID),
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 获取作业提交失败 - %v", err)
+		return nil, fmt.Errorf("获取作业提交失败: %w", err)
+	}
+	return resp, nil
+}
+
+// ListSubmissions 获取作业的提交列表
+func (s *AssignmentGRPCClientService) ListSubmissions(ctx context.Context, assignmentID, userID uint, status string) (*assignmentpb.ListSubmissionsResponse, error) {
+	resp, err := s.client.ListSubmissions(ctx, &assignmentpb.ListSubmissionsRequest{
+		AssignmentId: uint32(assignmentID),
+		UserId:       uint32(userID),
+		Status:       status,
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 获取作业提交列表失败 - %v", err)
+		return nil, fmt.Errorf("获取作业提交列表失败: %w", err)
+	}
+	return resp, nil
+}
+
+// GetGradingQueue 获取课程待批改队列
+func (s *AssignmentGRPCClientService) GetGradingQueue(ctx context.Context, courseID, userID uint) (*assignmentpb.GetGradingQueueResponse, error) {
+	resp, err := s.client.GetGradingQueue(ctx, &assignmentpb.GetGradingQueueRequest{
+		CourseId: uint32(courseID),
+		UserId:   uint32(userID),
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 获取待批改队列失败 - %v", err)
+		return nil, fmt.Errorf("获取待批改队列失败: %w", err)
+	}
+	return resp, nil
+}
+
+// GradeSubmission 批改作业
+func (s *AssignmentGRPCClientService) GradeSubmission(ctx context.Context, req *assignmentpb.GradeSubmissionRequest) (*assignmentpb.GradeSubmissionResponse, error) {
+	log.Printf("🔍 gRPC Client: 批改作业 - 提交ID: %d", req.SubmissionId)
+
+	resp, err := s.client.GradeSubmission(ctx, req)
+	if err != nil {
+		log.Printf("❌ gRPC Client: 批改作业失败 - %v", err)
+		return nil, fmt.Errorf("批改作业失败: %w", err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"course-platform/internal/shared/pb/bundlepb"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// BundleGRPCClientService 课程套餐服务gRPC客户端（套餐服务与课程服务同进程部署）
+type BundleGRPCClientService struct {
+	client bundlepb.BundleServiceClient
+	conn   *grpc.ClientConn
+}
+
+// NewBundleGRPCClientService 创建课程套餐服务gRPC客户端
+func NewBundleGRPCClientService(address string) (*BundleGRPCClientService, error) {
+	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("连接课程套餐服务失败: %w", err)
+	}
+
+	log.Printf("✅ 课程套餐服务gRPC客户端已连接: %s", address)
+	return &BundleGRPCClientService{
+		client: bundlepb.NewBundleServiceClient(conn),
+		conn:   conn,
+	}, nil
+}
+
+// Close 关闭连接
+func (s *BundleGRPCClientService) Close() error {
+	return s.conn.Close()
+}
+
+// CreateBundle 创建套餐
+func (s *BundleGRPCClientService) CreateBundle(ctx context.Context, req *bundlepb.CreateBundleRequest) (*bundlepb.CreateBundleResponse, error) {
+	log.Printf("🔍 gRPC Client: 创建套餐 - 标题: %s", req.Title)
+
+	resp, err := s.client.CreateBundle(ctx, req)
+	if err != nil {
+		log.Printf("❌ gRPC Client: 创建套餐失败 - %v", err)
+		return nil, fmt.Errorf("创建套餐失败: %w", err)
+	}
+	return resp, nil
+}
+
+// UpdateBundle 更新套餐
+func (s *BundleGRPCClientService) UpdateBundle(ctx context.Context, req *bundlepb.UpdateBundleRequest) (*bundlepb.UpdateBundleResponse, error) {
+	resp, err := s.client.UpdateBundle(ctx, req)
+	if err != nil {
+		log.Printf("❌ gRPC Client: 更新套餐失败 - %v", err)
+		return nil, fmt.Errorf("更新套餐失败: %w", err)
+	}
+	return resp, nil
+}
+
+// PublishBundle 发布套餐
+func (s *BundleGRPCClientService) PublishBundle(ctx context.Context, bundleID, userID uint) (*bundlepb.PublishBundleResponse, error) {
+	resp, err := s.client.PublishBundle(ctx, &bundlepb.PublishBundleRequest{
+		BundleId: uint32(bundleID),
+		UserId:   uint32(userID),
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 发布套餐失败 - %v", err)
+		return nil, fmt.Errorf("发布套餐失败: %w", err)
+	}
+	return resp, nil
+}
+
+// GetBundle 获取套餐详情
+func (s *BundleGRPCClientService) GetBundle(ctx context.Context, bundleID, userID uint) (*bundlepb.GetBundleResponse, error) {
+	resp, err := s.client.GetBundle(ctx, &bundlepb.GetBundleRequest{
+		BundleId: uint32(bundleID),
+		UserId:   uint32(userID),
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 获取套餐失败 - %v", err)
+		return nil, fmt.Errorf("获取套餐失败: %w", err)
+	}
+	return resp, nil
+}
+
+// ListBundles 获取已发布的套餐列表
+func (s *BundleGRPCClientService) ListBundles(ctx context.Context, page, pageSize uint) (*bundlepb.ListBundlesResponse, error) {
+	resp, err := s.client.ListBundles(ctx, &bundlepb.ListBundlesRequest{
+		Page:     uint32(page),
+		PageSize: uint32(pageSize),
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 获取套餐列表失败 - %v", err)
+		return nil, fmt.Errorf("获取套餐列表失败: %w", err)
+	}
+	return resp, nil
+}
+
+// ListMyBundles 获取本人创建的套餐
+func (s *BundleGRPCClientService) ListMyBundles(ctx context.Context, userID uint) (*bundlepb.ListMyBundlesResponse, error) {
+	resp, err := s.client.ListMyBundles(ctx, &bundlepb.ListMyBundlesRequest{
+		UserId: uint32(userID),
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 获取我的套餐失败 - %v", err)
+		return nil, fmt.Errorf("获取我的套餐失败: %w", err)
+	}
+	return resp, nil
+}
+
+// EnrollBundle 报名免费套餐
+func (s *BundleGRPCClientService) EnrollBundle(ctx context.Context, bundleID, userID uint) (*bundlepb.EnrollBundleResponse, error) {
+	log.Printf("🔍 gRPC Client: 报名套餐 - 用户ID: %d, 套餐ID: %d", userID, bundleID)
+
+	resp, err := s.client.EnrollBundle(ctx, &bundlepb.EnrollBundleRequest{
+		BundleId: uint32(bundleID),
+		UserId:   uint32(userID),
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 报名套餐失败 - %v", err)
+		return nil, fmt.Errorf("报名套餐失败: %w", err)
+	}
+	return resp, nil
+}
+
+// GetBundleProgress 获取学习路径进度
+func (s *BundleGRPCClientService) GetBundleProgress(ctx context.Context, bundleID, userID uint) (*bundlepb.GetBundleProgressResponse, error) {
+	resp, err := s.client.GetBundleProgress(ctx, &bundlepb.GetBundleProgressRequest{
+		BundleId: uint32(bundleID),
+		UserId:   uint32(userID),
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 获取学习进度失败 - %v", err)
+		return nil, fmt.Errorf("获取学习进度失败: %w", err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"course-platform/internal/shared/pb/certificatepb"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// CertificateGRPCClientService 证书服务gRPC客户端（证书服务与课程服务同进程部署）
+type CertificateGRPCClientService struct {
+	client certificatepb.CertificateServiceClient
+	conn   *grpc.ClientConn
+}
+
+// NewCertificateGRPCClientService 创建证书服务gRPC客户端
+func NewCertificateGRPCClientService(address string) (*CertificateGRPCClientService, error) {
+	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("连接证书服务失败: %w", err)
+	}
+
+	log.Printf("✅ 证书服务gRPC客户端已连接: %s", address)
+	return &CertificateGRPCClientService{
+		client: certificatepb.NewCertificateServiceClient(conn),
+		conn:   conn,
+	}, nil
+}
+
+// Close 关闭连接
+func (s *CertificateGRPCClientService) Close() error {
+	return s.conn.Close()
+}
+
+// GetCertificate 根据验证码获取证书
+func (s *CertificateGRPCClientService) GetCertificate(ctx context.Context, code string) (*certificatepb.GetCertificateResponse, error) {
+	resp, err := s.client.GetCertificate(ctx, &certificatepb.GetCertificateRequest{Code: code})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 获取证书失败 - %v", err)
+		return nil, fmt.Errorf("获取证书失败: %w", err)
+	}
+	return resp, nil
+}
+
+// ListMyCertificates 获取本人的全部证书
+func (s *CertificateGRPCClientService) ListMyCertificates(ctx context.Context, userID uint) (*certificatepb.ListMyCertificatesResponse, error) {
+	resp, err := s.client.ListMyCertificates(ctx, &certificatepb.ListMyCertificatesRequest{UserId: uint32(userID)})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 获取证书列表失败 - %v", err)
+		return nil, fmt.Errorf("获取证书列表失败: %w", err)
+	}
+	return resp, nil
+}
+
+// GetCertificateTemplate 获取课程证书模板
+func (s *CertificateGRPCClientService) GetCertificateTemplate(ctx context.Context, courseID uint) (*certificatepb.GetCertificateTemplateResponse, error) {
+	resp, err := s.client.GetCertificateTemplate(ctx, &certificatepb.GetCertificateTemplateRequest{CourseId: uint32(courseID)})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 获取证书模板失败 - %v", err)
+		return nil, fmt.Errorf("获取证书模板失败: %w", err)
+	}
+	return resp, nil
+}
+
+// SaveCertificateTemplate 保存课程证书模板
+func (s *CertificateGRPCClientService) SaveCertificateTemplate(ctx context.Context, req *certificatepb.SaveCertificateTemplateRequest) (*certificatepb.SaveCertificateTemplateResponse, error) {
+	log.Printf("🔍 gRPC Client: 保存证书模板 - 课程ID: %d", req.CourseId)
+
+	resp, err := s.client.SaveCertificateTemplate(ctx, req)
+	if err != nil {
+		log.Printf("❌ gRPC Client: 保存证书模板失败 - %v", err)
+		return nil, fmt.Errorf("保存证书模板失败: %w", err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,187 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"course-platform/internal/shared/pb/cohortpb"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// CohortGRPCClientService 班期与直播服务gRPC客户端（班期服务与课程服务同进程部署）
+type CohortGRPCClientService struct {
+	client cohortpb.CohortServiceClient
+	conn   *grpc.ClientConn
+}
+
+// NewCohortGRPCClientService 创建班期服务gRPC客户端
+func NewCohortGRPCClientService(address string) (*CohortGRPCClientService, error) {
+	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("连接班期服务失败: %w", err)
+	}
+
+	log.Printf("✅ 班期服务gRPC客户端已连接: %s", address)
+	return &CohortGRPCClientService{
+		client: cohortpb.NewCohortServiceClient(conn),
+		conn:   conn,
+	}, nil
+}
+
+// Close 关闭连接
+func (s *CohortGRPCClientService) Close() error {
+	return s.conn.Close()
+}
+
+// CreateCohort 开设班期
+func (s *CohortGRPCClientService) CreateCohort(ctx context.Context, req *cohortpb.CreateCohortRequest) (*cohortpb.CreateCohortResponse, error) {
+	log.Printf("🔍 gRPC Client: 开设班期 - 课程ID: %d, 名称: %s", req.CourseId, req.Name)
+
+	resp, err := s.client.CreateCohort(ctx, req)
+	if err != nil {
+		log.Printf("❌ gRPC Client: 开设班期失败 - %v", err)
+		return nil, fmt.Errorf("开设班期失败: %w", err)
+	}
+	return resp, nil
+}
+
+// UpdateCohort 更新班期
+func (s *CohortGRPCClientService) UpdateCohort(ctx context.Context, req *cohortpb.UpdateCohortRequest) (*cohortpb.UpdateCohortResponse, error) {
+	resp, err := s.client.UpdateCohort(ctx, req)
+	if err != nil {
+		log.Printf("❌ gRPC Client: 更新班期失败 - %v", err)
+		return nil, fmt.Errorf("更新班期失败: %w", err)
+	}
+	return resp, nil
+}
+
+// GetCohort 获取班期详情及直播安排
+func (s *CohortGRPCClientService) GetCohort(ctx context.Context, cohortID, userID uint) (*cohortpb.GetCohortResponse, error) {
+	resp, err := s.client.GetCohort(ctx, &cohortpb.GetCohortRequest{
+		CohortId: uint32(cohortID),
+		UserId:   uint32(userID),
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 获取班期失败 - %v", err)
+		return nil, fmt.Errorf("获取班期失败: %w", err)
+	}
+	return resp, nil
+}
+
+// ListCohorts 获取课程的班期列表
+func (s *CohortGRPCClientService) ListCohorts(ctx context.Context, courseID, userID uint) (*cohortpb.ListCohortsResponse, error) {
+	resp, err := s.client.ListCohorts(ctx, &cohortpb.ListCohortsRequest{
+		CourseId: uint32(courseID),
+		UserId:   uint32(userID),
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 获取班期列表失败 - %v", err)
+		return nil, fmt.Errorf("获取班期列表失败: %w", err)
+	}
+	return resp, nil
+}
+
+// EnrollCohort 报名班期
+func (s *CohortGRPCClientService) EnrollCohort(ctx context.Context, cohortID, userID uint) (*cohortpb.EnrollCohortResponse, error) {
+	log.Printf("🔍 gRPC Client: 报名班期 - 用户ID: %d, 班期ID: %d", userID, cohortID)
+
+	resp, err := s.client.EnrollCohort(ctx, &cohortpb.EnrollCohortRequest{
+		CohortId: uint32(cohortID),
+		UserId:   uint32(userID),
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 报名班期失败 - %v", err)
+		return nil, fmt.Errorf("报名班期失败: %w", err)
+	}
+	return resp, nil
+}
+
+// LeaveCohort 退出班期或取消候补
+func (s *CohortGRPCClientService) LeaveCohort(ctx context.Context, cohortID, userID uint) (*cohortpb.LeaveCohortResponse, error) {
+	resp, err := s.client.LeaveCohort(ctx, &cohortpb.LeaveCohortRequest{
+		CohortId: uint32(cohortID),
+		UserId:   uint32(userID),
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 退出班期失败 - %v", err)
+		return nil, fmt.Errorf("退出班期失败: %w", err)
+	}
+	return resp, nil
+}
+
+// ListCohortMembers 获取班期成员和候补名单
+func (s *CohortGRPCClientService) ListCohortMembers(ctx context.Context, cohortID, userID uint) (*cohortpb.ListCohortMembersResponse, error) {
+	resp, err := s.client.ListCohortMembers(ctx, &cohortpb.ListCohortMembersRequest{
+		CohortId: uint32(cohortID),
+		UserId:   uint32(userID),
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 获取班期成员失败 - %v", err)
+		return nil, fmt.Errorf("获取班期成员失败: %w", err)
+	}
+	return resp, nil
+}
+
+// CreateLiveSession 安排直播
+func (s *CohortGRPCClientService) CreateLiveSession(ctx context.Context, req *cohortpb.SaveLiveSessionRequest) (*cohortpb.SaveLiveSessionResponse, error) {
+	log.Printf("🔍 gRPC Client: 安排直播 - 班期ID: %d, 标题: %s", req.CohortId, req.Title)
+
+	resp, err := s.client.CreateLiveSession(ctx, req)
+	if err != nil {
+		log.Printf("❌ gRPC Client: 安排直播失败 - %v", err)
+		return nil, fmt.Errorf("安排直播失败: %w", err)
+	}
+	return resp, nil
+}
+
+// UpdateLiveSession 更新直播
+func (s *CohortGRPCClientService) UpdateLiveSession(ctx context.Context, req *cohortpb.SaveLiveSessionRequest) (*cohortpb.SaveLiveSessionResponse, error) {
+	resp, err := s.client.UpdateLiveSession(ctx, req)
+	if err != nil {
+		log.Printf("❌ gRPC Client: 更新直播失败 - %v", err)
+		return nil, fmt.Errorf("更新直播失败: %w", err)
+	}
+	return resp, nil
+}
+
+// DeleteLiveSession 取消直播
+func (s *CohortGRPCClientService) DeleteLiveSession(ctx context.Context, cohortID, sessionID, userID uint) (*cohortpb.DeleteLiveSessionResponse, error) {
+	resp, err := s.client.DeleteLiveSession(ctx, &cohortpb.DeleteLiveSessionRequest{
+		CohortId:  uint32(cohortID),
+		SessionId: uint32(sessionID),
+		UserId:    uint32(userID),
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 取消直播失败 - %v", err)
+		return nil, fmt.Errorf("取消直播失败: %w", err)
+	}
+	return resp, nil
+}
+
+// GetCalendarFeedToken 获取日历订阅令牌，regenerate 为 true 时重新生成
+func (s *CohortGRPCClientService) GetCalendarFeedToken(ctx context.Context, userID uint, regenerate bool) (*cohortpb.GetCalendarFeedTokenResponse, error) {
+	resp, err := s.client.GetCalendarFeedToken(ctx, &cohortpb.GetCalendarFeedTokenRequest{
+		UserId:     uint32(userID),
+		Regenerate: regenerate,
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 获取日历订阅失败 - %v", err)
+		return nil, fmt.Errorf("获取日历订阅失败: %w", err)
+	}
+	return resp, nil
+}
+
+// GetCalendarFeed 根据令牌获取iCalendar订阅内容
+func (s *CohortGRPCClientService) GetCalendarFeed(ctx context.Context, token string) (*cohortpb.GetCalendarFeedResponse, error) {
+	resp, err := s.client.GetCalendarFeed(ctx, &cohortpb.GetCalendarFeedRequest{
+		Token: token,
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 获取日历订阅内容失败 - %v", err)
+		return nil, fmt.Errorf("获取日历订阅内容失败: %w", err)
+	}
+	return resp, nil
+}
@@ -90,3 +90,115 @@ func (s *ContentGRPCClientService) DeleteFile(ctx context.Context, req *contentp
 	log.Printf("🗑️ 调用内容服务删除文件成功: 文件ID=%s", req.FileId)
 	return resp, nil
 }
+
+// GetHLSKey 获取HLS分片解密密钥
+func (s *ContentGRPCClientService) GetHLSKey(ctx context.Context, req *contentpb.GetHLSKeyRequest) (*contentpb.GetHLSKeyResponse, error) {
+	// 设置请求超时
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	// 调用gRPC服务
+	resp, err := s.client.GetHLSKey(ctx, req)
+	if err != nil {
+		log.Printf("❌ 调用内容服务获取HLS密钥失败: %v", err)
+		return nil, fmt.Errorf("获取HLS密钥失败: %w", err)
+	}
+
+	return resp, nil
+}
+
+// GetFile 获取单个文件
+func (s *ContentGRPCClientService) GetFile(ctx context.Context, req *contentpb.GetFileRequest) (*contentpb.GetFileResponse, error) {
+	// 设置请求超时
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	// 调用gRPC服务
+	resp, err := s.client.GetFile(ctx, req)
+	if err != nil {
+		log.Printf("❌ 调用内容服务获取单个文件失败: %v", err)
+		return nil, fmt.Errorf("获取单个文件失败: %w", err)
+	}
+
+	return resp, nil
+}
+
+// ReplaceFile 替换文件
+func (s *ContentGRPCClientService) ReplaceFile(ctx context.Context, req *contentpb.ReplaceFileRequest) (*contentpb.ReplaceFileResponse, error) {
+	// 设置请求超时
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	// 调用gRPC服务
+	resp, err := s.client.ReplaceFile(ctx, req)
+	if err != nil {
+		log.Printf("❌ 调用内容服务替换文件失败: %v", err)
+		return nil, fmt.Errorf("替换文件失败: %w", err)
+	}
+
+	return resp, nil
+}
+
+// ListFileVersions 获取文件版本列表
+func (s *ContentGRPCClientService) ListFileVersions(ctx context.Context, req *contentpb.ListFileVersionsRequest) (*contentpb.ListFileVersionsResponse, error) {
+	// 设置请求超时
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	// 调用gRPC服务
+	resp, err := s.client.ListFileVersions(ctx, req)
+	if err != nil {
+		log.Printf("❌ 调用内容服务获取文件版本列表失败: %v", err)
+		return nil, fmt.Errorf("获取文件版本列表失败: %w", err)
+	}
+
+	return resp, nil
+}
+
+// RevertFileVersion 回滚文件版本
+func (s *ContentGRPCClientService) RevertFileVersion(ctx context.Context, req *contentpb.RevertFileVersionRequest) (*contentpb.RevertFileVersionResponse, error) {
+	// 设置请求超时
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	// 调用gRPC服务
+	resp, err := s.client.RevertFileVersion(ctx, req)
+	if err != nil {
+		log.Printf("❌ 调用内容服务回滚文件版本失败: %v", err)
+		return nil, fmt.Errorf("回滚文件版本失败: %w", err)
+	}
+
+	return resp, nil
+}
+
+// BuildUserArchive 打包用户数据，需要读取全部上传文件，超时时间较长
+func (s *ContentGRPCClientService) BuildUserArchive(ctx context.Context, req *contentpb.BuildUserArchiveRequest) (*contentpb.BuildUserArchiveResponse, error) {
+	// 设置请求超时
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Minute)
+	defer cancel()
+
+	// 调用gRPC服务
+	resp, err := s.client.BuildUserArchive(ctx, req)
+	if err != nil {
+		log.Printf("❌ 调用内容服务打包用户数据失败: %v", err)
+		return nil, fmt.Errorf("打包用户数据失败: %w", err)
+	}
+
+	return resp, nil
+}
+
+// PurgeUserFiles 清理注销用户的文件
+func (s *ContentGRPCClientService) PurgeUserFiles(ctx context.Context, req *contentpb.PurgeUserFilesRequest) (*contentpb.PurgeUserFilesResponse, error) {
+	// 设置请求超时
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Minute)
+	defer cancel()
+
+	// 调用gRPC服务
+	resp, err := s.client.PurgeUserFiles(ctx, req)
+	if err != nil {
+		log.Printf("❌ 调用内容服务清理用户文件失败: %v", err)
+		return nil, fmt.Errorf("清理用户文件失败: %w", err)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"course-platform/internal/shared/pb/couponpb"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// CouponGRPCClientService 优惠券服务gRPC客户端（优惠券服务与课程服务同进程部署）
+type CouponGRPCClientService struct {
+	client couponpb.CouponServiceClient
+	conn   *grpc.ClientConn
+}
+
+// NewCouponGRPCClientService 创建优惠券服务gRPC客户端
+func NewCouponGRPCClientService(address string) (*CouponGRPCClientService, error) {
+	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("连接优惠券服务失败: %w", err)
+	}
+
+	log.Printf("✅ 优惠券服务gRPC客户端已连接: %s", address)
+	return &CouponGRPCClientService{
+		client: couponpb.NewCouponServiceClient(conn),
+		conn:   conn,
+	}, nil
+}
+
+// Close 关闭连接
+func (s *CouponGRPCClientService) Close() error {
+	return s.conn.Close()
+}
+
+// CreateCoupon 创建优惠券
+func (s *CouponGRPCClientService) CreateCoupon(ctx context.Context, req *couponpb.CreateCouponRequest) (*couponpb.CreateCouponResponse, error) {
+	log.Printf("🔍 gRPC Client: 创建优惠券 - 优惠码: %s", req.Code)
+
+	resp, err := s.client.CreateCoupon(ctx, req)
+	if err != nil {
+		log.Printf("❌ gRPC Client: 创建优惠券失败 - %v", err)
+		return nil, fmt.Errorf("创建优惠券失败: %w", err)
+	}
+	return resp, nil
+}
+
+// ListCoupons 获取优惠券列表
+func (s *CouponGRPCClientService) ListCoupons(ctx context.Context, userID, courseID uint) (*couponpb.ListCouponsResponse, error) {
+	resp, err := s.client.ListCoupons(ctx, &couponpb.ListCouponsRequest{
+		UserId:   uint32(userID),
+		CourseId: uint32(courseID),
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 获取优惠券列表失败 - %v", err)
+		return nil, fmt.Errorf("获取优惠券列表失败: %w", err)
+	}
+	return resp, nil
+}
+
+// DeactivateCoupon 停用优惠券
+func (s *CouponGRPCClientService) DeactivateCoupon(ctx context.Context, code string, userID uint) (*couponpb.DeactivateCouponResponse, error) {
+	resp, err := s.client.DeactivateCoupon(ctx, &couponpb.DeactivateCouponRequest{
+		UserId: uint32(userID),
+		Code:   code,
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 停用优惠券失败 - %v", err)
+		return nil, fmt.Errorf("停用优惠券失败: %w", err)
+	}
+	return resp, nil
+}
@@ -107,11 +107,13 @@ func (s *CourseGRPCClientService) GetCourses(ctx context.Context, page, pageSize
 }
 
 // GetCourse 获取单个课程
-func (s *CourseGRPCClientService) GetCourse(ctx context.Context, courseID uint) (*coursepb.GetCourseResponse, error) {
+// userID 不为0时返回该学员在各先修课程上的完成度
+func (s *CourseGRPCClientService) GetCourse(ctx context.Context, courseID, userID uint) (*coursepb.GetCourseResponse, error) {
 	log.Printf("🔍 gRPC Client: 获取课程详情 - 课程ID: %d", courseID)
 
 	req := &coursepb.GetCourseRequest{
 		CourseId: uint32(courseID),
+		UserId:   uint32(userID),
 	}
 
 	resp, err := s.client.GetCourse(ctx, req)
@@ -120,7 +122,7 @@ func (s *CourseGRPCClientService) GetCourse(ctx context.Context, courseID uint)
 		return nil, fmt.Errorf("获取课程详情失败: %w", err)
 	}
 
-	log.Printf("✅ gRPC Client: 获取课程详情成功 - 课程ID: %d", resp.Course.Id)
+	log.Printf("✅ gRPC Client: 获取课程详情成功 - 课程ID: %d", resp.GetCourse().GetId())
 	return resp, nil
 }
 
@@ -180,3 +182,151 @@ func (s *CourseGRPCClientService) UpdateCourse(ctx context.Context, courseID uin
 	log.Printf("✅ gRPC Client: 更新课程成功 - 课程ID: %d", resp.Course.Id)
 	return resp, nil
 }
+
+// EnrollCourse 报名课程
+func (s *CourseGRPCClientService) EnrollCourse(ctx context.Context, courseID, userID uint) (*coursepb.EnrollCourseResponse, error) {
+	log.Printf("🔍 gRPC Client: 报名课程 - 课程ID: %d, 用户ID: %d", courseID, userID)
+
+	req := &coursepb.EnrollCourseRequest{
+		CourseId: uint32(courseID),
+		UserId:   uint32(userID),
+	}
+
+	resp, err := s.client.EnrollCourse(ctx, req)
+	if err != nil {
+		log.Printf("❌ gRPC Client: 报名课程失败 - %v", err)
+		return nil, fmt.Errorf("报名课程失败: %w", err)
+	}
+
+	return resp, nil
+}
+
+// CheckCourseAccess 检查用户是否有权访问课程内容
+func (s *CourseGRPCClientService) CheckCourseAccess(ctx context.Context, courseID, userID uint) (bool, error) {
+	req := &coursepb.CheckCourseAccessRequest{
+		CourseId: uint32(courseID),
+		UserId:   uint32(userID),
+	}
+
+	resp, err := s.client.CheckCourseAccess(ctx, req)
+	if err != nil {
+		log.Printf("❌ gRPC Client: 检查课程访问权限失败 - %v", err)
+		return false, fmt.Errorf("检查课程访问权限失败: %w", err)
+	}
+	if resp.Code != 200 {
+		return false, fmt.Errorf("检查课程访问权限失败: %s", resp.Message)
+	}
+
+	return resp.HasAccess, nil
+}
+
+// CreateChapter 创建章节
+func (s *CourseGRPCClientService) CreateChapter(ctx context.Context, req *coursepb.CreateChapterRequest) (*coursepb.CreateChapterResponse, error) {
+	log.Printf("🔍 gRPC Client: 创建章节 - 课程ID: %d", req.CourseId)
+
+	resp, err := s.client.CreateChapter(ctx, req)
+	if err != nil {
+		log.Printf("❌ gRPC Client: 创建章节失败 - %v", err)
+		return nil, fmt.Errorf("创建章节失败: %w", err)
+	}
+
+	return resp, nil
+}
+
+// GetChapters 获取课程章节列表，userID 不为0时附带该学员的开放时间
+func (s *CourseGRPCClientService) GetChapters(ctx context.Context, courseID, userID uint) (*coursepb.GetChaptersResponse, error) {
+	resp, err := s.client.GetChapters(ctx, &coursepb.GetChaptersRequest{
+		CourseId: uint32(courseID),
+		UserId:   uint32(userID),
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 获取章节列表失败 - %v", err)
+		return nil, fmt.Errorf("获取章节列表失败: %w", err)
+	}
+
+	return resp, nil
+}
+
+// SetChapterRelease 设置章节开放规则
+func (s *CourseGRPCClientService) SetChapterRelease(ctx context.Context, req *coursepb.SetChapterReleaseRequest) (*coursepb.SetChapterReleaseResponse, error) {
+	log.Printf("🔍 gRPC Client: 设置章节开放规则 - 章节ID: %d", req.ChapterId)
+
+	resp, err := s.client.SetChapterRelease(ctx, req)
+	if err != nil {
+		log.Printf("❌ gRPC Client: 设置章节开放规则失败 - %v", err)
+		return nil, fmt.Errorf("设置章节开放规则失败: %w", err)
+	}
+
+	return resp, nil
+}
+
+// CheckChapterRelease 检查章节是否已对学员开放
+func (s *CourseGRPCClientService) CheckChapterRelease(ctx context.Context, chapterID, userID uint) (*coursepb.Chapter, error) {
+	resp, err := s.client.CheckChapterRelease(ctx, &coursepb.CheckChapterReleaseRequest{
+		ChapterId: uint32(chapterID),
+		UserId:    uint32(userID),
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 检查章节开放失败 - %v", err)
+		return nil, fmt.Errorf("检查章节开放失败: %w", err)
+	}
+	if resp.Code != 200 {
+		return nil, fmt.Errorf("检查章节开放失败: %s", resp.Message)
+	}
+
+	return resp.Chapter, nil
+}
+
+// CompleteChapter 标记章节已学完
+func (s *CourseGRPCClientService) CompleteChapter(ctx context.Context, courseID, chapterID, userID uint) (*coursepb.CompleteChapterResponse, error) {
+	resp, err := s.client.CompleteChapter(ctx, &coursepb.CompleteChapterRequest{
+		CourseId:  uint32(courseID),
+		ChapterId: uint32(chapterID),
+		UserId:    uint32(userID),
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 完成章节失败 - %v", err)
+		return nil, fmt.Errorf("完成章节失败: %w", err)
+	}
+
+	return resp, nil
+}
+
+// GetCourseProgress 获取学习进度
+func (s *CourseGRPCClientService) GetCourseProgress(ctx context.Context, courseID, userID uint) (*coursepb.GetCourseProgressResponse, error) {
+	resp, err := s.client.GetCourseProgress(ctx, &coursepb.GetCourseProgressRequest{
+		CourseId: uint32(courseID),
+		UserId:   uint32(userID),
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 获取学习进度失败 - %v", err)
+		return nil, fmt.Errorf("获取学习进度失败: %w", err)
+	}
+
+	return resp, nil
+}
+
+// SetCourseSale 设置或取消课程促销价
+func (s *CourseGRPCClientService) SetCourseSale(ctx context.Context, req *coursepb.SetCourseSaleRequest) (*coursepb.SetCourseSaleResponse, error) {
+	log.Printf("🔍 gRPC Client: 设置课程促销 - 课程ID: %d", req.CourseId)
+
+	resp, err := s.client.SetCourseSale(ctx, req)
+	if err != nil {
+		log.Printf("❌ gRPC Client: 设置课程促销失败 - %v", err)
+		return nil, fmt.Errorf("设置课程促销失败: %w", err)
+	}
+
+	return resp, nil
+}
+
+// SetCoursePrerequisites 设置课程的先修要求
+func (s *CourseGRPCClientService) SetCoursePrerequisites(ctx context.Context, req *coursepb.SetCoursePrerequisitesRequest) (*coursepb.SetCoursePrerequisitesResponse, error) {
+	log.Printf("🔍 gRPC Client: 设置先修课程 - 课程ID: %d", req.CourseId)
+
+	resp, err := s.client.SetCoursePrerequisites(ctx, req)
+	if err != nil {
+		log.Printf("❌ gRPC Client: 设置先修课程失败 - %v", err)
+		return nil, fmt.Errorf("设置先修课程失败: %w", err)
+	}
+	return resp, nil
+}
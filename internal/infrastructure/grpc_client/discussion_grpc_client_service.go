@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"course-platform/internal/shared/pb/discussionpb"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// DiscussionGRPCClientService 课程讨论区服务gRPC客户端（讨论区服务与课程服务同进程部署）
+type DiscussionGRPCClientService struct {
+	client discussionpb.DiscussionServiceClient
+	conn   *grpc.ClientConn
+}
+
+// NewDiscussionGRPCClientService 创建课程讨论区服务gRPC客户端
+func NewDiscussionGRPCClientService(address string) (*DiscussionGRPCClientService, error) {
+	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("连接讨论区服务失败: %w", err)
+	}
+
+	log.Printf("✅ 讨论区服务gRPC客户端已连接: %s", address)
+	return &DiscussionGRPCClientService{
+		client: discussionpb.NewDiscussionServiceClient(conn),
+		conn:   conn,
+	}, nil
+}
+
+// Close 关闭连接
+func (s *DiscussionGRPCClientService) Close() error {
+	return s.conn.Close()
+}
+
+// CreateThread 发布讨论帖
+func (s *DiscussionGRPCClientService) CreateThread(ctx context.Context, req *discussionpb.CreateThreadRequest) (*discussionpb.CreateThreadResponse, error) {
+	log.Printf("🔍 gRPC Client: 发布讨论帖 - 课程ID: %d, 标题: %s", req.CourseId, req.Title)
+
+	resp, err := s.client.CreateThread(ctx, req)
+	if err != nil {
+		log.Printf("❌ gRPC Client: 发布讨论帖失败 - %v", err)
+		return nil, fmt.Errorf("发布讨论帖失败: %w", err)
+	}
+	return resp, nil
+}
+
+// ListThreads 分页获取课程讨论帖
+func (s *DiscussionGRPCClientService) ListThreads(ctx context.Context, req *discussionpb.ListThreadsRequest) (*discussionpb.ListThreadsResponse, error) {
+	resp, err := s.client.ListThreads(ctx, req)
+	if err != nil {
+		log.Printf("❌ gRPC Client: 获取讨论帖列表失败 - %v", err)
+		return nil, fmt.Errorf("获取讨论帖列表失败: %w", err)
+	}
+	return resp, nil
+}
+
+// GetThread 获取讨论帖及分页的回复
+func (s *DiscussionGRPCClientService) GetThread(ctx context.Context, threadID, userID, page, pageSize uint) (*discussionpb.GetThreadResponse, error) {
+	resp, err := s.client.GetThread(ctx, &discussionpb.GetThreadRequest{
+		ThreadId: uint32(threadID),
+		UserId:   uint32(userID),
+		Page:     uint32(page),
+		PageSize: uint32(pageSize),
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 获取讨论帖失败 - %v", err)
+		return nil, fmt.Errorf("获取讨论帖失败: %w", err)
+	}
+	return resp, nil
+}
+
+// CreateReply 回复讨论帖
+func (s *DiscussionGRPCClientService) CreateReply(ctx context.Context, threadID, parentID, userID uint, body string) (*discussionpb.CreateReplyResponse, error) {
+	resp, err := s.client.CreateReply(ctx, &discussionpb.CreateReplyRequest{
+		ThreadId: uint32(threadID),
+		ParentId: uint32(parentID),
+		UserId:   uint32(userID),
+		Body:     body,
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 回复失败 - %v", err)
+		return nil, fmt.Errorf("回复失败: %w", err)
+	}
+	return resp, nil
+}
+
+// Vote 点赞或取消点赞
+func (s *DiscussionGRPCClientService) Vote(ctx context.Context, targetType string, targetID, userID uint, upvote bool) (*discussionpb.VoteResponse, error) {
+	resp, err := s.client.Vote(ctx, &discussionpb.VoteRequest{
+		TargetType: targetType,
+		TargetId:   uint32(targetID),
+		UserId:     uint32(userID),
+		Upvote:     upvote,
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 点赞失败 - %v", err)
+		return nil, fmt.Errorf("点赞失败: %w", err)
+	}
+	return resp, nil
+}
+
+// ModerateThread 置顶或锁定讨论帖
+func (s *DiscussionGRPCClientService) ModerateThread(ctx context.Context, threadID, userID uint, action string) (*discussionpb.ModerateThreadResponse, error) {
+	log.Printf("🔍 gRPC Client: 管理讨论帖 - 帖子ID: %d, 操作: %s", threadID, action)
+
+	resp, err := s.client.ModerateThread(ctx, &discussionpb.ModerateThreadRequest{
+		ThreadId: uint32(threadID),
+		UserId:   uint32(userID),
+		Action:   action,
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 管理讨论帖失败 - %v", err)
+		return nil, fmt.Errorf("管理讨论帖失败: %w", err)
+	}
+	return resp, nil
+}
+
+// MarkAnswer 标记或取消最佳回答
+func (s *DiscussionGRPCClientService) MarkAnswer(ctx context.Context, threadID, replyID, userID uint) (*discussionpb.MarkAnswerResponse, error) {
+	resp, err := s.client.MarkAnswer(ctx, &discussionpb.MarkAnswerRequest{
+		ThreadId: uint32(threadID),
+		ReplyId:  uint32(replyID),
+		UserId:   uint32(userID),
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 标记最佳回答失败 - %v", err)
+		return nil, fmt.Errorf("标记最佳回答失败: %w", err)
+	}
+	return resp, nil
+}
+
+// DeleteThread 删除讨论帖
+func (s *DiscussionGRPCClientService) DeleteThread(ctx context.Context, threadID, userID uint) (*discussionpb.DeleteThreadResponse, error) {
+	resp, err := s.client.DeleteThread(ctx, &discussionpb.DeleteThreadRequest{
+		ThreadId: uint32(threadID),
+		UserId:   uint32(userID),
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 删除讨论帖失败 - %v", err)
+		return nil, fmt.Errorf("删除讨论帖失败: %w", err)
+	}
+	return resp, nil
+}
+
+// DeleteReply 删除回复
+func (s *DiscussionGRPCClientService) DeleteReply(ctx context.Context, replyID, userID uint) (*discussionpb.DeleteReplyResponse, error) {
+	resp, err := s.client.DeleteReply(ctx, &discussionpb.DeleteReplyRequest{
+		ReplyId: uint32(replyID),
+		UserId:  uint32(userID),
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 删除回复失败 - %v", err)
+		return nil, fmt.Errorf("删除回复失败: %w", err)
+	}
+	return resp, nil
+}
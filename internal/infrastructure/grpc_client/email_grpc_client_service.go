@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"course-platform/internal/shared/pb/emailpb"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// EmailGRPCClientService 邮件订阅服务gRPC客户端（邮件服务与课程服务同进程部署）
+type EmailGRPCClientService struct {
+	client emailpb.EmailServiceClient
+	conn   *grpc.ClientConn
+}
+
+// NewEmailGRPCClientService 创建邮件订阅服务gRPC客户端
+func NewEmailGRPCClientService(address string) (*EmailGRPCClientService, error) {
+	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("连接邮件服务失败: %w", err)
+	}
+
+	log.Printf("✅ 邮件服务gRPC客户端已连接: %s", address)
+	return &EmailGRPCClientService{
+		client: emailpb.NewEmailServiceClient(conn),
+		conn:   conn,
+	}, nil
+}
+
+// Close 关闭连接
+func (s *EmailGRPCClientService) Close() error {
+	return s.conn.Close()
+}
+
+// GetEmailPreferences 获取邮件订阅状态
+func (s *EmailGRPCClientService) GetEmailPreferences(ctx context.Context, userID uint) (*emailpb.EmailPreferencesResponse, error) {
+	resp, err := s.client.GetEmailPreferences(ctx, &emailpb.GetEmailPreferencesRequest{
+		UserId: uint32(userID),
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 获取邮件订阅状态失败 - %v", err)
+		return nil, fmt.Errorf("获取邮件订阅状态失败: %w", err)
+	}
+	return resp, nil
+}
+
+// UpdateEmailPreferences 更新邮件订阅状态，subscribed 以邮件类别为键
+func (s *EmailGRPCClientService) UpdateEmailPreferences(ctx context.Context, userID uint, subscribed map[string]bool) (*emailpb.EmailPreferencesResponse, error) {
+	preferences := make([]*emailpb.EmailPreference, 0, len(subscribed))
+	for category, value := range subscribed {
+		preferences = append(preferences, &emailpb.EmailPreference{
+			Category:   category,
+			Subscribed: value,
+		})
+	}
+
+	resp, err := s.client.UpdateEmailPreferences(ctx, &emailpb.UpdateEmailPreferencesRequest{
+		UserId:      uint32(userID),
+		Preferences: preferences,
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 更新邮件订阅状态失败 - %v", err)
+		return nil, fmt.Errorf("更新邮件订阅状态失败: %w", err)
+	}
+	return resp, nil
+}
+
+// Unsubscribe 通过退订令牌退订
+func (s *EmailGRPCClientService) Unsubscribe(ctx context.Context, token string) (*emailpb.UnsubscribeResponse, error) {
+	resp, err := s.client.Unsubscribe(ctx, &emailpb.UnsubscribeRequest{
+		Token: token,
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 退订邮件失败 - %v", err)
+		return nil, fmt.Errorf("退订邮件失败: %w", err)
+	}
+	return resp, nil
+}
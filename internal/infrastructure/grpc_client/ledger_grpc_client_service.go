@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"course-platform/internal/shared/pb/ledgerpb"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// LedgerGRPCClientService 收入分账服务gRPC客户端（分账服务与课程服务同进程部署）
+type LedgerGRPCClientService struct {
+	client ledgerpb.LedgerServiceClient
+	conn   *grpc.ClientConn
+}
+
+// NewLedgerGRPCClientService 创建收入分账服务gRPC客户端
+func NewLedgerGRPCClientService(address string) (*LedgerGRPCClientService, error) {
+	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("连接收入分账服务失败: %w", err)
+	}
+
+	log.Printf("✅ 收入分账服务gRPC客户端已连接: %s", address)
+	return &LedgerGRPCClientService{
+		client: ledgerpb.NewLedgerServiceClient(conn),
+		conn:   conn,
+	}, nil
+}
+
+// Close 关闭连接
+func (s *LedgerGRPCClientService) Close() error {
+	return s.conn.Close()
+}
+
+// GetCreatorStats 获取讲师经营统计
+func (s *LedgerGRPCClientService) GetCreatorStats(ctx context.Context, instructorID uint, months int) (*ledgerpb.GetCreatorStatsResponse, error) {
+	log.Printf("🔍 gRPC Client: 获取讲师统计 - 讲师ID: %d", instructorID)
+
+	resp, err := s.client.GetCreatorStats(ctx, &ledgerpb.GetCreatorStatsRequest{
+		InstructorId: uint32(instructorID),
+		Months:       int32(months),
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 获取讲师统计失败 - %v", err)
+		return nil, fmt.Errorf("获取讲师统计失败: %w", err)
+	}
+	return resp, nil
+}
+
+// ExportCreatorLedger 导出讲师收入明细CSV
+func (s *LedgerGRPCClientService) ExportCreatorLedger(ctx context.Context, instructorID uint, fromMonth, toMonth string) (*ledgerpb.ExportCreatorLedgerResponse, error) {
+	resp, err := s.client.ExportCreatorLedger(ctx, &ledgerpb.ExportCreatorLedgerRequest{
+		InstructorId: uint32(instructorID),
+		FromMonth:    fromMonth,
+		ToMonth:      toMonth,
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 导出收入明细失败 - %v", err)
+		return nil, fmt.Errorf("导出收入明细失败: %w", err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"course-platform/internal/shared/pb/notificationpb"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// NotificationGRPCClientService 站内通知服务gRPC客户端（通知服务与课程服务同进程部署）
+type NotificationGRPCClientService struct {
+	client notificationpb.NotificationServiceClient
+	conn   *grpc.ClientConn
+}
+
+// NewNotificationGRPCClientService 创建站内通知服务gRPC客户端
+func NewNotificationGRPCClientService(address string) (*NotificationGRPCClientService, error) {
+	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("连接站内通知服务失败: %w", err)
+	}
+
+	log.Printf("✅ 站内通知服务gRPC客户端已连接: %s", address)
+	return &NotificationGRPCClientService{
+		client: notificationpb.NewNotificationServiceClient(conn),
+		conn:   conn,
+	}, nil
+}
+
+// Close 关闭连接
+func (s *NotificationGRPCClientService) Close() error {
+	return s.conn.Close()
+}
+
+// ListNotifications 分页获取用户的通知
+func (s *NotificationGRPCClientService) ListNotifications(ctx context.Context, userID uint, unreadOnly bool, page, pageSize uint) (*notificationpb.ListNotificationsResponse, error) {
+	resp, err := s.client.ListNotifications(ctx, &notificationpb.ListNotificationsRequest{
+		UserId:     uint32(userID),
+		UnreadOnly: unreadOnly,
+		Page:       uint32(page),
+		PageSize:   uint32(pageSize),
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 获取通知列表失败 - %v", err)
+		return nil, fmt.Errorf("获取通知列表失败: %w", err)
+	}
+	return resp, nil
+}
+
+// MarkNotificationsRead 标记通知已读，all 为 true 时标记全部
+func (s *NotificationGRPCClientService) MarkNotificationsRead(ctx context.Context, userID uint, ids []uint, all bool) (*notificationpb.MarkNotificationsReadResponse, error) {
+	pbIDs := make([]uint32, 0, len(ids))
+	for _, id := range ids {
+		pbIDs = append(pbIDs, uint32(id))
+	}
+
+	resp, err := s.client.MarkNotificationsRead(ctx, &notificationpb.MarkNotificationsReadRequest{
+		UserId: uint32(userID),
+		Ids:    pbIDs,
+		All:    all,
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 标记通知已读失败 - %v", err)
+		return nil, fmt.Errorf("标记通知已读失败: %w", err)
+	}
+	return resp, nil
+}
+
+// GetPreferences 获取通知偏好
+func (s *NotificationGRPCClientService) GetPreferences(ctx context.Context, userID uint) (*notificationpb.PreferencesResponse, error) {
+	resp, err := s.client.GetPreferences(ctx, &notificationpb.GetPreferencesRequest{
+		UserId: uint32(userID),
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 获取通知偏好失败 - %v", err)
+		return nil, fmt.Errorf("获取通知偏好失败: %w", err)
+	}
+	return resp, nil
+}
+
+// UpdatePreferences 更新通知偏好，enabled 以通知类型为键
+func (s *NotificationGRPCClientService) UpdatePreferences(ctx context.Context, userID uint, enabled map[string]bool) (*notificationpb.PreferencesResponse, error) {
+	preferences := make([]*notificationpb.Preference, 0, len(enabled))
+	for notificationType, value := range enabled {
+		preferences = append(preferences, &notificationpb.Preference{
+			Type:    notificationType,
+			Enabled: value,
+		})
+	}
+
+	resp, err := s.client.UpdatePreferences(ctx, &notificationpb.UpdatePreferencesRequest{
+		UserId:      uint32(userID),
+		Preferences: preferences,
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 更新通知偏好失败 - %v", err)
+		return nil, fmt.Errorf("更新通知偏好失败: %w", err)
+	}
+	return resp, nil
+}
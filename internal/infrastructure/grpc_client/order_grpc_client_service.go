@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"course-platform/internal/shared/pb/orderpb"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// OrderGRPCClientService 订单服务gRPC客户端（订单服务与课程服务同进程部署）
+type OrderGRPCClientService struct {
+	client orderpb.OrderServiceClient
+	conn   *grpc.ClientConn
+}
+
+// NewOrderGRPCClientService 创建订单服务gRPC客户端
+func NewOrderGRPCClientService(address string) (*OrderGRPCClientService, error) {
+	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("连接订单服务失败: %w", err)
+	}
+
+	log.Printf("✅ 订单服务gRPC客户端已连接: %s", address)
+	return &OrderGRPCClientService{
+		client: orderpb.NewOrderServiceClient(conn),
+		conn:   conn,
+	}, nil
+}
+
+// Close 关闭连接
+func (s *OrderGRPCClientService) Close() error {
+	return s.conn.Close()
+}
+
+// CreateOrder 创建订单
+func (s *OrderGRPCClientService) CreateOrder(ctx context.Context, userID, courseID, bundleID uint, idempotencyKey, couponCode string) (*orderpb.CreateOrderResponse, error) {
+	log.Printf("🔍 gRPC Client: 创建订单 - 课程ID: %d, 套餐ID: %d", courseID, bundleID)
+
+	resp, err := s.client.CreateOrder(ctx, &orderpb.CreateOrderRequest{
+		UserId:         uint32(userID),
+		CourseId:       uint32(courseID),
+		BundleId:       uint32(bundleID),
+		IdempotencyKey: idempotencyKey,
+		CouponCode:     couponCode,
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 创建订单失败 - %v", err)
+		return nil, fmt.Errorf("创建订单失败: %w", err)
+	}
+	return resp, nil
+}
+
+// PreviewOrder 预览结算价格
+func (s *OrderGRPCClientService) PreviewOrder(ctx context.Context, userID, courseID, bundleID uint, couponCode string) (*orderpb.PreviewOrderResponse, error) {
+	resp, err := s.client.PreviewOrder(ctx, &orderpb.PreviewOrderRequest{
+		UserId:     uint32(userID),
+		CourseId:   uint32(courseID),
+		BundleId:   uint32(bundleID),
+		CouponCode: couponCode,
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 获取结算价格失败 - %v", err)
+		return nil, fmt.Errorf("获取结算价格失败: %w", err)
+	}
+	return resp, nil
+}
+
+// GetOrder 获取订单详情
+func (s *OrderGRPCClientService) GetOrder(ctx context.Context, orderNo string, userID uint) (*orderpb.GetOrderResponse, error) {
+	resp, err := s.client.GetOrder(ctx, &orderpb.GetOrderRequest{OrderNo: orderNo, UserId: uint32(userID)})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 获取订单失败 - %v", err)
+		return nil, fmt.Errorf("获取订单失败: %w", err)
+	}
+	return resp, nil
+}
+
+// ListMyOrders 获取本人的订单列表
+func (s *OrderGRPCClientService) ListMyOrders(ctx context.Context, userID uint) (*orderpb.ListMyOrdersResponse, error) {
+	resp, err := s.client.ListMyOrders(ctx, &orderpb.ListMyOrdersRequest{UserId: uint32(userID)})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 获取订单列表失败 - %v", err)
+		return nil, fmt.Errorf("获取订单列表失败: %w", err)
+	}
+	return resp, nil
+}
+
+// CancelOrder 取消订单
+func (s *OrderGRPCClientService) CancelOrder(ctx context.Context, orderNo string, userID uint) (*orderpb.CancelOrderResponse, error) {
+	resp, err := s.client.CancelOrder(ctx, &orderpb.CancelOrderRequest{OrderNo: orderNo, UserId: uint32(userID)})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 取消订单失败 - %v", err)
+		return nil, fmt.Errorf("取消订单失败: %w", err)
+	}
+	return resp, nil
+}
+
+// HandlePaymentWebhook 转发支付渠道回调
+func (s *OrderGRPCClientService) HandlePaymentWebhook(ctx context.Context, payload []byte, signature string) (*orderpb.HandlePaymentWebhookResponse, error) {
+	resp, err := s.client.HandlePaymentWebhook(ctx, &orderpb.HandlePaymentWebhookRequest{
+		Payload:   payload,
+		Signature: signature,
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 处理支付回调失败 - %v", err)
+		return nil, fmt.Errorf("处理支付回调失败: %w", err)
+	}
+	return resp, nil
+}
+
+// SimulatePayment 模拟支付结果
+func (s *OrderGRPCClientService) SimulatePayment(ctx context.Context, orderNo string, userID uint, succeed bool) (*orderpb.SimulatePaymentResponse, error) {
+	resp, err := s.client.SimulatePayment(ctx, &orderpb.SimulatePaymentRequest{
+		OrderNo: orderNo,
+		UserId:  uint32(userID),
+		Succeed: succeed,
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 模拟支付失败 - %v", err)
+		return nil, fmt.Errorf("模拟支付失败: %w", err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"course-platform/internal/shared/pb/privacypb"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// PrivacyGRPCClientService 个人数据服务gRPC客户端（个人数据服务与课程服务同进程部署）
+type PrivacyGRPCClientService struct {
+	client privacypb.PrivacyServiceClient
+	conn   *grpc.ClientConn
+}
+
+// NewPrivacyGRPCClientService 创建个人数据服务gRPC客户端
+func NewPrivacyGRPCClientService(address string) (*PrivacyGRPCClientService, error) {
+	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("连接个人数据服务失败: %w", err)
+	}
+
+	log.Printf("✅ 个人数据服务gRPC客户端已连接: %s", address)
+	return &PrivacyGRPCClientService{
+		client: privacypb.NewPrivacyServiceClient(conn),
+		conn:   conn,
+	}, nil
+}
+
+// Close 关闭连接
+func (s *PrivacyGRPCClientService) Close() error {
+	return s.conn.Close()
+}
+
+// GetPrivacyStatus 获取导出记录和注销状态
+func (s *PrivacyGRPCClientService) GetPrivacyStatus(ctx context.Context, userID uint) (*privacypb.PrivacyStatusResponse, error) {
+	resp, err := s.client.GetPrivacyStatus(ctx, &privacypb.GetPrivacyStatusRequest{
+		UserId: uint32(userID),
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 获取个人数据状态失败 - %v", err)
+		return nil, fmt.Errorf("获取个人数据状态失败: %w", err)
+	}
+	return resp, nil
+}
+
+// RequestDataExport 申请导出个人数据
+func (s *PrivacyGRPCClientService) RequestDataExport(ctx context.Context, userID uint) (*privacypb.PrivacyStatusResponse, error) {
+	log.Printf("🔍 gRPC Client: 申请导出个人数据 - 用户ID: %d", userID)
+
+	resp, err := s.client.RequestDataExport(ctx, &privacypb.RequestDataExportRequest{
+		UserId: uint32(userID),
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 申请导出个人数据失败 - %v", err)
+		return nil, fmt.Errorf("申请导出个人数据失败: %w", err)
+	}
+	return resp, nil
+}
+
+// GetDataExport 获取导出压缩包的下载地址
+func (s *PrivacyGRPCClientService) GetDataExport(ctx context.Context, userID, exportID uint) (*privacypb.GetDataExportResponse, error) {
+	resp, err := s.client.GetDataExport(ctx, &privacypb.GetDataExportRequest{
+		UserId: uint32(userID),
+		Id:     uint32(exportID),
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 获取数据导出失败 - %v", err)
+		return nil, fmt.Errorf("获取数据导出失败: %w", err)
+	}
+	return resp, nil
+}
+
+// RequestAccountDeletion 申请注销账号
+func (s *PrivacyGRPCClientService) RequestAccountDeletion(ctx context.Context, userID uint, confirmEmail, transferToEmail string) (*privacypb.PrivacyStatusResponse, error) {
+	log.Printf("🔍 gRPC Client: 申请注销账号 - 用户ID: %d", userID)
+
+	resp, err := s.client.RequestAccountDeletion(ctx, &privacypb.RequestAccountDeletionRequest{
+		UserId:          uint32(userID),
+		ConfirmEmail:    confirmEmail,
+		TransferToEmail: transferToEmail,
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 申请注销账号失败 - %v", err)
+		return nil, fmt.Errorf("申请注销账号失败: %w", err)
+	}
+	return resp, nil
+}
+
+// CancelAccountDeletion 撤销注销申请
+func (s *PrivacyGRPCClientService) CancelAccountDeletion(ctx context.Context, userID uint) (*privacypb.PrivacyStatusResponse, error) {
+	resp, err := s.client.CancelAccountDeletion(ctx, &privacypb.CancelAccountDeletionRequest{
+		UserId: uint32(userID),
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 撤销注销申请失败 - %v", err)
+		return nil, fmt.Errorf("撤销注销申请失败: %w", err)
+	}
+	return resp, nil
+}
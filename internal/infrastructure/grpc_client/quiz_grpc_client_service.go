@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"course-platform/internal/shared/pb/quizpb"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// QuizGRPCClientService 测验服务gRPC客户端（测验服务与课程服务同进程部署）
+type QuizGRPCClientService struct {
+	client quizpb.QuizServiceClient
+	conn   *grpc.ClientConn
+}
+
+// NewQuizGRPCClientService 创建测验服务gRPC客户端
+func NewQuizGRPCClientService(address string) (*QuizGRPCClientService, error) {
+	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("连接测验服务失败: %w", err)
+	}
+
+	log.Printf("✅ 测验服务gRPC客户端已连接: %s", address)
+	return &QuizGRPCClientService{
+		client: quizpb.NewQuizServiceClient(conn),
+		conn:   conn,
+	}, nil
+}
+
+// Close 关闭连接
+func (s *QuizGRPCClientService) Close() error {
+	return s.conn.Close()
+}
+
+// CreateQuestion 创建题目
+func (s *QuizGRPCClientService) CreateQuestion(ctx context.Context, req *quizpb.CreateQuestionRequest) (*quizpb.CreateQuestionResponse, error) {
+	resp, err := s.client.CreateQuestion(ctx, req)
+	if err != nil {
+		log.Printf("❌ gRPC Client: 创建题目失败 - %v", err)
+		return nil, fmt.Errorf("创建题目失败: %w", err)
+	}
+	return resp, nil
+}
+
+// ListQuestions 获取课程题库
+func (s *QuizGRPCClientService) ListQuestions(ctx context.Context, courseID, userID uint) (*quizpb.ListQuestionsResponse, error) {
+	resp, err := s.client.ListQuestions(ctx, &quizpb.ListQuestionsRequest{
+		CourseId: uint32(courseID),
+		UserId:   uint32(userID),
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 获取题库失败 - %v", err)
+		return nil, fmt.Errorf("获取题库失败: %w", err)
+	}
+	return resp, nil
+}
+
+// CreateQuiz 创建测验
+func (s *QuizGRPCClientService) CreateQuiz(ctx context.Context, req *quizpb.CreateQuizRequest) (*quizpb.CreateQuizResponse, error) {
+	log.Printf("🔍 gRPC Client: 创建测验 - 课程ID: %d, 章节ID: %d", req.CourseId, req.ChapterId)
+
+	resp, err := s.client.CreateQuiz(ctx, req)
+	if err != nil {
+		log.Printf("❌ gRPC Client: 创建测验失败 - %v", err)
+		return nil, fmt.Errorf("创建测验失败: %w", err)
+	}
+	return resp, nil
+}
+
+// GetQuiz 获取测验详情
+func (s *QuizGRPCClientService) GetQuiz(ctx context.Context, quizID, userID uint) (*quizpb.GetQuizResponse, error) {
+	resp, err := s.client.GetQuiz(ctx, &quizpb.GetQuizRequest{
+		QuizId: uint32(quizID),
+		UserId: uint32(userID),
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 获取测验失败 - %v", err)
+		return nil, fmt.Errorf("获取测验失败: %w", err)
+	}
+	return resp, nil
+}
+
+// ListQuizzes 按课程或章节获取测验列表
+func (s *QuizGRPCClientService) ListQuizzes(ctx context.Context, courseID, chapterID uint) (*quizpb.ListQuizzesResponse, error) {
+	resp, err := s.client.ListQuizzes(ctx, &quizpb.ListQuizzesRequest{
+		CourseId:  uint32(courseID),
+		ChapterId: uint32(chapterID),
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 获取测验列表失败 - %v", err)
+		return nil, fmt.Errorf("获取测验列表失败: %w", err)
+	}
+	return resp, nil
+}
+
+// StartAttempt 开始答题
+func (s *QuizGRPCClientService) StartAttempt(ctx context.Context, quizID, userID uint) (*quizpb.StartAttemptResponse, error) {
+	log.Printf("🔍 gRPC Client: 开始答题 - 测验ID: %d, 用户ID: %d", quizID, userID)
+
+	resp, err := s.client.StartAttempt(ctx, &quizpb.StartAttemptRequest{
+		QuizId: uint32(quizID),
+		UserId: uint32(userID),
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 开始答题失败 - %v", err)
+		return nil, fmt.Errorf("开始答题失败: %w", err)
+	}
+	return resp, nil
+}
+
+// SubmitAttempt 提交答卷
+func (s *QuizGRPCClientService) SubmitAttempt(ctx context.Context, req *quizpb.SubmitAttemptRequest) (*quizpb.SubmitAttemptResponse, error) {
+	log.Printf("🔍 gRPC Client: 提交答卷 - 记录ID: %d, 用户ID: %d", req.AttemptId, req.UserId)
+
+	resp, err := s.client.SubmitAttempt(ctx, req)
+	if err != nil {
+		log.Printf("❌ gRPC Client: 提交答卷失败 - %v", err)
+		return nil, fmt.Errorf("提交答卷失败: %w", err)
+	}
+	return resp, nil
+}
+
+// ListAttempts 获取答题历史
+func (s *QuizGRPCClientService) ListAttempts(ctx context.Context, quizID, userID, studentID uint) (*quizpb.ListAttemptsResponse, error) {
+	resp, err := s.client.ListAttempts(ctx, &quizpb.ListAttemptsRequest{
+		QuizId:    uint32(quizID),
+		UserId:    uint32(userID),
+		StudentId: uint32(studentID),
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 获取答题历史失败 - %v", err)
+		return nil, fmt.Errorf("获取答题历史失败: %w", err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"course-platform/internal/shared/pb/refundpb"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// RefundGRPCClientService 退款服务gRPC客户端（退款服务与课程服务同进程部署）
+type RefundGRPCClientService struct {
+	client refundpb.RefundServiceClient
+	conn   *grpc.ClientConn
+}
+
+// NewRefundGRPCClientService 创建退款服务gRPC客户端
+func NewRefundGRPCClientService(address string) (*RefundGRPCClientService, error) {
+	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("连接退款服务失败: %w", err)
+	}
+
+	log.Printf("✅ 退款服务gRPC客户端已连接: %s", address)
+	return &RefundGRPCClientService{
+		client: refundpb.NewRefundServiceClient(conn),
+		conn:   conn,
+	}, nil
+}
+
+// Close 关闭连接
+func (s *RefundGRPCClientService) Close() error {
+	return s.conn.Close()
+}
+
+// RequestRefund 提交退款申请
+func (s *RefundGRPCClientService) RequestRefund(ctx context.Context, userID uint, orderNo string, amount int64, reason string) (*refundpb.RequestRefundResponse, error) {
+	log.Printf("🔍 gRPC Client: 提交退款申请 - 订单号: %s", orderNo)
+
+	resp, err := s.client.RequestRefund(ctx, &refundpb.RequestRefundRequest{
+		UserId:  uint32(userID),
+		OrderNo: orderNo,
+		Amount:  amount,
+		Reason:  reason,
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 提交退款申请失败 - %v", err)
+		return nil, fmt.Errorf("提交退款申请失败: %w", err)
+	}
+	return resp, nil
+}
+
+// ApproveRefund 审核通过并执行退款
+func (s *RefundGRPCClientService) ApproveRefund(ctx context.Context, refundID, reviewerID uint, amount int64, note string) (*refundpb.ApproveRefundResponse, error) {
+	resp, err := s.client.ApproveRefund(ctx, &refundpb.ApproveRefundRequest{
+		RefundId:   uint32(refundID),
+		ReviewerId: uint32(reviewerID),
+		Amount:     amount,
+		Note:       note,
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 审核退款失败 - %v", err)
+		return nil, fmt.Errorf("审核退款失败: %w", err)
+	}
+	return resp, nil
+}
+
+// RejectRefund 拒绝退款申请
+func (s *RefundGRPCClientService) RejectRefund(ctx context.Context, refundID, reviewerID uint, note string) (*refundpb.RejectRefundResponse, error) {
+	resp, err := s.client.RejectRefund(ctx, &refundpb.RejectRefundRequest{
+		RefundId:   uint32(refundID),
+		ReviewerId: uint32(reviewerID),
+		Note:       note,
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 拒绝退款失败 - %v", err)
+		return nil, fmt.Errorf("拒绝退款失败: %w", err)
+	}
+	return resp, nil
+}
+
+// GetRefund 获取退款申请详情
+func (s *RefundGRPCClientService) GetRefund(ctx context.Context, refundID, userID uint) (*refundpb.GetRefundResponse, error) {
+	resp, err := s.client.GetRefund(ctx, &refundpb.GetRefundRequest{
+		RefundId: uint32(refundID),
+		UserId:   uint32(userID),
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 获取退款申请失败 - %v", err)
+		return nil, fmt.Errorf("获取退款申请失败: %w", err)
+	}
+	return resp, nil
+}
+
+// ListMyRefunds 获取本人的退款申请
+func (s *RefundGRPCClientService) ListMyRefunds(ctx context.Context, userID uint) (*refundpb.ListMyRefundsResponse, error) {
+	resp, err := s.client.ListMyRefunds(ctx, &refundpb.ListMyRefundsRequest{UserId: uint32(userID)})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 获取退款申请失败 - %v", err)
+		return nil, fmt.Errorf("获取退款申请失败: %w", err)
+	}
+	return resp, nil
+}
+
+// ListRefundQueue 获取退款审核列表
+func (s *RefundGRPCClientService) ListRefundQueue(ctx context.Context, reviewerID uint, status string) (*refundpb.ListRefundQueueResponse, error) {
+	resp, err := s.client.ListRefundQueue(ctx, &refundpb.ListRefundQueueRequest{
+		ReviewerId: uint32(reviewerID),
+		Status:     status,
+	})
+	if err != nil {
+		log.Printf("❌ gRPC Client: 获取退款审核列表失败 - %v", err)
+		return nil, fmt.Errorf("获取退款审核列表失败: %w", err)
+	}
+	return resp, nil
+}
@@ -5,9 +5,13 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"time"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 
 	"course-platform/internal/domain/user/model"
 	"course-platform/internal/shared/pb/userpb"
@@ -49,7 +53,7 @@ func (s *UserGRPCClientService) Close() error {
 }
 
 // Register 通过gRPC调用用户注册
-func (s *UserGRPCClientService) Register(username, email, password, nickname string) (*model.User, error) {
+func (s *UserGRPCClientService) Register(username, email, password, nickname, locale string) (*model.User, error) {
 	log.Printf("🌐 API Gateway: 通过gRPC调用注册 - 用户名: %s, 邮箱: %s", username, email)
 
 	req := &userpb.RegisterRequest{
@@ -57,6 +61,7 @@ func (s *UserGRPCClientService) Register(username, email, password, nickname str
 		Password: password,
 		Email:    email,
 		Nickname: nickname,
+		Locale:   locale,
 	}
 
 	resp, err := s.client.Register(context.Background(), req)
@@ -83,36 +88,327 @@ func (s *UserGRPCClientService) Register(username, email, password, nickname str
 	return user, nil
 }
 
-// Login 通过gRPC调用用户登录
-func (s *UserGRPCClientService) Login(username, password string) (string, *model.User, error) {
+// LoginLockedError 登录失败次数过多，被用户服务暂时限制
+type LoginLockedError struct {
+	Reason        string    // ErrorInfo 中的原因，如 ACCOUNT_LOCKED
+	AccountLocked bool      // true 为账号锁定，false 为来源IP受限
+	LockedUntil   time.Time // 解除时间
+	Message       string
+}
+
+// Error 返回用户服务给出的提示
+func (e *LoginLockedError) Error() string {
+	return e.Message
+}
+
+// Login 通过gRPC调用用户登录，启用两步验证的账号返回 MfaToken 而不是 Token
+// 失败次数过多被限制时返回 *LoginLockedError
+func (s *UserGRPCClientService) Login(username, password, clientIP, userAgent string) (*userpb.LoginResponse, error) {
 	log.Printf("🌐 API Gateway: 通过gRPC调用登录 - 用户名: %s", username)
 
 	req := &userpb.LoginRequest{
-		Username: username,
-		Password: password,
+		Username:  username,
+		Password:  password,
+		ClientIp:  clientIP,
+		UserAgent: userAgent,
 	}
 
 	resp, err := s.client.Login(context.Background(), req)
 	if err != nil {
+		if locked := parseLoginLocked(err); locked != nil {
+			log.Printf("⚠️ API Gateway: 登录被限制 - %s", locked.Message)
+			return nil, locked
+		}
 		log.Printf("❌ API Gateway: gRPC调用失败 - %v", err)
-		return "", nil, fmt.Errorf("gRPC调用失败: %v", err)
+		return nil, fmt.Errorf("gRPC调用失败: %v", err)
 	}
 
 	if resp.Code != 200 {
 		log.Printf("❌ API Gateway: 登录失败 - %s", resp.Message)
-		return "", nil, fmt.Errorf("登录失败: %s", resp.Message)
+		return nil, fmt.Errorf("登录失败: %s", resp.Message)
 	}
+	return resp, nil
+}
 
-	// 转换protobuf User为model.User
-	user := &model.User{
-		Username: resp.User.Username,
-		Nickname: resp.User.Nickname,
-		Avatar:   resp.User.Avatar,
+// parseLoginLocked 从 gRPC status details 中解析登录限制，不是登录限制错误时返回 nil
+func parseLoginLocked(err error) *LoginLockedError {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.ResourceExhausted {
+		return nil
 	}
-	user.ID = uint(resp.User.Id)
+	for _, detail := range st.Details() {
+		info, ok := detail.(*errdetails.ErrorInfo)
+		if !ok || info.Domain != model.LoginLockDomain {
+			continue
+		}
+		lockedUntil, _ := time.Parse(time.RFC3339, info.Metadata[model.LoginLockedUntilKey])
+		return &LoginLockedError{
+			Reason:        info.Reason,
+			AccountLocked: info.Reason == model.LoginReasonAccountLocked,
+			LockedUntil:   lockedUntil,
+			Message:       st.Message(),
+		}
+	}
+	return nil
+}
+
+// VerifyMFALogin 通过gRPC完成登录第二步，业务错误由调用方按 Code 处理
+func (s *UserGRPCClientService) VerifyMFALogin(mfaToken, code, clientIP, userAgent string) (*userpb.LoginResponse, error) {
+	resp, err := s.client.VerifyMFALogin(context.Background(), &userpb.VerifyMFALoginRequest{
+		MfaToken:  mfaToken,
+		Code:      code,
+		ClientIp:  clientIP,
+		UserAgent: userAgent,
+	})
+	if err != nil {
+		log.Printf("❌ API Gateway: gRPC调用失败 - %v", err)
+		return nil, fmt.Errorf("gRPC调用失败: %v", err)
+	}
+	return resp, nil
+}
+
+// GetMFAStatus 通过gRPC获取两步验证状态
+func (s *UserGRPCClientService) GetMFAStatus(userID uint) (*userpb.GetMFAStatusResponse, error) {
+	resp, err := s.client.GetMFAStatus(context.Background(), &userpb.GetMFAStatusRequest{
+		UserId: uint32(userID),
+	})
+	if err != nil {
+		log.Printf("❌ API Gateway: gRPC调用失败 - %v", err)
+		return nil, fmt.Errorf("gRPC调用失败: %v", err)
+	}
+	return resp, nil
+}
+
+// BeginTOTPSetup 通过gRPC开始绑定身份验证器，已登录时传 userID，登录中被要求绑定时传 mfaToken
+func (s *UserGRPCClientService) BeginTOTPSetup(userID uint, mfaToken string) (*userpb.BeginTOTPSetupResponse, error) {
+	resp, err := s.client.BeginTOTPSetup(context.Background(), &userpb.BeginTOTPSetupRequest{
+		UserId:   uint32(userID),
+		MfaToken: mfaToken,
+	})
+	if err != nil {
+		log.Printf("❌ API Gateway: gRPC调用失败 - %v", err)
+		return nil, fmt.Errorf("gRPC调用失败: %v", err)
+	}
+	return resp, nil
+}
+
+// EnableTOTP 通过gRPC确认绑定并启用两步验证
+func (s *UserGRPCClientService) EnableTOTP(userID uint, mfaToken, code, clientIP, userAgent string) (*userpb.EnableTOTPResponse, error) {
+	resp, err := s.client.EnableTOTP(context.Background(), &userpb.EnableTOTPRequest{
+		UserId:    uint32(userID),
+		MfaToken:  mfaToken,
+		Code:      code,
+		ClientIp:  clientIP,
+		UserAgent: userAgent,
+	})
+	if err != nil {
+		log.Printf("❌ API Gateway: gRPC调用失败 - %v", err)
+		return nil, fmt.Errorf("gRPC调用失败: %v", err)
+	}
+	return resp, nil
+}
 
-	log.Printf("✅ API Gateway: 登录成功 - 用户ID: %d, Token长度: %d", user.ID, len(resp.Token))
-	return resp.Token, user, nil
+// DisableTOTP 通过gRPC关闭两步验证
+func (s *UserGRPCClientService) DisableTOTP(userID uint, password, code string) (*userpb.DisableTOTPResponse, error) {
+	resp, err := s.client.DisableTOTP(context.Background(), &userpb.DisableTOTPRequest{
+		UserId:   uint32(userID),
+		Password: password,
+		Code:     code,
+	})
+	if err != nil {
+		log.Printf("❌ API Gateway: gRPC调用失败 - %v", err)
+		return nil, fmt.Errorf("gRPC调用失败: %v", err)
+	}
+	return resp, nil
+}
+
+// RegenerateRecoveryCodes 通过gRPC重新生成恢复码
+func (s *UserGRPCClientService) RegenerateRecoveryCodes(userID uint, code string) (*userpb.RecoveryCodesResponse, error) {
+	resp, err := s.client.RegenerateRecoveryCodes(context.Background(), &userpb.RegenerateRecoveryCodesRequest{
+		UserId: uint32(userID),
+		Code:   code,
+	})
+	if err != nil {
+		log.Printf("❌ API Gateway: gRPC调用失败 - %v", err)
+		return nil, fmt.Errorf("gRPC调用失败: %v", err)
+	}
+	return resp, nil
+}
+
+// ListMFAPolicies 通过gRPC获取两步验证策略
+func (s *UserGRPCClientService) ListMFAPolicies(adminID uint) (*userpb.MFAPoliciesResponse, error) {
+	resp, err := s.client.ListMFAPolicies(context.Background(), &userpb.ListMFAPoliciesRequest{
+		AdminId: uint32(adminID),
+	})
+	if err != nil {
+		log.Printf("❌ API Gateway: gRPC调用失败 - %v", err)
+		return nil, fmt.Errorf("gRPC调用失败: %v", err)
+	}
+	return resp, nil
+}
+
+// SetMFAPolicy 通过gRPC设置角色的两步验证策略
+func (s *UserGRPCClientService) SetMFAPolicy(adminID uint, role string, required bool) (*userpb.MFAPoliciesResponse, error) {
+	resp, err := s.client.SetMFAPolicy(context.Background(), &userpb.SetMFAPolicyRequest{
+		AdminId:  uint32(adminID),
+		Role:     role,
+		Required: required,
+	})
+	if err != nil {
+		log.Printf("❌ API Gateway: gRPC调用失败 - %v", err)
+		return nil, fmt.Errorf("gRPC调用失败: %v", err)
+	}
+	return resp, nil
+}
+
+// LoginWithOIDC 通过gRPC完成单点登录，业务错误由调用方按 Code 处理
+func (s *UserGRPCClientService) LoginWithOIDC(profile *userpb.OIDCProfile, clientIP, userAgent string) (*userpb.LoginResponse, error) {
+	resp, err := s.client.LoginWithOIDC(context.Background(), &userpb.OIDCLoginRequest{
+		Profile:   profile,
+		ClientIp:  clientIP,
+		UserAgent: userAgent,
+	})
+	if err != nil {
+		log.Printf("❌ API Gateway: gRPC调用失败 - %v", err)
+		return nil, fmt.Errorf("gRPC调用失败: %v", err)
+	}
+	return resp, nil
+}
+
+// LinkOIDCIdentity 通过gRPC绑定单点登录身份
+func (s *UserGRPCClientService) LinkOIDCIdentity(userID uint, profile *userpb.OIDCProfile) (*userpb.OIDCIdentitiesResponse, error) {
+	resp, err := s.client.LinkOIDCIdentity(context.Background(), &userpb.LinkOIDCIdentityRequest{
+		UserId:  uint32(userID),
+		Profile: profile,
+	})
+	if err != nil {
+		log.Printf("❌ API Gateway: gRPC调用失败 - %v", err)
+		return nil, fmt.Errorf("gRPC调用失败: %v", err)
+	}
+	return resp, nil
+}
+
+// ListOIDCIdentities 通过gRPC获取已绑定的单点登录身份
+func (s *UserGRPCClientService) ListOIDCIdentities(userID uint) (*userpb.OIDCIdentitiesResponse, error) {
+	resp, err := s.client.ListOIDCIdentities(context.Background(), &userpb.ListOIDCIdentitiesRequest{
+		UserId: uint32(userID),
+	})
+	if err != nil {
+		log.Printf("❌ API Gateway: gRPC调用失败 - %v", err)
+		return nil, fmt.Errorf("gRPC调用失败: %v", err)
+	}
+	return resp, nil
+}
+
+// UnlinkOIDCIdentity 通过gRPC解除单点登录绑定
+func (s *UserGRPCClientService) UnlinkOIDCIdentity(userID uint, provider string) (*userpb.OIDCIdentitiesResponse, error) {
+	resp, err := s.client.UnlinkOIDCIdentity(context.Background(), &userpb.UnlinkOIDCIdentityRequest{
+		UserId:   uint32(userID),
+		Provider: provider,
+	})
+	if err != nil {
+		log.Printf("❌ API Gateway: gRPC调用失败 - %v", err)
+		return nil, fmt.Errorf("gRPC调用失败: %v", err)
+	}
+	return resp, nil
+}
+
+// ListSessions 通过gRPC获取登录会话，currentSessionID 用于标记当前设备
+func (s *UserGRPCClientService) ListSessions(userID uint, currentSessionID string) (*userpb.SessionsResponse, error) {
+	resp, err := s.client.ListSessions(context.Background(), &userpb.ListSessionsRequest{
+		UserId:           uint32(userID),
+		CurrentSessionId: currentSessionID,
+	})
+	if err != nil {
+		log.Printf("❌ API Gateway: gRPC调用失败 - %v", err)
+		return nil, fmt.Errorf("gRPC调用失败: %v", err)
+	}
+	return resp, nil
+}
+
+// RevokeSession 通过gRPC注销指定登录会话
+func (s *UserGRPCClientService) RevokeSession(userID, id uint, currentSessionID string) (*userpb.SessionsResponse, error) {
+	resp, err := s.client.RevokeSession(context.Background(), &userpb.RevokeSessionRequest{
+		UserId:           uint32(userID),
+		Id:               uint32(id),
+		CurrentSessionId: currentSessionID,
+	})
+	if err != nil {
+		log.Printf("❌ API Gateway: gRPC调用失败 - %v", err)
+		return nil, fmt.Errorf("gRPC调用失败: %v", err)
+	}
+	return resp, nil
+}
+
+// RevokeOtherSessions 通过gRPC注销除当前会话外的全部会话
+func (s *UserGRPCClientService) RevokeOtherSessions(userID uint, currentSessionID string) (*userpb.SessionsResponse, error) {
+	resp, err := s.client.RevokeOtherSessions(context.Background(), &userpb.RevokeOtherSessionsRequest{
+		UserId:           uint32(userID),
+		CurrentSessionId: currentSessionID,
+	})
+	if err != nil {
+		log.Printf("❌ API Gateway: gRPC调用失败 - %v", err)
+		return nil, fmt.Errorf("gRPC调用失败: %v", err)
+	}
+	return resp, nil
+}
+
+// CreateAPIToken 通过gRPC创建个人访问令牌，业务错误由调用方按 Code 处理
+func (s *UserGRPCClientService) CreateAPIToken(userID uint, name string, scopes []string, expiresInDays int) (*userpb.CreateAPITokenResponse, error) {
+	resp, err := s.client.CreateAPIToken(context.Background(), &userpb.CreateAPITokenRequest{
+		UserId:        uint32(userID),
+		Name:          name,
+		Scopes:        scopes,
+		ExpiresInDays: int32(expiresInDays),
+	})
+	if err != nil {
+		log.Printf("❌ API Gateway: gRPC调用失败 - %v", err)
+		return nil, fmt.Errorf("gRPC调用失败: %v", err)
+	}
+	return resp, nil
+}
+
+// ListAPITokens 通过gRPC获取个人访问令牌
+func (s *UserGRPCClientService) ListAPITokens(userID uint) (*userpb.APITokensResponse, error) {
+	resp, err := s.client.ListAPITokens(context.Background(), &userpb.ListAPITokensRequest{
+		UserId: uint32(userID),
+	})
+	if err != nil {
+		log.Printf("❌ API Gateway: gRPC调用失败 - %v", err)
+		return nil, fmt.Errorf("gRPC调用失败: %v", err)
+	}
+	return resp, nil
+}
+
+// RevokeAPIToken 通过gRPC吊销个人访问令牌
+func (s *UserGRPCClientService) RevokeAPIToken(userID, id uint) (*userpb.APITokensResponse, error) {
+	resp, err := s.client.RevokeAPIToken(context.Background(), &userpb.RevokeAPITokenRequest{
+		UserId: uint32(userID),
+		Id:     uint32(id),
+	})
+	if err != nil {
+		log.Printf("❌ API Gateway: gRPC调用失败 - %v", err)
+		return nil, fmt.Errorf("gRPC调用失败: %v", err)
+	}
+	return resp, nil
+}
+
+// EraseAccount 通过gRPC清除账号，由账号注销任务调用
+func (s *UserGRPCClientService) EraseAccount(userID uint) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	resp, err := s.client.EraseAccount(ctx, &userpb.EraseAccountRequest{
+		UserId: uint32(userID),
+	})
+	if err != nil {
+		log.Printf("❌ gRPC调用清除账号失败 - %v", err)
+		return fmt.Errorf("gRPC调用失败: %v", err)
+	}
+	if resp.Code != 200 {
+		return fmt.Errorf("清除账号失败: %s", resp.Message)
+	}
+	return nil
 }
 
 // GetUserByUsername 通过gRPC获取用户信息
@@ -219,3 +515,60 @@ func (s *UserGRPCClientService) ChangePassword(userID uint, currentPassword, new
 	log.Printf("✅ API Gateway: 修改密码成功 (临时实现) - 用户ID: %d", userID)
 	return nil
 }
+
+// VerifyEmail 通过gRPC验证邮箱，业务错误由调用方按 Code 处理
+func (s *UserGRPCClientService) VerifyEmail(token string) (*userpb.VerifyEmailResponse, error) {
+	log.Printf("🌐 API Gateway: 通过gRPC验证邮箱")
+
+	resp, err := s.client.VerifyEmail(context.Background(), &userpb.VerifyEmailRequest{
+		Token: token,
+	})
+	if err != nil {
+		log.Printf("❌ API Gateway: gRPC调用失败 - %v", err)
+		return nil, fmt.Errorf("gRPC调用失败: %v", err)
+	}
+	return resp, nil
+}
+
+// ResendVerification 通过gRPC重新发送验证邮件，业务错误由调用方按 Code 处理
+func (s *UserGRPCClientService) ResendVerification(userID uint) (*userpb.ResendVerificationResponse, error) {
+	log.Printf("🌐 API Gateway: 通过gRPC重新发送验证邮件 - 用户ID: %d", userID)
+
+	resp, err := s.client.ResendVerification(context.Background(), &userpb.ResendVerificationRequest{
+		UserId: uint32(userID),
+	})
+	if err != nil {
+		log.Printf("❌ API Gateway: gRPC调用失败 - %v", err)
+		return nil, fmt.Errorf("gRPC调用失败: %v", err)
+	}
+	return resp, nil
+}
+
+// ForgotPassword 通过gRPC申请重置密码
+func (s *UserGRPCClientService) ForgotPassword(email string) (*userpb.ForgotPasswordResponse, error) {
+	log.Printf("🌐 API Gateway: 通过gRPC申请重置密码")
+
+	resp, err := s.client.ForgotPassword(context.Background(), &userpb.ForgotPasswordRequest{
+		Email: email,
+	})
+	if err != nil {
+		log.Printf("❌ API Gateway: gRPC调用失败 - %v", err)
+		return nil, fmt.Errorf("gRPC调用失败: %v", err)
+	}
+	return resp, nil
+}
+
+// ResetPassword 通过gRPC重置密码，业务错误由调用方按 Code 处理
+func (s *UserGRPCClientService) ResetPassword(token, newPassword string) (*userpb.ResetPasswordResponse, error) {
+	log.Printf("🌐 API Gateway: 通过gRPC重置密码")
+
+	resp, err := s.client.ResetPassword(context.Background(), &userpb.ResetPasswordRequest{
+		Token:       token,
+		NewPassword: newPassword,
+	})
+	if err != nil {
+		log.Printf("❌ API Gateway: gRPC调用失败 - %v", err)
+		return nil, fmt.Errorf("gRPC调用失败: %v", err)
+	}
+	return resp, nil
+}
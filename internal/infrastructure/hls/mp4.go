@@ -0,0 +1,558 @@
+package hls
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// 错误定义
+var (
+	ErrInvalidMP4       = errors.New("无效的MP4文件")
+	ErrNoVideoTrack     = errors.New("MP4文件中没有可用的视频轨道")
+	ErrUnsupportedCodec = errors.New("仅支持H.264视频编码")
+)
+
+// maxSamples 单个轨道的采样数上限（30fps视频约19小时），防止伪造的采样表导致超大内存分配
+const maxSamples = 1 << 21
+
+// sample 单个媒体采样（一帧视频或一帧音频）
+type sample struct {
+	offset   int64 // 在文件中的偏移
+	size     int   // 采样大小
+	dts      int64 // 解码时间戳（轨道时间刻度）
+	cts      int64 // 合成时间偏移（轨道时间刻度）
+	duration int64 // 采样时长（轨道时间刻度）
+	keyframe bool  // 是否为关键帧
+}
+
+// track 解析后的轨道信息
+type track struct {
+	kind      string // vide / soun
+	codec     string // avc1 / avc3 / mp4a
+	timescale uint32
+	samples   []sample
+
+	// H.264 参数
+	nalLengthSize int
+	sps           [][]byte
+	pps           [][]byte
+
+	// AAC 参数
+	audioObjectType int
+	sampleRateIndex int
+	channelConfig   int
+}
+
+// movie 解析后的MP4结构
+type movie struct {
+	video *track
+	audio *track
+	shift int64 // 时间轴平移量（90kHz）
+}
+
+// box MP4盒子
+type box struct {
+	typ     string
+	payload []byte
+}
+
+// readBoxes 读取连续的盒子列表
+func readBoxes(data []byte) ([]box, error) {
+	var boxes []box
+	for len(data) > 0 {
+		if len(data) < 8 {
+			return nil, ErrInvalidMP4
+		}
+		size := uint64(binary.BigEndian.Uint32(data[0:4]))
+		typ := string(data[4:8])
+		header := uint64(8)
+		switch size {
+		case 0:
+			size = uint64(len(data))
+		case 1:
+			if len(data) < 16 {
+				return nil, ErrInvalidMP4
+			}
+			size = binary.BigEndian.Uint64(data[8:16])
+			header = 16
+		}
+		if size < header || size > uint64(len(data)) {
+			return nil, ErrInvalidMP4
+		}
+		boxes = append(boxes, box{typ: typ, payload: data[header:size]})
+		data = data[size:]
+	}
+	return boxes, nil
+}
+
+// findBox 在盒子列表中查找指定类型的第一个盒子
+func findBox(boxes []box, typ string) *box {
+	for i := range boxes {
+		if boxes[i].typ == typ {
+			return &boxes[i]
+		}
+	}
+	return nil
+}
+
+// findPath 按路径查找嵌套盒子，例如 "mdia/minf/stbl"
+func findPath(data []byte, path ...string) (*box, error) {
+	var current *box
+	payload := data
+	for _, typ := range path {
+		children, err := readBoxes(payload)
+		if err != nil {
+			return nil, err
+		}
+		current = findBox(children, typ)
+		if current == nil {
+			return nil, nil
+		}
+		payload = current.payload
+	}
+	return current, nil
+}
+
+// parseMP4 解析MP4文件，提取H.264视频轨道和AAC音频轨道
+func parseMP4(data []byte) (*movie, error) {
+	top, err := readBoxes(data)
+	if err != nil {
+		return nil, err
+	}
+	moov := findBox(top, "moov")
+	if moov == nil {
+		return nil, fmt.Errorf("%w: 缺少moov盒子", ErrInvalidMP4)
+	}
+
+	children, err := readBoxes(moov.payload)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &movie{}
+	for _, child := range children {
+		if child.typ != "trak" {
+			continue
+		}
+		t, err := parseTrack(child.payload, len(data))
+		if err != nil {
+			return nil, err
+		}
+		if t == nil {
+			continue
+		}
+		switch {
+		case t.kind == "vide" && m.video == nil:
+			m.video = t
+		case t.kind == "soun" && m.audio == nil && t.codec == "mp4a":
+			m.audio = t
+		}
+	}
+
+	if m.video == nil {
+		return nil, ErrNoVideoTrack
+	}
+	if m.video.codec != "avc1" && m.video.codec != "avc3" {
+		return nil, ErrUnsupportedCodec
+	}
+	return m, nil
+}
+
+// parseTrack 解析单个trak盒子，不支持的轨道返回nil；fileSize 用于校验采样表声明的数据量
+func parseTrack(trak []byte, fileSize int) (*track, error) {
+	hdlr, err := findPath(trak, "mdia", "hdlr")
+	if err != nil || hdlr == nil || len(hdlr.payload) < 12 {
+		return nil, err
+	}
+	kind := string(hdlr.payload[8:12])
+	if kind != "vide" && kind != "soun" {
+		return nil, nil
+	}
+
+	mdhd, err := findPath(trak, "mdia", "mdhd")
+	if err != nil || mdhd == nil || len(mdhd.payload) < 24 {
+		return nil, fmt.Errorf("%w: 缺少mdhd盒子", ErrInvalidMP4)
+	}
+	t := &track{kind: kind}
+	if mdhd.payload[0] == 1 {
+		if len(mdhd.payload) < 32 {
+			return nil, ErrInvalidMP4
+		}
+		t.timescale = binary.BigEndian.Uint32(mdhd.payload[20:24])
+	} else {
+		t.timescale = binary.BigEndian.Uint32(mdhd.payload[12:16])
+	}
+	if t.timescale == 0 {
+		return nil, fmt.Errorf("%w: 时间刻度为0", ErrInvalidMP4)
+	}
+
+	stbl, err := findPath(trak, "mdia", "minf", "stbl")
+	if err != nil || stbl == nil {
+		return nil, fmt.Errorf("%w: 缺少stbl盒子", ErrInvalidMP4)
+	}
+	tables, err := readBoxes(stbl.payload)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := t.parseSampleDescription(findBox(tables, "stsd")); err != nil {
+		return nil, err
+	}
+	if t.codec == "" {
+		return t, nil
+	}
+	if err := t.buildSamples(tables, fileSize); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// parseSampleDescription 解析stsd盒子中的编码参数
+func (t *track) parseSampleDescription(stsd *box) error {
+	if stsd == nil || len(stsd.payload) < 8 {
+		return fmt.Errorf("%w: 缺少stsd盒子", ErrInvalidMP4)
+	}
+	entries, err := readBoxes(stsd.payload[8:])
+	if err != nil || len(entries) == 0 {
+		return fmt.Errorf("%w: stsd盒子为空", ErrInvalidMP4)
+	}
+	entry := entries[0]
+
+	switch entry.typ {
+	case "avc1", "avc3":
+		// VisualSampleEntry 固定头部长度为78字节
+		if len(entry.payload) < 78 {
+			return ErrInvalidMP4
+		}
+		children, err := readBoxes(entry.payload[78:])
+		if err != nil {
+			return err
+		}
+		avcC := findBox(children, "avcC")
+		if avcC == nil {
+			return fmt.Errorf("%w: 缺少avcC盒子", ErrInvalidMP4)
+		}
+		if err := t.parseAVCC(avcC.payload); err != nil {
+			return err
+		}
+		t.codec = entry.typ
+	case "mp4a":
+		// AudioSampleEntry 固定头部长度为28字节，QuickTime v1/v2有额外字段
+		if len(entry.payload) < 28 {
+			return ErrInvalidMP4
+		}
+		headerLen := 28
+		switch binary.BigEndian.Uint16(entry.payload[8:10]) {
+		case 1:
+			headerLen += 16
+		case 2:
+			headerLen += 36
+		}
+		if len(entry.payload) < headerLen {
+			return ErrInvalidMP4
+		}
+		children, err := readBoxes(entry.payload[headerLen:])
+		if err != nil {
+			return err
+		}
+		esds := findBox(children, "esds")
+		if esds == nil {
+			return nil // 无法识别的音频，忽略该轨道
+		}
+		if err := t.parseESDS(esds.payload); err != nil {
+			return nil
+		}
+		t.codec = entry.typ
+	default:
+		if t.kind == "vide" {
+			t.codec = entry.typ
+		}
+	}
+	return nil
+}
+
+// parseAVCC 解析H.264解码配置记录
+func (t *track) parseAVCC(p []byte) error {
+	if len(p) < 7 {
+		return fmt.Errorf("%w: avcC过短", ErrInvalidMP4)
+	}
+	t.nalLengthSize = int(p[4]&0x03) + 1
+	numSPS := int(p[5] & 0x1f)
+	pos := 6
+	for i := 0; i < numSPS; i++ {
+		if pos+2 > len(p) {
+			return ErrInvalidMP4
+		}
+		n := int(binary.BigEndian.Uint16(p[pos:]))
+		pos += 2
+		if pos+n > len(p) {
+			return ErrInvalidMP4
+		}
+		t.sps = append(t.sps, p[pos:pos+n])
+		pos += n
+	}
+	if pos >= len(p) {
+		return ErrInvalidMP4
+	}
+	numPPS := int(p[pos])
+	pos++
+	for i := 0; i < numPPS; i++ {
+		if pos+2 > len(p) {
+			return ErrInvalidMP4
+		}
+		n := int(binary.BigEndian.Uint16(p[pos:]))
+		pos += 2
+		if pos+n > len(p) {
+			return ErrInvalidMP4
+		}
+		t.pps = append(t.pps, p[pos:pos+n])
+		pos += n
+	}
+	return nil
+}
+
+// parseESDS 解析AAC的AudioSpecificConfig
+func (t *track) parseESDS(p []byte) error {
+	if len(p) < 4 {
+		return ErrInvalidMP4
+	}
+	p = p[4:] // version + flags
+
+	readDescriptor := func(p []byte) (byte, []byte, []byte, error) {
+		if len(p) < 2 {
+			return 0, nil, nil, ErrInvalidMP4
+		}
+		tag := p[0]
+		size := 0
+		i := 1
+		for ; i < 5 && i < len(p); i++ {
+			size = size<<7 | int(p[i]&0x7f)
+			if p[i]&0x80 == 0 {
+				i++
+				break
+			}
+		}
+		if i+size > len(p) {
+			return 0, nil, nil, ErrInvalidMP4
+		}
+		return tag, p[i : i+size], p[i+size:], nil
+	}
+
+	tag, body, _, err := readDescriptor(p)
+	if err != nil || tag != 0x03 || len(body) < 3 {
+		return ErrInvalidMP4
+	}
+	flags := body[2]
+	body = body[3:]
+	if flags&0x80 != 0 {
+		if len(body) < 2 {
+			return ErrInvalidMP4
+		}
+		body = body[2:]
+	}
+	if flags&0x40 != 0 {
+		if len(body) < 1 || len(body) < int(body[0])+1 {
+			return ErrInvalidMP4
+		}
+		body = body[int(body[0])+1:]
+	}
+	if flags&0x20 != 0 {
+		if len(body) < 2 {
+			return ErrInvalidMP4
+		}
+		body = body[2:]
+	}
+
+	tag, body, _, err = readDescriptor(body)
+	if err != nil || tag != 0x04 || len(body) < 13 {
+		return ErrInvalidMP4
+	}
+	tag, asc, _, err := readDescriptor(body[13:])
+	if err != nil || tag != 0x05 || len(asc) < 2 {
+		return ErrInvalidMP4
+	}
+
+	t.audioObjectType = int(asc[0] >> 3)
+	t.sampleRateIndex = int(asc[0]&0x07)<<1 | int(asc[1]>>7)
+	t.channelConfig = int(asc[1]>>3) & 0x0f
+	if t.audioObjectType == 0 || t.audioObjectType > 4 || t.sampleRateIndex > 12 {
+		return ErrUnsupportedCodec // ADTS仅支持AAC Main/LC/SSR/LTP
+	}
+	return nil
+}
+
+// buildSamples 根据采样表计算每个采样的位置与时间戳
+func (t *track) buildSamples(tables []box, fileSize int) error {
+	sizes, err := readSampleSizes(findBox(tables, "stsz"), fileSize)
+	if err != nil {
+		return err
+	}
+	count := len(sizes)
+	t.samples = make([]sample, count)
+	for i := range t.samples {
+		t.samples[i].size = sizes[i]
+	}
+
+	// 采样偏移：stsc + stco/co64
+	chunkOffsets, err := readChunkOffsets(tables)
+	if err != nil {
+		return err
+	}
+	stsc := findBox(tables, "stsc")
+	if stsc == nil || len(stsc.payload) < 8 {
+		return fmt.Errorf("%w: 缺少stsc盒子", ErrInvalidMP4)
+	}
+	entryCount := int(binary.BigEndian.Uint32(stsc.payload[4:8]))
+	if len(stsc.payload) < 8+entryCount*12 {
+		return ErrInvalidMP4
+	}
+	idx := 0
+	for e := 0; e < entryCount && idx < count; e++ {
+		entry := stsc.payload[8+e*12:]
+		firstChunk := int(binary.BigEndian.Uint32(entry[0:4]))
+		perChunk := int(binary.BigEndian.Uint32(entry[4:8]))
+		lastChunk := len(chunkOffsets)
+		if e+1 < entryCount {
+			lastChunk = int(binary.BigEndian.Uint32(stsc.payload[8+(e+1)*12:])) - 1
+		}
+		for chunk := firstChunk; chunk <= lastChunk && idx < count; chunk++ {
+			if chunk < 1 || chunk > len(chunkOffsets) {
+				return fmt.Errorf("%w: chunk索引越界", ErrInvalidMP4)
+			}
+			offset := chunkOffsets[chunk-1]
+			for s := 0; s < perChunk && idx < count; s++ {
+				t.samples[idx].offset = offset
+				offset += int64(t.samples[idx].size)
+				idx++
+			}
+		}
+	}
+	if idx != count {
+		return fmt.Errorf("%w: 采样表不完整", ErrInvalidMP4)
+	}
+
+	// 解码时间：stts
+	stts := findBox(tables, "stts")
+	if stts == nil || len(stts.payload) < 8 {
+		return fmt.Errorf("%w: 缺少stts盒子", ErrInvalidMP4)
+	}
+	entryCount = int(binary.BigEndian.Uint32(stts.payload[4:8]))
+	if len(stts.payload) < 8+entryCount*8 {
+		return ErrInvalidMP4
+	}
+	idx = 0
+	var dts int64
+	for e := 0; e < entryCount && idx < count; e++ {
+		n := int(binary.BigEndian.Uint32(stts.payload[8+e*8:]))
+		delta := int64(binary.BigEndian.Uint32(stts.payload[12+e*8:]))
+		for s := 0; s < n && idx < count; s++ {
+			t.samples[idx].dts = dts
+			t.samples[idx].duration = delta
+			dts += delta
+			idx++
+		}
+	}
+	if idx != count {
+		return fmt.Errorf("%w: 时间表与采样数不一致", ErrInvalidMP4)
+	}
+
+	// 合成时间偏移：ctts（可选）
+	if ctts := findBox(tables, "ctts"); ctts != nil && len(ctts.payload) >= 8 {
+		version := ctts.payload[0]
+		entryCount = int(binary.BigEndian.Uint32(ctts.payload[4:8]))
+		if len(ctts.payload) < 8+entryCount*8 {
+			return ErrInvalidMP4
+		}
+		idx = 0
+		for e := 0; e < entryCount && idx < count; e++ {
+			n := int(binary.BigEndian.Uint32(ctts.payload[8+e*8:]))
+			raw := binary.BigEndian.Uint32(ctts.payload[12+e*8:])
+			offset := int64(raw)
+			if version == 1 {
+				offset = int64(int32(raw))
+			}
+			for s := 0; s < n && idx < count; s++ {
+				t.samples[idx].cts = offset
+				idx++
+			}
+		}
+	}
+
+	// 关键帧：stss（缺失时所有采样都是关键帧）
+	if stss := findBox(tables, "stss"); stss != nil && len(stss.payload) >= 8 {
+		entryCount = int(binary.BigEndian.Uint32(stss.payload[4:8]))
+		if len(stss.payload) < 8+entryCount*4 {
+			return ErrInvalidMP4
+		}
+		for e := 0; e < entryCount; e++ {
+			n := int(binary.BigEndian.Uint32(stss.payload[8+e*4:]))
+			if n >= 1 && n <= count {
+				t.samples[n-1].keyframe = true
+			}
+		}
+	} else {
+		for i := range t.samples {
+			t.samples[i].keyframe = true
+		}
+	}
+
+	return nil
+}
+
+// readSampleSizes 读取stsz采样大小表，采样数和总数据量不能超过文件实际大小
+func readSampleSizes(stsz *box, fileSize int) ([]int, error) {
+	if stsz == nil || len(stsz.payload) < 12 {
+		return nil, fmt.Errorf("%w: 缺少stsz盒子", ErrInvalidMP4)
+	}
+	fixed := int(binary.BigEndian.Uint32(stsz.payload[4:8]))
+	count := int(binary.BigEndian.Uint32(stsz.payload[8:12]))
+	if count > maxSamples {
+		return nil, fmt.Errorf("%w: 采样数过多", ErrInvalidMP4)
+	}
+	if int64(fixed)*int64(count) > int64(fileSize) {
+		return nil, fmt.Errorf("%w: 采样数据超出文件大小", ErrInvalidMP4)
+	}
+	if fixed == 0 && len(stsz.payload) < 12+count*4 {
+		return nil, ErrInvalidMP4
+	}
+
+	sizes := make([]int, count)
+	if fixed != 0 {
+		for i := range sizes {
+			sizes[i] = fixed
+		}
+		return sizes, nil
+	}
+	for i := range sizes {
+		sizes[i] = int(binary.BigEndian.Uint32(stsz.payload[12+i*4:]))
+	}
+	return sizes, nil
+}
+
+// readChunkOffsets 读取stco或co64块偏移表
+func readChunkOffsets(tables []box) ([]int64, error) {
+	if stco := findBox(tables, "stco"); stco != nil && len(stco.payload) >= 8 {
+		count := int(binary.BigEndian.Uint32(stco.payload[4:8]))
+		if len(stco.payload) < 8+count*4 {
+			return nil, ErrInvalidMP4
+		}
+		offsets := make([]int64, count)
+		for i := range offsets {
+			offsets[i] = int64(binary.BigEndian.Uint32(stco.payload[8+i*4:]))
+		}
+		return offsets, nil
+	}
+	if co64 := findBox(tables, "co64"); co64 != nil && len(co64.payload) >= 8 {
+		count := int(binary.BigEndian.Uint32(co64.payload[4:8]))
+		if len(co64.payload) < 8+count*8 {
+			return nil, ErrInvalidMP4
+		}
+		offsets := make([]int64, count)
+		for i := range offsets {
+			offsets[i] = int64(binary.BigEndian.Uint64(co64.payload[8+i*8:]))
+		}
+		return offsets, nil
+	}
+	return nil, fmt.Errorf("%w: 缺少stco/co64盒子", ErrInvalidMP4)
+}
@@ -0,0 +1,366 @@
+// Package hls 将MP4中的H.264/AAC码流直接切分为HLS分片（不重新编码）
+package hls
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// 默认参数
+const (
+	DefaultSegmentDuration = 6 * time.Second
+	PlaylistName           = "index.m3u8"
+	KeySize                = 16
+)
+
+// Options 打包选项
+type Options struct {
+	SegmentDuration time.Duration // 目标分片时长
+	Key             []byte        // AES-128密钥，为空时不加密
+	KeyURI          string        // 播放列表中的密钥地址
+}
+
+// Result 打包结果
+type Result struct {
+	PlaylistPath string  // m3u8文件路径
+	Segments     int     // 分片数量
+	Duration     float64 // 总时长（秒）
+}
+
+// segment 单个分片的采样范围
+type segment struct {
+	videoStart, videoEnd int   // 视频采样区间 [start, end)
+	startDTS, endDTS     int64 // 视频时间刻度下的起止时间
+}
+
+// PackageMP4 读取MP4文件并在outDir中生成HLS播放列表与TS分片
+func PackageMP4(srcPath, outDir string, opts Options) (*Result, error) {
+	if opts.SegmentDuration <= 0 {
+		opts.SegmentDuration = DefaultSegmentDuration
+	}
+	if len(opts.Key) != 0 && len(opts.Key) != KeySize {
+		return nil, fmt.Errorf("AES-128密钥长度必须为%d字节", KeySize)
+	}
+
+	data, err := os.ReadFile(srcPath)
+	if err != nil {
+		return nil, fmt.Errorf("读取源文件失败: %w", err)
+	}
+	m, err := parseMP4(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(m.video.samples) == 0 {
+		return nil, fmt.Errorf("%w: 视频轨道没有采样", ErrInvalidMP4)
+	}
+
+	if err := os.MkdirAll(outDir, 0755); err != nil {
+		return nil, fmt.Errorf("创建HLS目录失败: %w", err)
+	}
+
+	// 负的合成时间偏移会导致PTS小于0，整体平移时间轴
+	for _, s := range m.video.samples {
+		if pts := to90k(s.dts+s.cts, m.video.timescale); pts < -m.shift {
+			m.shift = -pts
+		}
+	}
+
+	segments := splitSegments(m.video, opts.SegmentDuration)
+	var block cipher.Block
+	if len(opts.Key) > 0 {
+		if block, err = aes.NewCipher(opts.Key); err != nil {
+			return nil, fmt.Errorf("初始化AES失败: %w", err)
+		}
+	}
+
+	var playlist strings.Builder
+	var entries strings.Builder
+	maxDuration := 0.0
+	total := 0.0
+	audioIndex := 0
+
+	for i, seg := range segments {
+		ts, nextAudio, err := muxSegment(data, m, seg, audioIndex)
+		if err != nil {
+			return nil, err
+		}
+		audioIndex = nextAudio
+
+		if block != nil {
+			ts = encryptSegment(block, ts, uint64(i))
+		}
+
+		name := SegmentName(i)
+		if err := os.WriteFile(filepath.Join(outDir, name), ts, 0644); err != nil {
+			return nil, fmt.Errorf("写入分片失败: %w", err)
+		}
+
+		duration := float64(seg.endDTS-seg.startDTS) / float64(m.video.timescale)
+		maxDuration = math.Max(maxDuration, duration)
+		total += duration
+		fmt.Fprintf(&entries, "#EXTINF:%.3f,\n%s\n", duration, name)
+	}
+
+	playlist.WriteString("#EXTM3U\n")
+	playlist.WriteString("#EXT-X-VERSION:3\n")
+	fmt.Fprintf(&playlist, "#EXT-X-TARGETDURATION:%d\n", int(math.Ceil(maxDuration)))
+	playlist.WriteString("#EXT-X-MEDIA-SEQUENCE:0\n")
+	playlist.WriteString("#EXT-X-PLAYLIST-TYPE:VOD\n")
+	if block != nil {
+		// 未指定IV时，播放器使用分片序号作为IV
+		fmt.Fprintf(&playlist, "#EXT-X-KEY:METHOD=AES-128,URI=\"%s\"\n", opts.KeyURI)
+	}
+	playlist.WriteString(entries.String())
+	playlist.WriteString("#EXT-X-ENDLIST\n")
+
+	playlistPath := filepath.Join(outDir, PlaylistName)
+	if err := os.WriteFile(playlistPath, []byte(playlist.String()), 0644); err != nil {
+		return nil, fmt.Errorf("写入播放列表失败: %w", err)
+	}
+
+	return &Result{
+		PlaylistPath: playlistPath,
+		Segments:     len(segments),
+		Duration:     total,
+	}, nil
+}
+
+// OutputDir 根据源文件路径（或URL）得到HLS分片目录，与源文件同级
+func OutputDir(srcPath string) string {
+	return strings.TrimSuffix(srcPath, filepath.Ext(srcPath)) + "_hls"
+}
+
+// SegmentName 返回第i个TS分片的文件名
+func SegmentName(i int) string {
+	return fmt.Sprintf("segment_%05d.ts", i)
+}
+
+// IsOutputName 判断文件名是否为打包生成的播放列表或分片，用于校验外部请求的文件名
+func IsOutputName(name string) bool {
+	if name == PlaylistName {
+		return true
+	}
+	digits, ok := strings.CutPrefix(name, "segment_")
+	if !ok {
+		return false
+	}
+	digits, ok = strings.CutSuffix(digits, ".ts")
+	if !ok || len(digits) < 5 {
+		return false
+	}
+	for _, ch := range digits {
+		if ch < '0' || ch > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// splitSegments 按关键帧和目标时长切分视频采样
+func splitSegments(video *track, target time.Duration) []segment {
+	targetTicks := int64(target.Seconds() * float64(video.timescale))
+	samples := video.samples
+
+	var segments []segment
+	current := segment{videoStart: 0, startDTS: samples[0].dts}
+	for i := 1; i < len(samples); i++ {
+		if samples[i].keyframe && samples[i].dts-current.startDTS >= targetTicks {
+			current.videoEnd = i
+			current.endDTS = samples[i].dts
+			segments = append(segments, current)
+			current = segment{videoStart: i, startDTS: samples[i].dts}
+		}
+	}
+	last := samples[len(samples)-1]
+	current.videoEnd = len(samples)
+	current.endDTS = last.dts + last.duration
+	return append(segments, current)
+}
+
+// muxSegment 将一个分片内的音视频采样封装为TS，返回下一个未写入的音频采样索引
+func muxSegment(data []byte, m *movie, seg segment, audioIndex int) ([]byte, int, error) {
+	video := m.video
+	w := newTSWriter(m.audio != nil)
+	w.writeTables()
+
+	endSeconds := float64(seg.endDTS) / float64(video.timescale)
+	last := seg.videoEnd == len(video.samples)
+
+	// 音频帧按时间与视频帧交错写入
+	var audioBuf []byte
+	var audioPTS int64 = -1
+	flushAudio := func() {
+		if len(audioBuf) > 0 {
+			w.writePES(pidAudio, streamIDAudio, audioBuf, audioPTS, audioPTS, false, false)
+			audioBuf = nil
+			audioPTS = -1
+		}
+	}
+	writeAudioUntil := func(seconds float64) error {
+		if m.audio == nil {
+			return nil
+		}
+		audio := m.audio
+		for audioIndex < len(audio.samples) {
+			s := audio.samples[audioIndex]
+			at := float64(s.dts) / float64(audio.timescale)
+			if at >= seconds {
+				break
+			}
+			frame, err := readSample(data, s)
+			if err != nil {
+				return err
+			}
+			if audioPTS < 0 {
+				audioPTS = to90k(s.dts, audio.timescale) + m.shift
+			}
+			audioBuf = append(audioBuf, adtsHeader(audio, len(frame))...)
+			audioBuf = append(audioBuf, frame...)
+			audioIndex++
+			if len(audioBuf) >= 2048 {
+				flushAudio()
+			}
+		}
+		return nil
+	}
+
+	for i := seg.videoStart; i < seg.videoEnd; i++ {
+		s := video.samples[i]
+		at := float64(s.dts) / float64(video.timescale)
+		if err := writeAudioUntil(at); err != nil {
+			return nil, 0, err
+		}
+		flushAudio()
+
+		raw, err := readSample(data, s)
+		if err != nil {
+			return nil, 0, err
+		}
+		au, err := toAnnexB(video, raw, s.keyframe)
+		if err != nil {
+			return nil, 0, err
+		}
+		dts := to90k(s.dts, video.timescale) + m.shift
+		pts := to90k(s.dts+s.cts, video.timescale) + m.shift
+		w.writePES(pidVideo, streamIDVideo, au, pts, dts, i == seg.videoStart, s.keyframe)
+	}
+
+	// 写入剩余音频（最后一个分片写完全部音频）
+	limit := endSeconds
+	if last {
+		limit = math.Inf(1)
+	}
+	if err := writeAudioUntil(limit); err != nil {
+		return nil, 0, err
+	}
+	flushAudio()
+
+	return w.Bytes(), audioIndex, nil
+}
+
+// readSample 读取采样数据
+func readSample(data []byte, s sample) ([]byte, error) {
+	end := s.offset + int64(s.size)
+	if s.offset < 0 || end > int64(len(data)) {
+		return nil, fmt.Errorf("%w: 采样超出文件范围", ErrInvalidMP4)
+	}
+	return data[s.offset:end], nil
+}
+
+// toAnnexB 将长度前缀格式的NAL单元转换为起始码格式，关键帧前插入SPS/PPS
+func toAnnexB(video *track, raw []byte, keyframe bool) ([]byte, error) {
+	startCode := []byte{0x00, 0x00, 0x00, 0x01}
+	var out bytes.Buffer
+
+	// 访问单元分隔符
+	out.Write(startCode)
+	out.Write([]byte{0x09, 0xf0})
+
+	var nals [][]byte
+	hasParams := false
+	for len(raw) > 0 {
+		if len(raw) < video.nalLengthSize {
+			return nil, fmt.Errorf("%w: NAL长度字段不完整", ErrInvalidMP4)
+		}
+		var n int
+		for i := 0; i < video.nalLengthSize; i++ {
+			n = n<<8 | int(raw[i])
+		}
+		raw = raw[video.nalLengthSize:]
+		if n > len(raw) {
+			return nil, fmt.Errorf("%w: NAL长度越界", ErrInvalidMP4)
+		}
+		nal := raw[:n]
+		raw = raw[n:]
+		if len(nal) == 0 {
+			continue
+		}
+		switch nal[0] & 0x1f {
+		case 9: // 已有的访问单元分隔符
+			continue
+		case 7, 8:
+			hasParams = true
+		}
+		nals = append(nals, nal)
+	}
+
+	if keyframe && !hasParams {
+		for _, sps := range video.sps {
+			out.Write(startCode)
+			out.Write(sps)
+		}
+		for _, pps := range video.pps {
+			out.Write(startCode)
+			out.Write(pps)
+		}
+	}
+	for _, nal := range nals {
+		out.Write(startCode)
+		out.Write(nal)
+	}
+	return out.Bytes(), nil
+}
+
+// adtsHeader 生成AAC帧的ADTS头
+func adtsHeader(audio *track, frameLen int) []byte {
+	length := frameLen + 7
+	profile := audio.audioObjectType - 1
+	return []byte{
+		0xff,
+		0xf1, // MPEG-4, 无CRC
+		byte(profile<<6) | byte(audio.sampleRateIndex<<2) | byte(audio.channelConfig>>2),
+		byte(audio.channelConfig&0x03)<<6 | byte(length>>11),
+		byte(length >> 3),
+		byte(length&0x07)<<5 | 0x1f,
+		0xfc,
+	}
+}
+
+// to90k 将轨道时间刻度转换为90kHz
+func to90k(ts int64, timescale uint32) int64 {
+	return ts * 90000 / int64(timescale)
+}
+
+// encryptSegment 使用AES-128-CBC加密分片，IV为分片序号
+func encryptSegment(block cipher.Block, data []byte, sequence uint64) []byte {
+	iv := make([]byte, aes.BlockSize)
+	binary.BigEndian.PutUint64(iv[8:], sequence)
+
+	padding := aes.BlockSize - len(data)%aes.BlockSize
+	padded := make([]byte, len(data)+padding)
+	copy(padded, data)
+	for i := len(data); i < len(padded); i++ {
+		padded[i] = byte(padding)
+	}
+
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(padded, padded)
+	return padded
+}
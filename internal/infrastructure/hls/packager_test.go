@@ -0,0 +1,360 @@
+package hls
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/binary"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+var (
+	testSPS = []byte{0x67, 0x42, 0x00, 0x1e, 0xab, 0x40}
+	testPPS = []byte{0x68, 0xce, 0x38, 0x80}
+)
+
+// mp4Box 拼接一个MP4盒子
+func mp4Box(typ string, payloads ...[]byte) []byte {
+	body := bytes.Join(payloads, nil)
+	out := make([]byte, 8, 8+len(body))
+	binary.BigEndian.PutUint32(out, uint32(8+len(body)))
+	copy(out[4:], typ)
+	return append(out, body...)
+}
+
+// u32s 将整数序列编码为大端uint32
+func u32s(values ...int) []byte {
+	out := make([]byte, 4*len(values))
+	for i, v := range values {
+		binary.BigEndian.PutUint32(out[i*4:], uint32(v))
+	}
+	return out
+}
+
+// buildTestMP4 生成只有视频轨道的最小MP4：时间刻度1000，每帧1秒，keyframes 为关键帧序号（从1开始）
+func buildTestMP4(frames int, keyframes []int) []byte {
+	isKey := make(map[int]bool)
+	for _, k := range keyframes {
+		isKey[k] = true
+	}
+
+	var mdat, sizes []byte
+	for i := 1; i <= frames; i++ {
+		nal := []byte{0x41, byte(i), 0x00, 0x11}
+		if isKey[i] {
+			nal[0] = 0x65
+		}
+		sample := append(u32s(len(nal)), nal...)
+		mdat = append(mdat, sample...)
+		sizes = append(sizes, u32s(len(sample))...)
+	}
+
+	avcC := []byte{0x01, 0x42, 0x00, 0x1e, 0xff, 0xe1}
+	avcC = append(avcC, byte(len(testSPS)>>8), byte(len(testSPS)))
+	avcC = append(avcC, testSPS...)
+	avcC = append(avcC, 0x01, byte(len(testPPS)>>8), byte(len(testPPS)))
+	avcC = append(avcC, testPPS...)
+
+	stss := u32s(0, len(keyframes))
+	for _, k := range keyframes {
+		stss = append(stss, u32s(k)...)
+	}
+
+	moov := func(mdatOffset int) []byte {
+		stbl := mp4Box("stbl",
+			mp4Box("stsd", u32s(0, 1), mp4Box("avc1", make([]byte, 78), mp4Box("avcC", avcC))),
+			mp4Box("stts", u32s(0, 1, frames, 1000)),
+			mp4Box("stss", stss),
+			mp4Box("stsz", u32s(0, 0, frames), sizes),
+			mp4Box("stsc", u32s(0, 1, 1, frames, 1)),
+			mp4Box("stco", u32s(0, 1, mdatOffset)),
+		)
+		hdlr := append(u32s(0, 0), []byte("vide")...)
+		hdlr = append(hdlr, make([]byte, 13)...)
+		mdia := mp4Box("mdia",
+			mp4Box("mdhd", u32s(0, 0, 0, 1000, frames*1000), make([]byte, 4)),
+			mp4Box("hdlr", hdlr),
+			mp4Box("minf", stbl),
+		)
+		return mp4Box("moov", mp4Box("trak", mdia))
+	}
+
+	ftyp := mp4Box("ftyp", []byte("isom"), u32s(0), []byte("isom"))
+	offset := len(ftyp) + len(moov(0)) + 8
+	return bytes.Join([][]byte{ftyp, moov(offset), mp4Box("mdat", mdat)}, nil)
+}
+
+func writeTestMP4(t *testing.T, data []byte) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "lecture.mp4")
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestPackageMP4(t *testing.T) {
+	key := bytes.Repeat([]byte{0x2b}, KeySize)
+
+	tests := []struct {
+		name         string
+		frames       int
+		keyframes    []int
+		opts         Options
+		wantSegments int
+		wantExtinf   []string
+	}{
+		{
+			name:         "按关键帧和目标时长切分",
+			frames:       10,
+			keyframes:    []int{1, 4, 7, 10},
+			opts:         Options{SegmentDuration: 2 * time.Second},
+			wantSegments: 4,
+			wantExtinf:   []string{"3.000", "3.000", "3.000", "1.000"},
+		},
+		{
+			name:         "关键帧间隔大于目标时长",
+			frames:       6,
+			keyframes:    []int{1, 5},
+			opts:         Options{SegmentDuration: 2 * time.Second},
+			wantSegments: 2,
+			wantExtinf:   []string{"4.000", "2.000"},
+		},
+		{
+			name:         "默认时长下整段为一个分片",
+			frames:       5,
+			keyframes:    []int{1},
+			wantSegments: 1,
+			wantExtinf:   []string{"5.000"},
+		},
+		{
+			name:         "AES-128加密",
+			frames:       10,
+			keyframes:    []int{1, 4, 7, 10},
+			opts:         Options{SegmentDuration: 2 * time.Second, Key: key, KeyURI: "/api/v1/content/files/1/hls/key"},
+			wantSegments: 4,
+			wantExtinf:   []string{"3.000", "3.000", "3.000", "1.000"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src := writeTestMP4(t, buildTestMP4(tt.frames, tt.keyframes))
+			outDir := OutputDir(src)
+
+			result, err := PackageMP4(src, outDir, tt.opts)
+			if err != nil {
+				t.Fatalf("PackageMP4() error = %v", err)
+			}
+			if result.Segments != tt.wantSegments {
+				t.Errorf("Segments = %d, want %d", result.Segments, tt.wantSegments)
+			}
+			if result.Duration != float64(tt.frames) {
+				t.Errorf("Duration = %v, want %d", result.Duration, tt.frames)
+			}
+
+			playlist, err := os.ReadFile(result.PlaylistPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var extinf []string
+			for _, line := range strings.Split(string(playlist), "\n") {
+				if d, ok := strings.CutPrefix(line, "#EXTINF:"); ok {
+					extinf = append(extinf, strings.TrimSuffix(d, ","))
+				}
+			}
+			if strings.Join(extinf, " ") != strings.Join(tt.wantExtinf, " ") {
+				t.Errorf("EXTINF = %v, want %v", extinf, tt.wantExtinf)
+			}
+			if !strings.HasSuffix(string(playlist), "#EXT-X-ENDLIST\n") {
+				t.Error("播放列表缺少 #EXT-X-ENDLIST")
+			}
+			hasKey := strings.Contains(string(playlist), `#EXT-X-KEY:METHOD=AES-128,URI="`+tt.opts.KeyURI+`"`)
+			if hasKey != (len(tt.opts.Key) > 0) {
+				t.Errorf("播放列表密钥行存在 = %v, want %v", hasKey, len(tt.opts.Key) > 0)
+			}
+
+			for i := 0; i < tt.wantSegments; i++ {
+				ts, err := os.ReadFile(filepath.Join(outDir, SegmentName(i)))
+				if err != nil {
+					t.Fatalf("读取分片 %d 失败: %v", i, err)
+				}
+				if len(tt.opts.Key) > 0 {
+					ts = decryptSegment(t, tt.opts.Key, ts, uint64(i))
+				}
+				if len(ts) == 0 || len(ts)%188 != 0 {
+					t.Fatalf("分片 %d 长度 %d 不是TS包长度的整数倍", i, len(ts))
+				}
+				for p := 0; p < len(ts); p += 188 {
+					if ts[p] != 0x47 {
+						t.Fatalf("分片 %d 第 %d 个TS包缺少同步字节", i, p/188)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestPackageMP4Errors(t *testing.T) {
+	valid := buildTestMP4(3, []int{1})
+
+	tests := []struct {
+		name    string
+		data    []byte
+		opts    Options
+		wantErr error
+	}{
+		{"密钥长度错误", valid, Options{Key: []byte("short")}, nil},
+		{"不是MP4", []byte("not an mp4 file"), Options{}, ErrInvalidMP4},
+		{"缺少moov", mp4Box("ftyp", []byte("isom")), Options{}, ErrInvalidMP4},
+		{"没有视频轨道", mp4Box("moov", mp4Box("mvhd", make([]byte, 100))), Options{}, ErrNoVideoTrack},
+		{"伪造的固定采样数", patchBox(valid, "stsz", u32s(0, 4, 0xffffffff)), Options{}, ErrInvalidMP4},
+		{"固定采样数据超出文件", patchBox(valid, "stsz", u32s(0, 1<<20, 3)), Options{}, ErrInvalidMP4},
+		{"可变采样表长度不足", patchBox(valid, "stsz", u32s(0, 0, 1000)), Options{}, ErrInvalidMP4},
+		{"时间表少于采样数", patchBox(valid, "stts", u32s(0, 1, 2, 1000)), Options{}, ErrInvalidMP4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src := writeTestMP4(t, tt.data)
+			_, err := PackageMP4(src, OutputDir(src), tt.opts)
+			if err == nil {
+				t.Fatal("PackageMP4() error = nil, want error")
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("PackageMP4() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// patchBox 覆盖第一个指定类型盒子的负载开头，盒子大小不变
+func patchBox(data []byte, typ string, payload []byte) []byte {
+	out := append([]byte{}, data...)
+	i := bytes.Index(out, []byte(typ))
+	copy(out[i+4:], payload)
+	return out
+}
+
+// decryptSegment 按播放器的方式解密分片：IV为分片序号，去除PKCS#7填充
+func decryptSegment(t *testing.T, key, data []byte, sequence uint64) []byte {
+	t.Helper()
+	if len(data)%aes.BlockSize != 0 {
+		t.Fatalf("加密分片长度 %d 不是块大小的整数倍", len(data))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	iv := make([]byte, aes.BlockSize)
+	binary.BigEndian.PutUint64(iv[8:], sequence)
+	plain := make([]byte, len(data))
+	cipher.NewCBCDecrypter(block, iv).CryptBlocks(plain, data)
+
+	padding := int(plain[len(plain)-1])
+	if padding == 0 || padding > aes.BlockSize {
+		t.Fatalf("无效的填充长度 %d", padding)
+	}
+	return plain[:len(plain)-padding]
+}
+
+func TestToAnnexB(t *testing.T) {
+	video := &track{nalLengthSize: 4, sps: [][]byte{testSPS}, pps: [][]byte{testPPS}}
+	startCode := []byte{0x00, 0x00, 0x00, 0x01}
+	aud := append(append([]byte{}, startCode...), 0x09, 0xf0)
+	annexB := func(nals ...[]byte) []byte {
+		out := append([]byte{}, aud...)
+		for _, nal := range nals {
+			out = append(out, startCode...)
+			out = append(out, nal...)
+		}
+		return out
+	}
+	lengthPrefixed := func(nals ...[]byte) []byte {
+		var out []byte
+		for _, nal := range nals {
+			out = append(out, u32s(len(nal))...)
+			out = append(out, nal...)
+		}
+		return out
+	}
+
+	idr := []byte{0x65, 0x88, 0x84}
+	slice := []byte{0x41, 0x9a}
+
+	tests := []struct {
+		name     string
+		raw      []byte
+		keyframe bool
+		want     []byte
+		wantErr  bool
+	}{
+		{"关键帧补充SPS/PPS", lengthPrefixed(idr), true, annexB(testSPS, testPPS, idr), false},
+		{"非关键帧", lengthPrefixed(slice), false, annexB(slice), false},
+		{"已带参数集的关键帧", lengthPrefixed(testSPS, testPPS, idr), true, annexB(testSPS, testPPS, idr), false},
+		{"去除原有分隔符", lengthPrefixed([]byte{0x09, 0x10}, slice), false, annexB(slice), false},
+		{"长度字段不完整", []byte{0x00, 0x00}, false, nil, true},
+		{"NAL长度越界", append(u32s(10), slice...), false, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := toAnnexB(video, tt.raw, tt.keyframe)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("toAnnexB() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !bytes.Equal(got, tt.want) {
+				t.Errorf("toAnnexB() = % x, want % x", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOutputNames(t *testing.T) {
+	if got := OutputDir("/data/uploads/course_1/lecture.mp4"); got != "/data/uploads/course_1/lecture_hls" {
+		t.Errorf("OutputDir() = %s", got)
+	}
+	if got := OutputDir("/uploads/course_1/lecture"); got != "/uploads/course_1/lecture_hls" {
+		t.Errorf("OutputDir() 无扩展名 = %s", got)
+	}
+	if got := SegmentName(7); got != "segment_00007.ts" {
+		t.Errorf("SegmentName(7) = %s", got)
+	}
+	if got := SegmentName(123456); got != "segment_123456.ts" {
+		t.Errorf("SegmentName(123456) = %s", got)
+	}
+
+	tests := []struct {
+		name string
+		want bool
+	}{
+		{PlaylistName, true},
+		{SegmentName(0), true},
+		{SegmentName(123456), true},
+		{"segment_0001.ts", false},
+		{"segment_0000a.ts", false},
+		{"segment_00001.ts.bak", false},
+		{"segment_.ts", false},
+		{"../index.m3u8", false},
+		{"key.bin", false},
+		{"lecture.mp4", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		if got := IsOutputName(tt.name); got != tt.want {
+			t.Errorf("IsOutputName(%q) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestCRC32MPEG(t *testing.T) {
+	// CRC-32/MPEG-2 标准校验值
+	if got := crc32MPEG([]byte("123456789")); got != 0x0376e6e7 {
+		t.Errorf("crc32MPEG() = %#08x, want 0x0376e6e7", got)
+	}
+}
@@ -0,0 +1,217 @@
+package hls
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+// MPEG-TS 常量
+const (
+	tsPacketSize = 188
+	pidPAT       = 0x0000
+	pidPMT       = 0x1000
+	pidVideo     = 0x0100
+	pidAudio     = 0x0101
+
+	streamTypeH264 = 0x1b
+	streamTypeAAC  = 0x0f
+
+	streamIDVideo = 0xe0
+	streamIDAudio = 0xc0
+)
+
+// tsWriter MPEG-TS 封装器，将PES数据切分为188字节的TS包
+type tsWriter struct {
+	buf        bytes.Buffer
+	continuity map[uint16]byte
+	hasAudio   bool
+}
+
+// newTSWriter 创建TS封装器
+func newTSWriter(hasAudio bool) *tsWriter {
+	return &tsWriter{
+		continuity: make(map[uint16]byte),
+		hasAudio:   hasAudio,
+	}
+}
+
+// Bytes 返回已写入的TS数据
+func (w *tsWriter) Bytes() []byte {
+	return w.buf.Bytes()
+}
+
+// writeTables 写入PAT和PMT（每个分片开头都需要）
+func (w *tsWriter) writeTables() {
+	// PAT
+	pat := []byte{
+		0x00,       // table_id
+		0xb0, 0x00, // section_syntax_indicator + section_length（稍后填充）
+		0x00, 0x01, // transport_stream_id
+		0xc1,       // version 0, current_next 1
+		0x00, 0x00, // section_number, last_section_number
+		0x00, 0x01, // program_number 1
+		0xe0 | byte(pidPMT>>8), byte(pidPMT & 0xff),
+	}
+	w.writeSection(pidPAT, pat)
+
+	// PMT
+	pmt := []byte{
+		0x02,       // table_id
+		0xb0, 0x00, // section_length（稍后填充）
+		0x00, 0x01, // program_number
+		0xc1,       // version 0, current_next 1
+		0x00, 0x00, // section_number, last_section_number
+		0xe0 | byte(pidVideo>>8), byte(pidVideo & 0xff), // PCR_PID
+		0xf0, 0x00, // program_info_length
+		streamTypeH264, 0xe0 | byte(pidVideo>>8), byte(pidVideo & 0xff), 0xf0, 0x00,
+	}
+	if w.hasAudio {
+		pmt = append(pmt, streamTypeAAC, 0xe0|byte(pidAudio>>8), byte(pidAudio&0xff), 0xf0, 0x00)
+	}
+	w.writeSection(pidPMT, pmt)
+}
+
+// writeSection 写入一个PSI表（单包即可容纳）
+func (w *tsWriter) writeSection(pid uint16, section []byte) {
+	sectionLength := len(section) - 3 + 4 // 不含前3字节，包含CRC
+	section[1] = section[1]&0xf0 | byte(sectionLength>>8)&0x0f
+	section[2] = byte(sectionLength)
+	crc := crc32MPEG(section)
+	section = binary.BigEndian.AppendUint32(section, crc)
+
+	packet := make([]byte, tsPacketSize)
+	packet[0] = 0x47
+	packet[1] = 0x40 | byte(pid>>8)&0x1f
+	packet[2] = byte(pid)
+	packet[3] = 0x10 | w.nextContinuity(pid)
+	packet[4] = 0x00 // pointer_field
+	n := copy(packet[5:], section)
+	for i := 5 + n; i < tsPacketSize; i++ {
+		packet[i] = 0xff
+	}
+	w.buf.Write(packet)
+}
+
+// writePES 写入一个PES包，pts/dts 为90kHz时间戳
+func (w *tsWriter) writePES(pid uint16, streamID byte, payload []byte, pts, dts int64, withPCR, randomAccess bool) {
+	// PES 头
+	var header []byte
+	header = append(header, 0x00, 0x00, 0x01, streamID, 0x00, 0x00)
+	if pts != dts {
+		header = append(header, 0x80, 0xc0, 10)
+		header = appendTimestamp(header, 0x03, pts)
+		header = appendTimestamp(header, 0x01, dts)
+	} else {
+		header = append(header, 0x80, 0x80, 5)
+		header = appendTimestamp(header, 0x02, pts)
+	}
+	pesLength := len(header) - 6 + len(payload)
+	if pesLength <= 0xffff && streamID != streamIDVideo {
+		binary.BigEndian.PutUint16(header[4:6], uint16(pesLength))
+	}
+
+	data := append(header, payload...)
+	first := true
+	for len(data) > 0 {
+		packet := make([]byte, 0, tsPacketSize)
+		flags := byte(0x00)
+		if first {
+			flags = 0x40 // payload_unit_start_indicator
+		}
+		packet = append(packet, 0x47, flags|byte(pid>>8)&0x1f, byte(pid))
+
+		// 适配字段：首包携带PCR与随机访问标记，末包用于填充
+		var adaptation []byte
+		if first && (withPCR || randomAccess) {
+			afFlags := byte(0x00)
+			if randomAccess {
+				afFlags |= 0x40
+			}
+			adaptation = append(adaptation, afFlags)
+			if withPCR {
+				adaptation[0] |= 0x10
+				adaptation = appendPCR(adaptation, dts)
+			}
+		}
+
+		capacity := tsPacketSize - 4
+		if adaptation != nil {
+			capacity -= 1 + len(adaptation)
+		}
+		if len(data) < capacity {
+			// 数据不足一个包：通过适配字段填充
+			stuffing := capacity - len(data)
+			if adaptation == nil {
+				adaptation = []byte{}
+				stuffing-- // 适配字段长度字节
+				if stuffing > 0 {
+					adaptation = append(adaptation, 0x00) // 适配字段标志
+					stuffing--
+				}
+			}
+			for i := 0; i < stuffing; i++ {
+				adaptation = append(adaptation, 0xff)
+			}
+			capacity = len(data)
+		}
+
+		if adaptation != nil {
+			packet = append(packet, 0x30|w.nextContinuity(pid))
+			packet = append(packet, byte(len(adaptation)))
+			packet = append(packet, adaptation...)
+		} else {
+			packet = append(packet, 0x10|w.nextContinuity(pid))
+		}
+		packet = append(packet, data[:capacity]...)
+		data = data[capacity:]
+		first = false
+
+		w.buf.Write(packet)
+	}
+}
+
+// nextContinuity 获取并递增PID的连续计数器
+func (w *tsWriter) nextContinuity(pid uint16) byte {
+	cc := w.continuity[pid]
+	w.continuity[pid] = (cc + 1) & 0x0f
+	return cc
+}
+
+// appendTimestamp 按PES格式写入33位时间戳
+func appendTimestamp(b []byte, marker byte, ts int64) []byte {
+	return append(b,
+		marker<<4|byte(ts>>29)&0x0e|0x01,
+		byte(ts>>22),
+		byte(ts>>14)&0xfe|0x01,
+		byte(ts>>7),
+		byte(ts<<1)&0xfe|0x01,
+	)
+}
+
+// appendPCR 写入PCR字段（以90kHz为基准，扩展位为0）
+func appendPCR(b []byte, base int64) []byte {
+	return append(b,
+		byte(base>>25),
+		byte(base>>17),
+		byte(base>>9),
+		byte(base>>1),
+		byte(base<<7)&0x80|0x7e,
+		0x00,
+	)
+}
+
+// crc32MPEG 计算MPEG-2 PSI表使用的CRC32
+func crc32MPEG(data []byte) uint32 {
+	crc := uint32(0xffffffff)
+	for _, b := range data {
+		crc ^= uint32(b) << 24
+		for i := 0; i < 8; i++ {
+			if crc&0x80000000 != 0 {
+				crc = crc<<1 ^ 0x04c11db7
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
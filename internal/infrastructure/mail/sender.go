@@ -0,0 +1,176 @@
+// Package mail 邮件发送，SMTPSender 对接任意 SMTP 服务（本地开发可使用 MailHog），未配置 SMTP 时使用 LogSender
+package mail
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SMTP 连接和整体发送的超时时间
+const (
+	dialTimeout = 10 * time.Second
+	sendTimeout = 30 * time.Second
+)
+
+// Message 待发送的邮件
+type Message struct {
+	To       string
+	ToName   string
+	Subject  string
+	HTMLBody string
+	// UnsubscribeURL 一键退订地址，非空时写入 List-Unsubscribe 头（RFC 8058）
+	UnsubscribeURL string
+}
+
+// Sender 邮件发送接口
+type Sender interface {
+	Send(ctx context.Context, msg *Message) error
+}
+
+// SMTPSender 通过 SMTP 发送邮件，服务端支持 STARTTLS 时自动加密，配置了账号时使用 PLAIN 认证
+type SMTPSender struct {
+	addr     string
+	host     string
+	username string
+	password string
+	from     mail.Address
+}
+
+// NewSMTPSender 创建 SMTP 发送器
+func NewSMTPSender(host string, port int, username, password, from, fromName string) *SMTPSender {
+	return &SMTPSender{
+		addr:     net.JoinHostPort(host, strconv.Itoa(port)),
+		host:     host,
+		username: username,
+		password: password,
+		from:     mail.Address{Name: fromName, Address: from},
+	}
+}
+
+// Send 发送邮件
+func (s *SMTPSender) Send(ctx context.Context, msg *Message) error {
+	data, err := buildMessage(s.from, msg)
+	if err != nil {
+		return err
+	}
+
+	dialer := &net.Dialer{Timeout: dialTimeout}
+	conn, err := dialer.DialContext(ctx, "tcp", s.addr)
+	if err != nil {
+		return fmt.Errorf("连接 SMTP 服务器失败: %w", err)
+	}
+	deadline := time.Now().Add(sendTimeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	conn.SetDeadline(deadline)
+
+	client, err := smtp.NewClient(conn, s.host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("SMTP 握手失败: %w", err)
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: s.host}); err != nil {
+			return fmt.Errorf("SMTP STARTTLS 失败: %w", err)
+		}
+	}
+	if s.username != "" {
+		if err := client.Auth(smtp.PlainAuth("", s.username, s.password, s.host)); err != nil {
+			return fmt.Errorf("SMTP 认证失败: %w", err)
+		}
+	}
+	if err := client.Mail(s.from.Address); err != nil {
+		return fmt.Errorf("SMTP MAIL FROM 失败: %w", err)
+	}
+	if err := client.Rcpt(msg.To); err != nil {
+		return fmt.Errorf("SMTP RCPT TO 失败: %w", err)
+	}
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("SMTP DATA 失败: %w", err)
+	}
+	if _, err := w.Write(data); err != nil {
+		return fmt.Errorf("写入邮件内容失败: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("SMTP 服务器拒收邮件: %w", err)
+	}
+	return client.Quit()
+}
+
+// LogSender 只把邮件记录到日志，用于未配置 SMTP 的开发环境
+type LogSender struct{}
+
+// NewLogSender 创建日志发送器
+func NewLogSender() *LogSender {
+	return &LogSender{}
+}
+
+// Send 记录邮件摘要
+func (s *LogSender) Send(ctx context.Context, msg *Message) error {
+	log.Printf("📧 [未配置SMTP] 收件人: %s, 标题: %s", msg.To, msg.Subject)
+	return nil
+}
+
+// buildMessage 生成 MIME 格式的邮件内容，正文使用 base64 编码以支持中文
+func buildMessage(from mail.Address, msg *Message) ([]byte, error) {
+	if msg.To == "" {
+		return nil, errors.New("收件地址不能为空")
+	}
+	to := mail.Address{Name: msg.ToName, Address: msg.To}
+
+	var buf bytes.Buffer
+	header := func(key, value string) {
+		buf.WriteString(key + ": " + value + "\r\n")
+	}
+	header("From", from.String())
+	header("To", to.String())
+	header("Subject", mime.QEncoding.Encode("utf-8", msg.Subject))
+	header("Date", time.Now().Format(time.RFC1123Z))
+	header("Message-ID", messageID(from.Address))
+	header("MIME-Version", "1.0")
+	if msg.UnsubscribeURL != "" {
+		header("List-Unsubscribe", "<"+msg.UnsubscribeURL+">")
+		header("List-Unsubscribe-Post", "List-Unsubscribe=One-Click")
+	}
+	header("Content-Type", `text/html; charset="utf-8"`)
+	header("Content-Transfer-Encoding", "base64")
+	buf.WriteString("\r\n")
+
+	// base64 正文每行不超过 76 个字符
+	encoded := base64.StdEncoding.EncodeToString([]byte(msg.HTMLBody))
+	for len(encoded) > 76 {
+		buf.WriteString(encoded[:76] + "\r\n")
+		encoded = encoded[76:]
+	}
+	buf.WriteString(encoded + "\r\n")
+	return buf.Bytes(), nil
+}
+
+// messageID 生成唯一的 Message-ID，域名取自寄件地址
+func messageID(from string) string {
+	domain := "localhost"
+	if at := strings.LastIndex(from, "@"); at >= 0 {
+		domain = from[at+1:]
+	}
+	buf := make([]byte, 12)
+	rand.Read(buf)
+	return fmt.Sprintf("<%d.%s@%s>", time.Now().UnixNano(), hex.EncodeToString(buf), domain)
+}
@@ -0,0 +1,289 @@
+// Package oidc OpenID Connect 依赖方实现（授权码模式 + PKCE），用于企业身份提供者单点登录
+// 端点从 {issuer}/.well-known/openid-configuration 自动发现，ID Token 使用提供者公布的 JWKS 校验签名
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// 请求身份提供者的参数
+const (
+	httpTimeout      = 10 * time.Second
+	discoveryTTL     = time.Hour        // 发现文档缓存时长
+	jwksRefreshLimit = time.Minute      // 遇到未知 kid 时重新获取 JWKS 的最小间隔
+	maxResponseSize  = 1 << 20          // 身份提供者响应的大小上限
+	clockSkew        = 60 * time.Second // 校验 ID Token 时间时允许的时钟误差
+)
+
+// ProviderConfig 身份提供者配置
+type ProviderConfig struct {
+	Name         string   // 提供者名称，用于回调地址和绑定记录
+	DisplayName  string   // 登录按钮上显示的名称
+	Issuer       string   // 发行者地址
+	ClientID     string   // 客户端ID
+	ClientSecret string   // 客户端密钥，公开客户端留空只使用 PKCE
+	RedirectURL  string   // 回调地址，需要在身份提供者登记
+	Scopes       []string // 申请的权限，默认 openid email profile
+	TrustEmail   bool     // 信任该提供者已验证的邮箱
+}
+
+// Claims ID Token 中使用到的声明
+type Claims struct {
+	jwt.RegisteredClaims
+	Nonce             string `json:"nonce"`
+	AuthorizedParty   string `json:"azp"`
+	Email             string `json:"email"`
+	EmailVerified     bool   `json:"email_verified"`
+	Name              string `json:"name"`
+	PreferredUsername string `json:"preferred_username"`
+	Picture           string `json:"picture"`
+}
+
+// discoveryDocument 发现文档中使用到的字段
+type discoveryDocument struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// Provider 单个身份提供者，发现文档和签名公钥在首次使用时获取并缓存
+type Provider struct {
+	config     ProviderConfig
+	httpClient *http.Client
+
+	mu            sync.Mutex
+	discovery     *discoveryDocument
+	discoveredAt  time.Time
+	keys          map[string]interface{} // kid -> 公钥
+	keysFetchedAt time.Time
+}
+
+// NewProvider 创建身份提供者，不会立即请求发现文档，提供者暂时不可用也不影响网关启动
+func NewProvider(config ProviderConfig) *Provider {
+	config.Issuer = strings.TrimRight(config.Issuer, "/")
+	if len(config.Scopes) == 0 {
+		config.Scopes = []string{"openid", "email", "profile"}
+	}
+	return &Provider{
+		config:     config,
+		httpClient: &http.Client{Timeout: httpTimeout},
+	}
+}
+
+// Name 提供者名称
+func (p *Provider) Name() string {
+	return p.config.Name
+}
+
+// DisplayName 显示名称，未配置时使用提供者名称
+func (p *Provider) DisplayName() string {
+	if p.config.DisplayName != "" {
+		return p.config.DisplayName
+	}
+	return p.config.Name
+}
+
+// TrustEmail 是否信任该提供者已验证的邮箱
+func (p *Provider) TrustEmail() bool {
+	return p.config.TrustEmail
+}
+
+// AuthCodeURL 生成跳转到身份提供者的授权地址
+func (p *Provider) AuthCodeURL(ctx context.Context, state, nonce, codeVerifier string) (string, error) {
+	doc, err := p.getDiscovery(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	params := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {p.config.ClientID},
+		"redirect_uri":          {p.config.RedirectURL},
+		"scope":                 {strings.Join(p.config.Scopes, " ")},
+		"state":                 {state},
+		"nonce":                 {nonce},
+		"code_challenge":        {CodeChallenge(codeVerifier)},
+		"code_challenge_method": {"S256"},
+	}
+	separator := "?"
+	if strings.Contains(doc.AuthorizationEndpoint, "?") {
+		separator = "&"
+	}
+	return doc.AuthorizationEndpoint + separator + params.Encode(), nil
+}
+
+// Exchange 用授权码换取令牌并校验 ID Token，返回其中的声明
+func (p *Provider) Exchange(ctx context.Context, code, codeVerifier, nonce string) (*Claims, error) {
+	doc, err := p.getDiscovery(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {p.config.RedirectURL},
+		"code_verifier": {codeVerifier},
+	}
+	if p.config.ClientSecret == "" {
+		form.Set("client_id", p.config.ClientID)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, doc.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if p.config.ClientSecret != "" {
+		// client_secret_basic：按规范先对客户端ID和密钥做表单编码
+		req.SetBasicAuth(url.QueryEscape(p.config.ClientID), url.QueryEscape(p.config.ClientSecret))
+	}
+
+	var token struct {
+		IDToken          string `json:"id_token"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	status, err := p.doJSON(req, &token)
+	if err != nil {
+		return nil, fmt.Errorf("换取令牌失败: %w", err)
+	}
+	if status != http.StatusOK || token.Error != "" {
+		return nil, fmt.Errorf("换取令牌失败: %s %s", token.Error, token.ErrorDescription)
+	}
+	if token.IDToken == "" {
+		return nil, errors.New("身份提供者没有返回 ID Token")
+	}
+	return p.verifyIDToken(ctx, doc, token.IDToken, nonce)
+}
+
+// verifyIDToken 校验 ID Token 的签名、发行者、受众、有效期和 nonce
+func (p *Provider) verifyIDToken(ctx context.Context, doc *discoveryDocument, rawIDToken, nonce string) (*Claims, error) {
+	claims := &Claims{}
+	_, err := jwt.ParseWithClaims(rawIDToken, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		return p.getKey(ctx, doc, kid)
+	},
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512"}),
+		jwt.WithIssuer(doc.Issuer),
+		jwt.WithAudience(p.config.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(clockSkew),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("ID Token 校验失败: %w", err)
+	}
+	if claims.Nonce != nonce {
+		return nil, errors.New("ID Token 校验失败: nonce 不匹配")
+	}
+	if len(claims.Audience) > 1 && claims.AuthorizedParty != p.config.ClientID {
+		return nil, errors.New("ID Token 校验失败: azp 不匹配")
+	}
+	if claims.Subject == "" {
+		return nil, errors.New("ID Token 校验失败: 缺少 sub")
+	}
+	return claims, nil
+}
+
+// getDiscovery 获取发现文档，缓存一段时间
+func (p *Provider) getDiscovery(ctx context.Context) (*discoveryDocument, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.discovery != nil && time.Since(p.discoveredAt) < discoveryTTL {
+		return p.discovery, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.config.Issuer+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		return nil, err
+	}
+	var doc discoveryDocument
+	status, err := p.doJSON(req, &doc)
+	if err != nil || status != http.StatusOK {
+		return nil, fmt.Errorf("获取身份提供者配置失败: status=%d %v", status, err)
+	}
+	if strings.TrimRight(doc.Issuer, "/") != p.config.Issuer {
+		return nil, fmt.Errorf("身份提供者 issuer 不一致: %s", doc.Issuer)
+	}
+	if doc.AuthorizationEndpoint == "" || doc.TokenEndpoint == "" || doc.JWKSURI == "" {
+		return nil, errors.New("身份提供者配置缺少必要的端点")
+	}
+
+	p.discovery = &doc
+	p.discoveredAt = time.Now()
+	return p.discovery, nil
+}
+
+// getKey 按 kid 获取签名公钥，找不到时重新获取 JWKS（提供者可能已轮换密钥）
+func (p *Provider) getKey(ctx context.Context, doc *discoveryDocument, kid string) (interface{}, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if key := p.lookupKey(kid); key != nil {
+		return key, nil
+	}
+	if time.Since(p.keysFetchedAt) < jwksRefreshLimit {
+		return nil, fmt.Errorf("未知的签名密钥: %s", kid)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, doc.JWKSURI, nil)
+	if err != nil {
+		return nil, err
+	}
+	var set jsonWebKeySet
+	status, err := p.doJSON(req, &set)
+	if err != nil || status != http.StatusOK {
+		return nil, fmt.Errorf("获取签名公钥失败: status=%d %v", status, err)
+	}
+	p.keys = set.publicKeys()
+	p.keysFetchedAt = time.Now()
+
+	if key := p.lookupKey(kid); key != nil {
+		return key, nil
+	}
+	return nil, fmt.Errorf("未知的签名密钥: %s", kid)
+}
+
+// lookupKey 查找已缓存的公钥，令牌没有 kid 且只有一个公钥时直接使用
+func (p *Provider) lookupKey(kid string) interface{} {
+	if key, ok := p.keys[kid]; ok {
+		return key
+	}
+	if kid == "" && len(p.keys) == 1 {
+		for _, key := range p.keys {
+			return key
+		}
+	}
+	return nil
+}
+
+// doJSON 发送请求并解析 JSON 响应
+func (p *Provider) doJSON(req *http.Request, out interface{}) (int, error) {
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return resp.StatusCode, err
+	}
+	if err := json.Unmarshal(body, out); err != nil {
+		return resp.StatusCode, fmt.Errorf("解析响应失败: %w", err)
+	}
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,152 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// StateTTL 从跳转到身份提供者到回调的最长时间
+const StateTTL = 10 * time.Minute
+
+// 授权请求的用途
+const (
+	ModeLogin = "login" // 单点登录
+	ModeLink  = "link"  // 已登录用户绑定身份
+)
+
+// ErrStateNotFound state 不存在、已使用或已过期
+var ErrStateNotFound = errors.New("登录请求已过期，请重新登录")
+
+// AuthRequest 跳转到身份提供者前保存的请求信息，回调时按 state 取回
+type AuthRequest struct {
+	Provider     string `json:"provider"`
+	Mode         string `json:"mode"`
+	UserID       uint   `json:"user_id,omitempty"` // 绑定身份时的当前用户
+	CodeVerifier string `json:"code_verifier"`
+	Nonce        string `json:"nonce"`
+	Redirect     string `json:"redirect,omitempty"` // 登录后跳转的站内地址
+}
+
+// StateStore 保存授权请求，每个 state 只能取回一次
+type StateStore interface {
+	Save(ctx context.Context, state string, req *AuthRequest) error
+	Take(ctx context.Context, state string) (*AuthRequest, error)
+}
+
+// RandomString 生成 URL 安全的随机字符串，用于 state、nonce 和 PKCE code_verifier
+func RandomString() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("生成随机数失败: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// CodeChallenge 计算 PKCE S256 code_challenge
+func CodeChallenge(codeVerifier string) string {
+	sum := sha256.Sum256([]byte(codeVerifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// RedisStateStore 使用 Redis 保存授权请求，多个网关实例共享
+type RedisStateStore struct {
+	redis *redis.Client
+}
+
+// NewRedisStateStore 创建 Redis 授权请求存储
+func NewRedisStateStore(redis *redis.Client) *RedisStateStore {
+	return &RedisStateStore{redis: redis}
+}
+
+// Save 保存授权请求
+func (s *RedisStateStore) Save(ctx context.Context, state string, req *AuthRequest) error {
+	data, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	if err := s.redis.Set(ctx, stateKey(state), data, StateTTL).Err(); err != nil {
+		return fmt.Errorf("保存登录请求失败: %w", err)
+	}
+	return nil
+}
+
+// Take 取回并删除授权请求
+func (s *RedisStateStore) Take(ctx context.Context, state string) (*AuthRequest, error) {
+	var get *redis.StringCmd
+	_, err := s.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		get = pipe.Get(ctx, stateKey(state))
+		pipe.Del(ctx, stateKey(state))
+		return nil
+	})
+	if err == redis.Nil {
+		return nil, ErrStateNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("读取登录请求失败: %w", err)
+	}
+
+	var req AuthRequest
+	if err := json.Unmarshal([]byte(get.Val()), &req); err != nil {
+		return nil, ErrStateNotFound
+	}
+	return &req, nil
+}
+
+// stateKey 授权请求的缓存键
+func stateKey(state string) string {
+	return "oidc:state:" + state
+}
+
+// MemoryStateStore 进程内保存授权请求，用于没有 Redis 的单实例开发环境
+type MemoryStateStore struct {
+	mu       sync.Mutex
+	requests map[string]memoryAuthRequest
+}
+
+// memoryAuthRequest 带过期时间的授权请求
+type memoryAuthRequest struct {
+	req       AuthRequest
+	expiresAt time.Time
+}
+
+// NewMemoryStateStore 创建进程内授权请求存储
+func NewMemoryStateStore() *MemoryStateStore {
+	return &MemoryStateStore{requests: make(map[string]memoryAuthRequest)}
+}
+
+// Save 保存授权请求，顺便清理已过期的请求
+func (s *MemoryStateStore) Save(ctx context.Context, state string, req *AuthRequest) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	for key, item := range s.requests {
+		if now.After(item.expiresAt) {
+			delete(s.requests, key)
+		}
+	}
+	s.requests[state] = memoryAuthRequest{req: *req, expiresAt: now.Add(StateTTL)}
+	return nil
+}
+
+// Take 取回并删除授权请求
+func (s *MemoryStateStore) Take(ctx context.Context, state string) (*AuthRequest, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item, ok := s.requests[state]
+	delete(s.requests, state)
+	if !ok || time.Now().After(item.expiresAt) {
+		return nil, ErrStateNotFound
+	}
+	return &item.req, nil
+}
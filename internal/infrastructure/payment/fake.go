@@ -0,0 +1,82 @@
+package payment
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+)
+
+// ProviderFake 本地模拟支付渠道名称
+const ProviderFake = "fake"
+
+// FakeProvider 本地模拟支付渠道，用于开发和测试
+// 不会真正扣款，回调使用 HMAC-SHA256 签名，与真实渠道走相同的确认流程
+type FakeProvider struct {
+	secret []byte
+}
+
+// NewFakeProvider 创建模拟支付渠道，secret 为回调签名密钥
+func NewFakeProvider(secret string) *FakeProvider {
+	return &FakeProvider{secret: []byte(secret)}
+}
+
+// Name 渠道名称
+func (p *FakeProvider) Name() string {
+	return ProviderFake
+}
+
+// CreatePayment 生成模拟支付单，没有支付页面，通过 BuildWebhook 模拟支付结果
+func (p *FakeProvider) CreatePayment(ctx context.Context, req *PaymentRequest) (*Payment, error) {
+	buf := make([]byte, 12)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, fmt.Errorf("生成支付单号失败: %w", err)
+	}
+
+	return &Payment{PaymentID: "fake_" + hex.EncodeToString(buf)}, nil
+}
+
+// Refund 模拟退款，立即成功
+func (p *FakeProvider) Refund(ctx context.Context, req *RefundRequest) (*RefundResult, error) {
+	if req.PaymentID == "" || req.Amount <= 0 {
+		return nil, fmt.Errorf("退款参数无效")
+	}
+
+	buf := make([]byte, 12)
+	if _, err := rand.Read(buf); err != nil {
+		return nil, fmt.Errorf("生成退款单号失败: %w", err)
+	}
+	return &RefundResult{RefundID: "fake_re_" + hex.EncodeToString(buf)}, nil
+}
+
+// ParseWebhook 校验签名并解析回调事件
+func (p *FakeProvider) ParseWebhook(payload []byte, signature string) (*WebhookEvent, error) {
+	if !hmac.Equal([]byte(p.Sign(payload)), []byte(signature)) {
+		return nil, ErrInvalidSignature
+	}
+
+	var event WebhookEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, fmt.Errorf("支付回调格式错误: %w", err)
+	}
+	return &event, nil
+}
+
+// Sign 计算回调内容的签名
+func (p *FakeProvider) Sign(payload []byte) string {
+	mac := hmac.New(sha256.New, p.secret)
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// BuildWebhook 构造一条已签名的回调，模拟支付渠道通知支付结果
+func (p *FakeProvider) BuildWebhook(event *WebhookEvent) (payload []byte, signature string, err error) {
+	payload, err = json.Marshal(event)
+	if err != nil {
+		return nil, "", err
+	}
+	return payload, p.Sign(payload), nil
+}
@@ -0,0 +1,63 @@
+// Package payment 支付渠道抽象，订单服务通过 Provider 接口对接具体的支付平台
+package payment
+
+import (
+	"context"
+	"errors"
+)
+
+// 支付回调事件类型
+const (
+	EventPaymentSucceeded = "payment.succeeded"
+	EventPaymentFailed    = "payment.failed"
+)
+
+// ErrInvalidSignature 回调签名校验失败
+var ErrInvalidSignature = errors.New("支付回调签名无效")
+
+// PaymentRequest 发起支付请求
+type PaymentRequest struct {
+	OrderNo     string
+	Amount      int64 // 金额（分）
+	Currency    string
+	Description string
+}
+
+// Payment 支付渠道创建的支付单
+type Payment struct {
+	PaymentID   string // 支付渠道的支付单号
+	CheckoutURL string // 用户完成支付的页面地址
+}
+
+// RefundRequest 发起退款请求，Amount 可小于支付金额（部分退款）
+type RefundRequest struct {
+	PaymentID string
+	OrderNo   string
+	Amount    int64 // 退款金额（分）
+	Reason    string
+}
+
+// RefundResult 支付渠道受理的退款
+type RefundResult struct {
+	RefundID string // 支付渠道的退款单号
+}
+
+// WebhookEvent 支付渠道回调事件
+type WebhookEvent struct {
+	Type      string `json:"type"`
+	PaymentID string `json:"payment_id"`
+	OrderNo   string `json:"order_no"`
+	Amount    int64  `json:"amount"`
+}
+
+// Provider 支付渠道接口
+type Provider interface {
+	// Name 渠道名称，记录在订单上
+	Name() string
+	// CreatePayment 为订单创建支付单
+	CreatePayment(ctx context.Context, req *PaymentRequest) (*Payment, error)
+	// ParseWebhook 校验回调签名并解析事件
+	ParseWebhook(payload []byte, signature string) (*WebhookEvent, error)
+	// Refund 将已支付的款项原路退回
+	Refund(ctx context.Context, req *RefundRequest) (*RefundResult, error)
+}
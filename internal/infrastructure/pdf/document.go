@@ -0,0 +1,224 @@
+// Package pdf 纯Go实现的单页PDF生成器，用于证书等简单版式文档
+//
+// 文字统一使用 Adobe 预定义的 STSong-Light 字体（UniGB-UCS2-H 编码），
+// 阅读器自带该字体的替代实现，因此无需嵌入字体文件即可显示中文。
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf16"
+)
+
+// 常用纸张尺寸（单位：pt）
+const (
+	A4Width  = 595.28
+	A4Height = 841.89
+)
+
+// 字宽（千分之一字号）：ASCII为半角，其余按全角计算
+const (
+	halfWidth = 500
+	fullWidth = 1000
+)
+
+// Color RGB颜色，分量取值0~1
+type Color struct {
+	R, G, B float64
+}
+
+// ParseHexColor 解析 #RRGGBB 格式的颜色
+func ParseHexColor(s string) (Color, error) {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "#")
+	if len(s) != 6 {
+		return Color{}, fmt.Errorf("颜色格式错误: %s", s)
+	}
+	v, err := strconv.ParseUint(s, 16, 32)
+	if err != nil {
+		return Color{}, fmt.Errorf("颜色格式错误: %s", s)
+	}
+	return Color{
+		R: float64(v>>16&0xff) / 255,
+		G: float64(v>>8&0xff) / 255,
+		B: float64(v&0xff) / 255,
+	}, nil
+}
+
+// Document 单页PDF文档，坐标原点位于页面左下角
+type Document struct {
+	width, height float64
+	title         string
+	content       bytes.Buffer
+}
+
+// New 创建指定页面尺寸的文档
+func New(width, height float64) *Document {
+	return &Document{width: width, height: height}
+}
+
+// Width 页面宽度
+func (d *Document) Width() float64 { return d.width }
+
+// Height 页面高度
+func (d *Document) Height() float64 { return d.height }
+
+// SetTitle 设置文档标题（写入文档信息字典）
+func (d *Document) SetTitle(title string) {
+	d.title = title
+}
+
+// FillRect 绘制填充矩形
+func (d *Document) FillRect(x, y, w, h float64, c Color) {
+	fmt.Fprintf(&d.content, "q %s rg %s %s %s %s re f Q\n", num3(c), num(x), num(y), num(w), num(h))
+}
+
+// StrokeRect 绘制矩形边框
+func (d *Document) StrokeRect(x, y, w, h, lineWidth float64, c Color) {
+	fmt.Fprintf(&d.content, "q %s RG %s w %s %s %s %s re S Q\n", num3(c), num(lineWidth), num(x), num(y), num(w), num(h))
+}
+
+// Line 绘制直线
+func (d *Document) Line(x1, y1, x2, y2, lineWidth float64, c Color) {
+	fmt.Fprintf(&d.content, "q %s RG %s w %s %s m %s %s l S Q\n", num3(c), num(lineWidth), num(x1), num(y1), num(x2), num(y2))
+}
+
+// Text 在(x, y)处绘制一行文字，y为基线位置
+func (d *Document) Text(x, y, size float64, c Color, text string) {
+	fmt.Fprintf(&d.content, "q BT %s rg /F1 %s Tf %s %s Td <%s> Tj ET Q\n", num3(c), num(size), num(x), num(y), encodeUCS2(text))
+}
+
+// TextCentered 以cx为中心绘制一行文字
+func (d *Document) TextCentered(cx, y, size float64, c Color, text string) {
+	d.Text(cx-TextWidth(text, size)/2, y, size, c, text)
+}
+
+// TextWidth 估算文字宽度
+func TextWidth(text string, size float64) float64 {
+	units := 0
+	for _, r := range text {
+		units += runeWidth(r)
+	}
+	return float64(units) * size / 1000
+}
+
+// WrapText 按最大宽度折行，英文单词尽量不拆分
+func WrapText(text string, size, maxWidth float64) []string {
+	var lines []string
+	for _, paragraph := range strings.Split(text, "\n") {
+		var line []rune
+		lineWidth := 0.0
+		lastSpace := -1
+		for _, r := range paragraph {
+			w := float64(runeWidth(r)) * size / 1000
+			if lineWidth+w > maxWidth && len(line) > 0 {
+				if r != ' ' && lastSpace > 0 && runeWidth(line[len(line)-1]) == halfWidth {
+					// 在最后一个空格处断开
+					lines = append(lines, string(line[:lastSpace]))
+					line = append([]rune{}, line[lastSpace+1:]...)
+				} else {
+					lines = append(lines, string(line))
+					line = line[:0]
+				}
+				lineWidth = TextWidth(string(line), size)
+				lastSpace = -1
+				if r == ' ' && len(line) == 0 {
+					continue
+				}
+			}
+			if r == ' ' {
+				lastSpace = len(line)
+			}
+			line = append(line, r)
+			lineWidth += w
+		}
+		lines = append(lines, string(line))
+	}
+	return lines
+}
+
+// Bytes 输出完整的PDF文件内容
+func (d *Document) Bytes() ([]byte, error) {
+	var stream bytes.Buffer
+	zw := zlib.NewWriter(&stream)
+	if _, err := zw.Write(d.content.Bytes()); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %s %s] /Resources << /Font << /F1 5 0 R >> >> /Contents 4 0 R >>",
+			num(d.width), num(d.height)),
+		fmt.Sprintf("<< /Length %d /Filter /FlateDecode >>\nstream\n%s\nendstream", stream.Len(), stream.Bytes()),
+		"<< /Type /Font /Subtype /Type0 /BaseFont /STSong-Light /Encoding /UniGB-UCS2-H /DescendantFonts [6 0 R] >>",
+		fmt.Sprintf("<< /Type /Font /Subtype /CIDFontType0 /BaseFont /STSong-Light /CIDSystemInfo << /Registry (Adobe) /Ordering (GB1) /Supplement 2 >> /FontDescriptor 7 0 R /DW %d /W [1 95 %d] >>",
+			fullWidth, halfWidth),
+		"<< /Type /FontDescriptor /FontName /STSong-Light /Flags 6 /FontBBox [-25 -254 1000 880] /ItalicAngle 0 /Ascent 880 /Descent -120 /CapHeight 880 /StemV 93 >>",
+		fmt.Sprintf("<< /Producer (course-platform) /Title <FEFF%s> /CreationDate (D:%s) >>",
+			encodeUTF16(d.title), time.Now().UTC().Format("20060102150405Z")),
+	}
+
+	var out bytes.Buffer
+	out.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+	offsets := make([]int, len(objects))
+	for i, obj := range objects {
+		offsets[i] = out.Len()
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+	}
+
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R /Info %d 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, len(objects), xref)
+	return out.Bytes(), nil
+}
+
+// runeWidth 返回字符宽度（千分之一字号）
+func runeWidth(r rune) int {
+	if r >= 0x20 && r <= 0x7e {
+		return halfWidth
+	}
+	return fullWidth
+}
+
+// encodeUCS2 将文字编码为UCS-2大端十六进制串，超出基本平面的字符以?代替
+func encodeUCS2(text string) string {
+	var b strings.Builder
+	for _, r := range text {
+		if r > 0xffff || r < 0x20 {
+			r = '?'
+		}
+		fmt.Fprintf(&b, "%04X", r)
+	}
+	return b.String()
+}
+
+// encodeUTF16 将文字编码为UTF-16大端十六进制串（用于文档信息字典）
+func encodeUTF16(text string) string {
+	var b strings.Builder
+	for _, u := range utf16.Encode([]rune(text)) {
+		fmt.Fprintf(&b, "%04X", u)
+	}
+	return b.String()
+}
+
+// num 格式化数值，保留两位小数并去掉末尾的0
+func num(v float64) string {
+	s := strconv.FormatFloat(v, 'f', 2, 64)
+	s = strings.TrimRight(s, "0")
+	return strings.TrimSuffix(s, ".")
+}
+
+// num3 格式化颜色分量
+func num3(c Color) string {
+	return fmt.Sprintf("%s %s %s", strconv.FormatFloat(c.R, 'f', 3, 64), strconv.FormatFloat(c.G, 'f', 3, 64), strconv.FormatFloat(c.B, 'f', 3, 64))
+}
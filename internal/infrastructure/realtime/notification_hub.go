@@ -0,0 +1,110 @@
+// Package realtime 网关的实时推送，订阅课程服务经 Redis 发布的通知事件并转发给本实例上在线的用户
+package realtime
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"sync"
+
+	"course-platform/internal/domain/notification/model"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// subscriberBuffer 每个连接的事件缓冲，消费不及时的连接会丢弃事件（客户端可重新拉取列表）
+const subscriberBuffer = 16
+
+// streamEvents 实时事件类型对应推送给客户端的 SSE 事件名，未列出的类型不转发
+var streamEvents = map[string]string{
+	model.EventCreated: "notification",
+	model.EventRead:    "read",
+}
+
+// Message 投递给连接的一条事件
+type Message struct {
+	Event   string // SSE 事件名
+	Payload []byte // 原始事件 JSON
+}
+
+// NotificationHub 通知推送中心，每个网关实例一个
+// 所有实例都订阅同一个 Redis 频道，只把事件投递给连接在本实例上的用户
+type NotificationHub struct {
+	redis *redis.Client
+
+	mu          sync.RWMutex
+	subscribers map[uint]map[chan Message]struct{}
+}
+
+// NewNotificationHub 创建通知推送中心，需调用 Run 开始订阅
+func NewNotificationHub(redis *redis.Client) *NotificationHub {
+	return &NotificationHub{
+		redis:       redis,
+		subscribers: make(map[uint]map[chan Message]struct{}),
+	}
+}
+
+// Run 订阅 Redis 频道并分发事件，直到 ctx 结束
+func (h *NotificationHub) Run(ctx context.Context) {
+	pubsub := h.redis.Subscribe(ctx, model.RealtimeChannel)
+	defer pubsub.Close()
+	log.Printf("✅ 实时通知已订阅 Redis 频道: %s", model.RealtimeChannel)
+
+	messages := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case message, ok := <-messages:
+			if !ok {
+				return
+			}
+			h.dispatch([]byte(message.Payload))
+		}
+	}
+}
+
+// Subscribe 注册用户的一个连接，返回事件通道和取消函数
+// 同一用户可以有多个连接（多个标签页或设备）
+func (h *NotificationHub) Subscribe(userID uint) (<-chan Message, func()) {
+	ch := make(chan Message, subscriberBuffer)
+
+	h.mu.Lock()
+	if h.subscribers[userID] == nil {
+		h.subscribers[userID] = make(map[chan Message]struct{})
+	}
+	h.subscribers[userID][ch] = struct{}{}
+	h.mu.Unlock()
+
+	return ch, func() {
+		h.mu.Lock()
+		delete(h.subscribers[userID], ch)
+		if len(h.subscribers[userID]) == 0 {
+			delete(h.subscribers, userID)
+		}
+		h.mu.Unlock()
+	}
+}
+
+// dispatch 把事件投递给对应用户在本实例上的全部连接
+func (h *NotificationHub) dispatch(payload []byte) {
+	var event model.RealtimeEvent
+	if err := json.Unmarshal(payload, &event); err != nil || event.UserID == 0 {
+		log.Printf("⚠️ 实时通知事件格式无效: %v", err)
+		return
+	}
+	name, ok := streamEvents[event.Event]
+	if !ok {
+		return
+	}
+	message := Message{Event: name, Payload: payload}
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	for ch := range h.subscribers[event.UserID] {
+		select {
+		case ch <- message:
+		default:
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+)
+
+const testAPITokenPrefix = "cpt_"
+
+func TestAPITokenScopes(t *testing.T) {
+	SetAPITokenCheck(testAPITokenPrefix, func(token, clientIP string) (uint, []string, error) {
+		switch token {
+		case testAPITokenPrefix + "read":
+			return 1, []string{"courses:read"}, nil
+		case testAPITokenPrefix + "write":
+			return 1, []string{"courses:read", "courses:write"}, nil
+		}
+		return 0, nil, errors.New("令牌不存在")
+	})
+	routeScopes = map[string]string{}
+	RegisterScope(http.MethodGet, "/api/v1/courses/:id", "courses:read")
+	RegisterScope(http.MethodPut, "/api/v1/courses/:id", "courses:write")
+	defer func() {
+		SetAPITokenCheck("", nil)
+		routeScopes = map[string]string{}
+	}()
+
+	tests := []struct {
+		name       string
+		method     string
+		route      string
+		token      string
+		wantCode   int
+		wantUserID uint
+	}{
+		{"权限满足", http.MethodGet, "/api/v1/courses/:id", "read", http.StatusNoContent, 1},
+		{"缺少写权限", http.MethodPut, "/api/v1/courses/:id", "read", http.StatusForbidden, 0},
+		{"拥有写权限", http.MethodPut, "/api/v1/courses/:id", "write", http.StatusNoContent, 1},
+		{"未登记的路由", http.MethodDelete, "/api/v1/courses/:id", "write", http.StatusForbidden, 0},
+		{"未登记的同路径其他方法", http.MethodPost, "/api/v1/courses/:id", "write", http.StatusForbidden, 0},
+		{"未登记的敏感路由", http.MethodPost, "/api/v1/user/api-tokens", "write", http.StatusForbidden, 0},
+		{"令牌无效", http.MethodGet, "/api/v1/courses/:id", "unknown", http.StatusUnauthorized, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := tt.route
+			if tt.route == "/api/v1/courses/:id" {
+				path = "/api/v1/courses/42"
+			}
+			code, userID := serveAuth(AuthMiddleware(), tt.method, path, tt.route, "Bearer "+testAPITokenPrefix+tt.token)
+			if code != tt.wantCode || userID != tt.wantUserID {
+				t.Errorf("(%d, %d), want (%d, %d)", code, userID, tt.wantCode, tt.wantUserID)
+			}
+		})
+	}
+}
+
+func TestAPITokenDisabled(t *testing.T) {
+	// 未配置个人访问令牌时按登录令牌解析，格式不符直接拒绝
+	SetAPITokenCheck("", nil)
+	if code, _ := serveAuth(AuthMiddleware(), http.MethodGet, "/me", "/me", "Bearer "+testAPITokenPrefix+"read"); code != http.StatusUnauthorized {
+		t.Errorf("状态码 = %d, want %d", code, http.StatusUnauthorized)
+	}
+}
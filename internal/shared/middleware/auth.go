@@ -3,6 +3,7 @@ package middleware
 import (
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
@@ -11,10 +12,34 @@ import (
 // JWT 密钥 - 需要与service中的保持一致
 var jwtSecret = []byte("course-platform-secret-key-2024")
 
+// RevocationCheck 判断用户在 issuedAt 签发、属于 sessionID 会话的令牌是否已被吊销
+// 无法完成检查时返回错误
+type RevocationCheck func(userID uint, sessionID string, issuedAt time.Time) (bool, error)
+
+// SessionActivity 记录会话的最近活动
+type SessionActivity func(sessionID, clientIP string)
+
+// revocationCheck 令牌吊销检查，为空时不检查
+var revocationCheck RevocationCheck
+
+// sessionActivity 会话活动记录，为空时不记录
+var sessionActivity SessionActivity
+
+// SetRevocationCheck 设置令牌吊销检查，应在注册路由前调用
+func SetRevocationCheck(check RevocationCheck) {
+	revocationCheck = check
+}
+
+// SetSessionActivity 设置会话活动记录，应在注册路由前调用
+func SetSessionActivity(activity SessionActivity) {
+	sessionActivity = activity
+}
+
 // JWTClaims JWT声明结构体
 type JWTClaims struct {
-	UserID   uint   `json:"user_id"`
-	Username string `json:"username"`
+	UserID    uint   `json:"user_id"`
+	Username  string `json:"username"`
+	SessionID string `json:"sid"` // 登录会话标识
 	jwt.RegisteredClaims
 }
 
@@ -45,6 +70,14 @@ func AuthMiddleware() gin.HandlerFunc {
 
 		tokenString := tokenParts[1]
 
+		// 个人访问令牌按路由权限校验
+		if isAPIToken(tokenString) {
+			if authenticateAPIToken(c, tokenString) {
+				c.Next()
+			}
+			return
+		}
+
 		// 解析和验证Token
 		token, err := jwt.ParseWithClaims(tokenString, &JWTClaims{}, func(token *jwt.Token) (interface{}, error) {
 			// 验证签名方法
@@ -93,9 +126,30 @@ func AuthMiddleware() gin.HandlerFunc {
 
 		// 提取用户信息
 		if claims, ok := token.Claims.(*JWTClaims); ok {
+			revoked, err := isRevoked(claims)
+			if err != nil {
+				// 无法确认令牌是否已吊销时拒绝请求，但不要求重新登录
+				c.JSON(http.StatusServiceUnavailable, gin.H{
+					"error": "暂时无法校验登录状态，请稍后重试",
+					"code":  "AUTH_UNAVAILABLE",
+				})
+				c.Abort()
+				return
+			}
+			if revoked {
+				c.JSON(http.StatusUnauthorized, gin.H{
+					"error": "登录已失效，请重新登录",
+					"code":  "TOKEN_REVOKED",
+				})
+				c.Abort()
+				return
+			}
+
 			// 将用户信息存储到上下文中
 			c.Set("userID", claims.UserID)
 			c.Set("username", claims.Username)
+			c.Set("sessionID", claims.SessionID)
+			recordActivity(c, claims)
 
 			// 继续处理请求
 			c.Next()
@@ -125,6 +179,14 @@ func OptionalAuthMiddleware() gin.HandlerFunc {
 		if len(tokenParts) == 2 && tokenParts[0] == "Bearer" {
 			tokenString := tokenParts[1]
 
+			// 个人访问令牌无效或权限不足时直接拒绝，避免脚本误以为请求成功
+			if isAPIToken(tokenString) {
+				if authenticateAPIToken(c, tokenString) {
+					c.Next()
+				}
+				return
+			}
+
 			token, err := jwt.ParseWithClaims(tokenString, &JWTClaims{}, func(token *jwt.Token) (interface{}, error) {
 				if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 					return nil, jwt.ErrSignatureInvalid
@@ -133,9 +195,11 @@ func OptionalAuthMiddleware() gin.HandlerFunc {
 			})
 
 			if err == nil && token.Valid {
-				if claims, ok := token.Claims.(*JWTClaims); ok {
+				if claims, ok := token.Claims.(*JWTClaims); ok && !revokedOrUnknown(claims) {
 					c.Set("userID", claims.UserID)
 					c.Set("username", claims.Username)
+					c.Set("sessionID", claims.SessionID)
+					recordActivity(c, claims)
 				}
 			}
 		}
@@ -144,6 +208,30 @@ func OptionalAuthMiddleware() gin.HandlerFunc {
 	}
 }
 
+// isRevoked 检查令牌是否已被吊销，缺少签发时间的令牌无法判断，一律视为已吊销
+func isRevoked(claims *JWTClaims) (bool, error) {
+	if revocationCheck == nil {
+		return false, nil
+	}
+	if claims.IssuedAt == nil {
+		return true, nil
+	}
+	return revocationCheck(claims.UserID, claims.SessionID, claims.IssuedAt.Time)
+}
+
+// revokedOrUnknown 可选认证使用：已吊销或无法检查时都按未登录处理
+func revokedOrUnknown(claims *JWTClaims) bool {
+	revoked, err := isRevoked(claims)
+	return revoked || err != nil
+}
+
+// recordActivity 记录会话的最近活动时间和来源IP
+func recordActivity(c *gin.Context, claims *JWTClaims) {
+	if sessionActivity != nil && claims.SessionID != "" {
+		sessionActivity(claims.SessionID, c.ClientIP())
+	}
+}
+
 // GetUserFromContext 从上下文中获取用户信息
 func GetUserFromContext(c *gin.Context) (userID uint, username string, exists bool) {
 	userIDInterface, exists1 := c.Get("userID")
@@ -0,0 +1,97 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+// signTestToken 签发一个测试用登录令牌
+func signTestToken(t *testing.T, sessionID string) string {
+	t.Helper()
+	now := time.Now()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &JWTClaims{
+		UserID:    1,
+		SessionID: sessionID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(time.Hour)),
+		},
+	}).SignedString(jwtSecret)
+	if err != nil {
+		t.Fatalf("签发令牌失败: %v", err)
+	}
+	return token
+}
+
+// serveAuth 用指定的中间件处理一次请求，返回状态码和处理函数看到的用户ID
+func serveAuth(handler gin.HandlerFunc, method, path, route, authorization string) (int, uint) {
+	var userID uint
+	router := gin.New()
+	router.Handle(method, route, handler, func(c *gin.Context) {
+		userID = c.GetUint("userID")
+		c.Status(http.StatusNoContent)
+	})
+
+	req := httptest.NewRequest(method, path, nil)
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w.Code, userID
+}
+
+func TestAuthMiddlewareRevocation(t *testing.T) {
+	defer SetRevocationCheck(nil)
+
+	tests := []struct {
+		name         string
+		check        RevocationCheck
+		wantCode     int
+		wantOptional uint // 可选认证下处理函数看到的用户ID
+	}{
+		{"会话有效", func(uint, string, time.Time) (bool, error) { return false, nil }, http.StatusNoContent, 1},
+		{"会话已注销", func(uint, string, time.Time) (bool, error) { return true, nil }, http.StatusUnauthorized, 0},
+		{"会话查询失败", func(uint, string, time.Time) (bool, error) { return true, errors.New("缓存超时") }, http.StatusServiceUnavailable, 0},
+		{"查询失败时忽略返回值", func(uint, string, time.Time) (bool, error) { return false, errors.New("缓存超时") }, http.StatusServiceUnavailable, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetRevocationCheck(tt.check)
+			authorization := "Bearer " + signTestToken(t, "sid-1")
+
+			if code, _ := serveAuth(AuthMiddleware(), http.MethodGet, "/me", "/me", authorization); code != tt.wantCode {
+				t.Errorf("AuthMiddleware 状态码 = %d, want %d", code, tt.wantCode)
+			}
+			// 可选认证不拒绝请求，已注销或无法确认时按未登录处理
+			code, userID := serveAuth(OptionalAuthMiddleware(), http.MethodGet, "/courses", "/courses", authorization)
+			if code != http.StatusNoContent || userID != tt.wantOptional {
+				t.Errorf("OptionalAuthMiddleware = (%d, %d), want (%d, %d)", code, userID, http.StatusNoContent, tt.wantOptional)
+			}
+		})
+	}
+}
+
+func TestAuthMiddlewareMissingIssuedAt(t *testing.T) {
+	SetRevocationCheck(func(uint, string, time.Time) (bool, error) { return false, nil })
+	defer SetRevocationCheck(nil)
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &JWTClaims{UserID: 1}).SignedString(jwtSecret)
+	if err != nil {
+		t.Fatalf("签发令牌失败: %v", err)
+	}
+	if code, _ := serveAuth(AuthMiddleware(), http.MethodGet, "/me", "/me", "Bearer "+token); code != http.StatusUnauthorized {
+		t.Errorf("状态码 = %d, want %d", code, http.StatusUnauthorized)
+	}
+}
@@ -0,0 +1,1723 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: protos/assignment.proto
+
+package assignmentpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 创建作业请求消息
+type CreateAssignmentRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	CourseId           uint32                 `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	ChapterId          uint32                 `protobuf:"varint,2,opt,name=chapter_id,json=chapterId,proto3" json:"chapter_id,omitempty"`
+	UserId             uint32                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Title              string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Description        string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	DueAt              string                 `protobuf:"bytes,6,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`                                           // RFC3339格式
+	LatePolicy         string                 `protobuf:"bytes,7,opt,name=late_policy,json=latePolicy,proto3" json:"late_policy,omitempty"`                            // reject/accept/penalty
+	LatePenaltyPercent uint32                 `protobuf:"varint,8,opt,name=late_penalty_percent,json=latePenaltyPercent,proto3" json:"late_penalty_percent,omitempty"` // 每迟交一天扣除的百分比
+	LateCutoffHours    uint32                 `protobuf:"varint,9,opt,name=late_cutoff_hours,json=lateCutoffHours,proto3" json:"late_cutoff_hours,omitempty"`          // 截止后仍可提交的小时数，0表示不限
+	Rubric             []*RubricCriterion     `protobuf:"bytes,10,rep,name=rubric,proto3" json:"rubric,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CreateAssignmentRequest) Reset() {
+	*x = CreateAssignmentRequest{}
+	mi := &file_protos_assignment_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAssignmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAssignmentRequest) ProtoMessage() {}
+
+func (x *CreateAssignmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_assignment_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAssignmentRequest.ProtoReflect.Descriptor instead.
+func (*CreateAssignmentRequest) Descriptor() ([]byte, []int) {
+	return file_protos_assignment_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateAssignmentRequest) GetCourseId() uint32 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *CreateAssignmentRequest) GetChapterId() uint32 {
+	if x != nil {
+		return x.ChapterId
+	}
+	return 0
+}
+
+func (x *CreateAssignmentRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateAssignmentRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateAssignmentRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateAssignmentRequest) GetDueAt() string {
+	if x != nil {
+		return x.DueAt
+	}
+	return ""
+}
+
+func (x *CreateAssignmentRequest) GetLatePolicy() string {
+	if x != nil {
+		return x.LatePolicy
+	}
+	return ""
+}
+
+func (x *CreateAssignmentRequest) GetLatePenaltyPercent() uint32 {
+	if x != nil {
+		return x.LatePenaltyPercent
+	}
+	return 0
+}
+
+func (x *CreateAssignmentRequest) GetLateCutoffHours() uint32 {
+	if x != nil {
+		return x.LateCutoffHours
+	}
+	return 0
+}
+
+func (x *CreateAssignmentRequest) GetRubric() []*RubricCriterion {
+	if x != nil {
+		return x.Rubric
+	}
+	return nil
+}
+
+// 创建作业响应消息
+type CreateAssignmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Assignment    *Assignment            `protobuf:"bytes,3,opt,name=assignment,proto3" json:"assignment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAssignmentResponse) Reset() {
+	*x = CreateAssignmentResponse{}
+	mi := &file_protos_assignment_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAssignmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAssignmentResponse) ProtoMessage() {}
+
+func (x *CreateAssignmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_assignment_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAssignmentResponse.ProtoReflect.Descriptor instead.
+func (*CreateAssignmentResponse) Descriptor() ([]byte, []int) {
+	return file_protos_assignment_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateAssignmentResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *CreateAssignmentResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateAssignmentResponse) GetAssignment() *Assignment {
+	if x != nil {
+		return x.Assignment
+	}
+	return nil
+}
+
+// 获取作业详情请求消息
+type GetAssignmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssignmentId  uint32                 `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAssignmentRequest) Reset() {
+	*x = GetAssignmentRequest{}
+	mi := &file_protos_assignment_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAssignmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAssignmentRequest) ProtoMessage() {}
+
+func (x *GetAssignmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_assignment_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAssignmentRequest.ProtoReflect.Descriptor instead.
+func (*GetAssignmentRequest) Descriptor() ([]byte, []int) {
+	return file_protos_assignment_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetAssignmentRequest) GetAssignmentId() uint32 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+// 获取作业详情响应消息
+type GetAssignmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Assignment    *Assignment            `protobuf:"bytes,3,opt,name=assignment,proto3" json:"assignment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAssignmentResponse) Reset() {
+	*x = GetAssignmentResponse{}
+	mi := &file_protos_assignment_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAssignmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAssignmentResponse) ProtoMessage() {}
+
+func (x *GetAssignmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_assignment_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAssignmentResponse.ProtoReflect.Descriptor instead.
+func (*GetAssignmentResponse) Descriptor() ([]byte, []int) {
+	return file_protos_assignment_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetAssignmentResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetAssignmentResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetAssignmentResponse) GetAssignment() *Assignment {
+	if x != nil {
+		return x.Assignment
+	}
+	return nil
+}
+
+// 获取作业列表请求消息
+type ListAssignmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      uint32                 `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAssignmentsRequest) Reset() {
+	*x = ListAssignmentsRequest{}
+	mi := &file_protos_assignment_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAssignmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAssignmentsRequest) ProtoMessage() {}
+
+func (x *ListAssignmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_assignment_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAssignmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListAssignmentsRequest) Descriptor() ([]byte, []int) {
+	return file_protos_assignment_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListAssignmentsRequest) GetCourseId() uint32 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+// 获取作业列表响应消息
+type ListAssignmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Assignments   []*Assignment          `protobuf:"bytes,3,rep,name=assignments,proto3" json:"assignments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAssignmentsResponse) Reset() {
+	*x = ListAssignmentsResponse{}
+	mi := &file_protos_assignment_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAssignmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAssignmentsResponse) ProtoMessage() {}
+
+func (x *ListAssignmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_assignment_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAssignmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListAssignmentsResponse) Descriptor() ([]byte, []int) {
+	return file_protos_assignment_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListAssignmentsResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListAssignmentsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListAssignmentsResponse) GetAssignments() []*Assignment {
+	if x != nil {
+		return x.Assignments
+	}
+	return nil
+}
+
+// 提交作业请求消息（附件已由内容服务保存）
+type SubmitAssignmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssignmentId  uint32                 `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	FileId        uint32                 `protobuf:"varint,4,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	FileName      string                 `protobuf:"bytes,5,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	FileUrl       string                 `protobuf:"bytes,6,opt,name=file_url,json=fileUrl,proto3" json:"file_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitAssignmentRequest) Reset() {
+	*x = SubmitAssignmentRequest{}
+	mi := &file_protos_assignment_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitAssignmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitAssignmentRequest) ProtoMessage() {}
+
+func (x *SubmitAssignmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_assignment_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitAssignmentRequest.ProtoReflect.Descriptor instead.
+func (*SubmitAssignmentRequest) Descriptor() ([]byte, []int) {
+	return file_protos_assignment_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SubmitAssignmentRequest) GetAssignmentId() uint32 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *SubmitAssignmentRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SubmitAssignmentRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *SubmitAssignmentRequest) GetFileId() uint32 {
+	if x != nil {
+		return x.FileId
+	}
+	return 0
+}
+
+func (x *SubmitAssignmentRequest) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *SubmitAssignmentRequest) GetFileUrl() string {
+	if x != nil {
+		return x.FileUrl
+	}
+	return ""
+}
+
+// 提交作业响应消息
+type SubmitAssignmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Submission    *Submission            `protobuf:"bytes,3,opt,name=submission,proto3" json:"submission,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitAssignmentResponse) Reset() {
+	*x = SubmitAssignmentResponse{}
+	mi := &file_protos_assignment_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitAssignmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitAssignmentResponse) ProtoMessage() {}
+
+func (x *SubmitAssignmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_assignment_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitAssignmentResponse.ProtoReflect.Descriptor instead.
+func (*SubmitAssignmentResponse) Descriptor() ([]byte, []int) {
+	return file_protos_assignment_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SubmitAssignmentResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *SubmitAssignmentResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SubmitAssignmentResponse) GetSubmission() *Submission {
+	if x != nil {
+		return x.Submission
+	}
+	return nil
+}
+
+// 获取本人提交请求消息
+type GetMySubmissionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssignmentId  uint32                 `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMySubmissionRequest) Reset() {
+	*x = GetMySubmissionRequest{}
+	mi := &file_protos_assignment_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMySubmissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMySubmissionRequest) ProtoMessage() {}
+
+func (x *GetMySubmissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_assignment_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMySubmissionRequest.ProtoReflect.Descriptor instead.
+func (*GetMySubmissionRequest) Descriptor() ([]byte, []int) {
+	return file_protos_assignment_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetMySubmissionRequest) GetAssignmentId() uint32 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *GetMySubmissionRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// 获取本人提交响应消息
+type GetMySubmissionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Submission    *Submission            `protobuf:"bytes,3,opt,name=submission,proto3" json:"submission,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMySubmissionResponse) Reset() {
+	*x = GetMySubmissionResponse{}
+	mi := &file_protos_assignment_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMySubmissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMySubmissionResponse) ProtoMessage() {}
+
+func (x *GetMySubmissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_assignment_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMySubmissionResponse.ProtoReflect.Descriptor instead.
+func (*GetMySubmissionResponse) Descriptor() ([]byte, []int) {
+	return file_protos_assignment_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetMySubmissionResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetMySubmissionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetMySubmissionResponse) GetSubmission() *Submission {
+	if x != nil {
+		return x.Submission
+	}
+	return nil
+}
+
+// 获取作业提交列表请求消息
+type ListSubmissionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AssignmentId  uint32                 `protobuf:"varint,1,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // submitted/graded，为空时返回全部
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubmissionsRequest) Reset() {
+	*x = ListSubmissionsRequest{}
+	mi := &file_protos_assignment_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubmissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubmissionsRequest) ProtoMessage() {}
+
+func (x *ListSubmissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_assignment_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubmissionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSubmissionsRequest) Descriptor() ([]byte, []int) {
+	return file_protos_assignment_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListSubmissionsRequest) GetAssignmentId() uint32 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *ListSubmissionsRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListSubmissionsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// 获取作业提交列表响应消息
+type ListSubmissionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Submissions   []*Submission          `protobuf:"bytes,3,rep,name=submissions,proto3" json:"submissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubmissionsResponse) Reset() {
+	*x = ListSubmissionsResponse{}
+	mi := &file_protos_assignment_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubmissionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubmissionsResponse) ProtoMessage() {}
+
+func (x *ListSubmissionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_assignment_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubmissionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSubmissionsResponse) Descriptor() ([]byte, []int) {
+	return file_protos_assignment_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListSubmissionsResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListSubmissionsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListSubmissionsResponse) GetSubmissions() []*Submission {
+	if x != nil {
+		return x.Submissions
+	}
+	return nil
+}
+
+// 获取待批改队列请求消息
+type GetGradingQueueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      uint32                 `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGradingQueueRequest) Reset() {
+	*x = GetGradingQueueRequest{}
+	mi := &file_protos_assignment_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGradingQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGradingQueueRequest) ProtoMessage() {}
+
+func (x *GetGradingQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_assignment_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGradingQueueRequest.ProtoReflect.Descriptor instead.
+func (*GetGradingQueueRequest) Descriptor() ([]byte, []int) {
+	return file_protos_assignment_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetGradingQueueRequest) GetCourseId() uint32 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *GetGradingQueueRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// 获取待批改队列响应消息
+type GetGradingQueueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Submissions   []*Submission          `protobuf:"bytes,3,rep,name=submissions,proto3" json:"submissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGradingQueueResponse) Reset() {
+	*x = GetGradingQueueResponse{}
+	mi := &file_protos_assignment_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGradingQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGradingQueueResponse) ProtoMessage() {}
+
+func (x *GetGradingQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_assignment_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGradingQueueResponse.ProtoReflect.Descriptor instead.
+func (*GetGradingQueueResponse) Descriptor() ([]byte, []int) {
+	return file_protos_assignment_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetGradingQueueResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetGradingQueueResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetGradingQueueResponse) GetSubmissions() []*Submission {
+	if x != nil {
+		return x.Submissions
+	}
+	return nil
+}
+
+// 批改作业请求消息
+type GradeSubmissionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SubmissionId  uint32                 `protobuf:"varint,1,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Scores        []*RubricScore         `protobuf:"bytes,3,rep,name=scores,proto3" json:"scores,omitempty"`
+	Feedback      string                 `protobuf:"bytes,4,opt,name=feedback,proto3" json:"feedback,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GradeSubmissionRequest) Reset() {
+	*x = GradeSubmissionRequest{}
+	mi := &file_protos_assignment_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GradeSubmissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeSubmissionRequest) ProtoMessage() {}
+
+func (x *GradeSubmissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_assignment_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradeSubmissionRequest.ProtoReflect.Descriptor instead.
+func (*GradeSubmissionRequest) Descriptor() ([]byte, []int) {
+	return file_protos_assignment_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GradeSubmissionRequest) GetSubmissionId() uint32 {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return 0
+}
+
+func (x *GradeSubmissionRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GradeSubmissionRequest) GetScores() []*RubricScore {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+func (x *GradeSubmissionRequest) GetFeedback() string {
+	if x != nil {
+		return x.Feedback
+	}
+	return ""
+}
+
+// 批改作业响应消息
+type GradeSubmissionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Submission    *Submission            `protobuf:"bytes,3,opt,name=submission,proto3" json:"submission,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GradeSubmissionResponse) Reset() {
+	*x = GradeSubmissionResponse{}
+	mi := &file_protos_assignment_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GradeSubmissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GradeSubmissionResponse) ProtoMessage() {}
+
+func (x *GradeSubmissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_assignment_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GradeSubmissionResponse.ProtoReflect.Descriptor instead.
+func (*GradeSubmissionResponse) Descriptor() ([]byte, []int) {
+	return file_protos_assignment_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GradeSubmissionResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GradeSubmissionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GradeSubmissionResponse) GetSubmission() *Submission {
+	if x != nil {
+		return x.Submission
+	}
+	return nil
+}
+
+// 评分标准项
+type RubricCriterion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	MaxScore      uint32                 `protobuf:"varint,3,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RubricCriterion) Reset() {
+	*x = RubricCriterion{}
+	mi := &file_protos_assignment_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RubricCriterion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RubricCriterion) ProtoMessage() {}
+
+func (x *RubricCriterion) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_assignment_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RubricCriterion.ProtoReflect.Descriptor instead.
+func (*RubricCriterion) Descriptor() ([]byte, []int) {
+	return file_protos_assignment_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RubricCriterion) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RubricCriterion) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *RubricCriterion) GetMaxScore() uint32 {
+	if x != nil {
+		return x.MaxScore
+	}
+	return 0
+}
+
+// 评分标准得分
+type RubricScore struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Criterion     string                 `protobuf:"bytes,1,opt,name=criterion,proto3" json:"criterion,omitempty"`
+	Score         uint32                 `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
+	Comment       string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RubricScore) Reset() {
+	*x = RubricScore{}
+	mi := &file_protos_assignment_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RubricScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RubricScore) ProtoMessage() {}
+
+func (x *RubricScore) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_assignment_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RubricScore.ProtoReflect.Descriptor instead.
+func (*RubricScore) Descriptor() ([]byte, []int) {
+	return file_protos_assignment_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RubricScore) GetCriterion() string {
+	if x != nil {
+		return x.Criterion
+	}
+	return ""
+}
+
+func (x *RubricScore) GetScore() uint32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *RubricScore) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+// 作业模型
+type Assignment struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CourseId           uint32                 `protobuf:"varint,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	ChapterId          uint32                 `protobuf:"varint,3,opt,name=chapter_id,json=chapterId,proto3" json:"chapter_id,omitempty"`
+	Title              string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Description        string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	DueAt              string                 `protobuf:"bytes,6,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`
+	LatePolicy         string                 `protobuf:"bytes,7,opt,name=late_policy,json=latePolicy,proto3" json:"late_policy,omitempty"`
+	LatePenaltyPercent uint32                 `protobuf:"varint,8,opt,name=late_penalty_percent,json=latePenaltyPercent,proto3" json:"late_penalty_percent,omitempty"`
+	LateCutoffHours    uint32                 `protobuf:"varint,9,opt,name=late_cutoff_hours,json=lateCutoffHours,proto3" json:"late_cutoff_hours,omitempty"`
+	Rubric             []*RubricCriterion     `protobuf:"bytes,10,rep,name=rubric,proto3" json:"rubric,omitempty"`
+	MaxScore           uint32                 `protobuf:"varint,11,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
+	CreatedAt          string                 `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Assignment) Reset() {
+	*x = Assignment{}
+	mi := &file_protos_assignment_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Assignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Assignment) ProtoMessage() {}
+
+func (x *Assignment) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_assignment_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Assignment.ProtoReflect.Descriptor instead.
+func (*Assignment) Descriptor() ([]byte, []int) {
+	return file_protos_assignment_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Assignment) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Assignment) GetCourseId() uint32 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *Assignment) GetChapterId() uint32 {
+	if x != nil {
+		return x.ChapterId
+	}
+	return 0
+}
+
+func (x *Assignment) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Assignment) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Assignment) GetDueAt() string {
+	if x != nil {
+		return x.DueAt
+	}
+	return ""
+}
+
+func (x *Assignment) GetLatePolicy() string {
+	if x != nil {
+		return x.LatePolicy
+	}
+	return ""
+}
+
+func (x *Assignment) GetLatePenaltyPercent() uint32 {
+	if x != nil {
+		return x.LatePenaltyPercent
+	}
+	return 0
+}
+
+func (x *Assignment) GetLateCutoffHours() uint32 {
+	if x != nil {
+		return x.LateCutoffHours
+	}
+	return 0
+}
+
+func (x *Assignment) GetRubric() []*RubricCriterion {
+	if x != nil {
+		return x.Rubric
+	}
+	return nil
+}
+
+func (x *Assignment) GetMaxScore() uint32 {
+	if x != nil {
+		return x.MaxScore
+	}
+	return 0
+}
+
+func (x *Assignment) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// 作业提交模型
+type Submission struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AssignmentId    uint32                 `protobuf:"varint,2,opt,name=assignment_id,json=assignmentId,proto3" json:"assignment_id,omitempty"`
+	UserId          uint32                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Content         string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	FileId          uint32                 `protobuf:"varint,5,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	FileName        string                 `protobuf:"bytes,6,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	FileUrl         string                 `protobuf:"bytes,7,opt,name=file_url,json=fileUrl,proto3" json:"file_url,omitempty"`
+	Status          string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	IsLate          bool                   `protobuf:"varint,9,opt,name=is_late,json=isLate,proto3" json:"is_late,omitempty"`
+	LateDays        uint32                 `protobuf:"varint,10,opt,name=late_days,json=lateDays,proto3" json:"late_days,omitempty"`
+	SubmittedAt     string                 `protobuf:"bytes,11,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+	RawScore        uint32                 `protobuf:"varint,12,opt,name=raw_score,json=rawScore,proto3" json:"raw_score,omitempty"`
+	PenaltyPercent  uint32                 `protobuf:"varint,13,opt,name=penalty_percent,json=penaltyPercent,proto3" json:"penalty_percent,omitempty"`
+	Score           uint32                 `protobuf:"varint,14,opt,name=score,proto3" json:"score,omitempty"`
+	RubricScores    []*RubricScore         `protobuf:"bytes,15,rep,name=rubric_scores,json=rubricScores,proto3" json:"rubric_scores,omitempty"`
+	Feedback        string                 `protobuf:"bytes,16,opt,name=feedback,proto3" json:"feedback,omitempty"`
+	GradedBy        uint32                 `protobuf:"varint,17,opt,name=graded_by,json=gradedBy,proto3" json:"graded_by,omitempty"`
+	GradedAt        string                 `protobuf:"bytes,18,opt,name=graded_at,json=gradedAt,proto3" json:"graded_at,omitempty"`
+	AssignmentTitle string                 `protobuf:"bytes,19,opt,name=assignment_title,json=assignmentTitle,proto3" json:"assignment_title,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Submission) Reset() {
+	*x = Submission{}
+	mi := &file_protos_assignment_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Submission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Submission) ProtoMessage() {}
+
+func (x *Submission) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_assignment_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Submission.ProtoReflect.Descriptor instead.
+func (*Submission) Descriptor() ([]byte, []int) {
+	return file_protos_assignment_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Submission) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Submission) GetAssignmentId() uint32 {
+	if x != nil {
+		return x.AssignmentId
+	}
+	return 0
+}
+
+func (x *Submission) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Submission) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Submission) GetFileId() uint32 {
+	if x != nil {
+		return x.FileId
+	}
+	return 0
+}
+
+func (x *Submission) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *Submission) GetFileUrl() string {
+	if x != nil {
+		return x.FileUrl
+	}
+	return ""
+}
+
+func (x *Submission) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Submission) GetIsLate() bool {
+	if x != nil {
+		return x.IsLate
+	}
+	return false
+}
+
+func (x *Submission) GetLateDays() uint32 {
+	if x != nil {
+		return x.LateDays
+	}
+	return 0
+}
+
+func (x *Submission) GetSubmittedAt() string {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return ""
+}
+
+func (x *Submission) GetRawScore() uint32 {
+	if x != nil {
+		return x.RawScore
+	}
+	return 0
+}
+
+func (x *Submission) GetPenaltyPercent() uint32 {
+	if x != nil {
+		return x.PenaltyPercent
+	}
+	return 0
+}
+
+func (x *Submission) GetScore() uint32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Submission) GetRubricScores() []*RubricScore {
+	if x != nil {
+		return x.RubricScores
+	}
+	return nil
+}
+
+func (x *Submission) GetFeedback() string {
+	if x != nil {
+		return x.Feedback
+	}
+	return ""
+}
+
+func (x *Submission) GetGradedBy() uint32 {
+	if x != nil {
+		return x.GradedBy
+	}
+	return 0
+}
+
+func (x *Submission) GetGradedAt() string {
+	if x != nil {
+		return x.GradedAt
+	}
+	return ""
+}
+
+func (x *Submission) GetAssignmentTitle() string {
+	if x != nil {
+		return x.AssignmentTitle
+	}
+	return ""
+}
+
+var File_protos_assignment_proto protoreflect.FileDescriptor
+
+const file_protos_assignment_proto_rawDesc = "" +
+	"\n" +
+	"\x17protos/assignment.proto\x12\n" +
+	"assignment\"\xf1\x02\n" +
+	"\x17CreateAssignmentRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\rR\bcourseId\x12\x1d\n" +
+	"\n" +
+	"chapter_id\x18\x02 \x01(\rR\tchapterId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\rR\x06userId\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x15\n" +
+	"\x06due_at\x18\x06 \x01(\tR\x05dueAt\x12\x1f\n" +
+	"\vlate_policy\x18\a \x01(\tR\n" +
+	"latePolicy\x120\n" +
+	"\x14late_penalty_percent\x18\b \x01(\rR\x12latePenaltyPercent\x12*\n" +
+	"\x11late_cutoff_hours\x18\t \x01(\rR\x0flateCutoffHours\x123\n" +
+	"\x06rubric\x18\n" +
+	" \x03(\v2\x1b.assignment.RubricCriterionR\x06rubric\"\x80\x01\n" +
+	"\x18CreateAssignmentResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x126\n" +
+	"\n" +
+	"assignment\x18\x03 \x01(\v2\x16.assignment.AssignmentR\n" +
+	"assignment\";\n" +
+	"\x14GetAssignmentRequest\x12#\n" +
+	"\rassignment_id\x18\x01 \x01(\rR\fassignmentId\"}\n" +
+	"\x15GetAssignmentResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x126\n" +
+	"\n" +
+	"assignment\x18\x03 \x01(\v2\x16.assignment.AssignmentR\n" +
+	"assignment\"5\n" +
+	"\x16ListAssignmentsRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\rR\bcourseId\"\x81\x01\n" +
+	"\x17ListAssignmentsResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x128\n" +
+	"\vassignments\x18\x03 \x03(\v2\x16.assignment.AssignmentR\vassignments\"\xc2\x01\n" +
+	"\x17SubmitAssignmentRequest\x12#\n" +
+	"\rassignment_id\x18\x01 \x01(\rR\fassignmentId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x12\x17\n" +
+	"\afile_id\x18\x04 \x01(\rR\x06fileId\x12\x1b\n" +
+	"\tfile_name\x18\x05 \x01(\tR\bfileName\x12\x19\n" +
+	"\bfile_url\x18\x06 \x01(\tR\afileUrl\"\x80\x01\n" +
+	"\x18SubmitAssignmentResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x126\n" +
+	"\n" +
+	"submission\x18\x03 \x01(\v2\x16.assignment.SubmissionR\n" +
+	"submission\"V\n" +
+	"\x16GetMySubmissionRequest\x12#\n" +
+	"\rassignment_id\x18\x01 \x01(\rR\fassignmentId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\"\x7f\n" +
+	"\x17GetMySubmissionResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x126\n" +
+	"\n" +
+	"submission\x18\x03 \x01(\v2\x16.assignment.SubmissionR\n" +
+	"submission\"n\n" +
+	"\x16ListSubmissionsRequest\x12#\n" +
+	"\rassignment_id\x18\x01 \x01(\rR\fassignmentId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\"\x81\x01\n" +
+	"\x17ListSubmissionsResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x128\n" +
+	"\vsubmissions\x18\x03 \x03(\v2\x16.assignment.SubmissionR\vsubmissions\"N\n" +
+	"\x16GetGradingQueueRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\rR\bcourseId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\"\x81\x01\n" +
+	"\x17GetGradingQueueResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x128\n" +
+	"\vsubmissions\x18\x03 \x03(\v2\x16.assignment.SubmissionR\vsubmissions\"\xa3\x01\n" +
+	"\x16GradeSubmissionRequest\x12#\n" +
+	"\rsubmission_id\x18\x01 \x01(\rR\fsubmissionId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\x12/\n" +
+	"\x06scores\x18\x03 \x03(\v2\x17.assignment.RubricScoreR\x06scores\x12\x1a\n" +
+	"\bfeedback\x18\x04 \x01(\tR\bfeedback\"\x7f\n" +
+	"\x17GradeSubmissionResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x126\n" +
+	"\n" +
+	"submission\x18\x03 \x01(\v2\x16.assignment.SubmissionR\n" +
+	"submission\"d\n" +
+	"\x0fRubricCriterion\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1b\n" +
+	"\tmax_score\x18\x03 \x01(\rR\bmaxScore\"[\n" +
+	"\vRubricScore\x12\x1c\n" +
+	"\tcriterion\x18\x01 \x01(\tR\tcriterion\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\rR\x05score\x12\x18\n" +
+	"\acomment\x18\x03 \x01(\tR\acomment\"\x97\x03\n" +
+	"\n" +
+	"Assignment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1b\n" +
+	"\tcourse_id\x18\x02 \x01(\rR\bcourseId\x12\x1d\n" +
+	"\n" +
+	"chapter_id\x18\x03 \x01(\rR\tchapterId\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x15\n" +
+	"\x06due_at\x18\x06 \x01(\tR\x05dueAt\x12\x1f\n" +
+	"\vlate_policy\x18\a \x01(\tR\n" +
+	"latePolicy\x120\n" +
+	"\x14late_penalty_percent\x18\b \x01(\rR\x12latePenaltyPercent\x12*\n" +
+	"\x11late_cutoff_hours\x18\t \x01(\rR\x0flateCutoffHours\x123\n" +
+	"\x06rubric\x18\n" +
+	" \x03(\v2\x1b.assignment.RubricCriterionR\x06rubric\x12\x1b\n" +
+	"\tmax_score\x18\v \x01(\rR\bmaxScore\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\f \x01(\tR\tcreatedAt\"\xd1\x04\n" +
+	"\n" +
+	"Submission\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12#\n" +
+	"\rassignment_id\x18\x02 \x01(\rR\fassignmentId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\rR\x06userId\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x12\x17\n" +
+	"\afile_id\x18\x05 \x01(\rR\x06fileId\x12\x1b\n" +
+	"\tfile_name\x18\x06 \x01(\tR\bfileName\x12\x19\n" +
+	"\bfile_url\x18\a \x01(\tR\afileUrl\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12\x17\n" +
+	"\ais_late\x18\t \x01(\bR\x06isLate\x12\x1b\n" +
+	"\tlate_days\x18\n" +
+	" \x01(\rR\blateDays\x12!\n" +
+	"\fsubmitted_at\x18\v \x01(\tR\vsubmittedAt\x12\x1b\n" +
+	"\traw_score\x18\f \x01(\rR\brawScore\x12'\n" +
+	"\x0fpenalty_percent\x18\r \x01(\rR\x0epenaltyPercent\x12\x14\n" +
+	"\x05score\x18\x0e \x01(\rR\x05score\x12<\n" +
+	"\rrubric_scores\x18\x0f \x03(\v2\x17.assignment.RubricScoreR\frubricScores\x12\x1a\n" +
+	"\bfeedback\x18\x10 \x01(\tR\bfeedback\x12\x1b\n" +
+	"\tgraded_by\x18\x11 \x01(\rR\bgradedBy\x12\x1b\n" +
+	"\tgraded_at\x18\x12 \x01(\tR\bgradedAt\x12)\n" +
+	"\x10assignment_title\x18\x13 \x01(\tR\x0fassignmentTitle2\xf3\x05\n" +
+	"\x11AssignmentService\x12]\n" +
+	"\x10CreateAssignment\x12#.assignment.CreateAssignmentRequest\x1a$.assignment.CreateAssignmentResponse\x12T\n" +
+	"\rGetAssignment\x12 .assignment.GetAssignmentRequest\x1a!.assignment.GetAssignmentResponse\x12Z\n" +
+	"\x0fListAssignments\x12\".assignment.ListAssignmentsRequest\x1a#.assignment.ListAssignmentsResponse\x12]\n" +
+	"\x10SubmitAssignment\x12#.assignment.SubmitAssignmentRequest\x1a$.assignment.SubmitAssignmentResponse\x12Z\n" +
+	"\x0fGetMySubmission\x12\".assignment.GetMySubmissionRequest\x1a#.assignment.GetMySubmissionResponse\x12Z\n" +
+	"\x0fListSubmissions\x12\".assignment.ListSubmissionsRequest\x1a#.assignment.ListSubmissionsResponse\x12Z\n" +
+	"\x0fGetGradingQueue\x12\".assignment.GetGradingQueueRequest\x1a#.assignment.GetGradingQueueResponse\x12Z\n" +
+	"\x0fGradeSubmission\x12\".assignment.GradeSubmissionRequest\x1a#.assignment.GradeSubmissionResponseB1Z/course-platform/internal/shared/pb/assignmentpbb\x06proto3"
+
+var (
+	file_protos_assignment_proto_rawDescOnce sync.Once
+	file_protos_assignment_proto_rawDescData []byte
+)
+
+func file_protos_assignment_proto_rawDescGZIP() []byte {
+	file_protos_assignment_proto_rawDescOnce.Do(func() {
+		file_protos_assignment_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_protos_assignment_proto_rawDesc), len(file_protos_assignment_proto_rawDesc)))
+	})
+	return file_protos_assignment_proto_rawDescData
+}
+
+var file_protos_assignment_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_protos_assignment_proto_goTypes = []any{
+	(*CreateAssignmentRequest)(nil),  // 0: assignment.CreateAssignmentRequest
+	(*CreateAssignmentResponse)(nil), // 1: assignment.CreateAssignmentResponse
+	(*GetAssignmentRequest)(nil),     // 2: assignment.GetAssignmentRequest
+	(*GetAssignmentResponse)(nil),    // 3: assignment.GetAssignmentResponse
+	(*ListAssignmentsRequest)(nil),   // 4: assignment.ListAssignmentsRequest
+	(*ListAssignmentsResponse)(nil),  // 5: assignment.ListAssignmentsResponse
+	(*SubmitAssignmentRequest)(nil),  // 6: assignment.SubmitAssignmentRequest
+	(*SubmitAssignmentResponse)(nil), // 7: assignment.SubmitAssignmentResponse
+	(*GetMySubmissionRequest)(nil),   // 8: assignment.GetMySubmissionRequest
+	(*GetMySubmissionResponse)(nil),  // 9: assignment.GetMySubmissionResponse
+	(*ListSubmissionsRequest)(nil),   // 10: assignment.ListSubmissionsRequest
+	(*ListSubmissionsResponse)(nil),  // 11: assignment.ListSubmissionsResponse
+	(*GetGradingQueueRequest)(nil),   // 12: assignment.GetGradingQueueRequest
+	(*GetGradingQueueResponse)(nil),  // 13: assignment.GetGradingQueueResponse
+	(*GradeSubmissionRequest)(nil),   // 14: assignment.GradeSubmissionRequest
+	(*GradeSubmissionResponse)(nil),  // 15: assignment.GradeSubmissionResponse
+	(*RubricCriterion)(nil),          // 16: assignment.RubricCriterion
+	(*RubricScore)(nil),              // 17: assignment.RubricScore
+	(*Assignment)(nil),               // 18: assignment.Assignment
+	(*Submission)(nil),               // 19: assignment.Submission
+}
+var file_protos_assignment_proto_depIdxs = []int32{
+	16, // 0: assignment.CreateAssignmentRequest.rubric:type_name -> assignment.RubricCriterion
+	18, // 1: assignment.CreateAssignmentResponse.assignment:type_name -> assignment.Assignment
+	18, // 2: assignment.GetAssignmentResponse.assignment:type_name -> assignment.Assignment
+	18, // 3: assignment.ListAssignmentsResponse.assignments:type_name -> assignment.Assignment
+	19, // 4: assignment.SubmitAssignmentResponse.submission:type_name -> assignment.Submission
+	19, // 5: assignment.GetMySubmissionResponse.submission:type_name -> assignment.Submission
+	19, // 6: assignment.ListSubmissionsResponse.submissions:type_name -> assignment.Submission
+	19, // 7: assignment.GetGradingQueueResponse.submissions:type_name -> assignment.Submission
+	17, // 8: assignment.GradeSubmissionRequest.scores:type_name -> assignment.RubricScore
+	19, // 9: assignment.GradeSubmissionResponse.submission:type_name -> assignment.Submission
+	16, // 10: assignment.Assignment.rubric:type_name -> assignment.RubricCriterion
+	17, // 11: assignment.Submission.rubric_scores:type_name -> assignment.RubricScore
+	0,  // 12: assignment.AssignmentService.CreateAssignment:input_type -> assignment.CreateAssignmentRequest
+	2,  // 13: assignment.AssignmentService.GetAssignment:input_type -> assignment.GetAssignmentRequest
+	4,  // 14: assignment.AssignmentService.ListAssignments:input_type -> assignment.ListAssignmentsRequest
+	6,  // 15: assignment.AssignmentService.SubmitAssignment:input_type -> assignment.SubmitAssignmentRequest
+	8,  // 16: assignment.AssignmentService.GetMySubmission:input_type -> assignment.GetMySubmissionRequest
+	10, // 17: assignment.AssignmentService.ListSubmissions:input_type -> assignment.ListSubmissionsRequest
+	12, // 18: assignment.AssignmentService.GetGradingQueue:input_type -> assignment.GetGradingQueueRequest
+	14, // 19: assignment.AssignmentService.GradeSubmission:input_type -> assignment.GradeSubmissionRequest
+	1,  // 20: assignment.AssignmentService.CreateAssignment:output_type -> assignment.CreateAssignmentResponse
+	3,  // 21: assignment.AssignmentService.GetAssignment:output_type -> assignment.GetAssignmentResponse
+	5,  // 22: assignment.AssignmentService.ListAssignments:output_type -> assignment.ListAssignmentsResponse
+	7,  // 23: assignment.AssignmentService.SubmitAssignment:output_type -> assignment.SubmitAssignmentResponse
+	9,  // 24: assignment.AssignmentService.GetMySubmission:output_type -> assignment.GetMySubmissionResponse
+	11, // 25: assignment.AssignmentService.ListSubmissions:output_type -> assignment.ListSubmissionsResponse
+	13, // 26: assignment.AssignmentService.GetGradingQueue:output_type -> assignment.GetGradingQueueResponse
+	15, // 27: assignment.AssignmentService.GradeSubmission:output_type -> assignment.GradeSubmissionResponse
+	20, // [20:28] is the sub-list for method output_type
+	12, // [12:20] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_protos_assignment_proto_init() }
+func file_protos_assignment_proto_init() {
+	if File_protos_assignment_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_protos_assignment_proto_rawDesc), len(file_protos_assignment_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   20,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_protos_assignment_proto_goTypes,
+		DependencyIndexes: file_protos_assignment_proto_depIdxs,
+		MessageInfos:      file_protos_assignment_proto_msgTypes,
+	}.Build()
+	File_protos_assignment_proto = out.File
+	file_protos_assignment_proto_goTypes = nil
+	file_protos_assignment_proto_depIdxs = nil
+}
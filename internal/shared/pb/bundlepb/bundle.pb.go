@@ -0,0 +1,1675 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: protos/bundle.proto
+
+package bundlepb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 套餐中的一门课程，先修课程只能是排在前面的课程
+type BundleStep struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	CourseId              uint32                 `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	PrerequisiteCourseIds []uint32               `protobuf:"varint,2,rep,packed,name=prerequisite_course_ids,json=prerequisiteCourseIds,proto3" json:"prerequisite_course_ids,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *BundleStep) Reset() {
+	*x = BundleStep{}
+	mi := &file_protos_bundle_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BundleStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BundleStep) ProtoMessage() {}
+
+func (x *BundleStep) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_bundle_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BundleStep.ProtoReflect.Descriptor instead.
+func (*BundleStep) Descriptor() ([]byte, []int) {
+	return file_protos_bundle_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BundleStep) GetCourseId() uint32 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *BundleStep) GetPrerequisiteCourseIds() []uint32 {
+	if x != nil {
+		return x.PrerequisiteCourseIds
+	}
+	return nil
+}
+
+// 创建套餐请求消息，steps 的顺序即学习顺序
+type CreateBundleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	CoverImage    string                 `protobuf:"bytes,4,opt,name=cover_image,json=coverImage,proto3" json:"cover_image,omitempty"`
+	Kind          string                 `protobuf:"bytes,5,opt,name=kind,proto3" json:"kind,omitempty"`     // bundle/path
+	Price         float32                `protobuf:"fixed32,6,opt,name=price,proto3" json:"price,omitempty"` // 价格（元）
+	Steps         []*BundleStep          `protobuf:"bytes,7,rep,name=steps,proto3" json:"steps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBundleRequest) Reset() {
+	*x = CreateBundleRequest{}
+	mi := &file_protos_bundle_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBundleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBundleRequest) ProtoMessage() {}
+
+func (x *CreateBundleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_bundle_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBundleRequest.ProtoReflect.Descriptor instead.
+func (*CreateBundleRequest) Descriptor() ([]byte, []int) {
+	return file_protos_bundle_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateBundleRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateBundleRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateBundleRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateBundleRequest) GetCoverImage() string {
+	if x != nil {
+		return x.CoverImage
+	}
+	return ""
+}
+
+func (x *CreateBundleRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CreateBundleRequest) GetPrice() float32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *CreateBundleRequest) GetSteps() []*BundleStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+// 创建套餐响应消息
+type CreateBundleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Bundle        *Bundle                `protobuf:"bytes,3,opt,name=bundle,proto3" json:"bundle,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBundleResponse) Reset() {
+	*x = CreateBundleResponse{}
+	mi := &file_protos_bundle_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBundleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBundleResponse) ProtoMessage() {}
+
+func (x *CreateBundleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_bundle_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBundleResponse.ProtoReflect.Descriptor instead.
+func (*CreateBundleResponse) Descriptor() ([]byte, []int) {
+	return file_protos_bundle_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateBundleResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *CreateBundleResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateBundleResponse) GetBundle() *Bundle {
+	if x != nil {
+		return x.Bundle
+	}
+	return nil
+}
+
+// 更新套餐请求消息，课程列表整体替换
+type UpdateBundleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BundleId      uint32                 `protobuf:"varint,1,opt,name=bundle_id,json=bundleId,proto3" json:"bundle_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	CoverImage    string                 `protobuf:"bytes,5,opt,name=cover_image,json=coverImage,proto3" json:"cover_image,omitempty"`
+	Kind          string                 `protobuf:"bytes,6,opt,name=kind,proto3" json:"kind,omitempty"`
+	Price         float32                `protobuf:"fixed32,7,opt,name=price,proto3" json:"price,omitempty"`
+	Steps         []*BundleStep          `protobuf:"bytes,8,rep,name=steps,proto3" json:"steps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBundleRequest) Reset() {
+	*x = UpdateBundleRequest{}
+	mi := &file_protos_bundle_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBundleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBundleRequest) ProtoMessage() {}
+
+func (x *UpdateBundleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_bundle_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBundleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBundleRequest) Descriptor() ([]byte, []int) {
+	return file_protos_bundle_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateBundleRequest) GetBundleId() uint32 {
+	if x != nil {
+		return x.BundleId
+	}
+	return 0
+}
+
+func (x *UpdateBundleRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateBundleRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateBundleRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateBundleRequest) GetCoverImage() string {
+	if x != nil {
+		return x.CoverImage
+	}
+	return ""
+}
+
+func (x *UpdateBundleRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *UpdateBundleRequest) GetPrice() float32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *UpdateBundleRequest) GetSteps() []*BundleStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+// 更新套餐响应消息
+type UpdateBundleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Bundle        *Bundle                `protobuf:"bytes,3,opt,name=bundle,proto3" json:"bundle,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateBundleResponse) Reset() {
+	*x = UpdateBundleResponse{}
+	mi := &file_protos_bundle_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBundleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBundleResponse) ProtoMessage() {}
+
+func (x *UpdateBundleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_bundle_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBundleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateBundleResponse) Descriptor() ([]byte, []int) {
+	return file_protos_bundle_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateBundleResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *UpdateBundleResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UpdateBundleResponse) GetBundle() *Bundle {
+	if x != nil {
+		return x.Bundle
+	}
+	return nil
+}
+
+// 发布套餐请求消息
+type PublishBundleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BundleId      uint32                 `protobuf:"varint,1,opt,name=bundle_id,json=bundleId,proto3" json:"bundle_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishBundleRequest) Reset() {
+	*x = PublishBundleRequest{}
+	mi := &file_protos_bundle_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishBundleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishBundleRequest) ProtoMessage() {}
+
+func (x *PublishBundleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_bundle_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishBundleRequest.ProtoReflect.Descriptor instead.
+func (*PublishBundleRequest) Descriptor() ([]byte, []int) {
+	return file_protos_bundle_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PublishBundleRequest) GetBundleId() uint32 {
+	if x != nil {
+		return x.BundleId
+	}
+	return 0
+}
+
+func (x *PublishBundleRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// 发布套餐响应消息
+type PublishBundleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Bundle        *Bundle                `protobuf:"bytes,3,opt,name=bundle,proto3" json:"bundle,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishBundleResponse) Reset() {
+	*x = PublishBundleResponse{}
+	mi := &file_protos_bundle_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishBundleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishBundleResponse) ProtoMessage() {}
+
+func (x *PublishBundleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_bundle_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishBundleResponse.ProtoReflect.Descriptor instead.
+func (*PublishBundleResponse) Descriptor() ([]byte, []int) {
+	return file_protos_bundle_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PublishBundleResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *PublishBundleResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PublishBundleResponse) GetBundle() *Bundle {
+	if x != nil {
+		return x.Bundle
+	}
+	return nil
+}
+
+// 获取套餐详情请求消息，user_id 为0表示未登录
+type GetBundleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BundleId      uint32                 `protobuf:"varint,1,opt,name=bundle_id,json=bundleId,proto3" json:"bundle_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBundleRequest) Reset() {
+	*x = GetBundleRequest{}
+	mi := &file_protos_bundle_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBundleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBundleRequest) ProtoMessage() {}
+
+func (x *GetBundleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_bundle_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBundleRequest.ProtoReflect.Descriptor instead.
+func (*GetBundleRequest) Descriptor() ([]byte, []int) {
+	return file_protos_bundle_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetBundleRequest) GetBundleId() uint32 {
+	if x != nil {
+		return x.BundleId
+	}
+	return 0
+}
+
+func (x *GetBundleRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// 获取套餐详情响应消息
+type GetBundleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Bundle        *Bundle                `protobuf:"bytes,3,opt,name=bundle,proto3" json:"bundle,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBundleResponse) Reset() {
+	*x = GetBundleResponse{}
+	mi := &file_protos_bundle_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBundleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBundleResponse) ProtoMessage() {}
+
+func (x *GetBundleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_bundle_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBundleResponse.ProtoReflect.Descriptor instead.
+func (*GetBundleResponse) Descriptor() ([]byte, []int) {
+	return file_protos_bundle_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetBundleResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetBundleResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetBundleResponse) GetBundle() *Bundle {
+	if x != nil {
+		return x.Bundle
+	}
+	return nil
+}
+
+// 获取套餐列表请求消息
+type ListBundlesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          uint32                 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      uint32                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBundlesRequest) Reset() {
+	*x = ListBundlesRequest{}
+	mi := &file_protos_bundle_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBundlesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBundlesRequest) ProtoMessage() {}
+
+func (x *ListBundlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_bundle_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBundlesRequest.ProtoReflect.Descriptor instead.
+func (*ListBundlesRequest) Descriptor() ([]byte, []int) {
+	return file_protos_bundle_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListBundlesRequest) GetPage() uint32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListBundlesRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// 获取套餐列表响应消息
+type ListBundlesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Bundles       []*Bundle              `protobuf:"bytes,3,rep,name=bundles,proto3" json:"bundles,omitempty"`
+	Total         uint32                 `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBundlesResponse) Reset() {
+	*x = ListBundlesResponse{}
+	mi := &file_protos_bundle_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBundlesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBundlesResponse) ProtoMessage() {}
+
+func (x *ListBundlesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_bundle_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBundlesResponse.ProtoReflect.Descriptor instead.
+func (*ListBundlesResponse) Descriptor() ([]byte, []int) {
+	return file_protos_bundle_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListBundlesResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListBundlesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListBundlesResponse) GetBundles() []*Bundle {
+	if x != nil {
+		return x.Bundles
+	}
+	return nil
+}
+
+func (x *ListBundlesResponse) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 获取本人套餐请求消息
+type ListMyBundlesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyBundlesRequest) Reset() {
+	*x = ListMyBundlesRequest{}
+	mi := &file_protos_bundle_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyBundlesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyBundlesRequest) ProtoMessage() {}
+
+func (x *ListMyBundlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_bundle_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyBundlesRequest.ProtoReflect.Descriptor instead.
+func (*ListMyBundlesRequest) Descriptor() ([]byte, []int) {
+	return file_protos_bundle_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListMyBundlesRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// 获取本人套餐响应消息
+type ListMyBundlesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Bundles       []*Bundle              `protobuf:"bytes,3,rep,name=bundles,proto3" json:"bundles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyBundlesResponse) Reset() {
+	*x = ListMyBundlesResponse{}
+	mi := &file_protos_bundle_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyBundlesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyBundlesResponse) ProtoMessage() {}
+
+func (x *ListMyBundlesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_bundle_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyBundlesResponse.ProtoReflect.Descriptor instead.
+func (*ListMyBundlesResponse) Descriptor() ([]byte, []int) {
+	return file_protos_bundle_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListMyBundlesResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListMyBundlesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListMyBundlesResponse) GetBundles() []*Bundle {
+	if x != nil {
+		return x.Bundles
+	}
+	return nil
+}
+
+// 领取免费套餐请求消息
+type EnrollBundleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BundleId      uint32                 `protobuf:"varint,1,opt,name=bundle_id,json=bundleId,proto3" json:"bundle_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrollBundleRequest) Reset() {
+	*x = EnrollBundleRequest{}
+	mi := &file_protos_bundle_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollBundleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollBundleRequest) ProtoMessage() {}
+
+func (x *EnrollBundleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_bundle_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollBundleRequest.ProtoReflect.Descriptor instead.
+func (*EnrollBundleRequest) Descriptor() ([]byte, []int) {
+	return file_protos_bundle_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *EnrollBundleRequest) GetBundleId() uint32 {
+	if x != nil {
+		return x.BundleId
+	}
+	return 0
+}
+
+func (x *EnrollBundleRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// 领取免费套餐响应消息
+type EnrollBundleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrollBundleResponse) Reset() {
+	*x = EnrollBundleResponse{}
+	mi := &file_protos_bundle_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollBundleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollBundleResponse) ProtoMessage() {}
+
+func (x *EnrollBundleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_bundle_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollBundleResponse.ProtoReflect.Descriptor instead.
+func (*EnrollBundleResponse) Descriptor() ([]byte, []int) {
+	return file_protos_bundle_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *EnrollBundleResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *EnrollBundleResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 获取学习路径进度请求消息
+type GetBundleProgressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BundleId      uint32                 `protobuf:"varint,1,opt,name=bundle_id,json=bundleId,proto3" json:"bundle_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBundleProgressRequest) Reset() {
+	*x = GetBundleProgressRequest{}
+	mi := &file_protos_bundle_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBundleProgressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBundleProgressRequest) ProtoMessage() {}
+
+func (x *GetBundleProgressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_bundle_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBundleProgressRequest.ProtoReflect.Descriptor instead.
+func (*GetBundleProgressRequest) Descriptor() ([]byte, []int) {
+	return file_protos_bundle_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetBundleProgressRequest) GetBundleId() uint32 {
+	if x != nil {
+		return x.BundleId
+	}
+	return 0
+}
+
+func (x *GetBundleProgressRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// 获取学习路径进度响应消息
+type GetBundleProgressResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Progress      *PathProgress          `protobuf:"bytes,3,opt,name=progress,proto3" json:"progress,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBundleProgressResponse) Reset() {
+	*x = GetBundleProgressResponse{}
+	mi := &file_protos_bundle_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBundleProgressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBundleProgressResponse) ProtoMessage() {}
+
+func (x *GetBundleProgressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_bundle_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBundleProgressResponse.ProtoReflect.Descriptor instead.
+func (*GetBundleProgressResponse) Descriptor() ([]byte, []int) {
+	return file_protos_bundle_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetBundleProgressResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetBundleProgressResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetBundleProgressResponse) GetProgress() *PathProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+// 课程套餐或学习路径
+type Bundle struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	CoverImage    string                 `protobuf:"bytes,4,opt,name=cover_image,json=coverImage,proto3" json:"cover_image,omitempty"`
+	Kind          string                 `protobuf:"bytes,5,opt,name=kind,proto3" json:"kind,omitempty"` // bundle/path
+	CreatorId     uint32                 `protobuf:"varint,6,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	Price         float32                `protobuf:"fixed32,7,opt,name=price,proto3" json:"price,omitempty"`                                   // 套餐价格（元）
+	CoursesPrice  float32                `protobuf:"fixed32,8,opt,name=courses_price,json=coursesPrice,proto3" json:"courses_price,omitempty"` // 单独购买全部课程的价格（元）
+	Status        string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`                                   // draft/published
+	Courses       []*BundleCourse        `protobuf:"bytes,10,rep,name=courses,proto3" json:"courses,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Bundle) Reset() {
+	*x = Bundle{}
+	mi := &file_protos_bundle_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Bundle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Bundle) ProtoMessage() {}
+
+func (x *Bundle) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_bundle_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Bundle.ProtoReflect.Descriptor instead.
+func (*Bundle) Descriptor() ([]byte, []int) {
+	return file_protos_bundle_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Bundle) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Bundle) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Bundle) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Bundle) GetCoverImage() string {
+	if x != nil {
+		return x.CoverImage
+	}
+	return ""
+}
+
+func (x *Bundle) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Bundle) GetCreatorId() uint32 {
+	if x != nil {
+		return x.CreatorId
+	}
+	return 0
+}
+
+func (x *Bundle) GetPrice() float32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Bundle) GetCoursesPrice() float32 {
+	if x != nil {
+		return x.CoursesPrice
+	}
+	return 0
+}
+
+func (x *Bundle) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Bundle) GetCourses() []*BundleCourse {
+	if x != nil {
+		return x.Courses
+	}
+	return nil
+}
+
+func (x *Bundle) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// 套餐中的课程
+type BundleCourse struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	CourseId              uint32                 `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	CourseTitle           string                 `protobuf:"bytes,2,opt,name=course_title,json=courseTitle,proto3" json:"course_title,omitempty"`
+	CoursePrice           float32                `protobuf:"fixed32,3,opt,name=course_price,json=coursePrice,proto3" json:"course_price,omitempty"`
+	Position              int32                  `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
+	PrerequisiteCourseIds []uint32               `protobuf:"varint,5,rep,packed,name=prerequisite_course_ids,json=prerequisiteCourseIds,proto3" json:"prerequisite_course_ids,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *BundleCourse) Reset() {
+	*x = BundleCourse{}
+	mi := &file_protos_bundle_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BundleCourse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BundleCourse) ProtoMessage() {}
+
+func (x *BundleCourse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_bundle_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BundleCourse.ProtoReflect.Descriptor instead.
+func (*BundleCourse) Descriptor() ([]byte, []int) {
+	return file_protos_bundle_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *BundleCourse) GetCourseId() uint32 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *BundleCourse) GetCourseTitle() string {
+	if x != nil {
+		return x.CourseTitle
+	}
+	return ""
+}
+
+func (x *BundleCourse) GetCoursePrice() float32 {
+	if x != nil {
+		return x.CoursePrice
+	}
+	return 0
+}
+
+func (x *BundleCourse) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *BundleCourse) GetPrerequisiteCourseIds() []uint32 {
+	if x != nil {
+		return x.PrerequisiteCourseIds
+	}
+	return nil
+}
+
+// 学习路径进度，percent 为已学完课程数占比
+type PathProgress struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	BundleId         uint32                 `protobuf:"varint,1,opt,name=bundle_id,json=bundleId,proto3" json:"bundle_id,omitempty"`
+	Enrolled         bool                   `protobuf:"varint,2,opt,name=enrolled,proto3" json:"enrolled,omitempty"`
+	CompletedCourses int32                  `protobuf:"varint,3,opt,name=completed_courses,json=completedCourses,proto3" json:"completed_courses,omitempty"`
+	TotalCourses     int32                  `protobuf:"varint,4,opt,name=total_courses,json=totalCourses,proto3" json:"total_courses,omitempty"`
+	Percent          int32                  `protobuf:"varint,5,opt,name=percent,proto3" json:"percent,omitempty"`
+	Steps            []*StepProgress        `protobuf:"bytes,6,rep,name=steps,proto3" json:"steps,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PathProgress) Reset() {
+	*x = PathProgress{}
+	mi := &file_protos_bundle_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PathProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PathProgress) ProtoMessage() {}
+
+func (x *PathProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_bundle_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PathProgress.ProtoReflect.Descriptor instead.
+func (*PathProgress) Descriptor() ([]byte, []int) {
+	return file_protos_bundle_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PathProgress) GetBundleId() uint32 {
+	if x != nil {
+		return x.BundleId
+	}
+	return 0
+}
+
+func (x *PathProgress) GetEnrolled() bool {
+	if x != nil {
+		return x.Enrolled
+	}
+	return false
+}
+
+func (x *PathProgress) GetCompletedCourses() int32 {
+	if x != nil {
+		return x.CompletedCourses
+	}
+	return 0
+}
+
+func (x *PathProgress) GetTotalCourses() int32 {
+	if x != nil {
+		return x.TotalCourses
+	}
+	return 0
+}
+
+func (x *PathProgress) GetPercent() int32 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *PathProgress) GetSteps() []*StepProgress {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+// 单个步骤的学习进度
+type StepProgress struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	CourseId              uint32                 `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	CourseTitle           string                 `protobuf:"bytes,2,opt,name=course_title,json=courseTitle,proto3" json:"course_title,omitempty"`
+	Position              int32                  `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	PrerequisiteCourseIds []uint32               `protobuf:"varint,4,rep,packed,name=prerequisite_course_ids,json=prerequisiteCourseIds,proto3" json:"prerequisite_course_ids,omitempty"`
+	CompletedChapters     int32                  `protobuf:"varint,5,opt,name=completed_chapters,json=completedChapters,proto3" json:"completed_chapters,omitempty"`
+	TotalChapters         int32                  `protobuf:"varint,6,opt,name=total_chapters,json=totalChapters,proto3" json:"total_chapters,omitempty"`
+	Percent               int32                  `protobuf:"varint,7,opt,name=percent,proto3" json:"percent,omitempty"`
+	Status                string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"` // locked/available/in_progress/completed
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *StepProgress) Reset() {
+	*x = StepProgress{}
+	mi := &file_protos_bundle_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StepProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StepProgress) ProtoMessage() {}
+
+func (x *StepProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_bundle_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StepProgress.ProtoReflect.Descriptor instead.
+func (*StepProgress) Descriptor() ([]byte, []int) {
+	return file_protos_bundle_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *StepProgress) GetCourseId() uint32 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *StepProgress) GetCourseTitle() string {
+	if x != nil {
+		return x.CourseTitle
+	}
+	return ""
+}
+
+func (x *StepProgress) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *StepProgress) GetPrerequisiteCourseIds() []uint32 {
+	if x != nil {
+		return x.PrerequisiteCourseIds
+	}
+	return nil
+}
+
+func (x *StepProgress) GetCompletedChapters() int32 {
+	if x != nil {
+		return x.CompletedChapters
+	}
+	return 0
+}
+
+func (x *StepProgress) GetTotalChapters() int32 {
+	if x != nil {
+		return x.TotalChapters
+	}
+	return 0
+}
+
+func (x *StepProgress) GetPercent() int32 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *StepProgress) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+var File_protos_bundle_proto protoreflect.FileDescriptor
+
+const file_protos_bundle_proto_rawDesc = "" +
+	"\n" +
+	"\x13protos/bundle.proto\x12\x06bundle\"a\n" +
+	"\n" +
+	"BundleStep\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\rR\bcourseId\x126\n" +
+	"\x17prerequisite_course_ids\x18\x02 \x03(\rR\x15prerequisiteCourseIds\"\xdb\x01\n" +
+	"\x13CreateBundleRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1f\n" +
+	"\vcover_image\x18\x04 \x01(\tR\n" +
+	"coverImage\x12\x12\n" +
+	"\x04kind\x18\x05 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05price\x18\x06 \x01(\x02R\x05price\x12(\n" +
+	"\x05steps\x18\a \x03(\v2\x12.bundle.BundleStepR\x05steps\"l\n" +
+	"\x14CreateBundleResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12&\n" +
+	"\x06bundle\x18\x03 \x01(\v2\x0e.bundle.BundleR\x06bundle\"\xf8\x01\n" +
+	"\x13UpdateBundleRequest\x12\x1b\n" +
+	"\tbundle_id\x18\x01 \x01(\rR\bbundleId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1f\n" +
+	"\vcover_image\x18\x05 \x01(\tR\n" +
+	"coverImage\x12\x12\n" +
+	"\x04kind\x18\x06 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05price\x18\a \x01(\x02R\x05price\x12(\n" +
+	"\x05steps\x18\b \x03(\v2\x12.bundle.BundleStepR\x05steps\"l\n" +
+	"\x14UpdateBundleResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12&\n" +
+	"\x06bundle\x18\x03 \x01(\v2\x0e.bundle.BundleR\x06bundle\"L\n" +
+	"\x14PublishBundleRequest\x12\x1b\n" +
+	"\tbundle_id\x18\x01 \x01(\rR\bbundleId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\"m\n" +
+	"\x15PublishBundleResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12&\n" +
+	"\x06bundle\x18\x03 \x01(\v2\x0e.bundle.BundleR\x06bundle\"H\n" +
+	"\x10GetBundleRequest\x12\x1b\n" +
+	"\tbundle_id\x18\x01 \x01(\rR\bbundleId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\"i\n" +
+	"\x11GetBundleResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12&\n" +
+	"\x06bundle\x18\x03 \x01(\v2\x0e.bundle.BundleR\x06bundle\"E\n" +
+	"\x12ListBundlesRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\rR\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\rR\bpageSize\"\x83\x01\n" +
+	"\x13ListBundlesResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12(\n" +
+	"\abundles\x18\x03 \x03(\v2\x0e.bundle.BundleR\abundles\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\rR\x05total\"/\n" +
+	"\x14ListMyBundlesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\"o\n" +
+	"\x15ListMyBundlesResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12(\n" +
+	"\abundles\x18\x03 \x03(\v2\x0e.bundle.BundleR\abundles\"K\n" +
+	"\x13EnrollBundleRequest\x12\x1b\n" +
+	"\tbundle_id\x18\x01 \x01(\rR\bbundleId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\"D\n" +
+	"\x14EnrollBundleResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"P\n" +
+	"\x18GetBundleProgressRequest\x12\x1b\n" +
+	"\tbundle_id\x18\x01 \x01(\rR\bbundleId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\"{\n" +
+	"\x19GetBundleProgressResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x120\n" +
+	"\bprogress\x18\x03 \x01(\v2\x14.bundle.PathProgressR\bprogress\"\xc6\x02\n" +
+	"\x06Bundle\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1f\n" +
+	"\vcover_image\x18\x04 \x01(\tR\n" +
+	"coverImage\x12\x12\n" +
+	"\x04kind\x18\x05 \x01(\tR\x04kind\x12\x1d\n" +
+	"\n" +
+	"creator_id\x18\x06 \x01(\rR\tcreatorId\x12\x14\n" +
+	"\x05price\x18\a \x01(\x02R\x05price\x12#\n" +
+	"\rcourses_price\x18\b \x01(\x02R\fcoursesPrice\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\x12.\n" +
+	"\acourses\x18\n" +
+	" \x03(\v2\x14.bundle.BundleCourseR\acourses\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\tR\tcreatedAt\"\xc5\x01\n" +
+	"\fBundleCourse\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\rR\bcourseId\x12!\n" +
+	"\fcourse_title\x18\x02 \x01(\tR\vcourseTitle\x12!\n" +
+	"\fcourse_price\x18\x03 \x01(\x02R\vcoursePrice\x12\x1a\n" +
+	"\bposition\x18\x04 \x01(\x05R\bposition\x126\n" +
+	"\x17prerequisite_course_ids\x18\x05 \x03(\rR\x15prerequisiteCourseIds\"\xdf\x01\n" +
+	"\fPathProgress\x12\x1b\n" +
+	"\tbundle_id\x18\x01 \x01(\rR\bbundleId\x12\x1a\n" +
+	"\benrolled\x18\x02 \x01(\bR\benrolled\x12+\n" +
+	"\x11completed_courses\x18\x03 \x01(\x05R\x10completedCourses\x12#\n" +
+	"\rtotal_courses\x18\x04 \x01(\x05R\ftotalCourses\x12\x18\n" +
+	"\apercent\x18\x05 \x01(\x05R\apercent\x12*\n" +
+	"\x05steps\x18\x06 \x03(\v2\x14.bundle.StepProgressR\x05steps\"\xaa\x02\n" +
+	"\fStepProgress\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\rR\bcourseId\x12!\n" +
+	"\fcourse_title\x18\x02 \x01(\tR\vcourseTitle\x12\x1a\n" +
+	"\bposition\x18\x03 \x01(\x05R\bposition\x126\n" +
+	"\x17prerequisite_course_ids\x18\x04 \x03(\rR\x15prerequisiteCourseIds\x12-\n" +
+	"\x12completed_chapters\x18\x05 \x01(\x05R\x11completedChapters\x12%\n" +
+	"\x0etotal_chapters\x18\x06 \x01(\x05R\rtotalChapters\x12\x18\n" +
+	"\apercent\x18\a \x01(\x05R\apercent\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status2\xf0\x04\n" +
+	"\rBundleService\x12I\n" +
+	"\fCreateBundle\x12\x1b.bundle.CreateBundleRequest\x1a\x1c.bundle.CreateBundleResponse\x12I\n" +
+	"\fUpdateBundle\x12\x1b.bundle.UpdateBundleRequest\x1a\x1c.bundle.UpdateBundleResponse\x12L\n" +
+	"\rPublishBundle\x12\x1c.bundle.PublishBundleRequest\x1a\x1d.bundle.PublishBundleResponse\x12@\n" +
+	"\tGetBundle\x12\x18.bundle.GetBundleRequest\x1a\x19.bundle.GetBundleResponse\x12F\n" +
+	"\vListBundles\x12\x1a.bundle.ListBundlesRequest\x1a\x1b.bundle.ListBundlesResponse\x12L\n" +
+	"\rListMyBundles\x12\x1c.bundle.ListMyBundlesRequest\x1a\x1d.bundle.ListMyBundlesResponse\x12I\n" +
+	"\fEnrollBundle\x12\x1b.bundle.EnrollBundleRequest\x1a\x1c.bundle.EnrollBundleResponse\x12X\n" +
+	"\x11GetBundleProgress\x12 .bundle.GetBundleProgressRequest\x1a!.bundle.GetBundleProgressResponseB-Z+course-platform/internal/shared/pb/bundlepbb\x06proto3"
+
+var (
+	file_protos_bundle_proto_rawDescOnce sync.Once
+	file_protos_bundle_proto_rawDescData []byte
+)
+
+func file_protos_bundle_proto_rawDescGZIP() []byte {
+	file_protos_bundle_proto_rawDescOnce.Do(func() {
+		file_protos_bundle_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_protos_bundle_proto_rawDesc), len(file_protos_bundle_proto_rawDesc)))
+	})
+	return file_protos_bundle_proto_rawDescData
+}
+
+var file_protos_bundle_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_protos_bundle_proto_goTypes = []any{
+	(*BundleStep)(nil),                // 0: bundle.BundleStep
+	(*CreateBundleRequest)(nil),       // 1: bundle.CreateBundleRequest
+	(*CreateBundleResponse)(nil),      // 2: bundle.CreateBundleResponse
+	(*UpdateBundleRequest)(nil),       // 3: bundle.UpdateBundleRequest
+	(*UpdateBundleResponse)(nil),      // 4: bundle.UpdateBundleResponse
+	(*PublishBundleRequest)(nil),      // 5: bundle.PublishBundleRequest
+	(*PublishBundleResponse)(nil),     // 6: bundle.PublishBundleResponse
+	(*GetBundleRequest)(nil),          // 7: bundle.GetBundleRequest
+	(*GetBundleResponse)(nil),         // 8: bundle.GetBundleResponse
+	(*ListBundlesRequest)(nil),        // 9: bundle.ListBundlesRequest
+	(*ListBundlesResponse)(nil),       // 10: bundle.ListBundlesResponse
+	(*ListMyBundlesRequest)(nil),      // 11: bundle.ListMyBundlesRequest
+	(*ListMyBundlesResponse)(nil),     // 12: bundle.ListMyBundlesResponse
+	(*EnrollBundleRequest)(nil),       // 13: bundle.EnrollBundleRequest
+	(*EnrollBundleResponse)(nil),      // 14: bundle.EnrollBundleResponse
+	(*GetBundleProgressRequest)(nil),  // 15: bundle.GetBundleProgressRequest
+	(*GetBundleProgressResponse)(nil), // 16: bundle.GetBundleProgressResponse
+	(*Bundle)(nil),                    // 17: bundle.Bundle
+	(*BundleCourse)(nil),              // 18: bundle.BundleCourse
+	(*PathProgress)(nil),              // 19: bundle.PathProgress
+	(*StepProgress)(nil),              // 20: bundle.StepProgress
+}
+var file_protos_bundle_proto_depIdxs = []int32{
+	0,  // 0: bundle.CreateBundleRequest.steps:type_name -> bundle.BundleStep
+	17, // 1: bundle.CreateBundleResponse.bundle:type_name -> bundle.Bundle
+	0,  // 2: bundle.UpdateBundleRequest.steps:type_name -> bundle.BundleStep
+	17, // 3: bundle.UpdateBundleResponse.bundle:type_name -> bundle.Bundle
+	17, // 4: bundle.PublishBundleResponse.bundle:type_name -> bundle.Bundle
+	17, // 5: bundle.GetBundleResponse.bundle:type_name -> bundle.Bundle
+	17, // 6: bundle.ListBundlesResponse.bundles:type_name -> bundle.Bundle
+	17, // 7: bundle.ListMyBundlesResponse.bundles:type_name -> bundle.Bundle
+	19, // 8: bundle.GetBundleProgressResponse.progress:type_name -> bundle.PathProgress
+	18, // 9: bundle.Bundle.courses:type_name -> bundle.BundleCourse
+	20, // 10: bundle.PathProgress.steps:type_name -> bundle.StepProgress
+	1,  // 11: bundle.BundleService.CreateBundle:input_type -> bundle.CreateBundleRequest
+	3,  // 12: bundle.BundleService.UpdateBundle:input_type -> bundle.UpdateBundleRequest
+	5,  // 13: bundle.BundleService.PublishBundle:input_type -> bundle.PublishBundleRequest
+	7,  // 14: bundle.BundleService.GetBundle:input_type -> bundle.GetBundleRequest
+	9,  // 15: bundle.BundleService.ListBundles:input_type -> bundle.ListBundlesRequest
+	11, // 16: bundle.BundleService.ListMyBundles:input_type -> bundle.ListMyBundlesRequest
+	13, // 17: bundle.BundleService.EnrollBundle:input_type -> bundle.EnrollBundleRequest
+	15, // 18: bundle.BundleService.GetBundleProgress:input_type -> bundle.GetBundleProgressRequest
+	2,  // 19: bundle.BundleService.CreateBundle:output_type -> bundle.CreateBundleResponse
+	4,  // 20: bundle.BundleService.UpdateBundle:output_type -> bundle.UpdateBundleResponse
+	6,  // 21: bundle.BundleService.PublishBundle:output_type -> bundle.PublishBundleResponse
+	8,  // 22: bundle.BundleService.GetBundle:output_type -> bundle.GetBundleResponse
+	10, // 23: bundle.BundleService.ListBundles:output_type -> bundle.ListBundlesResponse
+	12, // 24: bundle.BundleService.ListMyBundles:output_type -> bundle.ListMyBundlesResponse
+	14, // 25: bundle.BundleService.EnrollBundle:output_type -> bundle.EnrollBundleResponse
+	16, // 26: bundle.BundleService.GetBundleProgress:output_type -> bundle.GetBundleProgressResponse
+	19, // [19:27] is the sub-list for method output_type
+	11, // [11:19] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_protos_bundle_proto_init() }
+func file_protos_bundle_proto_init() {
+	if File_protos_bundle_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_protos_bundle_proto_rawDesc), len(file_protos_bundle_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   21,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_protos_bundle_proto_goTypes,
+		DependencyIndexes: file_protos_bundle_proto_depIdxs,
+		MessageInfos:      file_protos_bundle_proto_msgTypes,
+	}.Build()
+	File_protos_bundle_proto = out.File
+	file_protos_bundle_proto_goTypes = nil
+	file_protos_bundle_proto_depIdxs = nil
+}
@@ -0,0 +1,815 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: protos/certificate.proto
+
+package certificatepb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 获取证书请求消息
+type GetCertificateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCertificateRequest) Reset() {
+	*x = GetCertificateRequest{}
+	mi := &file_protos_certificate_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCertificateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCertificateRequest) ProtoMessage() {}
+
+func (x *GetCertificateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_certificate_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCertificateRequest.ProtoReflect.Descriptor instead.
+func (*GetCertificateRequest) Descriptor() ([]byte, []int) {
+	return file_protos_certificate_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetCertificateRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// 获取证书响应消息
+type GetCertificateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Certificate   *Certificate           `protobuf:"bytes,3,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCertificateResponse) Reset() {
+	*x = GetCertificateResponse{}
+	mi := &file_protos_certificate_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCertificateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCertificateResponse) ProtoMessage() {}
+
+func (x *GetCertificateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_certificate_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCertificateResponse.ProtoReflect.Descriptor instead.
+func (*GetCertificateResponse) Descriptor() ([]byte, []int) {
+	return file_protos_certificate_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetCertificateResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetCertificateResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetCertificateResponse) GetCertificate() *Certificate {
+	if x != nil {
+		return x.Certificate
+	}
+	return nil
+}
+
+// 获取本人证书请求消息
+type ListMyCertificatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyCertificatesRequest) Reset() {
+	*x = ListMyCertificatesRequest{}
+	mi := &file_protos_certificate_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyCertificatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyCertificatesRequest) ProtoMessage() {}
+
+func (x *ListMyCertificatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_certificate_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyCertificatesRequest.ProtoReflect.Descriptor instead.
+func (*ListMyCertificatesRequest) Descriptor() ([]byte, []int) {
+	return file_protos_certificate_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListMyCertificatesRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// 获取本人证书响应消息
+type ListMyCertificatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Certificates  []*Certificate         `protobuf:"bytes,3,rep,name=certificates,proto3" json:"certificates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyCertificatesResponse) Reset() {
+	*x = ListMyCertificatesResponse{}
+	mi := &file_protos_certificate_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyCertificatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyCertificatesResponse) ProtoMessage() {}
+
+func (x *ListMyCertificatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_certificate_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyCertificatesResponse.ProtoReflect.Descriptor instead.
+func (*ListMyCertificatesResponse) Descriptor() ([]byte, []int) {
+	return file_protos_certificate_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListMyCertificatesResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListMyCertificatesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListMyCertificatesResponse) GetCertificates() []*Certificate {
+	if x != nil {
+		return x.Certificates
+	}
+	return nil
+}
+
+// 获取证书模板请求消息
+type GetCertificateTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      uint32                 `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCertificateTemplateRequest) Reset() {
+	*x = GetCertificateTemplateRequest{}
+	mi := &file_protos_certificate_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCertificateTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCertificateTemplateRequest) ProtoMessage() {}
+
+func (x *GetCertificateTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_certificate_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCertificateTemplateRequest.ProtoReflect.Descriptor instead.
+func (*GetCertificateTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_protos_certificate_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetCertificateTemplateRequest) GetCourseId() uint32 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+// 获取证书模板响应消息
+type GetCertificateTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Template      *CertificateTemplate   `protobuf:"bytes,3,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCertificateTemplateResponse) Reset() {
+	*x = GetCertificateTemplateResponse{}
+	mi := &file_protos_certificate_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCertificateTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCertificateTemplateResponse) ProtoMessage() {}
+
+func (x *GetCertificateTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_certificate_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCertificateTemplateResponse.ProtoReflect.Descriptor instead.
+func (*GetCertificateTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_protos_certificate_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetCertificateTemplateResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetCertificateTemplateResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetCertificateTemplateResponse) GetTemplate() *CertificateTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+// 保存证书模板请求消息
+type SaveCertificateTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      uint32                 `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Heading       string                 `protobuf:"bytes,3,opt,name=heading,proto3" json:"heading,omitempty"`
+	Body          string                 `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"` // 支持占位符 {{student}} {{course}} {{instructor}} {{date}}
+	Footer        string                 `protobuf:"bytes,5,opt,name=footer,proto3" json:"footer,omitempty"`
+	AccentColor   string                 `protobuf:"bytes,6,opt,name=accent_color,json=accentColor,proto3" json:"accent_color,omitempty"` // #RRGGBB
+	Layout        string                 `protobuf:"bytes,7,opt,name=layout,proto3" json:"layout,omitempty"`                              // classic/modern
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveCertificateTemplateRequest) Reset() {
+	*x = SaveCertificateTemplateRequest{}
+	mi := &file_protos_certificate_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveCertificateTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveCertificateTemplateRequest) ProtoMessage() {}
+
+func (x *SaveCertificateTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_certificate_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveCertificateTemplateRequest.ProtoReflect.Descriptor instead.
+func (*SaveCertificateTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_protos_certificate_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SaveCertificateTemplateRequest) GetCourseId() uint32 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *SaveCertificateTemplateRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SaveCertificateTemplateRequest) GetHeading() string {
+	if x != nil {
+		return x.Heading
+	}
+	return ""
+}
+
+func (x *SaveCertificateTemplateRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *SaveCertificateTemplateRequest) GetFooter() string {
+	if x != nil {
+		return x.Footer
+	}
+	return ""
+}
+
+func (x *SaveCertificateTemplateRequest) GetAccentColor() string {
+	if x != nil {
+		return x.AccentColor
+	}
+	return ""
+}
+
+func (x *SaveCertificateTemplateRequest) GetLayout() string {
+	if x != nil {
+		return x.Layout
+	}
+	return ""
+}
+
+// 保存证书模板响应消息
+type SaveCertificateTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Template      *CertificateTemplate   `protobuf:"bytes,3,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveCertificateTemplateResponse) Reset() {
+	*x = SaveCertificateTemplateResponse{}
+	mi := &file_protos_certificate_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveCertificateTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveCertificateTemplateResponse) ProtoMessage() {}
+
+func (x *SaveCertificateTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_certificate_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveCertificateTemplateResponse.ProtoReflect.Descriptor instead.
+func (*SaveCertificateTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_protos_certificate_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SaveCertificateTemplateResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *SaveCertificateTemplateResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SaveCertificateTemplateResponse) GetTemplate() *CertificateTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+// 证书模型
+type Certificate struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code           string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	UserId         uint32                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CourseId       uint32                 `protobuf:"varint,4,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	StudentName    string                 `protobuf:"bytes,5,opt,name=student_name,json=studentName,proto3" json:"student_name,omitempty"`
+	CourseTitle    string                 `protobuf:"bytes,6,opt,name=course_title,json=courseTitle,proto3" json:"course_title,omitempty"`
+	InstructorName string                 `protobuf:"bytes,7,opt,name=instructor_name,json=instructorName,proto3" json:"instructor_name,omitempty"`
+	IssuedAt       string                 `protobuf:"bytes,8,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`
+	FileUrl        string                 `protobuf:"bytes,9,opt,name=file_url,json=fileUrl,proto3" json:"file_url,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Certificate) Reset() {
+	*x = Certificate{}
+	mi := &file_protos_certificate_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Certificate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Certificate) ProtoMessage() {}
+
+func (x *Certificate) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_certificate_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Certificate.ProtoReflect.Descriptor instead.
+func (*Certificate) Descriptor() ([]byte, []int) {
+	return file_protos_certificate_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Certificate) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Certificate) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Certificate) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Certificate) GetCourseId() uint32 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *Certificate) GetStudentName() string {
+	if x != nil {
+		return x.StudentName
+	}
+	return ""
+}
+
+func (x *Certificate) GetCourseTitle() string {
+	if x != nil {
+		return x.CourseTitle
+	}
+	return ""
+}
+
+func (x *Certificate) GetInstructorName() string {
+	if x != nil {
+		return x.InstructorName
+	}
+	return ""
+}
+
+func (x *Certificate) GetIssuedAt() string {
+	if x != nil {
+		return x.IssuedAt
+	}
+	return ""
+}
+
+func (x *Certificate) GetFileUrl() string {
+	if x != nil {
+		return x.FileUrl
+	}
+	return ""
+}
+
+// 证书模板模型
+type CertificateTemplate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      uint32                 `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Heading       string                 `protobuf:"bytes,2,opt,name=heading,proto3" json:"heading,omitempty"`
+	Body          string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	Footer        string                 `protobuf:"bytes,4,opt,name=footer,proto3" json:"footer,omitempty"`
+	AccentColor   string                 `protobuf:"bytes,5,opt,name=accent_color,json=accentColor,proto3" json:"accent_color,omitempty"`
+	Layout        string                 `protobuf:"bytes,6,opt,name=layout,proto3" json:"layout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CertificateTemplate) Reset() {
+	*x = CertificateTemplate{}
+	mi := &file_protos_certificate_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CertificateTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertificateTemplate) ProtoMessage() {}
+
+func (x *CertificateTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_certificate_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertificateTemplate.ProtoReflect.Descriptor instead.
+func (*CertificateTemplate) Descriptor() ([]byte, []int) {
+	return file_protos_certificate_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CertificateTemplate) GetCourseId() uint32 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *CertificateTemplate) GetHeading() string {
+	if x != nil {
+		return x.Heading
+	}
+	return ""
+}
+
+func (x *CertificateTemplate) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *CertificateTemplate) GetFooter() string {
+	if x != nil {
+		return x.Footer
+	}
+	return ""
+}
+
+func (x *CertificateTemplate) GetAccentColor() string {
+	if x != nil {
+		return x.AccentColor
+	}
+	return ""
+}
+
+func (x *CertificateTemplate) GetLayout() string {
+	if x != nil {
+		return x.Layout
+	}
+	return ""
+}
+
+var File_protos_certificate_proto protoreflect.FileDescriptor
+
+const file_protos_certificate_proto_rawDesc = "" +
+	"\n" +
+	"\x18protos/certificate.proto\x12\vcertificate\"+\n" +
+	"\x15GetCertificateRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"\x82\x01\n" +
+	"\x16GetCertificateResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12:\n" +
+	"\vcertificate\x18\x03 \x01(\v2\x18.certificate.CertificateR\vcertificate\"4\n" +
+	"\x19ListMyCertificatesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\"\x88\x01\n" +
+	"\x1aListMyCertificatesResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12<\n" +
+	"\fcertificates\x18\x03 \x03(\v2\x18.certificate.CertificateR\fcertificates\"<\n" +
+	"\x1dGetCertificateTemplateRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\rR\bcourseId\"\x8c\x01\n" +
+	"\x1eGetCertificateTemplateResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12<\n" +
+	"\btemplate\x18\x03 \x01(\v2 .certificate.CertificateTemplateR\btemplate\"\xd7\x01\n" +
+	"\x1eSaveCertificateTemplateRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\rR\bcourseId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\x12\x18\n" +
+	"\aheading\x18\x03 \x01(\tR\aheading\x12\x12\n" +
+	"\x04body\x18\x04 \x01(\tR\x04body\x12\x16\n" +
+	"\x06footer\x18\x05 \x01(\tR\x06footer\x12!\n" +
+	"\faccent_color\x18\x06 \x01(\tR\vaccentColor\x12\x16\n" +
+	"\x06layout\x18\a \x01(\tR\x06layout\"\x8d\x01\n" +
+	"\x1fSaveCertificateTemplateResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12<\n" +
+	"\btemplate\x18\x03 \x01(\v2 .certificate.CertificateTemplateR\btemplate\"\x8e\x02\n" +
+	"\vCertificate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\rR\x06userId\x12\x1b\n" +
+	"\tcourse_id\x18\x04 \x01(\rR\bcourseId\x12!\n" +
+	"\fstudent_name\x18\x05 \x01(\tR\vstudentName\x12!\n" +
+	"\fcourse_title\x18\x06 \x01(\tR\vcourseTitle\x12'\n" +
+	"\x0finstructor_name\x18\a \x01(\tR\x0einstructorName\x12\x1b\n" +
+	"\tissued_at\x18\b \x01(\tR\bissuedAt\x12\x19\n" +
+	"\bfile_url\x18\t \x01(\tR\afileUrl\"\xb3\x01\n" +
+	"\x13CertificateTemplate\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\rR\bcourseId\x12\x18\n" +
+	"\aheading\x18\x02 \x01(\tR\aheading\x12\x12\n" +
+	"\x04body\x18\x03 \x01(\tR\x04body\x12\x16\n" +
+	"\x06footer\x18\x04 \x01(\tR\x06footer\x12!\n" +
+	"\faccent_color\x18\x05 \x01(\tR\vaccentColor\x12\x16\n" +
+	"\x06layout\x18\x06 \x01(\tR\x06layout2\xbf\x03\n" +
+	"\x12CertificateService\x12Y\n" +
+	"\x0eGetCertificate\x12\".certificate.GetCertificateRequest\x1a#.certificate.GetCertificateResponse\x12e\n" +
+	"\x12ListMyCertificates\x12&.certificate.ListMyCertificatesRequest\x1a'.certificate.ListMyCertificatesResponse\x12q\n" +
+	"\x16GetCertificateTemplate\x12*.certificate.GetCertificateTemplateRequest\x1a+.certificate.GetCertificateTemplateResponse\x12t\n" +
+	"\x17SaveCertificateTemplate\x12+.certificate.SaveCertificateTemplateRequest\x1a,.certificate.SaveCertificateTemplateResponseB2Z0course-platform/internal/shared/pb/certificatepbb\x06proto3"
+
+var (
+	file_protos_certificate_proto_rawDescOnce sync.Once
+	file_protos_certificate_proto_rawDescData []byte
+)
+
+func file_protos_certificate_proto_rawDescGZIP() []byte {
+	file_protos_certificate_proto_rawDescOnce.Do(func() {
+		file_protos_certificate_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_protos_certificate_proto_rawDesc), len(file_protos_certificate_proto_rawDesc)))
+	})
+	return file_protos_certificate_proto_rawDescData
+}
+
+var file_protos_certificate_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_protos_certificate_proto_goTypes = []any{
+	(*GetCertificateRequest)(nil),           // 0: certificate.GetCertificateRequest
+	(*GetCertificateResponse)(nil),          // 1: certificate.GetCertificateResponse
+	(*ListMyCertificatesRequest)(nil),       // 2: certificate.ListMyCertificatesRequest
+	(*ListMyCertificatesResponse)(nil),      // 3: certificate.ListMyCertificatesResponse
+	(*GetCertificateTemplateRequest)(nil),   // 4: certificate.GetCertificateTemplateRequest
+	(*GetCertificateTemplateResponse)(nil),  // 5: certificate.GetCertificateTemplateResponse
+	(*SaveCertificateTemplateRequest)(nil),  // 6: certificate.SaveCertificateTemplateRequest
+	(*SaveCertificateTemplateResponse)(nil), // 7: certificate.SaveCertificateTemplateResponse
+	(*Certificate)(nil),                     // 8: certificate.Certificate
+	(*CertificateTemplate)(nil),             // 9: certificate.CertificateTemplate
+}
+var file_protos_certificate_proto_depIdxs = []int32{
+	8, // 0: certificate.GetCertificateResponse.certificate:type_name -> certificate.Certificate
+	8, // 1: certificate.ListMyCertificatesResponse.certificates:type_name -> certificate.Certificate
+	9, // 2: certificate.GetCertificateTemplateResponse.template:type_name -> certificate.CertificateTemplate
+	9, // 3: certificate.SaveCertificateTemplateResponse.template:type_name -> certificate.CertificateTemplate
+	0, // 4: certificate.CertificateService.GetCertificate:input_type -> certificate.GetCertificateRequest
+	2, // 5: certificate.CertificateService.ListMyCertificates:input_type -> certificate.ListMyCertificatesRequest
+	4, // 6: certificate.CertificateService.GetCertificateTemplate:input_type -> certificate.GetCertificateTemplateRequest
+	6, // 7: certificate.CertificateService.SaveCertificateTemplate:input_type -> certificate.SaveCertificateTemplateRequest
+	1, // 8: certificate.CertificateService.GetCertificate:output_type -> certificate.GetCertificateResponse
+	3, // 9: certificate.CertificateService.ListMyCertificates:output_type -> certificate.ListMyCertificatesResponse
+	5, // 10: certificate.CertificateService.GetCertificateTemplate:output_type -> certificate.GetCertificateTemplateResponse
+	7, // 11: certificate.CertificateService.SaveCertificateTemplate:output_type -> certificate.SaveCertificateTemplateResponse
+	8, // [8:12] is the sub-list for method output_type
+	4, // [4:8] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_protos_certificate_proto_init() }
+func file_protos_certificate_proto_init() {
+	if File_protos_certificate_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_protos_certificate_proto_rawDesc), len(file_protos_certificate_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_protos_certificate_proto_goTypes,
+		DependencyIndexes: file_protos_certificate_proto_depIdxs,
+		MessageInfos:      file_protos_certificate_proto_msgTypes,
+	}.Build()
+	File_protos_certificate_proto = out.File
+	file_protos_certificate_proto_goTypes = nil
+	file_protos_certificate_proto_depIdxs = nil
+}
@@ -0,0 +1,247 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.31.1
+// source: protos/certificate.proto
+
+package certificatepb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CertificateService_GetCertificate_FullMethodName          = "/certificate.CertificateService/GetCertificate"
+	CertificateService_ListMyCertificates_FullMethodName      = "/certificate.CertificateService/ListMyCertificates"
+	CertificateService_GetCertificateTemplate_FullMethodName  = "/certificate.CertificateService/GetCertificateTemplate"
+	CertificateService_SaveCertificateTemplate_FullMethodName = "/certificate.CertificateService/SaveCertificateTemplate"
+)
+
+// CertificateServiceClient is the client API for CertificateService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 证书服务定义
+type CertificateServiceClient interface {
+	// 根据验证码获取证书（公开验证）
+	GetCertificate(ctx context.Context, in *GetCertificateRequest, opts ...grpc.CallOption) (*GetCertificateResponse, error)
+	// 获取本人的全部证书
+	ListMyCertificates(ctx context.Context, in *ListMyCertificatesRequest, opts ...grpc.CallOption) (*ListMyCertificatesResponse, error)
+	// 获取课程证书模板
+	GetCertificateTemplate(ctx context.Context, in *GetCertificateTemplateRequest, opts ...grpc.CallOption) (*GetCertificateTemplateResponse, error)
+	// 保存课程证书模板（讲师）
+	SaveCertificateTemplate(ctx context.Context, in *SaveCertificateTemplateRequest, opts ...grpc.CallOption) (*SaveCertificateTemplateResponse, error)
+}
+
+type certificateServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCertificateServiceClient(cc grpc.ClientConnInterface) CertificateServiceClient {
+	return &certificateServiceClient{cc}
+}
+
+func (c *certificateServiceClient) GetCertificate(ctx context.Context, in *GetCertificateRequest, opts ...grpc.CallOption) (*GetCertificateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCertificateResponse)
+	err := c.cc.Invoke(ctx, CertificateService_GetCertificate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *certificateServiceClient) ListMyCertificates(ctx context.Context, in *ListMyCertificatesRequest, opts ...grpc.CallOption) (*ListMyCertificatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMyCertificatesResponse)
+	err := c.cc.Invoke(ctx, CertificateService_ListMyCertificates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *certificateServiceClient) GetCertificateTemplate(ctx context.Context, in *GetCertificateTemplateRequest, opts ...grpc.CallOption) (*GetCertificateTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCertificateTemplateResponse)
+	err := c.cc.Invoke(ctx, CertificateService_GetCertificateTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *certificateServiceClient) SaveCertificateTemplate(ctx context.Context, in *SaveCertificateTemplateRequest, opts ...grpc.CallOption) (*SaveCertificateTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveCertificateTemplateResponse)
+	err := c.cc.Invoke(ctx, CertificateService_SaveCertificateTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CertificateServiceServer is the server API for CertificateService service.
+// All implementations must embed UnimplementedCertificateServiceServer
+// for forward compatibility.
+//
+// 证书服务定义
+type CertificateServiceServer interface {
+	// 根据验证码获取证书（公开验证）
+	GetCertificate(context.Context, *GetCertificateRequest) (*GetCertificateResponse, error)
+	// 获取本人的全部证书
+	ListMyCertificates(context.Context, *ListMyCertificatesRequest) (*ListMyCertificatesResponse, error)
+	// 获取课程证书模板
+	GetCertificateTemplate(context.Context, *GetCertificateTemplateRequest) (*GetCertificateTemplateResponse, error)
+	// 保存课程证书模板（讲师）
+	SaveCertificateTemplate(context.Context, *SaveCertificateTemplateRequest) (*SaveCertificateTemplateResponse, error)
+	mustEmbedUnimplementedCertificateServiceServer()
+}
+
+// UnimplementedCertificateServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCertificateServiceServer struct{}
+
+func (UnimplementedCertificateServiceServer) GetCertificate(context.Context, *GetCertificateRequest) (*GetCertificateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCertificate not implemented")
+}
+func (UnimplementedCertificateServiceServer) ListMyCertificates(context.Context, *ListMyCertificatesRequest) (*ListMyCertificatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyCertificates not implemented")
+}
+func (UnimplementedCertificateServiceServer) GetCertificateTemplate(context.Context, *GetCertificateTemplateRequest) (*GetCertificateTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCertificateTemplate not implemented")
+}
+func (UnimplementedCertificateServiceServer) SaveCertificateTemplate(context.Context, *SaveCertificateTemplateRequest) (*SaveCertificateTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveCertificateTemplate not implemented")
+}
+func (UnimplementedCertificateServiceServer) mustEmbedUnimplementedCertificateServiceServer() {}
+func (UnimplementedCertificateServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeCertificateServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CertificateServiceServer will
+// result in compilation errors.
+type UnsafeCertificateServiceServer interface {
+	mustEmbedUnimplementedCertificateServiceServer()
+}
+
+func RegisterCertificateServiceServer(s grpc.ServiceRegistrar, srv CertificateServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCertificateServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CertificateService_ServiceDesc, srv)
+}
+
+func _CertificateService_GetCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCertificateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CertificateServiceServer).GetCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CertificateService_GetCertificate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CertificateServiceServer).GetCertificate(ctx, req.(*GetCertificateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CertificateService_ListMyCertificates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyCertificatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CertificateServiceServer).ListMyCertificates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CertificateService_ListMyCertificates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CertificateServiceServer).ListMyCertificates(ctx, req.(*ListMyCertificatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CertificateService_GetCertificateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCertificateTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CertificateServiceServer).GetCertificateTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CertificateService_GetCertificateTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CertificateServiceServer).GetCertificateTemplate(ctx, req.(*GetCertificateTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CertificateService_SaveCertificateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveCertificateTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CertificateServiceServer).SaveCertificateTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CertificateService_SaveCertificateTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CertificateServiceServer).SaveCertificateTemplate(ctx, req.(*SaveCertificateTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CertificateService_ServiceDesc is the grpc.ServiceDesc for CertificateService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CertificateService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "certificate.CertificateService",
+	HandlerType: (*CertificateServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetCertificate",
+			Handler:    _CertificateService_GetCertificate_Handler,
+		},
+		{
+			MethodName: "ListMyCertificates",
+			Handler:    _CertificateService_ListMyCertificates_Handler,
+		},
+		{
+			MethodName: "GetCertificateTemplate",
+			Handler:    _CertificateService_GetCertificateTemplate_Handler,
+		},
+		{
+			MethodName: "SaveCertificateTemplate",
+			Handler:    _CertificateService_SaveCertificateTemplate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "protos/certificate.proto",
+}
@@ -0,0 +1,2100 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: protos/cohort.proto
+
+package cohortpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 创建班期请求消息
+type CreateCohortRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      uint32                 `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	StartDate     string                 `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // RFC3339格式，或 timezone 中的当地时间（2006-01-02 15:04）
+	EndDate       string                 `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // 格式同上，为空表示不限
+	Capacity      int32                  `protobuf:"varint,6,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Timezone      string                 `protobuf:"bytes,7,opt,name=timezone,proto3" json:"timezone,omitempty"` // IANA时区名称，默认 Asia/Shanghai
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCohortRequest) Reset() {
+	*x = CreateCohortRequest{}
+	mi := &file_protos_cohort_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCohortRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCohortRequest) ProtoMessage() {}
+
+func (x *CreateCohortRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cohort_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCohortRequest.ProtoReflect.Descriptor instead.
+func (*CreateCohortRequest) Descriptor() ([]byte, []int) {
+	return file_protos_cohort_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateCohortRequest) GetCourseId() uint32 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *CreateCohortRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateCohortRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCohortRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *CreateCohortRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *CreateCohortRequest) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *CreateCohortRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+// 创建班期响应消息
+type CreateCohortResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Cohort        *Cohort                `protobuf:"bytes,3,opt,name=cohort,proto3" json:"cohort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCohortResponse) Reset() {
+	*x = CreateCohortResponse{}
+	mi := &file_protos_cohort_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCohortResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCohortResponse) ProtoMessage() {}
+
+func (x *CreateCohortResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cohort_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCohortResponse.ProtoReflect.Descriptor instead.
+func (*CreateCohortResponse) Descriptor() ([]byte, []int) {
+	return file_protos_cohort_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateCohortResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *CreateCohortResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateCohortResponse) GetCohort() *Cohort {
+	if x != nil {
+		return x.Cohort
+	}
+	return nil
+}
+
+// 更新班期请求消息
+type UpdateCohortRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CohortId      uint32                 `protobuf:"varint,1,opt,name=cohort_id,json=cohortId,proto3" json:"cohort_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	StartDate     string                 `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       string                 `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Capacity      int32                  `protobuf:"varint,6,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Timezone      string                 `protobuf:"bytes,7,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCohortRequest) Reset() {
+	*x = UpdateCohortRequest{}
+	mi := &file_protos_cohort_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCohortRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCohortRequest) ProtoMessage() {}
+
+func (x *UpdateCohortRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cohort_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCohortRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCohortRequest) Descriptor() ([]byte, []int) {
+	return file_protos_cohort_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UpdateCohortRequest) GetCohortId() uint32 {
+	if x != nil {
+		return x.CohortId
+	}
+	return 0
+}
+
+func (x *UpdateCohortRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateCohortRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCohortRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *UpdateCohortRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *UpdateCohortRequest) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *UpdateCohortRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+// 更新班期响应消息
+type UpdateCohortResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Cohort        *Cohort                `protobuf:"bytes,3,opt,name=cohort,proto3" json:"cohort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCohortResponse) Reset() {
+	*x = UpdateCohortResponse{}
+	mi := &file_protos_cohort_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCohortResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCohortResponse) ProtoMessage() {}
+
+func (x *UpdateCohortResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cohort_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCohortResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCohortResponse) Descriptor() ([]byte, []int) {
+	return file_protos_cohort_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateCohortResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *UpdateCohortResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UpdateCohortResponse) GetCohort() *Cohort {
+	if x != nil {
+		return x.Cohort
+	}
+	return nil
+}
+
+// 获取班期详情请求消息
+type GetCohortRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CohortId      uint32                 `protobuf:"varint,1,opt,name=cohort_id,json=cohortId,proto3" json:"cohort_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCohortRequest) Reset() {
+	*x = GetCohortRequest{}
+	mi := &file_protos_cohort_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCohortRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCohortRequest) ProtoMessage() {}
+
+func (x *GetCohortRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cohort_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCohortRequest.ProtoReflect.Descriptor instead.
+func (*GetCohortRequest) Descriptor() ([]byte, []int) {
+	return file_protos_cohort_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetCohortRequest) GetCohortId() uint32 {
+	if x != nil {
+		return x.CohortId
+	}
+	return 0
+}
+
+func (x *GetCohortRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// 获取班期详情响应消息
+type GetCohortResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Cohort        *Cohort                `protobuf:"bytes,3,opt,name=cohort,proto3" json:"cohort,omitempty"`
+	Sessions      []*LiveSession         `protobuf:"bytes,4,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCohortResponse) Reset() {
+	*x = GetCohortResponse{}
+	mi := &file_protos_cohort_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCohortResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCohortResponse) ProtoMessage() {}
+
+func (x *GetCohortResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cohort_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCohortResponse.ProtoReflect.Descriptor instead.
+func (*GetCohortResponse) Descriptor() ([]byte, []int) {
+	return file_protos_cohort_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetCohortResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetCohortResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetCohortResponse) GetCohort() *Cohort {
+	if x != nil {
+		return x.Cohort
+	}
+	return nil
+}
+
+func (x *GetCohortResponse) GetSessions() []*LiveSession {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+// 获取班期列表请求消息
+type ListCohortsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      uint32                 `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCohortsRequest) Reset() {
+	*x = ListCohortsRequest{}
+	mi := &file_protos_cohort_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCohortsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCohortsRequest) ProtoMessage() {}
+
+func (x *ListCohortsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cohort_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCohortsRequest.ProtoReflect.Descriptor instead.
+func (*ListCohortsRequest) Descriptor() ([]byte, []int) {
+	return file_protos_cohort_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListCohortsRequest) GetCourseId() uint32 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *ListCohortsRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// 获取班期列表响应消息
+type ListCohortsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Cohorts       []*Cohort              `protobuf:"bytes,3,rep,name=cohorts,proto3" json:"cohorts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCohortsResponse) Reset() {
+	*x = ListCohortsResponse{}
+	mi := &file_protos_cohort_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCohortsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCohortsResponse) ProtoMessage() {}
+
+func (x *ListCohortsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cohort_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCohortsResponse.ProtoReflect.Descriptor instead.
+func (*ListCohortsResponse) Descriptor() ([]byte, []int) {
+	return file_protos_cohort_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListCohortsResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListCohortsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListCohortsResponse) GetCohorts() []*Cohort {
+	if x != nil {
+		return x.Cohorts
+	}
+	return nil
+}
+
+// 报名班期请求消息
+type EnrollCohortRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CohortId      uint32                 `protobuf:"varint,1,opt,name=cohort_id,json=cohortId,proto3" json:"cohort_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrollCohortRequest) Reset() {
+	*x = EnrollCohortRequest{}
+	mi := &file_protos_cohort_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollCohortRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollCohortRequest) ProtoMessage() {}
+
+func (x *EnrollCohortRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cohort_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollCohortRequest.ProtoReflect.Descriptor instead.
+func (*EnrollCohortRequest) Descriptor() ([]byte, []int) {
+	return file_protos_cohort_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *EnrollCohortRequest) GetCohortId() uint32 {
+	if x != nil {
+		return x.CohortId
+	}
+	return 0
+}
+
+func (x *EnrollCohortRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// 报名班期响应消息，cohort.my_status 为 waitlisted 时表示进入候补
+type EnrollCohortResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Code                 int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message              string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Cohort               *Cohort                `protobuf:"bytes,3,opt,name=cohort,proto3" json:"cohort,omitempty"`
+	MissingPrerequisites []*MissingPrerequisite `protobuf:"bytes,4,rep,name=missing_prerequisites,json=missingPrerequisites,proto3" json:"missing_prerequisites,omitempty"` // 先修要求未满足时的缺口
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *EnrollCohortResponse) Reset() {
+	*x = EnrollCohortResponse{}
+	mi := &file_protos_cohort_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollCohortResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollCohortResponse) ProtoMessage() {}
+
+func (x *EnrollCohortResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cohort_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollCohortResponse.ProtoReflect.Descriptor instead.
+func (*EnrollCohortResponse) Descriptor() ([]byte, []int) {
+	return file_protos_cohort_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *EnrollCohortResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *EnrollCohortResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *EnrollCohortResponse) GetCohort() *Cohort {
+	if x != nil {
+		return x.Cohort
+	}
+	return nil
+}
+
+func (x *EnrollCohortResponse) GetMissingPrerequisites() []*MissingPrerequisite {
+	if x != nil {
+		return x.MissingPrerequisites
+	}
+	return nil
+}
+
+// 未满足的先修要求
+type MissingPrerequisite struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	CourseId           uint32                 `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	CourseTitle        string                 `protobuf:"bytes,2,opt,name=course_title,json=courseTitle,proto3" json:"course_title,omitempty"`
+	MinProgressPercent int32                  `protobuf:"varint,3,opt,name=min_progress_percent,json=minProgressPercent,proto3" json:"min_progress_percent,omitempty"`
+	CurrentPercent     int32                  `protobuf:"varint,4,opt,name=current_percent,json=currentPercent,proto3" json:"current_percent,omitempty"`
+	Enrolled           bool                   `protobuf:"varint,5,opt,name=enrolled,proto3" json:"enrolled,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *MissingPrerequisite) Reset() {
+	*x = MissingPrerequisite{}
+	mi := &file_protos_cohort_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MissingPrerequisite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MissingPrerequisite) ProtoMessage() {}
+
+func (x *MissingPrerequisite) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cohort_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MissingPrerequisite.ProtoReflect.Descriptor instead.
+func (*MissingPrerequisite) Descriptor() ([]byte, []int) {
+	return file_protos_cohort_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MissingPrerequisite) GetCourseId() uint32 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *MissingPrerequisite) GetCourseTitle() string {
+	if x != nil {
+		return x.CourseTitle
+	}
+	return ""
+}
+
+func (x *MissingPrerequisite) GetMinProgressPercent() int32 {
+	if x != nil {
+		return x.MinProgressPercent
+	}
+	return 0
+}
+
+func (x *MissingPrerequisite) GetCurrentPercent() int32 {
+	if x != nil {
+		return x.CurrentPercent
+	}
+	return 0
+}
+
+func (x *MissingPrerequisite) GetEnrolled() bool {
+	if x != nil {
+		return x.Enrolled
+	}
+	return false
+}
+
+// 退出班期请求消息
+type LeaveCohortRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CohortId      uint32                 `protobuf:"varint,1,opt,name=cohort_id,json=cohortId,proto3" json:"cohort_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveCohortRequest) Reset() {
+	*x = LeaveCohortRequest{}
+	mi := &file_protos_cohort_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveCohortRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveCohortRequest) ProtoMessage() {}
+
+func (x *LeaveCohortRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cohort_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveCohortRequest.ProtoReflect.Descriptor instead.
+func (*LeaveCohortRequest) Descriptor() ([]byte, []int) {
+	return file_protos_cohort_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *LeaveCohortRequest) GetCohortId() uint32 {
+	if x != nil {
+		return x.CohortId
+	}
+	return 0
+}
+
+func (x *LeaveCohortRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// 退出班期响应消息
+type LeaveCohortResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaveCohortResponse) Reset() {
+	*x = LeaveCohortResponse{}
+	mi := &file_protos_cohort_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaveCohortResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaveCohortResponse) ProtoMessage() {}
+
+func (x *LeaveCohortResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cohort_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaveCohortResponse.ProtoReflect.Descriptor instead.
+func (*LeaveCohortResponse) Descriptor() ([]byte, []int) {
+	return file_protos_cohort_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *LeaveCohortResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *LeaveCohortResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 获取班期成员请求消息
+type ListCohortMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CohortId      uint32                 `protobuf:"varint,1,opt,name=cohort_id,json=cohortId,proto3" json:"cohort_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCohortMembersRequest) Reset() {
+	*x = ListCohortMembersRequest{}
+	mi := &file_protos_cohort_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCohortMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCohortMembersRequest) ProtoMessage() {}
+
+func (x *ListCohortMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cohort_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCohortMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListCohortMembersRequest) Descriptor() ([]byte, []int) {
+	return file_protos_cohort_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListCohortMembersRequest) GetCohortId() uint32 {
+	if x != nil {
+		return x.CohortId
+	}
+	return 0
+}
+
+func (x *ListCohortMembersRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// 获取班期成员响应消息
+type ListCohortMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Members       []*CohortMember        `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCohortMembersResponse) Reset() {
+	*x = ListCohortMembersResponse{}
+	mi := &file_protos_cohort_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCohortMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCohortMembersResponse) ProtoMessage() {}
+
+func (x *ListCohortMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cohort_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCohortMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListCohortMembersResponse) Descriptor() ([]byte, []int) {
+	return file_protos_cohort_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListCohortMembersResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListCohortMembersResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListCohortMembersResponse) GetMembers() []*CohortMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+// 创建或更新直播请求消息，更新时需提供 session_id
+type SaveLiveSessionRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CohortId        uint32                 `protobuf:"varint,1,opt,name=cohort_id,json=cohortId,proto3" json:"cohort_id,omitempty"`
+	SessionId       uint32                 `protobuf:"varint,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	UserId          uint32                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Title           string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Description     string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	StartsAt        string                 `protobuf:"bytes,6,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`                       // RFC3339格式，或 timezone 中的当地时间（2006-01-02 15:04）
+	DurationMinutes int32                  `protobuf:"varint,7,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"` // 0表示默认60分钟
+	Timezone        string                 `protobuf:"bytes,8,opt,name=timezone,proto3" json:"timezone,omitempty"`                                       // 为空时使用班期时区
+	JoinUrl         string                 `protobuf:"bytes,9,opt,name=join_url,json=joinUrl,proto3" json:"join_url,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SaveLiveSessionRequest) Reset() {
+	*x = SaveLiveSessionRequest{}
+	mi := &file_protos_cohort_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveLiveSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveLiveSessionRequest) ProtoMessage() {}
+
+func (x *SaveLiveSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cohort_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveLiveSessionRequest.ProtoReflect.Descriptor instead.
+func (*SaveLiveSessionRequest) Descriptor() ([]byte, []int) {
+	return file_protos_cohort_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SaveLiveSessionRequest) GetCohortId() uint32 {
+	if x != nil {
+		return x.CohortId
+	}
+	return 0
+}
+
+func (x *SaveLiveSessionRequest) GetSessionId() uint32 {
+	if x != nil {
+		return x.SessionId
+	}
+	return 0
+}
+
+func (x *SaveLiveSessionRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SaveLiveSessionRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SaveLiveSessionRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SaveLiveSessionRequest) GetStartsAt() string {
+	if x != nil {
+		return x.StartsAt
+	}
+	return ""
+}
+
+func (x *SaveLiveSessionRequest) GetDurationMinutes() int32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
+func (x *SaveLiveSessionRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *SaveLiveSessionRequest) GetJoinUrl() string {
+	if x != nil {
+		return x.JoinUrl
+	}
+	return ""
+}
+
+// 创建或更新直播响应消息
+type SaveLiveSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Session       *LiveSession           `protobuf:"bytes,3,opt,name=session,proto3" json:"session,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveLiveSessionResponse) Reset() {
+	*x = SaveLiveSessionResponse{}
+	mi := &file_protos_cohort_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveLiveSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveLiveSessionResponse) ProtoMessage() {}
+
+func (x *SaveLiveSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cohort_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveLiveSessionResponse.ProtoReflect.Descriptor instead.
+func (*SaveLiveSessionResponse) Descriptor() ([]byte, []int) {
+	return file_protos_cohort_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SaveLiveSessionResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *SaveLiveSessionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SaveLiveSessionResponse) GetSession() *LiveSession {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+// 取消直播请求消息
+type DeleteLiveSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CohortId      uint32                 `protobuf:"varint,1,opt,name=cohort_id,json=cohortId,proto3" json:"cohort_id,omitempty"`
+	SessionId     uint32                 `protobuf:"varint,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteLiveSessionRequest) Reset() {
+	*x = DeleteLiveSessionRequest{}
+	mi := &file_protos_cohort_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLiveSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLiveSessionRequest) ProtoMessage() {}
+
+func (x *DeleteLiveSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cohort_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLiveSessionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteLiveSessionRequest) Descriptor() ([]byte, []int) {
+	return file_protos_cohort_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DeleteLiveSessionRequest) GetCohortId() uint32 {
+	if x != nil {
+		return x.CohortId
+	}
+	return 0
+}
+
+func (x *DeleteLiveSessionRequest) GetSessionId() uint32 {
+	if x != nil {
+		return x.SessionId
+	}
+	return 0
+}
+
+func (x *DeleteLiveSessionRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// 取消直播响应消息
+type DeleteLiveSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteLiveSessionResponse) Reset() {
+	*x = DeleteLiveSessionResponse{}
+	mi := &file_protos_cohort_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLiveSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLiveSessionResponse) ProtoMessage() {}
+
+func (x *DeleteLiveSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cohort_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLiveSessionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteLiveSessionResponse) Descriptor() ([]byte, []int) {
+	return file_protos_cohort_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DeleteLiveSessionResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *DeleteLiveSessionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 获取日历订阅令牌请求消息，regenerate 为 true 时重新生成
+type GetCalendarFeedTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Regenerate    bool                   `protobuf:"varint,2,opt,name=regenerate,proto3" json:"regenerate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCalendarFeedTokenRequest) Reset() {
+	*x = GetCalendarFeedTokenRequest{}
+	mi := &file_protos_cohort_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCalendarFeedTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCalendarFeedTokenRequest) ProtoMessage() {}
+
+func (x *GetCalendarFeedTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cohort_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCalendarFeedTokenRequest.ProtoReflect.Descriptor instead.
+func (*GetCalendarFeedTokenRequest) Descriptor() ([]byte, []int) {
+	return file_protos_cohort_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetCalendarFeedTokenRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetCalendarFeedTokenRequest) GetRegenerate() bool {
+	if x != nil {
+		return x.Regenerate
+	}
+	return false
+}
+
+// 获取日历订阅令牌响应消息
+type GetCalendarFeedTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Token         string                 `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCalendarFeedTokenResponse) Reset() {
+	*x = GetCalendarFeedTokenResponse{}
+	mi := &file_protos_cohort_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCalendarFeedTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCalendarFeedTokenResponse) ProtoMessage() {}
+
+func (x *GetCalendarFeedTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cohort_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCalendarFeedTokenResponse.ProtoReflect.Descriptor instead.
+func (*GetCalendarFeedTokenResponse) Descriptor() ([]byte, []int) {
+	return file_protos_cohort_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetCalendarFeedTokenResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetCalendarFeedTokenResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetCalendarFeedTokenResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// 获取日历订阅内容请求消息
+type GetCalendarFeedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCalendarFeedRequest) Reset() {
+	*x = GetCalendarFeedRequest{}
+	mi := &file_protos_cohort_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCalendarFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCalendarFeedRequest) ProtoMessage() {}
+
+func (x *GetCalendarFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cohort_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCalendarFeedRequest.ProtoReflect.Descriptor instead.
+func (*GetCalendarFeedRequest) Descriptor() ([]byte, []int) {
+	return file_protos_cohort_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetCalendarFeedRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// 获取日历订阅内容响应消息
+type GetCalendarFeedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Ics           []byte                 `protobuf:"bytes,3,opt,name=ics,proto3" json:"ics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCalendarFeedResponse) Reset() {
+	*x = GetCalendarFeedResponse{}
+	mi := &file_protos_cohort_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCalendarFeedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCalendarFeedResponse) ProtoMessage() {}
+
+func (x *GetCalendarFeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cohort_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCalendarFeedResponse.ProtoReflect.Descriptor instead.
+func (*GetCalendarFeedResponse) Descriptor() ([]byte, []int) {
+	return file_protos_cohort_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetCalendarFeedResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetCalendarFeedResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetCalendarFeedResponse) GetIcs() []byte {
+	if x != nil {
+		return x.Ics
+	}
+	return nil
+}
+
+// 班期模型
+type Cohort struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CourseId        uint32                 `protobuf:"varint,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Name            string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	StartDate       string                 `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // RFC3339格式
+	EndDate         string                 `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`       // RFC3339格式，为空表示不限
+	Capacity        int32                  `protobuf:"varint,6,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Timezone        string                 `protobuf:"bytes,7,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	EnrolledCount   int32                  `protobuf:"varint,8,opt,name=enrolled_count,json=enrolledCount,proto3" json:"enrolled_count,omitempty"`
+	WaitlistCount   int32                  `protobuf:"varint,9,opt,name=waitlist_count,json=waitlistCount,proto3" json:"waitlist_count,omitempty"`
+	MyStatus        string                 `protobuf:"bytes,10,opt,name=my_status,json=myStatus,proto3" json:"my_status,omitempty"`                         // 当前用户状态：enrolled/waitlisted，未报名为空
+	MyWaitlistOrder int32                  `protobuf:"varint,11,opt,name=my_waitlist_order,json=myWaitlistOrder,proto3" json:"my_waitlist_order,omitempty"` // 当前用户的候补顺位
+	IsInstructor    bool                   `protobuf:"varint,12,opt,name=is_instructor,json=isInstructor,proto3" json:"is_instructor,omitempty"`
+	CreatedAt       string                 `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Cohort) Reset() {
+	*x = Cohort{}
+	mi := &file_protos_cohort_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Cohort) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Cohort) ProtoMessage() {}
+
+func (x *Cohort) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cohort_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Cohort.ProtoReflect.Descriptor instead.
+func (*Cohort) Descriptor() ([]byte, []int) {
+	return file_protos_cohort_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Cohort) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Cohort) GetCourseId() uint32 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *Cohort) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Cohort) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *Cohort) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *Cohort) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *Cohort) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *Cohort) GetEnrolledCount() int32 {
+	if x != nil {
+		return x.EnrolledCount
+	}
+	return 0
+}
+
+func (x *Cohort) GetWaitlistCount() int32 {
+	if x != nil {
+		return x.WaitlistCount
+	}
+	return 0
+}
+
+func (x *Cohort) GetMyStatus() string {
+	if x != nil {
+		return x.MyStatus
+	}
+	return ""
+}
+
+func (x *Cohort) GetMyWaitlistOrder() int32 {
+	if x != nil {
+		return x.MyWaitlistOrder
+	}
+	return 0
+}
+
+func (x *Cohort) GetIsInstructor() bool {
+	if x != nil {
+		return x.IsInstructor
+	}
+	return false
+}
+
+func (x *Cohort) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// 班期成员模型
+type CohortMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	QueuedAt      string                 `protobuf:"bytes,3,opt,name=queued_at,json=queuedAt,proto3" json:"queued_at,omitempty"`                 // RFC3339格式
+	PromotedAt    string                 `protobuf:"bytes,4,opt,name=promoted_at,json=promotedAt,proto3" json:"promoted_at,omitempty"`           // RFC3339格式，从候补递补时的时间
+	WaitlistOrder int32                  `protobuf:"varint,5,opt,name=waitlist_order,json=waitlistOrder,proto3" json:"waitlist_order,omitempty"` // 候补顺位，正式成员为0
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CohortMember) Reset() {
+	*x = CohortMember{}
+	mi := &file_protos_cohort_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CohortMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CohortMember) ProtoMessage() {}
+
+func (x *CohortMember) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cohort_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CohortMember.ProtoReflect.Descriptor instead.
+func (*CohortMember) Descriptor() ([]byte, []int) {
+	return file_protos_cohort_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CohortMember) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CohortMember) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CohortMember) GetQueuedAt() string {
+	if x != nil {
+		return x.QueuedAt
+	}
+	return ""
+}
+
+func (x *CohortMember) GetPromotedAt() string {
+	if x != nil {
+		return x.PromotedAt
+	}
+	return ""
+}
+
+func (x *CohortMember) GetWaitlistOrder() int32 {
+	if x != nil {
+		return x.WaitlistOrder
+	}
+	return 0
+}
+
+// 直播模型
+type LiveSession struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CohortId        uint32                 `protobuf:"varint,2,opt,name=cohort_id,json=cohortId,proto3" json:"cohort_id,omitempty"`
+	CourseId        uint32                 `protobuf:"varint,3,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Title           string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Description     string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	StartsAt        string                 `protobuf:"bytes,6,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`                  // RFC3339格式（UTC）
+	EndsAt          string                 `protobuf:"bytes,7,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`                        // RFC3339格式（UTC）
+	LocalStartsAt   string                 `protobuf:"bytes,8,opt,name=local_starts_at,json=localStartsAt,proto3" json:"local_starts_at,omitempty"` // 直播时区的当地时间（2006-01-02 15:04）
+	DurationMinutes int32                  `protobuf:"varint,9,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"`
+	Timezone        string                 `protobuf:"bytes,10,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	JoinUrl         string                 `protobuf:"bytes,11,opt,name=join_url,json=joinUrl,proto3" json:"join_url,omitempty"` // 仅班期正式成员和讲师可见
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *LiveSession) Reset() {
+	*x = LiveSession{}
+	mi := &file_protos_cohort_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LiveSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiveSession) ProtoMessage() {}
+
+func (x *LiveSession) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_cohort_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiveSession.ProtoReflect.Descriptor instead.
+func (*LiveSession) Descriptor() ([]byte, []int) {
+	return file_protos_cohort_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *LiveSession) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LiveSession) GetCohortId() uint32 {
+	if x != nil {
+		return x.CohortId
+	}
+	return 0
+}
+
+func (x *LiveSession) GetCourseId() uint32 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *LiveSession) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *LiveSession) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *LiveSession) GetStartsAt() string {
+	if x != nil {
+		return x.StartsAt
+	}
+	return ""
+}
+
+func (x *LiveSession) GetEndsAt() string {
+	if x != nil {
+		return x.EndsAt
+	}
+	return ""
+}
+
+func (x *LiveSession) GetLocalStartsAt() string {
+	if x != nil {
+		return x.LocalStartsAt
+	}
+	return ""
+}
+
+func (x *LiveSession) GetDurationMinutes() int32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
+func (x *LiveSession) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *LiveSession) GetJoinUrl() string {
+	if x != nil {
+		return x.JoinUrl
+	}
+	return ""
+}
+
+var File_protos_cohort_proto protoreflect.FileDescriptor
+
+const file_protos_cohort_proto_rawDesc = "" +
+	"\n" +
+	"\x13protos/cohort.proto\x12\x06cohort\"\xd1\x01\n" +
+	"\x13CreateCohortRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\rR\bcourseId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x04 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x05 \x01(\tR\aendDate\x12\x1a\n" +
+	"\bcapacity\x18\x06 \x01(\x05R\bcapacity\x12\x1a\n" +
+	"\btimezone\x18\a \x01(\tR\btimezone\"l\n" +
+	"\x14CreateCohortResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12&\n" +
+	"\x06cohort\x18\x03 \x01(\v2\x0e.cohort.CohortR\x06cohort\"\xd1\x01\n" +
+	"\x13UpdateCohortRequest\x12\x1b\n" +
+	"\tcohort_id\x18\x01 \x01(\rR\bcohortId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x04 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x05 \x01(\tR\aendDate\x12\x1a\n" +
+	"\bcapacity\x18\x06 \x01(\x05R\bcapacity\x12\x1a\n" +
+	"\btimezone\x18\a \x01(\tR\btimezone\"l\n" +
+	"\x14UpdateCohortResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12&\n" +
+	"\x06cohort\x18\x03 \x01(\v2\x0e.cohort.CohortR\x06cohort\"H\n" +
+	"\x10GetCohortRequest\x12\x1b\n" +
+	"\tcohort_id\x18\x01 \x01(\rR\bcohortId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\"\x9a\x01\n" +
+	"\x11GetCohortResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12&\n" +
+	"\x06cohort\x18\x03 \x01(\v2\x0e.cohort.CohortR\x06cohort\x12/\n" +
+	"\bsessions\x18\x04 \x03(\v2\x13.cohort.LiveSessionR\bsessions\"J\n" +
+	"\x12ListCohortsRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\rR\bcourseId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\"m\n" +
+	"\x13ListCohortsResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12(\n" +
+	"\acohorts\x18\x03 \x03(\v2\x0e.cohort.CohortR\acohorts\"K\n" +
+	"\x13EnrollCohortRequest\x12\x1b\n" +
+	"\tcohort_id\x18\x01 \x01(\rR\bcohortId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\"\xbe\x01\n" +
+	"\x14EnrollCohortResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12&\n" +
+	"\x06cohort\x18\x03 \x01(\v2\x0e.cohort.CohortR\x06cohort\x12P\n" +
+	"\x15missing_prerequisites\x18\x04 \x03(\v2\x1b.cohort.MissingPrerequisiteR\x14missingPrerequisites\"\xcc\x01\n" +
+	"\x13MissingPrerequisite\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\rR\bcourseId\x12!\n" +
+	"\fcourse_title\x18\x02 \x01(\tR\vcourseTitle\x120\n" +
+	"\x14min_progress_percent\x18\x03 \x01(\x05R\x12minProgressPercent\x12'\n" +
+	"\x0fcurrent_percent\x18\x04 \x01(\x05R\x0ecurrentPercent\x12\x1a\n" +
+	"\benrolled\x18\x05 \x01(\bR\benrolled\"J\n" +
+	"\x12LeaveCohortRequest\x12\x1b\n" +
+	"\tcohort_id\x18\x01 \x01(\rR\bcohortId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\"C\n" +
+	"\x13LeaveCohortResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"P\n" +
+	"\x18ListCohortMembersRequest\x12\x1b\n" +
+	"\tcohort_id\x18\x01 \x01(\rR\bcohortId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\"y\n" +
+	"\x19ListCohortMembersResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12.\n" +
+	"\amembers\x18\x03 \x03(\v2\x14.cohort.CohortMemberR\amembers\"\xa4\x02\n" +
+	"\x16SaveLiveSessionRequest\x12\x1b\n" +
+	"\tcohort_id\x18\x01 \x01(\rR\bcohortId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\rR\tsessionId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\rR\x06userId\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x1b\n" +
+	"\tstarts_at\x18\x06 \x01(\tR\bstartsAt\x12)\n" +
+	"\x10duration_minutes\x18\a \x01(\x05R\x0fdurationMinutes\x12\x1a\n" +
+	"\btimezone\x18\b \x01(\tR\btimezone\x12\x19\n" +
+	"\bjoin_url\x18\t \x01(\tR\ajoinUrl\"v\n" +
+	"\x17SaveLiveSessionResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12-\n" +
+	"\asession\x18\x03 \x01(\v2\x13.cohort.LiveSessionR\asession\"o\n" +
+	"\x18DeleteLiveSessionRequest\x12\x1b\n" +
+	"\tcohort_id\x18\x01 \x01(\rR\bcohortId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\rR\tsessionId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\rR\x06userId\"I\n" +
+	"\x19DeleteLiveSessionResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"V\n" +
+	"\x1bGetCalendarFeedTokenRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12\x1e\n" +
+	"\n" +
+	"regenerate\x18\x02 \x01(\bR\n" +
+	"regenerate\"b\n" +
+	"\x1cGetCalendarFeedTokenResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x14\n" +
+	"\x05token\x18\x03 \x01(\tR\x05token\".\n" +
+	"\x16GetCalendarFeedRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"Y\n" +
+	"\x17GetCalendarFeedResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x10\n" +
+	"\x03ics\x18\x03 \x01(\fR\x03ics\"\x96\x03\n" +
+	"\x06Cohort\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1b\n" +
+	"\tcourse_id\x18\x02 \x01(\rR\bcourseId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"start_date\x18\x04 \x01(\tR\tstartDate\x12\x19\n" +
+	"\bend_date\x18\x05 \x01(\tR\aendDate\x12\x1a\n" +
+	"\bcapacity\x18\x06 \x01(\x05R\bcapacity\x12\x1a\n" +
+	"\btimezone\x18\a \x01(\tR\btimezone\x12%\n" +
+	"\x0eenrolled_count\x18\b \x01(\x05R\renrolledCount\x12%\n" +
+	"\x0ewaitlist_count\x18\t \x01(\x05R\rwaitlistCount\x12\x1b\n" +
+	"\tmy_status\x18\n" +
+	" \x01(\tR\bmyStatus\x12*\n" +
+	"\x11my_waitlist_order\x18\v \x01(\x05R\x0fmyWaitlistOrder\x12#\n" +
+	"\ris_instructor\x18\f \x01(\bR\fisInstructor\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\r \x01(\tR\tcreatedAt\"\xa4\x01\n" +
+	"\fCohortMember\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1b\n" +
+	"\tqueued_at\x18\x03 \x01(\tR\bqueuedAt\x12\x1f\n" +
+	"\vpromoted_at\x18\x04 \x01(\tR\n" +
+	"promotedAt\x12%\n" +
+	"\x0ewaitlist_order\x18\x05 \x01(\x05R\rwaitlistOrder\"\xcf\x02\n" +
+	"\vLiveSession\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1b\n" +
+	"\tcohort_id\x18\x02 \x01(\rR\bcohortId\x12\x1b\n" +
+	"\tcourse_id\x18\x03 \x01(\rR\bcourseId\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x1b\n" +
+	"\tstarts_at\x18\x06 \x01(\tR\bstartsAt\x12\x17\n" +
+	"\aends_at\x18\a \x01(\tR\x06endsAt\x12&\n" +
+	"\x0flocal_starts_at\x18\b \x01(\tR\rlocalStartsAt\x12)\n" +
+	"\x10duration_minutes\x18\t \x01(\x05R\x0fdurationMinutes\x12\x1a\n" +
+	"\btimezone\x18\n" +
+	" \x01(\tR\btimezone\x12\x19\n" +
+	"\bjoin_url\x18\v \x01(\tR\ajoinUrl2\xd9\a\n" +
+	"\rCohortService\x12I\n" +
+	"\fCreateCohort\x12\x1b.cohort.CreateCohortRequest\x1a\x1c.cohort.CreateCohortResponse\x12I\n" +
+	"\fUpdateCohort\x12\x1b.cohort.UpdateCohortRequest\x1a\x1c.cohort.UpdateCohortResponse\x12@\n" +
+	"\tGetCohort\x12\x18.cohort.GetCohortRequest\x1a\x19.cohort.GetCohortResponse\x12F\n" +
+	"\vListCohorts\x12\x1a.cohort.ListCohortsRequest\x1a\x1b.cohort.ListCohortsResponse\x12I\n" +
+	"\fEnrollCohort\x12\x1b.cohort.EnrollCohortRequest\x1a\x1c.cohort.EnrollCohortResponse\x12F\n" +
+	"\vLeaveCohort\x12\x1a.cohort.LeaveCohortRequest\x1a\x1b.cohort.LeaveCohortResponse\x12X\n" +
+	"\x11ListCohortMembers\x12 .cohort.ListCohortMembersRequest\x1a!.cohort.ListCohortMembersResponse\x12T\n" +
+	"\x11CreateLiveSession\x12\x1e.cohort.SaveLiveSessionRequest\x1a\x1f.cohort.SaveLiveSessionResponse\x12T\n" +
+	"\x11UpdateLiveSession\x12\x1e.cohort.SaveLiveSessionRequest\x1a\x1f.cohort.SaveLiveSessionResponse\x12X\n" +
+	"\x11DeleteLiveSession\x12 .cohort.DeleteLiveSessionRequest\x1a!.cohort.DeleteLiveSessionResponse\x12a\n" +
+	"\x14GetCalendarFeedToken\x12#.cohort.GetCalendarFeedTokenRequest\x1a$.cohort.GetCalendarFeedTokenResponse\x12R\n" +
+	"\x0fGetCalendarFeed\x12\x1e.cohort.GetCalendarFeedRequest\x1a\x1f.cohort.GetCalendarFeedResponseB-Z+course-platform/internal/shared/pb/cohortpbb\x06proto3"
+
+var (
+	file_protos_cohort_proto_rawDescOnce sync.Once
+	file_protos_cohort_proto_rawDescData []byte
+)
+
+func file_protos_cohort_proto_rawDescGZIP() []byte {
+	file_protos_cohort_proto_rawDescOnce.Do(func() {
+		file_protos_cohort_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_protos_cohort_proto_rawDesc), len(file_protos_cohort_proto_rawDesc)))
+	})
+	return file_protos_cohort_proto_rawDescData
+}
+
+var file_protos_cohort_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_protos_cohort_proto_goTypes = []any{
+	(*CreateCohortRequest)(nil),          // 0: cohort.CreateCohortRequest
+	(*CreateCohortResponse)(nil),         // 1: cohort.CreateCohortResponse
+	(*UpdateCohortRequest)(nil),          // 2: cohort.UpdateCohortRequest
+	(*UpdateCohortResponse)(nil),         // 3: cohort.UpdateCohortResponse
+	(*GetCohortRequest)(nil),             // 4: cohort.GetCohortRequest
+	(*GetCohortResponse)(nil),            // 5: cohort.GetCohortResponse
+	(*ListCohortsRequest)(nil),           // 6: cohort.ListCohortsRequest
+	(*ListCohortsResponse)(nil),          // 7: cohort.ListCohortsResponse
+	(*EnrollCohortRequest)(nil),          // 8: cohort.EnrollCohortRequest
+	(*EnrollCohortResponse)(nil),         // 9: cohort.EnrollCohortResponse
+	(*MissingPrerequisite)(nil),          // 10: cohort.MissingPrerequisite
+	(*LeaveCohortRequest)(nil),           // 11: cohort.LeaveCohortRequest
+	(*LeaveCohortResponse)(nil),          // 12: cohort.LeaveCohortResponse
+	(*ListCohortMembersRequest)(nil),     // 13: cohort.ListCohortMembersRequest
+	(*ListCohortMembersResponse)(nil),    // 14: cohort.ListCohortMembersResponse
+	(*SaveLiveSessionRequest)(nil),       // 15: cohort.SaveLiveSessionRequest
+	(*SaveLiveSessionResponse)(nil),      // 16: cohort.SaveLiveSessionResponse
+	(*DeleteLiveSessionRequest)(nil),     // 17: cohort.DeleteLiveSessionRequest
+	(*DeleteLiveSessionResponse)(nil),    // 18: cohort.DeleteLiveSessionResponse
+	(*GetCalendarFeedTokenRequest)(nil),  // 19: cohort.GetCalendarFeedTokenRequest
+	(*GetCalendarFeedTokenResponse)(nil), // 20: cohort.GetCalendarFeedTokenResponse
+	(*GetCalendarFeedRequest)(nil),       // 21: cohort.GetCalendarFeedRequest
+	(*GetCalendarFeedResponse)(nil),      // 22: cohort.GetCalendarFeedResponse
+	(*Cohort)(nil),                       // 23: cohort.Cohort
+	(*CohortMember)(nil),                 // 24: cohort.CohortMember
+	(*LiveSession)(nil),                  // 25: cohort.LiveSession
+}
+var file_protos_cohort_proto_depIdxs = []int32{
+	23, // 0: cohort.CreateCohortResponse.cohort:type_name -> cohort.Cohort
+	23, // 1: cohort.UpdateCohortResponse.cohort:type_name -> cohort.Cohort
+	23, // 2: cohort.GetCohortResponse.cohort:type_name -> cohort.Cohort
+	25, // 3: cohort.GetCohortResponse.sessions:type_name -> cohort.LiveSession
+	23, // 4: cohort.ListCohortsResponse.cohorts:type_name -> cohort.Cohort
+	23, // 5: cohort.EnrollCohortResponse.cohort:type_name -> cohort.Cohort
+	10, // 6: cohort.EnrollCohortResponse.missing_prerequisites:type_name -> cohort.MissingPrerequisite
+	24, // 7: cohort.ListCohortMembersResponse.members:type_name -> cohort.CohortMember
+	25, // 8: cohort.SaveLiveSessionResponse.session:type_name -> cohort.LiveSession
+	0,  // 9: cohort.CohortService.CreateCohort:input_type -> cohort.CreateCohortRequest
+	2,  // 10: cohort.CohortService.UpdateCohort:input_type -> cohort.UpdateCohortRequest
+	4,  // 11: cohort.CohortService.GetCohort:input_type -> cohort.GetCohortRequest
+	6,  // 12: cohort.CohortService.ListCohorts:input_type -> cohort.ListCohortsRequest
+	8,  // 13: cohort.CohortService.EnrollCohort:input_type -> cohort.EnrollCohortRequest
+	11, // 14: cohort.CohortService.LeaveCohort:input_type -> cohort.LeaveCohortRequest
+	13, // 15: cohort.CohortService.ListCohortMembers:input_type -> cohort.ListCohortMembersRequest
+	15, // 16: cohort.CohortService.CreateLiveSession:input_type -> cohort.SaveLiveSessionRequest
+	15, // 17: cohort.CohortService.UpdateLiveSession:input_type -> cohort.SaveLiveSessionRequest
+	17, // 18: cohort.CohortService.DeleteLiveSession:input_type -> cohort.DeleteLiveSessionRequest
+	19, // 19: cohort.CohortService.GetCalendarFeedToken:input_type -> cohort.GetCalendarFeedTokenRequest
+	21, // 20: cohort.CohortService.GetCalendarFeed:input_type -> cohort.GetCalendarFeedRequest
+	1,  // 21: cohort.CohortService.CreateCohort:output_type -> cohort.CreateCohortResponse
+	3,  // 22: cohort.CohortService.UpdateCohort:output_type -> cohort.UpdateCohortResponse
+	5,  // 23: cohort.CohortService.GetCohort:output_type -> cohort.GetCohortResponse
+	7,  // 24: cohort.CohortService.ListCohorts:output_type -> cohort.ListCohortsResponse
+	9,  // 25: cohort.CohortService.EnrollCohort:output_type -> cohort.EnrollCohortResponse
+	12, // 26: cohort.CohortService.LeaveCohort:output_type -> cohort.LeaveCohortResponse
+	14, // 27: cohort.CohortService.ListCohortMembers:output_type -> cohort.ListCohortMembersResponse
+	16, // 28: cohort.CohortService.CreateLiveSession:output_type -> cohort.SaveLiveSessionResponse
+	16, // 29: cohort.CohortService.UpdateLiveSession:output_type -> cohort.SaveLiveSessionResponse
+	18, // 30: cohort.CohortService.DeleteLiveSession:output_type -> cohort.DeleteLiveSessionResponse
+	20, // 31: cohort.CohortService.GetCalendarFeedToken:output_type -> cohort.GetCalendarFeedTokenResponse
+	22, // 32: cohort.CohortService.GetCalendarFeed:output_type -> cohort.GetCalendarFeedResponse
+	21, // [21:33] is the sub-list for method output_type
+	9,  // [9:21] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_protos_cohort_proto_init() }
+func file_protos_cohort_proto_init() {
+	if File_protos_cohort_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_protos_cohort_proto_rawDesc), len(file_protos_cohort_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   26,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_protos_cohort_proto_goTypes,
+		DependencyIndexes: file_protos_cohort_proto_depIdxs,
+		MessageInfos:      file_protos_cohort_proto_msgTypes,
+	}.Build()
+	File_protos_cohort_proto = out.File
+	file_protos_cohort_proto_goTypes = nil
+	file_protos_cohort_proto_depIdxs = nil
+}
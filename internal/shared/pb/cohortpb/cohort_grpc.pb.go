@@ -0,0 +1,567 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.31.1
+// source: protos/cohort.proto
+
+package cohortpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CohortService_CreateCohort_FullMethodName         = "/cohort.CohortService/CreateCohort"
+	CohortService_UpdateCohort_FullMethodName         = "/cohort.CohortService/UpdateCohort"
+	CohortService_GetCohort_FullMethodName            = "/cohort.CohortService/GetCohort"
+	CohortService_ListCohorts_FullMethodName          = "/cohort.CohortService/ListCohorts"
+	CohortService_EnrollCohort_FullMethodName         = "/cohort.CohortService/EnrollCohort"
+	CohortService_LeaveCohort_FullMethodName          = "/cohort.CohortService/LeaveCohort"
+	CohortService_ListCohortMembers_FullMethodName    = "/cohort.CohortService/ListCohortMembers"
+	CohortService_CreateLiveSession_FullMethodName    = "/cohort.CohortService/CreateLiveSession"
+	CohortService_UpdateLiveSession_FullMethodName    = "/cohort.CohortService/UpdateLiveSession"
+	CohortService_DeleteLiveSession_FullMethodName    = "/cohort.CohortService/DeleteLiveSession"
+	CohortService_GetCalendarFeedToken_FullMethodName = "/cohort.CohortService/GetCalendarFeedToken"
+	CohortService_GetCalendarFeed_FullMethodName      = "/cohort.CohortService/GetCalendarFeed"
+)
+
+// CohortServiceClient is the client API for CohortService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 班期与直播服务定义
+type CohortServiceClient interface {
+	// 开设班期（讲师）
+	CreateCohort(ctx context.Context, in *CreateCohortRequest, opts ...grpc.CallOption) (*CreateCohortResponse, error)
+	// 更新班期（讲师），名额增加时自动递补候补学员
+	UpdateCohort(ctx context.Context, in *UpdateCohortRequest, opts ...grpc.CallOption) (*UpdateCohortResponse, error)
+	// 获取班期详情及直播安排
+	GetCohort(ctx context.Context, in *GetCohortRequest, opts ...grpc.CallOption) (*GetCohortResponse, error)
+	// 获取课程的班期列表
+	ListCohorts(ctx context.Context, in *ListCohortsRequest, opts ...grpc.CallOption) (*ListCohortsResponse, error)
+	// 报名班期，名额已满时进入候补
+	EnrollCohort(ctx context.Context, in *EnrollCohortRequest, opts ...grpc.CallOption) (*EnrollCohortResponse, error)
+	// 退出班期或取消候补
+	LeaveCohort(ctx context.Context, in *LeaveCohortRequest, opts ...grpc.CallOption) (*LeaveCohortResponse, error)
+	// 获取班期成员和候补名单（讲师）
+	ListCohortMembers(ctx context.Context, in *ListCohortMembersRequest, opts ...grpc.CallOption) (*ListCohortMembersResponse, error)
+	// 安排直播（讲师）
+	CreateLiveSession(ctx context.Context, in *SaveLiveSessionRequest, opts ...grpc.CallOption) (*SaveLiveSessionResponse, error)
+	// 更新直播（讲师）
+	UpdateLiveSession(ctx context.Context, in *SaveLiveSessionRequest, opts ...grpc.CallOption) (*SaveLiveSessionResponse, error)
+	// 取消直播（讲师）
+	DeleteLiveSession(ctx context.Context, in *DeleteLiveSessionRequest, opts ...grpc.CallOption) (*DeleteLiveSessionResponse, error)
+	// 获取日历订阅令牌
+	GetCalendarFeedToken(ctx context.Context, in *GetCalendarFeedTokenRequest, opts ...grpc.CallOption) (*GetCalendarFeedTokenResponse, error)
+	// 根据令牌生成iCalendar订阅内容
+	GetCalendarFeed(ctx context.Context, in *GetCalendarFeedRequest, opts ...grpc.CallOption) (*GetCalendarFeedResponse, error)
+}
+
+type cohortServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCohortServiceClient(cc grpc.ClientConnInterface) CohortServiceClient {
+	return &cohortServiceClient{cc}
+}
+
+func (c *cohortServiceClient) CreateCohort(ctx context.Context, in *CreateCohortRequest, opts ...grpc.CallOption) (*CreateCohortResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCohortResponse)
+	err := c.cc.Invoke(ctx, CohortService_CreateCohort_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cohortServiceClient) UpdateCohort(ctx context.Context, in *UpdateCohortRequest, opts ...grpc.CallOption) (*UpdateCohortResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCohortResponse)
+	err := c.cc.Invoke(ctx, CohortService_UpdateCohort_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cohortServiceClient) GetCohort(ctx context.Context, in *GetCohortRequest, opts ...grpc.CallOption) (*GetCohortResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCohortResponse)
+	err := c.cc.Invoke(ctx, CohortService_GetCohort_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cohortServiceClient) ListCohorts(ctx context.Context, in *ListCohortsRequest, opts ...grpc.CallOption) (*ListCohortsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCohortsResponse)
+	err := c.cc.Invoke(ctx, CohortService_ListCohorts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cohortServiceClient) EnrollCohort(ctx context.Context, in *EnrollCohortRequest, opts ...grpc.CallOption) (*EnrollCohortResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EnrollCohortResponse)
+	err := c.cc.Invoke(ctx, CohortService_EnrollCohort_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cohortServiceClient) LeaveCohort(ctx context.Context, in *LeaveCohortRequest, opts ...grpc.CallOption) (*LeaveCohortResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LeaveCohortResponse)
+	err := c.cc.Invoke(ctx, CohortService_LeaveCohort_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cohortServiceClient) ListCohortMembers(ctx context.Context, in *ListCohortMembersRequest, opts ...grpc.CallOption) (*ListCohortMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCohortMembersResponse)
+	err := c.cc.Invoke(ctx, CohortService_ListCohortMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cohortServiceClient) CreateLiveSession(ctx context.Context, in *SaveLiveSessionRequest, opts ...grpc.CallOption) (*SaveLiveSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveLiveSessionResponse)
+	err := c.cc.Invoke(ctx, CohortService_CreateLiveSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cohortServiceClient) UpdateLiveSession(ctx context.Context, in *SaveLiveSessionRequest, opts ...grpc.CallOption) (*SaveLiveSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveLiveSessionResponse)
+	err := c.cc.Invoke(ctx, CohortService_UpdateLiveSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cohortServiceClient) DeleteLiveSession(ctx context.Context, in *DeleteLiveSessionRequest, opts ...grpc.CallOption) (*DeleteLiveSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteLiveSessionResponse)
+	err := c.cc.Invoke(ctx, CohortService_DeleteLiveSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cohortServiceClient) GetCalendarFeedToken(ctx context.Context, in *GetCalendarFeedTokenRequest, opts ...grpc.CallOption) (*GetCalendarFeedTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCalendarFeedTokenResponse)
+	err := c.cc.Invoke(ctx, CohortService_GetCalendarFeedToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cohortServiceClient) GetCalendarFeed(ctx context.Context, in *GetCalendarFeedRequest, opts ...grpc.CallOption) (*GetCalendarFeedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCalendarFeedResponse)
+	err := c.cc.Invoke(ctx, CohortService_GetCalendarFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CohortServiceServer is the server API for CohortService service.
+// All implementations must embed UnimplementedCohortServiceServer
+// for forward compatibility.
+//
+// 班期与直播服务定义
+type CohortServiceServer interface {
+	// 开设班期（讲师）
+	CreateCohort(context.Context, *CreateCohortRequest) (*CreateCohortResponse, error)
+	// 更新班期（讲师），名额增加时自动递补候补学员
+	UpdateCohort(context.Context, *UpdateCohortRequest) (*UpdateCohortResponse, error)
+	// 获取班期详情及直播安排
+	GetCohort(context.Context, *GetCohortRequest) (*GetCohortResponse, error)
+	// 获取课程的班期列表
+	ListCohorts(context.Context, *ListCohortsRequest) (*ListCohortsResponse, error)
+	// 报名班期，名额已满时进入候补
+	EnrollCohort(context.Context, *EnrollCohortRequest) (*EnrollCohortResponse, error)
+	// 退出班期或取消候补
+	LeaveCohort(context.Context, *LeaveCohortRequest) (*LeaveCohortResponse, error)
+	// 获取班期成员和候补名单（讲师）
+	ListCohortMembers(context.Context, *ListCohortMembersRequest) (*ListCohortMembersResponse, error)
+	// 安排直播（讲师）
+	CreateLiveSession(context.Context, *SaveLiveSessionRequest) (*SaveLiveSessionResponse, error)
+	// 更新直播（讲师）
+	UpdateLiveSession(context.Context, *SaveLiveSessionRequest) (*SaveLiveSessionResponse, error)
+	// 取消直播（讲师）
+	DeleteLiveSession(context.Context, *DeleteLiveSessionRequest) (*DeleteLiveSessionResponse, error)
+	// 获取日历订阅令牌
+	GetCalendarFeedToken(context.Context, *GetCalendarFeedTokenRequest) (*GetCalendarFeedTokenResponse, error)
+	// 根据令牌生成iCalendar订阅内容
+	GetCalendarFeed(context.Context, *GetCalendarFeedRequest) (*GetCalendarFeedResponse, error)
+	mustEmbedUnimplementedCohortServiceServer()
+}
+
+// UnimplementedCohortServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCohortServiceServer struct{}
+
+func (UnimplementedCohortServiceServer) CreateCohort(context.Context, *CreateCohortRequest) (*CreateCohortResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCohort not implemented")
+}
+func (UnimplementedCohortServiceServer) UpdateCohort(context.Context, *UpdateCohortRequest) (*UpdateCohortResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCohort not implemented")
+}
+func (UnimplementedCohortServiceServer) GetCohort(context.Context, *GetCohortRequest) (*GetCohortResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCohort not implemented")
+}
+func (UnimplementedCohortServiceServer) ListCohorts(context.Context, *ListCohortsRequest) (*ListCohortsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCohorts not implemented")
+}
+func (UnimplementedCohortServiceServer) EnrollCohort(context.Context, *EnrollCohortRequest) (*EnrollCohortResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrollCohort not implemented")
+}
+func (UnimplementedCohortServiceServer) LeaveCohort(context.Context, *LeaveCohortRequest) (*LeaveCohortResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveCohort not implemented")
+}
+func (UnimplementedCohortServiceServer) ListCohortMembers(context.Context, *ListCohortMembersRequest) (*ListCohortMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCohortMembers not implemented")
+}
+func (UnimplementedCohortServiceServer) CreateLiveSession(context.Context, *SaveLiveSessionRequest) (*SaveLiveSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLiveSession not implemented")
+}
+func (UnimplementedCohortServiceServer) UpdateLiveSession(context.Context, *SaveLiveSessionRequest) (*SaveLiveSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLiveSession not implemented")
+}
+func (UnimplementedCohortServiceServer) DeleteLiveSession(context.Context, *DeleteLiveSessionRequest) (*DeleteLiveSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteLiveSession not implemented")
+}
+func (UnimplementedCohortServiceServer) GetCalendarFeedToken(context.Context, *GetCalendarFeedTokenRequest) (*GetCalendarFeedTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCalendarFeedToken not implemented")
+}
+func (UnimplementedCohortServiceServer) GetCalendarFeed(context.Context, *GetCalendarFeedRequest) (*GetCalendarFeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCalendarFeed not implemented")
+}
+func (UnimplementedCohortServiceServer) mustEmbedUnimplementedCohortServiceServer() {}
+func (UnimplementedCohortServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeCohortServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CohortServiceServer will
+// result in compilation errors.
+type UnsafeCohortServiceServer interface {
+	mustEmbedUnimplementedCohortServiceServer()
+}
+
+func RegisterCohortServiceServer(s grpc.ServiceRegistrar, srv CohortServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCohortServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CohortService_ServiceDesc, srv)
+}
+
+func _CohortService_CreateCohort_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCohortRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CohortServiceServer).CreateCohort(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CohortService_CreateCohort_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CohortServiceServer).CreateCohort(ctx, req.(*CreateCohortRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CohortService_UpdateCohort_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCohortRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CohortServiceServer).UpdateCohort(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CohortService_UpdateCohort_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CohortServiceServer).UpdateCohort(ctx, req.(*UpdateCohortRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CohortService_GetCohort_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCohortRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CohortServiceServer).GetCohort(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CohortService_GetCohort_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CohortServiceServer).GetCohort(ctx, req.(*GetCohortRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CohortService_ListCohorts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCohortsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CohortServiceServer).ListCohorts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CohortService_ListCohorts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CohortServiceServer).ListCohorts(ctx, req.(*ListCohortsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CohortService_EnrollCohort_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollCohortRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CohortServiceServer).EnrollCohort(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CohortService_EnrollCohort_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CohortServiceServer).EnrollCohort(ctx, req.(*EnrollCohortRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CohortService_LeaveCohort_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveCohortRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CohortServiceServer).LeaveCohort(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CohortService_LeaveCohort_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CohortServiceServer).LeaveCohort(ctx, req.(*LeaveCohortRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CohortService_ListCohortMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCohortMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CohortServiceServer).ListCohortMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CohortService_ListCohortMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CohortServiceServer).ListCohortMembers(ctx, req.(*ListCohortMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CohortService_CreateLiveSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveLiveSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CohortServiceServer).CreateLiveSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CohortService_CreateLiveSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CohortServiceServer).CreateLiveSession(ctx, req.(*SaveLiveSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CohortService_UpdateLiveSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveLiveSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CohortServiceServer).UpdateLiveSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CohortService_UpdateLiveSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CohortServiceServer).UpdateLiveSession(ctx, req.(*SaveLiveSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CohortService_DeleteLiveSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteLiveSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CohortServiceServer).DeleteLiveSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CohortService_DeleteLiveSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CohortServiceServer).DeleteLiveSession(ctx, req.(*DeleteLiveSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CohortService_GetCalendarFeedToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCalendarFeedTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CohortServiceServer).GetCalendarFeedToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CohortService_GetCalendarFeedToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CohortServiceServer).GetCalendarFeedToken(ctx, req.(*GetCalendarFeedTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CohortService_GetCalendarFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCalendarFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CohortServiceServer).GetCalendarFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CohortService_GetCalendarFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CohortServiceServer).GetCalendarFeed(ctx, req.(*GetCalendarFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CohortService_ServiceDesc is the grpc.ServiceDesc for CohortService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CohortService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cohort.CohortService",
+	HandlerType: (*CohortServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateCohort",
+			Handler:    _CohortService_CreateCohort_Handler,
+		},
+		{
+			MethodName: "UpdateCohort",
+			Handler:    _CohortService_UpdateCohort_Handler,
+		},
+		{
+			MethodName: "GetCohort",
+			Handler:    _CohortService_GetCohort_Handler,
+		},
+		{
+			MethodName: "ListCohorts",
+			Handler:    _CohortService_ListCohorts_Handler,
+		},
+		{
+			MethodName: "EnrollCohort",
+			Handler:    _CohortService_EnrollCohort_Handler,
+		},
+		{
+			MethodName: "LeaveCohort",
+			Handler:    _CohortService_LeaveCohort_Handler,
+		},
+		{
+			MethodName: "ListCohortMembers",
+			Handler:    _CohortService_ListCohortMembers_Handler,
+		},
+		{
+			MethodName: "CreateLiveSession",
+			Handler:    _CohortService_CreateLiveSession_Handler,
+		},
+		{
+			MethodName: "UpdateLiveSession",
+			Handler:    _CohortService_UpdateLiveSession_Handler,
+		},
+		{
+			MethodName: "DeleteLiveSession",
+			Handler:    _CohortService_DeleteLiveSession_Handler,
+		},
+		{
+			MethodName: "GetCalendarFeedToken",
+			Handler:    _CohortService_GetCalendarFeedToken_Handler,
+		},
+		{
+			MethodName: "GetCalendarFeed",
+			Handler:    _CohortService_GetCalendarFeed_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "protos/cohort.proto",
+}
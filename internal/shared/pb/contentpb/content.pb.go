@@ -29,6 +29,7 @@ type UploadFileRequest struct {
 	FileType      string                 `protobuf:"bytes,3,opt,name=file_type,json=fileType,proto3" json:"file_type,omitempty"`
 	CourseId      uint32                 `protobuf:"varint,4,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
 	UploaderId    uint32                 `protobuf:"varint,5,opt,name=uploader_id,json=uploaderId,proto3" json:"uploader_id,omitempty"`
+	ChapterId     uint32                 `protobuf:"varint,6,opt,name=chapter_id,json=chapterId,proto3" json:"chapter_id,omitempty"` // 所属章节，0表示课程通用资料
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -98,6 +99,13 @@ func (x *UploadFileRequest) GetUploaderId() uint32 {
 	return 0
 }
 
+func (x *UploadFileRequest) GetChapterId() uint32 {
+	if x != nil {
+		return x.ChapterId
+	}
+	return 0
+}
+
 // 上传文件响应消息
 type UploadFileResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -419,36 +427,28 @@ func (x *DeleteFileResponse) GetMessage() string {
 	return ""
 }
 
-// 文件信息模型
-type FileInfo struct {
+// 获取HLS密钥请求消息
+type GetHLSKeyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	FileId        string                 `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
-	FileName      string                 `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
-	FileUrl       string                 `protobuf:"bytes,3,opt,name=file_url,json=fileUrl,proto3" json:"file_url,omitempty"`
-	FileType      string                 `protobuf:"bytes,4,opt,name=file_type,json=fileType,proto3" json:"file_type,omitempty"`
-	FileSize      int64                  `protobuf:"varint,5,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
-	CourseId      uint32                 `protobuf:"varint,6,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
-	UploaderId    uint32                 `protobuf:"varint,7,opt,name=uploader_id,json=uploaderId,proto3" json:"uploader_id,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     string                 `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FileInfo) Reset() {
-	*x = FileInfo{}
+func (x *GetHLSKeyRequest) Reset() {
+	*x = GetHLSKeyRequest{}
 	mi := &file_protos_content_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FileInfo) String() string {
+func (x *GetHLSKeyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FileInfo) ProtoMessage() {}
+func (*GetHLSKeyRequest) ProtoMessage() {}
 
-func (x *FileInfo) ProtoReflect() protoreflect.Message {
+func (x *GetHLSKeyRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_content_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -460,127 +460,1301 @@ func (x *FileInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FileInfo.ProtoReflect.Descriptor instead.
-func (*FileInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetHLSKeyRequest.ProtoReflect.Descriptor instead.
+func (*GetHLSKeyRequest) Descriptor() ([]byte, []int) {
 	return file_protos_content_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *FileInfo) GetFileId() string {
+func (x *GetHLSKeyRequest) GetFileId() string {
 	if x != nil {
 		return x.FileId
 	}
 	return ""
 }
 
-func (x *FileInfo) GetFileName() string {
+// 获取HLS密钥响应消息
+type GetHLSKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Key           []byte                 `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	CourseId      uint32                 `protobuf:"varint,4,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	UploaderId    uint32                 `protobuf:"varint,5,opt,name=uploader_id,json=uploaderId,proto3" json:"uploader_id,omitempty"`
+	ChapterId     uint32                 `protobuf:"varint,6,opt,name=chapter_id,json=chapterId,proto3" json:"chapter_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHLSKeyResponse) Reset() {
+	*x = GetHLSKeyResponse{}
+	mi := &file_protos_content_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHLSKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHLSKeyResponse) ProtoMessage() {}
+
+func (x *GetHLSKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_content_proto_msgTypes[7]
 	if x != nil {
-		return x.FileName
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *FileInfo) GetFileUrl() string {
+// Deprecated: Use GetHLSKeyResponse.ProtoReflect.Descriptor instead.
+func (*GetHLSKeyResponse) Descriptor() ([]byte, []int) {
+	return file_protos_content_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetHLSKeyResponse) GetCode() int32 {
 	if x != nil {
-		return x.FileUrl
+		return x.Code
 	}
-	return ""
+	return 0
 }
 
-func (x *FileInfo) GetFileType() string {
+func (x *GetHLSKeyResponse) GetMessage() string {
 	if x != nil {
-		return x.FileType
+		return x.Message
 	}
 	return ""
 }
 
-func (x *FileInfo) GetFileSize() int64 {
+func (x *GetHLSKeyResponse) GetKey() []byte {
 	if x != nil {
-		return x.FileSize
+		return x.Key
 	}
-	return 0
+	return nil
 }
 
-func (x *FileInfo) GetCourseId() uint32 {
+func (x *GetHLSKeyResponse) GetCourseId() uint32 {
 	if x != nil {
 		return x.CourseId
 	}
 	return 0
 }
 
-func (x *FileInfo) GetUploaderId() uint32 {
+func (x *GetHLSKeyResponse) GetUploaderId() uint32 {
 	if x != nil {
 		return x.UploaderId
 	}
 	return 0
 }
 
-func (x *FileInfo) GetCreatedAt() string {
+func (x *GetHLSKeyResponse) GetChapterId() uint32 {
 	if x != nil {
-		return x.CreatedAt
+		return x.ChapterId
+	}
+	return 0
+}
+
+// 获取文件请求消息
+type GetFileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileId        string                 `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	Version       uint32                 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`             // 0 表示最新版本
+	UserId        uint32                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 请求者ID，私有文件只对上传者和课程讲师可见
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFileRequest) Reset() {
+	*x = GetFileRequest{}
+	mi := &file_protos_content_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileRequest) ProtoMessage() {}
+
+func (x *GetFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_content_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFileRequest.ProtoReflect.Descriptor instead.
+func (*GetFileRequest) Descriptor() ([]byte, []int) {
+	return file_protos_content_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetFileRequest) GetFileId() string {
+	if x != nil {
+		return x.FileId
 	}
 	return ""
 }
 
-func (x *FileInfo) GetUpdatedAt() string {
+func (x *GetFileRequest) GetVersion() uint32 {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Version
+	}
+	return 0
+}
+
+func (x *GetFileRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// 获取文件响应消息
+type GetFileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	FileInfo      *FileInfo              `protobuf:"bytes,3,opt,name=file_info,json=fileInfo,proto3" json:"file_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFileResponse) Reset() {
+	*x = GetFileResponse{}
+	mi := &file_protos_content_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileResponse) ProtoMessage() {}
+
+func (x *GetFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_content_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFileResponse.ProtoReflect.Descriptor instead.
+func (*GetFileResponse) Descriptor() ([]byte, []int) {
+	return file_protos_content_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetFileResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetFileResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
 	}
 	return ""
 }
 
-var File_protos_content_proto protoreflect.FileDescriptor
+func (x *GetFileResponse) GetFileInfo() *FileInfo {
+	if x != nil {
+		return x.FileInfo
+	}
+	return nil
+}
 
-const file_protos_content_proto_rawDesc = "" +
-	"\n" +
-	"\x14protos/content.proto\x12\acontent\"\xa8\x01\n" +
-	"\x11UploadFileRequest\x12\x1b\n" +
-	"\tfile_name\x18\x01 \x01(\tR\bfileName\x12\x1b\n" +
-	"\tfile_data\x18\x02 \x01(\fR\bfileData\x12\x1b\n" +
-	"\tfile_type\x18\x03 \x01(\tR\bfileType\x12\x1b\n" +
-	"\tcourse_id\x18\x04 \x01(\rR\bcourseId\x12\x1f\n" +
-	"\vuploader_id\x18\x05 \x01(\rR\n" +
-	"uploaderId\"r\n" +
-	"\x12UploadFileResponse\x12\x12\n" +
-	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x12.\n" +
-	"\tfile_info\x18\x03 \x01(\v2\x11.content.FileInfoR\bfileInfo\"|\n" +
-	"\x0fGetFilesRequest\x12\x1b\n" +
-	"\tcourse_id\x18\x01 \x01(\rR\bcourseId\x12\x1b\n" +
-	"\tfile_type\x18\x02 \x01(\tR\bfileType\x12\x12\n" +
-	"\x04page\x18\x03 \x01(\rR\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\rR\bpageSize\"\xb0\x01\n" +
-	"\x10GetFilesResponse\x12\x12\n" +
-	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x12'\n" +
-	"\x05files\x18\x03 \x03(\v2\x11.content.FileInfoR\x05files\x12\x14\n" +
-	"\x05total\x18\x04 \x01(\rR\x05total\x12\x12\n" +
-	"\x04page\x18\x05 \x01(\rR\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x06 \x01(\rR\bpageSize\"E\n" +
-	"\x11DeleteFileRequest\x12\x17\n" +
-	"\afile_id\x18\x01 \x01(\tR\x06fileId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\rR\x06userId\"B\n" +
-	"\x12DeleteFileResponse\x12\x12\n" +
-	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\x91\x02\n" +
-	"\bFileInfo\x12\x17\n" +
-	"\afile_id\x18\x01 \x01(\tR\x06fileId\x12\x1b\n" +
-	"\tfile_name\x18\x02 \x01(\tR\bfileName\x12\x19\n" +
-	"\bfile_url\x18\x03 \x01(\tR\afileUrl\x12\x1b\n" +
-	"\tfile_type\x18\x04 \x01(\tR\bfileType\x12\x1b\n" +
-	"\tfile_size\x18\x05 \x01(\x03R\bfileSize\x12\x1b\n" +
-	"\tcourse_id\x18\x06 \x01(\rR\bcourseId\x12\x1f\n" +
-	"\vuploader_id\x18\a \x01(\rR\n" +
-	"uploaderId\x12\x1d\n" +
-	"\n" +
-	"created_at\x18\b \x01(\tR\tcreatedAt\x12\x1d\n" +
+// 替换文件请求消息
+type ReplaceFileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileId        string                 `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	FileName      string                 `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	FileData      []byte                 `protobuf:"bytes,3,opt,name=file_data,json=fileData,proto3" json:"file_data,omitempty"`
+	UserId        uint32                 `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplaceFileRequest) Reset() {
+	*x = ReplaceFileRequest{}
+	mi := &file_protos_content_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplaceFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplaceFileRequest) ProtoMessage() {}
+
+func (x *ReplaceFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_content_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplaceFileRequest.ProtoReflect.Descriptor instead.
+func (*ReplaceFileRequest) Descriptor() ([]byte, []int) {
+	return file_protos_content_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ReplaceFileRequest) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *ReplaceFileRequest) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ReplaceFileRequest) GetFileData() []byte {
+	if x != nil {
+		return x.FileData
+	}
+	return nil
+}
+
+func (x *ReplaceFileRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// 替换文件响应消息
+type ReplaceFileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	FileInfo      *FileInfo              `protobuf:"bytes,3,opt,name=file_info,json=fileInfo,proto3" json:"file_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplaceFileResponse) Reset() {
+	*x = ReplaceFileResponse{}
+	mi := &file_protos_content_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplaceFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplaceFileResponse) ProtoMessage() {}
+
+func (x *ReplaceFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_content_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplaceFileResponse.ProtoReflect.Descriptor instead.
+func (*ReplaceFileResponse) Descriptor() ([]byte, []int) {
+	return file_protos_content_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ReplaceFileResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ReplaceFileResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ReplaceFileResponse) GetFileInfo() *FileInfo {
+	if x != nil {
+		return x.FileInfo
+	}
+	return nil
+}
+
+// 获取文件版本列表请求消息
+type ListFileVersionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileId        string                 `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 请求者ID，仅上传者和课程讲师可查看
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFileVersionsRequest) Reset() {
+	*x = ListFileVersionsRequest{}
+	mi := &file_protos_content_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFileVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFileVersionsRequest) ProtoMessage() {}
+
+func (x *ListFileVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_content_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFileVersionsRequest.ProtoReflect.Descriptor instead.
+func (*ListFileVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_protos_content_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListFileVersionsRequest) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *ListFileVersionsRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// 获取文件版本列表响应消息
+type ListFileVersionsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Code           int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message        string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Versions       []*FileVersion         `protobuf:"bytes,3,rep,name=versions,proto3" json:"versions,omitempty"`
+	CurrentVersion uint32                 `protobuf:"varint,4,opt,name=current_version,json=currentVersion,proto3" json:"current_version,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListFileVersionsResponse) Reset() {
+	*x = ListFileVersionsResponse{}
+	mi := &file_protos_content_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFileVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFileVersionsResponse) ProtoMessage() {}
+
+func (x *ListFileVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_content_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFileVersionsResponse.ProtoReflect.Descriptor instead.
+func (*ListFileVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_protos_content_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListFileVersionsResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListFileVersionsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListFileVersionsResponse) GetVersions() []*FileVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+func (x *ListFileVersionsResponse) GetCurrentVersion() uint32 {
+	if x != nil {
+		return x.CurrentVersion
+	}
+	return 0
+}
+
+// 回滚文件版本请求消息
+type RevertFileVersionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileId        string                 `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	Version       uint32                 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	UserId        uint32                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevertFileVersionRequest) Reset() {
+	*x = RevertFileVersionRequest{}
+	mi := &file_protos_content_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevertFileVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertFileVersionRequest) ProtoMessage() {}
+
+func (x *RevertFileVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_content_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertFileVersionRequest.ProtoReflect.Descriptor instead.
+func (*RevertFileVersionRequest) Descriptor() ([]byte, []int) {
+	return file_protos_content_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RevertFileVersionRequest) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *RevertFileVersionRequest) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *RevertFileVersionRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// 回滚文件版本响应消息
+type RevertFileVersionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	FileInfo      *FileInfo              `protobuf:"bytes,3,opt,name=file_info,json=fileInfo,proto3" json:"file_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevertFileVersionResponse) Reset() {
+	*x = RevertFileVersionResponse{}
+	mi := &file_protos_content_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevertFileVersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertFileVersionResponse) ProtoMessage() {}
+
+func (x *RevertFileVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_content_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertFileVersionResponse.ProtoReflect.Descriptor instead.
+func (*RevertFileVersionResponse) Descriptor() ([]byte, []int) {
+	return file_protos_content_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RevertFileVersionResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *RevertFileVersionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RevertFileVersionResponse) GetFileInfo() *FileInfo {
+	if x != nil {
+		return x.FileInfo
+	}
+	return nil
+}
+
+// 压缩包中的附加文件
+type ArchiveEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // 压缩包内的路径，如 profile.json
+	Data          []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveEntry) Reset() {
+	*x = ArchiveEntry{}
+	mi := &file_protos_content_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveEntry) ProtoMessage() {}
+
+func (x *ArchiveEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_content_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveEntry.ProtoReflect.Descriptor instead.
+func (*ArchiveEntry) Descriptor() ([]byte, []int) {
+	return file_protos_content_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ArchiveEntry) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ArchiveEntry) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// 打包用户数据请求消息
+type BuildUserArchiveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FileName      string                 `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	Entries       []*ArchiveEntry        `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildUserArchiveRequest) Reset() {
+	*x = BuildUserArchiveRequest{}
+	mi := &file_protos_content_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildUserArchiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildUserArchiveRequest) ProtoMessage() {}
+
+func (x *BuildUserArchiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_content_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildUserArchiveRequest.ProtoReflect.Descriptor instead.
+func (*BuildUserArchiveRequest) Descriptor() ([]byte, []int) {
+	return file_protos_content_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *BuildUserArchiveRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *BuildUserArchiveRequest) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *BuildUserArchiveRequest) GetEntries() []*ArchiveEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// 打包用户数据响应消息，file_count 为打包的上传文件数量
+type BuildUserArchiveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	FileInfo      *FileInfo              `protobuf:"bytes,3,opt,name=file_info,json=fileInfo,proto3" json:"file_info,omitempty"`
+	FileCount     uint32                 `protobuf:"varint,4,opt,name=file_count,json=fileCount,proto3" json:"file_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildUserArchiveResponse) Reset() {
+	*x = BuildUserArchiveResponse{}
+	mi := &file_protos_content_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildUserArchiveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildUserArchiveResponse) ProtoMessage() {}
+
+func (x *BuildUserArchiveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_content_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildUserArchiveResponse.ProtoReflect.Descriptor instead.
+func (*BuildUserArchiveResponse) Descriptor() ([]byte, []int) {
+	return file_protos_content_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *BuildUserArchiveResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *BuildUserArchiveResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BuildUserArchiveResponse) GetFileInfo() *FileInfo {
+	if x != nil {
+		return x.FileInfo
+	}
+	return nil
+}
+
+func (x *BuildUserArchiveResponse) GetFileCount() uint32 {
+	if x != nil {
+		return x.FileCount
+	}
+	return 0
+}
+
+// 清理用户文件请求消息，transfer_course_ids 中课程的文件转交给 transfer_to，其余全部删除
+type PurgeUserFilesRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	UserId            uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TransferCourseIds []uint32               `protobuf:"varint,2,rep,packed,name=transfer_course_ids,json=transferCourseIds,proto3" json:"transfer_course_ids,omitempty"`
+	TransferTo        uint32                 `protobuf:"varint,3,opt,name=transfer_to,json=transferTo,proto3" json:"transfer_to,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PurgeUserFilesRequest) Reset() {
+	*x = PurgeUserFilesRequest{}
+	mi := &file_protos_content_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeUserFilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeUserFilesRequest) ProtoMessage() {}
+
+func (x *PurgeUserFilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_content_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeUserFilesRequest.ProtoReflect.Descriptor instead.
+func (*PurgeUserFilesRequest) Descriptor() ([]byte, []int) {
+	return file_protos_content_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PurgeUserFilesRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PurgeUserFilesRequest) GetTransferCourseIds() []uint32 {
+	if x != nil {
+		return x.TransferCourseIds
+	}
+	return nil
+}
+
+func (x *PurgeUserFilesRequest) GetTransferTo() uint32 {
+	if x != nil {
+		return x.TransferTo
+	}
+	return 0
+}
+
+// 清理用户文件响应消息
+type PurgeUserFilesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Deleted       uint32                 `protobuf:"varint,3,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	Transferred   uint32                 `protobuf:"varint,4,opt,name=transferred,proto3" json:"transferred,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeUserFilesResponse) Reset() {
+	*x = PurgeUserFilesResponse{}
+	mi := &file_protos_content_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeUserFilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeUserFilesResponse) ProtoMessage() {}
+
+func (x *PurgeUserFilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_content_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeUserFilesResponse.ProtoReflect.Descriptor instead.
+func (*PurgeUserFilesResponse) Descriptor() ([]byte, []int) {
+	return file_protos_content_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *PurgeUserFilesResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *PurgeUserFilesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PurgeUserFilesResponse) GetDeleted() uint32 {
+	if x != nil {
+		return x.Deleted
+	}
+	return 0
+}
+
+func (x *PurgeUserFilesResponse) GetTransferred() uint32 {
+	if x != nil {
+		return x.Transferred
+	}
+	return 0
+}
+
+// 文件版本模型
+type FileVersion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       uint32                 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	FileName      string                 `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	FileUrl       string                 `protobuf:"bytes,3,opt,name=file_url,json=fileUrl,proto3" json:"file_url,omitempty"`
+	FileSize      int64                  `protobuf:"varint,4,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
+	UploaderId    uint32                 `protobuf:"varint,5,opt,name=uploader_id,json=uploaderId,proto3" json:"uploader_id,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	RevertedFrom  uint32                 `protobuf:"varint,7,opt,name=reverted_from,json=revertedFrom,proto3" json:"reverted_from,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileVersion) Reset() {
+	*x = FileVersion{}
+	mi := &file_protos_content_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileVersion) ProtoMessage() {}
+
+func (x *FileVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_content_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileVersion.ProtoReflect.Descriptor instead.
+func (*FileVersion) Descriptor() ([]byte, []int) {
+	return file_protos_content_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *FileVersion) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *FileVersion) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *FileVersion) GetFileUrl() string {
+	if x != nil {
+		return x.FileUrl
+	}
+	return ""
+}
+
+func (x *FileVersion) GetFileSize() int64 {
+	if x != nil {
+		return x.FileSize
+	}
+	return 0
+}
+
+func (x *FileVersion) GetUploaderId() uint32 {
+	if x != nil {
+		return x.UploaderId
+	}
+	return 0
+}
+
+func (x *FileVersion) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *FileVersion) GetRevertedFrom() uint32 {
+	if x != nil {
+		return x.RevertedFrom
+	}
+	return 0
+}
+
+// 文件信息模型
+type FileInfo struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	FileId         string                 `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	FileName       string                 `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	FileUrl        string                 `protobuf:"bytes,3,opt,name=file_url,json=fileUrl,proto3" json:"file_url,omitempty"`
+	FileType       string                 `protobuf:"bytes,4,opt,name=file_type,json=fileType,proto3" json:"file_type,omitempty"`
+	FileSize       int64                  `protobuf:"varint,5,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
+	CourseId       uint32                 `protobuf:"varint,6,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	UploaderId     uint32                 `protobuf:"varint,7,opt,name=uploader_id,json=uploaderId,proto3" json:"uploader_id,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      string                 `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	HlsStatus      string                 `protobuf:"bytes,10,opt,name=hls_status,json=hlsStatus,proto3" json:"hls_status,omitempty"`
+	HlsPlaylistUrl string                 `protobuf:"bytes,11,opt,name=hls_playlist_url,json=hlsPlaylistUrl,proto3" json:"hls_playlist_url,omitempty"`
+	HlsEncrypted   bool                   `protobuf:"varint,12,opt,name=hls_encrypted,json=hlsEncrypted,proto3" json:"hls_encrypted,omitempty"`
+	Version        uint32                 `protobuf:"varint,13,opt,name=version,proto3" json:"version,omitempty"`
+	ChapterId      uint32                 `protobuf:"varint,14,opt,name=chapter_id,json=chapterId,proto3" json:"chapter_id,omitempty"` // 所属章节，0表示课程通用资料
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *FileInfo) Reset() {
+	*x = FileInfo{}
+	mi := &file_protos_content_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileInfo) ProtoMessage() {}
+
+func (x *FileInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_content_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileInfo.ProtoReflect.Descriptor instead.
+func (*FileInfo) Descriptor() ([]byte, []int) {
+	return file_protos_content_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *FileInfo) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *FileInfo) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *FileInfo) GetFileUrl() string {
+	if x != nil {
+		return x.FileUrl
+	}
+	return ""
+}
+
+func (x *FileInfo) GetFileType() string {
+	if x != nil {
+		return x.FileType
+	}
+	return ""
+}
+
+func (x *FileInfo) GetFileSize() int64 {
+	if x != nil {
+		return x.FileSize
+	}
+	return 0
+}
+
+func (x *FileInfo) GetCourseId() uint32 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *FileInfo) GetUploaderId() uint32 {
+	if x != nil {
+		return x.UploaderId
+	}
+	return 0
+}
+
+func (x *FileInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *FileInfo) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *FileInfo) GetHlsStatus() string {
+	if x != nil {
+		return x.HlsStatus
+	}
+	return ""
+}
+
+func (x *FileInfo) GetHlsPlaylistUrl() string {
+	if x != nil {
+		return x.HlsPlaylistUrl
+	}
+	return ""
+}
+
+func (x *FileInfo) GetHlsEncrypted() bool {
+	if x != nil {
+		return x.HlsEncrypted
+	}
+	return false
+}
+
+func (x *FileInfo) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *FileInfo) GetChapterId() uint32 {
+	if x != nil {
+		return x.ChapterId
+	}
+	return 0
+}
+
+var File_protos_content_proto protoreflect.FileDescriptor
+
+const file_protos_content_proto_rawDesc = "" +
+	"\n" +
+	"\x14protos/content.proto\x12\acontent\"\xc7\x01\n" +
+	"\x11UploadFileRequest\x12\x1b\n" +
+	"\tfile_name\x18\x01 \x01(\tR\bfileName\x12\x1b\n" +
+	"\tfile_data\x18\x02 \x01(\fR\bfileData\x12\x1b\n" +
+	"\tfile_type\x18\x03 \x01(\tR\bfileType\x12\x1b\n" +
+	"\tcourse_id\x18\x04 \x01(\rR\bcourseId\x12\x1f\n" +
+	"\vuploader_id\x18\x05 \x01(\rR\n" +
+	"uploaderId\x12\x1d\n" +
+	"\n" +
+	"chapter_id\x18\x06 \x01(\rR\tchapterId\"r\n" +
+	"\x12UploadFileResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12.\n" +
+	"\tfile_info\x18\x03 \x01(\v2\x11.content.FileInfoR\bfileInfo\"|\n" +
+	"\x0fGetFilesRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\rR\bcourseId\x12\x1b\n" +
+	"\tfile_type\x18\x02 \x01(\tR\bfileType\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\rR\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\rR\bpageSize\"\xb0\x01\n" +
+	"\x10GetFilesResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12'\n" +
+	"\x05files\x18\x03 \x03(\v2\x11.content.FileInfoR\x05files\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\rR\x05total\x12\x12\n" +
+	"\x04page\x18\x05 \x01(\rR\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x06 \x01(\rR\bpageSize\"E\n" +
+	"\x11DeleteFileRequest\x12\x17\n" +
+	"\afile_id\x18\x01 \x01(\tR\x06fileId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\"B\n" +
+	"\x12DeleteFileResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"+\n" +
+	"\x10GetHLSKeyRequest\x12\x17\n" +
+	"\afile_id\x18\x01 \x01(\tR\x06fileId\"\xb0\x01\n" +
+	"\x11GetHLSKeyResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x10\n" +
+	"\x03key\x18\x03 \x01(\fR\x03key\x12\x1b\n" +
+	"\tcourse_id\x18\x04 \x01(\rR\bcourseId\x12\x1f\n" +
+	"\vuploader_id\x18\x05 \x01(\rR\n" +
+	"uploaderId\x12\x1d\n" +
+	"\n" +
+	"chapter_id\x18\x06 \x01(\rR\tchapterId\"\\\n" +
+	"\x0eGetFileRequest\x12\x17\n" +
+	"\afile_id\x18\x01 \x01(\tR\x06fileId\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\rR\aversion\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\rR\x06userId\"o\n" +
+	"\x0fGetFileResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12.\n" +
+	"\tfile_info\x18\x03 \x01(\v2\x11.content.FileInfoR\bfileInfo\"\x80\x01\n" +
+	"\x12ReplaceFileRequest\x12\x17\n" +
+	"\afile_id\x18\x01 \x01(\tR\x06fileId\x12\x1b\n" +
+	"\tfile_name\x18\x02 \x01(\tR\bfileName\x12\x1b\n" +
+	"\tfile_data\x18\x03 \x01(\fR\bfileData\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\rR\x06userId\"s\n" +
+	"\x13ReplaceFileResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12.\n" +
+	"\tfile_info\x18\x03 \x01(\v2\x11.content.FileInfoR\bfileInfo\"K\n" +
+	"\x17ListFileVersionsRequest\x12\x17\n" +
+	"\afile_id\x18\x01 \x01(\tR\x06fileId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\"\xa3\x01\n" +
+	"\x18ListFileVersionsResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x120\n" +
+	"\bversions\x18\x03 \x03(\v2\x14.content.FileVersionR\bversions\x12'\n" +
+	"\x0fcurrent_version\x18\x04 \x01(\rR\x0ecurrentVersion\"f\n" +
+	"\x18RevertFileVersionRequest\x12\x17\n" +
+	"\afile_id\x18\x01 \x01(\tR\x06fileId\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\rR\aversion\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\rR\x06userId\"y\n" +
+	"\x19RevertFileVersionResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12.\n" +
+	"\tfile_info\x18\x03 \x01(\v2\x11.content.FileInfoR\bfileInfo\"6\n" +
+	"\fArchiveEntry\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\"\x80\x01\n" +
+	"\x17BuildUserArchiveRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12\x1b\n" +
+	"\tfile_name\x18\x02 \x01(\tR\bfileName\x12/\n" +
+	"\aentries\x18\x03 \x03(\v2\x15.content.ArchiveEntryR\aentries\"\x97\x01\n" +
+	"\x18BuildUserArchiveResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12.\n" +
+	"\tfile_info\x18\x03 \x01(\v2\x11.content.FileInfoR\bfileInfo\x12\x1d\n" +
+	"\n" +
+	"file_count\x18\x04 \x01(\rR\tfileCount\"\x81\x01\n" +
+	"\x15PurgeUserFilesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12.\n" +
+	"\x13transfer_course_ids\x18\x02 \x03(\rR\x11transferCourseIds\x12\x1f\n" +
+	"\vtransfer_to\x18\x03 \x01(\rR\n" +
+	"transferTo\"\x82\x01\n" +
+	"\x16PurgeUserFilesResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\adeleted\x18\x03 \x01(\rR\adeleted\x12 \n" +
+	"\vtransferred\x18\x04 \x01(\rR\vtransferred\"\xe1\x01\n" +
+	"\vFileVersion\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\rR\aversion\x12\x1b\n" +
+	"\tfile_name\x18\x02 \x01(\tR\bfileName\x12\x19\n" +
+	"\bfile_url\x18\x03 \x01(\tR\afileUrl\x12\x1b\n" +
+	"\tfile_size\x18\x04 \x01(\x03R\bfileSize\x12\x1f\n" +
+	"\vuploader_id\x18\x05 \x01(\rR\n" +
+	"uploaderId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12#\n" +
+	"\rreverted_from\x18\a \x01(\rR\frevertedFrom\"\xb8\x03\n" +
+	"\bFileInfo\x12\x17\n" +
+	"\afile_id\x18\x01 \x01(\tR\x06fileId\x12\x1b\n" +
+	"\tfile_name\x18\x02 \x01(\tR\bfileName\x12\x19\n" +
+	"\bfile_url\x18\x03 \x01(\tR\afileUrl\x12\x1b\n" +
+	"\tfile_type\x18\x04 \x01(\tR\bfileType\x12\x1b\n" +
+	"\tfile_size\x18\x05 \x01(\x03R\bfileSize\x12\x1b\n" +
+	"\tcourse_id\x18\x06 \x01(\rR\bcourseId\x12\x1f\n" +
+	"\vuploader_id\x18\a \x01(\rR\n" +
+	"uploaderId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\tR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"hls_status\x18\n" +
+	" \x01(\tR\thlsStatus\x12(\n" +
+	"\x10hls_playlist_url\x18\v \x01(\tR\x0ehlsPlaylistUrl\x12#\n" +
+	"\rhls_encrypted\x18\f \x01(\bR\fhlsEncrypted\x12\x18\n" +
+	"\aversion\x18\r \x01(\rR\aversion\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\t \x01(\tR\tupdatedAt2\xdf\x01\n" +
+	"chapter_id\x18\x0e \x01(\rR\tchapterId2\x8c\x06\n" +
 	"\x0eContentService\x12E\n" +
 	"\n" +
 	"UploadFile\x12\x1a.content.UploadFileRequest\x1a\x1b.content.UploadFileResponse\x12?\n" +
 	"\bGetFiles\x12\x18.content.GetFilesRequest\x1a\x19.content.GetFilesResponse\x12E\n" +
 	"\n" +
-	"DeleteFile\x12\x1a.content.DeleteFileRequest\x1a\x1b.content.DeleteFileResponseB.Z,course-platform/internal/shared/pb/contentpbb\x06proto3"
+	"DeleteFile\x12\x1a.content.DeleteFileRequest\x1a\x1b.content.DeleteFileResponse\x12B\n" +
+	"\tGetHLSKey\x12\x19.content.GetHLSKeyRequest\x1a\x1a.content.GetHLSKeyResponse\x12<\n" +
+	"\aGetFile\x12\x17.content.GetFileRequest\x1a\x18.content.GetFileResponse\x12H\n" +
+	"\vReplaceFile\x12\x1b.content.ReplaceFileRequest\x1a\x1c.content.ReplaceFileResponse\x12W\n" +
+	"\x10ListFileVersions\x12 .content.ListFileVersionsRequest\x1a!.content.ListFileVersionsResponse\x12Z\n" +
+	"\x11RevertFileVersion\x12!.content.RevertFileVersionRequest\x1a\".content.RevertFileVersionResponse\x12W\n" +
+	"\x10BuildUserArchive\x12 .content.BuildUserArchiveRequest\x1a!.content.BuildUserArchiveResponse\x12Q\n" +
+	"\x0ePurgeUserFiles\x12\x1e.content.PurgeUserFilesRequest\x1a\x1f.content.PurgeUserFilesResponseB.Z,course-platform/internal/shared/pb/contentpbb\x06proto3"
 
 var (
 	file_protos_content_proto_rawDescOnce sync.Once
@@ -594,30 +1768,66 @@ func file_protos_content_proto_rawDescGZIP() []byte {
 	return file_protos_content_proto_rawDescData
 }
 
-var file_protos_content_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_protos_content_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_protos_content_proto_goTypes = []any{
-	(*UploadFileRequest)(nil),  // 0: content.UploadFileRequest
-	(*UploadFileResponse)(nil), // 1: content.UploadFileResponse
-	(*GetFilesRequest)(nil),    // 2: content.GetFilesRequest
-	(*GetFilesResponse)(nil),   // 3: content.GetFilesResponse
-	(*DeleteFileRequest)(nil),  // 4: content.DeleteFileRequest
-	(*DeleteFileResponse)(nil), // 5: content.DeleteFileResponse
-	(*FileInfo)(nil),           // 6: content.FileInfo
+	(*UploadFileRequest)(nil),         // 0: content.UploadFileRequest
+	(*UploadFileResponse)(nil),        // 1: content.UploadFileResponse
+	(*GetFilesRequest)(nil),           // 2: content.GetFilesRequest
+	(*GetFilesResponse)(nil),          // 3: content.GetFilesResponse
+	(*DeleteFileRequest)(nil),         // 4: content.DeleteFileRequest
+	(*DeleteFileResponse)(nil),        // 5: content.DeleteFileResponse
+	(*GetHLSKeyRequest)(nil),          // 6: content.GetHLSKeyRequest
+	(*GetHLSKeyResponse)(nil),         // 7: content.GetHLSKeyResponse
+	(*GetFileRequest)(nil),            // 8: content.GetFileRequest
+	(*GetFileResponse)(nil),           // 9: content.GetFileResponse
+	(*ReplaceFileRequest)(nil),        // 10: content.ReplaceFileRequest
+	(*ReplaceFileResponse)(nil),       // 11: content.ReplaceFileResponse
+	(*ListFileVersionsRequest)(nil),   // 12: content.ListFileVersionsRequest
+	(*ListFileVersionsResponse)(nil),  // 13: content.ListFileVersionsResponse
+	(*RevertFileVersionRequest)(nil),  // 14: content.RevertFileVersionRequest
+	(*RevertFileVersionResponse)(nil), // 15: content.RevertFileVersionResponse
+	(*ArchiveEntry)(nil),              // 16: content.ArchiveEntry
+	(*BuildUserArchiveRequest)(nil),   // 17: content.BuildUserArchiveRequest
+	(*BuildUserArchiveResponse)(nil),  // 18: content.BuildUserArchiveResponse
+	(*PurgeUserFilesRequest)(nil),     // 19: content.PurgeUserFilesRequest
+	(*PurgeUserFilesResponse)(nil),    // 20: content.PurgeUserFilesResponse
+	(*FileVersion)(nil),               // 21: content.FileVersion
+	(*FileInfo)(nil),                  // 22: content.FileInfo
 }
 var file_protos_content_proto_depIdxs = []int32{
-	6, // 0: content.UploadFileResponse.file_info:type_name -> content.FileInfo
-	6, // 1: content.GetFilesResponse.files:type_name -> content.FileInfo
-	0, // 2: content.ContentService.UploadFile:input_type -> content.UploadFileRequest
-	2, // 3: content.ContentService.GetFiles:input_type -> content.GetFilesRequest
-	4, // 4: content.ContentService.DeleteFile:input_type -> content.DeleteFileRequest
-	1, // 5: content.ContentService.UploadFile:output_type -> content.UploadFileResponse
-	3, // 6: content.ContentService.GetFiles:output_type -> content.GetFilesResponse
-	5, // 7: content.ContentService.DeleteFile:output_type -> content.DeleteFileResponse
-	5, // [5:8] is the sub-list for method output_type
-	2, // [2:5] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	22, // 0: content.UploadFileResponse.file_info:type_name -> content.FileInfo
+	22, // 1: content.GetFilesResponse.files:type_name -> content.FileInfo
+	22, // 2: content.GetFileResponse.file_info:type_name -> content.FileInfo
+	22, // 3: content.ReplaceFileResponse.file_info:type_name -> content.FileInfo
+	21, // 4: content.ListFileVersionsResponse.versions:type_name -> content.FileVersion
+	22, // 5: content.RevertFileVersionResponse.file_info:type_name -> content.FileInfo
+	16, // 6: content.BuildUserArchiveRequest.entries:type_name -> content.ArchiveEntry
+	22, // 7: content.BuildUserArchiveResponse.file_info:type_name -> content.FileInfo
+	0,  // 8: content.ContentService.UploadFile:input_type -> content.UploadFileRequest
+	2,  // 9: content.ContentService.GetFiles:input_type -> content.GetFilesRequest
+	4,  // 10: content.ContentService.DeleteFile:input_type -> content.DeleteFileRequest
+	6,  // 11: content.ContentService.GetHLSKey:input_type -> content.GetHLSKeyRequest
+	8,  // 12: content.ContentService.GetFile:input_type -> content.GetFileRequest
+	10, // 13: content.ContentService.ReplaceFile:input_type -> content.ReplaceFileRequest
+	12, // 14: content.ContentService.ListFileVersions:input_type -> content.ListFileVersionsRequest
+	14, // 15: content.ContentService.RevertFileVersion:input_type -> content.RevertFileVersionRequest
+	17, // 16: content.ContentService.BuildUserArchive:input_type -> content.BuildUserArchiveRequest
+	19, // 17: content.ContentService.PurgeUserFiles:input_type -> content.PurgeUserFilesRequest
+	1,  // 18: content.ContentService.UploadFile:output_type -> content.UploadFileResponse
+	3,  // 19: content.ContentService.GetFiles:output_type -> content.GetFilesResponse
+	5,  // 20: content.ContentService.DeleteFile:output_type -> content.DeleteFileResponse
+	7,  // 21: content.ContentService.GetHLSKey:output_type -> content.GetHLSKeyResponse
+	9,  // 22: content.ContentService.GetFile:output_type -> content.GetFileResponse
+	11, // 23: content.ContentService.ReplaceFile:output_type -> content.ReplaceFileResponse
+	13, // 24: content.ContentService.ListFileVersions:output_type -> content.ListFileVersionsResponse
+	15, // 25: content.ContentService.RevertFileVersion:output_type -> content.RevertFileVersionResponse
+	18, // 26: content.ContentService.BuildUserArchive:output_type -> content.BuildUserArchiveResponse
+	20, // 27: content.ContentService.PurgeUserFiles:output_type -> content.PurgeUserFilesResponse
+	18, // [18:28] is the sub-list for method output_type
+	8,  // [8:18] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_protos_content_proto_init() }
@@ -631,7 +1841,7 @@ func file_protos_content_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_protos_content_proto_rawDesc), len(file_protos_content_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
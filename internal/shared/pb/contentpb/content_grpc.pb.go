@@ -19,9 +19,16 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ContentService_UploadFile_FullMethodName = "/content.ContentService/UploadFile"
-	ContentService_GetFiles_FullMethodName   = "/content.ContentService/GetFiles"
-	ContentService_DeleteFile_FullMethodName = "/content.ContentService/DeleteFile"
+	ContentService_UploadFile_FullMethodName        = "/content.ContentService/UploadFile"
+	ContentService_GetFiles_FullMethodName          = "/content.ContentService/GetFiles"
+	ContentService_DeleteFile_FullMethodName        = "/content.ContentService/DeleteFile"
+	ContentService_GetHLSKey_FullMethodName         = "/content.ContentService/GetHLSKey"
+	ContentService_GetFile_FullMethodName           = "/content.ContentService/GetFile"
+	ContentService_ReplaceFile_FullMethodName       = "/content.ContentService/ReplaceFile"
+	ContentService_ListFileVersions_FullMethodName  = "/content.ContentService/ListFileVersions"
+	ContentService_RevertFileVersion_FullMethodName = "/content.ContentService/RevertFileVersion"
+	ContentService_BuildUserArchive_FullMethodName  = "/content.ContentService/BuildUserArchive"
+	ContentService_PurgeUserFiles_FullMethodName    = "/content.ContentService/PurgeUserFiles"
 )
 
 // ContentServiceClient is the client API for ContentService service.
@@ -36,6 +43,20 @@ type ContentServiceClient interface {
 	GetFiles(ctx context.Context, in *GetFilesRequest, opts ...grpc.CallOption) (*GetFilesResponse, error)
 	// 删除文件
 	DeleteFile(ctx context.Context, in *DeleteFileRequest, opts ...grpc.CallOption) (*DeleteFileResponse, error)
+	// 获取HLS分片解密密钥
+	GetHLSKey(ctx context.Context, in *GetHLSKeyRequest, opts ...grpc.CallOption) (*GetHLSKeyResponse, error)
+	// 获取单个文件（可指定版本，默认最新版本）
+	GetFile(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (*GetFileResponse, error)
+	// 替换文件内容，保留文件ID并生成新版本
+	ReplaceFile(ctx context.Context, in *ReplaceFileRequest, opts ...grpc.CallOption) (*ReplaceFileResponse, error)
+	// 获取文件版本列表
+	ListFileVersions(ctx context.Context, in *ListFileVersionsRequest, opts ...grpc.CallOption) (*ListFileVersionsResponse, error)
+	// 回滚到指定版本
+	RevertFileVersion(ctx context.Context, in *RevertFileVersionRequest, opts ...grpc.CallOption) (*RevertFileVersionResponse, error)
+	// 把用户上传的全部文件和附加数据打包为ZIP（个人数据导出）
+	BuildUserArchive(ctx context.Context, in *BuildUserArchiveRequest, opts ...grpc.CallOption) (*BuildUserArchiveResponse, error)
+	// 删除用户上传的文件，转交课程中的文件改为新上传者（账号注销）
+	PurgeUserFiles(ctx context.Context, in *PurgeUserFilesRequest, opts ...grpc.CallOption) (*PurgeUserFilesResponse, error)
 }
 
 type contentServiceClient struct {
@@ -76,6 +97,76 @@ func (c *contentServiceClient) DeleteFile(ctx context.Context, in *DeleteFileReq
 	return out, nil
 }
 
+func (c *contentServiceClient) GetHLSKey(ctx context.Context, in *GetHLSKeyRequest, opts ...grpc.CallOption) (*GetHLSKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHLSKeyResponse)
+	err := c.cc.Invoke(ctx, ContentService_GetHLSKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) GetFile(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (*GetFileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFileResponse)
+	err := c.cc.Invoke(ctx, ContentService_GetFile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) ReplaceFile(ctx context.Context, in *ReplaceFileRequest, opts ...grpc.CallOption) (*ReplaceFileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplaceFileResponse)
+	err := c.cc.Invoke(ctx, ContentService_ReplaceFile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) ListFileVersions(ctx context.Context, in *ListFileVersionsRequest, opts ...grpc.CallOption) (*ListFileVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFileVersionsResponse)
+	err := c.cc.Invoke(ctx, ContentService_ListFileVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) RevertFileVersion(ctx context.Context, in *RevertFileVersionRequest, opts ...grpc.CallOption) (*RevertFileVersionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevertFileVersionResponse)
+	err := c.cc.Invoke(ctx, ContentService_RevertFileVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) BuildUserArchive(ctx context.Context, in *BuildUserArchiveRequest, opts ...grpc.CallOption) (*BuildUserArchiveResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BuildUserArchiveResponse)
+	err := c.cc.Invoke(ctx, ContentService_BuildUserArchive_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contentServiceClient) PurgeUserFiles(ctx context.Context, in *PurgeUserFilesRequest, opts ...grpc.CallOption) (*PurgeUserFilesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeUserFilesResponse)
+	err := c.cc.Invoke(ctx, ContentService_PurgeUserFiles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ContentServiceServer is the server API for ContentService service.
 // All implementations must embed UnimplementedContentServiceServer
 // for forward compatibility.
@@ -88,6 +179,20 @@ type ContentServiceServer interface {
 	GetFiles(context.Context, *GetFilesRequest) (*GetFilesResponse, error)
 	// 删除文件
 	DeleteFile(context.Context, *DeleteFileRequest) (*DeleteFileResponse, error)
+	// 获取HLS分片解密密钥
+	GetHLSKey(context.Context, *GetHLSKeyRequest) (*GetHLSKeyResponse, error)
+	// 获取单个文件（可指定版本，默认最新版本）
+	GetFile(context.Context, *GetFileRequest) (*GetFileResponse, error)
+	// 替换文件内容，保留文件ID并生成新版本
+	ReplaceFile(context.Context, *ReplaceFileRequest) (*ReplaceFileResponse, error)
+	// 获取文件版本列表
+	ListFileVersions(context.Context, *ListFileVersionsRequest) (*ListFileVersionsResponse, error)
+	// 回滚到指定版本
+	RevertFileVersion(context.Context, *RevertFileVersionRequest) (*RevertFileVersionResponse, error)
+	// 把用户上传的全部文件和附加数据打包为ZIP（个人数据导出）
+	BuildUserArchive(context.Context, *BuildUserArchiveRequest) (*BuildUserArchiveResponse, error)
+	// 删除用户上传的文件，转交课程中的文件改为新上传者（账号注销）
+	PurgeUserFiles(context.Context, *PurgeUserFilesRequest) (*PurgeUserFilesResponse, error)
 	mustEmbedUnimplementedContentServiceServer()
 }
 
@@ -107,6 +212,27 @@ func (UnimplementedContentServiceServer) GetFiles(context.Context, *GetFilesRequ
 func (UnimplementedContentServiceServer) DeleteFile(context.Context, *DeleteFileRequest) (*DeleteFileResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteFile not implemented")
 }
+func (UnimplementedContentServiceServer) GetHLSKey(context.Context, *GetHLSKeyRequest) (*GetHLSKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHLSKey not implemented")
+}
+func (UnimplementedContentServiceServer) GetFile(context.Context, *GetFileRequest) (*GetFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFile not implemented")
+}
+func (UnimplementedContentServiceServer) ReplaceFile(context.Context, *ReplaceFileRequest) (*ReplaceFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplaceFile not implemented")
+}
+func (UnimplementedContentServiceServer) ListFileVersions(context.Context, *ListFileVersionsRequest) (*ListFileVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFileVersions not implemented")
+}
+func (UnimplementedContentServiceServer) RevertFileVersion(context.Context, *RevertFileVersionRequest) (*RevertFileVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertFileVersion not implemented")
+}
+func (UnimplementedContentServiceServer) BuildUserArchive(context.Context, *BuildUserArchiveRequest) (*BuildUserArchiveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuildUserArchive not implemented")
+}
+func (UnimplementedContentServiceServer) PurgeUserFiles(context.Context, *PurgeUserFilesRequest) (*PurgeUserFilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeUserFiles not implemented")
+}
 func (UnimplementedContentServiceServer) mustEmbedUnimplementedContentServiceServer() {}
 func (UnimplementedContentServiceServer) testEmbeddedByValue()                        {}
 
@@ -182,6 +308,132 @@ func _ContentService_DeleteFile_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ContentService_GetHLSKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHLSKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).GetHLSKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_GetHLSKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).GetHLSKey(ctx, req.(*GetHLSKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_GetFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).GetFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_GetFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).GetFile(ctx, req.(*GetFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_ReplaceFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplaceFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).ReplaceFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_ReplaceFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).ReplaceFile(ctx, req.(*ReplaceFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_ListFileVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFileVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).ListFileVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_ListFileVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).ListFileVersions(ctx, req.(*ListFileVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_RevertFileVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertFileVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).RevertFileVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_RevertFileVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).RevertFileVersion(ctx, req.(*RevertFileVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_BuildUserArchive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuildUserArchiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).BuildUserArchive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_BuildUserArchive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).BuildUserArchive(ctx, req.(*BuildUserArchiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContentService_PurgeUserFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeUserFilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContentServiceServer).PurgeUserFiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContentService_PurgeUserFiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContentServiceServer).PurgeUserFiles(ctx, req.(*PurgeUserFilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ContentService_ServiceDesc is the grpc.ServiceDesc for ContentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -201,6 +453,34 @@ var ContentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteFile",
 			Handler:    _ContentService_DeleteFile_Handler,
 		},
+		{
+			MethodName: "GetHLSKey",
+			Handler:    _ContentService_GetHLSKey_Handler,
+		},
+		{
+			MethodName: "GetFile",
+			Handler:    _ContentService_GetFile_Handler,
+		},
+		{
+			MethodName: "ReplaceFile",
+			Handler:    _ContentService_ReplaceFile_Handler,
+		},
+		{
+			MethodName: "ListFileVersions",
+			Handler:    _ContentService_ListFileVersions_Handler,
+		},
+		{
+			MethodName: "RevertFileVersion",
+			Handler:    _ContentService_RevertFileVersion_Handler,
+		},
+		{
+			MethodName: "BuildUserArchive",
+			Handler:    _ContentService_BuildUserArchive_Handler,
+		},
+		{
+			MethodName: "PurgeUserFiles",
+			Handler:    _ContentService_PurgeUserFiles_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "protos/content.proto",
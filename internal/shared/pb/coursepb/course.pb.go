@@ -301,6 +301,7 @@ func (x *GetCoursesResponse) GetTotal() uint32 {
 type GetCourseRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CourseId      uint32                 `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 可选，传入时返回该学员在各先修课程上的完成度
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -342,12 +343,20 @@ func (x *GetCourseRequest) GetCourseId() uint32 {
 	return 0
 }
 
+func (x *GetCourseRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
 // 获取单个课程响应消息
 type GetCourseResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
 	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	Course        *Course                `protobuf:"bytes,3,opt,name=course,proto3" json:"course,omitempty"`
+	Prerequisites []*CoursePrerequisite  `protobuf:"bytes,4,rep,name=prerequisites,proto3" json:"prerequisites,omitempty"` // 先修关系图（含间接先修）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -403,6 +412,13 @@ func (x *GetCourseResponse) GetCourse() *Course {
 	return nil
 }
 
+func (x *GetCourseResponse) GetPrerequisites() []*CoursePrerequisite {
+	if x != nil {
+		return x.Prerequisites
+	}
+	return nil
+}
+
 // 更新课程请求消息
 type UpdateCourseRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -655,37 +671,29 @@ func (x *PublishCourseResponse) GetCourse() *Course {
 	return nil
 }
 
-// 课程模型
-type Course struct {
+// 报名课程请求消息
+type EnrollCourseRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	InstructorId  uint32                 `protobuf:"varint,4,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"`
-	CategoryId    uint32                 `protobuf:"varint,5,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	Price         float32                `protobuf:"fixed32,6,opt,name=price,proto3" json:"price,omitempty"`
-	CoverImage    string                 `protobuf:"bytes,7,opt,name=cover_image,json=coverImage,proto3" json:"cover_image,omitempty"`
-	Status        string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     string                 `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CourseId      uint32                 `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Course) Reset() {
-	*x = Course{}
+func (x *EnrollCourseRequest) Reset() {
+	*x = EnrollCourseRequest{}
 	mi := &file_protos_course_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Course) String() string {
+func (x *EnrollCourseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Course) ProtoMessage() {}
+func (*EnrollCourseRequest) ProtoMessage() {}
 
-func (x *Course) ProtoReflect() protoreflect.Message {
+func (x *EnrollCourseRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_protos_course_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -697,115 +705,1866 @@ func (x *Course) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Course.ProtoReflect.Descriptor instead.
-func (*Course) Descriptor() ([]byte, []int) {
+// Deprecated: Use EnrollCourseRequest.ProtoReflect.Descriptor instead.
+func (*EnrollCourseRequest) Descriptor() ([]byte, []int) {
 	return file_protos_course_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *Course) GetId() uint32 {
+func (x *EnrollCourseRequest) GetCourseId() uint32 {
 	if x != nil {
-		return x.Id
+		return x.CourseId
 	}
 	return 0
 }
 
-func (x *Course) GetTitle() string {
+func (x *EnrollCourseRequest) GetUserId() uint32 {
 	if x != nil {
-		return x.Title
+		return x.UserId
 	}
-	return ""
+	return 0
 }
 
-func (x *Course) GetDescription() string {
+// 报名课程响应消息
+type EnrollCourseResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Code                 int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message              string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Enrollment           *Enrollment            `protobuf:"bytes,3,opt,name=enrollment,proto3" json:"enrollment,omitempty"`
+	MissingPrerequisites []*MissingPrerequisite `protobuf:"bytes,4,rep,name=missing_prerequisites,json=missingPrerequisites,proto3" json:"missing_prerequisites,omitempty"` // 未满足的先修要求
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *EnrollCourseResponse) Reset() {
+	*x = EnrollCourseResponse{}
+	mi := &file_protos_course_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollCourseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollCourseResponse) ProtoMessage() {}
+
+func (x *EnrollCourseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_course_proto_msgTypes[11]
 	if x != nil {
-		return x.Description
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollCourseResponse.ProtoReflect.Descriptor instead.
+func (*EnrollCourseResponse) Descriptor() ([]byte, []int) {
+	return file_protos_course_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *EnrollCourseResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *EnrollCourseResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
 	}
 	return ""
 }
 
-func (x *Course) GetInstructorId() uint32 {
+func (x *EnrollCourseResponse) GetEnrollment() *Enrollment {
 	if x != nil {
-		return x.InstructorId
+		return x.Enrollment
+	}
+	return nil
+}
+
+func (x *EnrollCourseResponse) GetMissingPrerequisites() []*MissingPrerequisite {
+	if x != nil {
+		return x.MissingPrerequisites
+	}
+	return nil
+}
+
+// 检查课程访问权限请求消息
+type CheckCourseAccessRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      uint32                 `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckCourseAccessRequest) Reset() {
+	*x = CheckCourseAccessRequest{}
+	mi := &file_protos_course_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckCourseAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckCourseAccessRequest) ProtoMessage() {}
+
+func (x *CheckCourseAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_course_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckCourseAccessRequest.ProtoReflect.Descriptor instead.
+func (*CheckCourseAccessRequest) Descriptor() ([]byte, []int) {
+	return file_protos_course_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CheckCourseAccessRequest) GetCourseId() uint32 {
+	if x != nil {
+		return x.CourseId
 	}
 	return 0
 }
 
-func (x *Course) GetCategoryId() uint32 {
+func (x *CheckCourseAccessRequest) GetUserId() uint32 {
 	if x != nil {
-		return x.CategoryId
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *Course) GetPrice() float32 {
+// 检查课程访问权限响应消息
+type CheckCourseAccessResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	HasAccess     bool                   `protobuf:"varint,3,opt,name=has_access,json=hasAccess,proto3" json:"has_access,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckCourseAccessResponse) Reset() {
+	*x = CheckCourseAccessResponse{}
+	mi := &file_protos_course_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckCourseAccessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckCourseAccessResponse) ProtoMessage() {}
+
+func (x *CheckCourseAccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_course_proto_msgTypes[13]
 	if x != nil {
-		return x.Price
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckCourseAccessResponse.ProtoReflect.Descriptor instead.
+func (*CheckCourseAccessResponse) Descriptor() ([]byte, []int) {
+	return file_protos_course_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CheckCourseAccessResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
 	}
 	return 0
 }
 
-func (x *Course) GetCoverImage() string {
+func (x *CheckCourseAccessResponse) GetMessage() string {
 	if x != nil {
-		return x.CoverImage
+		return x.Message
 	}
 	return ""
 }
 
-func (x *Course) GetStatus() string {
+func (x *CheckCourseAccessResponse) GetHasAccess() bool {
 	if x != nil {
-		return x.Status
+		return x.HasAccess
+	}
+	return false
+}
+
+// 创建章节请求消息
+type CreateChapterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      uint32                 `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	SortOrder     uint32                 `protobuf:"varint,5,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateChapterRequest) Reset() {
+	*x = CreateChapterRequest{}
+	mi := &file_protos_course_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateChapterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateChapterRequest) ProtoMessage() {}
+
+func (x *CreateChapterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_course_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateChapterRequest.ProtoReflect.Descriptor instead.
+func (*CreateChapterRequest) Descriptor() ([]byte, []int) {
+	return file_protos_course_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CreateChapterRequest) GetCourseId() uint32 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *CreateChapterRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateChapterRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
 	}
 	return ""
 }
 
-func (x *Course) GetCreatedAt() string {
+func (x *CreateChapterRequest) GetDescription() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Description
 	}
 	return ""
 }
 
-func (x *Course) GetUpdatedAt() string {
+func (x *CreateChapterRequest) GetSortOrder() uint32 {
 	if x != nil {
-		return x.UpdatedAt
+		return x.SortOrder
+	}
+	return 0
+}
+
+// 创建章节响应消息
+type CreateChapterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Chapter       *Chapter               `protobuf:"bytes,3,opt,name=chapter,proto3" json:"chapter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateChapterResponse) Reset() {
+	*x = CreateChapterResponse{}
+	mi := &file_protos_course_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateChapterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateChapterResponse) ProtoMessage() {}
+
+func (x *CreateChapterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_course_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateChapterResponse.ProtoReflect.Descriptor instead.
+func (*CreateChapterResponse) Descriptor() ([]byte, []int) {
+	return file_protos_course_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CreateChapterResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *CreateChapterResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
 	}
 	return ""
 }
 
-var File_protos_course_proto protoreflect.FileDescriptor
+func (x *CreateChapterResponse) GetChapter() *Chapter {
+	if x != nil {
+		return x.Chapter
+	}
+	return nil
+}
 
-const file_protos_course_proto_rawDesc = "" +
-	"\n" +
-	"\x13protos/course.proto\x12\x06course\"\xca\x01\n" +
-	"\x13CreateCourseRequest\x12\x14\n" +
-	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
-	"\vdescription\x18\x02 \x01(\tR\vdescription\x12#\n" +
-	"\rinstructor_id\x18\x03 \x01(\rR\finstructorId\x12\x1f\n" +
-	"\vcategory_id\x18\x04 \x01(\rR\n" +
-	"categoryId\x12\x14\n" +
-	"\x05price\x18\x05 \x01(\x02R\x05price\x12\x1f\n" +
-	"\vcover_image\x18\x06 \x01(\tR\n" +
-	"coverImage\"l\n" +
-	"\x14CreateCourseResponse\x12\x12\n" +
-	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x12&\n" +
-	"\x06course\x18\x03 \x01(\v2\x0e.course.CourseR\x06course\"e\n" +
-	"\x11GetCoursesRequest\x12\x12\n" +
-	"\x04page\x18\x01 \x01(\rR\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x02 \x01(\rR\bpageSize\x12\x1f\n" +
-	"\vcategory_id\x18\x03 \x01(\rR\n" +
-	"categoryId\"\x82\x01\n" +
-	"\x12GetCoursesResponse\x12\x12\n" +
-	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\x12(\n" +
-	"\acourses\x18\x03 \x03(\v2\x0e.course.CourseR\acourses\x12\x14\n" +
-	"\x05total\x18\x04 \x01(\rR\x05total\"/\n" +
-	"\x10GetCourseRequest\x12\x1b\n" +
-	"\tcourse_id\x18\x01 \x01(\rR\bcourseId\"i\n" +
-	"\x11GetCourseResponse\x12\x12\n" +
-	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+// 获取章节列表请求消息
+type GetChaptersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      uint32                 `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 不为0时按该学员的报名时间计算开放时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChaptersRequest) Reset() {
+	*x = GetChaptersRequest{}
+	mi := &file_protos_course_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChaptersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChaptersRequest) ProtoMessage() {}
+
+func (x *GetChaptersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_course_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChaptersRequest.ProtoReflect.Descriptor instead.
+func (*GetChaptersRequest) Descriptor() ([]byte, []int) {
+	return file_protos_course_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetChaptersRequest) GetCourseId() uint32 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *GetChaptersRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// 获取章节列表响应消息
+type GetChaptersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Chapters      []*Chapter             `protobuf:"bytes,3,rep,name=chapters,proto3" json:"chapters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChaptersResponse) Reset() {
+	*x = GetChaptersResponse{}
+	mi := &file_protos_course_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChaptersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChaptersResponse) ProtoMessage() {}
+
+func (x *GetChaptersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_course_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChaptersResponse.ProtoReflect.Descriptor instead.
+func (*GetChaptersResponse) Descriptor() ([]byte, []int) {
+	return file_protos_course_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetChaptersResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetChaptersResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetChaptersResponse) GetChapters() []*Chapter {
+	if x != nil {
+		return x.Chapters
+	}
+	return nil
+}
+
+// 完成章节请求消息
+type CompleteChapterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      uint32                 `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	ChapterId     uint32                 `protobuf:"varint,2,opt,name=chapter_id,json=chapterId,proto3" json:"chapter_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteChapterRequest) Reset() {
+	*x = CompleteChapterRequest{}
+	mi := &file_protos_course_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteChapterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteChapterRequest) ProtoMessage() {}
+
+func (x *CompleteChapterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_course_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteChapterRequest.ProtoReflect.Descriptor instead.
+func (*CompleteChapterRequest) Descriptor() ([]byte, []int) {
+	return file_protos_course_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CompleteChapterRequest) GetCourseId() uint32 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *CompleteChapterRequest) GetChapterId() uint32 {
+	if x != nil {
+		return x.ChapterId
+	}
+	return 0
+}
+
+func (x *CompleteChapterRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// 完成章节响应消息
+type CompleteChapterResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Code            int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message         string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Progress        *CourseProgress        `protobuf:"bytes,3,opt,name=progress,proto3" json:"progress,omitempty"`
+	CertificateCode string                 `protobuf:"bytes,4,opt,name=certificate_code,json=certificateCode,proto3" json:"certificate_code,omitempty"` // 课程全部学完时颁发的证书验证码
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CompleteChapterResponse) Reset() {
+	*x = CompleteChapterResponse{}
+	mi := &file_protos_course_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteChapterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteChapterResponse) ProtoMessage() {}
+
+func (x *CompleteChapterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_course_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteChapterResponse.ProtoReflect.Descriptor instead.
+func (*CompleteChapterResponse) Descriptor() ([]byte, []int) {
+	return file_protos_course_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CompleteChapterResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *CompleteChapterResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CompleteChapterResponse) GetProgress() *CourseProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+func (x *CompleteChapterResponse) GetCertificateCode() string {
+	if x != nil {
+		return x.CertificateCode
+	}
+	return ""
+}
+
+// 获取学习进度请求消息
+type GetCourseProgressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      uint32                 `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCourseProgressRequest) Reset() {
+	*x = GetCourseProgressRequest{}
+	mi := &file_protos_course_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourseProgressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseProgressRequest) ProtoMessage() {}
+
+func (x *GetCourseProgressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_course_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseProgressRequest.ProtoReflect.Descriptor instead.
+func (*GetCourseProgressRequest) Descriptor() ([]byte, []int) {
+	return file_protos_course_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetCourseProgressRequest) GetCourseId() uint32 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *GetCourseProgressRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// 获取学习进度响应消息
+type GetCourseProgressResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Progress      *CourseProgress        `protobuf:"bytes,3,opt,name=progress,proto3" json:"progress,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCourseProgressResponse) Reset() {
+	*x = GetCourseProgressResponse{}
+	mi := &file_protos_course_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCourseProgressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCourseProgressResponse) ProtoMessage() {}
+
+func (x *GetCourseProgressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_course_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCourseProgressResponse.ProtoReflect.Descriptor instead.
+func (*GetCourseProgressResponse) Descriptor() ([]byte, []int) {
+	return file_protos_course_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetCourseProgressResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetCourseProgressResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetCourseProgressResponse) GetProgress() *CourseProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+// 设置促销价请求消息，clear 为 true 时取消促销
+type SetCourseSaleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      uint32                 `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SalePrice     float32                `protobuf:"fixed32,3,opt,name=sale_price,json=salePrice,proto3" json:"sale_price,omitempty"`
+	StartsAt      string                 `protobuf:"bytes,4,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"` // RFC3339格式，为空表示立即生效
+	EndsAt        string                 `protobuf:"bytes,5,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`       // RFC3339格式，为空表示长期有效
+	Clear         bool                   `protobuf:"varint,6,opt,name=clear,proto3" json:"clear,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCourseSaleRequest) Reset() {
+	*x = SetCourseSaleRequest{}
+	mi := &file_protos_course_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCourseSaleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCourseSaleRequest) ProtoMessage() {}
+
+func (x *SetCourseSaleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_course_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCourseSaleRequest.ProtoReflect.Descriptor instead.
+func (*SetCourseSaleRequest) Descriptor() ([]byte, []int) {
+	return file_protos_course_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SetCourseSaleRequest) GetCourseId() uint32 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *SetCourseSaleRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SetCourseSaleRequest) GetSalePrice() float32 {
+	if x != nil {
+		return x.SalePrice
+	}
+	return 0
+}
+
+func (x *SetCourseSaleRequest) GetStartsAt() string {
+	if x != nil {
+		return x.StartsAt
+	}
+	return ""
+}
+
+func (x *SetCourseSaleRequest) GetEndsAt() string {
+	if x != nil {
+		return x.EndsAt
+	}
+	return ""
+}
+
+func (x *SetCourseSaleRequest) GetClear() bool {
+	if x != nil {
+		return x.Clear
+	}
+	return false
+}
+
+// 设置促销价响应消息
+type SetCourseSaleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Course        *Course                `protobuf:"bytes,3,opt,name=course,proto3" json:"course,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCourseSaleResponse) Reset() {
+	*x = SetCourseSaleResponse{}
+	mi := &file_protos_course_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCourseSaleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCourseSaleResponse) ProtoMessage() {}
+
+func (x *SetCourseSaleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_course_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCourseSaleResponse.ProtoReflect.Descriptor instead.
+func (*SetCourseSaleResponse) Descriptor() ([]byte, []int) {
+	return file_protos_course_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SetCourseSaleResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *SetCourseSaleResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SetCourseSaleResponse) GetCourse() *Course {
+	if x != nil {
+		return x.Course
+	}
+	return nil
+}
+
+// 设置先修课程请求消息，整体替换原有设置
+type SetCoursePrerequisitesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      uint32                 `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Prerequisites []*CoursePrerequisite  `protobuf:"bytes,3,rep,name=prerequisites,proto3" json:"prerequisites,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCoursePrerequisitesRequest) Reset() {
+	*x = SetCoursePrerequisitesRequest{}
+	mi := &file_protos_course_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCoursePrerequisitesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCoursePrerequisitesRequest) ProtoMessage() {}
+
+func (x *SetCoursePrerequisitesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_course_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCoursePrerequisitesRequest.ProtoReflect.Descriptor instead.
+func (*SetCoursePrerequisitesRequest) Descriptor() ([]byte, []int) {
+	return file_protos_course_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SetCoursePrerequisitesRequest) GetCourseId() uint32 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *SetCoursePrerequisitesRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SetCoursePrerequisitesRequest) GetPrerequisites() []*CoursePrerequisite {
+	if x != nil {
+		return x.Prerequisites
+	}
+	return nil
+}
+
+// 设置先修课程响应消息
+type SetCoursePrerequisitesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Prerequisites []*CoursePrerequisite  `protobuf:"bytes,3,rep,name=prerequisites,proto3" json:"prerequisites,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCoursePrerequisitesResponse) Reset() {
+	*x = SetCoursePrerequisitesResponse{}
+	mi := &file_protos_course_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCoursePrerequisitesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCoursePrerequisitesResponse) ProtoMessage() {}
+
+func (x *SetCoursePrerequisitesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_course_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCoursePrerequisitesResponse.ProtoReflect.Descriptor instead.
+func (*SetCoursePrerequisitesResponse) Descriptor() ([]byte, []int) {
+	return file_protos_course_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SetCoursePrerequisitesResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *SetCoursePrerequisitesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SetCoursePrerequisitesResponse) GetPrerequisites() []*CoursePrerequisite {
+	if x != nil {
+		return x.Prerequisites
+	}
+	return nil
+}
+
+// 设置章节开放规则请求消息
+type SetChapterReleaseRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	CourseId         uint32                 `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	ChapterId        uint32                 `protobuf:"varint,2,opt,name=chapter_id,json=chapterId,proto3" json:"chapter_id,omitempty"`
+	UserId           uint32                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ReleaseType      string                 `protobuf:"bytes,4,opt,name=release_type,json=releaseType,proto3" json:"release_type,omitempty"`                   // immediate/date/after_enrollment
+	ReleaseAt        string                 `protobuf:"bytes,5,opt,name=release_at,json=releaseAt,proto3" json:"release_at,omitempty"`                         // RFC3339格式，release_type 为 date 时必填
+	ReleaseAfterDays int32                  `protobuf:"varint,6,opt,name=release_after_days,json=releaseAfterDays,proto3" json:"release_after_days,omitempty"` // release_type 为 after_enrollment 时生效
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SetChapterReleaseRequest) Reset() {
+	*x = SetChapterReleaseRequest{}
+	mi := &file_protos_course_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetChapterReleaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetChapterReleaseRequest) ProtoMessage() {}
+
+func (x *SetChapterReleaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_course_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetChapterReleaseRequest.ProtoReflect.Descriptor instead.
+func (*SetChapterReleaseRequest) Descriptor() ([]byte, []int) {
+	return file_protos_course_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SetChapterReleaseRequest) GetCourseId() uint32 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *SetChapterReleaseRequest) GetChapterId() uint32 {
+	if x != nil {
+		return x.ChapterId
+	}
+	return 0
+}
+
+func (x *SetChapterReleaseRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SetChapterReleaseRequest) GetReleaseType() string {
+	if x != nil {
+		return x.ReleaseType
+	}
+	return ""
+}
+
+func (x *SetChapterReleaseRequest) GetReleaseAt() string {
+	if x != nil {
+		return x.ReleaseAt
+	}
+	return ""
+}
+
+func (x *SetChapterReleaseRequest) GetReleaseAfterDays() int32 {
+	if x != nil {
+		return x.ReleaseAfterDays
+	}
+	return 0
+}
+
+// 设置章节开放规则响应消息
+type SetChapterReleaseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Chapter       *Chapter               `protobuf:"bytes,3,opt,name=chapter,proto3" json:"chapter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetChapterReleaseResponse) Reset() {
+	*x = SetChapterReleaseResponse{}
+	mi := &file_protos_course_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetChapterReleaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetChapterReleaseResponse) ProtoMessage() {}
+
+func (x *SetChapterReleaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_course_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetChapterReleaseResponse.ProtoReflect.Descriptor instead.
+func (*SetChapterReleaseResponse) Descriptor() ([]byte, []int) {
+	return file_protos_course_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SetChapterReleaseResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *SetChapterReleaseResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SetChapterReleaseResponse) GetChapter() *Chapter {
+	if x != nil {
+		return x.Chapter
+	}
+	return nil
+}
+
+// 检查章节开放请求消息
+type CheckChapterReleaseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChapterId     uint32                 `protobuf:"varint,1,opt,name=chapter_id,json=chapterId,proto3" json:"chapter_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckChapterReleaseRequest) Reset() {
+	*x = CheckChapterReleaseRequest{}
+	mi := &file_protos_course_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckChapterReleaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckChapterReleaseRequest) ProtoMessage() {}
+
+func (x *CheckChapterReleaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_course_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckChapterReleaseRequest.ProtoReflect.Descriptor instead.
+func (*CheckChapterReleaseRequest) Descriptor() ([]byte, []int) {
+	return file_protos_course_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CheckChapterReleaseRequest) GetChapterId() uint32 {
+	if x != nil {
+		return x.ChapterId
+	}
+	return 0
+}
+
+func (x *CheckChapterReleaseRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// 检查章节开放响应消息
+type CheckChapterReleaseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Chapter       *Chapter               `protobuf:"bytes,3,opt,name=chapter,proto3" json:"chapter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckChapterReleaseResponse) Reset() {
+	*x = CheckChapterReleaseResponse{}
+	mi := &file_protos_course_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckChapterReleaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckChapterReleaseResponse) ProtoMessage() {}
+
+func (x *CheckChapterReleaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_course_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckChapterReleaseResponse.ProtoReflect.Descriptor instead.
+func (*CheckChapterReleaseResponse) Descriptor() ([]byte, []int) {
+	return file_protos_course_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CheckChapterReleaseResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *CheckChapterReleaseResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CheckChapterReleaseResponse) GetChapter() *Chapter {
+	if x != nil {
+		return x.Chapter
+	}
+	return nil
+}
+
+// 课程模型
+type Course struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title          string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	InstructorId   uint32                 `protobuf:"varint,4,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"`
+	CategoryId     uint32                 `protobuf:"varint,5,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Price          float32                `protobuf:"fixed32,6,opt,name=price,proto3" json:"price,omitempty"`
+	CoverImage     string                 `protobuf:"bytes,7,opt,name=cover_image,json=coverImage,proto3" json:"cover_image,omitempty"`
+	Status         string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      string                 `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	EffectivePrice float32                `protobuf:"fixed32,11,opt,name=effective_price,json=effectivePrice,proto3" json:"effective_price,omitempty"` // 当前实际售价（促销期内为促销价）
+	OnSale         bool                   `protobuf:"varint,12,opt,name=on_sale,json=onSale,proto3" json:"on_sale,omitempty"`                          // 当前是否处于促销期
+	HasSale        bool                   `protobuf:"varint,13,opt,name=has_sale,json=hasSale,proto3" json:"has_sale,omitempty"`                       // 是否设置了促销价
+	SalePrice      float32                `protobuf:"fixed32,14,opt,name=sale_price,json=salePrice,proto3" json:"sale_price,omitempty"`
+	SaleStartsAt   string                 `protobuf:"bytes,15,opt,name=sale_starts_at,json=saleStartsAt,proto3" json:"sale_starts_at,omitempty"` // RFC3339格式
+	SaleEndsAt     string                 `protobuf:"bytes,16,opt,name=sale_ends_at,json=saleEndsAt,proto3" json:"sale_ends_at,omitempty"`       // RFC3339格式
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Course) Reset() {
+	*x = Course{}
+	mi := &file_protos_course_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Course) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Course) ProtoMessage() {}
+
+func (x *Course) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_course_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Course.ProtoReflect.Descriptor instead.
+func (*Course) Descriptor() ([]byte, []int) {
+	return file_protos_course_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *Course) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Course) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Course) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Course) GetInstructorId() uint32 {
+	if x != nil {
+		return x.InstructorId
+	}
+	return 0
+}
+
+func (x *Course) GetCategoryId() uint32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *Course) GetPrice() float32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Course) GetCoverImage() string {
+	if x != nil {
+		return x.CoverImage
+	}
+	return ""
+}
+
+func (x *Course) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Course) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Course) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *Course) GetEffectivePrice() float32 {
+	if x != nil {
+		return x.EffectivePrice
+	}
+	return 0
+}
+
+func (x *Course) GetOnSale() bool {
+	if x != nil {
+		return x.OnSale
+	}
+	return false
+}
+
+func (x *Course) GetHasSale() bool {
+	if x != nil {
+		return x.HasSale
+	}
+	return false
+}
+
+func (x *Course) GetSalePrice() float32 {
+	if x != nil {
+		return x.SalePrice
+	}
+	return 0
+}
+
+func (x *Course) GetSaleStartsAt() string {
+	if x != nil {
+		return x.SaleStartsAt
+	}
+	return ""
+}
+
+func (x *Course) GetSaleEndsAt() string {
+	if x != nil {
+		return x.SaleEndsAt
+	}
+	return ""
+}
+
+// 选课记录模型
+type Enrollment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CourseId      uint32                 `protobuf:"varint,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	EnrolledAt    string                 `protobuf:"bytes,5,opt,name=enrolled_at,json=enrolledAt,proto3" json:"enrolled_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Enrollment) Reset() {
+	*x = Enrollment{}
+	mi := &file_protos_course_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Enrollment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Enrollment) ProtoMessage() {}
+
+func (x *Enrollment) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_course_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Enrollment.ProtoReflect.Descriptor instead.
+func (*Enrollment) Descriptor() ([]byte, []int) {
+	return file_protos_course_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Enrollment) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Enrollment) GetCourseId() uint32 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *Enrollment) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Enrollment) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Enrollment) GetEnrolledAt() string {
+	if x != nil {
+		return x.EnrolledAt
+	}
+	return ""
+}
+
+// 章节模型
+type Chapter struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CourseId         uint32                 `protobuf:"varint,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Title            string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description      string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	SortOrder        uint32                 `protobuf:"varint,5,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	CreatedAt        string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ReleaseType      string                 `protobuf:"bytes,7,opt,name=release_type,json=releaseType,proto3" json:"release_type,omitempty"` // immediate/date/after_enrollment
+	ReleaseAt        string                 `protobuf:"bytes,8,opt,name=release_at,json=releaseAt,proto3" json:"release_at,omitempty"`       // RFC3339格式
+	ReleaseAfterDays int32                  `protobuf:"varint,9,opt,name=release_after_days,json=releaseAfterDays,proto3" json:"release_after_days,omitempty"`
+	UnlockAt         string                 `protobuf:"bytes,10,opt,name=unlock_at,json=unlockAt,proto3" json:"unlock_at,omitempty"` // 对当前学员的开放时间，RFC3339格式，未知时为空
+	Released         bool                   `protobuf:"varint,11,opt,name=released,proto3" json:"released,omitempty"`                // 对当前学员是否已开放
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Chapter) Reset() {
+	*x = Chapter{}
+	mi := &file_protos_course_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Chapter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Chapter) ProtoMessage() {}
+
+func (x *Chapter) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_course_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Chapter.ProtoReflect.Descriptor instead.
+func (*Chapter) Descriptor() ([]byte, []int) {
+	return file_protos_course_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Chapter) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Chapter) GetCourseId() uint32 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *Chapter) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Chapter) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Chapter) GetSortOrder() uint32 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
+func (x *Chapter) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Chapter) GetReleaseType() string {
+	if x != nil {
+		return x.ReleaseType
+	}
+	return ""
+}
+
+func (x *Chapter) GetReleaseAt() string {
+	if x != nil {
+		return x.ReleaseAt
+	}
+	return ""
+}
+
+func (x *Chapter) GetReleaseAfterDays() int32 {
+	if x != nil {
+		return x.ReleaseAfterDays
+	}
+	return 0
+}
+
+func (x *Chapter) GetUnlockAt() string {
+	if x != nil {
+		return x.UnlockAt
+	}
+	return ""
+}
+
+func (x *Chapter) GetReleased() bool {
+	if x != nil {
+		return x.Released
+	}
+	return false
+}
+
+// 学习进度模型
+type CourseProgress struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	CourseId            uint32                 `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	CompletedChapterIds []uint32               `protobuf:"varint,2,rep,packed,name=completed_chapter_ids,json=completedChapterIds,proto3" json:"completed_chapter_ids,omitempty"`
+	TotalChapters       uint32                 `protobuf:"varint,3,opt,name=total_chapters,json=totalChapters,proto3" json:"total_chapters,omitempty"`
+	Completed           bool                   `protobuf:"varint,4,opt,name=completed,proto3" json:"completed,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CourseProgress) Reset() {
+	*x = CourseProgress{}
+	mi := &file_protos_course_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CourseProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CourseProgress) ProtoMessage() {}
+
+func (x *CourseProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_course_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CourseProgress.ProtoReflect.Descriptor instead.
+func (*CourseProgress) Descriptor() ([]byte, []int) {
+	return file_protos_course_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CourseProgress) GetCourseId() uint32 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *CourseProgress) GetCompletedChapterIds() []uint32 {
+	if x != nil {
+		return x.CompletedChapterIds
+	}
+	return nil
+}
+
+func (x *CourseProgress) GetTotalChapters() uint32 {
+	if x != nil {
+		return x.TotalChapters
+	}
+	return 0
+}
+
+func (x *CourseProgress) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+// 先修关系：course_id 需要先学 required_course_id
+type CoursePrerequisite struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	CourseId            uint32                 `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	RequiredCourseId    uint32                 `protobuf:"varint,2,opt,name=required_course_id,json=requiredCourseId,proto3" json:"required_course_id,omitempty"`
+	RequiredCourseTitle string                 `protobuf:"bytes,3,opt,name=required_course_title,json=requiredCourseTitle,proto3" json:"required_course_title,omitempty"`
+	MinProgressPercent  int32                  `protobuf:"varint,4,opt,name=min_progress_percent,json=minProgressPercent,proto3" json:"min_progress_percent,omitempty"` // 最低完成度，0表示全部学完
+	CurrentPercent      int32                  `protobuf:"varint,5,opt,name=current_percent,json=currentPercent,proto3" json:"current_percent,omitempty"`               // 学员当前完成度
+	Satisfied           bool                   `protobuf:"varint,6,opt,name=satisfied,proto3" json:"satisfied,omitempty"`                                               // 学员是否已满足
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CoursePrerequisite) Reset() {
+	*x = CoursePrerequisite{}
+	mi := &file_protos_course_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CoursePrerequisite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoursePrerequisite) ProtoMessage() {}
+
+func (x *CoursePrerequisite) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_course_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoursePrerequisite.ProtoReflect.Descriptor instead.
+func (*CoursePrerequisite) Descriptor() ([]byte, []int) {
+	return file_protos_course_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CoursePrerequisite) GetCourseId() uint32 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *CoursePrerequisite) GetRequiredCourseId() uint32 {
+	if x != nil {
+		return x.RequiredCourseId
+	}
+	return 0
+}
+
+func (x *CoursePrerequisite) GetRequiredCourseTitle() string {
+	if x != nil {
+		return x.RequiredCourseTitle
+	}
+	return ""
+}
+
+func (x *CoursePrerequisite) GetMinProgressPercent() int32 {
+	if x != nil {
+		return x.MinProgressPercent
+	}
+	return 0
+}
+
+func (x *CoursePrerequisite) GetCurrentPercent() int32 {
+	if x != nil {
+		return x.CurrentPercent
+	}
+	return 0
+}
+
+func (x *CoursePrerequisite) GetSatisfied() bool {
+	if x != nil {
+		return x.Satisfied
+	}
+	return false
+}
+
+// 未满足的先修要求
+type MissingPrerequisite struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	CourseId           uint32                 `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	CourseTitle        string                 `protobuf:"bytes,2,opt,name=course_title,json=courseTitle,proto3" json:"course_title,omitempty"`
+	MinProgressPercent int32                  `protobuf:"varint,3,opt,name=min_progress_percent,json=minProgressPercent,proto3" json:"min_progress_percent,omitempty"`
+	CurrentPercent     int32                  `protobuf:"varint,4,opt,name=current_percent,json=currentPercent,proto3" json:"current_percent,omitempty"`
+	Enrolled           bool                   `protobuf:"varint,5,opt,name=enrolled,proto3" json:"enrolled,omitempty"` // 是否已报名该先修课程
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *MissingPrerequisite) Reset() {
+	*x = MissingPrerequisite{}
+	mi := &file_protos_course_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MissingPrerequisite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MissingPrerequisite) ProtoMessage() {}
+
+func (x *MissingPrerequisite) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_course_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MissingPrerequisite.ProtoReflect.Descriptor instead.
+func (*MissingPrerequisite) Descriptor() ([]byte, []int) {
+	return file_protos_course_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *MissingPrerequisite) GetCourseId() uint32 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *MissingPrerequisite) GetCourseTitle() string {
+	if x != nil {
+		return x.CourseTitle
+	}
+	return ""
+}
+
+func (x *MissingPrerequisite) GetMinProgressPercent() int32 {
+	if x != nil {
+		return x.MinProgressPercent
+	}
+	return 0
+}
+
+func (x *MissingPrerequisite) GetCurrentPercent() int32 {
+	if x != nil {
+		return x.CurrentPercent
+	}
+	return 0
+}
+
+func (x *MissingPrerequisite) GetEnrolled() bool {
+	if x != nil {
+		return x.Enrolled
+	}
+	return false
+}
+
+var File_protos_course_proto protoreflect.FileDescriptor
+
+const file_protos_course_proto_rawDesc = "" +
+	"\n" +
+	"\x13protos/course.proto\x12\x06course\"\xca\x01\n" +
+	"\x13CreateCourseRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12#\n" +
+	"\rinstructor_id\x18\x03 \x01(\rR\finstructorId\x12\x1f\n" +
+	"\vcategory_id\x18\x04 \x01(\rR\n" +
+	"categoryId\x12\x14\n" +
+	"\x05price\x18\x05 \x01(\x02R\x05price\x12\x1f\n" +
+	"\vcover_image\x18\x06 \x01(\tR\n" +
+	"coverImage\"l\n" +
+	"\x14CreateCourseResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12&\n" +
+	"\x06course\x18\x03 \x01(\v2\x0e.course.CourseR\x06course\"e\n" +
+	"\x11GetCoursesRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\rR\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\rR\bpageSize\x12\x1f\n" +
+	"\vcategory_id\x18\x03 \x01(\rR\n" +
+	"categoryId\"\x82\x01\n" +
+	"\x12GetCoursesResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12(\n" +
+	"\acourses\x18\x03 \x03(\v2\x0e.course.CourseR\acourses\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\rR\x05total\"H\n" +
+	"\x10GetCourseRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\rR\bcourseId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\"\xab\x01\n" +
+	"\x11GetCourseResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12&\n" +
-	"\x06course\x18\x03 \x01(\v2\x0e.course.CourseR\x06course\"\xc2\x01\n" +
+	"\x06course\x18\x03 \x01(\v2\x0e.course.CourseR\x06course\x12@\n" +
+	"\rprerequisites\x18\x04 \x03(\v2\x1a.course.CoursePrerequisiteR\rprerequisites\"\xc2\x01\n" +
 	"\x13UpdateCourseRequest\x12\x1b\n" +
 	"\tcourse_id\x18\x01 \x01(\rR\bcourseId\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
@@ -824,7 +2583,101 @@ const file_protos_course_proto_rawDesc = "" +
 	"\x15PublishCourseResponse\x12\x12\n" +
 	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12&\n" +
-	"\x06course\x18\x03 \x01(\v2\x0e.course.CourseR\x06course\"\xa3\x02\n" +
+	"\x06course\x18\x03 \x01(\v2\x0e.course.CourseR\x06course\"K\n" +
+	"\x13EnrollCourseRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\rR\bcourseId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\"\xca\x01\n" +
+	"\x14EnrollCourseResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x122\n" +
+	"\n" +
+	"enrollment\x18\x03 \x01(\v2\x12.course.EnrollmentR\n" +
+	"enrollment\x12P\n" +
+	"\x15missing_prerequisites\x18\x04 \x03(\v2\x1b.course.MissingPrerequisiteR\x14missingPrerequisites\"P\n" +
+	"\x18CheckCourseAccessRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\rR\bcourseId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\"h\n" +
+	"\x19CheckCourseAccessResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1d\n" +
+	"\n" +
+	"has_access\x18\x03 \x01(\bR\thasAccess\"\xa3\x01\n" +
+	"\x14CreateChapterRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\rR\bcourseId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\x05 \x01(\rR\tsortOrder\"p\n" +
+	"\x15CreateChapterResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12)\n" +
+	"\achapter\x18\x03 \x01(\v2\x0f.course.ChapterR\achapter\"J\n" +
+	"\x12GetChaptersRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\rR\bcourseId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\"p\n" +
+	"\x13GetChaptersResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12+\n" +
+	"\bchapters\x18\x03 \x03(\v2\x0f.course.ChapterR\bchapters\"m\n" +
+	"\x16CompleteChapterRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\rR\bcourseId\x12\x1d\n" +
+	"\n" +
+	"chapter_id\x18\x02 \x01(\rR\tchapterId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\rR\x06userId\"\xa6\x01\n" +
+	"\x17CompleteChapterResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x122\n" +
+	"\bprogress\x18\x03 \x01(\v2\x16.course.CourseProgressR\bprogress\x12)\n" +
+	"\x10certificate_code\x18\x04 \x01(\tR\x0fcertificateCode\"P\n" +
+	"\x18GetCourseProgressRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\rR\bcourseId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\"}\n" +
+	"\x19GetCourseProgressResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x122\n" +
+	"\bprogress\x18\x03 \x01(\v2\x16.course.CourseProgressR\bprogress\"\xb7\x01\n" +
+	"\x14SetCourseSaleRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\rR\bcourseId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\x12\x1d\n" +
+	"\n" +
+	"sale_price\x18\x03 \x01(\x02R\tsalePrice\x12\x1b\n" +
+	"\tstarts_at\x18\x04 \x01(\tR\bstartsAt\x12\x17\n" +
+	"\aends_at\x18\x05 \x01(\tR\x06endsAt\x12\x14\n" +
+	"\x05clear\x18\x06 \x01(\bR\x05clear\"m\n" +
+	"\x15SetCourseSaleResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12&\n" +
+	"\x06course\x18\x03 \x01(\v2\x0e.course.CourseR\x06course\"\x97\x01\n" +
+	"\x1dSetCoursePrerequisitesRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\rR\bcourseId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\x12@\n" +
+	"\rprerequisites\x18\x03 \x03(\v2\x1a.course.CoursePrerequisiteR\rprerequisites\"\x90\x01\n" +
+	"\x1eSetCoursePrerequisitesResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12@\n" +
+	"\rprerequisites\x18\x03 \x03(\v2\x1a.course.CoursePrerequisiteR\rprerequisites\"\xdf\x01\n" +
+	"\x18SetChapterReleaseRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\rR\bcourseId\x12\x1d\n" +
+	"\n" +
+	"chapter_id\x18\x02 \x01(\rR\tchapterId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\rR\x06userId\x12!\n" +
+	"\frelease_type\x18\x04 \x01(\tR\vreleaseType\x12\x1d\n" +
+	"\n" +
+	"release_at\x18\x05 \x01(\tR\treleaseAt\x12,\n" +
+	"\x12release_after_days\x18\x06 \x01(\x05R\x10releaseAfterDays\"t\n" +
+	"\x19SetChapterReleaseResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12)\n" +
+	"\achapter\x18\x03 \x01(\v2\x0f.course.ChapterR\achapter\"T\n" +
+	"\x1aCheckChapterReleaseRequest\x12\x1d\n" +
+	"\n" +
+	"chapter_id\x18\x01 \x01(\rR\tchapterId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\"v\n" +
+	"\x1bCheckChapterReleaseResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12)\n" +
+	"\achapter\x18\x03 \x01(\v2\x0f.course.ChapterR\achapter\"\xe7\x03\n" +
 	"\x06Course\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
@@ -840,14 +2693,74 @@ const file_protos_course_proto_rawDesc = "" +
 	"created_at\x18\t \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
 	"updated_at\x18\n" +
-	" \x01(\tR\tupdatedAt2\xfa\x02\n" +
+	" \x01(\tR\tupdatedAt\x12'\n" +
+	"\x0feffective_price\x18\v \x01(\x02R\x0eeffectivePrice\x12\x17\n" +
+	"\aon_sale\x18\f \x01(\bR\x06onSale\x12\x19\n" +
+	"\bhas_sale\x18\r \x01(\bR\ahasSale\x12\x1d\n" +
+	"\n" +
+	"sale_price\x18\x0e \x01(\x02R\tsalePrice\x12$\n" +
+	"\x0esale_starts_at\x18\x0f \x01(\tR\fsaleStartsAt\x12 \n" +
+	"\fsale_ends_at\x18\x10 \x01(\tR\n" +
+	"saleEndsAt\"\x8b\x01\n" +
+	"\n" +
+	"Enrollment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1b\n" +
+	"\tcourse_id\x18\x02 \x01(\rR\bcourseId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\rR\x06userId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1f\n" +
+	"\venrolled_at\x18\x05 \x01(\tR\n" +
+	"enrolledAt\"\xd5\x02\n" +
+	"\aChapter\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1b\n" +
+	"\tcourse_id\x18\x02 \x01(\rR\bcourseId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\x05 \x01(\rR\tsortOrder\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12!\n" +
+	"\frelease_type\x18\a \x01(\tR\vreleaseType\x12\x1d\n" +
+	"\n" +
+	"release_at\x18\b \x01(\tR\treleaseAt\x12,\n" +
+	"\x12release_after_days\x18\t \x01(\x05R\x10releaseAfterDays\x12\x1b\n" +
+	"\tunlock_at\x18\n" +
+	" \x01(\tR\bunlockAt\x12\x1a\n" +
+	"\breleased\x18\v \x01(\bR\breleased\"\xa6\x01\n" +
+	"\x0eCourseProgress\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\rR\bcourseId\x122\n" +
+	"\x15completed_chapter_ids\x18\x02 \x03(\rR\x13completedChapterIds\x12%\n" +
+	"\x0etotal_chapters\x18\x03 \x01(\rR\rtotalChapters\x12\x1c\n" +
+	"\tcompleted\x18\x04 \x01(\bR\tcompleted\"\x8c\x02\n" +
+	"\x12CoursePrerequisite\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\rR\bcourseId\x12,\n" +
+	"\x12required_course_id\x18\x02 \x01(\rR\x10requiredCourseId\x122\n" +
+	"\x15required_course_title\x18\x03 \x01(\tR\x13requiredCourseTitle\x120\n" +
+	"\x14min_progress_percent\x18\x04 \x01(\x05R\x12minProgressPercent\x12'\n" +
+	"\x0fcurrent_percent\x18\x05 \x01(\x05R\x0ecurrentPercent\x12\x1c\n" +
+	"\tsatisfied\x18\x06 \x01(\bR\tsatisfied\"\xcc\x01\n" +
+	"\x13MissingPrerequisite\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\rR\bcourseId\x12!\n" +
+	"\fcourse_title\x18\x02 \x01(\tR\vcourseTitle\x120\n" +
+	"\x14min_progress_percent\x18\x03 \x01(\x05R\x12minProgressPercent\x12'\n" +
+	"\x0fcurrent_percent\x18\x04 \x01(\x05R\x0ecurrentPercent\x12\x1a\n" +
+	"\benrolled\x18\x05 \x01(\bR\benrolled2\xd4\t\n" +
 	"\rCourseService\x12I\n" +
 	"\fCreateCourse\x12\x1b.course.CreateCourseRequest\x1a\x1c.course.CreateCourseResponse\x12C\n" +
 	"\n" +
 	"GetCourses\x12\x19.course.GetCoursesRequest\x1a\x1a.course.GetCoursesResponse\x12@\n" +
 	"\tGetCourse\x12\x18.course.GetCourseRequest\x1a\x19.course.GetCourseResponse\x12I\n" +
 	"\fUpdateCourse\x12\x1b.course.UpdateCourseRequest\x1a\x1c.course.UpdateCourseResponse\x12L\n" +
-	"\rPublishCourse\x12\x1c.course.PublishCourseRequest\x1a\x1d.course.PublishCourseResponseB-Z+course-platform/internal/shared/pb/coursepbb\x06proto3"
+	"\rPublishCourse\x12\x1c.course.PublishCourseRequest\x1a\x1d.course.PublishCourseResponse\x12I\n" +
+	"\fEnrollCourse\x12\x1b.course.EnrollCourseRequest\x1a\x1c.course.EnrollCourseResponse\x12X\n" +
+	"\x11CheckCourseAccess\x12 .course.CheckCourseAccessRequest\x1a!.course.CheckCourseAccessResponse\x12L\n" +
+	"\rCreateChapter\x12\x1c.course.CreateChapterRequest\x1a\x1d.course.CreateChapterResponse\x12F\n" +
+	"\vGetChapters\x12\x1a.course.GetChaptersRequest\x1a\x1b.course.GetChaptersResponse\x12R\n" +
+	"\x0fCompleteChapter\x12\x1e.course.CompleteChapterRequest\x1a\x1f.course.CompleteChapterResponse\x12X\n" +
+	"\x11GetCourseProgress\x12 .course.GetCourseProgressRequest\x1a!.course.GetCourseProgressResponse\x12L\n" +
+	"\rSetCourseSale\x12\x1c.course.SetCourseSaleRequest\x1a\x1d.course.SetCourseSaleResponse\x12g\n" +
+	"\x16SetCoursePrerequisites\x12%.course.SetCoursePrerequisitesRequest\x1a&.course.SetCoursePrerequisitesResponse\x12X\n" +
+	"\x11SetChapterRelease\x12 .course.SetChapterReleaseRequest\x1a!.course.SetChapterReleaseResponse\x12^\n" +
+	"\x13CheckChapterRelease\x12\".course.CheckChapterReleaseRequest\x1a#.course.CheckChapterReleaseResponseB-Z+course-platform/internal/shared/pb/coursepbb\x06proto3"
 
 var (
 	file_protos_course_proto_rawDescOnce sync.Once
@@ -861,41 +2774,98 @@ func file_protos_course_proto_rawDescGZIP() []byte {
 	return file_protos_course_proto_rawDescData
 }
 
-var file_protos_course_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_protos_course_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
 var file_protos_course_proto_goTypes = []any{
-	(*CreateCourseRequest)(nil),   // 0: course.CreateCourseRequest
-	(*CreateCourseResponse)(nil),  // 1: course.CreateCourseResponse
-	(*GetCoursesRequest)(nil),     // 2: course.GetCoursesRequest
-	(*GetCoursesResponse)(nil),    // 3: course.GetCoursesResponse
-	(*GetCourseRequest)(nil),      // 4: course.GetCourseRequest
-	(*GetCourseResponse)(nil),     // 5: course.GetCourseResponse
-	(*UpdateCourseRequest)(nil),   // 6: course.UpdateCourseRequest
-	(*UpdateCourseResponse)(nil),  // 7: course.UpdateCourseResponse
-	(*PublishCourseRequest)(nil),  // 8: course.PublishCourseRequest
-	(*PublishCourseResponse)(nil), // 9: course.PublishCourseResponse
-	(*Course)(nil),                // 10: course.Course
+	(*CreateCourseRequest)(nil),            // 0: course.CreateCourseRequest
+	(*CreateCourseResponse)(nil),           // 1: course.CreateCourseResponse
+	(*GetCoursesRequest)(nil),              // 2: course.GetCoursesRequest
+	(*GetCoursesResponse)(nil),             // 3: course.GetCoursesResponse
+	(*GetCourseRequest)(nil),               // 4: course.GetCourseRequest
+	(*GetCourseResponse)(nil),              // 5: course.GetCourseResponse
+	(*UpdateCourseRequest)(nil),            // 6: course.UpdateCourseRequest
+	(*UpdateCourseResponse)(nil),           // 7: course.UpdateCourseResponse
+	(*PublishCourseRequest)(nil),           // 8: course.PublishCourseRequest
+	(*PublishCourseResponse)(nil),          // 9: course.PublishCourseResponse
+	(*EnrollCourseRequest)(nil),            // 10: course.EnrollCourseRequest
+	(*EnrollCourseResponse)(nil),           // 11: course.EnrollCourseResponse
+	(*CheckCourseAccessRequest)(nil),       // 12: course.CheckCourseAccessRequest
+	(*CheckCourseAccessResponse)(nil),      // 13: course.CheckCourseAccessResponse
+	(*CreateChapterRequest)(nil),           // 14: course.CreateChapterRequest
+	(*CreateChapterResponse)(nil),          // 15: course.CreateChapterResponse
+	(*GetChaptersRequest)(nil),             // 16: course.GetChaptersRequest
+	(*GetChaptersResponse)(nil),            // 17: course.GetChaptersResponse
+	(*CompleteChapterRequest)(nil),         // 18: course.CompleteChapterRequest
+	(*CompleteChapterResponse)(nil),        // 19: course.CompleteChapterResponse
+	(*GetCourseProgressRequest)(nil),       // 20: course.GetCourseProgressRequest
+	(*GetCourseProgressResponse)(nil),      // 21: course.GetCourseProgressResponse
+	(*SetCourseSaleRequest)(nil),           // 22: course.SetCourseSaleRequest
+	(*SetCourseSaleResponse)(nil),          // 23: course.SetCourseSaleResponse
+	(*SetCoursePrerequisitesRequest)(nil),  // 24: course.SetCoursePrerequisitesRequest
+	(*SetCoursePrerequisitesResponse)(nil), // 25: course.SetCoursePrerequisitesResponse
+	(*SetChapterReleaseRequest)(nil),       // 26: course.SetChapterReleaseRequest
+	(*SetChapterReleaseResponse)(nil),      // 27: course.SetChapterReleaseResponse
+	(*CheckChapterReleaseRequest)(nil),     // 28: course.CheckChapterReleaseRequest
+	(*CheckChapterReleaseResponse)(nil),    // 29: course.CheckChapterReleaseResponse
+	(*Course)(nil),                         // 30: course.Course
+	(*Enrollment)(nil),                     // 31: course.Enrollment
+	(*Chapter)(nil),                        // 32: course.Chapter
+	(*CourseProgress)(nil),                 // 33: course.CourseProgress
+	(*CoursePrerequisite)(nil),             // 34: course.CoursePrerequisite
+	(*MissingPrerequisite)(nil),            // 35: course.MissingPrerequisite
 }
 var file_protos_course_proto_depIdxs = []int32{
-	10, // 0: course.CreateCourseResponse.course:type_name -> course.Course
-	10, // 1: course.GetCoursesResponse.courses:type_name -> course.Course
-	10, // 2: course.GetCourseResponse.course:type_name -> course.Course
-	10, // 3: course.UpdateCourseResponse.course:type_name -> course.Course
-	10, // 4: course.PublishCourseResponse.course:type_name -> course.Course
-	0,  // 5: course.CourseService.CreateCourse:input_type -> course.CreateCourseRequest
-	2,  // 6: course.CourseService.GetCourses:input_type -> course.GetCoursesRequest
-	4,  // 7: course.CourseService.GetCourse:input_type -> course.GetCourseRequest
-	6,  // 8: course.CourseService.UpdateCourse:input_type -> course.UpdateCourseRequest
-	8,  // 9: course.CourseService.PublishCourse:input_type -> course.PublishCourseRequest
-	1,  // 10: course.CourseService.CreateCourse:output_type -> course.CreateCourseResponse
-	3,  // 11: course.CourseService.GetCourses:output_type -> course.GetCoursesResponse
-	5,  // 12: course.CourseService.GetCourse:output_type -> course.GetCourseResponse
-	7,  // 13: course.CourseService.UpdateCourse:output_type -> course.UpdateCourseResponse
-	9,  // 14: course.CourseService.PublishCourse:output_type -> course.PublishCourseResponse
-	10, // [10:15] is the sub-list for method output_type
-	5,  // [5:10] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	30, // 0: course.CreateCourseResponse.course:type_name -> course.Course
+	30, // 1: course.GetCoursesResponse.courses:type_name -> course.Course
+	30, // 2: course.GetCourseResponse.course:type_name -> course.Course
+	34, // 3: course.GetCourseResponse.prerequisites:type_name -> course.CoursePrerequisite
+	30, // 4: course.UpdateCourseResponse.course:type_name -> course.Course
+	30, // 5: course.PublishCourseResponse.course:type_name -> course.Course
+	31, // 6: course.EnrollCourseResponse.enrollment:type_name -> course.Enrollment
+	35, // 7: course.EnrollCourseResponse.missing_prerequisites:type_name -> course.MissingPrerequisite
+	32, // 8: course.CreateChapterResponse.chapter:type_name -> course.Chapter
+	32, // 9: course.GetChaptersResponse.chapters:type_name -> course.Chapter
+	33, // 10: course.CompleteChapterResponse.progress:type_name -> course.CourseProgress
+	33, // 11: course.GetCourseProgressResponse.progress:type_name -> course.CourseProgress
+	30, // 12: course.SetCourseSaleResponse.course:type_name -> course.Course
+	34, // 13: course.SetCoursePrerequisitesRequest.prerequisites:type_name -> course.CoursePrerequisite
+	34, // 14: course.SetCoursePrerequisitesResponse.prerequisites:type_name -> course.CoursePrerequisite
+	32, // 15: course.SetChapterReleaseResponse.chapter:type_name -> course.Chapter
+	32, // 16: course.CheckChapterReleaseResponse.chapter:type_name -> course.Chapter
+	0,  // 17: course.CourseService.CreateCourse:input_type -> course.CreateCourseRequest
+	2,  // 18: course.CourseService.GetCourses:input_type -> course.GetCoursesRequest
+	4,  // 19: course.CourseService.GetCourse:input_type -> course.GetCourseRequest
+	6,  // 20: course.CourseService.UpdateCourse:input_type -> course.UpdateCourseRequest
+	8,  // 21: course.CourseService.PublishCourse:input_type -> course.PublishCourseRequest
+	10, // 22: course.CourseService.EnrollCourse:input_type -> course.EnrollCourseRequest
+	12, // 23: course.CourseService.CheckCourseAccess:input_type -> course.CheckCourseAccessRequest
+	14, // 24: course.CourseService.CreateChapter:input_type -> course.CreateChapterRequest
+	16, // 25: course.CourseService.GetChapters:input_type -> course.GetChaptersRequest
+	18, // 26: course.CourseService.CompleteChapter:input_type -> course.CompleteChapterRequest
+	20, // 27: course.CourseService.GetCourseProgress:input_type -> course.GetCourseProgressRequest
+	22, // 28: course.CourseService.SetCourseSale:input_type -> course.SetCourseSaleRequest
+	24, // 29: course.CourseService.SetCoursePrerequisites:input_type -> course.SetCoursePrerequisitesRequest
+	26, // 30: course.CourseService.SetChapterRelease:input_type -> course.SetChapterReleaseRequest
+	28, // 31: course.CourseService.CheckChapterRelease:input_type -> course.CheckChapterReleaseRequest
+	1,  // 32: course.CourseService.CreateCourse:output_type -> course.CreateCourseResponse
+	3,  // 33: course.CourseService.GetCourses:output_type -> course.GetCoursesResponse
+	5,  // 34: course.CourseService.GetCourse:output_type -> course.GetCourseResponse
+	7,  // 35: course.CourseService.UpdateCourse:output_type -> course.UpdateCourseResponse
+	9,  // 36: course.CourseService.PublishCourse:output_type -> course.PublishCourseResponse
+	11, // 37: course.CourseService.EnrollCourse:output_type -> course.EnrollCourseResponse
+	13, // 38: course.CourseService.CheckCourseAccess:output_type -> course.CheckCourseAccessResponse
+	15, // 39: course.CourseService.CreateChapter:output_type -> course.CreateChapterResponse
+	17, // 40: course.CourseService.GetChapters:output_type -> course.GetChaptersResponse
+	19, // 41: course.CourseService.CompleteChapter:output_type -> course.CompleteChapterResponse
+	21, // 42: course.CourseService.GetCourseProgress:output_type -> course.GetCourseProgressResponse
+	23, // 43: course.CourseService.SetCourseSale:output_type -> course.SetCourseSaleResponse
+	25, // 44: course.CourseService.SetCoursePrerequisites:output_type -> course.SetCoursePrerequisitesResponse
+	27, // 45: course.CourseService.SetChapterRelease:output_type -> course.SetChapterReleaseResponse
+	29, // 46: course.CourseService.CheckChapterRelease:output_type -> course.CheckChapterReleaseResponse
+	32, // [32:47] is the sub-list for method output_type
+	17, // [17:32] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_protos_course_proto_init() }
@@ -909,7 +2879,7 @@ func file_protos_course_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_protos_course_proto_rawDesc), len(file_protos_course_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   36,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
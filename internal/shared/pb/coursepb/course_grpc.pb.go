@@ -19,11 +19,21 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	CourseService_CreateCourse_FullMethodName  = "/course.CourseService/CreateCourse"
-	CourseService_GetCourses_FullMethodName    = "/course.CourseService/GetCourses"
-	CourseService_GetCourse_FullMethodName     = "/course.CourseService/GetCourse"
-	CourseService_UpdateCourse_FullMethodName  = "/course.CourseService/UpdateCourse"
-	CourseService_PublishCourse_FullMethodName = "/course.CourseService/PublishCourse"
+	CourseService_CreateCourse_FullMethodName           = "/course.CourseService/CreateCourse"
+	CourseService_GetCourses_FullMethodName             = "/course.CourseService/GetCourses"
+	CourseService_GetCourse_FullMethodName              = "/course.CourseService/GetCourse"
+	CourseService_UpdateCourse_FullMethodName           = "/course.CourseService/UpdateCourse"
+	CourseService_PublishCourse_FullMethodName          = "/course.CourseService/PublishCourse"
+	CourseService_EnrollCourse_FullMethodName           = "/course.CourseService/EnrollCourse"
+	CourseService_CheckCourseAccess_FullMethodName      = "/course.CourseService/CheckCourseAccess"
+	CourseService_CreateChapter_FullMethodName          = "/course.CourseService/CreateChapter"
+	CourseService_GetChapters_FullMethodName            = "/course.CourseService/GetChapters"
+	CourseService_CompleteChapter_FullMethodName        = "/course.CourseService/CompleteChapter"
+	CourseService_GetCourseProgress_FullMethodName      = "/course.CourseService/GetCourseProgress"
+	CourseService_SetCourseSale_FullMethodName          = "/course.CourseService/SetCourseSale"
+	CourseService_SetCoursePrerequisites_FullMethodName = "/course.CourseService/SetCoursePrerequisites"
+	CourseService_SetChapterRelease_FullMethodName      = "/course.CourseService/SetChapterRelease"
+	CourseService_CheckChapterRelease_FullMethodName    = "/course.CourseService/CheckChapterRelease"
 )
 
 // CourseServiceClient is the client API for CourseService service.
@@ -42,6 +52,26 @@ type CourseServiceClient interface {
 	UpdateCourse(ctx context.Context, in *UpdateCourseRequest, opts ...grpc.CallOption) (*UpdateCourseResponse, error)
 	// 发布课程
 	PublishCourse(ctx context.Context, in *PublishCourseRequest, opts ...grpc.CallOption) (*PublishCourseResponse, error)
+	// 报名课程
+	EnrollCourse(ctx context.Context, in *EnrollCourseRequest, opts ...grpc.CallOption) (*EnrollCourseResponse, error)
+	// 检查用户是否有权访问课程内容
+	CheckCourseAccess(ctx context.Context, in *CheckCourseAccessRequest, opts ...grpc.CallOption) (*CheckCourseAccessResponse, error)
+	// 创建章节
+	CreateChapter(ctx context.Context, in *CreateChapterRequest, opts ...grpc.CallOption) (*CreateChapterResponse, error)
+	// 获取课程章节列表
+	GetChapters(ctx context.Context, in *GetChaptersRequest, opts ...grpc.CallOption) (*GetChaptersResponse, error)
+	// 标记章节已学完，学完全部章节时自动颁发证书
+	CompleteChapter(ctx context.Context, in *CompleteChapterRequest, opts ...grpc.CallOption) (*CompleteChapterResponse, error)
+	// 获取学习进度
+	GetCourseProgress(ctx context.Context, in *GetCourseProgressRequest, opts ...grpc.CallOption) (*GetCourseProgressResponse, error)
+	// 设置限时促销价（讲师）
+	SetCourseSale(ctx context.Context, in *SetCourseSaleRequest, opts ...grpc.CallOption) (*SetCourseSaleResponse, error)
+	// 设置先修课程（讲师）
+	SetCoursePrerequisites(ctx context.Context, in *SetCoursePrerequisitesRequest, opts ...grpc.CallOption) (*SetCoursePrerequisitesResponse, error)
+	// 设置章节开放规则（讲师）
+	SetChapterRelease(ctx context.Context, in *SetChapterReleaseRequest, opts ...grpc.CallOption) (*SetChapterReleaseResponse, error)
+	// 检查章节是否已对学员开放
+	CheckChapterRelease(ctx context.Context, in *CheckChapterReleaseRequest, opts ...grpc.CallOption) (*CheckChapterReleaseResponse, error)
 }
 
 type courseServiceClient struct {
@@ -102,6 +132,106 @@ func (c *courseServiceClient) PublishCourse(ctx context.Context, in *PublishCour
 	return out, nil
 }
 
+func (c *courseServiceClient) EnrollCourse(ctx context.Context, in *EnrollCourseRequest, opts ...grpc.CallOption) (*EnrollCourseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EnrollCourseResponse)
+	err := c.cc.Invoke(ctx, CourseService_EnrollCourse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) CheckCourseAccess(ctx context.Context, in *CheckCourseAccessRequest, opts ...grpc.CallOption) (*CheckCourseAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckCourseAccessResponse)
+	err := c.cc.Invoke(ctx, CourseService_CheckCourseAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) CreateChapter(ctx context.Context, in *CreateChapterRequest, opts ...grpc.CallOption) (*CreateChapterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateChapterResponse)
+	err := c.cc.Invoke(ctx, CourseService_CreateChapter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) GetChapters(ctx context.Context, in *GetChaptersRequest, opts ...grpc.CallOption) (*GetChaptersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetChaptersResponse)
+	err := c.cc.Invoke(ctx, CourseService_GetChapters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) CompleteChapter(ctx context.Context, in *CompleteChapterRequest, opts ...grpc.CallOption) (*CompleteChapterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompleteChapterResponse)
+	err := c.cc.Invoke(ctx, CourseService_CompleteChapter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) GetCourseProgress(ctx context.Context, in *GetCourseProgressRequest, opts ...grpc.CallOption) (*GetCourseProgressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCourseProgressResponse)
+	err := c.cc.Invoke(ctx, CourseService_GetCourseProgress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) SetCourseSale(ctx context.Context, in *SetCourseSaleRequest, opts ...grpc.CallOption) (*SetCourseSaleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetCourseSaleResponse)
+	err := c.cc.Invoke(ctx, CourseService_SetCourseSale_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) SetCoursePrerequisites(ctx context.Context, in *SetCoursePrerequisitesRequest, opts ...grpc.CallOption) (*SetCoursePrerequisitesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetCoursePrerequisitesResponse)
+	err := c.cc.Invoke(ctx, CourseService_SetCoursePrerequisites_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) SetChapterRelease(ctx context.Context, in *SetChapterReleaseRequest, opts ...grpc.CallOption) (*SetChapterReleaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetChapterReleaseResponse)
+	err := c.cc.Invoke(ctx, CourseService_SetChapterRelease_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) CheckChapterRelease(ctx context.Context, in *CheckChapterReleaseRequest, opts ...grpc.CallOption) (*CheckChapterReleaseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckChapterReleaseResponse)
+	err := c.cc.Invoke(ctx, CourseService_CheckChapterRelease_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CourseServiceServer is the server API for CourseService service.
 // All implementations must embed UnimplementedCourseServiceServer
 // for forward compatibility.
@@ -118,6 +248,26 @@ type CourseServiceServer interface {
 	UpdateCourse(context.Context, *UpdateCourseRequest) (*UpdateCourseResponse, error)
 	// 发布课程
 	PublishCourse(context.Context, *PublishCourseRequest) (*PublishCourseResponse, error)
+	// 报名课程
+	EnrollCourse(context.Context, *EnrollCourseRequest) (*EnrollCourseResponse, error)
+	// 检查用户是否有权访问课程内容
+	CheckCourseAccess(context.Context, *CheckCourseAccessRequest) (*CheckCourseAccessResponse, error)
+	// 创建章节
+	CreateChapter(context.Context, *CreateChapterRequest) (*CreateChapterResponse, error)
+	// 获取课程章节列表
+	GetChapters(context.Context, *GetChaptersRequest) (*GetChaptersResponse, error)
+	// 标记章节已学完，学完全部章节时自动颁发证书
+	CompleteChapter(context.Context, *CompleteChapterRequest) (*CompleteChapterResponse, error)
+	// 获取学习进度
+	GetCourseProgress(context.Context, *GetCourseProgressRequest) (*GetCourseProgressResponse, error)
+	// 设置限时促销价（讲师）
+	SetCourseSale(context.Context, *SetCourseSaleRequest) (*SetCourseSaleResponse, error)
+	// 设置先修课程（讲师）
+	SetCoursePrerequisites(context.Context, *SetCoursePrerequisitesRequest) (*SetCoursePrerequisitesResponse, error)
+	// 设置章节开放规则（讲师）
+	SetChapterRelease(context.Context, *SetChapterReleaseRequest) (*SetChapterReleaseResponse, error)
+	// 检查章节是否已对学员开放
+	CheckChapterRelease(context.Context, *CheckChapterReleaseRequest) (*CheckChapterReleaseResponse, error)
 	mustEmbedUnimplementedCourseServiceServer()
 }
 
@@ -143,6 +293,36 @@ func (UnimplementedCourseServiceServer) UpdateCourse(context.Context, *UpdateCou
 func (UnimplementedCourseServiceServer) PublishCourse(context.Context, *PublishCourseRequest) (*PublishCourseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PublishCourse not implemented")
 }
+func (UnimplementedCourseServiceServer) EnrollCourse(context.Context, *EnrollCourseRequest) (*EnrollCourseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrollCourse not implemented")
+}
+func (UnimplementedCourseServiceServer) CheckCourseAccess(context.Context, *CheckCourseAccessRequest) (*CheckCourseAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckCourseAccess not implemented")
+}
+func (UnimplementedCourseServiceServer) CreateChapter(context.Context, *CreateChapterRequest) (*CreateChapterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateChapter not implemented")
+}
+func (UnimplementedCourseServiceServer) GetChapters(context.Context, *GetChaptersRequest) (*GetChaptersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChapters not implemented")
+}
+func (UnimplementedCourseServiceServer) CompleteChapter(context.Context, *CompleteChapterRequest) (*CompleteChapterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteChapter not implemented")
+}
+func (UnimplementedCourseServiceServer) GetCourseProgress(context.Context, *GetCourseProgressRequest) (*GetCourseProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCourseProgress not implemented")
+}
+func (UnimplementedCourseServiceServer) SetCourseSale(context.Context, *SetCourseSaleRequest) (*SetCourseSaleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCourseSale not implemented")
+}
+func (UnimplementedCourseServiceServer) SetCoursePrerequisites(context.Context, *SetCoursePrerequisitesRequest) (*SetCoursePrerequisitesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCoursePrerequisites not implemented")
+}
+func (UnimplementedCourseServiceServer) SetChapterRelease(context.Context, *SetChapterReleaseRequest) (*SetChapterReleaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetChapterRelease not implemented")
+}
+func (UnimplementedCourseServiceServer) CheckChapterRelease(context.Context, *CheckChapterReleaseRequest) (*CheckChapterReleaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckChapterRelease not implemented")
+}
 func (UnimplementedCourseServiceServer) mustEmbedUnimplementedCourseServiceServer() {}
 func (UnimplementedCourseServiceServer) testEmbeddedByValue()                       {}
 
@@ -254,6 +434,186 @@ func _CourseService_PublishCourse_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CourseService_EnrollCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollCourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).EnrollCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_EnrollCourse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).EnrollCourse(ctx, req.(*EnrollCourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_CheckCourseAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckCourseAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).CheckCourseAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_CheckCourseAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).CheckCourseAccess(ctx, req.(*CheckCourseAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_CreateChapter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateChapterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).CreateChapter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_CreateChapter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).CreateChapter(ctx, req.(*CreateChapterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_GetChapters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChaptersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).GetChapters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_GetChapters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).GetChapters(ctx, req.(*GetChaptersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_CompleteChapter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteChapterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).CompleteChapter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_CompleteChapter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).CompleteChapter(ctx, req.(*CompleteChapterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_GetCourseProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCourseProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).GetCourseProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_GetCourseProgress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).GetCourseProgress(ctx, req.(*GetCourseProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_SetCourseSale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCourseSaleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).SetCourseSale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_SetCourseSale_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).SetCourseSale(ctx, req.(*SetCourseSaleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_SetCoursePrerequisites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCoursePrerequisitesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).SetCoursePrerequisites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_SetCoursePrerequisites_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).SetCoursePrerequisites(ctx, req.(*SetCoursePrerequisitesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_SetChapterRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetChapterReleaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).SetChapterRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_SetChapterRelease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).SetChapterRelease(ctx, req.(*SetChapterReleaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_CheckChapterRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckChapterReleaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).CheckChapterRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_CheckChapterRelease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).CheckChapterRelease(ctx, req.(*CheckChapterReleaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CourseService_ServiceDesc is the grpc.ServiceDesc for CourseService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -281,6 +641,46 @@ var CourseService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PublishCourse",
 			Handler:    _CourseService_PublishCourse_Handler,
 		},
+		{
+			MethodName: "EnrollCourse",
+			Handler:    _CourseService_EnrollCourse_Handler,
+		},
+		{
+			MethodName: "CheckCourseAccess",
+			Handler:    _CourseService_CheckCourseAccess_Handler,
+		},
+		{
+			MethodName: "CreateChapter",
+			Handler:    _CourseService_CreateChapter_Handler,
+		},
+		{
+			MethodName: "GetChapters",
+			Handler:    _CourseService_GetChapters_Handler,
+		},
+		{
+			MethodName: "CompleteChapter",
+			Handler:    _CourseService_CompleteChapter_Handler,
+		},
+		{
+			MethodName: "GetCourseProgress",
+			Handler:    _CourseService_GetCourseProgress_Handler,
+		},
+		{
+			MethodName: "SetCourseSale",
+			Handler:    _CourseService_SetCourseSale_Handler,
+		},
+		{
+			MethodName: "SetCoursePrerequisites",
+			Handler:    _CourseService_SetCoursePrerequisites_Handler,
+		},
+		{
+			MethodName: "SetChapterRelease",
+			Handler:    _CourseService_SetChapterRelease_Handler,
+		},
+		{
+			MethodName: "CheckChapterRelease",
+			Handler:    _CourseService_CheckChapterRelease_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "protos/course.proto",
@@ -0,0 +1,1740 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: protos/discussion.proto
+
+package discussionpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 发布讨论帖请求消息
+type CreateThreadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      uint32                 `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	ChapterId     uint32                 `protobuf:"varint,2,opt,name=chapter_id,json=chapterId,proto3" json:"chapter_id,omitempty"` // 0表示课程整体的讨论
+	UserId        uint32                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Body          string                 `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateThreadRequest) Reset() {
+	*x = CreateThreadRequest{}
+	mi := &file_protos_discussion_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateThreadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateThreadRequest) ProtoMessage() {}
+
+func (x *CreateThreadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_discussion_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateThreadRequest.ProtoReflect.Descriptor instead.
+func (*CreateThreadRequest) Descriptor() ([]byte, []int) {
+	return file_protos_discussion_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateThreadRequest) GetCourseId() uint32 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *CreateThreadRequest) GetChapterId() uint32 {
+	if x != nil {
+		return x.ChapterId
+	}
+	return 0
+}
+
+func (x *CreateThreadRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateThreadRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateThreadRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+// 发布讨论帖响应消息
+type CreateThreadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Thread        *Thread                `protobuf:"bytes,3,opt,name=thread,proto3" json:"thread,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateThreadResponse) Reset() {
+	*x = CreateThreadResponse{}
+	mi := &file_protos_discussion_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateThreadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateThreadResponse) ProtoMessage() {}
+
+func (x *CreateThreadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_discussion_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateThreadResponse.ProtoReflect.Descriptor instead.
+func (*CreateThreadResponse) Descriptor() ([]byte, []int) {
+	return file_protos_discussion_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateThreadResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *CreateThreadResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateThreadResponse) GetThread() *Thread {
+	if x != nil {
+		return x.Thread
+	}
+	return nil
+}
+
+// 讨论帖列表请求消息
+type ListThreadsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      uint32                 `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	ChapterId     uint32                 `protobuf:"varint,2,opt,name=chapter_id,json=chapterId,proto3" json:"chapter_id,omitempty"` // 0表示不限章节
+	UserId        uint32                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Sort          string                 `protobuf:"bytes,4,opt,name=sort,proto3" json:"sort,omitempty"` // latest/top/unanswered，默认 latest
+	Page          uint32                 `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      uint32                 `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListThreadsRequest) Reset() {
+	*x = ListThreadsRequest{}
+	mi := &file_protos_discussion_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListThreadsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListThreadsRequest) ProtoMessage() {}
+
+func (x *ListThreadsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_discussion_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListThreadsRequest.ProtoReflect.Descriptor instead.
+func (*ListThreadsRequest) Descriptor() ([]byte, []int) {
+	return file_protos_discussion_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListThreadsRequest) GetCourseId() uint32 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *ListThreadsRequest) GetChapterId() uint32 {
+	if x != nil {
+		return x.ChapterId
+	}
+	return 0
+}
+
+func (x *ListThreadsRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListThreadsRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *ListThreadsRequest) GetPage() uint32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListThreadsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// 讨论帖列表响应消息
+type ListThreadsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Threads       []*Thread              `protobuf:"bytes,3,rep,name=threads,proto3" json:"threads,omitempty"`
+	Total         int64                  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListThreadsResponse) Reset() {
+	*x = ListThreadsResponse{}
+	mi := &file_protos_discussion_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListThreadsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListThreadsResponse) ProtoMessage() {}
+
+func (x *ListThreadsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_discussion_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListThreadsResponse.ProtoReflect.Descriptor instead.
+func (*ListThreadsResponse) Descriptor() ([]byte, []int) {
+	return file_protos_discussion_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListThreadsResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListThreadsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListThreadsResponse) GetThreads() []*Thread {
+	if x != nil {
+		return x.Threads
+	}
+	return nil
+}
+
+func (x *ListThreadsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 获取讨论帖请求消息，回复按顶层回复分页
+type GetThreadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ThreadId      uint32                 `protobuf:"varint,1,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Page          uint32                 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      uint32                 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetThreadRequest) Reset() {
+	*x = GetThreadRequest{}
+	mi := &file_protos_discussion_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetThreadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetThreadRequest) ProtoMessage() {}
+
+func (x *GetThreadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_discussion_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetThreadRequest.ProtoReflect.Descriptor instead.
+func (*GetThreadRequest) Descriptor() ([]byte, []int) {
+	return file_protos_discussion_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetThreadRequest) GetThreadId() uint32 {
+	if x != nil {
+		return x.ThreadId
+	}
+	return 0
+}
+
+func (x *GetThreadRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetThreadRequest) GetPage() uint32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetThreadRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// 获取讨论帖响应消息，replies 包含本页顶层回复及其下全部嵌套回复
+type GetThreadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Thread        *Thread                `protobuf:"bytes,3,opt,name=thread,proto3" json:"thread,omitempty"`
+	Replies       []*Reply               `protobuf:"bytes,4,rep,name=replies,proto3" json:"replies,omitempty"`
+	TotalReplies  int64                  `protobuf:"varint,5,opt,name=total_replies,json=totalReplies,proto3" json:"total_replies,omitempty"` // 顶层回复总数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetThreadResponse) Reset() {
+	*x = GetThreadResponse{}
+	mi := &file_protos_discussion_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetThreadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetThreadResponse) ProtoMessage() {}
+
+func (x *GetThreadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_discussion_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetThreadResponse.ProtoReflect.Descriptor instead.
+func (*GetThreadResponse) Descriptor() ([]byte, []int) {
+	return file_protos_discussion_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetThreadResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetThreadResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetThreadResponse) GetThread() *Thread {
+	if x != nil {
+		return x.Thread
+	}
+	return nil
+}
+
+func (x *GetThreadResponse) GetReplies() []*Reply {
+	if x != nil {
+		return x.Replies
+	}
+	return nil
+}
+
+func (x *GetThreadResponse) GetTotalReplies() int64 {
+	if x != nil {
+		return x.TotalReplies
+	}
+	return 0
+}
+
+// 回复请求消息
+type CreateReplyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ThreadId      uint32                 `protobuf:"varint,1,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	ParentId      uint32                 `protobuf:"varint,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"` // 0表示直接回复帖子
+	UserId        uint32                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Body          string                 `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReplyRequest) Reset() {
+	*x = CreateReplyRequest{}
+	mi := &file_protos_discussion_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReplyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReplyRequest) ProtoMessage() {}
+
+func (x *CreateReplyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_discussion_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReplyRequest.ProtoReflect.Descriptor instead.
+func (*CreateReplyRequest) Descriptor() ([]byte, []int) {
+	return file_protos_discussion_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateReplyRequest) GetThreadId() uint32 {
+	if x != nil {
+		return x.ThreadId
+	}
+	return 0
+}
+
+func (x *CreateReplyRequest) GetParentId() uint32 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *CreateReplyRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateReplyRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+// 回复响应消息
+type CreateReplyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Reply         *Reply                 `protobuf:"bytes,3,opt,name=reply,proto3" json:"reply,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReplyResponse) Reset() {
+	*x = CreateReplyResponse{}
+	mi := &file_protos_discussion_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReplyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReplyResponse) ProtoMessage() {}
+
+func (x *CreateReplyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_discussion_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReplyResponse.ProtoReflect.Descriptor instead.
+func (*CreateReplyResponse) Descriptor() ([]byte, []int) {
+	return file_protos_discussion_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreateReplyResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *CreateReplyResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateReplyResponse) GetReply() *Reply {
+	if x != nil {
+		return x.Reply
+	}
+	return nil
+}
+
+// 点赞请求消息
+type VoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TargetType    string                 `protobuf:"bytes,1,opt,name=target_type,json=targetType,proto3" json:"target_type,omitempty"` // thread/reply
+	TargetId      uint32                 `protobuf:"varint,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Upvote        bool                   `protobuf:"varint,4,opt,name=upvote,proto3" json:"upvote,omitempty"` // false 表示取消点赞
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VoteRequest) Reset() {
+	*x = VoteRequest{}
+	mi := &file_protos_discussion_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoteRequest) ProtoMessage() {}
+
+func (x *VoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_discussion_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoteRequest.ProtoReflect.Descriptor instead.
+func (*VoteRequest) Descriptor() ([]byte, []int) {
+	return file_protos_discussion_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *VoteRequest) GetTargetType() string {
+	if x != nil {
+		return x.TargetType
+	}
+	return ""
+}
+
+func (x *VoteRequest) GetTargetId() uint32 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+func (x *VoteRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *VoteRequest) GetUpvote() bool {
+	if x != nil {
+		return x.Upvote
+	}
+	return false
+}
+
+// 点赞响应消息
+type VoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Upvotes       int32                  `protobuf:"varint,3,opt,name=upvotes,proto3" json:"upvotes,omitempty"`
+	Upvoted       bool                   `protobuf:"varint,4,opt,name=upvoted,proto3" json:"upvoted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VoteResponse) Reset() {
+	*x = VoteResponse{}
+	mi := &file_protos_discussion_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoteResponse) ProtoMessage() {}
+
+func (x *VoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_discussion_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoteResponse.ProtoReflect.Descriptor instead.
+func (*VoteResponse) Descriptor() ([]byte, []int) {
+	return file_protos_discussion_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *VoteResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *VoteResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *VoteResponse) GetUpvotes() int32 {
+	if x != nil {
+		return x.Upvotes
+	}
+	return 0
+}
+
+func (x *VoteResponse) GetUpvoted() bool {
+	if x != nil {
+		return x.Upvoted
+	}
+	return false
+}
+
+// 管理讨论帖请求消息
+type ModerateThreadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ThreadId      uint32                 `protobuf:"varint,1,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Action        string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"` // pin/unpin/lock/unlock
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerateThreadRequest) Reset() {
+	*x = ModerateThreadRequest{}
+	mi := &file_protos_discussion_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerateThreadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateThreadRequest) ProtoMessage() {}
+
+func (x *ModerateThreadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_discussion_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateThreadRequest.ProtoReflect.Descriptor instead.
+func (*ModerateThreadRequest) Descriptor() ([]byte, []int) {
+	return file_protos_discussion_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ModerateThreadRequest) GetThreadId() uint32 {
+	if x != nil {
+		return x.ThreadId
+	}
+	return 0
+}
+
+func (x *ModerateThreadRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ModerateThreadRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+// 管理讨论帖响应消息
+type ModerateThreadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Thread        *Thread                `protobuf:"bytes,3,opt,name=thread,proto3" json:"thread,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerateThreadResponse) Reset() {
+	*x = ModerateThreadResponse{}
+	mi := &file_protos_discussion_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerateThreadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateThreadResponse) ProtoMessage() {}
+
+func (x *ModerateThreadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_discussion_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateThreadResponse.ProtoReflect.Descriptor instead.
+func (*ModerateThreadResponse) Descriptor() ([]byte, []int) {
+	return file_protos_discussion_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ModerateThreadResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ModerateThreadResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ModerateThreadResponse) GetThread() *Thread {
+	if x != nil {
+		return x.Thread
+	}
+	return nil
+}
+
+// 标记最佳回答请求消息
+type MarkAnswerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ThreadId      uint32                 `protobuf:"varint,1,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	ReplyId       uint32                 `protobuf:"varint,2,opt,name=reply_id,json=replyId,proto3" json:"reply_id,omitempty"` // 0表示取消标记
+	UserId        uint32                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkAnswerRequest) Reset() {
+	*x = MarkAnswerRequest{}
+	mi := &file_protos_discussion_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkAnswerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkAnswerRequest) ProtoMessage() {}
+
+func (x *MarkAnswerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_discussion_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkAnswerRequest.ProtoReflect.Descriptor instead.
+func (*MarkAnswerRequest) Descriptor() ([]byte, []int) {
+	return file_protos_discussion_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MarkAnswerRequest) GetThreadId() uint32 {
+	if x != nil {
+		return x.ThreadId
+	}
+	return 0
+}
+
+func (x *MarkAnswerRequest) GetReplyId() uint32 {
+	if x != nil {
+		return x.ReplyId
+	}
+	return 0
+}
+
+func (x *MarkAnswerRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// 标记最佳回答响应消息
+type MarkAnswerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Thread        *Thread                `protobuf:"bytes,3,opt,name=thread,proto3" json:"thread,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkAnswerResponse) Reset() {
+	*x = MarkAnswerResponse{}
+	mi := &file_protos_discussion_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkAnswerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkAnswerResponse) ProtoMessage() {}
+
+func (x *MarkAnswerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_discussion_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkAnswerResponse.ProtoReflect.Descriptor instead.
+func (*MarkAnswerResponse) Descriptor() ([]byte, []int) {
+	return file_protos_discussion_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *MarkAnswerResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *MarkAnswerResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *MarkAnswerResponse) GetThread() *Thread {
+	if x != nil {
+		return x.Thread
+	}
+	return nil
+}
+
+// 删除讨论帖请求消息
+type DeleteThreadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ThreadId      uint32                 `protobuf:"varint,1,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteThreadRequest) Reset() {
+	*x = DeleteThreadRequest{}
+	mi := &file_protos_discussion_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteThreadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteThreadRequest) ProtoMessage() {}
+
+func (x *DeleteThreadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_discussion_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteThreadRequest.ProtoReflect.Descriptor instead.
+func (*DeleteThreadRequest) Descriptor() ([]byte, []int) {
+	return file_protos_discussion_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DeleteThreadRequest) GetThreadId() uint32 {
+	if x != nil {
+		return x.ThreadId
+	}
+	return 0
+}
+
+func (x *DeleteThreadRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// 删除讨论帖响应消息
+type DeleteThreadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteThreadResponse) Reset() {
+	*x = DeleteThreadResponse{}
+	mi := &file_protos_discussion_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteThreadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteThreadResponse) ProtoMessage() {}
+
+func (x *DeleteThreadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_discussion_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteThreadResponse.ProtoReflect.Descriptor instead.
+func (*DeleteThreadResponse) Descriptor() ([]byte, []int) {
+	return file_protos_discussion_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DeleteThreadResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *DeleteThreadResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 删除回复请求消息
+type DeleteReplyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReplyId       uint32                 `protobuf:"varint,1,opt,name=reply_id,json=replyId,proto3" json:"reply_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteReplyRequest) Reset() {
+	*x = DeleteReplyRequest{}
+	mi := &file_protos_discussion_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteReplyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReplyRequest) ProtoMessage() {}
+
+func (x *DeleteReplyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_discussion_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReplyRequest.ProtoReflect.Descriptor instead.
+func (*DeleteReplyRequest) Descriptor() ([]byte, []int) {
+	return file_protos_discussion_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DeleteReplyRequest) GetReplyId() uint32 {
+	if x != nil {
+		return x.ReplyId
+	}
+	return 0
+}
+
+func (x *DeleteReplyRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// 删除回复响应消息
+type DeleteReplyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteReplyResponse) Reset() {
+	*x = DeleteReplyResponse{}
+	mi := &file_protos_discussion_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteReplyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReplyResponse) ProtoMessage() {}
+
+func (x *DeleteReplyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_discussion_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReplyResponse.ProtoReflect.Descriptor instead.
+func (*DeleteReplyResponse) Descriptor() ([]byte, []int) {
+	return file_protos_discussion_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DeleteReplyResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *DeleteReplyResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 讨论帖模型
+type Thread struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CourseId           uint32                 `protobuf:"varint,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	ChapterId          uint32                 `protobuf:"varint,3,opt,name=chapter_id,json=chapterId,proto3" json:"chapter_id,omitempty"`
+	AuthorId           uint32                 `protobuf:"varint,4,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	AuthorName         string                 `protobuf:"bytes,5,opt,name=author_name,json=authorName,proto3" json:"author_name,omitempty"`
+	AuthorIsInstructor bool                   `protobuf:"varint,6,opt,name=author_is_instructor,json=authorIsInstructor,proto3" json:"author_is_instructor,omitempty"`
+	Title              string                 `protobuf:"bytes,7,opt,name=title,proto3" json:"title,omitempty"`
+	Body               string                 `protobuf:"bytes,8,opt,name=body,proto3" json:"body,omitempty"` // 已删除的帖子仅管理者可见内容
+	Pinned             bool                   `protobuf:"varint,9,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	Locked             bool                   `protobuf:"varint,10,opt,name=locked,proto3" json:"locked,omitempty"`
+	InstructorAnswered bool                   `protobuf:"varint,11,opt,name=instructor_answered,json=instructorAnswered,proto3" json:"instructor_answered,omitempty"`
+	AnswerReplyId      uint32                 `protobuf:"varint,12,opt,name=answer_reply_id,json=answerReplyId,proto3" json:"answer_reply_id,omitempty"`
+	ReplyCount         int32                  `protobuf:"varint,13,opt,name=reply_count,json=replyCount,proto3" json:"reply_count,omitempty"`
+	Upvotes            int32                  `protobuf:"varint,14,opt,name=upvotes,proto3" json:"upvotes,omitempty"`
+	Upvoted            bool                   `protobuf:"varint,15,opt,name=upvoted,proto3" json:"upvoted,omitempty"`
+	Deleted            bool                   `protobuf:"varint,16,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	CreatedAt          string                 `protobuf:"bytes,17,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastActivityAt     string                 `protobuf:"bytes,18,opt,name=last_activity_at,json=lastActivityAt,proto3" json:"last_activity_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Thread) Reset() {
+	*x = Thread{}
+	mi := &file_protos_discussion_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Thread) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Thread) ProtoMessage() {}
+
+func (x *Thread) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_discussion_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Thread.ProtoReflect.Descriptor instead.
+func (*Thread) Descriptor() ([]byte, []int) {
+	return file_protos_discussion_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Thread) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Thread) GetCourseId() uint32 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *Thread) GetChapterId() uint32 {
+	if x != nil {
+		return x.ChapterId
+	}
+	return 0
+}
+
+func (x *Thread) GetAuthorId() uint32 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
+func (x *Thread) GetAuthorName() string {
+	if x != nil {
+		return x.AuthorName
+	}
+	return ""
+}
+
+func (x *Thread) GetAuthorIsInstructor() bool {
+	if x != nil {
+		return x.AuthorIsInstructor
+	}
+	return false
+}
+
+func (x *Thread) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Thread) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *Thread) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+func (x *Thread) GetLocked() bool {
+	if x != nil {
+		return x.Locked
+	}
+	return false
+}
+
+func (x *Thread) GetInstructorAnswered() bool {
+	if x != nil {
+		return x.InstructorAnswered
+	}
+	return false
+}
+
+func (x *Thread) GetAnswerReplyId() uint32 {
+	if x != nil {
+		return x.AnswerReplyId
+	}
+	return 0
+}
+
+func (x *Thread) GetReplyCount() int32 {
+	if x != nil {
+		return x.ReplyCount
+	}
+	return 0
+}
+
+func (x *Thread) GetUpvotes() int32 {
+	if x != nil {
+		return x.Upvotes
+	}
+	return 0
+}
+
+func (x *Thread) GetUpvoted() bool {
+	if x != nil {
+		return x.Upvoted
+	}
+	return false
+}
+
+func (x *Thread) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *Thread) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Thread) GetLastActivityAt() string {
+	if x != nil {
+		return x.LastActivityAt
+	}
+	return ""
+}
+
+// 回复模型
+type Reply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ThreadId      uint32                 `protobuf:"varint,2,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	ParentId      uint32                 `protobuf:"varint,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Depth         int32                  `protobuf:"varint,4,opt,name=depth,proto3" json:"depth,omitempty"`
+	AuthorId      uint32                 `protobuf:"varint,5,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	AuthorName    string                 `protobuf:"bytes,6,opt,name=author_name,json=authorName,proto3" json:"author_name,omitempty"`
+	Body          string                 `protobuf:"bytes,7,opt,name=body,proto3" json:"body,omitempty"` // 已删除的回复仅管理者可见内容
+	ByInstructor  bool                   `protobuf:"varint,8,opt,name=by_instructor,json=byInstructor,proto3" json:"by_instructor,omitempty"`
+	IsAnswer      bool                   `protobuf:"varint,9,opt,name=is_answer,json=isAnswer,proto3" json:"is_answer,omitempty"`
+	Upvotes       int32                  `protobuf:"varint,10,opt,name=upvotes,proto3" json:"upvotes,omitempty"`
+	Upvoted       bool                   `protobuf:"varint,11,opt,name=upvoted,proto3" json:"upvoted,omitempty"`
+	Deleted       bool                   `protobuf:"varint,12,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reply) Reset() {
+	*x = Reply{}
+	mi := &file_protos_discussion_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reply) ProtoMessage() {}
+
+func (x *Reply) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_discussion_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reply.ProtoReflect.Descriptor instead.
+func (*Reply) Descriptor() ([]byte, []int) {
+	return file_protos_discussion_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Reply) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Reply) GetThreadId() uint32 {
+	if x != nil {
+		return x.ThreadId
+	}
+	return 0
+}
+
+func (x *Reply) GetParentId() uint32 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *Reply) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *Reply) GetAuthorId() uint32 {
+	if x != nil {
+		return x.AuthorId
+	}
+	return 0
+}
+
+func (x *Reply) GetAuthorName() string {
+	if x != nil {
+		return x.AuthorName
+	}
+	return ""
+}
+
+func (x *Reply) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *Reply) GetByInstructor() bool {
+	if x != nil {
+		return x.ByInstructor
+	}
+	return false
+}
+
+func (x *Reply) GetIsAnswer() bool {
+	if x != nil {
+		return x.IsAnswer
+	}
+	return false
+}
+
+func (x *Reply) GetUpvotes() int32 {
+	if x != nil {
+		return x.Upvotes
+	}
+	return 0
+}
+
+func (x *Reply) GetUpvoted() bool {
+	if x != nil {
+		return x.Upvoted
+	}
+	return false
+}
+
+func (x *Reply) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *Reply) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+var File_protos_discussion_proto protoreflect.FileDescriptor
+
+const file_protos_discussion_proto_rawDesc = "" +
+	"\n" +
+	"\x17protos/discussion.proto\x12\n" +
+	"discussion\"\x94\x01\n" +
+	"\x13CreateThreadRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\rR\bcourseId\x12\x1d\n" +
+	"\n" +
+	"chapter_id\x18\x02 \x01(\rR\tchapterId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\rR\x06userId\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\x05 \x01(\tR\x04body\"p\n" +
+	"\x14CreateThreadResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12*\n" +
+	"\x06thread\x18\x03 \x01(\v2\x12.discussion.ThreadR\x06thread\"\xae\x01\n" +
+	"\x12ListThreadsRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\rR\bcourseId\x12\x1d\n" +
+	"\n" +
+	"chapter_id\x18\x02 \x01(\rR\tchapterId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\rR\x06userId\x12\x12\n" +
+	"\x04sort\x18\x04 \x01(\tR\x04sort\x12\x12\n" +
+	"\x04page\x18\x05 \x01(\rR\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x06 \x01(\rR\bpageSize\"\x87\x01\n" +
+	"\x13ListThreadsResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12,\n" +
+	"\athreads\x18\x03 \x03(\v2\x12.discussion.ThreadR\athreads\x12\x14\n" +
+	"\x05total\x18\x04 \x01(\x03R\x05total\"y\n" +
+	"\x10GetThreadRequest\x12\x1b\n" +
+	"\tthread_id\x18\x01 \x01(\rR\bthreadId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\rR\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\rR\bpageSize\"\xbf\x01\n" +
+	"\x11GetThreadResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12*\n" +
+	"\x06thread\x18\x03 \x01(\v2\x12.discussion.ThreadR\x06thread\x12+\n" +
+	"\areplies\x18\x04 \x03(\v2\x11.discussion.ReplyR\areplies\x12#\n" +
+	"\rtotal_replies\x18\x05 \x01(\x03R\ftotalReplies\"{\n" +
+	"\x12CreateReplyRequest\x12\x1b\n" +
+	"\tthread_id\x18\x01 \x01(\rR\bthreadId\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\rR\bparentId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\rR\x06userId\x12\x12\n" +
+	"\x04body\x18\x04 \x01(\tR\x04body\"l\n" +
+	"\x13CreateReplyResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12'\n" +
+	"\x05reply\x18\x03 \x01(\v2\x11.discussion.ReplyR\x05reply\"|\n" +
+	"\vVoteRequest\x12\x1f\n" +
+	"\vtarget_type\x18\x01 \x01(\tR\n" +
+	"targetType\x12\x1b\n" +
+	"\ttarget_id\x18\x02 \x01(\rR\btargetId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\rR\x06userId\x12\x16\n" +
+	"\x06upvote\x18\x04 \x01(\bR\x06upvote\"p\n" +
+	"\fVoteResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\aupvotes\x18\x03 \x01(\x05R\aupvotes\x12\x18\n" +
+	"\aupvoted\x18\x04 \x01(\bR\aupvoted\"e\n" +
+	"\x15ModerateThreadRequest\x12\x1b\n" +
+	"\tthread_id\x18\x01 \x01(\rR\bthreadId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\"r\n" +
+	"\x16ModerateThreadResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12*\n" +
+	"\x06thread\x18\x03 \x01(\v2\x12.discussion.ThreadR\x06thread\"d\n" +
+	"\x11MarkAnswerRequest\x12\x1b\n" +
+	"\tthread_id\x18\x01 \x01(\rR\bthreadId\x12\x19\n" +
+	"\breply_id\x18\x02 \x01(\rR\areplyId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\rR\x06userId\"n\n" +
+	"\x12MarkAnswerResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12*\n" +
+	"\x06thread\x18\x03 \x01(\v2\x12.discussion.ThreadR\x06thread\"K\n" +
+	"\x13DeleteThreadRequest\x12\x1b\n" +
+	"\tthread_id\x18\x01 \x01(\rR\bthreadId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\"D\n" +
+	"\x14DeleteThreadResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"H\n" +
+	"\x12DeleteReplyRequest\x12\x19\n" +
+	"\breply_id\x18\x01 \x01(\rR\areplyId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\"C\n" +
+	"\x13DeleteReplyResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xaf\x04\n" +
+	"\x06Thread\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1b\n" +
+	"\tcourse_id\x18\x02 \x01(\rR\bcourseId\x12\x1d\n" +
+	"\n" +
+	"chapter_id\x18\x03 \x01(\rR\tchapterId\x12\x1b\n" +
+	"\tauthor_id\x18\x04 \x01(\rR\bauthorId\x12\x1f\n" +
+	"\vauthor_name\x18\x05 \x01(\tR\n" +
+	"authorName\x120\n" +
+	"\x14author_is_instructor\x18\x06 \x01(\bR\x12authorIsInstructor\x12\x14\n" +
+	"\x05title\x18\a \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\b \x01(\tR\x04body\x12\x16\n" +
+	"\x06pinned\x18\t \x01(\bR\x06pinned\x12\x16\n" +
+	"\x06locked\x18\n" +
+	" \x01(\bR\x06locked\x12/\n" +
+	"\x13instructor_answered\x18\v \x01(\bR\x12instructorAnswered\x12&\n" +
+	"\x0fanswer_reply_id\x18\f \x01(\rR\ranswerReplyId\x12\x1f\n" +
+	"\vreply_count\x18\r \x01(\x05R\n" +
+	"replyCount\x12\x18\n" +
+	"\aupvotes\x18\x0e \x01(\x05R\aupvotes\x12\x18\n" +
+	"\aupvoted\x18\x0f \x01(\bR\aupvoted\x12\x18\n" +
+	"\adeleted\x18\x10 \x01(\bR\adeleted\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x11 \x01(\tR\tcreatedAt\x12(\n" +
+	"\x10last_activity_at\x18\x12 \x01(\tR\x0elastActivityAt\"\xe8\x02\n" +
+	"\x05Reply\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1b\n" +
+	"\tthread_id\x18\x02 \x01(\rR\bthreadId\x12\x1b\n" +
+	"\tparent_id\x18\x03 \x01(\rR\bparentId\x12\x14\n" +
+	"\x05depth\x18\x04 \x01(\x05R\x05depth\x12\x1b\n" +
+	"\tauthor_id\x18\x05 \x01(\rR\bauthorId\x12\x1f\n" +
+	"\vauthor_name\x18\x06 \x01(\tR\n" +
+	"authorName\x12\x12\n" +
+	"\x04body\x18\a \x01(\tR\x04body\x12#\n" +
+	"\rby_instructor\x18\b \x01(\bR\fbyInstructor\x12\x1b\n" +
+	"\tis_answer\x18\t \x01(\bR\bisAnswer\x12\x18\n" +
+	"\aupvotes\x18\n" +
+	" \x01(\x05R\aupvotes\x12\x18\n" +
+	"\aupvoted\x18\v \x01(\bR\aupvoted\x12\x18\n" +
+	"\adeleted\x18\f \x01(\bR\adeleted\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\r \x01(\tR\tcreatedAt2\xd4\x05\n" +
+	"\x11DiscussionService\x12Q\n" +
+	"\fCreateThread\x12\x1f.discussion.CreateThreadRequest\x1a .discussion.CreateThreadResponse\x12N\n" +
+	"\vListThreads\x12\x1e.discussion.ListThreadsRequest\x1a\x1f.discussion.ListThreadsResponse\x12H\n" +
+	"\tGetThread\x12\x1c.discussion.GetThreadRequest\x1a\x1d.discussion.GetThreadResponse\x12N\n" +
+	"\vCreateReply\x12\x1e.discussion.CreateReplyRequest\x1a\x1f.discussion.CreateReplyResponse\x129\n" +
+	"\x04Vote\x12\x17.discussion.VoteRequest\x1a\x18.discussion.VoteResponse\x12W\n" +
+	"\x0eModerateThread\x12!.discussion.ModerateThreadRequest\x1a\".discussion.ModerateThreadResponse\x12K\n" +
+	"\n" +
+	"MarkAnswer\x12\x1d.discussion.MarkAnswerRequest\x1a\x1e.discussion.MarkAnswerResponse\x12Q\n" +
+	"\fDeleteThread\x12\x1f.discussion.DeleteThreadRequest\x1a .discussion.DeleteThreadResponse\x12N\n" +
+	"\vDeleteReply\x12\x1e.discussion.DeleteReplyRequest\x1a\x1f.discussion.DeleteReplyResponseB1Z/course-platform/internal/shared/pb/discussionpbb\x06proto3"
+
+var (
+	file_protos_discussion_proto_rawDescOnce sync.Once
+	file_protos_discussion_proto_rawDescData []byte
+)
+
+func file_protos_discussion_proto_rawDescGZIP() []byte {
+	file_protos_discussion_proto_rawDescOnce.Do(func() {
+		file_protos_discussion_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_protos_discussion_proto_rawDesc), len(file_protos_discussion_proto_rawDesc)))
+	})
+	return file_protos_discussion_proto_rawDescData
+}
+
+var file_protos_discussion_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_protos_discussion_proto_goTypes = []any{
+	(*CreateThreadRequest)(nil),    // 0: discussion.CreateThreadRequest
+	(*CreateThreadResponse)(nil),   // 1: discussion.CreateThreadResponse
+	(*ListThreadsRequest)(nil),     // 2: discussion.ListThreadsRequest
+	(*ListThreadsResponse)(nil),    // 3: discussion.ListThreadsResponse
+	(*GetThreadRequest)(nil),       // 4: discussion.GetThreadRequest
+	(*GetThreadResponse)(nil),      // 5: discussion.GetThreadResponse
+	(*CreateReplyRequest)(nil),     // 6: discussion.CreateReplyRequest
+	(*CreateReplyResponse)(nil),    // 7: discussion.CreateReplyResponse
+	(*VoteRequest)(nil),            // 8: discussion.VoteRequest
+	(*VoteResponse)(nil),           // 9: discussion.VoteResponse
+	(*ModerateThreadRequest)(nil),  // 10: discussion.ModerateThreadRequest
+	(*ModerateThreadResponse)(nil), // 11: discussion.ModerateThreadResponse
+	(*MarkAnswerRequest)(nil),      // 12: discussion.MarkAnswerRequest
+	(*MarkAnswerResponse)(nil),     // 13: discussion.MarkAnswerResponse
+	(*DeleteThreadRequest)(nil),    // 14: discussion.DeleteThreadRequest
+	(*DeleteThreadResponse)(nil),   // 15: discussion.DeleteThreadResponse
+	(*DeleteReplyRequest)(nil),     // 16: discussion.DeleteReplyRequest
+	(*DeleteReplyResponse)(nil),    // 17: discussion.DeleteReplyResponse
+	(*Thread)(nil),                 // 18: discussion.Thread
+	(*Reply)(nil),                  // 19: discussion.Reply
+}
+var file_protos_discussion_proto_depIdxs = []int32{
+	18, // 0: discussion.CreateThreadResponse.thread:type_name -> discussion.Thread
+	18, // 1: discussion.ListThreadsResponse.threads:type_name -> discussion.Thread
+	18, // 2: discussion.GetThreadResponse.thread:type_name -> discussion.Thread
+	19, // 3: discussion.GetThreadResponse.replies:type_name -> discussion.Reply
+	19, // 4: discussion.CreateReplyResponse.reply:type_name -> discussion.Reply
+	18, // 5: discussion.ModerateThreadResponse.thread:type_name -> discussion.Thread
+	18, // 6: discussion.MarkAnswerResponse.thread:type_name -> discussion.Thread
+	0,  // 7: discussion.DiscussionService.CreateThread:input_type -> discussion.CreateThreadRequest
+	2,  // 8: discussion.DiscussionService.ListThreads:input_type -> discussion.ListThreadsRequest
+	4,  // 9: discussion.DiscussionService.GetThread:input_type -> discussion.GetThreadRequest
+	6,  // 10: discussion.DiscussionService.CreateReply:input_type -> discussion.CreateReplyRequest
+	8,  // 11: discussion.DiscussionService.Vote:input_type -> discussion.VoteRequest
+	10, // 12: discussion.DiscussionService.ModerateThread:input_type -> discussion.ModerateThreadRequest
+	12, // 13: discussion.DiscussionService.MarkAnswer:input_type -> discussion.MarkAnswerRequest
+	14, // 14: discussion.DiscussionService.DeleteThread:input_type -> discussion.DeleteThreadRequest
+	16, // 15: discussion.DiscussionService.DeleteReply:input_type -> discussion.DeleteReplyRequest
+	1,  // 16: discussion.DiscussionService.CreateThread:output_type -> discussion.CreateThreadResponse
+	3,  // 17: discussion.DiscussionService.ListThreads:output_type -> discussion.ListThreadsResponse
+	5,  // 18: discussion.DiscussionService.GetThread:output_type -> discussion.GetThreadResponse
+	7,  // 19: discussion.DiscussionService.CreateReply:output_type -> discussion.CreateReplyResponse
+	9,  // 20: discussion.DiscussionService.Vote:output_type -> discussion.VoteResponse
+	11, // 21: discussion.DiscussionService.ModerateThread:output_type -> discussion.ModerateThreadResponse
+	13, // 22: discussion.DiscussionService.MarkAnswer:output_type -> discussion.MarkAnswerResponse
+	15, // 23: discussion.DiscussionService.DeleteThread:output_type -> discussion.DeleteThreadResponse
+	17, // 24: discussion.DiscussionService.DeleteReply:output_type -> discussion.DeleteReplyResponse
+	16, // [16:25] is the sub-list for method output_type
+	7,  // [7:16] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_protos_discussion_proto_init() }
+func file_protos_discussion_proto_init() {
+	if File_protos_discussion_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_protos_discussion_proto_rawDesc), len(file_protos_discussion_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   20,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_protos_discussion_proto_goTypes,
+		DependencyIndexes: file_protos_discussion_proto_depIdxs,
+		MessageInfos:      file_protos_discussion_proto_msgTypes,
+	}.Build()
+	File_protos_discussion_proto = out.File
+	file_protos_discussion_proto_goTypes = nil
+	file_protos_discussion_proto_depIdxs = nil
+}
@@ -0,0 +1,447 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.31.1
+// source: protos/discussion.proto
+
+package discussionpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	DiscussionService_CreateThread_FullMethodName   = "/discussion.DiscussionService/CreateThread"
+	DiscussionService_ListThreads_FullMethodName    = "/discussion.DiscussionService/ListThreads"
+	DiscussionService_GetThread_FullMethodName      = "/discussion.DiscussionService/GetThread"
+	DiscussionService_CreateReply_FullMethodName    = "/discussion.DiscussionService/CreateReply"
+	DiscussionService_Vote_FullMethodName           = "/discussion.DiscussionService/Vote"
+	DiscussionService_ModerateThread_FullMethodName = "/discussion.DiscussionService/ModerateThread"
+	DiscussionService_MarkAnswer_FullMethodName     = "/discussion.DiscussionService/MarkAnswer"
+	DiscussionService_DeleteThread_FullMethodName   = "/discussion.DiscussionService/DeleteThread"
+	DiscussionService_DeleteReply_FullMethodName    = "/discussion.DiscussionService/DeleteReply"
+)
+
+// DiscussionServiceClient is the client API for DiscussionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 课程讨论区服务定义
+type DiscussionServiceClient interface {
+	// 发布讨论帖（需报名课程）
+	CreateThread(ctx context.Context, in *CreateThreadRequest, opts ...grpc.CallOption) (*CreateThreadResponse, error)
+	// 分页获取课程讨论帖
+	ListThreads(ctx context.Context, in *ListThreadsRequest, opts ...grpc.CallOption) (*ListThreadsResponse, error)
+	// 获取讨论帖及分页的回复
+	GetThread(ctx context.Context, in *GetThreadRequest, opts ...grpc.CallOption) (*GetThreadResponse, error)
+	// 回复讨论帖或其中的回复
+	CreateReply(ctx context.Context, in *CreateReplyRequest, opts ...grpc.CallOption) (*CreateReplyResponse, error)
+	// 点赞或取消点赞
+	Vote(ctx context.Context, in *VoteRequest, opts ...grpc.CallOption) (*VoteResponse, error)
+	// 置顶、锁定讨论帖（讲师和管理员）
+	ModerateThread(ctx context.Context, in *ModerateThreadRequest, opts ...grpc.CallOption) (*ModerateThreadResponse, error)
+	// 标记最佳回答（讲师和管理员）
+	MarkAnswer(ctx context.Context, in *MarkAnswerRequest, opts ...grpc.CallOption) (*MarkAnswerResponse, error)
+	// 删除讨论帖（作者、讲师和管理员）
+	DeleteThread(ctx context.Context, in *DeleteThreadRequest, opts ...grpc.CallOption) (*DeleteThreadResponse, error)
+	// 删除回复（作者、讲师和管理员）
+	DeleteReply(ctx context.Context, in *DeleteReplyRequest, opts ...grpc.CallOption) (*DeleteReplyResponse, error)
+}
+
+type discussionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDiscussionServiceClient(cc grpc.ClientConnInterface) DiscussionServiceClient {
+	return &discussionServiceClient{cc}
+}
+
+func (c *discussionServiceClient) CreateThread(ctx context.Context, in *CreateThreadRequest, opts ...grpc.CallOption) (*CreateThreadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateThreadResponse)
+	err := c.cc.Invoke(ctx, DiscussionService_CreateThread_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discussionServiceClient) ListThreads(ctx context.Context, in *ListThreadsRequest, opts ...grpc.CallOption) (*ListThreadsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListThreadsResponse)
+	err := c.cc.Invoke(ctx, DiscussionService_ListThreads_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discussionServiceClient) GetThread(ctx context.Context, in *GetThreadRequest, opts ...grpc.CallOption) (*GetThreadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetThreadResponse)
+	err := c.cc.Invoke(ctx, DiscussionService_GetThread_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discussionServiceClient) CreateReply(ctx context.Context, in *CreateReplyRequest, opts ...grpc.CallOption) (*CreateReplyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateReplyResponse)
+	err := c.cc.Invoke(ctx, DiscussionService_CreateReply_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discussionServiceClient) Vote(ctx context.Context, in *VoteRequest, opts ...grpc.CallOption) (*VoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VoteResponse)
+	err := c.cc.Invoke(ctx, DiscussionService_Vote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discussionServiceClient) ModerateThread(ctx context.Context, in *ModerateThreadRequest, opts ...grpc.CallOption) (*ModerateThreadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ModerateThreadResponse)
+	err := c.cc.Invoke(ctx, DiscussionService_ModerateThread_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discussionServiceClient) MarkAnswer(ctx context.Context, in *MarkAnswerRequest, opts ...grpc.CallOption) (*MarkAnswerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkAnswerResponse)
+	err := c.cc.Invoke(ctx, DiscussionService_MarkAnswer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discussionServiceClient) DeleteThread(ctx context.Context, in *DeleteThreadRequest, opts ...grpc.CallOption) (*DeleteThreadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteThreadResponse)
+	err := c.cc.Invoke(ctx, DiscussionService_DeleteThread_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discussionServiceClient) DeleteReply(ctx context.Context, in *DeleteReplyRequest, opts ...grpc.CallOption) (*DeleteReplyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteReplyResponse)
+	err := c.cc.Invoke(ctx, DiscussionService_DeleteReply_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DiscussionServiceServer is the server API for DiscussionService service.
+// All implementations must embed UnimplementedDiscussionServiceServer
+// for forward compatibility.
+//
+// 课程讨论区服务定义
+type DiscussionServiceServer interface {
+	// 发布讨论帖（需报名课程）
+	CreateThread(context.Context, *CreateThreadRequest) (*CreateThreadResponse, error)
+	// 分页获取课程讨论帖
+	ListThreads(context.Context, *ListThreadsRequest) (*ListThreadsResponse, error)
+	// 获取讨论帖及分页的回复
+	GetThread(context.Context, *GetThreadRequest) (*GetThreadResponse, error)
+	// 回复讨论帖或其中的回复
+	CreateReply(context.Context, *CreateReplyRequest) (*CreateReplyResponse, error)
+	// 点赞或取消点赞
+	Vote(context.Context, *VoteRequest) (*VoteResponse, error)
+	// 置顶、锁定讨论帖（讲师和管理员）
+	ModerateThread(context.Context, *ModerateThreadRequest) (*ModerateThreadResponse, error)
+	// 标记最佳回答（讲师和管理员）
+	MarkAnswer(context.Context, *MarkAnswerRequest) (*MarkAnswerResponse, error)
+	// 删除讨论帖（作者、讲师和管理员）
+	DeleteThread(context.Context, *DeleteThreadRequest) (*DeleteThreadResponse, error)
+	// 删除回复（作者、讲师和管理员）
+	DeleteReply(context.Context, *DeleteReplyRequest) (*DeleteReplyResponse, error)
+	mustEmbedUnimplementedDiscussionServiceServer()
+}
+
+// UnimplementedDiscussionServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDiscussionServiceServer struct{}
+
+func (UnimplementedDiscussionServiceServer) CreateThread(context.Context, *CreateThreadRequest) (*CreateThreadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateThread not implemented")
+}
+func (UnimplementedDiscussionServiceServer) ListThreads(context.Context, *ListThreadsRequest) (*ListThreadsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListThreads not implemented")
+}
+func (UnimplementedDiscussionServiceServer) GetThread(context.Context, *GetThreadRequest) (*GetThreadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetThread not implemented")
+}
+func (UnimplementedDiscussionServiceServer) CreateReply(context.Context, *CreateReplyRequest) (*CreateReplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReply not implemented")
+}
+func (UnimplementedDiscussionServiceServer) Vote(context.Context, *VoteRequest) (*VoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Vote not implemented")
+}
+func (UnimplementedDiscussionServiceServer) ModerateThread(context.Context, *ModerateThreadRequest) (*ModerateThreadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModerateThread not implemented")
+}
+func (UnimplementedDiscussionServiceServer) MarkAnswer(context.Context, *MarkAnswerRequest) (*MarkAnswerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkAnswer not implemented")
+}
+func (UnimplementedDiscussionServiceServer) DeleteThread(context.Context, *DeleteThreadRequest) (*DeleteThreadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteThread not implemented")
+}
+func (UnimplementedDiscussionServiceServer) DeleteReply(context.Context, *DeleteReplyRequest) (*DeleteReplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteReply not implemented")
+}
+func (UnimplementedDiscussionServiceServer) mustEmbedUnimplementedDiscussionServiceServer() {}
+func (UnimplementedDiscussionServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeDiscussionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DiscussionServiceServer will
+// result in compilation errors.
+type UnsafeDiscussionServiceServer interface {
+	mustEmbedUnimplementedDiscussionServiceServer()
+}
+
+func RegisterDiscussionServiceServer(s grpc.ServiceRegistrar, srv DiscussionServiceServer) {
+	// If the following call pancis, it indicates UnimplementedDiscussionServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DiscussionService_ServiceDesc, srv)
+}
+
+func _DiscussionService_CreateThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateThreadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscussionServiceServer).CreateThread(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiscussionService_CreateThread_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscussionServiceServer).CreateThread(ctx, req.(*CreateThreadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscussionService_ListThreads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListThreadsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscussionServiceServer).ListThreads(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiscussionService_ListThreads_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscussionServiceServer).ListThreads(ctx, req.(*ListThreadsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscussionService_GetThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetThreadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscussionServiceServer).GetThread(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiscussionService_GetThread_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscussionServiceServer).GetThread(ctx, req.(*GetThreadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscussionService_CreateReply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscussionServiceServer).CreateReply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiscussionService_CreateReply_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscussionServiceServer).CreateReply(ctx, req.(*CreateReplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscussionService_Vote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscussionServiceServer).Vote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiscussionService_Vote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscussionServiceServer).Vote(ctx, req.(*VoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscussionService_ModerateThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateThreadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscussionServiceServer).ModerateThread(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiscussionService_ModerateThread_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscussionServiceServer).ModerateThread(ctx, req.(*ModerateThreadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscussionService_MarkAnswer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkAnswerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscussionServiceServer).MarkAnswer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiscussionService_MarkAnswer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscussionServiceServer).MarkAnswer(ctx, req.(*MarkAnswerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscussionService_DeleteThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteThreadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscussionServiceServer).DeleteThread(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiscussionService_DeleteThread_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscussionServiceServer).DeleteThread(ctx, req.(*DeleteThreadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscussionService_DeleteReply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscussionServiceServer).DeleteReply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiscussionService_DeleteReply_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscussionServiceServer).DeleteReply(ctx, req.(*DeleteReplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DiscussionService_ServiceDesc is the grpc.ServiceDesc for DiscussionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DiscussionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "discussion.DiscussionService",
+	HandlerType: (*DiscussionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateThread",
+			Handler:    _DiscussionService_CreateThread_Handler,
+		},
+		{
+			MethodName: "ListThreads",
+			Handler:    _DiscussionService_ListThreads_Handler,
+		},
+		{
+			MethodName: "GetThread",
+			Handler:    _DiscussionService_GetThread_Handler,
+		},
+		{
+			MethodName: "CreateReply",
+			Handler:    _DiscussionService_CreateReply_Handler,
+		},
+		{
+			MethodName: "Vote",
+			Handler:    _DiscussionService_Vote_Handler,
+		},
+		{
+			MethodName: "ModerateThread",
+			Handler:    _DiscussionService_ModerateThread_Handler,
+		},
+		{
+			MethodName: "MarkAnswer",
+			Handler:    _DiscussionService_MarkAnswer_Handler,
+		},
+		{
+			MethodName: "DeleteThread",
+			Handler:    _DiscussionService_DeleteThread_Handler,
+		},
+		{
+			MethodName: "DeleteReply",
+			Handler:    _DiscussionService_DeleteReply_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "protos/discussion.proto",
+}
@@ -0,0 +1,449 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: protos/email.proto
+
+package emailpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 获取邮件订阅状态请求消息
+type GetEmailPreferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEmailPreferencesRequest) Reset() {
+	*x = GetEmailPreferencesRequest{}
+	mi := &file_protos_email_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEmailPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEmailPreferencesRequest) ProtoMessage() {}
+
+func (x *GetEmailPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_email_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEmailPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*GetEmailPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_protos_email_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetEmailPreferencesRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// 更新邮件订阅状态请求消息
+type UpdateEmailPreferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Preferences   []*EmailPreference     `protobuf:"bytes,2,rep,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEmailPreferencesRequest) Reset() {
+	*x = UpdateEmailPreferencesRequest{}
+	mi := &file_protos_email_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEmailPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEmailPreferencesRequest) ProtoMessage() {}
+
+func (x *UpdateEmailPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_email_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEmailPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateEmailPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_protos_email_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UpdateEmailPreferencesRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateEmailPreferencesRequest) GetPreferences() []*EmailPreference {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+// 邮件订阅状态响应消息
+type EmailPreferencesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Preferences   []*EmailPreference     `protobuf:"bytes,3,rep,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmailPreferencesResponse) Reset() {
+	*x = EmailPreferencesResponse{}
+	mi := &file_protos_email_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmailPreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmailPreferencesResponse) ProtoMessage() {}
+
+func (x *EmailPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_email_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmailPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*EmailPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_protos_email_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EmailPreferencesResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *EmailPreferencesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *EmailPreferencesResponse) GetPreferences() []*EmailPreference {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+// 退订请求消息
+type UnsubscribeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnsubscribeRequest) Reset() {
+	*x = UnsubscribeRequest{}
+	mi := &file_protos_email_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubscribeRequest) ProtoMessage() {}
+
+func (x *UnsubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_email_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubscribeRequest.ProtoReflect.Descriptor instead.
+func (*UnsubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_protos_email_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UnsubscribeRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// 退订响应消息
+type UnsubscribeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	CategoryLabel string                 `protobuf:"bytes,3,opt,name=category_label,json=categoryLabel,proto3" json:"category_label,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnsubscribeResponse) Reset() {
+	*x = UnsubscribeResponse{}
+	mi := &file_protos_email_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsubscribeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubscribeResponse) ProtoMessage() {}
+
+func (x *UnsubscribeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_email_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubscribeResponse.ProtoReflect.Descriptor instead.
+func (*UnsubscribeResponse) Descriptor() ([]byte, []int) {
+	return file_protos_email_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UnsubscribeResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *UnsubscribeResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *UnsubscribeResponse) GetCategoryLabel() string {
+	if x != nil {
+		return x.CategoryLabel
+	}
+	return ""
+}
+
+// 邮件订阅状态模型，required 的类别不可退订
+type EmailPreference struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Required      bool                   `protobuf:"varint,3,opt,name=required,proto3" json:"required,omitempty"`
+	Subscribed    bool                   `protobuf:"varint,4,opt,name=subscribed,proto3" json:"subscribed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmailPreference) Reset() {
+	*x = EmailPreference{}
+	mi := &file_protos_email_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmailPreference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmailPreference) ProtoMessage() {}
+
+func (x *EmailPreference) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_email_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmailPreference.ProtoReflect.Descriptor instead.
+func (*EmailPreference) Descriptor() ([]byte, []int) {
+	return file_protos_email_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *EmailPreference) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *EmailPreference) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *EmailPreference) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *EmailPreference) GetSubscribed() bool {
+	if x != nil {
+		return x.Subscribed
+	}
+	return false
+}
+
+var File_protos_email_proto protoreflect.FileDescriptor
+
+const file_protos_email_proto_rawDesc = "" +
+	"\n" +
+	"\x12protos/email.proto\x12\x05email\"5\n" +
+	"\x1aGetEmailPreferencesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\"r\n" +
+	"\x1dUpdateEmailPreferencesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x128\n" +
+	"\vpreferences\x18\x02 \x03(\v2\x16.email.EmailPreferenceR\vpreferences\"\x82\x01\n" +
+	"\x18EmailPreferencesResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x128\n" +
+	"\vpreferences\x18\x03 \x03(\v2\x16.email.EmailPreferenceR\vpreferences\"*\n" +
+	"\x12UnsubscribeRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"j\n" +
+	"\x13UnsubscribeResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12%\n" +
+	"\x0ecategory_label\x18\x03 \x01(\tR\rcategoryLabel\"\x7f\n" +
+	"\x0fEmailPreference\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12\x1a\n" +
+	"\brequired\x18\x03 \x01(\bR\brequired\x12\x1e\n" +
+	"\n" +
+	"subscribed\x18\x04 \x01(\bR\n" +
+	"subscribed2\x90\x02\n" +
+	"\fEmailService\x12Y\n" +
+	"\x13GetEmailPreferences\x12!.email.GetEmailPreferencesRequest\x1a\x1f.email.EmailPreferencesResponse\x12_\n" +
+	"\x16UpdateEmailPreferences\x12$.email.UpdateEmailPreferencesRequest\x1a\x1f.email.EmailPreferencesResponse\x12D\n" +
+	"\vUnsubscribe\x12\x19.email.UnsubscribeRequest\x1a\x1a.email.UnsubscribeResponseB,Z*course-platform/internal/shared/pb/emailpbb\x06proto3"
+
+var (
+	file_protos_email_proto_rawDescOnce sync.Once
+	file_protos_email_proto_rawDescData []byte
+)
+
+func file_protos_email_proto_rawDescGZIP() []byte {
+	file_protos_email_proto_rawDescOnce.Do(func() {
+		file_protos_email_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_protos_email_proto_rawDesc), len(file_protos_email_proto_rawDesc)))
+	})
+	return file_protos_email_proto_rawDescData
+}
+
+var file_protos_email_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_protos_email_proto_goTypes = []any{
+	(*GetEmailPreferencesRequest)(nil),    // 0: email.GetEmailPreferencesRequest
+	(*UpdateEmailPreferencesRequest)(nil), // 1: email.UpdateEmailPreferencesRequest
+	(*EmailPreferencesResponse)(nil),      // 2: email.EmailPreferencesResponse
+	(*UnsubscribeRequest)(nil),            // 3: email.UnsubscribeRequest
+	(*UnsubscribeResponse)(nil),           // 4: email.UnsubscribeResponse
+	(*EmailPreference)(nil),               // 5: email.EmailPreference
+}
+var file_protos_email_proto_depIdxs = []int32{
+	5, // 0: email.UpdateEmailPreferencesRequest.preferences:type_name -> email.EmailPreference
+	5, // 1: email.EmailPreferencesResponse.preferences:type_name -> email.EmailPreference
+	0, // 2: email.EmailService.GetEmailPreferences:input_type -> email.GetEmailPreferencesRequest
+	1, // 3: email.EmailService.UpdateEmailPreferences:input_type -> email.UpdateEmailPreferencesRequest
+	3, // 4: email.EmailService.Unsubscribe:input_type -> email.UnsubscribeRequest
+	2, // 5: email.EmailService.GetEmailPreferences:output_type -> email.EmailPreferencesResponse
+	2, // 6: email.EmailService.UpdateEmailPreferences:output_type -> email.EmailPreferencesResponse
+	4, // 7: email.EmailService.Unsubscribe:output_type -> email.UnsubscribeResponse
+	5, // [5:8] is the sub-list for method output_type
+	2, // [2:5] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_protos_email_proto_init() }
+func file_protos_email_proto_init() {
+	if File_protos_email_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_protos_email_proto_rawDesc), len(file_protos_email_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_protos_email_proto_goTypes,
+		DependencyIndexes: file_protos_email_proto_depIdxs,
+		MessageInfos:      file_protos_email_proto_msgTypes,
+	}.Build()
+	File_protos_email_proto = out.File
+	file_protos_email_proto_goTypes = nil
+	file_protos_email_proto_depIdxs = nil
+}
@@ -0,0 +1,600 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: protos/ledger.proto
+
+package ledgerpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 获取讲师统计请求消息，months 为0时默认最近12个月
+type GetCreatorStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InstructorId  uint32                 `protobuf:"varint,1,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"`
+	Months        int32                  `protobuf:"varint,2,opt,name=months,proto3" json:"months,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCreatorStatsRequest) Reset() {
+	*x = GetCreatorStatsRequest{}
+	mi := &file_protos_ledger_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCreatorStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCreatorStatsRequest) ProtoMessage() {}
+
+func (x *GetCreatorStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_ledger_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCreatorStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetCreatorStatsRequest) Descriptor() ([]byte, []int) {
+	return file_protos_ledger_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetCreatorStatsRequest) GetInstructorId() uint32 {
+	if x != nil {
+		return x.InstructorId
+	}
+	return 0
+}
+
+func (x *GetCreatorStatsRequest) GetMonths() int32 {
+	if x != nil {
+		return x.Months
+	}
+	return 0
+}
+
+// 获取讲师统计响应消息
+type GetCreatorStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Stats         *CreatorStats          `protobuf:"bytes,3,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCreatorStatsResponse) Reset() {
+	*x = GetCreatorStatsResponse{}
+	mi := &file_protos_ledger_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCreatorStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCreatorStatsResponse) ProtoMessage() {}
+
+func (x *GetCreatorStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_ledger_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCreatorStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetCreatorStatsResponse) Descriptor() ([]byte, []int) {
+	return file_protos_ledger_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetCreatorStatsResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetCreatorStatsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetCreatorStatsResponse) GetStats() *CreatorStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+// 导出收入明细请求消息，月份格式为 YYYY-MM，留空表示不限制
+type ExportCreatorLedgerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InstructorId  uint32                 `protobuf:"varint,1,opt,name=instructor_id,json=instructorId,proto3" json:"instructor_id,omitempty"`
+	FromMonth     string                 `protobuf:"bytes,2,opt,name=from_month,json=fromMonth,proto3" json:"from_month,omitempty"`
+	ToMonth       string                 `protobuf:"bytes,3,opt,name=to_month,json=toMonth,proto3" json:"to_month,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportCreatorLedgerRequest) Reset() {
+	*x = ExportCreatorLedgerRequest{}
+	mi := &file_protos_ledger_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportCreatorLedgerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportCreatorLedgerRequest) ProtoMessage() {}
+
+func (x *ExportCreatorLedgerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_ledger_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportCreatorLedgerRequest.ProtoReflect.Descriptor instead.
+func (*ExportCreatorLedgerRequest) Descriptor() ([]byte, []int) {
+	return file_protos_ledger_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ExportCreatorLedgerRequest) GetInstructorId() uint32 {
+	if x != nil {
+		return x.InstructorId
+	}
+	return 0
+}
+
+func (x *ExportCreatorLedgerRequest) GetFromMonth() string {
+	if x != nil {
+		return x.FromMonth
+	}
+	return ""
+}
+
+func (x *ExportCreatorLedgerRequest) GetToMonth() string {
+	if x != nil {
+		return x.ToMonth
+	}
+	return ""
+}
+
+// 导出收入明细响应消息
+type ExportCreatorLedgerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Content       []byte                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"` // CSV内容
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportCreatorLedgerResponse) Reset() {
+	*x = ExportCreatorLedgerResponse{}
+	mi := &file_protos_ledger_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportCreatorLedgerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportCreatorLedgerResponse) ProtoMessage() {}
+
+func (x *ExportCreatorLedgerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_ledger_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportCreatorLedgerResponse.ProtoReflect.Descriptor instead.
+func (*ExportCreatorLedgerResponse) Descriptor() ([]byte, []int) {
+	return file_protos_ledger_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ExportCreatorLedgerResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ExportCreatorLedgerResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ExportCreatorLedgerResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+// 讲师经营统计，金额单位为分
+type CreatorStats struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	TotalCourses         int32                  `protobuf:"varint,1,opt,name=total_courses,json=totalCourses,proto3" json:"total_courses,omitempty"`
+	ActiveCourses        int32                  `protobuf:"varint,2,opt,name=active_courses,json=activeCourses,proto3" json:"active_courses,omitempty"`
+	DraftCourses         int32                  `protobuf:"varint,3,opt,name=draft_courses,json=draftCourses,proto3" json:"draft_courses,omitempty"`
+	TotalStudents        int64                  `protobuf:"varint,4,opt,name=total_students,json=totalStudents,proto3" json:"total_students,omitempty"`
+	ThisMonthStudents    int64                  `protobuf:"varint,5,opt,name=this_month_students,json=thisMonthStudents,proto3" json:"this_month_students,omitempty"`
+	GrossRevenue         int64                  `protobuf:"varint,6,opt,name=gross_revenue,json=grossRevenue,proto3" json:"gross_revenue,omitempty"`                // 学员累计实付
+	PlatformFee          int64                  `protobuf:"varint,7,opt,name=platform_fee,json=platformFee,proto3" json:"platform_fee,omitempty"`                   // 平台累计分成
+	RefundAmount         int64                  `protobuf:"varint,8,opt,name=refund_amount,json=refundAmount,proto3" json:"refund_amount,omitempty"`                // 累计退款
+	TotalRevenue         int64                  `protobuf:"varint,9,opt,name=total_revenue,json=totalRevenue,proto3" json:"total_revenue,omitempty"`                // 讲师累计实得收入
+	ThisMonthRevenue     int64                  `protobuf:"varint,10,opt,name=this_month_revenue,json=thisMonthRevenue,proto3" json:"this_month_revenue,omitempty"` // 讲师本月实得收入
+	PlatformSharePercent int32                  `protobuf:"varint,11,opt,name=platform_share_percent,json=platformSharePercent,proto3" json:"platform_share_percent,omitempty"`
+	Currency             string                 `protobuf:"bytes,12,opt,name=currency,proto3" json:"currency,omitempty"`
+	Monthly              []*MonthlyRevenue      `protobuf:"bytes,13,rep,name=monthly,proto3" json:"monthly,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *CreatorStats) Reset() {
+	*x = CreatorStats{}
+	mi := &file_protos_ledger_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatorStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatorStats) ProtoMessage() {}
+
+func (x *CreatorStats) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_ledger_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatorStats.ProtoReflect.Descriptor instead.
+func (*CreatorStats) Descriptor() ([]byte, []int) {
+	return file_protos_ledger_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreatorStats) GetTotalCourses() int32 {
+	if x != nil {
+		return x.TotalCourses
+	}
+	return 0
+}
+
+func (x *CreatorStats) GetActiveCourses() int32 {
+	if x != nil {
+		return x.ActiveCourses
+	}
+	return 0
+}
+
+func (x *CreatorStats) GetDraftCourses() int32 {
+	if x != nil {
+		return x.DraftCourses
+	}
+	return 0
+}
+
+func (x *CreatorStats) GetTotalStudents() int64 {
+	if x != nil {
+		return x.TotalStudents
+	}
+	return 0
+}
+
+func (x *CreatorStats) GetThisMonthStudents() int64 {
+	if x != nil {
+		return x.ThisMonthStudents
+	}
+	return 0
+}
+
+func (x *CreatorStats) GetGrossRevenue() int64 {
+	if x != nil {
+		return x.GrossRevenue
+	}
+	return 0
+}
+
+func (x *CreatorStats) GetPlatformFee() int64 {
+	if x != nil {
+		return x.PlatformFee
+	}
+	return 0
+}
+
+func (x *CreatorStats) GetRefundAmount() int64 {
+	if x != nil {
+		return x.RefundAmount
+	}
+	return 0
+}
+
+func (x *CreatorStats) GetTotalRevenue() int64 {
+	if x != nil {
+		return x.TotalRevenue
+	}
+	return 0
+}
+
+func (x *CreatorStats) GetThisMonthRevenue() int64 {
+	if x != nil {
+		return x.ThisMonthRevenue
+	}
+	return 0
+}
+
+func (x *CreatorStats) GetPlatformSharePercent() int32 {
+	if x != nil {
+		return x.PlatformSharePercent
+	}
+	return 0
+}
+
+func (x *CreatorStats) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CreatorStats) GetMonthly() []*MonthlyRevenue {
+	if x != nil {
+		return x.Monthly
+	}
+	return nil
+}
+
+// 讲师月度收入
+type MonthlyRevenue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Month         string                 `protobuf:"bytes,1,opt,name=month,proto3" json:"month,omitempty"` // YYYY-MM
+	GrossAmount   int64                  `protobuf:"varint,2,opt,name=gross_amount,json=grossAmount,proto3" json:"gross_amount,omitempty"`
+	PlatformFee   int64                  `protobuf:"varint,3,opt,name=platform_fee,json=platformFee,proto3" json:"platform_fee,omitempty"`
+	RefundAmount  int64                  `protobuf:"varint,4,opt,name=refund_amount,json=refundAmount,proto3" json:"refund_amount,omitempty"`
+	NetAmount     int64                  `protobuf:"varint,5,opt,name=net_amount,json=netAmount,proto3" json:"net_amount,omitempty"`
+	Sales         int32                  `protobuf:"varint,6,opt,name=sales,proto3" json:"sales,omitempty"`
+	Refunds       int32                  `protobuf:"varint,7,opt,name=refunds,proto3" json:"refunds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MonthlyRevenue) Reset() {
+	*x = MonthlyRevenue{}
+	mi := &file_protos_ledger_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MonthlyRevenue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonthlyRevenue) ProtoMessage() {}
+
+func (x *MonthlyRevenue) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_ledger_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonthlyRevenue.ProtoReflect.Descriptor instead.
+func (*MonthlyRevenue) Descriptor() ([]byte, []int) {
+	return file_protos_ledger_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MonthlyRevenue) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+func (x *MonthlyRevenue) GetGrossAmount() int64 {
+	if x != nil {
+		return x.GrossAmount
+	}
+	return 0
+}
+
+func (x *MonthlyRevenue) GetPlatformFee() int64 {
+	if x != nil {
+		return x.PlatformFee
+	}
+	return 0
+}
+
+func (x *MonthlyRevenue) GetRefundAmount() int64 {
+	if x != nil {
+		return x.RefundAmount
+	}
+	return 0
+}
+
+func (x *MonthlyRevenue) GetNetAmount() int64 {
+	if x != nil {
+		return x.NetAmount
+	}
+	return 0
+}
+
+func (x *MonthlyRevenue) GetSales() int32 {
+	if x != nil {
+		return x.Sales
+	}
+	return 0
+}
+
+func (x *MonthlyRevenue) GetRefunds() int32 {
+	if x != nil {
+		return x.Refunds
+	}
+	return 0
+}
+
+var File_protos_ledger_proto protoreflect.FileDescriptor
+
+const file_protos_ledger_proto_rawDesc = "" +
+	"\n" +
+	"\x13protos/ledger.proto\x12\x06ledger\"U\n" +
+	"\x16GetCreatorStatsRequest\x12#\n" +
+	"\rinstructor_id\x18\x01 \x01(\rR\finstructorId\x12\x16\n" +
+	"\x06months\x18\x02 \x01(\x05R\x06months\"s\n" +
+	"\x17GetCreatorStatsResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12*\n" +
+	"\x05stats\x18\x03 \x01(\v2\x14.ledger.CreatorStatsR\x05stats\"{\n" +
+	"\x1aExportCreatorLedgerRequest\x12#\n" +
+	"\rinstructor_id\x18\x01 \x01(\rR\finstructorId\x12\x1d\n" +
+	"\n" +
+	"from_month\x18\x02 \x01(\tR\tfromMonth\x12\x19\n" +
+	"\bto_month\x18\x03 \x01(\tR\atoMonth\"e\n" +
+	"\x1bExportCreatorLedgerResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\fR\acontent\"\x9a\x04\n" +
+	"\fCreatorStats\x12#\n" +
+	"\rtotal_courses\x18\x01 \x01(\x05R\ftotalCourses\x12%\n" +
+	"\x0eactive_courses\x18\x02 \x01(\x05R\ractiveCourses\x12#\n" +
+	"\rdraft_courses\x18\x03 \x01(\x05R\fdraftCourses\x12%\n" +
+	"\x0etotal_students\x18\x04 \x01(\x03R\rtotalStudents\x12.\n" +
+	"\x13this_month_students\x18\x05 \x01(\x03R\x11thisMonthStudents\x12#\n" +
+	"\rgross_revenue\x18\x06 \x01(\x03R\fgrossRevenue\x12!\n" +
+	"\fplatform_fee\x18\a \x01(\x03R\vplatformFee\x12#\n" +
+	"\rrefund_amount\x18\b \x01(\x03R\frefundAmount\x12#\n" +
+	"\rtotal_revenue\x18\t \x01(\x03R\ftotalRevenue\x12,\n" +
+	"\x12this_month_revenue\x18\n" +
+	" \x01(\x03R\x10thisMonthRevenue\x124\n" +
+	"\x16platform_share_percent\x18\v \x01(\x05R\x14platformSharePercent\x12\x1a\n" +
+	"\bcurrency\x18\f \x01(\tR\bcurrency\x120\n" +
+	"\amonthly\x18\r \x03(\v2\x16.ledger.MonthlyRevenueR\amonthly\"\xe0\x01\n" +
+	"\x0eMonthlyRevenue\x12\x14\n" +
+	"\x05month\x18\x01 \x01(\tR\x05month\x12!\n" +
+	"\fgross_amount\x18\x02 \x01(\x03R\vgrossAmount\x12!\n" +
+	"\fplatform_fee\x18\x03 \x01(\x03R\vplatformFee\x12#\n" +
+	"\rrefund_amount\x18\x04 \x01(\x03R\frefundAmount\x12\x1d\n" +
+	"\n" +
+	"net_amount\x18\x05 \x01(\x03R\tnetAmount\x12\x14\n" +
+	"\x05sales\x18\x06 \x01(\x05R\x05sales\x12\x18\n" +
+	"\arefunds\x18\a \x01(\x05R\arefunds2\xc3\x01\n" +
+	"\rLedgerService\x12R\n" +
+	"\x0fGetCreatorStats\x12\x1e.ledger.GetCreatorStatsRequest\x1a\x1f.ledger.GetCreatorStatsResponse\x12^\n" +
+	"\x13ExportCreatorLedger\x12\".ledger.ExportCreatorLedgerRequest\x1a#.ledger.ExportCreatorLedgerResponseB-Z+course-platform/internal/shared/pb/ledgerpbb\x06proto3"
+
+var (
+	file_protos_ledger_proto_rawDescOnce sync.Once
+	file_protos_ledger_proto_rawDescData []byte
+)
+
+func file_protos_ledger_proto_rawDescGZIP() []byte {
+	file_protos_ledger_proto_rawDescOnce.Do(func() {
+		file_protos_ledger_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_protos_ledger_proto_rawDesc), len(file_protos_ledger_proto_rawDesc)))
+	})
+	return file_protos_ledger_proto_rawDescData
+}
+
+var file_protos_ledger_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_protos_ledger_proto_goTypes = []any{
+	(*GetCreatorStatsRequest)(nil),      // 0: ledger.GetCreatorStatsRequest
+	(*GetCreatorStatsResponse)(nil),     // 1: ledger.GetCreatorStatsResponse
+	(*ExportCreatorLedgerRequest)(nil),  // 2: ledger.ExportCreatorLedgerRequest
+	(*ExportCreatorLedgerResponse)(nil), // 3: ledger.ExportCreatorLedgerResponse
+	(*CreatorStats)(nil),                // 4: ledger.CreatorStats
+	(*MonthlyRevenue)(nil),              // 5: ledger.MonthlyRevenue
+}
+var file_protos_ledger_proto_depIdxs = []int32{
+	4, // 0: ledger.GetCreatorStatsResponse.stats:type_name -> ledger.CreatorStats
+	5, // 1: ledger.CreatorStats.monthly:type_name -> ledger.MonthlyRevenue
+	0, // 2: ledger.LedgerService.GetCreatorStats:input_type -> ledger.GetCreatorStatsRequest
+	2, // 3: ledger.LedgerService.ExportCreatorLedger:input_type -> ledger.ExportCreatorLedgerRequest
+	1, // 4: ledger.LedgerService.GetCreatorStats:output_type -> ledger.GetCreatorStatsResponse
+	3, // 5: ledger.LedgerService.ExportCreatorLedger:output_type -> ledger.ExportCreatorLedgerResponse
+	4, // [4:6] is the sub-list for method output_type
+	2, // [2:4] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_protos_ledger_proto_init() }
+func file_protos_ledger_proto_init() {
+	if File_protos_ledger_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_protos_ledger_proto_rawDesc), len(file_protos_ledger_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_protos_ledger_proto_goTypes,
+		DependencyIndexes: file_protos_ledger_proto_depIdxs,
+		MessageInfos:      file_protos_ledger_proto_msgTypes,
+	}.Build()
+	File_protos_ledger_proto = out.File
+	file_protos_ledger_proto_goTypes = nil
+	file_protos_ledger_proto_depIdxs = nil
+}
@@ -0,0 +1,701 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: protos/privacy.proto
+
+package privacypb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 获取状态请求消息
+type GetPrivacyStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPrivacyStatusRequest) Reset() {
+	*x = GetPrivacyStatusRequest{}
+	mi := &file_protos_privacy_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPrivacyStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrivacyStatusRequest) ProtoMessage() {}
+
+func (x *GetPrivacyStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_privacy_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPrivacyStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetPrivacyStatusRequest) Descriptor() ([]byte, []int) {
+	return file_protos_privacy_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetPrivacyStatusRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// 申请数据导出请求消息
+type RequestDataExportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestDataExportRequest) Reset() {
+	*x = RequestDataExportRequest{}
+	mi := &file_protos_privacy_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestDataExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestDataExportRequest) ProtoMessage() {}
+
+func (x *RequestDataExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_privacy_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestDataExportRequest.ProtoReflect.Descriptor instead.
+func (*RequestDataExportRequest) Descriptor() ([]byte, []int) {
+	return file_protos_privacy_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RequestDataExportRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// 获取数据导出请求消息
+type GetDataExportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id            uint32                 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDataExportRequest) Reset() {
+	*x = GetDataExportRequest{}
+	mi := &file_protos_privacy_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDataExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataExportRequest) ProtoMessage() {}
+
+func (x *GetDataExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_privacy_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataExportRequest.ProtoReflect.Descriptor instead.
+func (*GetDataExportRequest) Descriptor() ([]byte, []int) {
+	return file_protos_privacy_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetDataExportRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetDataExportRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 获取数据导出响应消息
+type GetDataExportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	FileUrl       string                 `protobuf:"bytes,3,opt,name=file_url,json=fileUrl,proto3" json:"file_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDataExportResponse) Reset() {
+	*x = GetDataExportResponse{}
+	mi := &file_protos_privacy_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDataExportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataExportResponse) ProtoMessage() {}
+
+func (x *GetDataExportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_privacy_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataExportResponse.ProtoReflect.Descriptor instead.
+func (*GetDataExportResponse) Descriptor() ([]byte, []int) {
+	return file_protos_privacy_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetDataExportResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetDataExportResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetDataExportResponse) GetFileUrl() string {
+	if x != nil {
+		return x.FileUrl
+	}
+	return ""
+}
+
+// 申请注销请求消息，confirm_email 必须与账号邮箱一致
+type RequestAccountDeletionRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ConfirmEmail    string                 `protobuf:"bytes,2,opt,name=confirm_email,json=confirmEmail,proto3" json:"confirm_email,omitempty"`
+	TransferToEmail string                 `protobuf:"bytes,3,opt,name=transfer_to_email,json=transferToEmail,proto3" json:"transfer_to_email,omitempty"` // 课程接收人邮箱，留空时课程下架归档
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RequestAccountDeletionRequest) Reset() {
+	*x = RequestAccountDeletionRequest{}
+	mi := &file_protos_privacy_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestAccountDeletionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestAccountDeletionRequest) ProtoMessage() {}
+
+func (x *RequestAccountDeletionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_privacy_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestAccountDeletionRequest.ProtoReflect.Descriptor instead.
+func (*RequestAccountDeletionRequest) Descriptor() ([]byte, []int) {
+	return file_protos_privacy_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RequestAccountDeletionRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RequestAccountDeletionRequest) GetConfirmEmail() string {
+	if x != nil {
+		return x.ConfirmEmail
+	}
+	return ""
+}
+
+func (x *RequestAccountDeletionRequest) GetTransferToEmail() string {
+	if x != nil {
+		return x.TransferToEmail
+	}
+	return ""
+}
+
+// 撤销注销请求消息
+type CancelAccountDeletionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelAccountDeletionRequest) Reset() {
+	*x = CancelAccountDeletionRequest{}
+	mi := &file_protos_privacy_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelAccountDeletionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelAccountDeletionRequest) ProtoMessage() {}
+
+func (x *CancelAccountDeletionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_privacy_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelAccountDeletionRequest.ProtoReflect.Descriptor instead.
+func (*CancelAccountDeletionRequest) Descriptor() ([]byte, []int) {
+	return file_protos_privacy_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CancelAccountDeletionRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// 数据导出记录
+type DataExport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	FileSize      int64                  `protobuf:"varint,3,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CompletedAt   string                 `protobuf:"bytes,6,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DataExport) Reset() {
+	*x = DataExport{}
+	mi := &file_protos_privacy_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataExport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataExport) ProtoMessage() {}
+
+func (x *DataExport) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_privacy_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataExport.ProtoReflect.Descriptor instead.
+func (*DataExport) Descriptor() ([]byte, []int) {
+	return file_protos_privacy_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DataExport) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DataExport) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DataExport) GetFileSize() int64 {
+	if x != nil {
+		return x.FileSize
+	}
+	return 0
+}
+
+func (x *DataExport) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *DataExport) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *DataExport) GetCompletedAt() string {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return ""
+}
+
+func (x *DataExport) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+// 账号注销申请
+type AccountDeletion struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status          string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	ScheduledFor    string                 `protobuf:"bytes,3,opt,name=scheduled_for,json=scheduledFor,proto3" json:"scheduled_for,omitempty"`
+	TransferToEmail string                 `protobuf:"bytes,4,opt,name=transfer_to_email,json=transferToEmail,proto3" json:"transfer_to_email,omitempty"`
+	CreatedAt       string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AccountDeletion) Reset() {
+	*x = AccountDeletion{}
+	mi := &file_protos_privacy_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountDeletion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountDeletion) ProtoMessage() {}
+
+func (x *AccountDeletion) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_privacy_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountDeletion.ProtoReflect.Descriptor instead.
+func (*AccountDeletion) Descriptor() ([]byte, []int) {
+	return file_protos_privacy_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AccountDeletion) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AccountDeletion) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AccountDeletion) GetScheduledFor() string {
+	if x != nil {
+		return x.ScheduledFor
+	}
+	return ""
+}
+
+func (x *AccountDeletion) GetTransferToEmail() string {
+	if x != nil {
+		return x.TransferToEmail
+	}
+	return ""
+}
+
+func (x *AccountDeletion) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// 个人数据状态响应消息，deletion 为空表示没有进行中的注销申请
+type PrivacyStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Exports       []*DataExport          `protobuf:"bytes,3,rep,name=exports,proto3" json:"exports,omitempty"`
+	Deletion      *AccountDeletion       `protobuf:"bytes,4,opt,name=deletion,proto3" json:"deletion,omitempty"`
+	GraceDays     int32                  `protobuf:"varint,5,opt,name=grace_days,json=graceDays,proto3" json:"grace_days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrivacyStatusResponse) Reset() {
+	*x = PrivacyStatusResponse{}
+	mi := &file_protos_privacy_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrivacyStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrivacyStatusResponse) ProtoMessage() {}
+
+func (x *PrivacyStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_privacy_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrivacyStatusResponse.ProtoReflect.Descriptor instead.
+func (*PrivacyStatusResponse) Descriptor() ([]byte, []int) {
+	return file_protos_privacy_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PrivacyStatusResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *PrivacyStatusResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PrivacyStatusResponse) GetExports() []*DataExport {
+	if x != nil {
+		return x.Exports
+	}
+	return nil
+}
+
+func (x *PrivacyStatusResponse) GetDeletion() *AccountDeletion {
+	if x != nil {
+		return x.Deletion
+	}
+	return nil
+}
+
+func (x *PrivacyStatusResponse) GetGraceDays() int32 {
+	if x != nil {
+		return x.GraceDays
+	}
+	return 0
+}
+
+var File_protos_privacy_proto protoreflect.FileDescriptor
+
+const file_protos_privacy_proto_rawDesc = "" +
+	"\n" +
+	"\x14protos/privacy.proto\x12\aprivacy\"2\n" +
+	"\x17GetPrivacyStatusRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\"3\n" +
+	"\x18RequestDataExportRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\"?\n" +
+	"\x14GetDataExportRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\rR\x02id\"`\n" +
+	"\x15GetDataExportResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x19\n" +
+	"\bfile_url\x18\x03 \x01(\tR\afileUrl\"\x89\x01\n" +
+	"\x1dRequestAccountDeletionRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12#\n" +
+	"\rconfirm_email\x18\x02 \x01(\tR\fconfirmEmail\x12*\n" +
+	"\x11transfer_to_email\x18\x03 \x01(\tR\x0ftransferToEmail\"7\n" +
+	"\x1cCancelAccountDeletionRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\"\xc8\x01\n" +
+	"\n" +
+	"DataExport\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1b\n" +
+	"\tfile_size\x18\x03 \x01(\x03R\bfileSize\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12!\n" +
+	"\fcompleted_at\x18\x06 \x01(\tR\vcompletedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\tR\texpiresAt\"\xa9\x01\n" +
+	"\x0fAccountDeletion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12#\n" +
+	"\rscheduled_for\x18\x03 \x01(\tR\fscheduledFor\x12*\n" +
+	"\x11transfer_to_email\x18\x04 \x01(\tR\x0ftransferToEmail\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\"\xc9\x01\n" +
+	"\x15PrivacyStatusResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12-\n" +
+	"\aexports\x18\x03 \x03(\v2\x13.privacy.DataExportR\aexports\x124\n" +
+	"\bdeletion\x18\x04 \x01(\v2\x18.privacy.AccountDeletionR\bdeletion\x12\x1d\n" +
+	"\n" +
+	"grace_days\x18\x05 \x01(\x05R\tgraceDays2\xd0\x03\n" +
+	"\x0ePrivacyService\x12T\n" +
+	"\x10GetPrivacyStatus\x12 .privacy.GetPrivacyStatusRequest\x1a\x1e.privacy.PrivacyStatusResponse\x12V\n" +
+	"\x11RequestDataExport\x12!.privacy.RequestDataExportRequest\x1a\x1e.privacy.PrivacyStatusResponse\x12N\n" +
+	"\rGetDataExport\x12\x1d.privacy.GetDataExportRequest\x1a\x1e.privacy.GetDataExportResponse\x12`\n" +
+	"\x16RequestAccountDeletion\x12&.privacy.RequestAccountDeletionRequest\x1a\x1e.privacy.PrivacyStatusResponse\x12^\n" +
+	"\x15CancelAccountDeletion\x12%.privacy.CancelAccountDeletionRequest\x1a\x1e.privacy.PrivacyStatusResponseB.Z,course-platform/internal/shared/pb/privacypbb\x06proto3"
+
+var (
+	file_protos_privacy_proto_rawDescOnce sync.Once
+	file_protos_privacy_proto_rawDescData []byte
+)
+
+func file_protos_privacy_proto_rawDescGZIP() []byte {
+	file_protos_privacy_proto_rawDescOnce.Do(func() {
+		file_protos_privacy_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_protos_privacy_proto_rawDesc), len(file_protos_privacy_proto_rawDesc)))
+	})
+	return file_protos_privacy_proto_rawDescData
+}
+
+var file_protos_privacy_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_protos_privacy_proto_goTypes = []any{
+	(*GetPrivacyStatusRequest)(nil),       // 0: privacy.GetPrivacyStatusRequest
+	(*RequestDataExportRequest)(nil),      // 1: privacy.RequestDataExportRequest
+	(*GetDataExportRequest)(nil),          // 2: privacy.GetDataExportRequest
+	(*GetDataExportResponse)(nil),         // 3: privacy.GetDataExportResponse
+	(*RequestAccountDeletionRequest)(nil), // 4: privacy.RequestAccountDeletionRequest
+	(*CancelAccountDeletionRequest)(nil),  // 5: privacy.CancelAccountDeletionRequest
+	(*DataExport)(nil),                    // 6: privacy.DataExport
+	(*AccountDeletion)(nil),               // 7: privacy.AccountDeletion
+	(*PrivacyStatusResponse)(nil),         // 8: privacy.PrivacyStatusResponse
+}
+var file_protos_privacy_proto_depIdxs = []int32{
+	6, // 0: privacy.PrivacyStatusResponse.exports:type_name -> privacy.DataExport
+	7, // 1: privacy.PrivacyStatusResponse.deletion:type_name -> privacy.AccountDeletion
+	0, // 2: privacy.PrivacyService.GetPrivacyStatus:input_type -> privacy.GetPrivacyStatusRequest
+	1, // 3: privacy.PrivacyService.RequestDataExport:input_type -> privacy.RequestDataExportRequest
+	2, // 4: privacy.PrivacyService.GetDataExport:input_type -> privacy.GetDataExportRequest
+	4, // 5: privacy.PrivacyService.RequestAccountDeletion:input_type -> privacy.RequestAccountDeletionRequest
+	5, // 6: privacy.PrivacyService.CancelAccountDeletion:input_type -> privacy.CancelAccountDeletionRequest
+	8, // 7: privacy.PrivacyService.GetPrivacyStatus:output_type -> privacy.PrivacyStatusResponse
+	8, // 8: privacy.PrivacyService.RequestDataExport:output_type -> privacy.PrivacyStatusResponse
+	3, // 9: privacy.PrivacyService.GetDataExport:output_type -> privacy.GetDataExportResponse
+	8, // 10: privacy.PrivacyService.RequestAccountDeletion:output_type -> privacy.PrivacyStatusResponse
+	8, // 11: privacy.PrivacyService.CancelAccountDeletion:output_type -> privacy.PrivacyStatusResponse
+	7, // [7:12] is the sub-list for method output_type
+	2, // [2:7] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_protos_privacy_proto_init() }
+func file_protos_privacy_proto_init() {
+	if File_protos_privacy_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_protos_privacy_proto_rawDesc), len(file_protos_privacy_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_protos_privacy_proto_goTypes,
+		DependencyIndexes: file_protos_privacy_proto_depIdxs,
+		MessageInfos:      file_protos_privacy_proto_msgTypes,
+	}.Build()
+	File_protos_privacy_proto = out.File
+	file_protos_privacy_proto_goTypes = nil
+	file_protos_privacy_proto_depIdxs = nil
+}
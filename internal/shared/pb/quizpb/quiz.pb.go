@@ -0,0 +1,1806 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: protos/quiz.proto
+
+package quizpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 创建题目请求消息
+type CreateQuestionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      uint32                 `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	Options       []string               `protobuf:"bytes,5,rep,name=options,proto3" json:"options,omitempty"`
+	Answers       []string               `protobuf:"bytes,6,rep,name=answers,proto3" json:"answers,omitempty"`
+	Explanation   string                 `protobuf:"bytes,7,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	Score         uint32                 `protobuf:"varint,8,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateQuestionRequest) Reset() {
+	*x = CreateQuestionRequest{}
+	mi := &file_protos_quiz_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateQuestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateQuestionRequest) ProtoMessage() {}
+
+func (x *CreateQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_quiz_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateQuestionRequest.ProtoReflect.Descriptor instead.
+func (*CreateQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_protos_quiz_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateQuestionRequest) GetCourseId() uint32 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *CreateQuestionRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateQuestionRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CreateQuestionRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CreateQuestionRequest) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *CreateQuestionRequest) GetAnswers() []string {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+func (x *CreateQuestionRequest) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+func (x *CreateQuestionRequest) GetScore() uint32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+// 创建题目响应消息
+type CreateQuestionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Question      *Question              `protobuf:"bytes,3,opt,name=question,proto3" json:"question,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateQuestionResponse) Reset() {
+	*x = CreateQuestionResponse{}
+	mi := &file_protos_quiz_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateQuestionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateQuestionResponse) ProtoMessage() {}
+
+func (x *CreateQuestionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_quiz_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateQuestionResponse.ProtoReflect.Descriptor instead.
+func (*CreateQuestionResponse) Descriptor() ([]byte, []int) {
+	return file_protos_quiz_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateQuestionResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *CreateQuestionResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateQuestionResponse) GetQuestion() *Question {
+	if x != nil {
+		return x.Question
+	}
+	return nil
+}
+
+// 获取题库请求消息
+type ListQuestionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      uint32                 `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQuestionsRequest) Reset() {
+	*x = ListQuestionsRequest{}
+	mi := &file_protos_quiz_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQuestionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQuestionsRequest) ProtoMessage() {}
+
+func (x *ListQuestionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_quiz_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQuestionsRequest.ProtoReflect.Descriptor instead.
+func (*ListQuestionsRequest) Descriptor() ([]byte, []int) {
+	return file_protos_quiz_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListQuestionsRequest) GetCourseId() uint32 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *ListQuestionsRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// 获取题库响应消息
+type ListQuestionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Questions     []*Question            `protobuf:"bytes,3,rep,name=questions,proto3" json:"questions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQuestionsResponse) Reset() {
+	*x = ListQuestionsResponse{}
+	mi := &file_protos_quiz_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQuestionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQuestionsResponse) ProtoMessage() {}
+
+func (x *ListQuestionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_quiz_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQuestionsResponse.ProtoReflect.Descriptor instead.
+func (*ListQuestionsResponse) Descriptor() ([]byte, []int) {
+	return file_protos_quiz_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListQuestionsResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListQuestionsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListQuestionsResponse) GetQuestions() []*Question {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+// 创建测验请求消息
+type CreateQuizRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	CourseId         uint32                 `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	ChapterId        uint32                 `protobuf:"varint,2,opt,name=chapter_id,json=chapterId,proto3" json:"chapter_id,omitempty"`
+	UserId           uint32                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Title            string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Description      string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	TimeLimitSeconds uint32                 `protobuf:"varint,6,opt,name=time_limit_seconds,json=timeLimitSeconds,proto3" json:"time_limit_seconds,omitempty"`
+	MaxAttempts      uint32                 `protobuf:"varint,7,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	PassScore        uint32                 `protobuf:"varint,8,opt,name=pass_score,json=passScore,proto3" json:"pass_score,omitempty"`
+	QuestionIds      []uint32               `protobuf:"varint,9,rep,packed,name=question_ids,json=questionIds,proto3" json:"question_ids,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateQuizRequest) Reset() {
+	*x = CreateQuizRequest{}
+	mi := &file_protos_quiz_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateQuizRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateQuizRequest) ProtoMessage() {}
+
+func (x *CreateQuizRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_quiz_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateQuizRequest.ProtoReflect.Descriptor instead.
+func (*CreateQuizRequest) Descriptor() ([]byte, []int) {
+	return file_protos_quiz_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateQuizRequest) GetCourseId() uint32 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *CreateQuizRequest) GetChapterId() uint32 {
+	if x != nil {
+		return x.ChapterId
+	}
+	return 0
+}
+
+func (x *CreateQuizRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateQuizRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateQuizRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateQuizRequest) GetTimeLimitSeconds() uint32 {
+	if x != nil {
+		return x.TimeLimitSeconds
+	}
+	return 0
+}
+
+func (x *CreateQuizRequest) GetMaxAttempts() uint32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *CreateQuizRequest) GetPassScore() uint32 {
+	if x != nil {
+		return x.PassScore
+	}
+	return 0
+}
+
+func (x *CreateQuizRequest) GetQuestionIds() []uint32 {
+	if x != nil {
+		return x.QuestionIds
+	}
+	return nil
+}
+
+// 创建测验响应消息
+type CreateQuizResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Quiz          *Quiz                  `protobuf:"bytes,3,opt,name=quiz,proto3" json:"quiz,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateQuizResponse) Reset() {
+	*x = CreateQuizResponse{}
+	mi := &file_protos_quiz_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateQuizResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateQuizResponse) ProtoMessage() {}
+
+func (x *CreateQuizResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_quiz_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateQuizResponse.ProtoReflect.Descriptor instead.
+func (*CreateQuizResponse) Descriptor() ([]byte, []int) {
+	return file_protos_quiz_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateQuizResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *CreateQuizResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateQuizResponse) GetQuiz() *Quiz {
+	if x != nil {
+		return x.Quiz
+	}
+	return nil
+}
+
+// 获取测验请求消息
+type GetQuizRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuizId        uint32                 `protobuf:"varint,1,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQuizRequest) Reset() {
+	*x = GetQuizRequest{}
+	mi := &file_protos_quiz_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQuizRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuizRequest) ProtoMessage() {}
+
+func (x *GetQuizRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_quiz_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuizRequest.ProtoReflect.Descriptor instead.
+func (*GetQuizRequest) Descriptor() ([]byte, []int) {
+	return file_protos_quiz_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetQuizRequest) GetQuizId() uint32 {
+	if x != nil {
+		return x.QuizId
+	}
+	return 0
+}
+
+func (x *GetQuizRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// 获取测验响应消息
+type GetQuizResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Quiz          *Quiz                  `protobuf:"bytes,3,opt,name=quiz,proto3" json:"quiz,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQuizResponse) Reset() {
+	*x = GetQuizResponse{}
+	mi := &file_protos_quiz_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQuizResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuizResponse) ProtoMessage() {}
+
+func (x *GetQuizResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_quiz_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuizResponse.ProtoReflect.Descriptor instead.
+func (*GetQuizResponse) Descriptor() ([]byte, []int) {
+	return file_protos_quiz_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetQuizResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetQuizResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetQuizResponse) GetQuiz() *Quiz {
+	if x != nil {
+		return x.Quiz
+	}
+	return nil
+}
+
+// 获取测验列表请求消息
+type ListQuizzesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CourseId      uint32                 `protobuf:"varint,1,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	ChapterId     uint32                 `protobuf:"varint,2,opt,name=chapter_id,json=chapterId,proto3" json:"chapter_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQuizzesRequest) Reset() {
+	*x = ListQuizzesRequest{}
+	mi := &file_protos_quiz_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQuizzesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQuizzesRequest) ProtoMessage() {}
+
+func (x *ListQuizzesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_quiz_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQuizzesRequest.ProtoReflect.Descriptor instead.
+func (*ListQuizzesRequest) Descriptor() ([]byte, []int) {
+	return file_protos_quiz_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListQuizzesRequest) GetCourseId() uint32 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *ListQuizzesRequest) GetChapterId() uint32 {
+	if x != nil {
+		return x.ChapterId
+	}
+	return 0
+}
+
+// 获取测验列表响应消息
+type ListQuizzesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Quizzes       []*Quiz                `protobuf:"bytes,3,rep,name=quizzes,proto3" json:"quizzes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQuizzesResponse) Reset() {
+	*x = ListQuizzesResponse{}
+	mi := &file_protos_quiz_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQuizzesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQuizzesResponse) ProtoMessage() {}
+
+func (x *ListQuizzesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_quiz_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQuizzesResponse.ProtoReflect.Descriptor instead.
+func (*ListQuizzesResponse) Descriptor() ([]byte, []int) {
+	return file_protos_quiz_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListQuizzesResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListQuizzesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListQuizzesResponse) GetQuizzes() []*Quiz {
+	if x != nil {
+		return x.Quizzes
+	}
+	return nil
+}
+
+// 开始答题请求消息
+type StartAttemptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuizId        uint32                 `protobuf:"varint,1,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartAttemptRequest) Reset() {
+	*x = StartAttemptRequest{}
+	mi := &file_protos_quiz_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartAttemptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartAttemptRequest) ProtoMessage() {}
+
+func (x *StartAttemptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_quiz_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartAttemptRequest.ProtoReflect.Descriptor instead.
+func (*StartAttemptRequest) Descriptor() ([]byte, []int) {
+	return file_protos_quiz_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *StartAttemptRequest) GetQuizId() uint32 {
+	if x != nil {
+		return x.QuizId
+	}
+	return 0
+}
+
+func (x *StartAttemptRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// 开始答题响应消息
+type StartAttemptResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Attempt       *Attempt               `protobuf:"bytes,3,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	Quiz          *Quiz                  `protobuf:"bytes,4,opt,name=quiz,proto3" json:"quiz,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartAttemptResponse) Reset() {
+	*x = StartAttemptResponse{}
+	mi := &file_protos_quiz_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartAttemptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartAttemptResponse) ProtoMessage() {}
+
+func (x *StartAttemptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_quiz_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartAttemptResponse.ProtoReflect.Descriptor instead.
+func (*StartAttemptResponse) Descriptor() ([]byte, []int) {
+	return file_protos_quiz_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *StartAttemptResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *StartAttemptResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *StartAttemptResponse) GetAttempt() *Attempt {
+	if x != nil {
+		return x.Attempt
+	}
+	return nil
+}
+
+func (x *StartAttemptResponse) GetQuiz() *Quiz {
+	if x != nil {
+		return x.Quiz
+	}
+	return nil
+}
+
+// 提交答卷请求消息
+type SubmitAttemptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AttemptId     uint32                 `protobuf:"varint,1,opt,name=attempt_id,json=attemptId,proto3" json:"attempt_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Answers       []*QuestionAnswer      `protobuf:"bytes,3,rep,name=answers,proto3" json:"answers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitAttemptRequest) Reset() {
+	*x = SubmitAttemptRequest{}
+	mi := &file_protos_quiz_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitAttemptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitAttemptRequest) ProtoMessage() {}
+
+func (x *SubmitAttemptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_quiz_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitAttemptRequest.ProtoReflect.Descriptor instead.
+func (*SubmitAttemptRequest) Descriptor() ([]byte, []int) {
+	return file_protos_quiz_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SubmitAttemptRequest) GetAttemptId() uint32 {
+	if x != nil {
+		return x.AttemptId
+	}
+	return 0
+}
+
+func (x *SubmitAttemptRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SubmitAttemptRequest) GetAnswers() []*QuestionAnswer {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+// 提交答卷响应消息
+type SubmitAttemptResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Attempt       *Attempt               `protobuf:"bytes,3,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitAttemptResponse) Reset() {
+	*x = SubmitAttemptResponse{}
+	mi := &file_protos_quiz_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitAttemptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitAttemptResponse) ProtoMessage() {}
+
+func (x *SubmitAttemptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_quiz_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitAttemptResponse.ProtoReflect.Descriptor instead.
+func (*SubmitAttemptResponse) Descriptor() ([]byte, []int) {
+	return file_protos_quiz_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SubmitAttemptResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *SubmitAttemptResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SubmitAttemptResponse) GetAttempt() *Attempt {
+	if x != nil {
+		return x.Attempt
+	}
+	return nil
+}
+
+// 获取答题记录请求消息
+type ListAttemptsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuizId        uint32                 `protobuf:"varint,1,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StudentId     uint32                 `protobuf:"varint,3,opt,name=student_id,json=studentId,proto3" json:"student_id,omitempty"` // 讲师查看指定学员，0 表示查看自己
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAttemptsRequest) Reset() {
+	*x = ListAttemptsRequest{}
+	mi := &file_protos_quiz_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAttemptsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttemptsRequest) ProtoMessage() {}
+
+func (x *ListAttemptsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_quiz_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttemptsRequest.ProtoReflect.Descriptor instead.
+func (*ListAttemptsRequest) Descriptor() ([]byte, []int) {
+	return file_protos_quiz_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListAttemptsRequest) GetQuizId() uint32 {
+	if x != nil {
+		return x.QuizId
+	}
+	return 0
+}
+
+func (x *ListAttemptsRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListAttemptsRequest) GetStudentId() uint32 {
+	if x != nil {
+		return x.StudentId
+	}
+	return 0
+}
+
+// 获取答题记录响应消息
+type ListAttemptsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Attempts      []*Attempt             `protobuf:"bytes,3,rep,name=attempts,proto3" json:"attempts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAttemptsResponse) Reset() {
+	*x = ListAttemptsResponse{}
+	mi := &file_protos_quiz_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAttemptsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttemptsResponse) ProtoMessage() {}
+
+func (x *ListAttemptsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_quiz_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttemptsResponse.ProtoReflect.Descriptor instead.
+func (*ListAttemptsResponse) Descriptor() ([]byte, []int) {
+	return file_protos_quiz_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListAttemptsResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListAttemptsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListAttemptsResponse) GetAttempts() []*Attempt {
+	if x != nil {
+		return x.Attempts
+	}
+	return nil
+}
+
+// 题目模型
+type Question struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CourseId      uint32                 `protobuf:"varint,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	Options       []string               `protobuf:"bytes,5,rep,name=options,proto3" json:"options,omitempty"`
+	Answers       []string               `protobuf:"bytes,6,rep,name=answers,proto3" json:"answers,omitempty"`
+	Explanation   string                 `protobuf:"bytes,7,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	Score         uint32                 `protobuf:"varint,8,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Question) Reset() {
+	*x = Question{}
+	mi := &file_protos_quiz_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Question) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Question) ProtoMessage() {}
+
+func (x *Question) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_quiz_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Question.ProtoReflect.Descriptor instead.
+func (*Question) Descriptor() ([]byte, []int) {
+	return file_protos_quiz_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Question) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Question) GetCourseId() uint32 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *Question) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Question) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Question) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *Question) GetAnswers() []string {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+func (x *Question) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+func (x *Question) GetScore() uint32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+// 测验模型
+type Quiz struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CourseId         uint32                 `protobuf:"varint,2,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	ChapterId        uint32                 `protobuf:"varint,3,opt,name=chapter_id,json=chapterId,proto3" json:"chapter_id,omitempty"`
+	Title            string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Description      string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	TimeLimitSeconds uint32                 `protobuf:"varint,6,opt,name=time_limit_seconds,json=timeLimitSeconds,proto3" json:"time_limit_seconds,omitempty"`
+	MaxAttempts      uint32                 `protobuf:"varint,7,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	PassScore        uint32                 `protobuf:"varint,8,opt,name=pass_score,json=passScore,proto3" json:"pass_score,omitempty"`
+	QuestionCount    uint32                 `protobuf:"varint,9,opt,name=question_count,json=questionCount,proto3" json:"question_count,omitempty"`
+	TotalScore       uint32                 `protobuf:"varint,10,opt,name=total_score,json=totalScore,proto3" json:"total_score,omitempty"`
+	Questions        []*Question            `protobuf:"bytes,11,rep,name=questions,proto3" json:"questions,omitempty"`
+	CreatedAt        string                 `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Quiz) Reset() {
+	*x = Quiz{}
+	mi := &file_protos_quiz_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Quiz) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Quiz) ProtoMessage() {}
+
+func (x *Quiz) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_quiz_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Quiz.ProtoReflect.Descriptor instead.
+func (*Quiz) Descriptor() ([]byte, []int) {
+	return file_protos_quiz_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Quiz) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Quiz) GetCourseId() uint32 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *Quiz) GetChapterId() uint32 {
+	if x != nil {
+		return x.ChapterId
+	}
+	return 0
+}
+
+func (x *Quiz) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Quiz) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Quiz) GetTimeLimitSeconds() uint32 {
+	if x != nil {
+		return x.TimeLimitSeconds
+	}
+	return 0
+}
+
+func (x *Quiz) GetMaxAttempts() uint32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *Quiz) GetPassScore() uint32 {
+	if x != nil {
+		return x.PassScore
+	}
+	return 0
+}
+
+func (x *Quiz) GetQuestionCount() uint32 {
+	if x != nil {
+		return x.QuestionCount
+	}
+	return 0
+}
+
+func (x *Quiz) GetTotalScore() uint32 {
+	if x != nil {
+		return x.TotalScore
+	}
+	return 0
+}
+
+func (x *Quiz) GetQuestions() []*Question {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+func (x *Quiz) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// 单题作答
+type QuestionAnswer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuestionId    uint32                 `protobuf:"varint,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	Answers       []string               `protobuf:"bytes,2,rep,name=answers,proto3" json:"answers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuestionAnswer) Reset() {
+	*x = QuestionAnswer{}
+	mi := &file_protos_quiz_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuestionAnswer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuestionAnswer) ProtoMessage() {}
+
+func (x *QuestionAnswer) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_quiz_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuestionAnswer.ProtoReflect.Descriptor instead.
+func (*QuestionAnswer) Descriptor() ([]byte, []int) {
+	return file_protos_quiz_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *QuestionAnswer) GetQuestionId() uint32 {
+	if x != nil {
+		return x.QuestionId
+	}
+	return 0
+}
+
+func (x *QuestionAnswer) GetAnswers() []string {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+// 单题判分结果
+type AnswerResult struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	QuestionId     uint32                 `protobuf:"varint,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	Answers        []string               `protobuf:"bytes,2,rep,name=answers,proto3" json:"answers,omitempty"`
+	Correct        bool                   `protobuf:"varint,3,opt,name=correct,proto3" json:"correct,omitempty"`
+	Score          uint32                 `protobuf:"varint,4,opt,name=score,proto3" json:"score,omitempty"`
+	CorrectAnswers []string               `protobuf:"bytes,5,rep,name=correct_answers,json=correctAnswers,proto3" json:"correct_answers,omitempty"`
+	Explanation    string                 `protobuf:"bytes,6,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AnswerResult) Reset() {
+	*x = AnswerResult{}
+	mi := &file_protos_quiz_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnswerResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnswerResult) ProtoMessage() {}
+
+func (x *AnswerResult) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_quiz_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnswerResult.ProtoReflect.Descriptor instead.
+func (*AnswerResult) Descriptor() ([]byte, []int) {
+	return file_protos_quiz_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *AnswerResult) GetQuestionId() uint32 {
+	if x != nil {
+		return x.QuestionId
+	}
+	return 0
+}
+
+func (x *AnswerResult) GetAnswers() []string {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+func (x *AnswerResult) GetCorrect() bool {
+	if x != nil {
+		return x.Correct
+	}
+	return false
+}
+
+func (x *AnswerResult) GetScore() uint32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *AnswerResult) GetCorrectAnswers() []string {
+	if x != nil {
+		return x.CorrectAnswers
+	}
+	return nil
+}
+
+func (x *AnswerResult) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+// 答题记录模型
+type Attempt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	QuizId        uint32                 `protobuf:"varint,2,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Score         uint32                 `protobuf:"varint,5,opt,name=score,proto3" json:"score,omitempty"`
+	MaxScore      uint32                 `protobuf:"varint,6,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"`
+	Passed        bool                   `protobuf:"varint,7,opt,name=passed,proto3" json:"passed,omitempty"`
+	StartedAt     string                 `protobuf:"bytes,8,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	SubmittedAt   string                 `protobuf:"bytes,10,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+	Results       []*AnswerResult        `protobuf:"bytes,11,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Attempt) Reset() {
+	*x = Attempt{}
+	mi := &file_protos_quiz_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attempt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attempt) ProtoMessage() {}
+
+func (x *Attempt) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_quiz_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attempt.ProtoReflect.Descriptor instead.
+func (*Attempt) Descriptor() ([]byte, []int) {
+	return file_protos_quiz_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Attempt) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Attempt) GetQuizId() uint32 {
+	if x != nil {
+		return x.QuizId
+	}
+	return 0
+}
+
+func (x *Attempt) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Attempt) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Attempt) GetScore() uint32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Attempt) GetMaxScore() uint32 {
+	if x != nil {
+		return x.MaxScore
+	}
+	return 0
+}
+
+func (x *Attempt) GetPassed() bool {
+	if x != nil {
+		return x.Passed
+	}
+	return false
+}
+
+func (x *Attempt) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *Attempt) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *Attempt) GetSubmittedAt() string {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return ""
+}
+
+func (x *Attempt) GetResults() []*AnswerResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+var File_protos_quiz_proto protoreflect.FileDescriptor
+
+const file_protos_quiz_proto_rawDesc = "" +
+	"\n" +
+	"\x11protos/quiz.proto\x12\x04quiz\"\xe7\x01\n" +
+	"\x15CreateQuestionRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\rR\bcourseId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x12\x18\n" +
+	"\aoptions\x18\x05 \x03(\tR\aoptions\x12\x18\n" +
+	"\aanswers\x18\x06 \x03(\tR\aanswers\x12 \n" +
+	"\vexplanation\x18\a \x01(\tR\vexplanation\x12\x14\n" +
+	"\x05score\x18\b \x01(\rR\x05score\"r\n" +
+	"\x16CreateQuestionResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12*\n" +
+	"\bquestion\x18\x03 \x01(\v2\x0e.quiz.QuestionR\bquestion\"L\n" +
+	"\x14ListQuestionsRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\rR\bcourseId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\"s\n" +
+	"\x15ListQuestionsResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12,\n" +
+	"\tquestions\x18\x03 \x03(\v2\x0e.quiz.QuestionR\tquestions\"\xb3\x02\n" +
+	"\x11CreateQuizRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\rR\bcourseId\x12\x1d\n" +
+	"\n" +
+	"chapter_id\x18\x02 \x01(\rR\tchapterId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\rR\x06userId\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12,\n" +
+	"\x12time_limit_seconds\x18\x06 \x01(\rR\x10timeLimitSeconds\x12!\n" +
+	"\fmax_attempts\x18\a \x01(\rR\vmaxAttempts\x12\x1d\n" +
+	"\n" +
+	"pass_score\x18\b \x01(\rR\tpassScore\x12!\n" +
+	"\fquestion_ids\x18\t \x03(\rR\vquestionIds\"b\n" +
+	"\x12CreateQuizResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1e\n" +
+	"\x04quiz\x18\x03 \x01(\v2\n" +
+	".quiz.QuizR\x04quiz\"B\n" +
+	"\x0eGetQuizRequest\x12\x17\n" +
+	"\aquiz_id\x18\x01 \x01(\rR\x06quizId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\"_\n" +
+	"\x0fGetQuizResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1e\n" +
+	"\x04quiz\x18\x03 \x01(\v2\n" +
+	".quiz.QuizR\x04quiz\"P\n" +
+	"\x12ListQuizzesRequest\x12\x1b\n" +
+	"\tcourse_id\x18\x01 \x01(\rR\bcourseId\x12\x1d\n" +
+	"\n" +
+	"chapter_id\x18\x02 \x01(\rR\tchapterId\"i\n" +
+	"\x13ListQuizzesResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12$\n" +
+	"\aquizzes\x18\x03 \x03(\v2\n" +
+	".quiz.QuizR\aquizzes\"G\n" +
+	"\x13StartAttemptRequest\x12\x17\n" +
+	"\aquiz_id\x18\x01 \x01(\rR\x06quizId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\"\x8d\x01\n" +
+	"\x14StartAttemptResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12'\n" +
+	"\aattempt\x18\x03 \x01(\v2\r.quiz.AttemptR\aattempt\x12\x1e\n" +
+	"\x04quiz\x18\x04 \x01(\v2\n" +
+	".quiz.QuizR\x04quiz\"~\n" +
+	"\x14SubmitAttemptRequest\x12\x1d\n" +
+	"\n" +
+	"attempt_id\x18\x01 \x01(\rR\tattemptId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\x12.\n" +
+	"\aanswers\x18\x03 \x03(\v2\x14.quiz.QuestionAnswerR\aanswers\"n\n" +
+	"\x15SubmitAttemptResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12'\n" +
+	"\aattempt\x18\x03 \x01(\v2\r.quiz.AttemptR\aattempt\"f\n" +
+	"\x13ListAttemptsRequest\x12\x17\n" +
+	"\aquiz_id\x18\x01 \x01(\rR\x06quizId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\x12\x1d\n" +
+	"\n" +
+	"student_id\x18\x03 \x01(\rR\tstudentId\"o\n" +
+	"\x14ListAttemptsResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12)\n" +
+	"\battempts\x18\x03 \x03(\v2\r.quiz.AttemptR\battempts\"\xd1\x01\n" +
+	"\bQuestion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1b\n" +
+	"\tcourse_id\x18\x02 \x01(\rR\bcourseId\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x12\x18\n" +
+	"\aoptions\x18\x05 \x03(\tR\aoptions\x12\x18\n" +
+	"\aanswers\x18\x06 \x03(\tR\aanswers\x12 \n" +
+	"\vexplanation\x18\a \x01(\tR\vexplanation\x12\x14\n" +
+	"\x05score\x18\b \x01(\rR\x05score\"\x8f\x03\n" +
+	"\x04Quiz\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1b\n" +
+	"\tcourse_id\x18\x02 \x01(\rR\bcourseId\x12\x1d\n" +
+	"\n" +
+	"chapter_id\x18\x03 \x01(\rR\tchapterId\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12,\n" +
+	"\x12time_limit_seconds\x18\x06 \x01(\rR\x10timeLimitSeconds\x12!\n" +
+	"\fmax_attempts\x18\a \x01(\rR\vmaxAttempts\x12\x1d\n" +
+	"\n" +
+	"pass_score\x18\b \x01(\rR\tpassScore\x12%\n" +
+	"\x0equestion_count\x18\t \x01(\rR\rquestionCount\x12\x1f\n" +
+	"\vtotal_score\x18\n" +
+	" \x01(\rR\n" +
+	"totalScore\x12,\n" +
+	"\tquestions\x18\v \x03(\v2\x0e.quiz.QuestionR\tquestions\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\f \x01(\tR\tcreatedAt\"K\n" +
+	"\x0eQuestionAnswer\x12\x1f\n" +
+	"\vquestion_id\x18\x01 \x01(\rR\n" +
+	"questionId\x12\x18\n" +
+	"\aanswers\x18\x02 \x03(\tR\aanswers\"\xc4\x01\n" +
+	"\fAnswerResult\x12\x1f\n" +
+	"\vquestion_id\x18\x01 \x01(\rR\n" +
+	"questionId\x12\x18\n" +
+	"\aanswers\x18\x02 \x03(\tR\aanswers\x12\x18\n" +
+	"\acorrect\x18\x03 \x01(\bR\acorrect\x12\x14\n" +
+	"\x05score\x18\x04 \x01(\rR\x05score\x12'\n" +
+	"\x0fcorrect_answers\x18\x05 \x03(\tR\x0ecorrectAnswers\x12 \n" +
+	"\vexplanation\x18\x06 \x01(\tR\vexplanation\"\xbd\x02\n" +
+	"\aAttempt\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x17\n" +
+	"\aquiz_id\x18\x02 \x01(\rR\x06quizId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\rR\x06userId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x14\n" +
+	"\x05score\x18\x05 \x01(\rR\x05score\x12\x1b\n" +
+	"\tmax_score\x18\x06 \x01(\rR\bmaxScore\x12\x16\n" +
+	"\x06passed\x18\a \x01(\bR\x06passed\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\b \x01(\tR\tstartedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\t \x01(\tR\texpiresAt\x12!\n" +
+	"\fsubmitted_at\x18\n" +
+	" \x01(\tR\vsubmittedAt\x12,\n" +
+	"\aresults\x18\v \x03(\v2\x12.quiz.AnswerResultR\aresults2\xb9\x04\n" +
+	"\vQuizService\x12K\n" +
+	"\x0eCreateQuestion\x12\x1b.quiz.CreateQuestionRequest\x1a\x1c.quiz.CreateQuestionResponse\x12H\n" +
+	"\rListQuestions\x12\x1a.quiz.ListQuestionsRequest\x1a\x1b.quiz.ListQuestionsResponse\x12?\n" +
+	"\n" +
+	"CreateQuiz\x12\x17.quiz.CreateQuizRequest\x1a\x18.quiz.CreateQuizResponse\x126\n" +
+	"\aGetQuiz\x12\x14.quiz.GetQuizRequest\x1a\x15.quiz.GetQuizResponse\x12B\n" +
+	"\vListQuizzes\x12\x18.quiz.ListQuizzesRequest\x1a\x19.quiz.ListQuizzesResponse\x12E\n" +
+	"\fStartAttempt\x12\x19.quiz.StartAttemptRequest\x1a\x1a.quiz.StartAttemptResponse\x12H\n" +
+	"\rSubmitAttempt\x12\x1a.quiz.SubmitAttemptRequest\x1a\x1b.quiz.SubmitAttemptResponse\x12E\n" +
+	"\fListAttempts\x12\x19.quiz.ListAttemptsRequest\x1a\x1a.quiz.ListAttemptsResponseB+Z)course-platform/internal/shared/pb/quizpbb\x06proto3"
+
+var (
+	file_protos_quiz_proto_rawDescOnce sync.Once
+	file_protos_quiz_proto_rawDescData []byte
+)
+
+func file_protos_quiz_proto_rawDescGZIP() []byte {
+	file_protos_quiz_proto_rawDescOnce.Do(func() {
+		file_protos_quiz_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_protos_quiz_proto_rawDesc), len(file_protos_quiz_proto_rawDesc)))
+	})
+	return file_protos_quiz_proto_rawDescData
+}
+
+var file_protos_quiz_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_protos_quiz_proto_goTypes = []any{
+	(*CreateQuestionRequest)(nil),  // 0: quiz.CreateQuestionRequest
+	(*CreateQuestionResponse)(nil), // 1: quiz.CreateQuestionResponse
+	(*ListQuestionsRequest)(nil),   // 2: quiz.ListQuestionsRequest
+	(*ListQuestionsResponse)(nil),  // 3: quiz.ListQuestionsResponse
+	(*CreateQuizRequest)(nil),      // 4: quiz.CreateQuizRequest
+	(*CreateQuizResponse)(nil),     // 5: quiz.CreateQuizResponse
+	(*GetQuizRequest)(nil),         // 6: quiz.GetQuizRequest
+	(*GetQuizResponse)(nil),        // 7: quiz.GetQuizResponse
+	(*ListQuizzesRequest)(nil),     // 8: quiz.ListQuizzesRequest
+	(*ListQuizzesResponse)(nil),    // 9: quiz.ListQuizzesResponse
+	(*StartAttemptRequest)(nil),    // 10: quiz.StartAttemptRequest
+	(*StartAttemptResponse)(nil),   // 11: quiz.StartAttemptResponse
+	(*SubmitAttemptRequest)(nil),   // 12: quiz.SubmitAttemptRequest
+	(*SubmitAttemptResponse)(nil),  // 13: quiz.SubmitAttemptResponse
+	(*ListAttemptsRequest)(nil),    // 14: quiz.ListAttemptsRequest
+	(*ListAttemptsResponse)(nil),   // 15: quiz.ListAttemptsResponse
+	(*Question)(nil),               // 16: quiz.Question
+	(*Quiz)(nil),                   // 17: quiz.Quiz
+	(*QuestionAnswer)(nil),         // 18: quiz.QuestionAnswer
+	(*AnswerResult)(nil),           // 19: quiz.AnswerResult
+	(*Attempt)(nil),                // 20: quiz.Attempt
+}
+var file_protos_quiz_proto_depIdxs = []int32{
+	16, // 0: quiz.CreateQuestionResponse.question:type_name -> quiz.Question
+	16, // 1: quiz.ListQuestionsResponse.questions:type_name -> quiz.Question
+	17, // 2: quiz.CreateQuizResponse.quiz:type_name -> quiz.Quiz
+	17, // 3: quiz.GetQuizResponse.quiz:type_name -> quiz.Quiz
+	17, // 4: quiz.ListQuizzesResponse.quizzes:type_name -> quiz.Quiz
+	20, // 5: quiz.StartAttemptResponse.attempt:type_name -> quiz.Attempt
+	17, // 6: quiz.StartAttemptResponse.quiz:type_name -> quiz.Quiz
+	18, // 7: quiz.SubmitAttemptRequest.answers:type_name -> quiz.QuestionAnswer
+	20, // 8: quiz.SubmitAttemptResponse.attempt:type_name -> quiz.Attempt
+	20, // 9: quiz.ListAttemptsResponse.attempts:type_name -> quiz.Attempt
+	16, // 10: quiz.Quiz.questions:type_name -> quiz.Question
+	19, // 11: quiz.Attempt.results:type_name -> quiz.AnswerResult
+	0,  // 12: quiz.QuizService.CreateQuestion:input_type -> quiz.CreateQuestionRequest
+	2,  // 13: quiz.QuizService.ListQuestions:input_type -> quiz.ListQuestionsRequest
+	4,  // 14: quiz.QuizService.CreateQuiz:input_type -> quiz.CreateQuizRequest
+	6,  // 15: quiz.QuizService.GetQuiz:input_type -> quiz.GetQuizRequest
+	8,  // 16: quiz.QuizService.ListQuizzes:input_type -> quiz.ListQuizzesRequest
+	10, // 17: quiz.QuizService.StartAttempt:input_type -> quiz.StartAttemptRequest
+	12, // 18: quiz.QuizService.SubmitAttempt:input_type -> quiz.SubmitAttemptRequest
+	14, // 19: quiz.QuizService.ListAttempts:input_type -> quiz.ListAttemptsRequest
+	1,  // 20: quiz.QuizService.CreateQuestion:output_type -> quiz.CreateQuestionResponse
+	3,  // 21: quiz.QuizService.ListQuestions:output_type -> quiz.ListQuestionsResponse
+	5,  // 22: quiz.QuizService.CreateQuiz:output_type -> quiz.CreateQuizResponse
+	7,  // 23: quiz.QuizService.GetQuiz:output_type -> quiz.GetQuizResponse
+	9,  // 24: quiz.QuizService.ListQuizzes:output_type -> quiz.ListQuizzesResponse
+	11, // 25: quiz.QuizService.StartAttempt:output_type -> quiz.StartAttemptResponse
+	13, // 26: quiz.QuizService.SubmitAttempt:output_type -> quiz.SubmitAttemptResponse
+	15, // 27: quiz.QuizService.ListAttempts:output_type -> quiz.ListAttemptsResponse
+	20, // [20:28] is the sub-list for method output_type
+	12, // [12:20] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_protos_quiz_proto_init() }
+func file_protos_quiz_proto_init() {
+	if File_protos_quiz_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_protos_quiz_proto_rawDesc), len(file_protos_quiz_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   21,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_protos_quiz_proto_goTypes,
+		DependencyIndexes: file_protos_quiz_proto_depIdxs,
+		MessageInfos:      file_protos_quiz_proto_msgTypes,
+	}.Build()
+	File_protos_quiz_proto = out.File
+	file_protos_quiz_proto_goTypes = nil
+	file_protos_quiz_proto_depIdxs = nil
+}
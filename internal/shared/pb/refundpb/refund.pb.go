@@ -0,0 +1,1171 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v6.31.1
+// source: protos/refund.proto
+
+package refundpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 提交退款申请请求消息，amount 为0表示全额退款
+type RequestRefundRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OrderNo       string                 `protobuf:"bytes,2,opt,name=order_no,json=orderNo,proto3" json:"order_no,omitempty"`
+	Amount        int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"` // 金额（分）
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestRefundRequest) Reset() {
+	*x = RequestRefundRequest{}
+	mi := &file_protos_refund_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestRefundRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestRefundRequest) ProtoMessage() {}
+
+func (x *RequestRefundRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_refund_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestRefundRequest.ProtoReflect.Descriptor instead.
+func (*RequestRefundRequest) Descriptor() ([]byte, []int) {
+	return file_protos_refund_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RequestRefundRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RequestRefundRequest) GetOrderNo() string {
+	if x != nil {
+		return x.OrderNo
+	}
+	return ""
+}
+
+func (x *RequestRefundRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RequestRefundRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// 提交退款申请响应消息
+type RequestRefundResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Refund        *Refund                `protobuf:"bytes,3,opt,name=refund,proto3" json:"refund,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestRefundResponse) Reset() {
+	*x = RequestRefundResponse{}
+	mi := &file_protos_refund_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestRefundResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestRefundResponse) ProtoMessage() {}
+
+func (x *RequestRefundResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_refund_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestRefundResponse.ProtoReflect.Descriptor instead.
+func (*RequestRefundResponse) Descriptor() ([]byte, []int) {
+	return file_protos_refund_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RequestRefundResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *RequestRefundResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RequestRefundResponse) GetRefund() *Refund {
+	if x != nil {
+		return x.Refund
+	}
+	return nil
+}
+
+// 审核通过请求消息，amount 为0表示按申请金额退款
+type ApproveRefundRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RefundId      uint32                 `protobuf:"varint,1,opt,name=refund_id,json=refundId,proto3" json:"refund_id,omitempty"`
+	ReviewerId    uint32                 `protobuf:"varint,2,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	Amount        int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"` // 金额（分）
+	Note          string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveRefundRequest) Reset() {
+	*x = ApproveRefundRequest{}
+	mi := &file_protos_refund_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveRefundRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveRefundRequest) ProtoMessage() {}
+
+func (x *ApproveRefundRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_refund_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveRefundRequest.ProtoReflect.Descriptor instead.
+func (*ApproveRefundRequest) Descriptor() ([]byte, []int) {
+	return file_protos_refund_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ApproveRefundRequest) GetRefundId() uint32 {
+	if x != nil {
+		return x.RefundId
+	}
+	return 0
+}
+
+func (x *ApproveRefundRequest) GetReviewerId() uint32 {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return 0
+}
+
+func (x *ApproveRefundRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ApproveRefundRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+// 审核通过响应消息
+type ApproveRefundResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Refund        *Refund                `protobuf:"bytes,3,opt,name=refund,proto3" json:"refund,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveRefundResponse) Reset() {
+	*x = ApproveRefundResponse{}
+	mi := &file_protos_refund_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveRefundResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveRefundResponse) ProtoMessage() {}
+
+func (x *ApproveRefundResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_refund_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveRefundResponse.ProtoReflect.Descriptor instead.
+func (*ApproveRefundResponse) Descriptor() ([]byte, []int) {
+	return file_protos_refund_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ApproveRefundResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ApproveRefundResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ApproveRefundResponse) GetRefund() *Refund {
+	if x != nil {
+		return x.Refund
+	}
+	return nil
+}
+
+// 拒绝退款请求消息
+type RejectRefundRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RefundId      uint32                 `protobuf:"varint,1,opt,name=refund_id,json=refundId,proto3" json:"refund_id,omitempty"`
+	ReviewerId    uint32                 `protobuf:"varint,2,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	Note          string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectRefundRequest) Reset() {
+	*x = RejectRefundRequest{}
+	mi := &file_protos_refund_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectRefundRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectRefundRequest) ProtoMessage() {}
+
+func (x *RejectRefundRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_refund_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectRefundRequest.ProtoReflect.Descriptor instead.
+func (*RejectRefundRequest) Descriptor() ([]byte, []int) {
+	return file_protos_refund_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RejectRefundRequest) GetRefundId() uint32 {
+	if x != nil {
+		return x.RefundId
+	}
+	return 0
+}
+
+func (x *RejectRefundRequest) GetReviewerId() uint32 {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return 0
+}
+
+func (x *RejectRefundRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+// 拒绝退款响应消息
+type RejectRefundResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Refund        *Refund                `protobuf:"bytes,3,opt,name=refund,proto3" json:"refund,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectRefundResponse) Reset() {
+	*x = RejectRefundResponse{}
+	mi := &file_protos_refund_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectRefundResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectRefundResponse) ProtoMessage() {}
+
+func (x *RejectRefundResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_refund_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectRefundResponse.ProtoReflect.Descriptor instead.
+func (*RejectRefundResponse) Descriptor() ([]byte, []int) {
+	return file_protos_refund_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RejectRefundResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *RejectRefundResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RejectRefundResponse) GetRefund() *Refund {
+	if x != nil {
+		return x.Refund
+	}
+	return nil
+}
+
+// 获取退款详情请求消息
+type GetRefundRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RefundId      uint32                 `protobuf:"varint,1,opt,name=refund_id,json=refundId,proto3" json:"refund_id,omitempty"`
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRefundRequest) Reset() {
+	*x = GetRefundRequest{}
+	mi := &file_protos_refund_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRefundRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRefundRequest) ProtoMessage() {}
+
+func (x *GetRefundRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_refund_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRefundRequest.ProtoReflect.Descriptor instead.
+func (*GetRefundRequest) Descriptor() ([]byte, []int) {
+	return file_protos_refund_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetRefundRequest) GetRefundId() uint32 {
+	if x != nil {
+		return x.RefundId
+	}
+	return 0
+}
+
+func (x *GetRefundRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// 获取退款详情响应消息
+type GetRefundResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Refund        *Refund                `protobuf:"bytes,3,opt,name=refund,proto3" json:"refund,omitempty"`
+	AuditLogs     []*AuditLog            `protobuf:"bytes,4,rep,name=audit_logs,json=auditLogs,proto3" json:"audit_logs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRefundResponse) Reset() {
+	*x = GetRefundResponse{}
+	mi := &file_protos_refund_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRefundResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRefundResponse) ProtoMessage() {}
+
+func (x *GetRefundResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_refund_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRefundResponse.ProtoReflect.Descriptor instead.
+func (*GetRefundResponse) Descriptor() ([]byte, []int) {
+	return file_protos_refund_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetRefundResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *GetRefundResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetRefundResponse) GetRefund() *Refund {
+	if x != nil {
+		return x.Refund
+	}
+	return nil
+}
+
+func (x *GetRefundResponse) GetAuditLogs() []*AuditLog {
+	if x != nil {
+		return x.AuditLogs
+	}
+	return nil
+}
+
+// 获取本人退款申请请求消息
+type ListMyRefundsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyRefundsRequest) Reset() {
+	*x = ListMyRefundsRequest{}
+	mi := &file_protos_refund_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyRefundsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyRefundsRequest) ProtoMessage() {}
+
+func (x *ListMyRefundsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_refund_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyRefundsRequest.ProtoReflect.Descriptor instead.
+func (*ListMyRefundsRequest) Descriptor() ([]byte, []int) {
+	return file_protos_refund_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListMyRefundsRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// 获取本人退款申请响应消息
+type ListMyRefundsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Refunds       []*Refund              `protobuf:"bytes,3,rep,name=refunds,proto3" json:"refunds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyRefundsResponse) Reset() {
+	*x = ListMyRefundsResponse{}
+	mi := &file_protos_refund_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyRefundsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyRefundsResponse) ProtoMessage() {}
+
+func (x *ListMyRefundsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_refund_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyRefundsResponse.ProtoReflect.Descriptor instead.
+func (*ListMyRefundsResponse) Descriptor() ([]byte, []int) {
+	return file_protos_refund_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListMyRefundsResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListMyRefundsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListMyRefundsResponse) GetRefunds() []*Refund {
+	if x != nil {
+		return x.Refunds
+	}
+	return nil
+}
+
+// 获取退款审核列表请求消息，status 为空时返回全部状态
+type ListRefundQueueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReviewerId    uint32                 `protobuf:"varint,1,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRefundQueueRequest) Reset() {
+	*x = ListRefundQueueRequest{}
+	mi := &file_protos_refund_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRefundQueueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRefundQueueRequest) ProtoMessage() {}
+
+func (x *ListRefundQueueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_refund_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRefundQueueRequest.ProtoReflect.Descriptor instead.
+func (*ListRefundQueueRequest) Descriptor() ([]byte, []int) {
+	return file_protos_refund_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListRefundQueueRequest) GetReviewerId() uint32 {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return 0
+}
+
+func (x *ListRefundQueueRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// 获取退款审核列表响应消息
+type ListRefundQueueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Refunds       []*Refund              `protobuf:"bytes,3,rep,name=refunds,proto3" json:"refunds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRefundQueueResponse) Reset() {
+	*x = ListRefundQueueResponse{}
+	mi := &file_protos_refund_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRefundQueueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRefundQueueResponse) ProtoMessage() {}
+
+func (x *ListRefundQueueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_refund_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRefundQueueResponse.ProtoReflect.Descriptor instead.
+func (*ListRefundQueueResponse) Descriptor() ([]byte, []int) {
+	return file_protos_refund_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListRefundQueueResponse) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ListRefundQueueResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ListRefundQueueResponse) GetRefunds() []*Refund {
+	if x != nil {
+		return x.Refunds
+	}
+	return nil
+}
+
+// 退款申请模型，金额单位为分
+type Refund struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId         uint32                 `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	OrderNo         string                 `protobuf:"bytes,3,opt,name=order_no,json=orderNo,proto3" json:"order_no,omitempty"`
+	UserId          uint32                 `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CourseId        uint32                 `protobuf:"varint,5,opt,name=course_id,json=courseId,proto3" json:"course_id,omitempty"`
+	OrderAmount     int64                  `protobuf:"varint,6,opt,name=order_amount,json=orderAmount,proto3" json:"order_amount,omitempty"`
+	RequestedAmount int64                  `protobuf:"varint,7,opt,name=requested_amount,json=requestedAmount,proto3" json:"requested_amount,omitempty"`
+	ApprovedAmount  int64                  `protobuf:"varint,8,opt,name=approved_amount,json=approvedAmount,proto3" json:"approved_amount,omitempty"`
+	Reason          string                 `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason,omitempty"`
+	ProgressPercent int32                  `protobuf:"varint,10,opt,name=progress_percent,json=progressPercent,proto3" json:"progress_percent,omitempty"`
+	Status          string                 `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"` // pending/approved/refunded/rejected
+	ReviewerId      uint32                 `protobuf:"varint,12,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	ReviewNote      string                 `protobuf:"bytes,13,opt,name=review_note,json=reviewNote,proto3" json:"review_note,omitempty"`
+	ReviewedAt      string                 `protobuf:"bytes,14,opt,name=reviewed_at,json=reviewedAt,proto3" json:"reviewed_at,omitempty"`
+	RefundedAt      string                 `protobuf:"bytes,15,opt,name=refunded_at,json=refundedAt,proto3" json:"refunded_at,omitempty"`
+	CreatedAt       string                 `protobuf:"bytes,16,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	BundleId        uint32                 `protobuf:"varint,17,opt,name=bundle_id,json=bundleId,proto3" json:"bundle_id,omitempty"` // 套餐订单的套餐ID
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Refund) Reset() {
+	*x = Refund{}
+	mi := &file_protos_refund_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Refund) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Refund) ProtoMessage() {}
+
+func (x *Refund) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_refund_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Refund.ProtoReflect.Descriptor instead.
+func (*Refund) Descriptor() ([]byte, []int) {
+	return file_protos_refund_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Refund) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Refund) GetOrderId() uint32 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *Refund) GetOrderNo() string {
+	if x != nil {
+		return x.OrderNo
+	}
+	return ""
+}
+
+func (x *Refund) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Refund) GetCourseId() uint32 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *Refund) GetOrderAmount() int64 {
+	if x != nil {
+		return x.OrderAmount
+	}
+	return 0
+}
+
+func (x *Refund) GetRequestedAmount() int64 {
+	if x != nil {
+		return x.RequestedAmount
+	}
+	return 0
+}
+
+func (x *Refund) GetApprovedAmount() int64 {
+	if x != nil {
+		return x.ApprovedAmount
+	}
+	return 0
+}
+
+func (x *Refund) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Refund) GetProgressPercent() int32 {
+	if x != nil {
+		return x.ProgressPercent
+	}
+	return 0
+}
+
+func (x *Refund) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Refund) GetReviewerId() uint32 {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return 0
+}
+
+func (x *Refund) GetReviewNote() string {
+	if x != nil {
+		return x.ReviewNote
+	}
+	return ""
+}
+
+func (x *Refund) GetReviewedAt() string {
+	if x != nil {
+		return x.ReviewedAt
+	}
+	return ""
+}
+
+func (x *Refund) GetRefundedAt() string {
+	if x != nil {
+		return x.RefundedAt
+	}
+	return ""
+}
+
+func (x *Refund) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Refund) GetBundleId() uint32 {
+	if x != nil {
+		return x.BundleId
+	}
+	return 0
+}
+
+// 退款审计记录
+type AuditLog struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ActorId       uint32                 `protobuf:"varint,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"` // 0表示系统操作
+	Action        string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	Detail        string                 `protobuf:"bytes,4,opt,name=detail,proto3" json:"detail,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditLog) Reset() {
+	*x = AuditLog{}
+	mi := &file_protos_refund_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLog) ProtoMessage() {}
+
+func (x *AuditLog) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_refund_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLog.ProtoReflect.Descriptor instead.
+func (*AuditLog) Descriptor() ([]byte, []int) {
+	return file_protos_refund_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AuditLog) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AuditLog) GetActorId() uint32 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *AuditLog) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditLog) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *AuditLog) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+var File_protos_refund_proto protoreflect.FileDescriptor
+
+const file_protos_refund_proto_rawDesc = "" +
+	"\n" +
+	"\x13protos/refund.proto\x12\x06refund\"z\n" +
+	"\x14RequestRefundRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\x12\x19\n" +
+	"\border_no\x18\x02 \x01(\tR\aorderNo\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x03R\x06amount\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"m\n" +
+	"\x15RequestRefundResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12&\n" +
+	"\x06refund\x18\x03 \x01(\v2\x0e.refund.RefundR\x06refund\"\x80\x01\n" +
+	"\x14ApproveRefundRequest\x12\x1b\n" +
+	"\trefund_id\x18\x01 \x01(\rR\brefundId\x12\x1f\n" +
+	"\vreviewer_id\x18\x02 \x01(\rR\n" +
+	"reviewerId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x03R\x06amount\x12\x12\n" +
+	"\x04note\x18\x04 \x01(\tR\x04note\"m\n" +
+	"\x15ApproveRefundResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12&\n" +
+	"\x06refund\x18\x03 \x01(\v2\x0e.refund.RefundR\x06refund\"g\n" +
+	"\x13RejectRefundRequest\x12\x1b\n" +
+	"\trefund_id\x18\x01 \x01(\rR\brefundId\x12\x1f\n" +
+	"\vreviewer_id\x18\x02 \x01(\rR\n" +
+	"reviewerId\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\"l\n" +
+	"\x14RejectRefundResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12&\n" +
+	"\x06refund\x18\x03 \x01(\v2\x0e.refund.RefundR\x06refund\"H\n" +
+	"\x10GetRefundRequest\x12\x1b\n" +
+	"\trefund_id\x18\x01 \x01(\rR\brefundId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\rR\x06userId\"\x9a\x01\n" +
+	"\x11GetRefundResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12&\n" +
+	"\x06refund\x18\x03 \x01(\v2\x0e.refund.RefundR\x06refund\x12/\n" +
+	"\n" +
+	"audit_logs\x18\x04 \x03(\v2\x10.refund.AuditLogR\tauditLogs\"/\n" +
+	"\x14ListMyRefundsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\"o\n" +
+	"\x15ListMyRefundsResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12(\n" +
+	"\arefunds\x18\x03 \x03(\v2\x0e.refund.RefundR\arefunds\"Q\n" +
+	"\x16ListRefundQueueRequest\x12\x1f\n" +
+	"\vreviewer_id\x18\x01 \x01(\rR\n" +
+	"reviewerId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"q\n" +
+	"\x17ListRefundQueueResponse\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12(\n" +
+	"\arefunds\x18\x03 \x03(\v2\x0e.refund.RefundR\arefunds\"\x96\x04\n" +
+	"\x06Refund\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\rR\aorderId\x12\x19\n" +
+	"\border_no\x18\x03 \x01(\tR\aorderNo\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\rR\x06userId\x12\x1b\n" +
+	"\tcourse_id\x18\x05 \x01(\rR\bcourseId\x12!\n" +
+	"\forder_amount\x18\x06 \x01(\x03R\vorderAmount\x12)\n" +
+	"\x10requested_amount\x18\a \x01(\x03R\x0frequestedAmount\x12'\n" +
+	"\x0fapproved_amount\x18\b \x01(\x03R\x0eapprovedAmount\x12\x16\n" +
+	"\x06reason\x18\t \x01(\tR\x06reason\x12)\n" +
+	"\x10progress_percent\x18\n" +
+	" \x01(\x05R\x0fprogressPercent\x12\x16\n" +
+	"\x06status\x18\v \x01(\tR\x06status\x12\x1f\n" +
+	"\vreviewer_id\x18\f \x01(\rR\n" +
+	"reviewerId\x12\x1f\n" +
+	"\vreview_note\x18\r \x01(\tR\n" +
+	"reviewNote\x12\x1f\n" +
+	"\vreviewed_at\x18\x0e \x01(\tR\n" +
+	"reviewedAt\x12\x1f\n" +
+	"\vrefunded_at\x18\x0f \x01(\tR\n" +
+	"refundedAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x10 \x01(\tR\tcreatedAt\x12\x1b\n" +
+	"\tbundle_id\x18\x11 \x01(\rR\bbundleId\"\x84\x01\n" +
+	"\bAuditLog\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x19\n" +
+	"\bactor_id\x18\x02 \x01(\rR\aactorId\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\x12\x16\n" +
+	"\x06detail\x18\x04 \x01(\tR\x06detail\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt2\xda\x03\n" +
+	"\rRefundService\x12L\n" +
+	"\rRequestRefund\x12\x1c.refund.RequestRefundRequest\x1a\x1d.refund.RequestRefundResponse\x12L\n" +
+	"\rApproveRefund\x12\x1c.refund.ApproveRefundRequest\x1a\x1d.refund.ApproveRefundResponse\x12I\n" +
+	"\fRejectRefund\x12\x1b.refund.RejectRefundRequest\x1a\x1c.refund.RejectRefundResponse\x12@\n" +
+	"\tGetRefund\x12\x18.refund.GetRefundRequest\x1a\x19.refund.GetRefundResponse\x12L\n" +
+	"\rListMyRefunds\x12\x1c.refund.ListMyRefundsRequest\x1a\x1d.refund.ListMyRefundsResponse\x12R\n" +
+	"\x0fListRefundQueue\x12\x1e.refund.ListRefundQueueRequest\x1a\x1f.refund.ListRefundQueueResponseB-Z+course-platform/internal/shared/pb/refundpbb\x06proto3"
+
+var (
+	file_protos_refund_proto_rawDescOnce sync.Once
+	file_protos_refund_proto_rawDescData []byte
+)
+
+func file_protos_refund_proto_rawDescGZIP() []byte {
+	file_protos_refund_proto_rawDescOnce.Do(func() {
+		file_protos_refund_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_protos_refund_proto_rawDesc), len(file_protos_refund_proto_rawDesc)))
+	})
+	return file_protos_refund_proto_rawDescData
+}
+
+var file_protos_refund_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_protos_refund_proto_goTypes = []any{
+	(*RequestRefundRequest)(nil),    // 0: refund.RequestRefundRequest
+	(*RequestRefundResponse)(nil),   // 1: refund.RequestRefundResponse
+	(*ApproveRefundRequest)(nil),    // 2: refund.ApproveRefundRequest
+	(*ApproveRefundResponse)(nil),   // 3: refund.ApproveRefundResponse
+	(*RejectRefundRequest)(nil),     // 4: refund.RejectRefundRequest
+	(*RejectRefundResponse)(nil),    // 5: refund.RejectRefundResponse
+	(*GetRefundRequest)(nil),        // 6: refund.GetRefundRequest
+	(*GetRefundResponse)(nil),       // 7: refund.GetRefundResponse
+	(*ListMyRefundsRequest)(nil),    // 8: refund.ListMyRefundsRequest
+	(*ListMyRefundsResponse)(nil),   // 9: refund.ListMyRefundsResponse
+	(*ListRefundQueueRequest)(nil),  // 10: refund.ListRefundQueueRequest
+	(*ListRefundQueueResponse)(nil), // 11: refund.ListRefundQueueResponse
+	(*Refund)(nil),                  // 12: refund.Refund
+	(*AuditLog)(nil),                // 13: refund.AuditLog
+}
+var file_protos_refund_proto_depIdxs = []int32{
+	12, // 0: refund.RequestRefundResponse.refund:type_name -> refund.Refund
+	12, // 1: refund.ApproveRefundResponse.refund:type_name -> refund.Refund
+	12, // 2: refund.RejectRefundResponse.refund:type_name -> refund.Refund
+	12, // 3: refund.GetRefundResponse.refund:type_name -> refund.Refund
+	13, // 4: refund.GetRefundResponse.audit_logs:type_name -> refund.AuditLog
+	12, // 5: refund.ListMyRefundsResponse.refunds:type_name -> refund.Refund
+	12, // 6: refund.ListRefundQueueResponse.refunds:type_name -> refund.Refund
+	0,  // 7: refund.RefundService.RequestRefund:input_type -> refund.RequestRefundRequest
+	2,  // 8: refund.RefundService.ApproveRefund:input_type -> refund.ApproveRefundRequest
+	4,  // 9: refund.RefundService.RejectRefund:input_type -> refund.RejectRefundRequest
+	6,  // 10: refund.RefundService.GetRefund:input_type -> refund.GetRefundRequest
+	8,  // 11: refund.RefundService.ListMyRefunds:input_type -> refund.ListMyRefundsRequest
+	10, // 12: refund.RefundService.ListRefundQueue:input_type -> refund.ListRefundQueueRequest
+	1,  // 13: refund.RefundService.RequestRefund:output_type -> refund.RequestRefundResponse
+	3,  // 14: refund.RefundService.ApproveRefund:output_type -> refund.ApproveRefundResponse
+	5,  // 15: refund.RefundService.RejectRefund:output_type -> refund.RejectRefundResponse
+	7,  // 16: refund.RefundService.GetRefund:output_type -> refund.GetRefundResponse
+	9,  // 17: refund.RefundService.ListMyRefunds:output_type -> refund.ListMyRefundsResponse
+	11, // 18: refund.RefundService.ListRefundQueue:output_type -> refund.ListRefundQueueResponse
+	13, // [13:19] is the sub-list for method output_type
+	7,  // [7:13] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_protos_refund_proto_init() }
+func file_protos_refund_proto_init() {
+	if File_protos_refund_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_protos_refund_proto_rawDesc), len(file_protos_refund_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_protos_refund_proto_goTypes,
+		DependencyIndexes: file_protos_refund_proto_depIdxs,
+		MessageInfos:      file_protos_refund_proto_msgTypes,
+	}.Build()
+	File_protos_refund_proto = out.File
+	file_protos_refund_proto_goTypes = nil
+	file_protos_refund_proto_depIdxs = nil
+}
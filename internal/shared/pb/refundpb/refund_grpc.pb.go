@@ -0,0 +1,327 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v6.31.1
+// source: protos/refund.proto
+
+package refundpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	RefundService_RequestRefund_FullMethodName   = "/refund.RefundService/RequestRefund"
+	RefundService_ApproveRefund_FullMethodName   = "/refund.RefundService/ApproveRefund"
+	RefundService_RejectRefund_FullMethodName    = "/refund.RefundService/RejectRefund"
+	RefundService_GetRefund_FullMethodName       = "/refund.RefundService/GetRefund"
+	RefundService_ListMyRefunds_FullMethodName   = "/refund.RefundService/ListMyRefunds"
+	RefundService_ListRefundQueue_FullMethodName = "/refund.RefundService/ListRefundQueue"
+)
+
+// RefundServiceClient is the client API for RefundService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 退款服务定义
+type RefundServiceClient interface {
+	// 提交退款申请（学员）
+	RequestRefund(ctx context.Context, in *RequestRefundRequest, opts ...grpc.CallOption) (*RequestRefundResponse, error)
+	// 审核通过并执行退款（课程讲师或平台管理员）
+	ApproveRefund(ctx context.Context, in *ApproveRefundRequest, opts ...grpc.CallOption) (*ApproveRefundResponse, error)
+	// 拒绝退款申请（课程讲师或平台管理员）
+	RejectRefund(ctx context.Context, in *RejectRefundRequest, opts ...grpc.CallOption) (*RejectRefundResponse, error)
+	// 获取退款申请详情及审计记录
+	GetRefund(ctx context.Context, in *GetRefundRequest, opts ...grpc.CallOption) (*GetRefundResponse, error)
+	// 获取本人的退款申请
+	ListMyRefunds(ctx context.Context, in *ListMyRefundsRequest, opts ...grpc.CallOption) (*ListMyRefundsResponse, error)
+	// 获取退款审核列表
+	ListRefundQueue(ctx context.Context, in *ListRefundQueueRequest, opts ...grpc.CallOption) (*ListRefundQueueResponse, error)
+}
+
+type refundServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRefundServiceClient(cc grpc.ClientConnInterface) RefundServiceClient {
+	return &refundServiceClient{cc}
+}
+
+func (c *refundServiceClient) RequestRefund(ctx context.Context, in *RequestRefundRequest, opts ...grpc.CallOption) (*RequestRefundResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestRefundResponse)
+	err := c.cc.Invoke(ctx, RefundService_RequestRefund_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *refundServiceClient) ApproveRefund(ctx context.Context, in *ApproveRefundRequest, opts ...grpc.CallOption) (*ApproveRefundResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveRefundResponse)
+	err := c.cc.Invoke(ctx, RefundService_ApproveRefund_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *refundServiceClient) RejectRefund(ctx context.Context, in *RejectRefundRequest, opts ...grpc.CallOption) (*RejectRefundResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RejectRefundResponse)
+	err := c.cc.Invoke(ctx, RefundService_RejectRefund_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *refundServiceClient) GetRefund(ctx context.Context, in *GetRefundRequest, opts ...grpc.CallOption) (*GetRefundResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRefundResponse)
+	err := c.cc.Invoke(ctx, RefundService_GetRefund_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *refundServiceClient) ListMyRefunds(ctx context.Context, in *ListMyRefundsRequest, opts ...grpc.CallOption) (*ListMyRefundsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMyRefundsResponse)
+	err := c.cc.Invoke(ctx, RefundService_ListMyRefunds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *refundServiceClient) ListRefundQueue(ctx context.Context, in *ListRefundQueueRequest, opts ...grpc.CallOption) (*ListRefundQueueResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRefundQueueResponse)
+	err := c.cc.Invoke(ctx, RefundService_ListRefundQueue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RefundServiceServer is the server API for RefundService service.
+// All implementations must embed UnimplementedRefundServiceServer
+// for forward compatibility.
+//
+// 退款服务定义
+type RefundServiceServer interface {
+	// 提交退款申请（学员）
+	RequestRefund(context.Context, *RequestRefundRequest) (*RequestRefundResponse, error)
+	// 审核通过并执行退款（课程讲师或平台管理员）
+	ApproveRefund(context.Context, *ApproveRefundRequest) (*ApproveRefundResponse, error)
+	// 拒绝退款申请（课程讲师或平台管理员）
+	RejectRefund(context.Context, *RejectRefundRequest) (*RejectRefundResponse, error)
+	// 获取退款申请详情及审计记录
+	GetRefund(context.Context, *GetRefundRequest) (*GetRefundResponse, error)
+	// 获取本人的退款申请
+	ListMyRefunds(context.Context, *ListMyRefundsRequest) (*ListMyRefundsResponse, error)
+	// 获取退款审核列表
+	ListRefundQueue(context.Context, *ListRefundQueueRequest) (*ListRefundQueueResponse, error)
+	mustEmbedUnimplementedRefundServiceServer()
+}
+
+// UnimplementedRefundServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRefundServiceServer struct{}
+
+func (UnimplementedRefundServiceServer) RequestRefund(context.Context, *RequestRefundRequest) (*RequestRefundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestRefund not implemented")
+}
+func (UnimplementedRefundServiceServer) ApproveRefund(context.Context, *ApproveRefundRequest) (*ApproveRefundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveRefund not implemented")
+}
+func (UnimplementedRefundServiceServer) RejectRefund(context.Context, *RejectRefundRequest) (*RejectRefundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectRefund not implemented")
+}
+func (UnimplementedRefundServiceServer) GetRefund(context.Context, *GetRefundRequest) (*GetRefundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRefund not implemented")
+}
+func (UnimplementedRefundServiceServer) ListMyRefunds(context.Context, *ListMyRefundsRequest) (*ListMyRefundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyRefunds not implemented")
+}
+func (UnimplementedRefundServiceServer) ListRefundQueue(context.Context, *ListRefundQueueRequest) (*ListRefundQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRefundQueue not implemented")
+}
+func (UnimplementedRefundServiceServer) mustEmbedUnimplementedRefundServiceServer() {}
+func (UnimplementedRefundServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeRefundServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RefundServiceServer will
+// result in compilation errors.
+type UnsafeRefundServiceServer interface {
+	mustEmbedUnimplementedRefundServiceServer()
+}
+
+func RegisterRefundServiceServer(s grpc.ServiceRegistrar, srv RefundServiceServer) {
+	// If the following call pancis, it indicates UnimplementedRefundServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RefundService_ServiceDesc, srv)
+}
+
+func _RefundService_RequestRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestRefundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RefundServiceServer).RequestRefund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RefundService_RequestRefund_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RefundServiceServer).RequestRefund(ctx, req.(*RequestRefundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RefundService_ApproveRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveRefundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RefundServiceServer).ApproveRefund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RefundService_ApproveRefund_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RefundServiceServer).ApproveRefund(ctx, req.(*ApproveRefundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RefundService_RejectRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectRefundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RefundServiceServer).RejectRefund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RefundService_RejectRefund_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RefundServiceServer).RejectRefund(ctx, req.(*RejectRefundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RefundService_GetRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRefundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RefundServiceServer).GetRefund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RefundService_GetRefund_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RefundServiceServer).GetRefund(ctx, req.(*GetRefundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RefundService_ListMyRefunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMyRefundsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RefundServiceServer).ListMyRefunds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RefundService_ListMyRefunds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RefundServiceServer).ListMyRefunds(ctx, req.(*ListMyRefundsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RefundService_ListRefundQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRefundQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RefundServiceServer).ListRefundQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RefundService_ListRefundQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RefundServiceServer).ListRefundQueue(ctx, req.(*ListRefundQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RefundService_ServiceDesc is the grpc.ServiceDesc for RefundService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RefundService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "refund.RefundService",
+	HandlerType: (*RefundServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RequestRefund",
+			Handler:    _RefundService_RequestRefund_Handler,
+		},
+		{
+			MethodName: "ApproveRefund",
+			Handler:    _RefundService_ApproveRefund_Handler,
+		},
+		{
+			MethodName: "RejectRefund",
+			Handler:    _RefundService_RejectRefund_Handler,
+		},
+		{
+			MethodName: "GetRefund",
+			Handler:    _RefundService_GetRefund_Handler,
+		},
+		{
+			MethodName: "ListMyRefunds",
+			Handler:    _RefundService_ListMyRefunds_Handler,
+		},
+		{
+			MethodName: "ListRefundQueue",
+			Handler:    _RefundService_ListRefundQueue_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "protos/refund.proto",
+}
@@ -0,0 +1,27 @@
+package utils
+
+import (
+	"strings"
+)
+
+// 支持的界面和邮件语言
+const (
+	LocaleZhCN    = "zh-CN"
+	LocaleEn      = "en"
+	DefaultLocale = LocaleZhCN
+)
+
+// NormalizeLocale 将语言标签（如 Accept-Language 的值）归一化为支持的语言，无法识别时返回默认语言
+func NormalizeLocale(tag string) string {
+	// Accept-Language 可能包含多个候选，按顺序取第一个能识别的
+	for _, part := range strings.Split(tag, ",") {
+		lang := strings.ToLower(strings.TrimSpace(strings.SplitN(part, ";", 2)[0]))
+		switch {
+		case strings.HasPrefix(lang, "zh"):
+			return LocaleZhCN
+		case strings.HasPrefix(lang, "en"):
+			return LocaleEn
+		}
+	}
+	return DefaultLocale
+}
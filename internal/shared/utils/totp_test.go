@@ -0,0 +1,98 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// RFC 6238 附录B 的 SHA1 测试密钥 "12345678901234567890"
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestTOTPCodeRFCVectors(t *testing.T) {
+	key, err := totpEncoding.DecodeString(rfcSecret)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// RFC 给出8位验证码，6位验证码取其后6位
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	}
+	for _, tt := range tests {
+		if got := totpCode(key, tt.unix/TOTPPeriod); got != tt.want {
+			t.Errorf("totpCode(T=%d) = %s, want %s", tt.unix, got, tt.want)
+		}
+	}
+}
+
+func TestValidateTOTPWindow(t *testing.T) {
+	key, err := totpEncoding.DecodeString(rfcSecret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Unix(1111111111, 0)
+	current := now.Unix() / TOTPPeriod
+
+	tests := []struct {
+		name     string
+		secret   string
+		code     string
+		wantStep int64
+		wantOK   bool
+	}{
+		{"当前时间步", rfcSecret, totpCode(key, current), current, true},
+		{"前一个时间步", rfcSecret, totpCode(key, current-1), current - 1, true},
+		{"后一个时间步", rfcSecret, totpCode(key, current+1), current + 1, true},
+		{"超出前向窗口", rfcSecret, totpCode(key, current-2), 0, false},
+		{"超出后向窗口", rfcSecret, totpCode(key, current+2), 0, false},
+		{"首尾空白", rfcSecret, " " + totpCode(key, current) + " ", current, true},
+		{"小写密钥", strings.ToLower(rfcSecret), totpCode(key, current), current, true},
+		{"位数不足", rfcSecret, "12345", 0, false},
+		{"位数过多", rfcSecret, "1234567", 0, false},
+		{"非法密钥", "not-base32!", totpCode(key, current), 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			step, ok := ValidateTOTP(tt.secret, tt.code, now)
+			if ok != tt.wantOK || step != tt.wantStep {
+				t.Errorf("ValidateTOTP(%q) = (%d, %v), want (%d, %v)", tt.code, step, ok, tt.wantStep, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestGenerateTOTPSecret(t *testing.T) {
+	secret, err := GenerateTOTPSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := totpEncoding.DecodeString(secret)
+	if err != nil {
+		t.Fatalf("密钥不是有效的 base32: %v", err)
+	}
+	if len(key) != 20 {
+		t.Errorf("密钥长度 = %d 字节, want 20", len(key))
+	}
+
+	code := totpCode(key, time.Now().Unix()/TOTPPeriod)
+	if _, ok := ValidateTOTP(secret, code, time.Now()); !ok {
+		t.Error("新生成的密钥无法通过校验")
+	}
+}
+
+func TestTOTPProvisioningURI(t *testing.T) {
+	got := TOTPProvisioningURI("Course Platform", "alice@example.com", rfcSecret)
+	want := "otpauth://totp/Course%20Platform:alice@example.com?algorithm=SHA1&digits=6&issuer=Course%20Platform&period=30&secret=" + rfcSecret
+	if got != want {
+		t.Errorf("TOTPProvisioningURI() =\n%s\nwant\n%s", got, want)
+	}
+}
@@ -0,0 +1,126 @@
+package grpc
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"course-platform/internal/domain/announcement/model"
+	"course-platform/internal/domain/announcement/service"
+	"course-platform/internal/shared/pb/announcementpb"
+)
+
+// AnnouncementHandler 课程公告gRPC处理器
+type AnnouncementHandler struct {
+	announcementpb.UnimplementedAnnouncementServiceServer
+	announcementService service.AnnouncementServiceInterface
+}
+
+// NewAnnouncementHandler 创建课程公告gRPC处理器实例
+func NewAnnouncementHandler(announcementService service.AnnouncementServiceInterface) *AnnouncementHandler {
+	return &AnnouncementHandler{
+		announcementService: announcementService,
+	}
+}
+
+// CreateAnnouncement 处理发布公告gRPC请求
+func (h *AnnouncementHandler) CreateAnnouncement(ctx context.Context, req *announcementpb.CreateAnnouncementRequest) (*announcementpb.CreateAnnouncementResponse, error) {
+	log.Printf("🔍 gRPC: 收到发布公告请求 - 课程ID: %d, 标题: %s", req.CourseId, req.Title)
+
+	announcement, err := h.announcementService.CreateAnnouncement(uint(req.CourseId), uint(req.UserId), req.Title, req.Body)
+	if err != nil {
+		log.Printf("❌ gRPC: 发布公告失败 - %v", err)
+		return &announcementpb.CreateAnnouncementResponse{
+			Code:    announcementErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &announcementpb.CreateAnnouncementResponse{
+		Code:         200,
+		Message:      "公告发布成功",
+		Announcement: convertAnnouncementToPB(announcement),
+	}, nil
+}
+
+// ListAnnouncements 处理公告列表gRPC请求
+func (h *AnnouncementHandler) ListAnnouncements(ctx context.Context, req *announcementpb.ListAnnouncementsRequest) (*announcementpb.ListAnnouncementsResponse, error) {
+	announcements, total, err := h.announcementService.ListAnnouncements(uint(req.CourseId), uint(req.UserId), int(req.Page), int(req.PageSize))
+	if err != nil {
+		log.Printf("❌ gRPC: 获取公告列表失败 - %v", err)
+		return &announcementpb.ListAnnouncementsResponse{
+			Code:    announcementErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	pbAnnouncements := make([]*announcementpb.Announcement, 0, len(announcements))
+	for _, announcement := range announcements {
+		pbAnnouncements = append(pbAnnouncements, convertAnnouncementToPB(announcement))
+	}
+	return &announcementpb.ListAnnouncementsResponse{
+		Code:          200,
+		Message:       "获取成功",
+		Announcements: pbAnnouncements,
+		Total:         total,
+	}, nil
+}
+
+// MarkAnnouncementRead 处理标记公告已读gRPC请求
+func (h *AnnouncementHandler) MarkAnnouncementRead(ctx context.Context, req *announcementpb.MarkAnnouncementReadRequest) (*announcementpb.MarkAnnouncementReadResponse, error) {
+	if err := h.announcementService.MarkRead(uint(req.AnnouncementId), uint(req.UserId)); err != nil {
+		log.Printf("❌ gRPC: 标记公告已读失败 - %v", err)
+		return &announcementpb.MarkAnnouncementReadResponse{
+			Code:    announcementErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &announcementpb.MarkAnnouncementReadResponse{
+		Code:    200,
+		Message: "已标记为已读",
+	}, nil
+}
+
+// DeleteAnnouncement 处理删除公告gRPC请求
+func (h *AnnouncementHandler) DeleteAnnouncement(ctx context.Context, req *announcementpb.DeleteAnnouncementRequest) (*announcementpb.DeleteAnnouncementResponse, error) {
+	if err := h.announcementService.DeleteAnnouncement(uint(req.AnnouncementId), uint(req.UserId)); err != nil {
+		log.Printf("❌ gRPC: 删除公告失败 - %v", err)
+		return &announcementpb.DeleteAnnouncementResponse{
+			Code:    announcementErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &announcementpb.DeleteAnnouncementResponse{
+		Code:    200,
+		Message: "公告已删除",
+	}, nil
+}
+
+// announcementErrorCode 根据错误信息映射业务码
+func announcementErrorCode(err error) int32 {
+	msg := err.Error()
+	switch {
+	case strings.Contains(msg, "无权"):
+		return 403
+	case strings.Contains(msg, "不存在"):
+		return 404
+	default:
+		return 400
+	}
+}
+
+// convertAnnouncementToPB 将公告模型转换为protobuf对象
+func convertAnnouncementToPB(announcement *model.Announcement) *announcementpb.Announcement {
+	return &announcementpb.Announcement{
+		Id:             uint32(announcement.ID),
+		CourseId:       uint32(announcement.CourseID),
+		AuthorId:       uint32(announcement.AuthorID),
+		Title:          announcement.Title,
+		Body:           announcement.Body,
+		RecipientCount: int32(announcement.RecipientCount),
+		Unread:         announcement.Unread,
+		CreatedAt:      announcement.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
@@ -0,0 +1,321 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"course-platform/internal/domain/assignment/model"
+	"course-platform/internal/domain/assignment/service"
+	contentModel "course-platform/internal/domain/content/model"
+	"course-platform/internal/shared/pb/assignmentpb"
+)
+
+// AssignmentHandler 作业gRPC处理器
+type AssignmentHandler struct {
+	assignmentpb.UnimplementedAssignmentServiceServer
+	assignmentService service.AssignmentServiceInterface
+}
+
+// NewAssignmentHandler 创建作业gRPC处理器实例
+func NewAssignmentHandler(assignmentService service.AssignmentServiceInterface) *AssignmentHandler {
+	return &AssignmentHandler{
+		assignmentService: assignmentService,
+	}
+}
+
+// CreateAssignment 处理创建作业gRPC请求
+func (h *AssignmentHandler) CreateAssignment(ctx context.Context, req *assignmentpb.CreateAssignmentRequest) (*assignmentpb.CreateAssignmentResponse, error) {
+	log.Printf("🔍 gRPC: 收到创建作业请求 - 课程ID: %d, 标题: %s", req.CourseId, req.Title)
+
+	dueAt, err := time.Parse(time.RFC3339, req.DueAt)
+	if err != nil {
+		return &assignmentpb.CreateAssignmentResponse{
+			Code:    400,
+			Message: "截止时间格式错误，应为RFC3339格式",
+		}, nil
+	}
+
+	rubric := make([]model.RubricCriterion, len(req.Rubric))
+	for i, c := range req.Rubric {
+		rubric[i] = model.RubricCriterion{
+			Name:        c.Name,
+			Description: c.Description,
+			MaxScore:    int(c.MaxScore),
+		}
+	}
+
+	assignment, err := h.assignmentService.CreateAssignment(&service.CreateAssignmentRequest{
+		CourseID:           uint(req.CourseId),
+		ChapterID:          uint(req.ChapterId),
+		UserID:             uint(req.UserId),
+		Title:              req.Title,
+		Description:        req.Description,
+		DueAt:              dueAt,
+		LatePolicy:         req.LatePolicy,
+		LatePenaltyPercent: int(req.LatePenaltyPercent),
+		LateCutoffHours:    int(req.LateCutoffHours),
+		Rubric:             rubric,
+	})
+	if err != nil {
+		log.Printf("❌ gRPC: 创建作业失败 - %v", err)
+		return &assignmentpb.CreateAssignmentResponse{
+			Code:    assignmentErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &assignmentpb.CreateAssignmentResponse{
+		Code:       200,
+		Message:    "作业创建成功",
+		Assignment: convertAssignmentToPB(assignment),
+	}, nil
+}
+
+// GetAssignment 处理获取作业详情gRPC请求
+func (h *AssignmentHandler) GetAssignment(ctx context.Context, req *assignmentpb.GetAssignmentRequest) (*assignmentpb.GetAssignmentResponse, error) {
+	assignment, err := h.assignmentService.GetAssignment(uint(req.AssignmentId))
+	if err != nil {
+		return &assignmentpb.GetAssignmentResponse{
+			Code:    assignmentErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &assignmentpb.GetAssignmentResponse{
+		Code:       200,
+		Message:    "获取成功",
+		Assignment: convertAssignmentToPB(assignment),
+	}, nil
+}
+
+// ListAssignments 处理获取作业列表gRPC请求
+func (h *AssignmentHandler) ListAssignments(ctx context.Context, req *assignmentpb.ListAssignmentsRequest) (*assignmentpb.ListAssignmentsResponse, error) {
+	assignments, err := h.assignmentService.ListAssignments(uint(req.CourseId))
+	if err != nil {
+		log.Printf("❌ gRPC: 获取作业列表失败 - %v", err)
+		return &assignmentpb.ListAssignmentsResponse{
+			Code:    assignmentErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	pbAssignments := make([]*assignmentpb.Assignment, len(assignments))
+	for i, a := range assignments {
+		pbAssignments[i] = convertAssignmentToPB(a)
+	}
+
+	return &assignmentpb.ListAssignmentsResponse{
+		Code:        200,
+		Message:     "获取成功",
+		Assignments: pbAssignments,
+	}, nil
+}
+
+// SubmitAssignment 处理提交作业gRPC请求
+func (h *AssignmentHandler) SubmitAssignment(ctx context.Context, req *assignmentpb.SubmitAssignmentRequest) (*assignmentpb.SubmitAssignmentResponse, error) {
+	log.Printf("🔍 gRPC: 收到提交作业请求 - 作业ID: %d, 用户ID: %d", req.AssignmentId, req.UserId)
+
+	submission, assignment, err := h.assignmentService.SubmitAssignment(&service.SubmitAssignmentRequest{
+		AssignmentID: uint(req.AssignmentId),
+		UserID:       uint(req.UserId),
+		Content:      req.Content,
+		FileID:       uint(req.FileId),
+		FileName:     req.FileName,
+		FileURL:      req.FileUrl,
+	})
+	if err != nil {
+		log.Printf("❌ gRPC: 提交作业失败 - %v", err)
+		return &assignmentpb.SubmitAssignmentResponse{
+			Code:    assignmentErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	message := "提交成功"
+	if submission.IsLate {
+		message = "提交成功（已迟交）"
+	}
+	submission.Assignment = *assignment
+	return &assignmentpb.SubmitAssignmentResponse{
+		Code:       200,
+		Message:    message,
+		Submission: convertSubmissionToPB(submission),
+	}, nil
+}
+
+// GetMySubmission 处理获取本人提交gRPC请求
+func (h *AssignmentHandler) GetMySubmission(ctx context.Context, req *assignmentpb.GetMySubmissionRequest) (*assignmentpb.GetMySubmissionResponse, error) {
+	submission, _, err := h.assignmentService.GetMySubmission(uint(req.AssignmentId), uint(req.UserId))
+	if err != nil {
+		return &assignmentpb.GetMySubmissionResponse{
+			Code:    assignmentErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &assignmentpb.GetMySubmissionResponse{
+		Code:       200,
+		Message:    "获取成功",
+		Submission: convertSubmissionToPB(submission),
+	}, nil
+}
+
+// ListSubmissions 处理获取作业提交列表gRPC请求
+func (h *AssignmentHandler) ListSubmissions(ctx context.Context, req *assignmentpb.ListSubmissionsRequest) (*assignmentpb.ListSubmissionsResponse, error) {
+	submissions, err := h.assignmentService.ListSubmissions(uint(req.AssignmentId), uint(req.UserId), req.Status)
+	if err != nil {
+		log.Printf("❌ gRPC: 获取作业提交列表失败 - %v", err)
+		return &assignmentpb.ListSubmissionsResponse{
+			Code:    assignmentErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &assignmentpb.ListSubmissionsResponse{
+		Code:        200,
+		Message:     "获取成功",
+		Submissions: convertSubmissionsToPB(submissions),
+	}, nil
+}
+
+// GetGradingQueue 处理获取待批改队列gRPC请求
+func (h *AssignmentHandler) GetGradingQueue(ctx context.Context, req *assignmentpb.GetGradingQueueRequest) (*assignmentpb.GetGradingQueueResponse, error) {
+	submissions, err := h.assignmentService.GetGradingQueue(uint(req.CourseId), uint(req.UserId))
+	if err != nil {
+		log.Printf("❌ gRPC: 获取待批改队列失败 - %v", err)
+		return &assignmentpb.GetGradingQueueResponse{
+			Code:    assignmentErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &assignmentpb.GetGradingQueueResponse{
+		Code:        200,
+		Message:     "获取成功",
+		Submissions: convertSubmissionsToPB(submissions),
+	}, nil
+}
+
+// GradeSubmission 处理批改作业gRPC请求
+func (h *AssignmentHandler) GradeSubmission(ctx context.Context, req *assignmentpb.GradeSubmissionRequest) (*assignmentpb.GradeSubmissionResponse, error) {
+	log.Printf("🔍 gRPC: 收到批改作业请求 - 提交ID: %d, 批改人: %d", req.SubmissionId, req.UserId)
+
+	scores := make([]model.RubricScore, len(req.Scores))
+	for i, sc := range req.Scores {
+		scores[i] = model.RubricScore{
+			Criterion: sc.Criterion,
+			Score:     int(sc.Score),
+			Comment:   sc.Comment,
+		}
+	}
+
+	submission, err := h.assignmentService.GradeSubmission(&service.GradeSubmissionRequest{
+		SubmissionID: uint(req.SubmissionId),
+		UserID:       uint(req.UserId),
+		Scores:       scores,
+		Feedback:     req.Feedback,
+	})
+	if err != nil {
+		log.Printf("❌ gRPC: 批改作业失败 - %v", err)
+		return &assignmentpb.GradeSubmissionResponse{
+			Code:    assignmentErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &assignmentpb.GradeSubmissionResponse{
+		Code:       200,
+		Message:    "批改完成",
+		Submission: convertSubmissionToPB(submission),
+	}, nil
+}
+
+// convertAssignmentToPB 转换作业模型
+func convertAssignmentToPB(a *model.Assignment) *assignmentpb.Assignment {
+	rubric := make([]*assignmentpb.RubricCriterion, len(a.Rubric))
+	for i, c := range a.Rubric {
+		rubric[i] = &assignmentpb.RubricCriterion{
+			Name:        c.Name,
+			Description: c.Description,
+			MaxScore:    uint32(c.MaxScore),
+		}
+	}
+
+	return &assignmentpb.Assignment{
+		Id:                 uint32(a.ID),
+		CourseId:           uint32(a.CourseID),
+		ChapterId:          uint32(a.ChapterID),
+		Title:              a.Title,
+		Description:        a.Description,
+		DueAt:              a.DueAt.Format(time.RFC3339),
+		LatePolicy:         a.LatePolicy,
+		LatePenaltyPercent: uint32(a.LatePenaltyPercent),
+		LateCutoffHours:    uint32(a.LateCutoffHours),
+		Rubric:             rubric,
+		MaxScore:           uint32(a.MaxScore()),
+		CreatedAt:          a.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+// convertSubmissionToPB 转换作业提交模型
+func convertSubmissionToPB(s *model.Submission) *assignmentpb.Submission {
+	pb := &assignmentpb.Submission{
+		Id:              uint32(s.ID),
+		AssignmentId:    uint32(s.AssignmentID),
+		UserId:          uint32(s.UserID),
+		Content:         s.Content,
+		FileId:          uint32(s.FileID),
+		FileName:        s.FileName,
+		FileUrl:         s.FileURL,
+		Status:          s.Status,
+		IsLate:          s.IsLate,
+		LateDays:        uint32(s.LateDays),
+		SubmittedAt:     s.SubmittedAt.Format(time.RFC3339),
+		RawScore:        uint32(s.RawScore),
+		PenaltyPercent:  uint32(s.PenaltyPercent),
+		Score:           uint32(s.Score),
+		Feedback:        s.Feedback,
+		GradedBy:        uint32(s.GradedBy),
+		AssignmentTitle: s.Assignment.Title,
+	}
+	if s.GradedAt != nil {
+		pb.GradedAt = s.GradedAt.Format(time.RFC3339)
+	}
+	if s.FileID != 0 {
+		// 早期提交保存的是静态文件地址，统一改为鉴权的下载接口
+		pb.FileUrl = fmt.Sprintf(contentModel.DownloadURLFormat, s.FileID)
+	}
+	for _, sc := range s.RubricScores {
+		pb.RubricScores = append(pb.RubricScores, &assignmentpb.RubricScore{
+			Criterion: sc.Criterion,
+			Score:     uint32(sc.Score),
+			Comment:   sc.Comment,
+		})
+	}
+	return pb
+}
+
+// convertSubmissionsToPB 批量转换作业提交
+func convertSubmissionsToPB(submissions []*model.Submission) []*assignmentpb.Submission {
+	pbSubmissions := make([]*assignmentpb.Submission, len(submissions))
+	for i, s := range submissions {
+		pbSubmissions[i] = convertSubmissionToPB(s)
+	}
+	return pbSubmissions
+}
+
+// assignmentErrorCode 根据服务层错误信息推断响应码
+func assignmentErrorCode(err error) int32 {
+	msg := err.Error()
+	switch {
+	case strings.Contains(msg, "只有课程讲师"), strings.Contains(msg, "请先报名"):
+		return 403
+	case strings.Contains(msg, "不存在"), strings.Contains(msg, "尚未提交"):
+		return 404
+	default:
+		return 400
+	}
+}
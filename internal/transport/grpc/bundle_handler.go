@@ -0,0 +1,283 @@
+package grpc
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"course-platform/internal/domain/bundle/model"
+	"course-platform/internal/domain/bundle/service"
+	"course-platform/internal/shared/pb/bundlepb"
+)
+
+// BundleHandler 课程套餐gRPC处理器
+type BundleHandler struct {
+	bundlepb.UnimplementedBundleServiceServer
+	bundleService service.BundleServiceInterface
+}
+
+// NewBundleHandler 创建课程套餐gRPC处理器实例
+func NewBundleHandler(bundleService service.BundleServiceInterface) *BundleHandler {
+	return &BundleHandler{
+		bundleService: bundleService,
+	}
+}
+
+// CreateBundle 处理创建套餐gRPC请求
+func (h *BundleHandler) CreateBundle(ctx context.Context, req *bundlepb.CreateBundleRequest) (*bundlepb.CreateBundleResponse, error) {
+	log.Printf("🔍 gRPC: 收到创建套餐请求 - 标题: %s", req.Title)
+
+	bundle, err := h.bundleService.CreateBundle(&service.SaveBundleRequest{
+		UserID:      uint(req.UserId),
+		Title:       req.Title,
+		Description: req.Description,
+		CoverImage:  req.CoverImage,
+		Kind:        req.Kind,
+		Price:       req.Price,
+		Steps:       convertStepsFromPB(req.Steps),
+	})
+	if err != nil {
+		log.Printf("❌ gRPC: 创建套餐失败 - %v", err)
+		return &bundlepb.CreateBundleResponse{
+			Code:    bundleErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &bundlepb.CreateBundleResponse{
+		Code:    200,
+		Message: "套餐创建成功",
+		Bundle:  convertBundleToPB(bundle),
+	}, nil
+}
+
+// UpdateBundle 处理更新套餐gRPC请求
+func (h *BundleHandler) UpdateBundle(ctx context.Context, req *bundlepb.UpdateBundleRequest) (*bundlepb.UpdateBundleResponse, error) {
+	log.Printf("🔍 gRPC: 收到更新套餐请求 - ID: %d", req.BundleId)
+
+	bundle, err := h.bundleService.UpdateBundle(uint(req.BundleId), &service.SaveBundleRequest{
+		UserID:      uint(req.UserId),
+		Title:       req.Title,
+		Description: req.Description,
+		CoverImage:  req.CoverImage,
+		Kind:        req.Kind,
+		Price:       req.Price,
+		Steps:       convertStepsFromPB(req.Steps),
+	})
+	if err != nil {
+		log.Printf("❌ gRPC: 更新套餐失败 - %v", err)
+		return &bundlepb.UpdateBundleResponse{
+			Code:    bundleErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &bundlepb.UpdateBundleResponse{
+		Code:    200,
+		Message: "套餐更新成功",
+		Bundle:  convertBundleToPB(bundle),
+	}, nil
+}
+
+// PublishBundle 处理发布套餐gRPC请求
+func (h *BundleHandler) PublishBundle(ctx context.Context, req *bundlepb.PublishBundleRequest) (*bundlepb.PublishBundleResponse, error) {
+	bundle, err := h.bundleService.PublishBundle(uint(req.BundleId), uint(req.UserId))
+	if err != nil {
+		log.Printf("❌ gRPC: 发布套餐失败 - %v", err)
+		return &bundlepb.PublishBundleResponse{
+			Code:    bundleErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &bundlepb.PublishBundleResponse{
+		Code:    200,
+		Message: "套餐已发布",
+		Bundle:  convertBundleToPB(bundle),
+	}, nil
+}
+
+// GetBundle 处理获取套餐详情gRPC请求
+func (h *BundleHandler) GetBundle(ctx context.Context, req *bundlepb.GetBundleRequest) (*bundlepb.GetBundleResponse, error) {
+	bundle, err := h.bundleService.GetBundle(uint(req.BundleId), uint(req.UserId))
+	if err != nil {
+		return &bundlepb.GetBundleResponse{
+			Code:    bundleErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &bundlepb.GetBundleResponse{
+		Code:    200,
+		Message: "获取套餐成功",
+		Bundle:  convertBundleToPB(bundle),
+	}, nil
+}
+
+// ListBundles 处理获取套餐列表gRPC请求
+func (h *BundleHandler) ListBundles(ctx context.Context, req *bundlepb.ListBundlesRequest) (*bundlepb.ListBundlesResponse, error) {
+	bundles, total, err := h.bundleService.ListBundles(int(req.Page), int(req.PageSize))
+	if err != nil {
+		log.Printf("❌ gRPC: 获取套餐列表失败 - %v", err)
+		return &bundlepb.ListBundlesResponse{
+			Code:    500,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &bundlepb.ListBundlesResponse{
+		Code:    200,
+		Message: "获取套餐列表成功",
+		Bundles: convertBundlesToPB(bundles),
+		Total:   uint32(total),
+	}, nil
+}
+
+// ListMyBundles 处理获取本人套餐gRPC请求
+func (h *BundleHandler) ListMyBundles(ctx context.Context, req *bundlepb.ListMyBundlesRequest) (*bundlepb.ListMyBundlesResponse, error) {
+	bundles, err := h.bundleService.ListMyBundles(uint(req.UserId))
+	if err != nil {
+		return &bundlepb.ListMyBundlesResponse{
+			Code:    bundleErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &bundlepb.ListMyBundlesResponse{
+		Code:    200,
+		Message: "获取套餐列表成功",
+		Bundles: convertBundlesToPB(bundles),
+	}, nil
+}
+
+// EnrollBundle 处理领取免费套餐gRPC请求
+func (h *BundleHandler) EnrollBundle(ctx context.Context, req *bundlepb.EnrollBundleRequest) (*bundlepb.EnrollBundleResponse, error) {
+	log.Printf("🔍 gRPC: 收到套餐报名请求 - 用户ID: %d, 套餐ID: %d", req.UserId, req.BundleId)
+
+	if _, err := h.bundleService.EnrollBundle(uint(req.UserId), uint(req.BundleId)); err != nil {
+		log.Printf("❌ gRPC: 套餐报名失败 - %v", err)
+		return &bundlepb.EnrollBundleResponse{
+			Code:    bundleErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &bundlepb.EnrollBundleResponse{
+		Code:    200,
+		Message: "报名成功，已开通套餐中的全部课程",
+	}, nil
+}
+
+// GetBundleProgress 处理获取学习路径进度gRPC请求
+func (h *BundleHandler) GetBundleProgress(ctx context.Context, req *bundlepb.GetBundleProgressRequest) (*bundlepb.GetBundleProgressResponse, error) {
+	progress, err := h.bundleService.GetPathProgress(uint(req.UserId), uint(req.BundleId))
+	if err != nil {
+		return &bundlepb.GetBundleProgressResponse{
+			Code:    bundleErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	steps := make([]*bundlepb.StepProgress, 0, len(progress.Steps))
+	for _, step := range progress.Steps {
+		steps = append(steps, &bundlepb.StepProgress{
+			CourseId:              uint32(step.CourseID),
+			CourseTitle:           step.CourseTitle,
+			Position:              int32(step.Position),
+			PrerequisiteCourseIds: toUint32s(step.PrerequisiteCourseIDs),
+			CompletedChapters:     int32(step.CompletedChapters),
+			TotalChapters:         int32(step.TotalChapters),
+			Percent:               int32(step.Percent),
+			Status:                step.Status,
+		})
+	}
+
+	return &bundlepb.GetBundleProgressResponse{
+		Code:    200,
+		Message: "获取学习进度成功",
+		Progress: &bundlepb.PathProgress{
+			BundleId:         uint32(progress.BundleID),
+			Enrolled:         progress.Enrolled,
+			CompletedCourses: int32(progress.CompletedCourses),
+			TotalCourses:     int32(progress.TotalCourses),
+			Percent:          int32(progress.Percent),
+			Steps:            steps,
+		},
+	}, nil
+}
+
+// convertStepsFromPB 转换套餐课程列表
+func convertStepsFromPB(steps []*bundlepb.BundleStep) []service.StepInput {
+	result := make([]service.StepInput, 0, len(steps))
+	for _, step := range steps {
+		prerequisites := make([]uint, 0, len(step.PrerequisiteCourseIds))
+		for _, id := range step.PrerequisiteCourseIds {
+			prerequisites = append(prerequisites, uint(id))
+		}
+		result = append(result, service.StepInput{
+			CourseID:              uint(step.CourseId),
+			PrerequisiteCourseIDs: prerequisites,
+		})
+	}
+	return result
+}
+
+// convertBundlesToPB 转换套餐列表
+func convertBundlesToPB(bundles []*model.Bundle) []*bundlepb.Bundle {
+	result := make([]*bundlepb.Bundle, 0, len(bundles))
+	for _, bundle := range bundles {
+		result = append(result, convertBundleToPB(bundle))
+	}
+	return result
+}
+
+// convertBundleToPB 转换套餐为protobuf格式
+func convertBundleToPB(bundle *model.Bundle) *bundlepb.Bundle {
+	courses := make([]*bundlepb.BundleCourse, 0, len(bundle.Courses))
+	for _, c := range bundle.Courses {
+		courses = append(courses, &bundlepb.BundleCourse{
+			CourseId:              uint32(c.CourseID),
+			CourseTitle:           c.CourseTitle,
+			CoursePrice:           c.CoursePrice,
+			Position:              int32(c.Position),
+			PrerequisiteCourseIds: toUint32s(c.PrerequisiteCourseIDs),
+		})
+	}
+
+	return &bundlepb.Bundle{
+		Id:           uint32(bundle.ID),
+		Title:        bundle.Title,
+		Description:  bundle.Description,
+		CoverImage:   bundle.CoverImage,
+		Kind:         bundle.Kind,
+		CreatorId:    uint32(bundle.CreatorID),
+		Price:        bundle.Price,
+		CoursesPrice: bundle.CoursesPrice(),
+		Status:       bundle.Status,
+		Courses:      courses,
+		CreatedAt:    bundle.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// toUint32s 转换ID列表
+func toUint32s(ids []uint) []uint32 {
+	result := make([]uint32, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, uint32(id))
+	}
+	return result
+}
+
+// bundleErrorCode 根据错误信息返回业务状态码
+func bundleErrorCode(err error) int32 {
+	msg := err.Error()
+	switch {
+	case strings.Contains(msg, "无权"):
+		return 403
+	case strings.Contains(msg, "不存在"):
+		return 404
+	default:
+		return 400
+	}
+}
@@ -0,0 +1,150 @@
+package grpc
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"course-platform/internal/domain/certificate/model"
+	"course-platform/internal/domain/certificate/service"
+	"course-platform/internal/shared/pb/certificatepb"
+)
+
+// CertificateHandler 证书gRPC处理器
+type CertificateHandler struct {
+	certificatepb.UnimplementedCertificateServiceServer
+	certificateService service.CertificateServiceInterface
+}
+
+// NewCertificateHandler 创建证书gRPC处理器实例
+func NewCertificateHandler(certificateService service.CertificateServiceInterface) *CertificateHandler {
+	return &CertificateHandler{
+		certificateService: certificateService,
+	}
+}
+
+// GetCertificate 处理根据验证码获取证书gRPC请求
+func (h *CertificateHandler) GetCertificate(ctx context.Context, req *certificatepb.GetCertificateRequest) (*certificatepb.GetCertificateResponse, error) {
+	log.Printf("🔍 gRPC: 收到证书验证请求 - 验证码: %s", req.Code)
+
+	certificate, err := h.certificateService.GetCertificate(ctx, req.Code)
+	if err != nil {
+		return &certificatepb.GetCertificateResponse{
+			Code:    certificateErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &certificatepb.GetCertificateResponse{
+		Code:        200,
+		Message:     "证书有效",
+		Certificate: convertCertificateToPB(certificate),
+	}, nil
+}
+
+// ListMyCertificates 处理获取本人证书gRPC请求
+func (h *CertificateHandler) ListMyCertificates(ctx context.Context, req *certificatepb.ListMyCertificatesRequest) (*certificatepb.ListMyCertificatesResponse, error) {
+	certificates, err := h.certificateService.ListUserCertificates(uint(req.UserId))
+	if err != nil {
+		return &certificatepb.ListMyCertificatesResponse{
+			Code:    certificateErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	pbCertificates := make([]*certificatepb.Certificate, len(certificates))
+	for i, certificate := range certificates {
+		pbCertificates[i] = convertCertificateToPB(certificate)
+	}
+
+	return &certificatepb.ListMyCertificatesResponse{
+		Code:         200,
+		Message:      "获取证书列表成功",
+		Certificates: pbCertificates,
+	}, nil
+}
+
+// GetCertificateTemplate 处理获取证书模板gRPC请求
+func (h *CertificateHandler) GetCertificateTemplate(ctx context.Context, req *certificatepb.GetCertificateTemplateRequest) (*certificatepb.GetCertificateTemplateResponse, error) {
+	template, err := h.certificateService.GetTemplate(uint(req.CourseId))
+	if err != nil {
+		return &certificatepb.GetCertificateTemplateResponse{
+			Code:    certificateErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &certificatepb.GetCertificateTemplateResponse{
+		Code:     200,
+		Message:  "获取证书模板成功",
+		Template: convertCertificateTemplateToPB(template),
+	}, nil
+}
+
+// SaveCertificateTemplate 处理保存证书模板gRPC请求
+func (h *CertificateHandler) SaveCertificateTemplate(ctx context.Context, req *certificatepb.SaveCertificateTemplateRequest) (*certificatepb.SaveCertificateTemplateResponse, error) {
+	log.Printf("🔍 gRPC: 收到保存证书模板请求 - 课程ID: %d", req.CourseId)
+
+	template, err := h.certificateService.SaveTemplate(uint(req.UserId), &model.CertificateTemplate{
+		CourseID:    uint(req.CourseId),
+		Heading:     req.Heading,
+		Body:        req.Body,
+		Footer:      req.Footer,
+		AccentColor: req.AccentColor,
+		Layout:      req.Layout,
+	})
+	if err != nil {
+		log.Printf("❌ gRPC: 保存证书模板失败 - %v", err)
+		return &certificatepb.SaveCertificateTemplateResponse{
+			Code:    certificateErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &certificatepb.SaveCertificateTemplateResponse{
+		Code:     200,
+		Message:  "证书模板保存成功",
+		Template: convertCertificateTemplateToPB(template),
+	}, nil
+}
+
+// convertCertificateToPB 将证书模型转换为protobuf消息
+func convertCertificateToPB(certificate *model.Certificate) *certificatepb.Certificate {
+	return &certificatepb.Certificate{
+		Id:             uint32(certificate.ID),
+		Code:           certificate.Code,
+		UserId:         uint32(certificate.UserID),
+		CourseId:       uint32(certificate.CourseID),
+		StudentName:    certificate.StudentName,
+		CourseTitle:    certificate.CourseTitle,
+		InstructorName: certificate.InstructorName,
+		IssuedAt:       certificate.IssuedAt.Format(time.RFC3339),
+		FileUrl:        certificate.FileURL,
+	}
+}
+
+// convertCertificateTemplateToPB 将证书模板转换为protobuf消息
+func convertCertificateTemplateToPB(template *model.CertificateTemplate) *certificatepb.CertificateTemplate {
+	return &certificatepb.CertificateTemplate{
+		CourseId:    uint32(template.CourseID),
+		Heading:     template.Heading,
+		Body:        template.Body,
+		Footer:      template.Footer,
+		AccentColor: template.AccentColor,
+		Layout:      template.Layout,
+	}
+}
+
+// certificateErrorCode 根据错误信息映射业务状态码
+func certificateErrorCode(err error) int32 {
+	msg := err.Error()
+	switch {
+	case strings.Contains(msg, "只有课程讲师"):
+		return 403
+	case strings.Contains(msg, "不存在"):
+		return 404
+	default:
+		return 400
+	}
+}
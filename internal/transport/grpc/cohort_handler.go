@@ -0,0 +1,427 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"course-platform/internal/domain/cohort/model"
+	"course-platform/internal/domain/cohort/service"
+	courseService "course-platform/internal/domain/course/service"
+	"course-platform/internal/shared/pb/cohortpb"
+)
+
+// CohortHandler 班期与直播gRPC处理器
+type CohortHandler struct {
+	cohortpb.UnimplementedCohortServiceServer
+	cohortService service.CohortServiceInterface
+}
+
+// NewCohortHandler 创建班期与直播gRPC处理器实例
+func NewCohortHandler(cohortService service.CohortServiceInterface) *CohortHandler {
+	return &CohortHandler{
+		cohortService: cohortService,
+	}
+}
+
+// CreateCohort 处理开设班期gRPC请求
+func (h *CohortHandler) CreateCohort(ctx context.Context, req *cohortpb.CreateCohortRequest) (*cohortpb.CreateCohortResponse, error) {
+	log.Printf("🔍 gRPC: 收到开设班期请求 - 课程ID: %d, 名称: %s", req.CourseId, req.Name)
+
+	saveReq, err := buildSaveCohortRequest(uint(req.UserId), uint(req.CourseId), req.Name, req.StartDate, req.EndDate, req.Capacity, req.Timezone)
+	if err != nil {
+		return &cohortpb.CreateCohortResponse{Code: 400, Message: err.Error()}, nil
+	}
+
+	cohort, err := h.cohortService.CreateCohort(saveReq)
+	if err != nil {
+		log.Printf("❌ gRPC: 开设班期失败 - %v", err)
+		return &cohortpb.CreateCohortResponse{
+			Code:    cohortErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &cohortpb.CreateCohortResponse{
+		Code:    200,
+		Message: "班期创建成功",
+		Cohort:  convertCohortToPB(cohort),
+	}, nil
+}
+
+// UpdateCohort 处理更新班期gRPC请求
+func (h *CohortHandler) UpdateCohort(ctx context.Context, req *cohortpb.UpdateCohortRequest) (*cohortpb.UpdateCohortResponse, error) {
+	log.Printf("🔍 gRPC: 收到更新班期请求 - ID: %d", req.CohortId)
+
+	saveReq, err := buildSaveCohortRequest(uint(req.UserId), 0, req.Name, req.StartDate, req.EndDate, req.Capacity, req.Timezone)
+	if err != nil {
+		return &cohortpb.UpdateCohortResponse{Code: 400, Message: err.Error()}, nil
+	}
+
+	cohort, err := h.cohortService.UpdateCohort(uint(req.CohortId), saveReq)
+	if err != nil {
+		log.Printf("❌ gRPC: 更新班期失败 - %v", err)
+		return &cohortpb.UpdateCohortResponse{
+			Code:    cohortErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &cohortpb.UpdateCohortResponse{
+		Code:    200,
+		Message: "班期更新成功",
+		Cohort:  convertCohortToPB(cohort),
+	}, nil
+}
+
+// GetCohort 处理获取班期详情gRPC请求
+func (h *CohortHandler) GetCohort(ctx context.Context, req *cohortpb.GetCohortRequest) (*cohortpb.GetCohortResponse, error) {
+	cohort, sessions, err := h.cohortService.GetCohort(uint(req.CohortId), uint(req.UserId))
+	if err != nil {
+		log.Printf("❌ gRPC: 获取班期失败 - %v", err)
+		return &cohortpb.GetCohortResponse{
+			Code:    cohortErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	pbSessions := make([]*cohortpb.LiveSession, 0, len(sessions))
+	for _, session := range sessions {
+		pbSessions = append(pbSessions, convertLiveSessionToPB(session))
+	}
+	return &cohortpb.GetCohortResponse{
+		Code:     200,
+		Message:  "获取成功",
+		Cohort:   convertCohortToPB(cohort),
+		Sessions: pbSessions,
+	}, nil
+}
+
+// ListCohorts 处理获取班期列表gRPC请求
+func (h *CohortHandler) ListCohorts(ctx context.Context, req *cohortpb.ListCohortsRequest) (*cohortpb.ListCohortsResponse, error) {
+	cohorts, err := h.cohortService.ListCohorts(uint(req.CourseId), uint(req.UserId))
+	if err != nil {
+		log.Printf("❌ gRPC: 获取班期列表失败 - %v", err)
+		return &cohortpb.ListCohortsResponse{
+			Code:    cohortErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	pbCohorts := make([]*cohortpb.Cohort, 0, len(cohorts))
+	for _, cohort := range cohorts {
+		pbCohorts = append(pbCohorts, convertCohortToPB(cohort))
+	}
+	return &cohortpb.ListCohortsResponse{
+		Code:    200,
+		Message: "获取成功",
+		Cohorts: pbCohorts,
+	}, nil
+}
+
+// EnrollCohort 处理报名班期gRPC请求
+func (h *CohortHandler) EnrollCohort(ctx context.Context, req *cohortpb.EnrollCohortRequest) (*cohortpb.EnrollCohortResponse, error) {
+	log.Printf("🔍 gRPC: 收到报名班期请求 - 用户ID: %d, 班期ID: %d", req.UserId, req.CohortId)
+
+	cohort, err := h.cohortService.EnrollCohort(uint(req.CohortId), uint(req.UserId))
+	if err != nil {
+		log.Printf("❌ gRPC: 报名班期失败 - %v", err)
+
+		// 免费课程随班期一起报名时，先修要求未满足需逐项返回缺口
+		var prerequisiteErr *courseService.PrerequisiteError
+		if errors.As(err, &prerequisiteErr) {
+			missing := make([]*cohortpb.MissingPrerequisite, 0, len(prerequisiteErr.Missing))
+			for _, m := range prerequisiteErr.Missing {
+				missing = append(missing, &cohortpb.MissingPrerequisite{
+					CourseId:           uint32(m.CourseID),
+					CourseTitle:        m.CourseTitle,
+					MinProgressPercent: int32(m.MinProgressPercent),
+					CurrentPercent:     int32(m.CurrentPercent),
+					Enrolled:           m.Enrolled,
+				})
+			}
+			return &cohortpb.EnrollCohortResponse{
+				Code:                 403,
+				Message:              err.Error(),
+				MissingPrerequisites: missing,
+			}, nil
+		}
+
+		return &cohortpb.EnrollCohortResponse{
+			Code:    cohortErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	message := "班期报名成功"
+	if cohort.MyStatus == model.MemberStatusWaitlisted {
+		message = "班期名额已满，已加入候补名单"
+	}
+	return &cohortpb.EnrollCohortResponse{
+		Code:    200,
+		Message: message,
+		Cohort:  convertCohortToPB(cohort),
+	}, nil
+}
+
+// LeaveCohort 处理退出班期gRPC请求
+func (h *CohortHandler) LeaveCohort(ctx context.Context, req *cohortpb.LeaveCohortRequest) (*cohortpb.LeaveCohortResponse, error) {
+	if err := h.cohortService.LeaveCohort(uint(req.CohortId), uint(req.UserId)); err != nil {
+		log.Printf("❌ gRPC: 退出班期失败 - %v", err)
+		return &cohortpb.LeaveCohortResponse{
+			Code:    cohortErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &cohortpb.LeaveCohortResponse{
+		Code:    200,
+		Message: "已退出班期",
+	}, nil
+}
+
+// ListCohortMembers 处理获取班期成员gRPC请求
+func (h *CohortHandler) ListCohortMembers(ctx context.Context, req *cohortpb.ListCohortMembersRequest) (*cohortpb.ListCohortMembersResponse, error) {
+	members, err := h.cohortService.ListMembers(uint(req.CohortId), uint(req.UserId))
+	if err != nil {
+		log.Printf("❌ gRPC: 获取班期成员失败 - %v", err)
+		return &cohortpb.ListCohortMembersResponse{
+			Code:    cohortErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	// 成员列表中候补学员已按排队顺序排列
+	pbMembers := make([]*cohortpb.CohortMember, 0, len(members))
+	waitlistOrder := 0
+	for _, member := range members {
+		pbMember := &cohortpb.CohortMember{
+			UserId:   uint32(member.UserID),
+			Status:   member.Status,
+			QueuedAt: member.QueuedAt.Format(time.RFC3339),
+		}
+		if member.PromotedAt != nil {
+			pbMember.PromotedAt = member.PromotedAt.Format(time.RFC3339)
+		}
+		if member.Status == model.MemberStatusWaitlisted {
+			waitlistOrder++
+			pbMember.WaitlistOrder = int32(waitlistOrder)
+		}
+		pbMembers = append(pbMembers, pbMember)
+	}
+	return &cohortpb.ListCohortMembersResponse{
+		Code:    200,
+		Message: "获取成功",
+		Members: pbMembers,
+	}, nil
+}
+
+// CreateLiveSession 处理安排直播gRPC请求
+func (h *CohortHandler) CreateLiveSession(ctx context.Context, req *cohortpb.SaveLiveSessionRequest) (*cohortpb.SaveLiveSessionResponse, error) {
+	log.Printf("🔍 gRPC: 收到安排直播请求 - 班期ID: %d, 标题: %s", req.CohortId, req.Title)
+
+	saveReq, err := buildSaveSessionRequest(req)
+	if err != nil {
+		return &cohortpb.SaveLiveSessionResponse{Code: 400, Message: err.Error()}, nil
+	}
+
+	session, err := h.cohortService.CreateSession(saveReq)
+	if err != nil {
+		log.Printf("❌ gRPC: 安排直播失败 - %v", err)
+		return &cohortpb.SaveLiveSessionResponse{
+			Code:    cohortErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &cohortpb.SaveLiveSessionResponse{
+		Code:    200,
+		Message: "直播安排成功",
+		Session: convertLiveSessionToPB(session),
+	}, nil
+}
+
+// UpdateLiveSession 处理更新直播gRPC请求
+func (h *CohortHandler) UpdateLiveSession(ctx context.Context, req *cohortpb.SaveLiveSessionRequest) (*cohortpb.SaveLiveSessionResponse, error) {
+	log.Printf("🔍 gRPC: 收到更新直播请求 - ID: %d", req.SessionId)
+
+	saveReq, err := buildSaveSessionRequest(req)
+	if err != nil {
+		return &cohortpb.SaveLiveSessionResponse{Code: 400, Message: err.Error()}, nil
+	}
+
+	session, err := h.cohortService.UpdateSession(uint(req.SessionId), saveReq)
+	if err != nil {
+		log.Printf("❌ gRPC: 更新直播失败 - %v", err)
+		return &cohortpb.SaveLiveSessionResponse{
+			Code:    cohortErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &cohortpb.SaveLiveSessionResponse{
+		Code:    200,
+		Message: "直播更新成功",
+		Session: convertLiveSessionToPB(session),
+	}, nil
+}
+
+// DeleteLiveSession 处理取消直播gRPC请求
+func (h *CohortHandler) DeleteLiveSession(ctx context.Context, req *cohortpb.DeleteLiveSessionRequest) (*cohortpb.DeleteLiveSessionResponse, error) {
+	if err := h.cohortService.DeleteSession(uint(req.CohortId), uint(req.SessionId), uint(req.UserId)); err != nil {
+		log.Printf("❌ gRPC: 取消直播失败 - %v", err)
+		return &cohortpb.DeleteLiveSessionResponse{
+			Code:    cohortErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &cohortpb.DeleteLiveSessionResponse{
+		Code:    200,
+		Message: "直播已取消",
+	}, nil
+}
+
+// GetCalendarFeedToken 处理获取日历订阅令牌gRPC请求
+func (h *CohortHandler) GetCalendarFeedToken(ctx context.Context, req *cohortpb.GetCalendarFeedTokenRequest) (*cohortpb.GetCalendarFeedTokenResponse, error) {
+	token, err := h.cohortService.GetFeedToken(uint(req.UserId), req.Regenerate)
+	if err != nil {
+		log.Printf("❌ gRPC: 获取日历订阅令牌失败 - %v", err)
+		return &cohortpb.GetCalendarFeedTokenResponse{
+			Code:    cohortErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &cohortpb.GetCalendarFeedTokenResponse{
+		Code:    200,
+		Message: "获取成功",
+		Token:   token,
+	}, nil
+}
+
+// GetCalendarFeed 处理获取日历订阅内容gRPC请求
+func (h *CohortHandler) GetCalendarFeed(ctx context.Context, req *cohortpb.GetCalendarFeedRequest) (*cohortpb.GetCalendarFeedResponse, error) {
+	ics, err := h.cohortService.RenderCalendarFeed(req.Token)
+	if err != nil {
+		log.Printf("❌ gRPC: 生成日历订阅失败 - %v", err)
+		return &cohortpb.GetCalendarFeedResponse{
+			Code:    cohortErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &cohortpb.GetCalendarFeedResponse{
+		Code:    200,
+		Message: "获取成功",
+		Ics:     ics,
+	}, nil
+}
+
+// buildSaveCohortRequest 解析班期时间并组装服务层请求
+func buildSaveCohortRequest(userID, courseID uint, name, startDate, endDate string, capacity int32, timezone string) (*service.SaveCohortRequest, error) {
+	req := &service.SaveCohortRequest{
+		UserID:   userID,
+		CourseID: courseID,
+		Name:     name,
+		Capacity: int(capacity),
+		Timezone: timezone,
+	}
+
+	if startDate != "" {
+		start, err := service.ParseLocalTime(startDate, timezone)
+		if err != nil {
+			return nil, errors.New("开班时间格式无效")
+		}
+		req.StartDate = start
+	}
+	if endDate != "" {
+		end, err := service.ParseLocalTime(endDate, timezone)
+		if err != nil {
+			return nil, errors.New("结班时间格式无效")
+		}
+		req.EndDate = &end
+	}
+	return req, nil
+}
+
+// buildSaveSessionRequest 解析直播时间并组装服务层请求
+func buildSaveSessionRequest(req *cohortpb.SaveLiveSessionRequest) (*service.SaveSessionRequest, error) {
+	saveReq := &service.SaveSessionRequest{
+		UserID:          uint(req.UserId),
+		CohortID:        uint(req.CohortId),
+		Title:           req.Title,
+		Description:     req.Description,
+		DurationMinutes: int(req.DurationMinutes),
+		Timezone:        req.Timezone,
+		JoinURL:         req.JoinUrl,
+	}
+
+	if req.StartsAt != "" {
+		startsAt, err := service.ParseLocalTime(req.StartsAt, req.Timezone)
+		if err != nil {
+			return nil, errors.New("直播开始时间格式无效")
+		}
+		saveReq.StartsAt = startsAt
+	}
+	return saveReq, nil
+}
+
+// cohortErrorCode 根据服务层错误信息推断响应码
+func cohortErrorCode(err error) int32 {
+	msg := err.Error()
+	switch {
+	case strings.Contains(msg, "只有课程讲师"), strings.Contains(msg, "需要购买"):
+		return 403
+	case strings.Contains(msg, "不存在"):
+		return 404
+	case strings.Contains(msg, "其他班期"):
+		return 409
+	default:
+		return 400
+	}
+}
+
+// convertCohortToPB 将班期模型转换为protobuf对象
+func convertCohortToPB(cohort *model.Cohort) *cohortpb.Cohort {
+	pbCohort := &cohortpb.Cohort{
+		Id:              uint32(cohort.ID),
+		CourseId:        uint32(cohort.CourseID),
+		Name:            cohort.Name,
+		StartDate:       cohort.StartDate.Format(time.RFC3339),
+		Capacity:        int32(cohort.Capacity),
+		Timezone:        cohort.Timezone,
+		EnrolledCount:   int32(cohort.EnrolledCount),
+		WaitlistCount:   int32(cohort.WaitlistCount),
+		MyStatus:        cohort.MyStatus,
+		MyWaitlistOrder: int32(cohort.MyWaitlistOrder),
+		IsInstructor:    cohort.IsInstructor,
+		CreatedAt:       cohort.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+	if cohort.EndDate != nil {
+		pbCohort.EndDate = cohort.EndDate.Format(time.RFC3339)
+	}
+	return pbCohort
+}
+
+// convertLiveSessionToPB 将直播模型转换为protobuf对象，附带直播时区的当地时间
+func convertLiveSessionToPB(session *model.LiveSession) *cohortpb.LiveSession {
+	pbSession := &cohortpb.LiveSession{
+		Id:              uint32(session.ID),
+		CohortId:        uint32(session.CohortID),
+		CourseId:        uint32(session.CourseID),
+		Title:           session.Title,
+		Description:     session.Description,
+		StartsAt:        session.StartsAt.UTC().Format(time.RFC3339),
+		EndsAt:          session.EndsAt().UTC().Format(time.RFC3339),
+		DurationMinutes: int32(session.DurationMinutes),
+		Timezone:        session.Timezone,
+		JoinUrl:         session.JoinURL,
+	}
+	if loc, err := time.LoadLocation(session.Timezone); err == nil {
+		pbSession.LocalStartsAt = session.StartsAt.In(loc).Format("2006-01-02 15:04")
+	}
+	return pbSession
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"log"
 	"strconv"
+	"strings"
 
 	"course-platform/internal/domain/content/model"
 	"course-platform/internal/domain/content/service"
@@ -33,6 +34,7 @@ func (h *ContentHandler) UploadFile(ctx context.Context, req *contentpb.UploadFi
 		FileName:   req.FileName,
 		FileType:   req.FileType,
 		CourseID:   uint(req.CourseId),
+		ChapterID:  uint(req.ChapterId),
 		UploaderID: uint(req.UploaderId),
 	}
 
@@ -46,18 +48,8 @@ func (h *ContentHandler) UploadFile(ctx context.Context, req *contentpb.UploadFi
 		}, nil
 	}
 
-	// 转换文件信息 - 修复类型转换错误
-	fileInfo := &contentpb.FileInfo{
-		FileId:     strconv.FormatUint(uint64(file.ID), 10), // uint转换为string
-		FileName:   file.FileName,
-		FileUrl:    file.FileURL,
-		FileType:   file.FileType,
-		FileSize:   file.FileSize,
-		CourseId:   uint32(file.CourseID),
-		UploaderId: uint32(file.UploaderID),
-		CreatedAt:  file.UploadTime.Format("2006-01-02 15:04:05"),
-		UpdatedAt:  file.UploadTime.Format("2006-01-02 15:04:05"),
-	}
+	// 转换文件信息
+	fileInfo := convertFileToPB(file)
 
 	log.Printf("✅ 文件上传成功: %s", file.FileName)
 	return &contentpb.UploadFileResponse{
@@ -99,20 +91,10 @@ func (h *ContentHandler) GetFiles(ctx context.Context, req *contentpb.GetFilesRe
 		}, nil
 	}
 
-	// 转换文件列表 - 修复类型转换错误
+	// 转换文件列表
 	pbFiles := make([]*contentpb.FileInfo, len(files))
-	for i, file := range files {
-		pbFiles[i] = &contentpb.FileInfo{
-			FileId:     strconv.FormatUint(uint64(file.ID), 10), // uint转换为string
-			FileName:   file.FileName,
-			FileUrl:    file.FileURL,
-			FileType:   file.FileType,
-			FileSize:   file.FileSize,
-			CourseId:   uint32(file.CourseID),
-			UploaderId: uint32(file.UploaderID),
-			CreatedAt:  file.UploadTime.Format("2006-01-02 15:04:05"),
-			UpdatedAt:  file.UploadTime.Format("2006-01-02 15:04:05"),
-		}
+	for i := range files {
+		pbFiles[i] = convertFileToPB(&files[i])
 	}
 
 	log.Printf("✅ 获取文件列表成功，共 %d 条记录", len(files))
@@ -143,7 +125,7 @@ func (h *ContentHandler) DeleteFile(ctx context.Context, req *contentpb.DeleteFi
 	if err != nil {
 		log.Printf("❌ 删除文件失败: %v", err)
 		return &contentpb.DeleteFileResponse{
-			Code:    500,
+			Code:    contentErrorCode(err),
 			Message: err.Error(),
 		}, nil
 	}
@@ -154,3 +136,250 @@ func (h *ContentHandler) DeleteFile(ctx context.Context, req *contentpb.DeleteFi
 		Message: "文件删除成功",
 	}, nil
 }
+
+// GetHLSKey 获取HLS分片解密密钥
+func (h *ContentHandler) GetHLSKey(ctx context.Context, req *contentpb.GetHLSKeyRequest) (*contentpb.GetHLSKeyResponse, error) {
+	log.Printf("🔑 收到获取HLS密钥请求: 文件ID=%s", req.FileId)
+
+	fileID, err := strconv.ParseUint(req.FileId, 10, 64)
+	if err != nil {
+		return &contentpb.GetHLSKeyResponse{
+			Code:    400,
+			Message: "文件ID格式错误",
+		}, nil
+	}
+
+	file, key, err := h.contentService.GetHLSKey(ctx, uint(fileID))
+	if err != nil {
+		log.Printf("❌ 获取HLS密钥失败: %v", err)
+		return &contentpb.GetHLSKeyResponse{
+			Code:    404,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &contentpb.GetHLSKeyResponse{
+		Code:       200,
+		Message:    "获取HLS密钥成功",
+		Key:        key,
+		CourseId:   uint32(file.CourseID),
+		UploaderId: uint32(file.UploaderID),
+		ChapterId:  uint32(file.ChapterID),
+	}, nil
+}
+
+// GetFile 获取单个文件（可指定版本）
+func (h *ContentHandler) GetFile(ctx context.Context, req *contentpb.GetFileRequest) (*contentpb.GetFileResponse, error) {
+	fileID, err := strconv.ParseUint(req.FileId, 10, 64)
+	if err != nil {
+		return &contentpb.GetFileResponse{
+			Code:    400,
+			Message: "文件ID格式错误",
+		}, nil
+	}
+
+	file, err := h.contentService.GetFileVersion(ctx, uint(fileID), int(req.Version), uint(req.UserId))
+	if err != nil {
+		log.Printf("❌ 获取文件失败: %v", err)
+		return &contentpb.GetFileResponse{
+			Code:    contentErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &contentpb.GetFileResponse{
+		Code:     200,
+		Message:  "获取文件成功",
+		FileInfo: convertFileToPB(file),
+	}, nil
+}
+
+// ReplaceFile 替换文件内容并生成新版本
+func (h *ContentHandler) ReplaceFile(ctx context.Context, req *contentpb.ReplaceFileRequest) (*contentpb.ReplaceFileResponse, error) {
+	log.Printf("📁 收到替换文件请求: 文件ID=%s, 新文件名=%s", req.FileId, req.FileName)
+
+	fileID, err := strconv.ParseUint(req.FileId, 10, 64)
+	if err != nil {
+		return &contentpb.ReplaceFileResponse{
+			Code:    400,
+			Message: "文件ID格式错误",
+		}, nil
+	}
+
+	file, err := h.contentService.ReplaceFile(ctx, &service.ReplaceFileRequest{
+		FileID:   uint(fileID),
+		FileName: req.FileName,
+		FileData: req.FileData,
+		UserID:   uint(req.UserId),
+	})
+	if err != nil {
+		log.Printf("❌ 替换文件失败: %v", err)
+		return &contentpb.ReplaceFileResponse{
+			Code:    contentErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	log.Printf("✅ 替换文件成功: 文件ID=%d, 版本=%d", file.ID, file.Version)
+	return &contentpb.ReplaceFileResponse{
+		Code:     200,
+		Message:  "文件替换成功",
+		FileInfo: convertFileToPB(file),
+	}, nil
+}
+
+// ListFileVersions 获取文件版本列表
+func (h *ContentHandler) ListFileVersions(ctx context.Context, req *contentpb.ListFileVersionsRequest) (*contentpb.ListFileVersionsResponse, error) {
+	fileID, err := strconv.ParseUint(req.FileId, 10, 64)
+	if err != nil {
+		return &contentpb.ListFileVersionsResponse{
+			Code:    400,
+			Message: "文件ID格式错误",
+		}, nil
+	}
+
+	file, versions, err := h.contentService.ListFileVersions(ctx, uint(fileID), uint(req.UserId))
+	if err != nil {
+		log.Printf("❌ 获取文件版本失败: %v", err)
+		return &contentpb.ListFileVersionsResponse{
+			Code:    contentErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	pbVersions := make([]*contentpb.FileVersion, len(versions))
+	for i, v := range versions {
+		pbVersions[i] = &contentpb.FileVersion{
+			Version:      uint32(v.Version),
+			FileName:     v.FileName,
+			FileUrl:      v.FileURL,
+			FileSize:     v.FileSize,
+			UploaderId:   uint32(v.UploaderID),
+			CreatedAt:    v.CreatedAt.Format("2006-01-02 15:04:05"),
+			RevertedFrom: uint32(v.RevertedFrom),
+		}
+	}
+
+	return &contentpb.ListFileVersionsResponse{
+		Code:           200,
+		Message:        "获取文件版本成功",
+		Versions:       pbVersions,
+		CurrentVersion: uint32(file.Version),
+	}, nil
+}
+
+// RevertFileVersion 回滚文件到指定版本
+func (h *ContentHandler) RevertFileVersion(ctx context.Context, req *contentpb.RevertFileVersionRequest) (*contentpb.RevertFileVersionResponse, error) {
+	log.Printf("⏪ 收到回滚文件请求: 文件ID=%s, 目标版本=%d", req.FileId, req.Version)
+
+	fileID, err := strconv.ParseUint(req.FileId, 10, 64)
+	if err != nil {
+		return &contentpb.RevertFileVersionResponse{
+			Code:    400,
+			Message: "文件ID格式错误",
+		}, nil
+	}
+
+	file, err := h.contentService.RevertFileVersion(ctx, uint(fileID), uint(req.UserId), int(req.Version))
+	if err != nil {
+		log.Printf("❌ 回滚文件失败: %v", err)
+		return &contentpb.RevertFileVersionResponse{
+			Code:    contentErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	log.Printf("✅ 回滚文件成功: 文件ID=%d, 新版本=%d", file.ID, file.Version)
+	return &contentpb.RevertFileVersionResponse{
+		Code:     200,
+		Message:  "文件回滚成功",
+		FileInfo: convertFileToPB(file),
+	}, nil
+}
+
+// BuildUserArchive 打包用户数据
+func (h *ContentHandler) BuildUserArchive(ctx context.Context, req *contentpb.BuildUserArchiveRequest) (*contentpb.BuildUserArchiveResponse, error) {
+	log.Printf("📦 收到打包用户数据请求: 用户ID=%d", req.UserId)
+
+	entries := make([]service.ArchiveEntry, len(req.Entries))
+	for i, entry := range req.Entries {
+		entries[i] = service.ArchiveEntry{Name: entry.Name, Data: entry.Data}
+	}
+
+	file, count, err := h.contentService.BuildUserArchive(ctx, uint(req.UserId), req.FileName, entries)
+	if err != nil {
+		log.Printf("❌ 打包用户数据失败: %v", err)
+		return &contentpb.BuildUserArchiveResponse{
+			Code:    500,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &contentpb.BuildUserArchiveResponse{
+		Code:      200,
+		Message:   "打包用户数据成功",
+		FileInfo:  convertFileToPB(file),
+		FileCount: uint32(count),
+	}, nil
+}
+
+// PurgeUserFiles 清理注销用户的文件
+func (h *ContentHandler) PurgeUserFiles(ctx context.Context, req *contentpb.PurgeUserFilesRequest) (*contentpb.PurgeUserFilesResponse, error) {
+	log.Printf("🗑️ 收到清理用户文件请求: 用户ID=%d, 转交课程=%v, 接收人=%d", req.UserId, req.TransferCourseIds, req.TransferTo)
+
+	courseIDs := make([]uint, len(req.TransferCourseIds))
+	for i, id := range req.TransferCourseIds {
+		courseIDs[i] = uint(id)
+	}
+
+	deleted, transferred, err := h.contentService.PurgeUserFiles(ctx, uint(req.UserId), courseIDs, uint(req.TransferTo))
+	if err != nil {
+		log.Printf("❌ 清理用户文件失败: %v", err)
+		return &contentpb.PurgeUserFilesResponse{
+			Code:        500,
+			Message:     err.Error(),
+			Deleted:     uint32(deleted),
+			Transferred: uint32(transferred),
+		}, nil
+	}
+
+	return &contentpb.PurgeUserFilesResponse{
+		Code:        200,
+		Message:     "清理用户文件成功",
+		Deleted:     uint32(deleted),
+		Transferred: uint32(transferred),
+	}, nil
+}
+
+// convertFileToPB 将文件模型转换为protobuf文件信息
+func convertFileToPB(file *model.File) *contentpb.FileInfo {
+	return &contentpb.FileInfo{
+		FileId:         strconv.FormatUint(uint64(file.ID), 10), // uint转换为string
+		FileName:       file.FileName,
+		FileUrl:        file.FileURL,
+		FileType:       file.FileType,
+		FileSize:       file.FileSize,
+		CourseId:       uint32(file.CourseID),
+		ChapterId:      uint32(file.ChapterID),
+		UploaderId:     uint32(file.UploaderID),
+		CreatedAt:      file.UploadTime.Format("2006-01-02 15:04:05"),
+		UpdatedAt:      file.UploadTime.Format("2006-01-02 15:04:05"),
+		HlsStatus:      file.HLSStatus,
+		HlsPlaylistUrl: file.HLSPlaylistURL,
+		HlsEncrypted:   file.HLSEncrypted,
+		Version:        uint32(file.Version),
+	}
+}
+
+// contentErrorCode 根据服务层错误信息推断响应码
+func contentErrorCode(err error) int32 {
+	msg := err.Error()
+	switch {
+	case strings.Contains(msg, "无权限"):
+		return 403
+	case strings.Contains(msg, "不存在"):
+		return 404
+	default:
+		return 400
+	}
+}
@@ -0,0 +1,138 @@
+package grpc
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"course-platform/internal/domain/coupon/model"
+	"course-platform/internal/domain/coupon/service"
+	"course-platform/internal/shared/pb/couponpb"
+)
+
+// CouponHandler 优惠券gRPC处理器
+type CouponHandler struct {
+	couponpb.UnimplementedCouponServiceServer
+	couponService service.CouponServiceInterface
+}
+
+// NewCouponHandler 创建优惠券gRPC处理器实例
+func NewCouponHandler(couponService service.CouponServiceInterface) *CouponHandler {
+	return &CouponHandler{
+		couponService: couponService,
+	}
+}
+
+// CreateCoupon 处理创建优惠券gRPC请求
+func (h *CouponHandler) CreateCoupon(ctx context.Context, req *couponpb.CreateCouponRequest) (*couponpb.CreateCouponResponse, error) {
+	log.Printf("🔍 gRPC: 收到创建优惠券请求 - 优惠码: %s, 课程ID: %d", req.Code, req.CourseId)
+
+	startsAt, err := parseOptionalTime(req.StartsAt)
+	if err != nil {
+		return &couponpb.CreateCouponResponse{Code: 400, Message: "生效时间格式无效"}, nil
+	}
+	endsAt, err := parseOptionalTime(req.EndsAt)
+	if err != nil {
+		return &couponpb.CreateCouponResponse{Code: 400, Message: "失效时间格式无效"}, nil
+	}
+
+	coupon, err := h.couponService.CreateCoupon(&service.CreateCouponRequest{
+		UserID:         uint(req.UserId),
+		Code:           req.Code,
+		DiscountType:   req.DiscountType,
+		DiscountValue:  req.DiscountValue,
+		CourseID:       uint(req.CourseId),
+		MaxUses:        int(req.MaxUses),
+		MaxUsesPerUser: int(req.MaxUsesPerUser),
+		StartsAt:       startsAt,
+		EndsAt:         endsAt,
+	})
+	if err != nil {
+		log.Printf("❌ gRPC: 创建优惠券失败 - %v", err)
+		return &couponpb.CreateCouponResponse{
+			Code:    couponErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &couponpb.CreateCouponResponse{
+		Code:    200,
+		Message: "优惠券创建成功",
+		Coupon:  convertCouponToPB(coupon),
+	}, nil
+}
+
+// ListCoupons 处理获取优惠券列表gRPC请求
+func (h *CouponHandler) ListCoupons(ctx context.Context, req *couponpb.ListCouponsRequest) (*couponpb.ListCouponsResponse, error) {
+	coupons, err := h.couponService.ListCoupons(uint(req.UserId), uint(req.CourseId))
+	if err != nil {
+		return &couponpb.ListCouponsResponse{
+			Code:    couponErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	pbCoupons := make([]*couponpb.Coupon, len(coupons))
+	for i, coupon := range coupons {
+		pbCoupons[i] = convertCouponToPB(coupon)
+	}
+
+	return &couponpb.ListCouponsResponse{
+		Code:    200,
+		Message: "获取优惠券列表成功",
+		Coupons: pbCoupons,
+	}, nil
+}
+
+// DeactivateCoupon 处理停用优惠券gRPC请求
+func (h *CouponHandler) DeactivateCoupon(ctx context.Context, req *couponpb.DeactivateCouponRequest) (*couponpb.DeactivateCouponResponse, error) {
+	coupon, err := h.couponService.DeactivateCoupon(req.Code, uint(req.UserId))
+	if err != nil {
+		log.Printf("❌ gRPC: 停用优惠券失败 - %v", err)
+		return &couponpb.DeactivateCouponResponse{
+			Code:    couponErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &couponpb.DeactivateCouponResponse{
+		Code:    200,
+		Message: "优惠券已停用",
+		Coupon:  convertCouponToPB(coupon),
+	}, nil
+}
+
+// convertCouponToPB 将优惠券模型转换为protobuf消息
+func convertCouponToPB(coupon *model.Coupon) *couponpb.Coupon {
+	return &couponpb.Coupon{
+		Id:             uint32(coupon.ID),
+		Code:           coupon.Code,
+		DiscountType:   coupon.DiscountType,
+		DiscountValue:  coupon.DiscountValue,
+		CourseId:       uint32(coupon.CourseID),
+		CreatorId:      uint32(coupon.CreatorID),
+		MaxUses:        int32(coupon.MaxUses),
+		MaxUsesPerUser: int32(coupon.MaxUsesPerUser),
+		UsedCount:      int32(coupon.UsedCount),
+		StartsAt:       formatOptionalTime(coupon.StartsAt),
+		EndsAt:         formatOptionalTime(coupon.EndsAt),
+		Active:         coupon.Active,
+		CreatedAt:      coupon.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// couponErrorCode 根据错误信息映射业务状态码
+func couponErrorCode(err error) int32 {
+	msg := err.Error()
+	switch {
+	case strings.Contains(msg, "只有"):
+		return 403
+	case strings.Contains(msg, "不存在"):
+		return 404
+	case strings.Contains(msg, "已存在"):
+		return 409
+	default:
+		return 400
+	}
+}
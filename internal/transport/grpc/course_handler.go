@@ -2,9 +2,15 @@
 
 import (
 	"context"
+	"errors"
 	"log"
+	"strings"
+	"time"
 
+	certificateService "course-platform/internal/domain/certificate/service"
+	"course-platform/internal/domain/course/model"
 	"course-platform/internal/domain/course/service"
+	userModel "course-platform/internal/domain/user/model"
 	"course-platform/internal/shared/pb/coursepb"
 )
 
@@ -12,13 +18,15 @@ import (
 // 处理来自API Gateway的课程相关gRPC请求，调用课程服务完成业务逻辑
 type CourseHandler struct {
 	coursepb.UnimplementedCourseServiceServer
-	courseService service.CourseServiceInterface
+	courseService      service.CourseServiceInterface
+	certificateService certificateService.CertificateServiceInterface
 }
 
 // NewCourseHandler 创建课程gRPC处理器实例
-func NewCourseHandler(courseService service.CourseServiceInterface) *CourseHandler {
+func NewCourseHandler(courseService service.CourseServiceInterface, certificateService certificateService.CertificateServiceInterface) *CourseHandler {
 	return &CourseHandler{
-		courseService: courseService,
+		courseService:      courseService,
+		certificateService: certificateService,
 	}
 }
 
@@ -37,26 +45,19 @@ func (h *CourseHandler) CreateCourse(ctx context.Context, req *coursepb.CreateCo
 	)
 	if err != nil {
 		log.Printf("❌ gRPC: 创建课程失败 - %v", err)
+		code := int32(400)
+		if errors.Is(err, userModel.ErrEmailNotVerified) {
+			code = 403
+		}
 		return &coursepb.CreateCourseResponse{
-			Code:    400,
+			Code:    code,
 			Message: err.Error(),
 			Course:  nil,
 		}, nil
 	}
 
 	// 转换为protobuf课程对象
-	pbCourse := &coursepb.Course{
-		Id:           uint32(course.ID),
-		Title:        course.Title,
-		Description:  course.Description,
-		InstructorId: uint32(course.InstructorID),
-		CategoryId:   uint32(course.CategoryID),
-		Price:        course.Price,
-		CoverImage:   course.CoverImage,
-		Status:       course.Status,
-		CreatedAt:    course.CreatedAt.Format("2006-01-02 15:04:05"),
-		UpdatedAt:    course.UpdatedAt.Format("2006-01-02 15:04:05"),
-	}
+	pbCourse := convertCourseToPB(course)
 
 	log.Printf("✅ gRPC: 创建课程成功 - 课程ID: %d", course.ID)
 	return &coursepb.CreateCourseResponse{
@@ -89,18 +90,7 @@ func (h *CourseHandler) GetCourses(ctx context.Context, req *coursepb.GetCourses
 	// 转换为protobuf课程列表
 	var pbCourses []*coursepb.Course
 	for _, course := range courses {
-		pbCourse := &coursepb.Course{
-			Id:           uint32(course.ID),
-			Title:        course.Title,
-			Description:  course.Description,
-			InstructorId: uint32(course.InstructorID),
-			CategoryId:   uint32(course.CategoryID),
-			Price:        course.Price,
-			CoverImage:   course.CoverImage,
-			Status:       course.Status,
-			CreatedAt:    course.CreatedAt.Format("2006-01-02 15:04:05"),
-			UpdatedAt:    course.UpdatedAt.Format("2006-01-02 15:04:05"),
-		}
+		pbCourse := convertCourseToPB(course)
 		pbCourses = append(pbCourses, pbCourse)
 	}
 
@@ -129,24 +119,20 @@ func (h *CourseHandler) GetCourse(ctx context.Context, req *coursepb.GetCourseRe
 	}
 
 	// 转换为protobuf课程对象
-	pbCourse := &coursepb.Course{
-		Id:           uint32(course.ID),
-		Title:        course.Title,
-		Description:  course.Description,
-		InstructorId: uint32(course.InstructorID),
-		CategoryId:   uint32(course.CategoryID),
-		Price:        course.Price,
-		CoverImage:   course.CoverImage,
-		Status:       course.Status,
-		CreatedAt:    course.CreatedAt.Format("2006-01-02 15:04:05"),
-		UpdatedAt:    course.UpdatedAt.Format("2006-01-02 15:04:05"),
+	pbCourse := convertCourseToPB(course)
+
+	// 先修关系图获取失败不影响课程详情
+	prerequisites, err := h.courseService.GetPrerequisiteGraph(course.ID, uint(req.UserId))
+	if err != nil {
+		log.Printf("⚠️ gRPC: 获取先修课程失败 - %v", err)
 	}
 
 	log.Printf("✅ gRPC: 获取课程成功 - 课程ID: %d", course.ID)
 	return &coursepb.GetCourseResponse{
-		Code:    200,
-		Message: "获取成功",
-		Course:  pbCourse,
+		Code:          200,
+		Message:       "获取成功",
+		Course:        pbCourse,
+		Prerequisites: convertPrerequisitesToPB(prerequisites),
 	}, nil
 }
 
@@ -180,18 +166,7 @@ func (h *CourseHandler) PublishCourse(ctx context.Context, req *coursepb.Publish
 	}
 
 	// 转换为protobuf课程对象
-	pbCourse := &coursepb.Course{
-		Id:           uint32(course.ID),
-		Title:        course.Title,
-		Description:  course.Description,
-		InstructorId: uint32(course.InstructorID),
-		CategoryId:   uint32(course.CategoryID),
-		Price:        course.Price,
-		CoverImage:   course.CoverImage,
-		Status:       course.Status,
-		CreatedAt:    course.CreatedAt.Format("2006-01-02 15:04:05"),
-		UpdatedAt:    course.UpdatedAt.Format("2006-01-02 15:04:05"),
-	}
+	pbCourse := convertCourseToPB(course)
 
 	log.Printf("✅ gRPC: 发布课程成功 - 课程ID: %d", course.ID)
 	return &coursepb.PublishCourseResponse{
@@ -224,18 +199,7 @@ func (h *CourseHandler) UpdateCourse(ctx context.Context, req *coursepb.UpdateCo
 	}
 
 	// 转换为protobuf课程对象
-	pbCourse := &coursepb.Course{
-		Id:           uint32(course.ID),
-		Title:        course.Title,
-		Description:  course.Description,
-		InstructorId: uint32(course.InstructorID),
-		CategoryId:   uint32(course.CategoryID),
-		Price:        course.Price,
-		CoverImage:   course.CoverImage,
-		Status:       course.Status,
-		CreatedAt:    course.CreatedAt.Format("2006-01-02 15:04:05"),
-		UpdatedAt:    course.UpdatedAt.Format("2006-01-02 15:04:05"),
-	}
+	pbCourse := convertCourseToPB(course)
 
 	log.Printf("✅ gRPC: 更新课程成功 - 课程ID: %d", course.ID)
 	return &coursepb.UpdateCourseResponse{
@@ -244,3 +208,405 @@ func (h *CourseHandler) UpdateCourse(ctx context.Context, req *coursepb.UpdateCo
 		Course:  pbCourse,
 	}, nil
 }
+
+// EnrollCourse 处理报名课程gRPC请求
+func (h *CourseHandler) EnrollCourse(ctx context.Context, req *coursepb.EnrollCourseRequest) (*coursepb.EnrollCourseResponse, error) {
+	log.Printf("🔍 gRPC: 收到报名课程请求 - 课程ID: %d, 用户ID: %d", req.CourseId, req.UserId)
+
+	enrollment, err := h.courseService.EnrollCourse(uint(req.UserId), uint(req.CourseId))
+	if err != nil {
+		log.Printf("❌ gRPC: 报名课程失败 - %v", err)
+
+		// 先修要求未满足时逐项返回缺口，便于前端展示
+		var prerequisiteErr *service.PrerequisiteError
+		if errors.As(err, &prerequisiteErr) {
+			missing := make([]*coursepb.MissingPrerequisite, 0, len(prerequisiteErr.Missing))
+			for _, m := range prerequisiteErr.Missing {
+				missing = append(missing, &coursepb.MissingPrerequisite{
+					CourseId:           uint32(m.CourseID),
+					CourseTitle:        m.CourseTitle,
+					MinProgressPercent: int32(m.MinProgressPercent),
+					CurrentPercent:     int32(m.CurrentPercent),
+					Enrolled:           m.Enrolled,
+				})
+			}
+			return &coursepb.EnrollCourseResponse{
+				Code:                 403,
+				Message:              err.Error(),
+				MissingPrerequisites: missing,
+			}, nil
+		}
+
+		return &coursepb.EnrollCourseResponse{
+			Code:    400,
+			Message: err.Error(),
+		}, nil
+	}
+
+	log.Printf("✅ gRPC: 报名课程成功 - 课程ID: %d", req.CourseId)
+	return &coursepb.EnrollCourseResponse{
+		Code:    200,
+		Message: "报名成功",
+		Enrollment: &coursepb.Enrollment{
+			Id:         uint32(enrollment.ID),
+			CourseId:   uint32(enrollment.CourseID),
+			UserId:     uint32(enrollment.UserID),
+			Status:     enrollment.Status,
+			EnrolledAt: enrollment.EnrolledAt.Format("2006-01-02 15:04:05"),
+		},
+	}, nil
+}
+
+// CheckCourseAccess 处理课程访问权限检查gRPC请求
+func (h *CourseHandler) CheckCourseAccess(ctx context.Context, req *coursepb.CheckCourseAccessRequest) (*coursepb.CheckCourseAccessResponse, error) {
+	hasAccess, err := h.courseService.HasCourseAccess(uint(req.UserId), uint(req.CourseId))
+	if err != nil {
+		log.Printf("❌ gRPC: 检查课程访问权限失败 - %v", err)
+		return &coursepb.CheckCourseAccessResponse{
+			Code:    404,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &coursepb.CheckCourseAccessResponse{
+		Code:      200,
+		Message:   "检查成功",
+		HasAccess: hasAccess,
+	}, nil
+}
+
+// CreateChapter 处理创建章节gRPC请求
+func (h *CourseHandler) CreateChapter(ctx context.Context, req *coursepb.CreateChapterRequest) (*coursepb.CreateChapterResponse, error) {
+	log.Printf("🔍 gRPC: 收到创建章节请求 - 课程ID: %d, 标题: %s", req.CourseId, req.Title)
+
+	chapter, err := h.courseService.CreateChapter(uint(req.CourseId), uint(req.UserId), req.Title, req.Description, int(req.SortOrder))
+	if err != nil {
+		log.Printf("❌ gRPC: 创建章节失败 - %v", err)
+		return &coursepb.CreateChapterResponse{
+			Code:    400,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &coursepb.CreateChapterResponse{
+		Code:    200,
+		Message: "章节创建成功",
+		Chapter: convertChapterToPB(chapter),
+	}, nil
+}
+
+// GetChapters 处理获取章节列表gRPC请求
+func (h *CourseHandler) GetChapters(ctx context.Context, req *coursepb.GetChaptersRequest) (*coursepb.GetChaptersResponse, error) {
+	chapters, err := h.courseService.GetChaptersForUser(uint(req.CourseId), uint(req.UserId))
+	if err != nil {
+		log.Printf("❌ gRPC: 获取章节列表失败 - %v", err)
+		return &coursepb.GetChaptersResponse{
+			Code:    400,
+			Message: err.Error(),
+		}, nil
+	}
+
+	pbChapters := make([]*coursepb.Chapter, len(chapters))
+	for i, chapter := range chapters {
+		pbChapters[i] = convertChapterToPB(chapter)
+	}
+
+	return &coursepb.GetChaptersResponse{
+		Code:     200,
+		Message:  "获取成功",
+		Chapters: pbChapters,
+	}, nil
+}
+
+// CompleteChapter 处理完成章节gRPC请求，学完全部章节时颁发证书
+func (h *CourseHandler) CompleteChapter(ctx context.Context, req *coursepb.CompleteChapterRequest) (*coursepb.CompleteChapterResponse, error) {
+	log.Printf("🔍 gRPC: 收到完成章节请求 - 用户ID: %d, 章节ID: %d", req.UserId, req.ChapterId)
+
+	progress, err := h.courseService.CompleteChapter(uint(req.UserId), uint(req.CourseId), uint(req.ChapterId))
+	if err != nil {
+		log.Printf("❌ gRPC: 完成章节失败 - %v", err)
+		return &coursepb.CompleteChapterResponse{
+			Code:    progressErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	resp := &coursepb.CompleteChapterResponse{
+		Code:     200,
+		Message:  "章节已完成",
+		Progress: convertProgressToPB(progress),
+	}
+	if progress.IsCompleted() {
+		certificate, err := h.certificateService.IssueCertificate(ctx, uint(req.UserId), uint(req.CourseId))
+		if err != nil {
+			// 进度已保存，证书可在之后完成任意章节时补发
+			log.Printf("⚠️ gRPC: 颁发证书失败 - %v", err)
+		} else {
+			resp.Message = "恭喜完成全部课程内容，证书已颁发"
+			resp.CertificateCode = certificate.Code
+		}
+	}
+	return resp, nil
+}
+
+// GetCourseProgress 处理获取学习进度gRPC请求
+func (h *CourseHandler) GetCourseProgress(ctx context.Context, req *coursepb.GetCourseProgressRequest) (*coursepb.GetCourseProgressResponse, error) {
+	progress, err := h.courseService.GetCourseProgress(uint(req.UserId), uint(req.CourseId))
+	if err != nil {
+		log.Printf("❌ gRPC: 获取学习进度失败 - %v", err)
+		return &coursepb.GetCourseProgressResponse{
+			Code:    progressErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &coursepb.GetCourseProgressResponse{
+		Code:     200,
+		Message:  "获取成功",
+		Progress: convertProgressToPB(progress),
+	}, nil
+}
+
+// SetCourseSale 处理设置课程促销价gRPC请求
+func (h *CourseHandler) SetCourseSale(ctx context.Context, req *coursepb.SetCourseSaleRequest) (*coursepb.SetCourseSaleResponse, error) {
+	log.Printf("🔍 gRPC: 收到设置促销请求 - 课程ID: %d", req.CourseId)
+
+	var salePrice *float32
+	var startsAt, endsAt *time.Time
+	if !req.Clear {
+		salePrice = &req.SalePrice
+		var err error
+		if startsAt, err = parseOptionalTime(req.StartsAt); err != nil {
+			return &coursepb.SetCourseSaleResponse{Code: 400, Message: "促销开始时间格式无效"}, nil
+		}
+		if endsAt, err = parseOptionalTime(req.EndsAt); err != nil {
+			return &coursepb.SetCourseSaleResponse{Code: 400, Message: "促销结束时间格式无效"}, nil
+		}
+	}
+
+	course, err := h.courseService.SetCourseSale(uint(req.CourseId), uint(req.UserId), salePrice, startsAt, endsAt)
+	if err != nil {
+		log.Printf("❌ gRPC: 设置促销失败 - %v", err)
+		code := int32(400)
+		switch {
+		case strings.Contains(err.Error(), "只有课程讲师"):
+			code = 403
+		case strings.Contains(err.Error(), "不存在"):
+			code = 404
+		}
+		return &coursepb.SetCourseSaleResponse{
+			Code:    code,
+			Message: err.Error(),
+		}, nil
+	}
+
+	message := "促销价设置成功"
+	if req.Clear {
+		message = "促销已取消"
+	}
+	return &coursepb.SetCourseSaleResponse{
+		Code:    200,
+		Message: message,
+		Course:  convertCourseToPB(course),
+	}, nil
+}
+
+// SetCoursePrerequisites 处理设置先修课程gRPC请求
+func (h *CourseHandler) SetCoursePrerequisites(ctx context.Context, req *coursepb.SetCoursePrerequisitesRequest) (*coursepb.SetCoursePrerequisitesResponse, error) {
+	log.Printf("🔍 gRPC: 收到设置先修课程请求 - 课程ID: %d", req.CourseId)
+
+	inputs := make([]service.PrerequisiteInput, 0, len(req.Prerequisites))
+	for _, p := range req.Prerequisites {
+		inputs = append(inputs, service.PrerequisiteInput{
+			RequiredCourseID:   uint(p.RequiredCourseId),
+			MinProgressPercent: int(p.MinProgressPercent),
+		})
+	}
+
+	prerequisites, err := h.courseService.SetPrerequisites(uint(req.CourseId), uint(req.UserId), inputs)
+	if err != nil {
+		log.Printf("❌ gRPC: 设置先修课程失败 - %v", err)
+		code := int32(400)
+		switch {
+		case strings.Contains(err.Error(), "只有课程讲师"):
+			code = 403
+		case strings.Contains(err.Error(), "不存在"):
+			code = 404
+		}
+		return &coursepb.SetCoursePrerequisitesResponse{
+			Code:    code,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &coursepb.SetCoursePrerequisitesResponse{
+		Code:          200,
+		Message:       "先修课程设置成功",
+		Prerequisites: convertPrerequisitesToPB(prerequisites),
+	}, nil
+}
+
+// SetChapterRelease 处理设置章节开放规则gRPC请求
+func (h *CourseHandler) SetChapterRelease(ctx context.Context, req *coursepb.SetChapterReleaseRequest) (*coursepb.SetChapterReleaseResponse, error) {
+	log.Printf("🔍 gRPC: 收到设置章节开放规则请求 - 章节ID: %d, 方式: %s", req.ChapterId, req.ReleaseType)
+
+	releaseAt, err := parseOptionalTime(req.ReleaseAt)
+	if err != nil {
+		return &coursepb.SetChapterReleaseResponse{Code: 400, Message: "开放时间格式无效"}, nil
+	}
+
+	chapter, err := h.courseService.SetChapterRelease(uint(req.CourseId), uint(req.ChapterId), uint(req.UserId), req.ReleaseType, releaseAt, int(req.ReleaseAfterDays))
+	if err != nil {
+		log.Printf("❌ gRPC: 设置章节开放规则失败 - %v", err)
+		code := int32(400)
+		switch {
+		case strings.Contains(err.Error(), "只有课程讲师"):
+			code = 403
+		case strings.Contains(err.Error(), "不存在"):
+			code = 404
+		}
+		return &coursepb.SetChapterReleaseResponse{
+			Code:    code,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &coursepb.SetChapterReleaseResponse{
+		Code:    200,
+		Message: "章节开放规则设置成功",
+		Chapter: convertChapterToPB(chapter),
+	}, nil
+}
+
+// CheckChapterRelease 处理检查章节开放gRPC请求
+func (h *CourseHandler) CheckChapterRelease(ctx context.Context, req *coursepb.CheckChapterReleaseRequest) (*coursepb.CheckChapterReleaseResponse, error) {
+	chapter, err := h.courseService.CheckChapterRelease(uint(req.UserId), uint(req.ChapterId))
+	if err != nil {
+		log.Printf("❌ gRPC: 检查章节开放失败 - %v", err)
+		code := int32(500)
+		if strings.Contains(err.Error(), "不存在") {
+			code = 404
+		}
+		return &coursepb.CheckChapterReleaseResponse{
+			Code:    code,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &coursepb.CheckChapterReleaseResponse{
+		Code:    200,
+		Message: "检查成功",
+		Chapter: convertChapterToPB(chapter),
+	}, nil
+}
+
+// convertPrerequisitesToPB 转换先修关系为protobuf格式
+func convertPrerequisitesToPB(prerequisites []*model.CoursePrerequisite) []*coursepb.CoursePrerequisite {
+	result := make([]*coursepb.CoursePrerequisite, 0, len(prerequisites))
+	for _, p := range prerequisites {
+		result = append(result, &coursepb.CoursePrerequisite{
+			CourseId:            uint32(p.CourseID),
+			RequiredCourseId:    uint32(p.RequiredCourseID),
+			RequiredCourseTitle: p.RequiredCourseTitle,
+			MinProgressPercent:  int32(p.MinProgressPercent),
+			CurrentPercent:      int32(p.CurrentPercent),
+			Satisfied:           p.Satisfied,
+		})
+	}
+	return result
+}
+
+// parseOptionalTime 解析RFC3339时间，空字符串返回nil
+func parseOptionalTime(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// convertProgressToPB 将学习进度转换为protobuf对象
+func convertProgressToPB(progress *model.CourseProgress) *coursepb.CourseProgress {
+	chapterIDs := make([]uint32, len(progress.CompletedChapterIDs))
+	for i, id := range progress.CompletedChapterIDs {
+		chapterIDs[i] = uint32(id)
+	}
+	return &coursepb.CourseProgress{
+		CourseId:            uint32(progress.CourseID),
+		CompletedChapterIds: chapterIDs,
+		TotalChapters:       uint32(progress.TotalChapters),
+		Completed:           progress.IsCompleted(),
+	}
+}
+
+// progressErrorCode 根据错误信息映射学习进度相关的业务状态码
+func progressErrorCode(err error) int32 {
+	msg := err.Error()
+	switch {
+	case strings.Contains(msg, "请先报名"), strings.Contains(msg, "尚未开放"):
+		return 403
+	case strings.Contains(msg, "不存在"):
+		return 404
+	default:
+		return 400
+	}
+}
+
+// convertCourseToPB 将课程模型转换为protobuf课程对象，附带当前的促销价格
+func convertCourseToPB(course *model.Course) *coursepb.Course {
+	now := time.Now()
+	pbCourse := &coursepb.Course{
+		Id:             uint32(course.ID),
+		Title:          course.Title,
+		Description:    course.Description,
+		InstructorId:   uint32(course.InstructorID),
+		CategoryId:     uint32(course.CategoryID),
+		Price:          course.Price,
+		CoverImage:     course.CoverImage,
+		Status:         course.Status,
+		CreatedAt:      course.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:      course.UpdatedAt.Format("2006-01-02 15:04:05"),
+		EffectivePrice: course.EffectivePrice(now),
+		OnSale:         course.OnSale(now),
+	}
+	if course.SalePrice != nil {
+		pbCourse.SalePrice = *course.SalePrice
+		pbCourse.HasSale = true
+	}
+	if course.SaleStartsAt != nil {
+		pbCourse.SaleStartsAt = course.SaleStartsAt.Format(time.RFC3339)
+	}
+	if course.SaleEndsAt != nil {
+		pbCourse.SaleEndsAt = course.SaleEndsAt.Format(time.RFC3339)
+	}
+	return pbCourse
+}
+
+// convertChapterToPB 将章节模型转换为protobuf章节对象
+func convertChapterToPB(chapter *model.Chapter) *coursepb.Chapter {
+	pbChapter := &coursepb.Chapter{
+		Id:          uint32(chapter.ID),
+		CourseId:    uint32(chapter.CourseID),
+		Title:       chapter.Title,
+		Description: chapter.Description,
+		SortOrder:   uint32(chapter.SortOrder),
+		CreatedAt:   chapter.CreatedAt.Format("2006-01-02 15:04:05"),
+
+		ReleaseType:      chapter.ReleaseType,
+		ReleaseAfterDays: int32(chapter.ReleaseAfterDays),
+		Released:         chapter.Released,
+	}
+	if pbChapter.ReleaseType == "" {
+		pbChapter.ReleaseType = model.ReleaseImmediate
+	}
+	if chapter.ReleaseAt != nil {
+		pbChapter.ReleaseAt = chapter.ReleaseAt.Format(time.RFC3339)
+	}
+	if chapter.UnlockAt != nil {
+		pbChapter.UnlockAt = chapter.UnlockAt.Format(time.RFC3339)
+	}
+	return pbChapter
+}
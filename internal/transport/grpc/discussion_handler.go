@@ -0,0 +1,273 @@
+package grpc
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"course-platform/internal/domain/discussion/model"
+	"course-platform/internal/domain/discussion/service"
+	"course-platform/internal/shared/pb/discussionpb"
+)
+
+// DiscussionHandler 课程讨论区gRPC处理器
+type DiscussionHandler struct {
+	discussionpb.UnimplementedDiscussionServiceServer
+	discussionService service.DiscussionServiceInterface
+}
+
+// NewDiscussionHandler 创建课程讨论区gRPC处理器实例
+func NewDiscussionHandler(discussionService service.DiscussionServiceInterface) *DiscussionHandler {
+	return &DiscussionHandler{
+		discussionService: discussionService,
+	}
+}
+
+// CreateThread 处理发帖gRPC请求
+func (h *DiscussionHandler) CreateThread(ctx context.Context, req *discussionpb.CreateThreadRequest) (*discussionpb.CreateThreadResponse, error) {
+	log.Printf("🔍 gRPC: 收到发帖请求 - 课程ID: %d, 标题: %s", req.CourseId, req.Title)
+
+	thread, err := h.discussionService.CreateThread(&service.CreateThreadRequest{
+		CourseID:  uint(req.CourseId),
+		ChapterID: uint(req.ChapterId),
+		UserID:    uint(req.UserId),
+		Title:     req.Title,
+		Body:      req.Body,
+	})
+	if err != nil {
+		log.Printf("❌ gRPC: 发帖失败 - %v", err)
+		return &discussionpb.CreateThreadResponse{
+			Code:    discussionErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &discussionpb.CreateThreadResponse{
+		Code:    200,
+		Message: "发布成功",
+		Thread:  convertThreadToPB(thread),
+	}, nil
+}
+
+// ListThreads 处理讨论帖列表gRPC请求
+func (h *DiscussionHandler) ListThreads(ctx context.Context, req *discussionpb.ListThreadsRequest) (*discussionpb.ListThreadsResponse, error) {
+	threads, total, err := h.discussionService.ListThreads(&service.ListThreadsRequest{
+		CourseID:  uint(req.CourseId),
+		ChapterID: uint(req.ChapterId),
+		UserID:    uint(req.UserId),
+		Sort:      req.Sort,
+		Page:      int(req.Page),
+		PageSize:  int(req.PageSize),
+	})
+	if err != nil {
+		log.Printf("❌ gRPC: 获取讨论帖列表失败 - %v", err)
+		return &discussionpb.ListThreadsResponse{
+			Code:    discussionErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	pbThreads := make([]*discussionpb.Thread, 0, len(threads))
+	for _, thread := range threads {
+		pbThreads = append(pbThreads, convertThreadToPB(thread))
+	}
+	return &discussionpb.ListThreadsResponse{
+		Code:    200,
+		Message: "获取成功",
+		Threads: pbThreads,
+		Total:   total,
+	}, nil
+}
+
+// GetThread 处理获取讨论帖gRPC请求
+func (h *DiscussionHandler) GetThread(ctx context.Context, req *discussionpb.GetThreadRequest) (*discussionpb.GetThreadResponse, error) {
+	thread, replies, total, err := h.discussionService.GetThread(uint(req.ThreadId), uint(req.UserId), int(req.Page), int(req.PageSize))
+	if err != nil {
+		log.Printf("❌ gRPC: 获取讨论帖失败 - %v", err)
+		return &discussionpb.GetThreadResponse{
+			Code:    discussionErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	pbReplies := make([]*discussionpb.Reply, 0, len(replies))
+	for _, reply := range replies {
+		pbReplies = append(pbReplies, convertReplyToPB(reply))
+	}
+	return &discussionpb.GetThreadResponse{
+		Code:         200,
+		Message:      "获取成功",
+		Thread:       convertThreadToPB(thread),
+		Replies:      pbReplies,
+		TotalReplies: total,
+	}, nil
+}
+
+// CreateReply 处理回复gRPC请求
+func (h *DiscussionHandler) CreateReply(ctx context.Context, req *discussionpb.CreateReplyRequest) (*discussionpb.CreateReplyResponse, error) {
+	reply, err := h.discussionService.CreateReply(uint(req.ThreadId), uint(req.ParentId), uint(req.UserId), req.Body)
+	if err != nil {
+		log.Printf("❌ gRPC: 回复失败 - %v", err)
+		return &discussionpb.CreateReplyResponse{
+			Code:    discussionErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &discussionpb.CreateReplyResponse{
+		Code:    200,
+		Message: "回复成功",
+		Reply:   convertReplyToPB(reply),
+	}, nil
+}
+
+// Vote 处理点赞gRPC请求
+func (h *DiscussionHandler) Vote(ctx context.Context, req *discussionpb.VoteRequest) (*discussionpb.VoteResponse, error) {
+	upvotes, err := h.discussionService.Vote(uint(req.UserId), req.TargetType, uint(req.TargetId), req.Upvote)
+	if err != nil {
+		log.Printf("❌ gRPC: 点赞失败 - %v", err)
+		return &discussionpb.VoteResponse{
+			Code:    discussionErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	message := "点赞成功"
+	if !req.Upvote {
+		message = "已取消点赞"
+	}
+	return &discussionpb.VoteResponse{
+		Code:    200,
+		Message: message,
+		Upvotes: int32(upvotes),
+		Upvoted: req.Upvote,
+	}, nil
+}
+
+// ModerateThread 处理管理讨论帖gRPC请求
+func (h *DiscussionHandler) ModerateThread(ctx context.Context, req *discussionpb.ModerateThreadRequest) (*discussionpb.ModerateThreadResponse, error) {
+	thread, err := h.discussionService.ModerateThread(uint(req.ThreadId), uint(req.UserId), req.Action)
+	if err != nil {
+		log.Printf("❌ gRPC: 管理讨论帖失败 - %v", err)
+		return &discussionpb.ModerateThreadResponse{
+			Code:    discussionErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &discussionpb.ModerateThreadResponse{
+		Code:    200,
+		Message: "操作成功",
+		Thread:  convertThreadToPB(thread),
+	}, nil
+}
+
+// MarkAnswer 处理标记最佳回答gRPC请求
+func (h *DiscussionHandler) MarkAnswer(ctx context.Context, req *discussionpb.MarkAnswerRequest) (*discussionpb.MarkAnswerResponse, error) {
+	thread, err := h.discussionService.MarkAnswer(uint(req.ThreadId), uint(req.ReplyId), uint(req.UserId))
+	if err != nil {
+		log.Printf("❌ gRPC: 标记最佳回答失败 - %v", err)
+		return &discussionpb.MarkAnswerResponse{
+			Code:    discussionErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	message := "已标记为最佳回答"
+	if req.ReplyId == 0 {
+		message = "已取消最佳回答"
+	}
+	return &discussionpb.MarkAnswerResponse{
+		Code:    200,
+		Message: message,
+		Thread:  convertThreadToPB(thread),
+	}, nil
+}
+
+// DeleteThread 处理删除讨论帖gRPC请求
+func (h *DiscussionHandler) DeleteThread(ctx context.Context, req *discussionpb.DeleteThreadRequest) (*discussionpb.DeleteThreadResponse, error) {
+	if err := h.discussionService.DeleteThread(uint(req.ThreadId), uint(req.UserId)); err != nil {
+		log.Printf("❌ gRPC: 删除讨论帖失败 - %v", err)
+		return &discussionpb.DeleteThreadResponse{
+			Code:    discussionErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &discussionpb.DeleteThreadResponse{
+		Code:    200,
+		Message: "讨论帖已删除",
+	}, nil
+}
+
+// DeleteReply 处理删除回复gRPC请求
+func (h *DiscussionHandler) DeleteReply(ctx context.Context, req *discussionpb.DeleteReplyRequest) (*discussionpb.DeleteReplyResponse, error) {
+	if err := h.discussionService.DeleteReply(uint(req.ReplyId), uint(req.UserId)); err != nil {
+		log.Printf("❌ gRPC: 删除回复失败 - %v", err)
+		return &discussionpb.DeleteReplyResponse{
+			Code:    discussionErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &discussionpb.DeleteReplyResponse{
+		Code:    200,
+		Message: "回复已删除",
+	}, nil
+}
+
+// discussionErrorCode 根据错误信息映射业务码
+func discussionErrorCode(err error) int32 {
+	msg := err.Error()
+	switch {
+	case strings.Contains(msg, "无权"), strings.Contains(msg, "需要报名"), strings.Contains(msg, "已锁定"):
+		return 403
+	case strings.Contains(msg, "不存在"):
+		return 404
+	default:
+		return 400
+	}
+}
+
+// convertThreadToPB 将讨论帖模型转换为protobuf对象
+func convertThreadToPB(thread *model.Thread) *discussionpb.Thread {
+	return &discussionpb.Thread{
+		Id:                 uint32(thread.ID),
+		CourseId:           uint32(thread.CourseID),
+		ChapterId:          uint32(thread.ChapterID),
+		AuthorId:           uint32(thread.AuthorID),
+		AuthorName:         thread.AuthorName,
+		AuthorIsInstructor: thread.AuthorIsInstructor,
+		Title:              thread.Title,
+		Body:               thread.Body,
+		Pinned:             thread.Pinned,
+		Locked:             thread.Locked,
+		InstructorAnswered: thread.InstructorAnswered,
+		AnswerReplyId:      uint32(thread.AnswerReplyID),
+		ReplyCount:         int32(thread.ReplyCount),
+		Upvotes:            int32(thread.Upvotes),
+		Upvoted:            thread.Upvoted,
+		Deleted:            thread.IsDeleted(),
+		CreatedAt:          thread.CreatedAt.Format("2006-01-02 15:04:05"),
+		LastActivityAt:     thread.LastActivityAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+// convertReplyToPB 将回复模型转换为protobuf对象
+func convertReplyToPB(reply *model.Reply) *discussionpb.Reply {
+	return &discussionpb.Reply{
+		Id:           uint32(reply.ID),
+		ThreadId:     uint32(reply.ThreadID),
+		ParentId:     uint32(reply.ParentID),
+		Depth:        int32(reply.Depth),
+		AuthorId:     uint32(reply.AuthorID),
+		AuthorName:   reply.AuthorName,
+		Body:         reply.Body,
+		ByInstructor: reply.ByInstructor,
+		IsAnswer:     reply.IsAnswer,
+		Upvotes:      int32(reply.Upvotes),
+		Upvoted:      reply.Upvoted,
+		Deleted:      reply.IsDeleted(),
+		CreatedAt:    reply.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
@@ -0,0 +1,95 @@
+package grpc
+
+import (
+	"context"
+	"log"
+
+	"course-platform/internal/domain/email/service"
+	"course-platform/internal/shared/pb/emailpb"
+)
+
+// EmailHandler 邮件订阅gRPC处理器
+type EmailHandler struct {
+	emailpb.UnimplementedEmailServiceServer
+	emailService service.EmailServiceInterface
+}
+
+// NewEmailHandler 创建邮件订阅gRPC处理器实例
+func NewEmailHandler(emailService service.EmailServiceInterface) *EmailHandler {
+	return &EmailHandler{
+		emailService: emailService,
+	}
+}
+
+// GetEmailPreferences 处理获取邮件订阅状态gRPC请求
+func (h *EmailHandler) GetEmailPreferences(ctx context.Context, req *emailpb.GetEmailPreferencesRequest) (*emailpb.EmailPreferencesResponse, error) {
+	preferences, err := h.emailService.GetPreferences(uint(req.UserId))
+	if err != nil {
+		log.Printf("❌ gRPC: 获取邮件订阅状态失败 - %v", err)
+		return &emailpb.EmailPreferencesResponse{
+			Code:    400,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &emailpb.EmailPreferencesResponse{
+		Code:        200,
+		Message:     "获取成功",
+		Preferences: convertEmailPreferencesToPB(preferences),
+	}, nil
+}
+
+// UpdateEmailPreferences 处理更新邮件订阅状态gRPC请求
+func (h *EmailHandler) UpdateEmailPreferences(ctx context.Context, req *emailpb.UpdateEmailPreferencesRequest) (*emailpb.EmailPreferencesResponse, error) {
+	subscribed := make(map[string]bool, len(req.Preferences))
+	for _, preference := range req.Preferences {
+		subscribed[preference.Category] = preference.Subscribed
+	}
+
+	preferences, err := h.emailService.UpdatePreferences(uint(req.UserId), subscribed)
+	if err != nil {
+		log.Printf("❌ gRPC: 更新邮件订阅状态失败 - %v", err)
+		return &emailpb.EmailPreferencesResponse{
+			Code:    400,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &emailpb.EmailPreferencesResponse{
+		Code:        200,
+		Message:     "邮件订阅设置已保存",
+		Preferences: convertEmailPreferencesToPB(preferences),
+	}, nil
+}
+
+// Unsubscribe 处理退订gRPC请求
+func (h *EmailHandler) Unsubscribe(ctx context.Context, req *emailpb.UnsubscribeRequest) (*emailpb.UnsubscribeResponse, error) {
+	label, err := h.emailService.Unsubscribe(req.Token)
+	if err != nil {
+		log.Printf("❌ gRPC: 退订邮件失败 - %v", err)
+		return &emailpb.UnsubscribeResponse{
+			Code:    400,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &emailpb.UnsubscribeResponse{
+		Code:          200,
+		Message:       "已退订",
+		CategoryLabel: label,
+	}, nil
+}
+
+// convertEmailPreferencesToPB 将邮件订阅状态转换为protobuf对象
+func convertEmailPreferencesToPB(preferences []*service.PreferenceView) []*emailpb.EmailPreference {
+	pbPreferences := make([]*emailpb.EmailPreference, 0, len(preferences))
+	for _, preference := range preferences {
+		pbPreferences = append(pbPreferences, &emailpb.EmailPreference{
+			Category:   preference.Category,
+			Label:      preference.Label,
+			Required:   preference.Required,
+			Subscribed: preference.Subscribed,
+		})
+	}
+	return pbPreferences
+}
@@ -0,0 +1,89 @@
+package grpc
+
+import (
+	"context"
+	"log"
+
+	"course-platform/internal/domain/ledger/service"
+	"course-platform/internal/shared/pb/ledgerpb"
+)
+
+// LedgerHandler 收入分账gRPC处理器
+type LedgerHandler struct {
+	ledgerpb.UnimplementedLedgerServiceServer
+	ledgerService service.LedgerServiceInterface
+}
+
+// NewLedgerHandler 创建收入分账gRPC处理器实例
+func NewLedgerHandler(ledgerService service.LedgerServiceInterface) *LedgerHandler {
+	return &LedgerHandler{
+		ledgerService: ledgerService,
+	}
+}
+
+// GetCreatorStats 处理获取讲师统计gRPC请求
+func (h *LedgerHandler) GetCreatorStats(ctx context.Context, req *ledgerpb.GetCreatorStatsRequest) (*ledgerpb.GetCreatorStatsResponse, error) {
+	log.Printf("🔍 gRPC: 收到获取讲师统计请求 - 讲师ID: %d", req.InstructorId)
+
+	stats, err := h.ledgerService.GetCreatorStats(uint(req.InstructorId), int(req.Months))
+	if err != nil {
+		log.Printf("❌ gRPC: 获取讲师统计失败 - %v", err)
+		return &ledgerpb.GetCreatorStatsResponse{
+			Code:    400,
+			Message: err.Error(),
+		}, nil
+	}
+
+	monthly := make([]*ledgerpb.MonthlyRevenue, 0, len(stats.Monthly))
+	for _, m := range stats.Monthly {
+		monthly = append(monthly, &ledgerpb.MonthlyRevenue{
+			Month:        m.Month,
+			GrossAmount:  m.GrossAmount,
+			PlatformFee:  m.PlatformFee,
+			RefundAmount: m.RefundAmount,
+			NetAmount:    m.NetAmount,
+			Sales:        int32(m.Sales),
+			Refunds:      int32(m.Refunds),
+		})
+	}
+
+	return &ledgerpb.GetCreatorStatsResponse{
+		Code:    200,
+		Message: "获取讲师统计成功",
+		Stats: &ledgerpb.CreatorStats{
+			TotalCourses:         int32(stats.TotalCourses),
+			ActiveCourses:        int32(stats.ActiveCourses),
+			DraftCourses:         int32(stats.DraftCourses),
+			TotalStudents:        stats.TotalStudents,
+			ThisMonthStudents:    stats.ThisMonthStudents,
+			GrossRevenue:         stats.GrossRevenue,
+			PlatformFee:          stats.PlatformFee,
+			RefundAmount:         stats.RefundAmount,
+			TotalRevenue:         stats.TotalRevenue,
+			ThisMonthRevenue:     stats.ThisMonthRevenue,
+			PlatformSharePercent: int32(stats.PlatformSharePercent),
+			Currency:             stats.Currency,
+			Monthly:              monthly,
+		},
+	}, nil
+}
+
+// ExportCreatorLedger 处理导出讲师收入明细gRPC请求
+func (h *LedgerHandler) ExportCreatorLedger(ctx context.Context, req *ledgerpb.ExportCreatorLedgerRequest) (*ledgerpb.ExportCreatorLedgerResponse, error) {
+	log.Printf("🔍 gRPC: 收到导出收入明细请求 - 讲师ID: %d", req.InstructorId)
+
+	content, err := h.ledgerService.ExportCreatorLedger(uint(req.InstructorId), req.FromMonth, req.ToMonth)
+	if err != nil {
+		log.Printf("❌ gRPC: 导出收入明细失败 - %v", err)
+		return &ledgerpb.ExportCreatorLedgerResponse{
+			Code:    400,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &ledgerpb.ExportCreatorLedgerResponse{
+		Code:    200,
+		Message: "导出收入明细成功",
+		Content: content,
+	}, nil
+}
@@ -0,0 +1,143 @@
+package grpc
+
+import (
+	"context"
+	"log"
+
+	"course-platform/internal/domain/notification/model"
+	"course-platform/internal/domain/notification/service"
+	"course-platform/internal/shared/pb/notificationpb"
+)
+
+// NotificationHandler 站内通知gRPC处理器
+type NotificationHandler struct {
+	notificationpb.UnimplementedNotificationServiceServer
+	notificationService service.NotificationServiceInterface
+}
+
+// NewNotificationHandler 创建站内通知gRPC处理器实例
+func NewNotificationHandler(notificationService service.NotificationServiceInterface) *NotificationHandler {
+	return &NotificationHandler{
+		notificationService: notificationService,
+	}
+}
+
+// ListNotifications 处理通知列表gRPC请求
+func (h *NotificationHandler) ListNotifications(ctx context.Context, req *notificationpb.ListNotificationsRequest) (*notificationpb.ListNotificationsResponse, error) {
+	notifications, total, unread, err := h.notificationService.ListNotifications(uint(req.UserId), req.UnreadOnly, int(req.Page), int(req.PageSize))
+	if err != nil {
+		log.Printf("❌ gRPC: 获取通知列表失败 - %v", err)
+		return &notificationpb.ListNotificationsResponse{
+			Code:    400,
+			Message: err.Error(),
+		}, nil
+	}
+
+	pbNotifications := make([]*notificationpb.Notification, 0, len(notifications))
+	for _, notification := range notifications {
+		pbNotifications = append(pbNotifications, convertNotificationToPB(notification))
+	}
+	return &notificationpb.ListNotificationsResponse{
+		Code:          200,
+		Message:       "获取成功",
+		Notifications: pbNotifications,
+		Total:         total,
+		UnreadCount:   unread,
+	}, nil
+}
+
+// MarkNotificationsRead 处理标记通知已读gRPC请求
+func (h *NotificationHandler) MarkNotificationsRead(ctx context.Context, req *notificationpb.MarkNotificationsReadRequest) (*notificationpb.MarkNotificationsReadResponse, error) {
+	var unread int64
+	var err error
+	if req.All {
+		unread, err = h.notificationService.MarkAllRead(uint(req.UserId))
+	} else {
+		ids := make([]uint, 0, len(req.Ids))
+		for _, id := range req.Ids {
+			ids = append(ids, uint(id))
+		}
+		unread, err = h.notificationService.MarkRead(uint(req.UserId), ids)
+	}
+	if err != nil {
+		log.Printf("❌ gRPC: 标记通知已读失败 - %v", err)
+		return &notificationpb.MarkNotificationsReadResponse{
+			Code:    400,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &notificationpb.MarkNotificationsReadResponse{
+		Code:        200,
+		Message:     "已标记为已读",
+		UnreadCount: unread,
+	}, nil
+}
+
+// GetPreferences 处理获取通知偏好gRPC请求
+func (h *NotificationHandler) GetPreferences(ctx context.Context, req *notificationpb.GetPreferencesRequest) (*notificationpb.PreferencesResponse, error) {
+	preferences, err := h.notificationService.GetPreferences(uint(req.UserId))
+	if err != nil {
+		log.Printf("❌ gRPC: 获取通知偏好失败 - %v", err)
+		return &notificationpb.PreferencesResponse{
+			Code:    400,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &notificationpb.PreferencesResponse{
+		Code:        200,
+		Message:     "获取成功",
+		Preferences: convertPreferencesToPB(preferences),
+	}, nil
+}
+
+// UpdatePreferences 处理更新通知偏好gRPC请求
+func (h *NotificationHandler) UpdatePreferences(ctx context.Context, req *notificationpb.UpdatePreferencesRequest) (*notificationpb.PreferencesResponse, error) {
+	enabled := make(map[string]bool, len(req.Preferences))
+	for _, preference := range req.Preferences {
+		enabled[preference.Type] = preference.Enabled
+	}
+
+	preferences, err := h.notificationService.UpdatePreferences(uint(req.UserId), enabled)
+	if err != nil {
+		log.Printf("❌ gRPC: 更新通知偏好失败 - %v", err)
+		return &notificationpb.PreferencesResponse{
+			Code:    400,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &notificationpb.PreferencesResponse{
+		Code:        200,
+		Message:     "通知偏好已保存",
+		Preferences: convertPreferencesToPB(preferences),
+	}, nil
+}
+
+// convertNotificationToPB 将通知模型转换为protobuf对象
+func convertNotificationToPB(notification *model.Notification) *notificationpb.Notification {
+	return &notificationpb.Notification{
+		Id:        uint32(notification.ID),
+		Type:      notification.Type,
+		Title:     notification.Title,
+		Body:      notification.Body,
+		Link:      notification.Link,
+		RefId:     uint32(notification.RefID),
+		Read:      notification.IsRead(),
+		CreatedAt: notification.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+// convertPreferencesToPB 将通知偏好转换为protobuf对象
+func convertPreferencesToPB(preferences []*service.PreferenceView) []*notificationpb.Preference {
+	pbPreferences := make([]*notificationpb.Preference, 0, len(preferences))
+	for _, preference := range preferences {
+		pbPreferences = append(pbPreferences, &notificationpb.Preference{
+			Type:    preference.Type,
+			Label:   preference.Label,
+			Enabled: preference.Enabled,
+		})
+	}
+	return pbPreferences
+}
@@ -0,0 +1,234 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	couponRepository "course-platform/internal/domain/coupon/repository"
+	"course-platform/internal/domain/order/model"
+	"course-platform/internal/domain/order/service"
+	userModel "course-platform/internal/domain/user/model"
+	"course-platform/internal/infrastructure/payment"
+	"course-platform/internal/shared/pb/orderpb"
+)
+
+// OrderHandler 订单gRPC处理器
+type OrderHandler struct {
+	orderpb.UnimplementedOrderServiceServer
+	orderService service.OrderServiceInterface
+}
+
+// NewOrderHandler 创建订单gRPC处理器实例
+func NewOrderHandler(orderService service.OrderServiceInterface) *OrderHandler {
+	return &OrderHandler{
+		orderService: orderService,
+	}
+}
+
+// CreateOrder 处理创建订单gRPC请求
+func (h *OrderHandler) CreateOrder(ctx context.Context, req *orderpb.CreateOrderRequest) (*orderpb.CreateOrderResponse, error) {
+	log.Printf("🔍 gRPC: 收到创建订单请求 - 用户ID: %d, 课程ID: %d, 套餐ID: %d", req.UserId, req.CourseId, req.BundleId)
+
+	order, err := h.orderService.CreateOrder(ctx, &service.CreateOrderRequest{
+		UserID:         uint(req.UserId),
+		CourseID:       uint(req.CourseId),
+		BundleID:       uint(req.BundleId),
+		IdempotencyKey: req.IdempotencyKey,
+		CouponCode:     req.CouponCode,
+	})
+	if err != nil {
+		log.Printf("❌ gRPC: 创建订单失败 - %v", err)
+		return &orderpb.CreateOrderResponse{
+			Code:    orderErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &orderpb.CreateOrderResponse{
+		Code:    200,
+		Message: "订单创建成功",
+		Order:   convertOrderToPB(order),
+	}, nil
+}
+
+// PreviewOrder 处理预览结算价格gRPC请求
+func (h *OrderHandler) PreviewOrder(ctx context.Context, req *orderpb.PreviewOrderRequest) (*orderpb.PreviewOrderResponse, error) {
+	quote, err := h.orderService.PreviewOrder(uint(req.UserId), uint(req.CourseId), uint(req.BundleId), req.CouponCode)
+	if err != nil {
+		return &orderpb.PreviewOrderResponse{
+			Code:    orderErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &orderpb.PreviewOrderResponse{
+		Code:    200,
+		Message: "获取结算价格成功",
+		Quote: &orderpb.OrderQuote{
+			CourseId:        uint32(quote.CourseID),
+			CourseTitle:     quote.CourseTitle,
+			Currency:        quote.Currency,
+			OriginalAmount:  quote.OriginalAmount,
+			EffectiveAmount: quote.EffectiveAmount,
+			DiscountAmount:  quote.DiscountAmount,
+			FinalAmount:     quote.FinalAmount,
+			OnSale:          quote.OnSale,
+			CouponCode:      quote.CouponCode,
+			BundleId:        uint32(quote.BundleID),
+		},
+	}, nil
+}
+
+// GetOrder 处理获取订单详情gRPC请求
+func (h *OrderHandler) GetOrder(ctx context.Context, req *orderpb.GetOrderRequest) (*orderpb.GetOrderResponse, error) {
+	order, err := h.orderService.GetOrder(req.OrderNo, uint(req.UserId))
+	if err != nil {
+		return &orderpb.GetOrderResponse{
+			Code:    orderErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &orderpb.GetOrderResponse{
+		Code:    200,
+		Message: "获取订单成功",
+		Order:   convertOrderToPB(order),
+	}, nil
+}
+
+// ListMyOrders 处理获取订单列表gRPC请求
+func (h *OrderHandler) ListMyOrders(ctx context.Context, req *orderpb.ListMyOrdersRequest) (*orderpb.ListMyOrdersResponse, error) {
+	orders, err := h.orderService.ListOrders(uint(req.UserId))
+	if err != nil {
+		return &orderpb.ListMyOrdersResponse{
+			Code:    orderErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	pbOrders := make([]*orderpb.Order, len(orders))
+	for i, order := range orders {
+		pbOrders[i] = convertOrderToPB(order)
+	}
+
+	return &orderpb.ListMyOrdersResponse{
+		Code:    200,
+		Message: "获取订单列表成功",
+		Orders:  pbOrders,
+	}, nil
+}
+
+// CancelOrder 处理取消订单gRPC请求
+func (h *OrderHandler) CancelOrder(ctx context.Context, req *orderpb.CancelOrderRequest) (*orderpb.CancelOrderResponse, error) {
+	order, err := h.orderService.CancelOrder(req.OrderNo, uint(req.UserId))
+	if err != nil {
+		log.Printf("❌ gRPC: 取消订单失败 - %v", err)
+		return &orderpb.CancelOrderResponse{
+			Code:    orderErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &orderpb.CancelOrderResponse{
+		Code:    200,
+		Message: "订单已取消",
+		Order:   convertOrderToPB(order),
+	}, nil
+}
+
+// HandlePaymentWebhook 处理支付渠道回调gRPC请求
+func (h *OrderHandler) HandlePaymentWebhook(ctx context.Context, req *orderpb.HandlePaymentWebhookRequest) (*orderpb.HandlePaymentWebhookResponse, error) {
+	order, err := h.orderService.HandleWebhook(req.Payload, req.Signature)
+	if err != nil {
+		log.Printf("❌ gRPC: 处理支付回调失败 - %v", err)
+		return &orderpb.HandlePaymentWebhookResponse{
+			Code:    orderErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &orderpb.HandlePaymentWebhookResponse{
+		Code:    200,
+		Message: "回调处理成功，订单状态: " + order.Status,
+	}, nil
+}
+
+// SimulatePayment 处理模拟支付gRPC请求
+func (h *OrderHandler) SimulatePayment(ctx context.Context, req *orderpb.SimulatePaymentRequest) (*orderpb.SimulatePaymentResponse, error) {
+	log.Printf("🔍 gRPC: 收到模拟支付请求 - 订单号: %s", req.OrderNo)
+
+	order, err := h.orderService.SimulatePayment(req.OrderNo, uint(req.UserId), req.Succeed)
+	if err != nil {
+		log.Printf("❌ gRPC: 模拟支付失败 - %v", err)
+		return &orderpb.SimulatePaymentResponse{
+			Code:    orderErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	message := "支付成功"
+	if !order.IsPaid() {
+		message = "支付失败，订单已取消"
+	}
+	return &orderpb.SimulatePaymentResponse{
+		Code:    200,
+		Message: message,
+		Order:   convertOrderToPB(order),
+	}, nil
+}
+
+// convertOrderToPB 将订单模型转换为protobuf消息
+func convertOrderToPB(order *model.Order) *orderpb.Order {
+	return &orderpb.Order{
+		Id:          uint32(order.ID),
+		OrderNo:     order.OrderNo,
+		UserId:      uint32(order.UserID),
+		CourseId:    uint32(order.CourseID),
+		CourseTitle: order.CourseTitle,
+		Amount:      order.Amount,
+		Currency:    order.Currency,
+		Status:      order.Status,
+		Provider:    order.Provider,
+		CheckoutUrl: order.CheckoutURL,
+		CreatedAt:   order.CreatedAt.Format(time.RFC3339),
+		PaidAt:      formatOptionalTime(order.PaidAt),
+		CancelledAt: formatOptionalTime(order.CancelledAt),
+		RefundedAt:  formatOptionalTime(order.RefundedAt),
+
+		OriginalAmount: order.OriginalAmount,
+		DiscountAmount: order.DiscountAmount,
+		CouponCode:     order.CouponCode,
+		RefundedAmount: order.RefundedAmount,
+		BundleId:       uint32(order.BundleID),
+	}
+}
+
+// formatOptionalTime 格式化可能为空的时间
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+// orderErrorCode 根据错误信息映射业务状态码
+func orderErrorCode(err error) int32 {
+	msg := err.Error()
+	switch {
+	case errors.Is(err, payment.ErrInvalidSignature):
+		return 401
+	case errors.Is(err, couponRepository.ErrCouponExhausted), errors.Is(err, couponRepository.ErrCouponUserLimit):
+		return 409
+	case errors.Is(err, userModel.ErrEmailNotVerified), strings.Contains(msg, "无权"), strings.Contains(msg, "先修要求"):
+		return 403
+	case strings.Contains(msg, "不存在"):
+		return 404
+	case strings.Contains(msg, "已拥有"), strings.Contains(msg, "幂等键已用于"), strings.Contains(msg, "状态已变化"):
+		return 409
+	default:
+		return 400
+	}
+}
@@ -0,0 +1,157 @@
+package grpc
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"course-platform/internal/domain/privacy/model"
+	"course-platform/internal/domain/privacy/service"
+	"course-platform/internal/shared/pb/privacypb"
+)
+
+// PrivacyHandler 个人数据gRPC处理器
+type PrivacyHandler struct {
+	privacypb.UnimplementedPrivacyServiceServer
+	privacyService service.PrivacyServiceInterface
+}
+
+// NewPrivacyHandler 创建个人数据gRPC处理器实例
+func NewPrivacyHandler(privacyService service.PrivacyServiceInterface) *PrivacyHandler {
+	return &PrivacyHandler{
+		privacyService: privacyService,
+	}
+}
+
+// GetPrivacyStatus 处理获取导出记录和注销状态gRPC请求
+func (h *PrivacyHandler) GetPrivacyStatus(ctx context.Context, req *privacypb.GetPrivacyStatusRequest) (*privacypb.PrivacyStatusResponse, error) {
+	return h.statusResponse(uint(req.UserId), "获取成功"), nil
+}
+
+// RequestDataExport 处理申请数据导出gRPC请求
+func (h *PrivacyHandler) RequestDataExport(ctx context.Context, req *privacypb.RequestDataExportRequest) (*privacypb.PrivacyStatusResponse, error) {
+	log.Printf("🔍 gRPC: 收到数据导出申请 - 用户ID: %d", req.UserId)
+
+	if _, err := h.privacyService.RequestDataExport(uint(req.UserId)); err != nil {
+		log.Printf("❌ gRPC: 申请数据导出失败 - %v", err)
+		return &privacypb.PrivacyStatusResponse{
+			Code:    privacyErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+	return h.statusResponse(uint(req.UserId), "已开始打包，完成后会发送邮件通知"), nil
+}
+
+// GetDataExport 处理获取导出下载地址gRPC请求
+func (h *PrivacyHandler) GetDataExport(ctx context.Context, req *privacypb.GetDataExportRequest) (*privacypb.GetDataExportResponse, error) {
+	fileURL, err := h.privacyService.GetDataExportURL(uint(req.UserId), uint(req.Id))
+	if err != nil {
+		return &privacypb.GetDataExportResponse{
+			Code:    privacyErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+	return &privacypb.GetDataExportResponse{
+		Code:    200,
+		Message: "获取成功",
+		FileUrl: fileURL,
+	}, nil
+}
+
+// RequestAccountDeletion 处理申请注销账号gRPC请求
+func (h *PrivacyHandler) RequestAccountDeletion(ctx context.Context, req *privacypb.RequestAccountDeletionRequest) (*privacypb.PrivacyStatusResponse, error) {
+	log.Printf("🔍 gRPC: 收到账号注销申请 - 用户ID: %d", req.UserId)
+
+	deletion, err := h.privacyService.RequestAccountDeletion(uint(req.UserId), req.ConfirmEmail, req.TransferToEmail)
+	if err != nil {
+		log.Printf("❌ gRPC: 申请注销账号失败 - %v", err)
+		return &privacypb.PrivacyStatusResponse{
+			Code:    privacyErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+	message := "注销申请已提交，账号将于 " + deletion.ScheduledFor.Format("2006-01-02 15:04") + " 注销，在此之前可以撤销"
+	return h.statusResponse(uint(req.UserId), message), nil
+}
+
+// CancelAccountDeletion 处理撤销注销gRPC请求
+func (h *PrivacyHandler) CancelAccountDeletion(ctx context.Context, req *privacypb.CancelAccountDeletionRequest) (*privacypb.PrivacyStatusResponse, error) {
+	if err := h.privacyService.CancelAccountDeletion(uint(req.UserId)); err != nil {
+		log.Printf("❌ gRPC: 撤销注销失败 - %v", err)
+		return &privacypb.PrivacyStatusResponse{
+			Code:    privacyErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+	return h.statusResponse(uint(req.UserId), "已撤销注销申请"), nil
+}
+
+// statusResponse 查询并返回用户的导出记录和注销状态
+func (h *PrivacyHandler) statusResponse(userID uint, message string) *privacypb.PrivacyStatusResponse {
+	status, err := h.privacyService.GetStatus(userID)
+	if err != nil {
+		log.Printf("❌ gRPC: 获取个人数据状态失败 - %v", err)
+		return &privacypb.PrivacyStatusResponse{
+			Code:    500,
+			Message: err.Error(),
+		}
+	}
+
+	exports := make([]*privacypb.DataExport, 0, len(status.Exports))
+	for _, export := range status.Exports {
+		exports = append(exports, convertDataExportToPB(export))
+	}
+	resp := &privacypb.PrivacyStatusResponse{
+		Code:      200,
+		Message:   message,
+		Exports:   exports,
+		GraceDays: int32(status.GraceDays),
+	}
+	if deletion := status.Deletion; deletion != nil {
+		resp.Deletion = &privacypb.AccountDeletion{
+			Id:              uint32(deletion.ID),
+			Status:          deletion.Status,
+			ScheduledFor:    deletion.ScheduledFor.Format("2006-01-02 15:04:05"),
+			TransferToEmail: deletion.TransferToEmail,
+			CreatedAt:       deletion.CreatedAt.Format("2006-01-02 15:04:05"),
+		}
+	}
+	return resp
+}
+
+// convertDataExportToPB 转换数据导出记录为protobuf格式，不包含下载地址
+func convertDataExportToPB(export *model.DataExport) *privacypb.DataExport {
+	pbExport := &privacypb.DataExport{
+		Id:        uint32(export.ID),
+		Status:    export.Status,
+		FileSize:  export.FileSize,
+		Error:     export.Error,
+		CreatedAt: export.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+	if export.CompletedAt != nil {
+		pbExport.CompletedAt = export.CompletedAt.Format("2006-01-02 15:04:05")
+	}
+	if export.ExpiresAt != nil {
+		pbExport.ExpiresAt = export.ExpiresAt.Format("2006-01-02 15:04:05")
+	}
+	return pbExport
+}
+
+// privacyErrorCode 根据服务层错误信息推断响应码
+func privacyErrorCode(err error) int32 {
+	msg := err.Error()
+	switch {
+	case strings.Contains(msg, "接收人"):
+		return 400
+	case strings.Contains(msg, "不存在"):
+		return 404
+	case strings.Contains(msg, "已过期"):
+		return 410
+	case strings.Contains(msg, "最多申请"):
+		return 429
+	case strings.Contains(msg, "已有"), strings.Contains(msg, "正在注销"):
+		return 409
+	default:
+		return 400
+	}
+}
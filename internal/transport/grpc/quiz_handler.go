@@ -0,0 +1,318 @@
+package grpc
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"course-platform/internal/domain/quiz/model"
+	"course-platform/internal/domain/quiz/service"
+	"course-platform/internal/shared/pb/quizpb"
+)
+
+// QuizHandler 测验gRPC处理器
+type QuizHandler struct {
+	quizpb.UnimplementedQuizServiceServer
+	quizService service.QuizServiceInterface
+}
+
+// NewQuizHandler 创建测验gRPC处理器实例
+func NewQuizHandler(quizService service.QuizServiceInterface) *QuizHandler {
+	return &QuizHandler{
+		quizService: quizService,
+	}
+}
+
+// CreateQuestion 处理创建题目gRPC请求
+func (h *QuizHandler) CreateQuestion(ctx context.Context, req *quizpb.CreateQuestionRequest) (*quizpb.CreateQuestionResponse, error) {
+	log.Printf("🔍 gRPC: 收到创建题目请求 - 课程ID: %d, 类型: %s", req.CourseId, req.Type)
+
+	question, err := h.quizService.CreateQuestion(&model.Question{
+		CourseID:    uint(req.CourseId),
+		CreatorID:   uint(req.UserId),
+		Type:        req.Type,
+		Content:     req.Content,
+		Options:     req.Options,
+		Answers:     req.Answers,
+		Explanation: req.Explanation,
+		Score:       int(req.Score),
+	})
+	if err != nil {
+		log.Printf("❌ gRPC: 创建题目失败 - %v", err)
+		return &quizpb.CreateQuestionResponse{
+			Code:    quizErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &quizpb.CreateQuestionResponse{
+		Code:     200,
+		Message:  "题目创建成功",
+		Question: convertQuestionToPB(question, true),
+	}, nil
+}
+
+// ListQuestions 处理获取题库gRPC请求
+func (h *QuizHandler) ListQuestions(ctx context.Context, req *quizpb.ListQuestionsRequest) (*quizpb.ListQuestionsResponse, error) {
+	questions, err := h.quizService.ListQuestions(uint(req.CourseId), uint(req.UserId))
+	if err != nil {
+		log.Printf("❌ gRPC: 获取题库失败 - %v", err)
+		return &quizpb.ListQuestionsResponse{
+			Code:    quizErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	pbQuestions := make([]*quizpb.Question, len(questions))
+	for i, q := range questions {
+		pbQuestions[i] = convertQuestionToPB(q, true)
+	}
+
+	return &quizpb.ListQuestionsResponse{
+		Code:      200,
+		Message:   "获取成功",
+		Questions: pbQuestions,
+	}, nil
+}
+
+// CreateQuiz 处理创建测验gRPC请求
+func (h *QuizHandler) CreateQuiz(ctx context.Context, req *quizpb.CreateQuizRequest) (*quizpb.CreateQuizResponse, error) {
+	log.Printf("🔍 gRPC: 收到创建测验请求 - 课程ID: %d, 章节ID: %d", req.CourseId, req.ChapterId)
+
+	questionIDs := make([]uint, len(req.QuestionIds))
+	for i, id := range req.QuestionIds {
+		questionIDs[i] = uint(id)
+	}
+
+	quiz, err := h.quizService.CreateQuiz(&service.CreateQuizRequest{
+		CourseID:         uint(req.CourseId),
+		ChapterID:        uint(req.ChapterId),
+		UserID:           uint(req.UserId),
+		Title:            req.Title,
+		Description:      req.Description,
+		TimeLimitSeconds: int(req.TimeLimitSeconds),
+		MaxAttempts:      int(req.MaxAttempts),
+		PassScore:        int(req.PassScore),
+		QuestionIDs:      questionIDs,
+	})
+	if err != nil {
+		log.Printf("❌ gRPC: 创建测验失败 - %v", err)
+		return &quizpb.CreateQuizResponse{
+			Code:    quizErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &quizpb.CreateQuizResponse{
+		Code:    200,
+		Message: "测验创建成功",
+		Quiz:    convertQuizToPB(quiz, true, true),
+	}, nil
+}
+
+// GetQuiz 处理获取测验详情gRPC请求（仅讲师可见答案）
+func (h *QuizHandler) GetQuiz(ctx context.Context, req *quizpb.GetQuizRequest) (*quizpb.GetQuizResponse, error) {
+	quiz, isInstructor, err := h.quizService.GetQuiz(uint(req.QuizId), uint(req.UserId))
+	if err != nil {
+		log.Printf("❌ gRPC: 获取测验失败 - %v", err)
+		return &quizpb.GetQuizResponse{
+			Code:    quizErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &quizpb.GetQuizResponse{
+		Code:    200,
+		Message: "获取成功",
+		Quiz:    convertQuizToPB(quiz, true, isInstructor),
+	}, nil
+}
+
+// ListQuizzes 处理获取测验列表gRPC请求
+func (h *QuizHandler) ListQuizzes(ctx context.Context, req *quizpb.ListQuizzesRequest) (*quizpb.ListQuizzesResponse, error) {
+	quizzes, err := h.quizService.ListQuizzes(uint(req.CourseId), uint(req.ChapterId))
+	if err != nil {
+		log.Printf("❌ gRPC: 获取测验列表失败 - %v", err)
+		return &quizpb.ListQuizzesResponse{
+			Code:    quizErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	pbQuizzes := make([]*quizpb.Quiz, len(quizzes))
+	for i, quiz := range quizzes {
+		pbQuizzes[i] = convertQuizToPB(quiz, false, false)
+	}
+
+	return &quizpb.ListQuizzesResponse{
+		Code:    200,
+		Message: "获取成功",
+		Quizzes: pbQuizzes,
+	}, nil
+}
+
+// StartAttempt 处理开始答题gRPC请求
+func (h *QuizHandler) StartAttempt(ctx context.Context, req *quizpb.StartAttemptRequest) (*quizpb.StartAttemptResponse, error) {
+	log.Printf("🔍 gRPC: 收到开始答题请求 - 测验ID: %d, 用户ID: %d", req.QuizId, req.UserId)
+
+	attempt, quiz, err := h.quizService.StartAttempt(uint(req.QuizId), uint(req.UserId))
+	if err != nil {
+		log.Printf("❌ gRPC: 开始答题失败 - %v", err)
+		return &quizpb.StartAttemptResponse{
+			Code:    quizErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &quizpb.StartAttemptResponse{
+		Code:    200,
+		Message: "开始答题",
+		Attempt: convertAttemptToPB(attempt, quiz),
+		Quiz:    convertQuizToPB(quiz, true, false),
+	}, nil
+}
+
+// SubmitAttempt 处理提交答卷gRPC请求
+func (h *QuizHandler) SubmitAttempt(ctx context.Context, req *quizpb.SubmitAttemptRequest) (*quizpb.SubmitAttemptResponse, error) {
+	log.Printf("🔍 gRPC: 收到提交答卷请求 - 记录ID: %d, 用户ID: %d", req.AttemptId, req.UserId)
+
+	answers := make(map[uint][]string, len(req.Answers))
+	for _, a := range req.Answers {
+		answers[uint(a.QuestionId)] = a.Answers
+	}
+
+	attempt, quiz, err := h.quizService.SubmitAttempt(uint(req.AttemptId), uint(req.UserId), answers)
+	if err != nil {
+		log.Printf("❌ gRPC: 提交答卷失败 - %v", err)
+		return &quizpb.SubmitAttemptResponse{
+			Code:    quizErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	message := "提交成功"
+	if attempt.Status == model.AttemptStatusExpired {
+		message = "答题已超时，本次成绩记为0分"
+	}
+	return &quizpb.SubmitAttemptResponse{
+		Code:    200,
+		Message: message,
+		Attempt: convertAttemptToPB(attempt, quiz),
+	}, nil
+}
+
+// ListAttempts 处理获取答题历史gRPC请求
+func (h *QuizHandler) ListAttempts(ctx context.Context, req *quizpb.ListAttemptsRequest) (*quizpb.ListAttemptsResponse, error) {
+	attempts, quiz, err := h.quizService.ListAttempts(uint(req.QuizId), uint(req.UserId), uint(req.StudentId))
+	if err != nil {
+		log.Printf("❌ gRPC: 获取答题历史失败 - %v", err)
+		return &quizpb.ListAttemptsResponse{
+			Code:    quizErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	pbAttempts := make([]*quizpb.Attempt, len(attempts))
+	for i, attempt := range attempts {
+		pbAttempts[i] = convertAttemptToPB(attempt, quiz)
+	}
+
+	return &quizpb.ListAttemptsResponse{
+		Code:     200,
+		Message:  "获取成功",
+		Attempts: pbAttempts,
+	}, nil
+}
+
+// convertQuestionToPB 转换题目，withAnswers为false时隐藏答案和解析
+func convertQuestionToPB(q *model.Question, withAnswers bool) *quizpb.Question {
+	pb := &quizpb.Question{
+		Id:       uint32(q.ID),
+		CourseId: uint32(q.CourseID),
+		Type:     q.Type,
+		Content:  q.Content,
+		Options:  q.Options,
+		Score:    uint32(q.Score),
+	}
+	if withAnswers {
+		pb.Answers = q.Answers
+		pb.Explanation = q.Explanation
+	}
+	return pb
+}
+
+// convertQuizToPB 转换测验，withQuestions控制是否包含题目，withAnswers控制是否包含答案
+func convertQuizToPB(quiz *model.Quiz, withQuestions, withAnswers bool) *quizpb.Quiz {
+	pb := &quizpb.Quiz{
+		Id:               uint32(quiz.ID),
+		CourseId:         uint32(quiz.CourseID),
+		ChapterId:        uint32(quiz.ChapterID),
+		Title:            quiz.Title,
+		Description:      quiz.Description,
+		TimeLimitSeconds: uint32(quiz.TimeLimitSeconds),
+		MaxAttempts:      uint32(quiz.MaxAttempts),
+		PassScore:        uint32(quiz.PassScore),
+		QuestionCount:    uint32(len(quiz.Items)),
+		TotalScore:       uint32(quiz.TotalScore()),
+		CreatedAt:        quiz.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+	if withQuestions {
+		for i := range quiz.Items {
+			pb.Questions = append(pb.Questions, convertQuestionToPB(&quiz.Items[i].Question, withAnswers))
+		}
+	}
+	return pb
+}
+
+// convertAttemptToPB 转换答题记录，已提交的记录附带标准答案和解析
+func convertAttemptToPB(attempt *model.QuizAttempt, quiz *model.Quiz) *quizpb.Attempt {
+	pb := &quizpb.Attempt{
+		Id:        uint32(attempt.ID),
+		QuizId:    uint32(attempt.QuizID),
+		UserId:    uint32(attempt.UserID),
+		Status:    attempt.Status,
+		Score:     uint32(attempt.Score),
+		MaxScore:  uint32(attempt.MaxScore),
+		Passed:    attempt.Passed,
+		StartedAt: attempt.StartedAt.Format(time.RFC3339),
+	}
+	if attempt.ExpiresAt != nil {
+		pb.ExpiresAt = attempt.ExpiresAt.Format(time.RFC3339)
+	}
+	if attempt.SubmittedAt != nil {
+		pb.SubmittedAt = attempt.SubmittedAt.Format(time.RFC3339)
+	}
+
+	questions := make(map[uint]*model.Question, len(quiz.Items))
+	for i := range quiz.Items {
+		questions[quiz.Items[i].QuestionID] = &quiz.Items[i].Question
+	}
+	for _, answer := range attempt.Answers {
+		result := &quizpb.AnswerResult{
+			QuestionId: uint32(answer.QuestionID),
+			Answers:    answer.Answers,
+			Correct:    answer.Correct,
+			Score:      uint32(answer.Score),
+		}
+		if q, ok := questions[answer.QuestionID]; ok {
+			result.CorrectAnswers = q.Answers
+			result.Explanation = q.Explanation
+		}
+		pb.Results = append(pb.Results, result)
+	}
+	return pb
+}
+
+// quizErrorCode 根据服务层错误信息推断响应码
+func quizErrorCode(err error) int32 {
+	msg := err.Error()
+	switch {
+	case strings.Contains(msg, "只有课程讲师"), strings.Contains(msg, "无权限"), strings.Contains(msg, "请先报名"), strings.Contains(msg, "尚未开放"):
+		return 403
+	case strings.Contains(msg, "不存在"):
+		return 404
+	default:
+		return 400
+	}
+}
@@ -0,0 +1,198 @@
+package grpc
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"course-platform/internal/domain/refund/model"
+	"course-platform/internal/domain/refund/service"
+	"course-platform/internal/shared/pb/refundpb"
+)
+
+// RefundHandler 退款gRPC处理器
+type RefundHandler struct {
+	refundpb.UnimplementedRefundServiceServer
+	refundService service.RefundServiceInterface
+}
+
+// NewRefundHandler 创建退款gRPC处理器实例
+func NewRefundHandler(refundService service.RefundServiceInterface) *RefundHandler {
+	return &RefundHandler{
+		refundService: refundService,
+	}
+}
+
+// RequestRefund 处理提交退款申请gRPC请求
+func (h *RefundHandler) RequestRefund(ctx context.Context, req *refundpb.RequestRefundRequest) (*refundpb.RequestRefundResponse, error) {
+	log.Printf("🔍 gRPC: 收到退款申请 - 订单号: %s", req.OrderNo)
+
+	refund, err := h.refundService.RequestRefund(&service.RequestRefundRequest{
+		UserID:  uint(req.UserId),
+		OrderNo: req.OrderNo,
+		Amount:  req.Amount,
+		Reason:  req.Reason,
+	})
+	if err != nil {
+		log.Printf("❌ gRPC: 提交退款申请失败 - %v", err)
+		return &refundpb.RequestRefundResponse{
+			Code:    refundErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &refundpb.RequestRefundResponse{
+		Code:    200,
+		Message: "退款申请已提交，等待审核",
+		Refund:  convertRefundToPB(refund),
+	}, nil
+}
+
+// ApproveRefund 处理审核通过退款gRPC请求
+func (h *RefundHandler) ApproveRefund(ctx context.Context, req *refundpb.ApproveRefundRequest) (*refundpb.ApproveRefundResponse, error) {
+	log.Printf("🔍 gRPC: 收到退款审核通过请求 - ID: %d", req.RefundId)
+
+	refund, err := h.refundService.ApproveRefund(ctx, uint(req.RefundId), uint(req.ReviewerId), req.Amount, req.Note)
+	if err != nil {
+		log.Printf("❌ gRPC: 退款失败 - %v", err)
+		return &refundpb.ApproveRefundResponse{
+			Code:    refundErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &refundpb.ApproveRefundResponse{
+		Code:    200,
+		Message: "退款成功",
+		Refund:  convertRefundToPB(refund),
+	}, nil
+}
+
+// RejectRefund 处理拒绝退款gRPC请求
+func (h *RefundHandler) RejectRefund(ctx context.Context, req *refundpb.RejectRefundRequest) (*refundpb.RejectRefundResponse, error) {
+	refund, err := h.refundService.RejectRefund(uint(req.RefundId), uint(req.ReviewerId), req.Note)
+	if err != nil {
+		log.Printf("❌ gRPC: 拒绝退款失败 - %v", err)
+		return &refundpb.RejectRefundResponse{
+			Code:    refundErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &refundpb.RejectRefundResponse{
+		Code:    200,
+		Message: "退款申请已拒绝",
+		Refund:  convertRefundToPB(refund),
+	}, nil
+}
+
+// GetRefund 处理获取退款详情gRPC请求
+func (h *RefundHandler) GetRefund(ctx context.Context, req *refundpb.GetRefundRequest) (*refundpb.GetRefundResponse, error) {
+	refund, logs, err := h.refundService.GetRefund(uint(req.RefundId), uint(req.UserId))
+	if err != nil {
+		return &refundpb.GetRefundResponse{
+			Code:    refundErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	pbLogs := make([]*refundpb.AuditLog, len(logs))
+	for i, entry := range logs {
+		pbLogs[i] = &refundpb.AuditLog{
+			Id:        uint32(entry.ID),
+			ActorId:   uint32(entry.ActorID),
+			Action:    entry.Action,
+			Detail:    entry.Detail,
+			CreatedAt: entry.CreatedAt.Format(time.RFC3339),
+		}
+	}
+
+	return &refundpb.GetRefundResponse{
+		Code:      200,
+		Message:   "获取退款申请成功",
+		Refund:    convertRefundToPB(refund),
+		AuditLogs: pbLogs,
+	}, nil
+}
+
+// ListMyRefunds 处理获取本人退款申请gRPC请求
+func (h *RefundHandler) ListMyRefunds(ctx context.Context, req *refundpb.ListMyRefundsRequest) (*refundpb.ListMyRefundsResponse, error) {
+	refunds, err := h.refundService.ListMyRefunds(uint(req.UserId))
+	if err != nil {
+		return &refundpb.ListMyRefundsResponse{
+			Code:    refundErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &refundpb.ListMyRefundsResponse{
+		Code:    200,
+		Message: "获取退款申请成功",
+		Refunds: convertRefundsToPB(refunds),
+	}, nil
+}
+
+// ListRefundQueue 处理获取退款审核列表gRPC请求
+func (h *RefundHandler) ListRefundQueue(ctx context.Context, req *refundpb.ListRefundQueueRequest) (*refundpb.ListRefundQueueResponse, error) {
+	refunds, err := h.refundService.ListReviewQueue(uint(req.ReviewerId), req.Status)
+	if err != nil {
+		return &refundpb.ListRefundQueueResponse{
+			Code:    refundErrorCode(err),
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &refundpb.ListRefundQueueResponse{
+		Code:    200,
+		Message: "获取退款审核列表成功",
+		Refunds: convertRefundsToPB(refunds),
+	}, nil
+}
+
+// convertRefundsToPB 批量转换退款申请
+func convertRefundsToPB(refunds []*model.Refund) []*refundpb.Refund {
+	pbRefunds := make([]*refundpb.Refund, len(refunds))
+	for i, refund := range refunds {
+		pbRefunds[i] = convertRefundToPB(refund)
+	}
+	return pbRefunds
+}
+
+// convertRefundToPB 将退款申请转换为protobuf消息
+func convertRefundToPB(refund *model.Refund) *refundpb.Refund {
+	return &refundpb.Refund{
+		Id:              uint32(refund.ID),
+		OrderId:         uint32(refund.OrderID),
+		OrderNo:         refund.OrderNo,
+		UserId:          uint32(refund.UserID),
+		CourseId:        uint32(refund.CourseID),
+		BundleId:        uint32(refund.BundleID),
+		OrderAmount:     refund.OrderAmount,
+		RequestedAmount: refund.RequestedAmount,
+		ApprovedAmount:  refund.ApprovedAmount,
+		Reason:          refund.Reason,
+		ProgressPercent: int32(refund.ProgressPercent),
+		Status:          refund.Status,
+		ReviewerId:      uint32(refund.ReviewerID),
+		ReviewNote:      refund.ReviewNote,
+		ReviewedAt:      formatOptionalTime(refund.ReviewedAt),
+		RefundedAt:      formatOptionalTime(refund.RefundedAt),
+		CreatedAt:       refund.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// refundErrorCode 根据错误信息映射业务状态码
+func refundErrorCode(err error) int32 {
+	msg := err.Error()
+	switch {
+	case strings.Contains(msg, "无权"):
+		return 403
+	case strings.Contains(msg, "不存在"):
+		return 404
+	case strings.Contains(msg, "已有退款申请"), strings.Contains(msg, "已处理"), strings.Contains(msg, "状态已变化"):
+		return 409
+	default:
+		return 400
+	}
+}
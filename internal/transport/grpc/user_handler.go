@@ -2,10 +2,19 @@
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
+	"time"
 
+	"course-platform/internal/domain/user/model"
 	"course-platform/internal/domain/user/service"
 	"course-platform/internal/shared/pb/userpb"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 // UserHandler 用户gRPC处理器
@@ -27,7 +36,7 @@ func (h *UserHandler) Register(ctx context.Context, req *userpb.RegisterRequest)
 	log.Printf("🔍 gRPC: 收到注册请求 - 用户名: %s, 邮箱: %s", req.Username, req.Email)
 
 	// 使用完整参数注册用户
-	user, err := h.userService.Register(req.Username, req.Email, req.Password, req.Nickname)
+	user, err := h.userService.Register(req.Username, req.Email, req.Password, req.Nickname, req.Locale)
 	if err != nil {
 		log.Printf("❌ gRPC: 注册失败 - %v", err)
 		return &userpb.RegisterResponse{
@@ -38,15 +47,7 @@ func (h *UserHandler) Register(ctx context.Context, req *userpb.RegisterRequest)
 	}
 
 	// 转换为protobuf用户对象
-	pbUser := &userpb.User{
-		Id:        uint32(user.ID),
-		Username:  user.Username,
-		Email:     user.Email,
-		Nickname:  user.Nickname,
-		Avatar:    user.AvatarURL,
-		CreatedAt: user.CreatedAt.Format("2006-01-02 15:04:05"),
-		UpdatedAt: user.UpdatedAt.Format("2006-01-02 15:04:05"),
-	}
+	pbUser := convertUserToPB(user)
 
 	log.Printf("✅ gRPC: 注册成功 - 用户ID: %d", user.ID)
 	return &userpb.RegisterResponse{
@@ -56,14 +57,18 @@ func (h *UserHandler) Register(ctx context.Context, req *userpb.RegisterRequest)
 	}, nil
 }
 
-// Login 处理用户登录gRPC请求
+// Login 处理用户登录gRPC请求，需要两步验证时只返回挑战令牌
 func (h *UserHandler) Login(ctx context.Context, req *userpb.LoginRequest) (*userpb.LoginResponse, error) {
 	log.Printf("🔍 gRPC: 收到登录请求 - 用户名: %s", req.Username)
 
 	// 尝试用用户名或邮箱登录
-	token, user, err := h.userService.Login(req.Username, req.Password)
+	result, err := h.userService.Login(req.Username, req.Password, service.ClientInfo{IP: req.ClientIp, UserAgent: req.UserAgent})
 	if err != nil {
 		log.Printf("❌ gRPC: 登录失败 - %v", err)
+		var locked *service.LoginLockedError
+		if errors.As(err, &locked) {
+			return nil, loginLockedStatus(locked)
+		}
 		return &userpb.LoginResponse{
 			Code:    401,
 			Message: err.Error(),
@@ -72,24 +77,56 @@ func (h *UserHandler) Login(ctx context.Context, req *userpb.LoginRequest) (*use
 		}, nil
 	}
 
-	// 转换为protobuf用户对象
-	pbUser := &userpb.User{
-		Id:        uint32(user.ID),
-		Username:  user.Username,
-		Email:     user.Email,
-		Nickname:  user.Nickname,
-		Avatar:    user.AvatarURL,
-		CreatedAt: user.CreatedAt.Format("2006-01-02 15:04:05"),
-		UpdatedAt: user.UpdatedAt.Format("2006-01-02 15:04:05"),
+	return convertLoginResult(result), nil
+}
+
+// convertLoginResult 转换登录结果，需要两步验证时只返回挑战令牌
+func convertLoginResult(result *service.LoginResult) *userpb.LoginResponse {
+	if result.MFAToken != "" {
+		log.Printf("🔍 gRPC: 登录需要两步验证 - 用户ID: %d, 需要绑定: %v", result.User.ID, result.MFASetupRequired)
+		message := "请输入身份验证器中的验证码"
+		if result.MFASetupRequired {
+			message = "你的账号类型要求启用两步验证，请先绑定身份验证器"
+		}
+		return &userpb.LoginResponse{
+			Code:             200,
+			Message:          message,
+			MfaToken:         result.MFAToken,
+			MfaSetupRequired: result.MFASetupRequired,
+		}
 	}
 
-	log.Printf("✅ gRPC: 登录成功 - 用户ID: %d, Token长度: %d", user.ID, len(token))
+	log.Printf("✅ gRPC: 登录成功 - 用户ID: %d, Token长度: %d", result.User.ID, len(result.Token))
 	return &userpb.LoginResponse{
 		Code:    200,
 		Message: "登录成功",
-		Token:   token,
-		User:    pbUser,
-	}, nil
+		Token:   result.Token,
+		User:    convertUserToPB(result.User),
+	}
+}
+
+// loginLockedStatus 登录被限制时返回 RESOURCE_EXHAUSTED，原因和解除时间放在 status details 中
+func loginLockedStatus(locked *service.LoginLockedError) error {
+	reason := model.LoginReasonRateLimited
+	if locked.AccountLocked {
+		reason = model.LoginReasonAccountLocked
+	}
+
+	st := status.New(codes.ResourceExhausted, locked.Error())
+	detailed, err := st.WithDetails(
+		&errdetails.ErrorInfo{
+			Reason: reason,
+			Domain: model.LoginLockDomain,
+			Metadata: map[string]string{
+				model.LoginLockedUntilKey: locked.Until.UTC().Format(time.RFC3339),
+			},
+		},
+		&errdetails.RetryInfo{RetryDelay: durationpb.New(time.Until(locked.Until).Round(time.Second))},
+	)
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
 }
 
 // GetUser 处理获取用户gRPC请求
@@ -108,15 +145,7 @@ func (h *UserHandler) GetUser(ctx context.Context, req *userpb.GetUserRequest) (
 	}
 
 	// 转换为protobuf用户对象
-	pbUser := &userpb.User{
-		Id:        uint32(user.ID),
-		Username:  user.Username,
-		Email:     user.Email,
-		Nickname:  user.Nickname,
-		Avatar:    user.AvatarURL,
-		CreatedAt: user.CreatedAt.Format("2006-01-02 15:04:05"),
-		UpdatedAt: user.UpdatedAt.Format("2006-01-02 15:04:05"),
-	}
+	pbUser := convertUserToPB(user)
 
 	log.Printf("✅ gRPC: 获取用户成功 - 用户ID: %d", user.ID)
 	return &userpb.GetUserResponse{
@@ -141,15 +170,7 @@ func (h *UserHandler) GetUserByID(ctx context.Context, req *userpb.GetUserByIDRe
 	}
 
 	// 转换为protobuf用户对象
-	pbUser := &userpb.User{
-		Id:        uint32(user.ID),
-		Username:  user.Username,
-		Email:     user.Email,
-		Nickname:  user.Nickname,
-		Avatar:    user.AvatarURL,
-		CreatedAt: user.CreatedAt.Format("2006-01-02 15:04:05"),
-		UpdatedAt: user.UpdatedAt.Format("2006-01-02 15:04:05"),
-	}
+	pbUser := convertUserToPB(user)
 
 	log.Printf("✅ gRPC: 通过ID获取用户成功 - 用户ID: %d", user.ID)
 	return &userpb.GetUserByIDResponse{
@@ -159,8 +180,551 @@ func (h *UserHandler) GetUserByID(ctx context.Context, req *userpb.GetUserByIDRe
 	}, nil
 }
 
+// VerifyEmail 处理验证邮箱gRPC请求
+func (h *UserHandler) VerifyEmail(ctx context.Context, req *userpb.VerifyEmailRequest) (*userpb.VerifyEmailResponse, error) {
+	user, err := h.userService.VerifyEmail(req.Token)
+	if err != nil {
+		log.Printf("❌ gRPC: 验证邮箱失败 - %v", err)
+		return &userpb.VerifyEmailResponse{
+			Code:    400,
+			Message: err.Error(),
+		}, nil
+	}
+
+	log.Printf("✅ gRPC: 验证邮箱成功 - 用户ID: %d", user.ID)
+	return &userpb.VerifyEmailResponse{
+		Code:    200,
+		Message: "邮箱验证成功",
+		User:    convertUserToPB(user),
+	}, nil
+}
+
+// ResendVerification 处理重新发送验证邮件gRPC请求
+func (h *UserHandler) ResendVerification(ctx context.Context, req *userpb.ResendVerificationRequest) (*userpb.ResendVerificationResponse, error) {
+	if err := h.userService.ResendVerification(uint(req.UserId)); err != nil {
+		log.Printf("❌ gRPC: 重新发送验证邮件失败 - %v", err)
+		var tooSoon *service.ResendTooSoonError
+		if errors.As(err, &tooSoon) {
+			return &userpb.ResendVerificationResponse{
+				Code:              429,
+				Message:           err.Error(),
+				RetryAfterSeconds: int32(tooSoon.RetryAfter.Seconds()) + 1,
+			}, nil
+		}
+		return &userpb.ResendVerificationResponse{
+			Code:    400,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &userpb.ResendVerificationResponse{
+		Code:    200,
+		Message: "验证邮件已发送，请查收",
+	}, nil
+}
+
+// ForgotPassword 处理申请重置密码gRPC请求，邮箱是否注册都返回相同结果
+func (h *UserHandler) ForgotPassword(ctx context.Context, req *userpb.ForgotPasswordRequest) (*userpb.ForgotPasswordResponse, error) {
+	if err := h.userService.ForgotPassword(req.Email); err != nil {
+		log.Printf("❌ gRPC: 申请重置密码失败 - %v", err)
+		return &userpb.ForgotPasswordResponse{
+			Code:    400,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &userpb.ForgotPasswordResponse{
+		Code:    200,
+		Message: "如果该邮箱已注册，重置密码邮件将很快送达，请查收",
+	}, nil
+}
+
+// ResetPassword 处理重置密码gRPC请求
+func (h *UserHandler) ResetPassword(ctx context.Context, req *userpb.ResetPasswordRequest) (*userpb.ResetPasswordResponse, error) {
+	if err := h.userService.ResetPassword(req.Token, req.NewPassword); err != nil {
+		log.Printf("❌ gRPC: 重置密码失败 - %v", err)
+		return &userpb.ResetPasswordResponse{
+			Code:    400,
+			Message: err.Error(),
+		}, nil
+	}
+
+	log.Printf("✅ gRPC: 重置密码成功")
+	return &userpb.ResetPasswordResponse{
+		Code:    200,
+		Message: "密码已重置，请使用新密码登录",
+	}, nil
+}
+
+// VerifyMFALogin 处理登录第二步gRPC请求
+func (h *UserHandler) VerifyMFALogin(ctx context.Context, req *userpb.VerifyMFALoginRequest) (*userpb.LoginResponse, error) {
+	result, err := h.userService.VerifyMFALogin(req.MfaToken, req.Code, service.ClientInfo{IP: req.ClientIp, UserAgent: req.UserAgent})
+	if err != nil {
+		log.Printf("❌ gRPC: 两步验证失败 - %v", err)
+		return &userpb.LoginResponse{
+			Code:    401,
+			Message: err.Error(),
+		}, nil
+	}
+
+	log.Printf("✅ gRPC: 两步验证通过，登录成功 - 用户ID: %d", result.User.ID)
+	return &userpb.LoginResponse{
+		Code:    200,
+		Message: "登录成功",
+		Token:   result.Token,
+		User:    convertUserToPB(result.User),
+	}, nil
+}
+
+// GetMFAStatus 处理获取两步验证状态gRPC请求
+func (h *UserHandler) GetMFAStatus(ctx context.Context, req *userpb.GetMFAStatusRequest) (*userpb.GetMFAStatusResponse, error) {
+	status, err := h.userService.GetMFAStatus(uint(req.UserId))
+	if err != nil {
+		log.Printf("❌ gRPC: 获取两步验证状态失败 - %v", err)
+		return &userpb.GetMFAStatusResponse{
+			Code:    400,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &userpb.GetMFAStatusResponse{
+		Code:              200,
+		Message:           "获取成功",
+		Enabled:           status.Enabled,
+		Required:          status.Required,
+		RecoveryCodesLeft: int32(status.RecoveryCodesLeft),
+	}, nil
+}
+
+// BeginTOTPSetup 处理开始绑定身份验证器gRPC请求，未登录时通过 mfa_token 识别用户
+func (h *UserHandler) BeginTOTPSetup(ctx context.Context, req *userpb.BeginTOTPSetupRequest) (*userpb.BeginTOTPSetupResponse, error) {
+	userID, err := h.mfaUserID(req.UserId, req.MfaToken)
+	if err != nil {
+		return &userpb.BeginTOTPSetupResponse{
+			Code:    401,
+			Message: err.Error(),
+		}, nil
+	}
+
+	setup, err := h.userService.BeginTOTPSetup(userID)
+	if err != nil {
+		log.Printf("❌ gRPC: 开始绑定身份验证器失败 - %v", err)
+		return &userpb.BeginTOTPSetupResponse{
+			Code:    400,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &userpb.BeginTOTPSetupResponse{
+		Code:            200,
+		Message:         "请使用身份验证器扫描二维码",
+		Secret:          setup.Secret,
+		ProvisioningUri: setup.ProvisioningURI,
+	}, nil
+}
+
+// EnableTOTP 处理确认绑定gRPC请求，通过 mfa_token 绑定时同时完成登录
+func (h *UserHandler) EnableTOTP(ctx context.Context, req *userpb.EnableTOTPRequest) (*userpb.EnableTOTPResponse, error) {
+	userID, err := h.mfaUserID(req.UserId, req.MfaToken)
+	if err != nil {
+		return &userpb.EnableTOTPResponse{
+			Code:    401,
+			Message: err.Error(),
+		}, nil
+	}
+
+	codes, err := h.userService.EnableTOTP(userID, req.Code)
+	if err != nil {
+		log.Printf("❌ gRPC: 启用两步验证失败 - %v", err)
+		return &userpb.EnableTOTPResponse{
+			Code:    400,
+			Message: err.Error(),
+		}, nil
+	}
+
+	resp := &userpb.EnableTOTPResponse{
+		Code:          200,
+		Message:       "两步验证已启用，请妥善保存恢复码",
+		RecoveryCodes: codes,
+	}
+	if req.MfaToken != "" {
+		user, err := h.userService.GetUserByID(userID)
+		if err == nil {
+			resp.Token, err = h.userService.GenerateToken(userID, service.ClientInfo{IP: req.ClientIp, UserAgent: req.UserAgent})
+			resp.User = convertUserToPB(user)
+		}
+		if err != nil {
+			log.Printf("❌ gRPC: 绑定后登录失败 - %v", err)
+			resp.Message = "两步验证已启用，请保存恢复码后重新登录"
+		}
+	}
+	return resp, nil
+}
+
+// DisableTOTP 处理关闭两步验证gRPC请求
+func (h *UserHandler) DisableTOTP(ctx context.Context, req *userpb.DisableTOTPRequest) (*userpb.DisableTOTPResponse, error) {
+	if err := h.userService.DisableTOTP(uint(req.UserId), req.Password, req.Code); err != nil {
+		log.Printf("❌ gRPC: 关闭两步验证失败 - %v", err)
+		return &userpb.DisableTOTPResponse{
+			Code:    400,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &userpb.DisableTOTPResponse{
+		Code:    200,
+		Message: "两步验证已关闭",
+	}, nil
+}
+
+// RegenerateRecoveryCodes 处理重新生成恢复码gRPC请求
+func (h *UserHandler) RegenerateRecoveryCodes(ctx context.Context, req *userpb.RegenerateRecoveryCodesRequest) (*userpb.RecoveryCodesResponse, error) {
+	codes, err := h.userService.RegenerateRecoveryCodes(uint(req.UserId), req.Code)
+	if err != nil {
+		log.Printf("❌ gRPC: 重新生成恢复码失败 - %v", err)
+		return &userpb.RecoveryCodesResponse{
+			Code:    400,
+			Message: err.Error(),
+		}, nil
+	}
+
+	return &userpb.RecoveryCodesResponse{
+		Code:          200,
+		Message:       "已生成新的恢复码，旧恢复码全部失效",
+		RecoveryCodes: codes,
+	}, nil
+}
+
+// ListMFAPolicies 处理获取两步验证策略gRPC请求
+func (h *UserHandler) ListMFAPolicies(ctx context.Context, req *userpb.ListMFAPoliciesRequest) (*userpb.MFAPoliciesResponse, error) {
+	policies, err := h.userService.ListMFAPolicies(uint(req.AdminId))
+	if err != nil {
+		return mfaPolicyErrorResponse(err), nil
+	}
+	return convertMFAPoliciesResponse("获取成功", policies), nil
+}
+
+// SetMFAPolicy 处理设置两步验证策略gRPC请求
+func (h *UserHandler) SetMFAPolicy(ctx context.Context, req *userpb.SetMFAPolicyRequest) (*userpb.MFAPoliciesResponse, error) {
+	if _, err := h.userService.SetMFAPolicy(uint(req.AdminId), req.Role, req.Required); err != nil {
+		return mfaPolicyErrorResponse(err), nil
+	}
+	policies, err := h.userService.ListMFAPolicies(uint(req.AdminId))
+	if err != nil {
+		return mfaPolicyErrorResponse(err), nil
+	}
+	return convertMFAPoliciesResponse("两步验证策略已更新", policies), nil
+}
+
+// mfaUserID 确定绑定身份验证器的用户：已登录用户直接使用 user_id，登录中被要求绑定的用户使用 mfa_token
+func (h *UserHandler) mfaUserID(userID uint32, mfaToken string) (uint, error) {
+	if mfaToken != "" {
+		return h.userService.MFASetupUserID(mfaToken)
+	}
+	if userID == 0 {
+		return 0, errors.New("请先登录")
+	}
+	return uint(userID), nil
+}
+
+// mfaPolicyErrorResponse 两步验证策略的错误响应，非管理员返回 403
+func mfaPolicyErrorResponse(err error) *userpb.MFAPoliciesResponse {
+	log.Printf("❌ gRPC: 两步验证策略操作失败 - %v", err)
+	code := int32(400)
+	if errors.Is(err, service.ErrNotAdmin) {
+		code = 403
+	}
+	return &userpb.MFAPoliciesResponse{
+		Code:    code,
+		Message: err.Error(),
+	}
+}
+
+// convertMFAPoliciesResponse 转换两步验证策略列表
+func convertMFAPoliciesResponse(message string, policies []*model.MFAPolicy) *userpb.MFAPoliciesResponse {
+	pbPolicies := make([]*userpb.MFAPolicy, 0, len(policies))
+	for _, policy := range policies {
+		pbPolicy := &userpb.MFAPolicy{
+			Role:     policy.Role,
+			Required: policy.Required,
+		}
+		if !policy.UpdatedAt.IsZero() {
+			pbPolicy.UpdatedAt = policy.UpdatedAt.Format("2006-01-02 15:04:05")
+		}
+		pbPolicies = append(pbPolicies, pbPolicy)
+	}
+	return &userpb.MFAPoliciesResponse{
+		Code:     200,
+		Message:  message,
+		Policies: pbPolicies,
+	}
+}
+
+// LoginWithOIDC 处理单点登录gRPC请求
+func (h *UserHandler) LoginWithOIDC(ctx context.Context, req *userpb.OIDCLoginRequest) (*userpb.LoginResponse, error) {
+	if req.Profile == nil {
+		return &userpb.LoginResponse{Code: 400, Message: "身份信息不完整"}, nil
+	}
+	log.Printf("🔍 gRPC: 收到单点登录请求 - 提供者: %s", req.Profile.Provider)
+
+	result, err := h.userService.LoginWithOIDC(convertOIDCProfile(req.Profile), service.ClientInfo{IP: req.ClientIp, UserAgent: req.UserAgent})
+	if err != nil {
+		log.Printf("❌ gRPC: 单点登录失败 - %v", err)
+		return &userpb.LoginResponse{
+			Code:    401,
+			Message: err.Error(),
+		}, nil
+	}
+	return convertLoginResult(result), nil
+}
+
+// LinkOIDCIdentity 处理绑定单点登录身份gRPC请求
+func (h *UserHandler) LinkOIDCIdentity(ctx context.Context, req *userpb.LinkOIDCIdentityRequest) (*userpb.OIDCIdentitiesResponse, error) {
+	if req.Profile == nil {
+		return &userpb.OIDCIdentitiesResponse{Code: 400, Message: "身份信息不完整"}, nil
+	}
+	if err := h.userService.LinkOIDCIdentity(uint(req.UserId), convertOIDCProfile(req.Profile)); err != nil {
+		log.Printf("❌ gRPC: 绑定单点登录身份失败 - %v", err)
+		return &userpb.OIDCIdentitiesResponse{
+			Code:    400,
+			Message: err.Error(),
+		}, nil
+	}
+	return h.oidcIdentitiesResponse(uint(req.UserId), "绑定成功"), nil
+}
+
+// ListOIDCIdentities 处理获取已绑定单点登录身份gRPC请求
+func (h *UserHandler) ListOIDCIdentities(ctx context.Context, req *userpb.ListOIDCIdentitiesRequest) (*userpb.OIDCIdentitiesResponse, error) {
+	return h.oidcIdentitiesResponse(uint(req.UserId), "获取成功"), nil
+}
+
+// UnlinkOIDCIdentity 处理解除单点登录绑定gRPC请求
+func (h *UserHandler) UnlinkOIDCIdentity(ctx context.Context, req *userpb.UnlinkOIDCIdentityRequest) (*userpb.OIDCIdentitiesResponse, error) {
+	if err := h.userService.UnlinkOIDCIdentity(uint(req.UserId), req.Provider); err != nil {
+		log.Printf("❌ gRPC: 解除单点登录绑定失败 - %v", err)
+		return &userpb.OIDCIdentitiesResponse{
+			Code:    400,
+			Message: err.Error(),
+		}, nil
+	}
+	return h.oidcIdentitiesResponse(uint(req.UserId), "已解除绑定"), nil
+}
+
+// oidcIdentitiesResponse 查询并返回用户绑定的单点登录身份
+func (h *UserHandler) oidcIdentitiesResponse(userID uint, message string) *userpb.OIDCIdentitiesResponse {
+	identities, err := h.userService.ListOIDCIdentities(userID)
+	if err != nil {
+		log.Printf("❌ gRPC: 获取单点登录身份失败 - %v", err)
+		return &userpb.OIDCIdentitiesResponse{
+			Code:    400,
+			Message: err.Error(),
+		}
+	}
+
+	pbIdentities := make([]*userpb.OIDCIdentity, 0, len(identities))
+	for _, identity := range identities {
+		pbIdentity := &userpb.OIDCIdentity{
+			Provider: identity.Provider,
+			Email:    identity.Email,
+			LinkedAt: identity.CreatedAt.Format("2006-01-02 15:04:05"),
+		}
+		if identity.LastLoginAt != nil {
+			pbIdentity.LastLoginAt = identity.LastLoginAt.Format("2006-01-02 15:04:05")
+		}
+		pbIdentities = append(pbIdentities, pbIdentity)
+	}
+	return &userpb.OIDCIdentitiesResponse{
+		Code:       200,
+		Message:    message,
+		Identities: pbIdentities,
+	}
+}
+
+// convertOIDCProfile 转换身份提供者用户信息
+func convertOIDCProfile(profile *userpb.OIDCProfile) *service.OIDCProfile {
+	return &service.OIDCProfile{
+		Provider:          profile.Provider,
+		Subject:           profile.Subject,
+		Email:             profile.Email,
+		EmailVerified:     profile.EmailVerified,
+		Name:              profile.Name,
+		PreferredUsername: profile.PreferredUsername,
+		Picture:           profile.Picture,
+		TrustEmail:        profile.TrustEmail,
+	}
+}
+
+// convertUserToPB 转换为protobuf用户对象
+func convertUserToPB(user *model.User) *userpb.User {
+	return &userpb.User{
+		Id:            uint32(user.ID),
+		Username:      user.Username,
+		Email:         user.Email,
+		Nickname:      user.Nickname,
+		Avatar:        user.AvatarURL,
+		CreatedAt:     user.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:     user.UpdatedAt.Format("2006-01-02 15:04:05"),
+		EmailVerified: user.IsEmailVerified(),
+		MfaEnabled:    user.MFAEnabled,
+		Role:          user.Role,
+	}
+}
+
 // TODO: 以下方法需要在user.proto中添加相应的消息定义后才能实现
 
+// ListSessions 处理获取登录会话gRPC请求
+func (h *UserHandler) ListSessions(ctx context.Context, req *userpb.ListSessionsRequest) (*userpb.SessionsResponse, error) {
+	return h.sessionsResponse(uint(req.UserId), req.CurrentSessionId, "获取成功"), nil
+}
+
+// RevokeSession 处理注销登录会话gRPC请求
+func (h *UserHandler) RevokeSession(ctx context.Context, req *userpb.RevokeSessionRequest) (*userpb.SessionsResponse, error) {
+	if err := h.userService.RevokeSession(uint(req.UserId), uint(req.Id)); err != nil {
+		log.Printf("❌ gRPC: 注销登录会话失败 - %v", err)
+		return &userpb.SessionsResponse{
+			Code:    400,
+			Message: err.Error(),
+		}, nil
+	}
+	return h.sessionsResponse(uint(req.UserId), req.CurrentSessionId, "已注销该设备"), nil
+}
+
+// RevokeOtherSessions 处理注销其他登录会话gRPC请求
+func (h *UserHandler) RevokeOtherSessions(ctx context.Context, req *userpb.RevokeOtherSessionsRequest) (*userpb.SessionsResponse, error) {
+	count, err := h.userService.RevokeOtherSessions(uint(req.UserId), req.CurrentSessionId)
+	if err != nil {
+		log.Printf("❌ gRPC: 注销其他登录会话失败 - %v", err)
+		return &userpb.SessionsResponse{
+			Code:    400,
+			Message: err.Error(),
+		}, nil
+	}
+	return h.sessionsResponse(uint(req.UserId), req.CurrentSessionId, fmt.Sprintf("已注销 %d 个其他设备", count)), nil
+}
+
+// sessionsResponse 查询并返回用户的登录会话，标记当前会话
+func (h *UserHandler) sessionsResponse(userID uint, currentSessionID, message string) *userpb.SessionsResponse {
+	sessions, err := h.userService.ListSessions(userID)
+	if err != nil {
+		log.Printf("❌ gRPC: 获取登录会话失败 - %v", err)
+		return &userpb.SessionsResponse{
+			Code:    400,
+			Message: err.Error(),
+		}
+	}
+
+	pbSessions := make([]*userpb.Session, 0, len(sessions))
+	for _, session := range sessions {
+		pbSessions = append(pbSessions, &userpb.Session{
+			Id:         uint32(session.ID),
+			Device:     session.Device,
+			UserAgent:  session.UserAgent,
+			Ip:         session.IP,
+			CreatedAt:  session.CreatedAt.Format("2006-01-02 15:04:05"),
+			LastSeenAt: session.LastSeenAt.Format("2006-01-02 15:04:05"),
+			Current:    currentSessionID != "" && session.SessionID == currentSessionID,
+		})
+	}
+	return &userpb.SessionsResponse{
+		Code:     200,
+		Message:  message,
+		Sessions: pbSessions,
+	}
+}
+
+// CreateAPIToken 处理创建个人访问令牌gRPC请求
+func (h *UserHandler) CreateAPIToken(ctx context.Context, req *userpb.CreateAPITokenRequest) (*userpb.CreateAPITokenResponse, error) {
+	token, plaintext, err := h.userService.CreateAPIToken(uint(req.UserId), req.Name, req.Scopes, int(req.ExpiresInDays))
+	if err != nil {
+		log.Printf("❌ gRPC: 创建个人访问令牌失败 - %v", err)
+		return &userpb.CreateAPITokenResponse{
+			Code:    400,
+			Message: err.Error(),
+		}, nil
+	}
+	return &userpb.CreateAPITokenResponse{
+		Code:     200,
+		Message:  "令牌已创建，请立即复制保存，关闭后将无法再次查看",
+		Token:    plaintext,
+		ApiToken: toPBAPIToken(token, time.Now()),
+	}, nil
+}
+
+// ListAPITokens 处理获取个人访问令牌gRPC请求
+func (h *UserHandler) ListAPITokens(ctx context.Context, req *userpb.ListAPITokensRequest) (*userpb.APITokensResponse, error) {
+	return h.apiTokensResponse(uint(req.UserId), "获取成功"), nil
+}
+
+// RevokeAPIToken 处理吊销个人访问令牌gRPC请求
+func (h *UserHandler) RevokeAPIToken(ctx context.Context, req *userpb.RevokeAPITokenRequest) (*userpb.APITokensResponse, error) {
+	if err := h.userService.RevokeAPIToken(uint(req.UserId), uint(req.Id)); err != nil {
+		log.Printf("❌ gRPC: 吊销个人访问令牌失败 - %v", err)
+		return &userpb.APITokensResponse{
+			Code:    400,
+			Message: err.Error(),
+		}, nil
+	}
+	return h.apiTokensResponse(uint(req.UserId), "令牌已吊销"), nil
+}
+
+// EraseAccount 处理清除账号gRPC请求
+func (h *UserHandler) EraseAccount(ctx context.Context, req *userpb.EraseAccountRequest) (*userpb.EraseAccountResponse, error) {
+	log.Printf("🗑️ gRPC: 收到清除账号请求 - 用户ID: %d", req.UserId)
+
+	if err := h.userService.EraseAccount(uint(req.UserId)); err != nil {
+		log.Printf("❌ gRPC: 清除账号失败 - %v", err)
+		return &userpb.EraseAccountResponse{
+			Code:    500,
+			Message: err.Error(),
+		}, nil
+	}
+	return &userpb.EraseAccountResponse{
+		Code:    200,
+		Message: "账号已清除",
+	}, nil
+}
+
+// apiTokensResponse 查询并返回用户的个人访问令牌
+func (h *UserHandler) apiTokensResponse(userID uint, message string) *userpb.APITokensResponse {
+	tokens, err := h.userService.ListAPITokens(userID)
+	if err != nil {
+		log.Printf("❌ gRPC: 获取个人访问令牌失败 - %v", err)
+		return &userpb.APITokensResponse{
+			Code:    400,
+			Message: err.Error(),
+		}
+	}
+
+	now := time.Now()
+	pbTokens := make([]*userpb.APIToken, 0, len(tokens))
+	for _, token := range tokens {
+		pbTokens = append(pbTokens, toPBAPIToken(token, now))
+	}
+	return &userpb.APITokensResponse{
+		Code:    200,
+		Message: message,
+		Tokens:  pbTokens,
+	}
+}
+
+// toPBAPIToken 转换个人访问令牌为protobuf格式
+func toPBAPIToken(token *model.APIToken, now time.Time) *userpb.APIToken {
+	pbToken := &userpb.APIToken{
+		Id:         uint32(token.ID),
+		Name:       token.Name,
+		Prefix:     token.Prefix,
+		Scopes:     token.ScopeList(),
+		CreatedAt:  token.CreatedAt.Format("2006-01-02 15:04:05"),
+		ExpiresAt:  token.ExpiresAt.Format("2006-01-02 15:04:05"),
+		LastUsedIp: token.LastUsedIP,
+		Expired:    !now.Before(token.ExpiresAt),
+	}
+	if token.LastUsedAt != nil {
+		pbToken.LastUsedAt = token.LastUsedAt.Format("2006-01-02 15:04:05")
+	}
+	return pbToken
+}
+
 // GetMe 处理获取当前用户信息gRPC请求 (暂未实现)
 // func (h *UserHandler) GetMe(ctx context.Context, req *userpb.GetMeRequest) (*userpb.GetMeResponse, error) {
 //   // 需要在user.proto中添加GetMeRequest和GetMeResponse定义
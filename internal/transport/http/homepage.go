@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	grpcClient "course-platform/internal/infrastructure/grpc_client"
+	"course-platform/internal/shared/pb/bundlepb"
 	"course-platform/internal/shared/pb/coursepb"
 	"course-platform/internal/shared/utils"
 
@@ -14,12 +15,14 @@ import (
 // HomepageHandler 首页处理器
 type HomepageHandler struct {
 	courseService *grpcClient.CourseGRPCClientService
+	bundleService *grpcClient.BundleGRPCClientService
 }
 
 // NewHomepageHandler 创建首页处理器
-func NewHomepageHandler(courseService *grpcClient.CourseGRPCClientService) *HomepageHandler {
+func NewHomepageHandler(courseService *grpcClient.CourseGRPCClientService, bundleService *grpcClient.BundleGRPCClientService) *HomepageHandler {
 	return &HomepageHandler{
 		courseService: courseService,
+		bundleService: bundleService,
 	}
 }
 
@@ -31,10 +34,47 @@ func (h *HomepageHandler) HandleHomepage(c *gin.Context) {
 	c.HTML(http.StatusOK, "index.html", gin.H{
 		"SiteName":        "Course Platform",
 		"HotCourses":      hotCourses,
+		"Bundles":         h.getBundles(c),
 		"ContinueCourses": continueCourses,
 	})
 }
 
+// getBundles 获取首页展示的套餐和学习路径
+func (h *HomepageHandler) getBundles(c *gin.Context) []gin.H {
+	if h.bundleService == nil {
+		return nil
+	}
+
+	bundlesResp, err := h.bundleService.ListBundles(c, 1, 4)
+	if err != nil || bundlesResp.Code != 200 {
+		log.Printf("⚠️ 获取套餐列表失败，首页不展示套餐: %v", err)
+		return nil
+	}
+	return h.convertBundlesToDisplay(bundlesResp.Bundles)
+}
+
+// convertBundlesToDisplay 转换套餐数据为显示格式
+func (h *HomepageHandler) convertBundlesToDisplay(bundles []*bundlepb.Bundle) []gin.H {
+	var displayBundles []gin.H
+	for _, bundle := range bundles {
+		kindLabel := "课程套餐"
+		if bundle.Kind == "path" {
+			kindLabel = "学习路径"
+		}
+
+		displayBundles = append(displayBundles, gin.H{
+			"ID":           bundle.Id,
+			"Title":        bundle.Title,
+			"KindLabel":    kindLabel,
+			"CoverImage":   bundle.CoverImage,
+			"Price":        bundle.Price,
+			"CoursesPrice": bundle.CoursesPrice,
+			"CourseCount":  len(bundle.Courses),
+		})
+	}
+	return displayBundles
+}
+
 // getHotCourses 获取热门课程数据
 func (h *HomepageHandler) getHotCourses(c *gin.Context) []gin.H {
 	// 从数据库获取热门课程
@@ -1,16 +1,35 @@
 ﻿package router
 
 import (
+	"context"
 	"log"
+	"strings"
 
 	_ "course-platform/docs"
 	"course-platform/internal/configs"
+	announcementHandler "course-platform/internal/domain/announcement/handler"
+	assignmentHandler "course-platform/internal/domain/assignment/handler"
+	bundleHandler "course-platform/internal/domain/bundle/handler"
+	certificateHandler "course-platform/internal/domain/certificate/handler"
+	cohortHandler "course-platform/internal/domain/cohort/handler"
 	contentHandler "course-platform/internal/domain/content/handler"
+	couponHandler "course-platform/internal/domain/coupon/handler"
 	courseHandler "course-platform/internal/domain/course/handler"
+	discussionHandler "course-platform/internal/domain/discussion/handler"
+	emailHandler "course-platform/internal/domain/email/handler"
+	ledgerHandler "course-platform/internal/domain/ledger/handler"
+	notificationHandler "course-platform/internal/domain/notification/handler"
+	orderHandler "course-platform/internal/domain/order/handler"
+	privacyHandler "course-platform/internal/domain/privacy/handler"
+	quizHandler "course-platform/internal/domain/quiz/handler"
+	refundHandler "course-platform/internal/domain/refund/handler"
 	userHandler "course-platform/internal/domain/user/handler"
+	"course-platform/internal/domain/user/model"
 	"course-platform/internal/domain/user/repository"
 	"course-platform/internal/domain/user/service"
 	grpcClient "course-platform/internal/infrastructure/grpc_client"
+	"course-platform/internal/infrastructure/oidc"
+	"course-platform/internal/infrastructure/realtime"
 	"course-platform/internal/shared/middleware"
 	templatefuncs "course-platform/internal/shared/utils"
 
@@ -22,7 +41,7 @@ import (
 )
 
 // SetupRouter 设置路由和所有依赖注入
-func SetupRouter(db *gorm.DB, rdb *redis.Client) *gin.Engine {
+func SetupRouter(db *gorm.DB, rdb *redis.Client, config *configs.Config) *gin.Engine {
 	// 初始化 Gin 引擎
 	r := gin.Default()
 
@@ -30,13 +49,21 @@ func SetupRouter(db *gorm.DB, rdb *redis.Client) *gin.Engine {
 	setupTemplatesAndStatic(r)
 
 	// 初始化服务
-	services := initializeServices(db, rdb)
+	services := initializeServices(db, rdb, config)
+
+	// 认证中间件拒绝已吊销的登录令牌（如重置密码前签发的令牌、已注销会话的令牌）
+	middleware.SetRevocationCheck(services.UserService.IsTokenRevoked)
+	// 记录会话的最近活动时间和来源IP，用于登录设备列表
+	middleware.SetSessionActivity(services.UserService.TouchSession)
+	// 个人访问令牌只能调用登记过权限的接口
+	middleware.SetAPITokenCheck(model.APITokenPrefix, services.UserService.AuthenticateAPIToken)
+	registerAPITokenScopes()
 
 	// 初始化处理器
 	handlers := initializeHandlers(services)
 
 	// 设置路由
-	setupAllRoutes(r, handlers, services.CourseGRPCService)
+	setupAllRoutes(r, handlers, config, services.CourseGRPCService, services.BundleGRPCService)
 
 	return r
 }
@@ -52,21 +79,37 @@ func setupTemplatesAndStatic(r *gin.Engine) {
 	// 加载HTML模板
 	r.LoadHTMLGlob(staticConfig.TemplateGlob)
 
-	// 设定静态文件路径
+	// 设定静态文件路径（上传目录不整体公开，见 setupPageRoutes）
 	r.Static("/static", staticConfig.StaticDir)
-	r.Static("/uploads", staticConfig.UploadsDir)
 }
 
 // Services 服务集合
 type Services struct {
-	CourseGRPCService  *grpcClient.CourseGRPCClientService
-	ContentGRPCService *grpcClient.ContentGRPCClientService
-	UserGRPCService    *grpcClient.UserGRPCClientService
-	UserService        service.UserServiceInterface
+	CourseGRPCService       *grpcClient.CourseGRPCClientService
+	ContentGRPCService      *grpcClient.ContentGRPCClientService
+	QuizGRPCService         *grpcClient.QuizGRPCClientService
+	AssignmentGRPCService   *grpcClient.AssignmentGRPCClientService
+	CertificateGRPCService  *grpcClient.CertificateGRPCClientService
+	OrderGRPCService        *grpcClient.OrderGRPCClientService
+	CouponGRPCService       *grpcClient.CouponGRPCClientService
+	RefundGRPCService       *grpcClient.RefundGRPCClientService
+	LedgerGRPCService       *grpcClient.LedgerGRPCClientService
+	BundleGRPCService       *grpcClient.BundleGRPCClientService
+	CohortGRPCService       *grpcClient.CohortGRPCClientService
+	DiscussionGRPCService   *grpcClient.DiscussionGRPCClientService
+	AnnouncementGRPCService *grpcClient.AnnouncementGRPCClientService
+	NotificationGRPCService *grpcClient.NotificationGRPCClientService
+	NotificationHub         *realtime.NotificationHub
+	EmailGRPCService        *grpcClient.EmailGRPCClientService
+	PrivacyGRPCService      *grpcClient.PrivacyGRPCClientService
+	UserGRPCService         *grpcClient.UserGRPCClientService
+	UserService             service.UserServiceInterface
+	OIDCProviders           []*oidc.Provider
+	OIDCStates              oidc.StateStore
 }
 
 // initializeServices 初始化所有服务
-func initializeServices(db *gorm.DB, rdb *redis.Client) *Services {
+func initializeServices(db *gorm.DB, rdb *redis.Client, config *configs.Config) *Services {
 	// 获取服务地址配置
 	addresses := configs.GetServiceAddresses()
 
@@ -83,6 +126,77 @@ func initializeServices(db *gorm.DB, rdb *redis.Client) *Services {
 		log.Fatalf("❌ 初始化内容gRPC客户端失败: %v", err)
 	}
 
+	// 测验服务与课程服务同进程部署，复用课程服务地址
+	quizGRPCService, err := grpcClient.NewQuizGRPCClientService(addresses.CourseService)
+	if err != nil {
+		log.Fatalf("❌ 初始化测验gRPC客户端失败: %v", err)
+	}
+
+	assignmentGRPCService, err := grpcClient.NewAssignmentGRPCClientService(addresses.CourseService)
+	if err != nil {
+		log.Fatalf("❌ 初始化作业gRPC客户端失败: %v", err)
+	}
+
+	certificateGRPCService, err := grpcClient.NewCertificateGRPCClientService(addresses.CourseService)
+	if err != nil {
+		log.Fatalf("❌ 初始化证书gRPC客户端失败: %v", err)
+	}
+
+	orderGRPCService, err := grpcClient.NewOrderGRPCClientService(addresses.CourseService)
+	if err != nil {
+		log.Fatalf("❌ 初始化订单gRPC客户端失败: %v", err)
+	}
+
+	couponGRPCService, err := grpcClient.NewCouponGRPCClientService(addresses.CourseService)
+	if err != nil {
+		log.Fatalf("❌ 初始化优惠券gRPC客户端失败: %v", err)
+	}
+
+	refundGRPCService, err := grpcClient.NewRefundGRPCClientService(addresses.CourseService)
+	if err != nil {
+		log.Fatalf("❌ 初始化退款gRPC客户端失败: %v", err)
+	}
+
+	ledgerGRPCService, err := grpcClient.NewLedgerGRPCClientService(addresses.CourseService)
+	if err != nil {
+		log.Fatalf("❌ 初始化收入分账gRPC客户端失败: %v", err)
+	}
+
+	bundleGRPCService, err := grpcClient.NewBundleGRPCClientService(addresses.CourseService)
+	if err != nil {
+		log.Fatalf("❌ 初始化课程套餐gRPC客户端失败: %v", err)
+	}
+
+	cohortGRPCService, err := grpcClient.NewCohortGRPCClientService(addresses.CourseService)
+	if err != nil {
+		log.Fatalf("❌ 初始化班期gRPC客户端失败: %v", err)
+	}
+
+	discussionGRPCService, err := grpcClient.NewDiscussionGRPCClientService(addresses.CourseService)
+	if err != nil {
+		log.Fatalf("❌ 初始化讨论区gRPC客户端失败: %v", err)
+	}
+
+	announcementGRPCService, err := grpcClient.NewAnnouncementGRPCClientService(addresses.CourseService)
+	if err != nil {
+		log.Fatalf("❌ 初始化课程公告gRPC客户端失败: %v", err)
+	}
+
+	notificationGRPCService, err := grpcClient.NewNotificationGRPCClientService(addresses.CourseService)
+	if err != nil {
+		log.Fatalf("❌ 初始化站内通知gRPC客户端失败: %v", err)
+	}
+
+	emailGRPCService, err := grpcClient.NewEmailGRPCClientService(addresses.CourseService)
+	if err != nil {
+		log.Fatalf("❌ 初始化邮件订阅gRPC客户端失败: %v", err)
+	}
+
+	privacyGRPCService, err := grpcClient.NewPrivacyGRPCClientService(addresses.CourseService)
+	if err != nil {
+		log.Fatalf("❌ 初始化个人数据gRPC客户端失败: %v", err)
+	}
+
 	userGRPCService, err := grpcClient.NewUserGRPCClientService()
 	if err != nil {
 		log.Fatalf("❌ 初始化用户gRPC客户端失败: %v", err)
@@ -92,27 +206,101 @@ func initializeServices(db *gorm.DB, rdb *redis.Client) *Services {
 
 	// 初始化仓储层和业务服务层
 	userRepo := repository.NewUserRepository(db, rdb)
-	userService := service.NewUserService(userRepo)
+	sessionRepo := repository.NewSessionRepository(db, rdb)
+	apiTokenRepo := repository.NewAPITokenRepository(db, rdb)
+	userService := service.NewUserService(userRepo, nil, nil, nil, nil, sessionRepo, apiTokenRepo, nil)
+
+	// 实时通知依赖 Redis 发布订阅在多个网关实例间分发，没有 Redis 时只提供通知列表
+	var notificationHub *realtime.NotificationHub
+	if rdb != nil {
+		notificationHub = realtime.NewNotificationHub(rdb)
+		go notificationHub.Run(context.Background())
+	} else {
+		log.Printf("⚠️ 未连接 Redis，实时通知推送不可用")
+	}
+
+	oidcProviders, oidcStates := initializeOIDC(config, rdb)
 
 	return &Services{
-		CourseGRPCService:  courseGRPCService,
-		ContentGRPCService: contentGRPCService,
-		UserGRPCService:    userGRPCService,
-		UserService:        userService,
+		CourseGRPCService:       courseGRPCService,
+		ContentGRPCService:      contentGRPCService,
+		QuizGRPCService:         quizGRPCService,
+		AssignmentGRPCService:   assignmentGRPCService,
+		CertificateGRPCService:  certificateGRPCService,
+		OrderGRPCService:        orderGRPCService,
+		CouponGRPCService:       couponGRPCService,
+		RefundGRPCService:       refundGRPCService,
+		LedgerGRPCService:       ledgerGRPCService,
+		BundleGRPCService:       bundleGRPCService,
+		CohortGRPCService:       cohortGRPCService,
+		DiscussionGRPCService:   discussionGRPCService,
+		AnnouncementGRPCService: announcementGRPCService,
+		NotificationGRPCService: notificationGRPCService,
+		NotificationHub:         notificationHub,
+		EmailGRPCService:        emailGRPCService,
+		PrivacyGRPCService:      privacyGRPCService,
+		UserGRPCService:         userGRPCService,
+		UserService:             userService,
+		OIDCProviders:           oidcProviders,
+		OIDCStates:              oidcStates,
+	}
+}
+
+// initializeOIDC 初始化单点登录身份提供者，授权请求优先保存在 Redis 中以便多个网关实例共享
+func initializeOIDC(config *configs.Config, rdb *redis.Client) ([]*oidc.Provider, oidc.StateStore) {
+	var providers []*oidc.Provider
+	if config != nil {
+		publicURL := strings.TrimRight(config.Server.PublicURL, "/")
+		for _, p := range config.OIDC.Providers {
+			providers = append(providers, oidc.NewProvider(oidc.ProviderConfig{
+				Name:         p.Name,
+				DisplayName:  p.DisplayName,
+				Issuer:       p.Issuer,
+				ClientID:     p.ClientID,
+				ClientSecret: p.ClientSecret,
+				RedirectURL:  publicURL + "/auth/oidc/" + p.Name + "/callback",
+				Scopes:       p.Scopes,
+				TrustEmail:   p.TrustEmail,
+			}))
+			log.Printf("✅ 已配置单点登录身份提供者: %s", p.Name)
+		}
+	}
+
+	if rdb != nil {
+		return providers, oidc.NewRedisStateStore(rdb)
+	}
+	if len(providers) > 0 {
+		log.Printf("⚠️ 未连接 Redis，单点登录请求保存在进程内，仅适用于单实例部署")
 	}
+	return providers, oidc.NewMemoryStateStore()
 }
 
 // initializeHandlers 初始化所有处理器
 func initializeHandlers(services *Services) *RouteHandlers {
 	return &RouteHandlers{
-		UserHandler:    userHandler.NewUserHandler(services.UserGRPCService, services.UserService),
-		CourseHandler:  courseHandler.NewCourseHandler(services.CourseGRPCService),
-		ContentHandler: contentHandler.NewContentHandler(services.ContentGRPCService),
+		UserHandler:         userHandler.NewUserHandler(services.UserGRPCService, services.UserService),
+		CourseHandler:       courseHandler.NewCourseHandler(services.CourseGRPCService, services.QuizGRPCService, services.BundleGRPCService),
+		ContentHandler:      contentHandler.NewContentHandler(services.ContentGRPCService, services.CourseGRPCService),
+		QuizHandler:         quizHandler.NewQuizHandler(services.QuizGRPCService),
+		AssignmentHandler:   assignmentHandler.NewAssignmentHandler(services.AssignmentGRPCService, services.ContentGRPCService),
+		CertificateHandler:  certificateHandler.NewCertificateHandler(services.CertificateGRPCService),
+		OrderHandler:        orderHandler.NewOrderHandler(services.OrderGRPCService),
+		CouponHandler:       couponHandler.NewCouponHandler(services.CouponGRPCService),
+		RefundHandler:       refundHandler.NewRefundHandler(services.RefundGRPCService),
+		LedgerHandler:       ledgerHandler.NewLedgerHandler(services.LedgerGRPCService),
+		BundleHandler:       bundleHandler.NewBundleHandler(services.BundleGRPCService),
+		CohortHandler:       cohortHandler.NewCohortHandler(services.CohortGRPCService),
+		DiscussionHandler:   discussionHandler.NewDiscussionHandler(services.DiscussionGRPCService),
+		AnnouncementHandler: announcementHandler.NewAnnouncementHandler(services.AnnouncementGRPCService),
+		NotificationHandler: notificationHandler.NewNotificationHandler(services.NotificationGRPCService, services.NotificationHub),
+		EmailHandler:        emailHandler.NewEmailHandler(services.EmailGRPCService),
+		PrivacyHandler:      privacyHandler.NewPrivacyHandler(services.PrivacyGRPCService),
+		OIDCHandler:         userHandler.NewOIDCHandler(services.UserGRPCService, services.OIDCProviders, services.OIDCStates),
 	}
 }
 
 // setupAllRoutes 设置所有路由
-func setupAllRoutes(r *gin.Engine, handlers *RouteHandlers, courseService *grpcClient.CourseGRPCClientService) {
+func setupAllRoutes(r *gin.Engine, handlers *RouteHandlers, config *configs.Config, courseService *grpcClient.CourseGRPCClientService, bundleService *grpcClient.BundleGRPCClientService) {
 	// 设置基础路由（健康检查、调试API、Swagger等）
 	setupBasicRoutes(r, handlers)
 
@@ -120,15 +308,15 @@ func setupAllRoutes(r *gin.Engine, handlers *RouteHandlers, courseService *grpcC
 	setupPageRoutes(r, handlers)
 
 	// 设置API路由
-	setupAPIRoutes(r, handlers)
+	setupAPIRoutes(r, handlers, config)
 
 	// 设置首页路由（使用专门的首页处理器）
-	setupHomepageRoute(r, courseService)
+	setupHomepageRoute(r, courseService, bundleService)
 }
 
 // setupHomepageRoute 设置首页路由
-func setupHomepageRoute(r *gin.Engine, courseService *grpcClient.CourseGRPCClientService) {
-	homepageHandler := NewHomepageHandler(courseService)
+func setupHomepageRoute(r *gin.Engine, courseService *grpcClient.CourseGRPCClientService, bundleService *grpcClient.BundleGRPCClientService) {
+	homepageHandler := NewHomepageHandler(courseService, bundleService)
 	r.GET("/", homepageHandler.HandleHomepage)
 }
 
@@ -137,11 +325,31 @@ func setupPageRoutes(r *gin.Engine, handlers *RouteHandlers) {
 	// 课程相关页面路由
 	r.GET("/course/:id", handlers.CourseHandler.CourseDetailPage)
 	r.GET("/courses", handlers.CourseHandler.CoursesListPage)
+	r.GET("/bundle/:id", handlers.BundleHandler.BundleDetailPage)
+
+	// 公开的上传文件（头像和课程封面），其余上传文件需经鉴权接口访问
+	r.GET("/uploads/*filepath", handlers.ContentHandler.ServePublicUpload)
+	r.HEAD("/uploads/*filepath", handlers.ContentHandler.ServePublicUpload)
+
+	// 证书公开验证页面
+	r.GET("/certificates/:code", handlers.CertificateHandler.CertificatePage)
+	r.GET("/certificates/:code/pdf", handlers.CertificateHandler.DownloadCertificatePDF)
+
+	// 邮件退订页面（令牌即凭证，无需登录）
+	r.GET("/email/unsubscribe", handlers.EmailHandler.UnsubscribePage)
+	r.POST("/email/unsubscribe", handlers.EmailHandler.UnsubscribePage)
 
 	// 认证页面路由
 	// 静态页面路由 (无需认证)
 	r.GET("/login", handlers.UserHandler.LoginPage)
 	r.GET("/register", handlers.UserHandler.RegisterPage)
+	r.GET("/verify-email", handlers.UserHandler.VerifyEmailPage)
+	r.GET("/forgot-password", handlers.UserHandler.ForgotPasswordPage)
+	r.GET("/reset-password", handlers.UserHandler.ResetPasswordPage)
+
+	// 单点登录 - 浏览器跳转到身份提供者并在回调中完成登录
+	r.GET("/auth/oidc/:provider/login", handlers.OIDCHandler.Login)
+	r.GET("/auth/oidc/:provider/callback", handlers.OIDCHandler.Callback)
 
 	// 需要可选认证的页面
 	dashboardRoutes := r.Group("/")
@@ -153,16 +361,32 @@ func setupPageRoutes(r *gin.Engine, handlers *RouteHandlers) {
 }
 
 // setupAPIRoutes 设置API路由
-func setupAPIRoutes(r *gin.Engine, handlers *RouteHandlers) {
+func setupAPIRoutes(r *gin.Engine, handlers *RouteHandlers, config *configs.Config) {
 	// API v1 路由组
 	v1 := r.Group("/api/v1")
 	{
 		// 用户相关路由 (无需认证)
 		v1.POST("/register", handlers.UserHandler.Register)
 		v1.POST("/login", handlers.UserHandler.Login)
+		v1.POST("/login/mfa", handlers.UserHandler.VerifyMFALogin)
+		v1.POST("/login/mfa/setup", handlers.UserHandler.BeginMFALoginSetup)
+		v1.POST("/login/mfa/enable", handlers.UserHandler.EnableMFALogin)
+		v1.POST("/verify-email", handlers.UserHandler.VerifyEmail)
+		v1.POST("/password/forgot", handlers.UserHandler.ForgotPassword)
+		v1.POST("/password/reset", handlers.UserHandler.ResetPassword)
+		v1.GET("/oidc/providers", handlers.OIDCHandler.ListProviders)
 		v1.POST("/validate-token", handlers.UserHandler.ValidateToken)
 		v1.POST("/analytics", handlers.UserHandler.Analytics)
 
+		// 支付渠道回调 (通过签名校验，无需登录)
+		v1.POST("/payments/webhook", handlers.OrderHandler.PaymentWebhook)
+
+		// 日历订阅 (令牌即凭证，日历应用无法携带登录信息)
+		v1.GET("/calendar/feeds/:file", handlers.CohortHandler.CalendarFeed)
+
+		// 邮件一键退订 (邮件客户端直接提交，令牌即凭证)
+		v1.POST("/email/unsubscribe", handlers.EmailHandler.Unsubscribe)
+
 		// 可选认证的路由 (支持演示模式)
 		optional := v1.Group("/")
 		optional.Use(middleware.OptionalAuthMiddleware())
@@ -174,9 +398,30 @@ func setupAPIRoutes(r *gin.Engine, handlers *RouteHandlers) {
 			optional.PUT("/courses/:id", handlers.CourseHandler.UpdateCourse)
 			optional.POST("/courses/:id/publish", handlers.CourseHandler.PublishCourse)
 			optional.GET("/courses/search", handlers.CourseHandler.SearchCourses)
+			optional.GET("/courses/:id/chapters", handlers.CourseHandler.GetChapters)
+
+			// 测验相关 - 浏览测验支持演示模式
+			optional.GET("/quizzes", handlers.QuizHandler.ListQuizzes)
+			optional.GET("/quizzes/:id", handlers.QuizHandler.GetQuiz)
+
+			// 作业相关 - 浏览作业支持演示模式
+			optional.GET("/assignments", handlers.AssignmentHandler.ListAssignments)
+			optional.GET("/assignments/:id", handlers.AssignmentHandler.GetAssignment)
 
-			// 创作者相关 (支持演示模式)
-			optional.GET("/creator/stats", handlers.UserHandler.GetCreatorStats)
+			// 套餐相关 - 浏览套餐支持演示模式
+			optional.GET("/bundles", handlers.BundleHandler.ListBundles)
+			optional.GET("/bundles/:id", handlers.BundleHandler.GetBundle)
+
+			// 班期相关 - 浏览班期支持演示模式
+			optional.GET("/courses/:id/cohorts", handlers.CohortHandler.ListCohorts)
+			optional.GET("/cohorts/:id", handlers.CohortHandler.GetCohort)
+
+			// 课程公告 - 课程页公开展示，登录后附带未读标记
+			optional.GET("/courses/:id/announcements", handlers.AnnouncementHandler.ListAnnouncements)
+
+			// 证书相关 - 验证证书和查看模板无需登录
+			optional.GET("/certificates/:code", handlers.CertificateHandler.GetCertificate)
+			optional.GET("/courses/:id/certificate-template", handlers.CertificateHandler.GetTemplate)
 
 			// 内容相关 - 文件列表支持演示模式
 			optional.GET("/content/files", handlers.ContentHandler.GetFiles)
@@ -190,19 +435,219 @@ func setupAPIRoutes(r *gin.Engine, handlers *RouteHandlers) {
 			auth.GET("/me", handlers.UserHandler.GetMe)
 			auth.PUT("/user/profile", handlers.UserHandler.UpdateProfile)
 			auth.PUT("/user/password", handlers.UserHandler.ChangePassword)
+			auth.POST("/verify-email/resend", handlers.UserHandler.ResendVerification)
+
+			// 登录设备
+			auth.GET("/me/sessions", handlers.UserHandler.ListSessions)
+			auth.DELETE("/me/sessions/:id", handlers.UserHandler.RevokeSession)
+			auth.POST("/me/sessions/revoke-others", handlers.UserHandler.RevokeOtherSessions)
+
+			// 个人访问令牌
+			auth.GET("/me/tokens", handlers.UserHandler.ListAPITokens)
+			auth.POST("/me/tokens", handlers.UserHandler.CreateAPIToken)
+			auth.DELETE("/me/tokens/:id", handlers.UserHandler.RevokeAPIToken)
+
+			// 数据导出与账号注销
+			auth.GET("/me/privacy", handlers.PrivacyHandler.GetPrivacyStatus)
+			auth.POST("/me/exports", handlers.PrivacyHandler.RequestDataExport)
+			auth.GET("/me/exports/:id/download", handlers.PrivacyHandler.DownloadDataExport)
+			auth.POST("/me/deletion", handlers.PrivacyHandler.RequestAccountDeletion)
+			auth.DELETE("/me/deletion", handlers.PrivacyHandler.CancelAccountDeletion)
+
+			// 两步验证
+			auth.GET("/mfa", handlers.UserHandler.GetMFAStatus)
+			auth.POST("/mfa/totp/setup", handlers.UserHandler.BeginTOTPSetup)
+			auth.POST("/mfa/totp/enable", handlers.UserHandler.EnableTOTP)
+			auth.POST("/mfa/totp/disable", handlers.UserHandler.DisableTOTP)
+			auth.POST("/mfa/recovery-codes", handlers.UserHandler.RegenerateRecoveryCodes)
+			auth.GET("/admin/mfa/policies", handlers.UserHandler.ListMFAPolicies)
+			auth.PUT("/admin/mfa/policies/:role", handlers.UserHandler.SetMFAPolicy)
+
+			// 单点登录绑定
+			auth.POST("/oidc/:provider/link", handlers.OIDCHandler.BeginLink)
+			auth.GET("/oidc/identities", handlers.OIDCHandler.ListIdentities)
+			auth.DELETE("/oidc/identities/:provider", handlers.OIDCHandler.Unlink)
+
+			// 课程相关 - 需要登录
+			auth.POST("/courses/:id/enroll", handlers.CourseHandler.EnrollCourse)
+			auth.POST("/courses/:id/chapters", handlers.CourseHandler.CreateChapter)
+			auth.PUT("/courses/:id/chapters/:chapter_id/release", handlers.CourseHandler.SetChapterRelease)
+			auth.PUT("/courses/:id/prerequisites", handlers.CourseHandler.SetCoursePrerequisites)
+			auth.POST("/courses/:id/questions", handlers.QuizHandler.CreateQuestion)
+			auth.GET("/courses/:id/questions", handlers.QuizHandler.ListQuestions)
+
+			// 测验相关 - 需要登录
+			auth.POST("/quizzes", handlers.QuizHandler.CreateQuiz)
+			auth.POST("/quizzes/:id/attempts", handlers.QuizHandler.StartAttempt)
+			auth.GET("/quizzes/:id/attempts", handlers.QuizHandler.ListAttempts)
+			auth.POST("/quizzes/attempts/:attempt_id/submit", handlers.QuizHandler.SubmitAttempt)
+
+			// 作业相关 - 需要登录
+			auth.POST("/assignments", handlers.AssignmentHandler.CreateAssignment)
+			auth.POST("/assignments/:id/submissions", handlers.AssignmentHandler.SubmitAssignment)
+			auth.GET("/assignments/:id/submissions", handlers.AssignmentHandler.ListSubmissions)
+			auth.GET("/assignments/:id/submissions/me", handlers.AssignmentHandler.GetMySubmission)
+			auth.POST("/assignments/submissions/:submission_id/grade", handlers.AssignmentHandler.GradeSubmission)
+			auth.GET("/courses/:id/grading-queue", handlers.AssignmentHandler.GetGradingQueue)
+
+			// 学习进度与证书 - 需要登录
+			auth.POST("/courses/:id/chapters/:chapter_id/complete", handlers.CourseHandler.CompleteChapter)
+			auth.GET("/courses/:id/progress", handlers.CourseHandler.GetCourseProgress)
+			auth.GET("/certificates", handlers.CertificateHandler.ListMyCertificates)
+			auth.PUT("/courses/:id/certificate-template", handlers.CertificateHandler.SaveTemplate)
+
+			// 订单相关 - 需要登录
+			auth.POST("/orders", handlers.OrderHandler.CreateOrder)
+			auth.GET("/orders", handlers.OrderHandler.ListMyOrders)
+			auth.GET("/orders/:order_no", handlers.OrderHandler.GetOrder)
+			auth.POST("/orders/:order_no/cancel", handlers.OrderHandler.CancelOrder)
+			// 模拟支付只在开发和测试环境显式开启时注册
+			if config != nil && config.Payment.SimulateEndpoint && config.Server.IsDevelopment() {
+				auth.POST("/orders/:order_no/simulate-payment", handlers.OrderHandler.SimulatePayment)
+			} else if config != nil && config.Payment.SimulateEndpoint {
+				log.Printf("⚠️ 当前环境不是开发或测试环境，已忽略 payment.simulate_endpoint")
+			}
+			auth.GET("/courses/:id/checkout", handlers.OrderHandler.PreviewCheckout)
+			auth.GET("/bundles/:id/checkout", handlers.OrderHandler.PreviewBundleCheckout)
+
+			// 套餐与学习路径 - 需要登录
+			auth.POST("/bundles", handlers.BundleHandler.CreateBundle)
+			auth.GET("/bundles/mine", handlers.BundleHandler.ListMyBundles)
+			auth.PUT("/bundles/:id", handlers.BundleHandler.UpdateBundle)
+			auth.POST("/bundles/:id/publish", handlers.BundleHandler.PublishBundle)
+			auth.POST("/bundles/:id/enroll", handlers.BundleHandler.EnrollBundle)
+			auth.GET("/bundles/:id/progress", handlers.BundleHandler.GetBundleProgress)
+
+			// 班期与直播 - 需要登录
+			auth.POST("/courses/:id/cohorts", handlers.CohortHandler.CreateCohort)
+			auth.PUT("/cohorts/:id", handlers.CohortHandler.UpdateCohort)
+			auth.POST("/cohorts/:id/enroll", handlers.CohortHandler.EnrollCohort)
+			auth.POST("/cohorts/:id/leave", handlers.CohortHandler.LeaveCohort)
+			auth.GET("/cohorts/:id/members", handlers.CohortHandler.ListMembers)
+			auth.POST("/cohorts/:id/sessions", handlers.CohortHandler.CreateSession)
+			auth.PUT("/cohorts/:id/sessions/:session_id", handlers.CohortHandler.UpdateSession)
+			auth.DELETE("/cohorts/:id/sessions/:session_id", handlers.CohortHandler.DeleteSession)
+			auth.GET("/calendar/feed", handlers.CohortHandler.GetCalendarFeed)
+			auth.POST("/calendar/feed/reset", handlers.CohortHandler.ResetCalendarFeed)
+
+			// 课程讨论区 - 需要登录并报名课程
+			auth.GET("/courses/:id/discussions", handlers.DiscussionHandler.ListThreads)
+			auth.POST("/courses/:id/discussions", handlers.DiscussionHandler.CreateThread)
+			auth.GET("/discussions/:id", handlers.DiscussionHandler.GetThread)
+			auth.DELETE("/discussions/:id", handlers.DiscussionHandler.DeleteThread)
+			auth.POST("/discussions/:id/replies", handlers.DiscussionHandler.CreateReply)
+			auth.POST("/discussions/:id/upvote", handlers.DiscussionHandler.UpvoteThread)
+			auth.DELETE("/discussions/:id/upvote", handlers.DiscussionHandler.UpvoteThread)
+			auth.POST("/discussions/:id/moderate", handlers.DiscussionHandler.ModerateThread)
+			auth.PUT("/discussions/:id/answer", handlers.DiscussionHandler.MarkAnswer)
+			auth.DELETE("/discussions/replies/:reply_id", handlers.DiscussionHandler.DeleteReply)
+			auth.POST("/discussions/replies/:reply_id/upvote", handlers.DiscussionHandler.UpvoteReply)
+			auth.DELETE("/discussions/replies/:reply_id/upvote", handlers.DiscussionHandler.UpvoteReply)
+
+			// 课程公告 - 需要登录
+			auth.POST("/courses/:id/announcements", handlers.AnnouncementHandler.CreateAnnouncement)
+			auth.POST("/announcements/:id/read", handlers.AnnouncementHandler.MarkAnnouncementRead)
+			auth.DELETE("/announcements/:id", handlers.AnnouncementHandler.DeleteAnnouncement)
+
+			// 站内通知 - 需要登录
+			auth.GET("/notifications", handlers.NotificationHandler.ListNotifications)
+			auth.POST("/notifications/read", handlers.NotificationHandler.MarkRead)
+			auth.POST("/notifications/read-all", handlers.NotificationHandler.MarkAllRead)
+			auth.GET("/notifications/preferences", handlers.NotificationHandler.GetPreferences)
+			auth.PUT("/notifications/preferences", handlers.NotificationHandler.UpdatePreferences)
+			auth.GET("/notifications/stream", handlers.NotificationHandler.Stream)
+
+			// 邮件订阅 - 需要登录
+			auth.GET("/email/preferences", handlers.EmailHandler.GetEmailPreferences)
+			auth.PUT("/email/preferences", handlers.EmailHandler.UpdateEmailPreferences)
+
+			// 退款相关 - 需要登录
+			auth.POST("/orders/:order_no/refunds", handlers.RefundHandler.RequestRefund)
+			auth.GET("/refunds", handlers.RefundHandler.ListMyRefunds)
+			auth.GET("/refunds/review", handlers.RefundHandler.ListRefundQueue)
+			auth.GET("/refunds/:id", handlers.RefundHandler.GetRefund)
+			auth.POST("/refunds/:id/approve", handlers.RefundHandler.ApproveRefund)
+			auth.POST("/refunds/:id/reject", handlers.RefundHandler.RejectRefund)
+
+			// 创作者收入统计 - 需要登录
+			auth.GET("/creator/stats", handlers.LedgerHandler.GetCreatorStats)
+			auth.GET("/creator/revenue/export", handlers.LedgerHandler.ExportCreatorLedger)
+
+			// 促销与优惠券 - 需要登录
+			auth.PUT("/courses/:id/sale", handlers.CourseHandler.SetCourseSale)
+			auth.POST("/coupons", handlers.CouponHandler.CreateCoupon)
+			auth.GET("/coupons", handlers.CouponHandler.ListCoupons)
+			auth.POST("/coupons/:code/deactivate", handlers.CouponHandler.DeactivateCoupon)
 
 			// 内容相关 - 需要登录
 			auth.POST("/content/upload", handlers.ContentHandler.UploadFile)
 			auth.DELETE("/content/files/:id", handlers.ContentHandler.DeleteFile)
+			auth.GET("/content/files/:id/download", handlers.ContentHandler.DownloadFile)
+			auth.GET("/content/files/:id/hls/key", handlers.ContentHandler.GetHLSKey)
+			auth.GET("/content/files/:id/hls/:name", handlers.ContentHandler.GetHLSFile)
+			auth.PUT("/content/files/:id", handlers.ContentHandler.ReplaceFile)
+			auth.GET("/content/files/:id/versions", handlers.ContentHandler.ListFileVersions)
+			auth.POST("/content/files/:id/versions/:version/revert", handlers.ContentHandler.RevertFileVersion)
+		}
+	}
+}
+
+// registerAPITokenScopes 登记个人访问令牌可调用的接口及所需权限，未登记的接口拒绝个人访问令牌
+func registerAPITokenScopes() {
+	scopes := map[string][][2]string{
+		model.ScopeCoursesRead: {
+			{"GET", "/api/v1/courses"},
+			{"GET", "/api/v1/courses/:id"},
+			{"GET", "/api/v1/courses/search"},
+			{"GET", "/api/v1/courses/:id/chapters"},
+		},
+		model.ScopeCoursesWrite: {
+			{"POST", "/api/v1/courses"},
+			{"PUT", "/api/v1/courses/:id"},
+			{"POST", "/api/v1/courses/:id/publish"},
+			{"POST", "/api/v1/courses/:id/chapters"},
+			{"PUT", "/api/v1/courses/:id/chapters/:chapter_id/release"},
+			{"PUT", "/api/v1/courses/:id/prerequisites"},
+		},
+		model.ScopeContentRead: {
+			{"GET", "/api/v1/content/files"},
+			{"GET", "/api/v1/content/files/:id/download"},
+			{"GET", "/api/v1/content/files/:id/versions"},
+		},
+		model.ScopeContentUpload: {
+			{"POST", "/api/v1/content/upload"},
+			{"PUT", "/api/v1/content/files/:id"},
+			{"DELETE", "/api/v1/content/files/:id"},
+			{"POST", "/api/v1/content/files/:id/versions/:version/revert"},
+		},
+	}
+	for scope, routes := range scopes {
+		for _, route := range routes {
+			middleware.RegisterScope(route[0], route[1], scope)
 		}
 	}
 }
 
 // RouteHandlers 路由处理器集合
 type RouteHandlers struct {
-	UserHandler    *userHandler.UserHandler
-	CourseHandler  *courseHandler.CourseHandler
-	ContentHandler *contentHandler.ContentHandler
+	UserHandler         *userHandler.UserHandler
+	CourseHandler       *courseHandler.CourseHandler
+	ContentHandler      *contentHandler.ContentHandler
+	QuizHandler         *quizHandler.QuizHandler
+	AssignmentHandler   *assignmentHandler.AssignmentHandler
+	CertificateHandler  *certificateHandler.CertificateHandler
+	OrderHandler        *orderHandler.OrderHandler
+	CouponHandler       *couponHandler.CouponHandler
+	RefundHandler       *refundHandler.RefundHandler
+	LedgerHandler       *ledgerHandler.LedgerHandler
+	BundleHandler       *bundleHandler.BundleHandler
+	CohortHandler       *cohortHandler.CohortHandler
+	DiscussionHandler   *discussionHandler.DiscussionHandler
+	AnnouncementHandler *announcementHandler.AnnouncementHandler
+	NotificationHandler *notificationHandler.NotificationHandler
+	EmailHandler        *emailHandler.EmailHandler
+	PrivacyHandler      *privacyHandler.PrivacyHandler
+	OIDCHandler         *userHandler.OIDCHandler
 }
 
 // setupBasicRoutes 设置基础路由
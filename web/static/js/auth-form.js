@@ -157,7 +157,7 @@ class AuthForm {
                         <span class="checkmark"></span>
                         <span class="checkbox-text">记住我</span>
                     </label>
-                    <a href="#" class="forgot-password">忘记密码？</a>
+                    <a href="/forgot-password" class="forgot-password">忘记密码？</a>
                 </div>
             `;
         }
@@ -209,15 +209,7 @@ class AuthForm {
                 this.clearFieldError(input.name);
             });
         });
-        
-        // 忘记密码点击事件
-        const forgotPasswordLink = this.container.querySelector('.forgot-password');
-        if (forgotPasswordLink) {
-            forgotPasswordLink.addEventListener('click', (e) => {
-                e.preventDefault();
-                this.showNotification('密码重置功能暂未开放', 'info');
-            });
-        }
+
     }
     
     togglePasswordVisibility() {
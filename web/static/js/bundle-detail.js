@@ -0,0 +1,179 @@
+// 套餐详情页：展示学习进度，免费套餐直接加入，付费套餐创建订单并支付
+
+const bundleDetail = document.getElementById('bundleDetail');
+const bundleId = bundleDetail ? bundleDetail.dataset.bundleId : null;
+
+const stepStatusLabels = {
+    locked: '🔒 待完成前置课程',
+    available: '可以开始',
+    in_progress: '学习中',
+    completed: '✅ 已完成'
+};
+
+document.addEventListener('DOMContentLoaded', function() {
+    if (!bundleId) {
+        return;
+    }
+    document.getElementById('bundleCtaBtn').addEventListener('click', joinBundle);
+    loadProgress();
+});
+
+// 获取登录请求头，未登录返回 null
+function getAuthHeaders() {
+    const token = localStorage.getItem('authToken') || sessionStorage.getItem('authToken');
+    if (!token) {
+        return null;
+    }
+    return { 'Authorization': `Bearer ${token}`, 'Content-Type': 'application/json' };
+}
+
+// 格式化金额（分）
+function formatCents(cents) {
+    return '¥' + ((cents || 0) / 100).toFixed(2);
+}
+
+// 加载学习进度并标记每门课程的状态
+async function loadProgress() {
+    const headers = getAuthHeaders();
+    if (!headers) {
+        return;
+    }
+
+    try {
+        const response = await fetch(`/api/v1/bundles/${bundleId}/progress`, { headers });
+        const result = await response.json();
+        if (!response.ok || result.code !== 200) {
+            return;
+        }
+
+        const progress = result.data;
+        (progress.steps || []).forEach(step => {
+            const item = document.querySelector(`.bundle-step[data-course-id="${step.course_id}"]`);
+            if (!item) {
+                return;
+            }
+            item.classList.add(step.status);
+            item.querySelector('.bundle-step-status').textContent =
+                step.status === 'in_progress' ? `学习中 ${step.percent || 0}%` : stepStatusLabels[step.status];
+        });
+
+        if (progress.enrolled) {
+            document.getElementById('bundleProgress').hidden = false;
+            document.getElementById('bundleProgressFill').style.width = `${progress.percent || 0}%`;
+            document.getElementById('bundleProgressText').textContent =
+                `已完成 ${progress.completed_courses || 0} / ${progress.total_courses} 门课程`;
+            markJoined();
+        }
+    } catch (error) {
+        console.error('获取学习进度失败:', error);
+    }
+}
+
+// 已加入套餐后禁用按钮
+function markJoined() {
+    const button = document.getElementById('bundleCtaBtn');
+    button.disabled = true;
+    button.textContent = '已加入套餐';
+}
+
+// 加入套餐
+async function joinBundle() {
+    const headers = getAuthHeaders();
+    if (!headers) {
+        showNotification('请先登录后再加入套餐', 'warning');
+        return;
+    }
+
+    try {
+        const price = Number(bundleDetail.dataset.price);
+        const joined = price > 0 ? await purchaseBundle(headers) : await enrollFreeBundle(headers);
+        if (joined) {
+            showNotification('加入成功，套餐中的课程已全部开通', 'success');
+            loadProgress();
+        }
+    } catch (error) {
+        console.error('加入套餐失败:', error);
+        showNotification('网络错误，请稍后重试', 'error');
+    }
+}
+
+// 报名免费套餐
+async function enrollFreeBundle(headers) {
+    const response = await fetch(`/api/v1/bundles/${bundleId}/enroll`, { method: 'POST', headers });
+    const result = await response.json();
+    if (!response.ok || result.code !== 200) {
+        showNotification(result.message || '报名失败', 'error');
+        return false;
+    }
+    return true;
+}
+
+// 购买付费套餐：先预览结算价格，确认后创建订单
+// 同一套餐和优惠码重复点击复用同一个幂等键，避免重复下单
+async function purchaseBundle(headers) {
+    const couponCode = (prompt('如有全站优惠码请输入（可留空）：', '') || '').trim().toUpperCase();
+
+    const previewResponse = await fetch(`/api/v1/bundles/${bundleId}/checkout?coupon=${encodeURIComponent(couponCode)}`, { headers });
+    const previewResult = await previewResponse.json();
+    if (!previewResponse.ok || previewResult.code !== 200) {
+        showNotification(previewResult.message || '获取结算价格失败', 'error');
+        return false;
+    }
+
+    const quote = previewResult.data;
+    const lines = [`套餐：${quote.course_title}`, `套餐价：${formatCents(quote.original_amount)}`];
+    if (quote.coupon_code) {
+        lines.push(`优惠券 ${quote.coupon_code}：-${formatCents(quote.discount_amount)}`);
+    }
+    lines.push(`应付金额：${formatCents(quote.final_amount)}`);
+    if (!confirm(lines.join('\n') + '\n\n确认购买？')) {
+        return false;
+    }
+
+    const keyName = 'order_key_bundle_' + bundleId + '_' + couponCode;
+    let idempotencyKey = sessionStorage.getItem(keyName);
+    if (!idempotencyKey) {
+        idempotencyKey = crypto.randomUUID ? crypto.randomUUID() : `${Date.now()}-${Math.random().toString(16).slice(2)}`;
+        sessionStorage.setItem(keyName, idempotencyKey);
+    }
+
+    const response = await fetch('/api/v1/orders', {
+        method: 'POST',
+        headers: { ...headers, 'Idempotency-Key': idempotencyKey },
+        body: JSON.stringify({ bundle_id: Number(bundleId), coupon_code: couponCode })
+    });
+    const result = await response.json();
+    if (response.status === 409 && result.message === '您已拥有该套餐') {
+        return true;
+    }
+    if (!response.ok || result.code !== 200) {
+        showNotification(result.message || '创建订单失败', 'error');
+        return false;
+    }
+
+    const order = result.data;
+    if (order.status === 'paid') {
+        sessionStorage.removeItem(keyName);
+        return true;
+    }
+    if (order.checkout_url) {
+        // 真实支付渠道：跳转到支付页面，支付结果通过回调确认
+        window.location.href = order.checkout_url;
+        return false;
+    }
+
+    // 模拟支付渠道：直接完成支付
+    const payResponse = await fetch(`/api/v1/orders/${order.order_no}/simulate-payment`, {
+        method: 'POST',
+        headers,
+        body: JSON.stringify({ succeed: true })
+    });
+    const payResult = await payResponse.json();
+    if (!payResponse.ok || payResult.code !== 200 || payResult.data.status !== 'paid') {
+        showNotification(payResult.message || '支付失败', 'error');
+        return false;
+    }
+
+    sessionStorage.removeItem(keyName);
+    return true;
+}
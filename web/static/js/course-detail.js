@@ -18,6 +18,8 @@ document.addEventListener('DOMContentLoaded', function() {
     bindEventListeners();
     updateVideoControls();
     updateMainCtaButton();
+    loadAnnouncements();
+    loadCohorts();
 });
 
 // 初始化页面
@@ -623,15 +625,285 @@ function handleMainCtaClick() {
     }
 }
 
-// 显示注册模态框
-function showEnrollmentModal() {
-    showNotification('正在跳转到课程注册页面...', 'info');
-    // 模拟注册流程
-    setTimeout(() => {
-        localStorage.setItem('enrolled_course_' + courseId, 'true');
-        updateMainCtaButton();
-        showNotification('恭喜！您已成功加入课程', 'success');
-    }, 2000);
+// 加入课程：免费课程直接报名，付费课程创建订单并支付
+async function showEnrollmentModal() {
+    const token = localStorage.getItem('authToken') || sessionStorage.getItem('authToken');
+    if (!token) {
+        showNotification('请先登录后再加入课程', 'warning');
+        return;
+    }
+    const headers = { 'Authorization': `Bearer ${token}`, 'Content-Type': 'application/json' };
+
+    try {
+        const courseResponse = await fetch(`/api/v1/courses/${courseId}`, { headers });
+        const courseResult = await courseResponse.json();
+        const price = courseResult.data ? Number(courseResult.data.price) : 0;
+
+        const joined = price > 0 ? await purchaseCourse(headers) : await enrollFreeCourse(headers);
+        if (joined) {
+            localStorage.setItem('enrolled_course_' + courseId, 'true');
+            updateMainCtaButton();
+            showNotification('恭喜！您已成功加入课程', 'success');
+        }
+    } catch (error) {
+        console.error('加入课程失败:', error);
+        showNotification('网络错误，请稍后重试', 'error');
+    }
+}
+
+// 报名免费课程
+async function enrollFreeCourse(headers) {
+    const response = await fetch(`/api/v1/courses/${courseId}/enroll`, { method: 'POST', headers });
+    const result = await response.json();
+    if (!response.ok || result.code !== 200) {
+        showNotification(result.message || '报名失败', 'error');
+        return false;
+    }
+    return true;
+}
+
+// 加载课程公告
+async function loadAnnouncements() {
+    const section = document.getElementById('announcements');
+    if (!section || !courseId) {
+        return;
+    }
+    const token = localStorage.getItem('authToken') || sessionStorage.getItem('authToken');
+    const headers = token ? { 'Authorization': `Bearer ${token}` } : {};
+
+    try {
+        const response = await fetch(`/api/v1/courses/${courseId}/announcements?page_size=5`, { headers });
+        const result = await response.json();
+        if (!response.ok || result.code !== 200 || !result.data || result.data.announcements.length === 0) {
+            return;
+        }
+        renderAnnouncements(result.data);
+        section.hidden = false;
+    } catch (error) {
+        console.error('加载公告失败:', error);
+    }
+}
+
+// 渲染公告列表，未读公告点击展开后标记已读
+function renderAnnouncements(data) {
+    const list = document.getElementById('announcementList');
+    const unreadText = data.unread_count > 0 ? `，${data.unread_count} 条未读` : '';
+    document.getElementById('announcementCount').textContent = `共 ${data.total} 条${unreadText}`;
+    list.innerHTML = '';
+
+    data.announcements.forEach(announcement => {
+        const item = document.createElement('details');
+        item.className = 'announcement-item' + (announcement.unread ? ' unread' : '');
+
+        const summary = document.createElement('summary');
+        const title = document.createElement('span');
+        title.className = 'announcement-title';
+        title.textContent = announcement.title;
+        const time = document.createElement('span');
+        time.className = 'announcement-time';
+        time.textContent = announcement.created_at;
+        summary.append(title, time);
+
+        const body = document.createElement('p');
+        body.className = 'announcement-body';
+        body.textContent = announcement.body;
+        item.append(summary, body);
+
+        if (announcement.unread) {
+            item.addEventListener('toggle', () => markAnnouncementRead(announcement.id, item), { once: true });
+        }
+        list.appendChild(item);
+    });
+}
+
+// 标记公告已读
+async function markAnnouncementRead(announcementId, item) {
+    const token = localStorage.getItem('authToken') || sessionStorage.getItem('authToken');
+    if (!token) {
+        return;
+    }
+
+    try {
+        const response = await fetch(`/api/v1/announcements/${announcementId}/read`, {
+            method: 'POST',
+            headers: { 'Authorization': `Bearer ${token}` }
+        });
+        if (response.ok) {
+            item.classList.remove('unread');
+        }
+    } catch (error) {
+        console.error('标记公告已读失败:', error);
+    }
+}
+
+// 加载课程班期
+async function loadCohorts() {
+    const section = document.getElementById('courseCohorts');
+    if (!section || !courseId) {
+        return;
+    }
+    const token = localStorage.getItem('authToken') || sessionStorage.getItem('authToken');
+    const headers = token ? { 'Authorization': `Bearer ${token}` } : {};
+
+    try {
+        const response = await fetch(`/api/v1/courses/${courseId}/cohorts`, { headers });
+        const result = await response.json();
+        if (!response.ok || result.code !== 200 || !result.data || result.data.length === 0) {
+            return;
+        }
+        renderCohorts(result.data);
+        section.hidden = false;
+    } catch (error) {
+        console.error('加载班期失败:', error);
+    }
+}
+
+// 渲染班期列表
+function renderCohorts(cohorts) {
+    const list = document.getElementById('cohortList');
+    document.getElementById('cohortCount').textContent = `${cohorts.length} 个班期`;
+    list.innerHTML = '';
+
+    cohorts.forEach(cohort => {
+        const item = document.createElement('div');
+        item.className = 'cohort-item';
+
+        const info = document.createElement('div');
+        const name = document.createElement('h4');
+        name.className = 'cohort-name';
+        name.textContent = cohort.name;
+        if (cohort.my_status === 'enrolled') {
+            name.insertAdjacentHTML('beforeend', '<span class="cohort-status">已报名</span>');
+        } else if (cohort.my_status === 'waitlisted') {
+            name.insertAdjacentHTML('beforeend', `<span class="cohort-status">候补第 ${cohort.my_waitlist_order} 位</span>`);
+        }
+        const meta = document.createElement('p');
+        meta.className = 'cohort-meta';
+        const startDate = new Date(cohort.start_date).toLocaleDateString();
+        const seats = cohort.is_full ? `名额已满，候补 ${cohort.waitlist_count} 人` : `剩余 ${cohort.seats_left} / ${cohort.capacity} 个名额`;
+        meta.textContent = `${startDate} 开班 · ${cohort.timezone} · ${seats}`;
+        info.append(name, meta);
+        item.appendChild(info);
+
+        if (!cohort.is_instructor) {
+            const button = document.createElement('button');
+            button.className = 'cohort-action';
+            if (cohort.my_status) {
+                button.textContent = cohort.my_status === 'waitlisted' ? '取消候补' : '退出班期';
+                button.addEventListener('click', () => changeCohort(cohort.id, 'leave'));
+            } else {
+                button.textContent = cohort.is_full ? '加入候补' : '报名班期';
+                button.addEventListener('click', () => changeCohort(cohort.id, 'enroll'));
+            }
+            item.appendChild(button);
+        }
+        list.appendChild(item);
+    });
+}
+
+// 报名或退出班期
+async function changeCohort(cohortId, action) {
+    const token = localStorage.getItem('authToken') || sessionStorage.getItem('authToken');
+    if (!token) {
+        showNotification('请先登录后再报名班期', 'warning');
+        return;
+    }
+
+    try {
+        const response = await fetch(`/api/v1/cohorts/${cohortId}/${action}`, {
+            method: 'POST',
+            headers: { 'Authorization': `Bearer ${token}` }
+        });
+        const result = await response.json();
+        if (!response.ok || result.code !== 200) {
+            showNotification(result.message || '操作失败', 'error');
+            return;
+        }
+        showNotification(result.message, 'success');
+        loadCohorts();
+    } catch (error) {
+        console.error('班期操作失败:', error);
+        showNotification('网络错误，请稍后重试', 'error');
+    }
+}
+
+// 格式化金额（分）
+function formatCents(cents) {
+    return '¥' + ((cents || 0) / 100).toFixed(2);
+}
+
+// 购买付费课程：先预览结算价格，确认后创建订单
+// 同一课程和优惠码重复点击复用同一个幂等键，避免重复下单
+async function purchaseCourse(headers) {
+    const couponCode = (prompt('如有优惠码请输入（可留空）：', '') || '').trim().toUpperCase();
+
+    const previewResponse = await fetch(`/api/v1/courses/${courseId}/checkout?coupon=${encodeURIComponent(couponCode)}`, { headers });
+    const previewResult = await previewResponse.json();
+    if (!previewResponse.ok || previewResult.code !== 200) {
+        showNotification(previewResult.message || '获取结算价格失败', 'error');
+        return false;
+    }
+
+    const quote = previewResult.data;
+    const lines = [`课程：${quote.course_title}`, `原价：${formatCents(quote.original_amount)}`];
+    if (quote.on_sale) {
+        lines.push(`限时促销价：${formatCents(quote.effective_amount)}`);
+    }
+    if (quote.coupon_code) {
+        lines.push(`优惠券 ${quote.coupon_code}：-${formatCents(quote.discount_amount)}`);
+    }
+    lines.push(`应付金额：${formatCents(quote.final_amount)}`);
+    if (!confirm(lines.join('\n') + '\n\n确认购买？')) {
+        return false;
+    }
+
+    const keyName = 'order_key_' + courseId + '_' + couponCode;
+    let idempotencyKey = sessionStorage.getItem(keyName);
+    if (!idempotencyKey) {
+        idempotencyKey = crypto.randomUUID ? crypto.randomUUID() : `${Date.now()}-${Math.random().toString(16).slice(2)}`;
+        sessionStorage.setItem(keyName, idempotencyKey);
+    }
+
+    const response = await fetch('/api/v1/orders', {
+        method: 'POST',
+        headers: { ...headers, 'Idempotency-Key': idempotencyKey },
+        body: JSON.stringify({ course_id: Number(courseId), coupon_code: couponCode })
+    });
+    const result = await response.json();
+    if (response.status === 409 && result.message === '您已拥有该课程') {
+        return true;
+    }
+    if (!response.ok || result.code !== 200) {
+        showNotification(result.message || '创建订单失败', 'error');
+        return false;
+    }
+
+    const order = result.data;
+    if (order.status === 'paid') {
+        // 优惠后金额为0，订单已直接完成
+        sessionStorage.removeItem(keyName);
+        return true;
+    }
+    if (order.checkout_url) {
+        // 真实支付渠道：跳转到支付页面，支付结果通过回调确认
+        window.location.href = order.checkout_url;
+        return false;
+    }
+
+    // 模拟支付渠道：直接完成支付
+    const payResponse = await fetch(`/api/v1/orders/${order.order_no}/simulate-payment`, {
+        method: 'POST',
+        headers,
+        body: JSON.stringify({ succeed: true })
+    });
+    const payResult = await payResponse.json();
+    if (!payResponse.ok || payResult.code !== 200 || payResult.data.status !== 'paid') {
+        showNotification(payResult.message || '支付失败', 'error');
+        return false;
+    }
+
+    sessionStorage.removeItem(keyName);
+    return true;
 }
 
 // 课程类型检测和UI更新
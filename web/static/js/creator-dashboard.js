@@ -115,6 +115,11 @@ class CreatorDashboard {
             resetBtn.addEventListener('click', this.resetForm.bind(this));
         }
 
+        const exportBtn = document.getElementById('exportRevenueBtn');
+        if (exportBtn) {
+            exportBtn.addEventListener('click', this.exportRevenue.bind(this));
+        }
+
         const backBtn = document.getElementById('backToFormBtn');
         if (backBtn) {
             backBtn.addEventListener('click', this.backToForm.bind(this));
@@ -400,7 +405,7 @@ class CreatorDashboard {
         const formData = new FormData();
         formData.append('file', file);
         formData.append('course_id', courseId);
-        formData.append('file_type', 'image'); // 封面图片使用image类型
+        formData.append('file_type', 'cover'); // 封面使用cover类型，存放在可公开访问的目录
 
         const token = localStorage.getItem('authToken');
         const response = await fetch('/api/v1/content/upload', {
@@ -746,7 +751,7 @@ class CreatorDashboard {
     }
 
     // 下载文件
-    downloadFile(fileId) {
+    async downloadFile(fileId) {
         const token = localStorage.getItem('authToken');
         
         if (!token) {
@@ -755,15 +760,40 @@ class CreatorDashboard {
             return;
         }
 
-        // 正常模式：实际下载
-        const downloadUrl = '/api/v1/content/files/' + fileId + '/download?token=' + encodeURIComponent(token);
-        
-        const link = document.createElement('a');
-        link.href = downloadUrl;
-        link.style.display = 'none';
-        document.body.appendChild(link);
-        link.click();
-        document.body.removeChild(link);
+        // 正常模式：下载接口需要认证头，先取回文件再保存
+        try {
+            const response = await fetch('/api/v1/content/files/' + fileId + '/download', {
+                headers: { 'Authorization': 'Bearer ' + token }
+            });
+            if (!response.ok) {
+                const result = await response.json().catch(() => ({}));
+                throw new Error(result.message || '下载失败');
+            }
+
+            const blob = await response.blob();
+            const url = URL.createObjectURL(blob);
+            const link = document.createElement('a');
+            link.href = url;
+            link.download = this.attachmentFileName(response) || ('file-' + fileId);
+            document.body.appendChild(link);
+            link.click();
+            link.remove();
+            URL.revokeObjectURL(url);
+        } catch (error) {
+            console.error('下载文件错误:', error);
+            this.showNotification('下载失败：' + error.message, 'error');
+        }
+    }
+
+    // 从响应头中解析附件文件名
+    attachmentFileName(response) {
+        const disposition = response.headers.get('Content-Disposition') || '';
+        const encoded = disposition.match(/filename\*=UTF-8''([^;]+)/i);
+        if (encoded) {
+            return decodeURIComponent(encoded[1]);
+        }
+        const plain = disposition.match(/filename="?([^";]+)"?/i);
+        return plain ? plain[1] : '';
     }
 
     // 预览课程
@@ -845,15 +875,17 @@ class CreatorDashboard {
             const token = localStorage.getItem('authToken');
             
             if (!token) {
-                // 演示模式：使用演示数据
+                // 演示模式：使用演示数据（金额单位为分）
                 const demoStats = {
                     total_courses: 8,
                     total_students: 1250,
-                    total_revenue: 8450.50,
+                    total_revenue: 845050,
                     active_courses: 6,
                     draft_courses: 2,
                     this_month_students: 180,
-                    this_month_revenue: 1250.00
+                    this_month_revenue: 125000,
+                    platform_share_percent: 30,
+                    monthly: []
                 };
                 this.updateStatsDisplay(demoStats);
                 return;
@@ -869,8 +901,8 @@ class CreatorDashboard {
             });
 
             if (response.ok) {
-                const stats = await response.json();
-                this.updateStatsDisplay(stats);
+                const result = await response.json();
+                this.updateStatsDisplay(result.data || {});
             } else {
                 throw new Error('获取统计数据失败');
             }
@@ -892,7 +924,91 @@ class CreatorDashboard {
 
         if (totalCourses) totalCourses.textContent = stats.total_courses || 0;
         if (totalStudents) totalStudents.textContent = stats.total_students || 0;
-        if (totalRevenue) totalRevenue.textContent = '¥' + (stats.total_revenue || 0).toFixed(2);
+        if (totalRevenue) totalRevenue.textContent = this.formatCents(stats.total_revenue);
+
+        const fields = {
+            thisMonthRevenue: this.formatCents(stats.this_month_revenue),
+            thisMonthStudents: stats.this_month_students || 0,
+            activeCourses: stats.active_courses || 0,
+            draftCourses: stats.draft_courses || 0
+        };
+        Object.entries(fields).forEach(([id, value]) => {
+            const el = document.getElementById(id);
+            if (el) el.textContent = value;
+        });
+
+        const shareHint = document.getElementById('revenueShareHint');
+        if (shareHint && stats.platform_share_percent !== undefined) {
+            shareHint.textContent = `收入已扣除平台分成（${stats.platform_share_percent}%）和退款`;
+        }
+
+        this.renderMonthlyRevenue(stats.monthly || []);
+    }
+
+    // 渲染按月收入，最近的月份排在最前
+    renderMonthlyRevenue(monthly) {
+        const tbody = document.getElementById('monthlyRevenueBody');
+        if (!tbody) return;
+
+        const rows = monthly.filter(m => m.sales || m.refunds).reverse();
+        if (rows.length === 0) {
+            tbody.innerHTML = '<tr><td colspan="6" class="revenue-empty">暂无收入数据</td></tr>';
+            return;
+        }
+
+        tbody.innerHTML = rows.map(m => `
+            <tr>
+                <td>${m.month}</td>
+                <td>${m.sales}</td>
+                <td>${this.formatCents(m.gross_amount)}</td>
+                <td>${this.formatCents(m.platform_fee)}</td>
+                <td>${this.formatCents(m.refund_amount)}</td>
+                <td>${this.formatCents(m.net_amount)}</td>
+            </tr>
+        `).join('');
+    }
+
+    // 导出收入明细CSV
+    async exportRevenue() {
+        const token = localStorage.getItem('authToken');
+        if (!token) {
+            this.showNotification('请先登录后再导出收入明细', 'warning');
+            return;
+        }
+
+        const params = new URLSearchParams();
+        const from = document.getElementById('revenueFrom')?.value;
+        const to = document.getElementById('revenueTo')?.value;
+        if (from) params.set('from', from);
+        if (to) params.set('to', to);
+
+        try {
+            const response = await fetch('/api/v1/creator/revenue/export?' + params.toString(), {
+                headers: { 'Authorization': 'Bearer ' + token }
+            });
+            if (!response.ok) {
+                const result = await response.json().catch(() => ({}));
+                throw new Error(result.message || '导出失败');
+            }
+
+            const blob = await response.blob();
+            const url = URL.createObjectURL(blob);
+            const link = document.createElement('a');
+            link.href = url;
+            link.download = `revenue${from ? '-' + from : ''}${to ? '-' + to : ''}.csv`;
+            document.body.appendChild(link);
+            link.click();
+            link.remove();
+            URL.revokeObjectURL(url);
+        } catch (error) {
+            console.error('导出收入明细错误:', error);
+            this.showNotification('导出失败：' + error.message, 'error');
+        }
+    }
+
+    // 将分格式化为金额
+    formatCents(cents) {
+        return '¥' + ((cents || 0) / 100).toFixed(2);
     }
 
     // 显示通知
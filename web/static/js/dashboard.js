@@ -3,6 +3,8 @@ class UserDashboard {
     constructor() {
         this.currentUser = null;
         this.currentSection = 'learning-progress';
+        this.unreadCount = 0;
+        this.notificationStream = null;
         this.init();
     }
 
@@ -12,6 +14,9 @@ class UserDashboard {
         this.bindEvents();
         this.initializeAnimations();
         this.loadDefaultSection();
+        this.handleOIDCLinkResult();
+        this.loadUnreadCount();
+        this.connectNotificationStream();
     }
 
     // ===== 用户认证检查 =====
@@ -109,6 +114,12 @@ class UserDashboard {
             userName.textContent = this.currentUser.nickname || this.currentUser.username || '用户';
         }
 
+        // 邮箱未验证时显示提醒
+        const verifyBanner = document.getElementById('verifyBanner');
+        if (verifyBanner) {
+            verifyBanner.hidden = this.currentUser.email_verified !== false;
+        }
+
         // 更新个人资料表单
         this.loadProfileData();
         
@@ -134,6 +145,15 @@ class UserDashboard {
 
         // 安全设置事件
         this.bindSecurityEvents();
+
+        // 站内通知事件
+        this.bindNotificationEvents();
+
+        // 重新发送验证邮件
+        const resendVerificationBtn = document.getElementById('resendVerificationBtn');
+        if (resendVerificationBtn) {
+            resendVerificationBtn.addEventListener('click', () => this.resendVerification(resendVerificationBtn));
+        }
     }
 
     bindSidebarNavEvents() {
@@ -485,7 +505,11 @@ class UserDashboard {
         
         if (enable2FABtn) {
             enable2FABtn.addEventListener('click', () => {
-                this.showNotification('两步验证功能开发中...', 'info');
+                if (this.mfaStatus && this.mfaStatus.enabled) {
+                    this.showMFADisableForm();
+                } else {
+                    this.startMFASetup();
+                }
             });
         }
     }
@@ -551,6 +575,16 @@ class UserDashboard {
                 title: '订单管理',
                 subtitle: '查看你的购买历史和订单状态'
             },
+            'notifications': {
+                breadcrumb: '消息通知',
+                title: '消息通知',
+                subtitle: '课程公告、作业批改和讨论回复都会在这里提醒你'
+            },
+            'privacy': {
+                breadcrumb: '数据与隐私',
+                title: '数据与隐私',
+                subtitle: '导出你的个人数据，或申请注销账号'
+            },
             'settings': {
                 breadcrumb: '系统设置',
                 title: '系统设置',
@@ -591,6 +625,23 @@ class UserDashboard {
             case 'profile':
                 this.loadProfileData();
                 break;
+            case 'notifications':
+                this.loadNotifications();
+                break;
+            case 'security':
+                this.loadMFAStatus();
+                this.loadMFAPolicies();
+                this.loadOIDCIdentities();
+                this.loadSessions();
+                this.loadAPITokens();
+                break;
+            case 'privacy':
+                this.loadPrivacy();
+                break;
+            case 'settings':
+                this.loadNotificationPreferences();
+                this.loadEmailPreferences();
+                break;
             default:
                 console.log(`📄 加载 ${sectionName} 数据...`);
         }
@@ -995,11 +1046,1146 @@ class UserDashboard {
         }
     }
 
+    // ===== 站内通知 =====
+    bindNotificationEvents() {
+        const unreadOnly = document.getElementById('inboxUnreadOnly');
+        const markAllReadBtn = document.getElementById('markAllReadBtn');
+
+        if (unreadOnly) {
+            unreadOnly.addEventListener('change', () => this.loadNotifications());
+        }
+        if (markAllReadBtn) {
+            markAllReadBtn.addEventListener('click', () => this.markNotificationsRead(null));
+        }
+    }
+
+    async loadUnreadCount() {
+        const token = this.getAuthToken();
+        if (!token) return;
+
+        try {
+            const response = await fetch('/api/v1/notifications?page_size=1', {
+                headers: { 'Authorization': `Bearer ${token}` }
+            });
+            const result = await response.json();
+            if (response.ok && result.code === 200) {
+                this.updateUnreadBadge(result.data.unread_count);
+            }
+        } catch (error) {
+            console.error('获取未读通知数失败:', error);
+        }
+    }
+
+    async loadNotifications() {
+        const list = document.getElementById('inboxList');
+        const unreadOnly = document.getElementById('inboxUnreadOnly');
+        if (!list) return;
+
+        try {
+            const query = unreadOnly && unreadOnly.checked ? '?unread_only=true' : '';
+            const response = await fetch(`/api/v1/notifications${query}`, {
+                headers: { 'Authorization': `Bearer ${this.getAuthToken()}` }
+            });
+            const result = await response.json();
+            if (!response.ok || result.code !== 200) {
+                throw new Error(result.message || '获取通知失败');
+            }
+            this.updateUnreadBadge(result.data.unread_count);
+            this.renderNotifications(result.data.notifications);
+        } catch (error) {
+            console.error('获取通知失败:', error);
+            this.showNotification(error.message || '获取通知失败', 'error');
+        }
+    }
+
+    renderNotifications(notifications) {
+        const list = document.getElementById('inboxList');
+        list.innerHTML = '';
+
+        if (notifications.length === 0) {
+            list.innerHTML = `
+                <div class="empty-state">
+                    <div class="empty-illustration">
+                        <i class="far fa-bell-slash"></i>
+                    </div>
+                    <h3>暂无通知</h3>
+                    <p>有新的课程公告、作业批改结果或讨论回复时会在这里提醒你</p>
+                </div>
+            `;
+            return;
+        }
+
+        notifications.forEach(notification => {
+            const item = document.createElement('a');
+            item.className = 'inbox-item' + (notification.read ? '' : ' unread');
+            item.href = notification.link || '#';
+
+            const title = document.createElement('h4');
+            title.className = 'inbox-title';
+            title.textContent = notification.title;
+            const body = document.createElement('p');
+            body.className = 'inbox-body';
+            body.textContent = notification.body;
+            const time = document.createElement('span');
+            time.className = 'inbox-time';
+            time.textContent = notification.created_at;
+            item.append(title, body, time);
+
+            item.addEventListener('click', (e) => {
+                if (!notification.link) {
+                    e.preventDefault();
+                }
+                if (!notification.read) {
+                    this.markNotificationsRead([notification.id]);
+                }
+            });
+            list.appendChild(item);
+        });
+    }
+
+    // ids 为空时全部标记已读
+    async markNotificationsRead(ids) {
+        const url = ids ? '/api/v1/notifications/read' : '/api/v1/notifications/read-all';
+        try {
+            const response = await fetch(url, {
+                method: 'POST',
+                headers: {
+                    'Content-Type': 'application/json',
+                    'Authorization': `Bearer ${this.getAuthToken()}`
+                },
+                body: ids ? JSON.stringify({ ids }) : null
+            });
+            const result = await response.json();
+            if (!response.ok || result.code !== 200) {
+                throw new Error(result.message || '操作失败');
+            }
+            this.updateUnreadBadge(result.data.unread_count);
+            if (this.currentSection === 'notifications') {
+                this.loadNotifications();
+            }
+        } catch (error) {
+            console.error('标记通知已读失败:', error);
+            this.showNotification(error.message || '操作失败，请重试', 'error');
+        }
+    }
+
+    updateUnreadBadge(count) {
+        this.unreadCount = Math.max(0, count);
+        const badge = document.getElementById('unreadBadge');
+        if (!badge) return;
+        badge.textContent = this.unreadCount > 99 ? '99+' : this.unreadCount;
+        badge.hidden = this.unreadCount === 0;
+    }
+
+    // 通过 SSE 接收实时通知；用 fetch 读取事件流以便携带 Authorization 头
+    async connectNotificationStream() {
+        const token = this.getAuthToken();
+        if (!token) return;
+
+        this.notificationStream = new AbortController();
+        try {
+            const response = await fetch('/api/v1/notifications/stream', {
+                headers: { 'Authorization': `Bearer ${token}` },
+                signal: this.notificationStream.signal
+            });
+            if (!response.ok || !response.body) {
+                // 服务端未开启实时推送或登录失效时不再重连
+                console.log('⚠️ 实时通知不可用，状态码:', response.status);
+                return;
+            }
+
+            const reader = response.body.getReader();
+            const decoder = new TextDecoder();
+            let buffer = '';
+            while (true) {
+                const { value, done } = await reader.read();
+                if (done) break;
+                buffer += decoder.decode(value, { stream: true });
+                const blocks = buffer.split('\n\n');
+                buffer = blocks.pop();
+                blocks.forEach(block => this.handleStreamBlock(block));
+            }
+        } catch (error) {
+            if (error.name === 'AbortError') return;
+            console.error('实时通知连接中断:', error);
+        }
+
+        // 连接断开后稍后重连
+        setTimeout(() => this.connectNotificationStream(), 5000);
+    }
+
+    handleStreamBlock(block) {
+        let eventName = 'message';
+        const dataLines = [];
+        block.split('\n').forEach(line => {
+            if (line.startsWith('event:')) {
+                eventName = line.slice(6).trim();
+            } else if (line.startsWith('data:')) {
+                dataLines.push(line.slice(5));
+            }
+        });
+        if (eventName !== 'notification' || dataLines.length === 0) return;
+
+        const event = JSON.parse(dataLines.join('\n'));
+        if (event.event === 'created') {
+            this.updateUnreadBadge(this.unreadCount + 1);
+            this.showNotification(`新通知：${event.notification.title}`, 'info');
+        } else if (event.event === 'read') {
+            this.updateUnreadBadge(event.unread_count);
+        }
+        if (this.currentSection === 'notifications') {
+            this.loadNotifications();
+        }
+    }
+
+    async loadNotificationPreferences() {
+        const container = document.getElementById('notificationPreferences');
+        if (!container) return;
+
+        try {
+            const response = await fetch('/api/v1/notifications/preferences', {
+                headers: { 'Authorization': `Bearer ${this.getAuthToken()}` }
+            });
+            const result = await response.json();
+            if (!response.ok || result.code !== 200) {
+                throw new Error(result.message || '获取通知设置失败');
+            }
+
+            container.innerHTML = '';
+            result.data.forEach(preference => {
+                const item = document.createElement('div');
+                item.className = 'setting-item';
+                item.innerHTML = `
+                    <div class="setting-info">
+                        <label></label>
+                        <p>关闭后将不再收到此类站内通知</p>
+                    </div>
+                    <div class="setting-control">
+                        <label class="toggle-switch">
+                            <input type="checkbox">
+                            <span class="toggle-slider"></span>
+                        </label>
+                    </div>
+                `;
+                item.querySelector('.setting-info label').textContent = preference.label;
+                const checkbox = item.querySelector('input');
+                checkbox.checked = preference.enabled;
+                checkbox.addEventListener('change', () => this.saveNotificationPreference(preference.type, checkbox));
+                container.appendChild(item);
+            });
+        } catch (error) {
+            console.error('获取通知设置失败:', error);
+            this.showNotification(error.message || '获取通知设置失败', 'error');
+        }
+    }
+
+    async saveNotificationPreference(type, checkbox) {
+        try {
+            const response = await fetch('/api/v1/notifications/preferences', {
+                method: 'PUT',
+                headers: {
+                    'Content-Type': 'application/json',
+                    'Authorization': `Bearer ${this.getAuthToken()}`
+                },
+                body: JSON.stringify({ preferences: { [type]: checkbox.checked } })
+            });
+            const result = await response.json();
+            if (!response.ok || result.code !== 200) {
+                throw new Error(result.message || '保存失败');
+            }
+            this.showNotification(result.message, 'success');
+        } catch (error) {
+            checkbox.checked = !checkbox.checked;
+            console.error('保存通知设置失败:', error);
+            this.showNotification(error.message || '保存失败，请重试', 'error');
+        }
+    }
+
+    async loadEmailPreferences() {
+        const container = document.getElementById('emailPreferences');
+        if (!container) return;
+
+        try {
+            const response = await fetch('/api/v1/email/preferences', {
+                headers: { 'Authorization': `Bearer ${this.getAuthToken()}` }
+            });
+            const result = await response.json();
+            if (!response.ok || result.code !== 200) {
+                throw new Error(result.message || '获取邮件订阅失败');
+            }
+
+            container.innerHTML = '';
+            result.data.forEach(preference => {
+                const item = document.createElement('div');
+                item.className = 'setting-item';
+                item.innerHTML = `
+                    <div class="setting-info">
+                        <label></label>
+                        <p></p>
+                    </div>
+                    <div class="setting-control">
+                        <label class="toggle-switch">
+                            <input type="checkbox">
+                            <span class="toggle-slider"></span>
+                        </label>
+                    </div>
+                `;
+                item.querySelector('.setting-info label').textContent = preference.label;
+                item.querySelector('.setting-info p').textContent = preference.required
+                    ? '账户与安全相关邮件始终发送'
+                    : '关闭后将不再收到此类邮件';
+                const checkbox = item.querySelector('input');
+                checkbox.checked = preference.subscribed;
+                checkbox.disabled = preference.required;
+                checkbox.addEventListener('change', () => this.saveEmailPreference(preference.category, checkbox));
+                container.appendChild(item);
+            });
+        } catch (error) {
+            console.error('获取邮件订阅失败:', error);
+            this.showNotification(error.message || '获取邮件订阅失败', 'error');
+        }
+    }
+
+    async saveEmailPreference(category, checkbox) {
+        try {
+            const response = await fetch('/api/v1/email/preferences', {
+                method: 'PUT',
+                headers: {
+                    'Content-Type': 'application/json',
+                    'Authorization': `Bearer ${this.getAuthToken()}`
+                },
+                body: JSON.stringify({ preferences: { [category]: checkbox.checked } })
+            });
+            const result = await response.json();
+            if (!response.ok || result.code !== 200) {
+                throw new Error(result.message || '保存失败');
+            }
+            this.showNotification(result.message, 'success');
+        } catch (error) {
+            checkbox.checked = !checkbox.checked;
+            console.error('保存邮件订阅失败:', error);
+            this.showNotification(error.message || '保存失败，请重试', 'error');
+        }
+    }
+
+    // ===== 两步验证 =====
+    async mfaRequest(url, method = 'GET', body = null) {
+        const options = {
+            method,
+            headers: { 'Authorization': `Bearer ${this.getAuthToken()}` }
+        };
+        if (body) {
+            options.headers['Content-Type'] = 'application/json';
+            options.body = JSON.stringify(body);
+        }
+        const response = await fetch(url, options);
+        const result = await response.json();
+        if (!response.ok) {
+            throw new Error(result.error || '操作失败');
+        }
+        return result;
+    }
+
+    async loadMFAStatus() {
+        const button = document.getElementById('enable2FABtn');
+        const statusText = document.getElementById('mfaStatusText');
+        if (!button || !statusText) return;
+
+        try {
+            this.mfaStatus = await this.mfaRequest('/api/v1/mfa');
+            if (this.mfaStatus.enabled) {
+                statusText.textContent = `已开启，剩余 ${this.mfaStatus.recovery_codes_left} 个恢复码`;
+                button.innerHTML = '<i class="fas fa-toggle-on"></i> 关闭验证';
+            } else {
+                statusText.textContent = this.mfaStatus.required
+                    ? '您的账户类型要求开启两步验证，下次登录时需要先绑定身份验证器'
+                    : '开启两步验证，为账户添加额外保护';
+                button.innerHTML = '<i class="fas fa-toggle-off"></i> 开启验证';
+            }
+            this.renderMFAPanel();
+        } catch (error) {
+            console.error('获取两步验证状态失败:', error);
+            this.showNotification(error.message || '获取两步验证状态失败', 'error');
+        }
+    }
+
+    renderMFAPanel() {
+        const panel = document.getElementById('mfaPanel');
+        if (!panel) return;
+
+        if (!this.mfaStatus || !this.mfaStatus.enabled) {
+            panel.hidden = true;
+            panel.innerHTML = '';
+            return;
+        }
+
+        panel.hidden = false;
+        panel.innerHTML = `
+            <form class="mfa-form" id="recoveryCodesForm">
+                <p>恢复码用完或遗失时可以重新生成，旧的恢复码将全部失效</p>
+                <div class="form-group">
+                    <input type="text" name="code" placeholder="输入身份验证器中的6位验证码" inputmode="numeric" autocomplete="one-time-code" required>
+                </div>
+                <div class="form-actions">
+                    <button type="submit" class="btn btn-outline">
+                        <i class="fas fa-redo"></i>
+                        重新生成恢复码
+                    </button>
+                </div>
+            </form>
+        `;
+        const form = document.getElementById('recoveryCodesForm');
+        form.addEventListener('submit', async (e) => {
+            e.preventDefault();
+            try {
+                const result = await this.mfaRequest('/api/v1/mfa/recovery-codes', 'POST', { code: form.code.value.trim() });
+                this.showRecoveryCodes(result.recovery_codes);
+                this.showNotification(result.message, 'success');
+            } catch (error) {
+                this.showNotification(error.message, 'error');
+            }
+        });
+    }
+
+    async startMFASetup() {
+        const panel = document.getElementById('mfaPanel');
+        if (!panel) return;
+
+        try {
+            const result = await this.mfaRequest('/api/v1/mfa/totp/setup', 'POST');
+            panel.hidden = false;
+            panel.innerHTML = `
+                <div class="mfa-setup">
+                    <p>1. 使用 Google Authenticator、Microsoft Authenticator 等应用扫描二维码</p>
+                    <div class="mfa-qrcode" id="mfaQRCode"></div>
+                    <p>无法扫码时手动输入密钥：<code class="mfa-secret"></code></p>
+                    <form class="mfa-form" id="mfaSetupForm">
+                        <p>2. 输入应用中显示的6位验证码完成绑定</p>
+                        <div class="form-group">
+                            <input type="text" name="code" placeholder="6位验证码" inputmode="numeric" autocomplete="one-time-code" required>
+                        </div>
+                        <div class="form-actions">
+                            <button type="button" class="btn btn-outline" id="cancelMFASetup">取消</button>
+                            <button type="submit" class="btn btn-primary">
+                                <i class="fas fa-shield-alt"></i>
+                                开启验证
+                            </button>
+                        </div>
+                    </form>
+                </div>
+            `;
+            panel.querySelector('.mfa-secret').textContent = result.secret;
+            this.renderQRCode(document.getElementById('mfaQRCode'), result.provisioning_uri);
+
+            document.getElementById('cancelMFASetup').addEventListener('click', () => this.renderMFAPanel());
+            const form = document.getElementById('mfaSetupForm');
+            form.addEventListener('submit', async (e) => {
+                e.preventDefault();
+                try {
+                    const enabled = await this.mfaRequest('/api/v1/mfa/totp/enable', 'POST', { code: form.code.value.trim() });
+                    this.showNotification(enabled.message, 'success');
+                    await this.loadMFAStatus();
+                    this.showRecoveryCodes(enabled.recovery_codes);
+                } catch (error) {
+                    this.showNotification(error.message, 'error');
+                }
+            });
+        } catch (error) {
+            console.error('获取绑定二维码失败:', error);
+            this.showNotification(error.message || '获取绑定二维码失败', 'error');
+        }
+    }
+
+    showMFADisableForm() {
+        const panel = document.getElementById('mfaPanel');
+        if (!panel) return;
+
+        panel.hidden = false;
+        panel.innerHTML = `
+            <form class="mfa-form" id="mfaDisableForm">
+                <p>关闭两步验证需要验证密码和当前验证码（也可使用恢复码）</p>
+                <div class="form-group">
+                    <input type="password" name="password" placeholder="当前密码" autocomplete="current-password" required>
+                </div>
+                <div class="form-group">
+                    <input type="text" name="code" placeholder="验证码或恢复码" autocomplete="one-time-code" required>
+                </div>
+                <div class="form-actions">
+                    <button type="button" class="btn btn-outline" id="cancelMFADisable">取消</button>
+                    <button type="submit" class="btn btn-primary">关闭验证</button>
+                </div>
+            </form>
+        `;
+        document.getElementById('cancelMFADisable').addEventListener('click', () => this.renderMFAPanel());
+        const form = document.getElementById('mfaDisableForm');
+        form.addEventListener('submit', async (e) => {
+            e.preventDefault();
+            try {
+                const result = await this.mfaRequest('/api/v1/mfa/totp/disable', 'POST', {
+                    password: form.password.value,
+                    code: form.code.value.trim()
+                });
+                this.showNotification(result.message, 'success');
+                this.loadMFAStatus();
+            } catch (error) {
+                this.showNotification(error.message, 'error');
+            }
+        });
+    }
+
+    showRecoveryCodes(codes) {
+        const panel = document.getElementById('mfaPanel');
+        if (!panel || !codes) return;
+
+        panel.hidden = false;
+        panel.innerHTML = `
+            <div class="mfa-setup">
+                <p>手机丢失时可用恢复码登录，每个只能使用一次。恢复码只显示这一次，请抄写后妥善保存</p>
+                <ul class="recovery-codes">
+                    ${codes.map(code => `<li><code>${code}</code></li>`).join('')}
+                </ul>
+                <div class="form-actions">
+                    <button type="button" class="btn btn-primary" id="recoveryCodesSaved">我已保存</button>
+                </div>
+            </div>
+        `;
+        document.getElementById('recoveryCodesSaved').addEventListener('click', () => this.renderMFAPanel());
+    }
+
+    renderQRCode(element, uri) {
+        element.innerHTML = '';
+        if (typeof QRCode === 'undefined') {
+            // 二维码脚本加载失败时只显示手动输入的密钥
+            element.style.display = 'none';
+            return;
+        }
+        new QRCode(element, { text: uri, width: 180, height: 180 });
+    }
+
+    async loadMFAPolicies() {
+        const section = document.getElementById('mfaPolicySection');
+        const container = document.getElementById('mfaPolicies');
+        if (!section || !container) return;
+
+        const roleLabels = { user: '普通用户', instructor: '讲师', admin: '管理员' };
+        try {
+            // 不是管理员时接口返回403，保持策略区域隐藏
+            const result = await this.mfaRequest('/api/v1/admin/mfa/policies');
+            section.hidden = false;
+            container.innerHTML = '';
+            result.policies.forEach(policy => {
+                const item = document.createElement('div');
+                item.className = 'setting-item';
+                item.innerHTML = `
+                    <div class="setting-info">
+                        <label></label>
+                        <p>开启后该角色未绑定身份验证器的用户下次登录时必须先绑定</p>
+                    </div>
+                    <div class="setting-control">
+                        <label class="toggle-switch">
+                            <input type="checkbox">
+                            <span class="toggle-slider"></span>
+                        </label>
+                    </div>
+                `;
+                item.querySelector('.setting-info label').textContent = `${roleLabels[policy.role] || policy.role}强制两步验证`;
+                const checkbox = item.querySelector('input');
+                checkbox.checked = policy.required;
+                checkbox.addEventListener('change', () => this.saveMFAPolicy(policy.role, checkbox));
+                container.appendChild(item);
+            });
+        } catch (error) {
+            section.hidden = true;
+        }
+    }
+
+    async saveMFAPolicy(role, checkbox) {
+        try {
+            const result = await this.mfaRequest(`/api/v1/admin/mfa/policies/${role}`, 'PUT', { required: checkbox.checked });
+            this.showNotification(result.message, 'success');
+        } catch (error) {
+            checkbox.checked = !checkbox.checked;
+            console.error('保存两步验证策略失败:', error);
+            this.showNotification(error.message || '保存失败，请重试', 'error');
+        }
+    }
+
+    // ===== 登录设备 =====
+    async loadSessions() {
+        try {
+            const result = await this.mfaRequest('/api/v1/me/sessions');
+            this.renderSessions(result.sessions);
+        } catch (error) {
+            console.error('获取登录设备失败:', error);
+            this.showNotification(error.message || '获取登录设备失败', 'error');
+        }
+    }
+
+    renderSessions(sessions) {
+        const container = document.getElementById('sessionList');
+        const revokeOthersBtn = document.getElementById('revokeOtherSessionsBtn');
+        if (!container) return;
+
+        container.innerHTML = '';
+        sessions.forEach(session => {
+            const item = document.createElement('div');
+            item.className = 'setting-item session-item';
+            item.innerHTML = `
+                <div class="setting-info">
+                    <label></label>
+                    <p></p>
+                </div>
+                <div class="setting-control"></div>
+            `;
+            const label = item.querySelector('.setting-info label');
+            label.textContent = session.device;
+            if (session.current) {
+                const badge = document.createElement('span');
+                badge.className = 'session-current';
+                badge.textContent = '当前设备';
+                label.appendChild(badge);
+            }
+            item.querySelector('.setting-info p').textContent =
+                `${session.ip || '未知IP'} · 最近活动 ${session.last_seen_at} · 登录于 ${session.created_at}`;
+            item.querySelector('.setting-info p').title = session.user_agent;
+
+            const button = document.createElement('button');
+            button.className = 'btn btn-outline';
+            button.textContent = session.current ? '退出登录' : '注销';
+            button.addEventListener('click', () => this.revokeSession(session, button));
+            item.querySelector('.setting-control').appendChild(button);
+            container.appendChild(item);
+        });
+
+        if (revokeOthersBtn) {
+            revokeOthersBtn.hidden = !sessions.some(session => !session.current);
+            revokeOthersBtn.onclick = () => this.revokeOtherSessions(revokeOthersBtn);
+        }
+    }
+
+    async revokeSession(session, button) {
+        if (!confirm(session.current ? '确定退出当前设备的登录吗？' : `确定注销 ${session.device} 上的登录吗？`)) return;
+
+        button.disabled = true;
+        try {
+            const result = await this.mfaRequest(`/api/v1/me/sessions/${session.id}`, 'DELETE');
+            if (session.current) {
+                this.logout();
+                return;
+            }
+            this.showNotification(result.message, 'success');
+            this.renderSessions(result.sessions);
+        } catch (error) {
+            button.disabled = false;
+            this.showNotification(error.message || '注销失败，请重试', 'error');
+        }
+    }
+
+    async revokeOtherSessions(button) {
+        if (!confirm('确定退出除当前设备外的全部登录吗？')) return;
+
+        button.disabled = true;
+        try {
+            const result = await this.mfaRequest('/api/v1/me/sessions/revoke-others', 'POST');
+            this.showNotification(result.message, 'success');
+            this.renderSessions(result.sessions);
+        } catch (error) {
+            this.showNotification(error.message || '操作失败，请重试', 'error');
+        } finally {
+            button.disabled = false;
+        }
+    }
+
+    // ===== 个人访问令牌 =====
+    async loadAPITokens() {
+        try {
+            const result = await this.mfaRequest('/api/v1/me/tokens');
+            this.renderAPITokenScopes(result.scopes);
+            this.renderAPITokens(result.tokens);
+        } catch (error) {
+            console.error('获取个人访问令牌失败:', error);
+            this.showNotification(error.message || '获取个人访问令牌失败', 'error');
+        }
+
+        const form = document.getElementById('apiTokenForm');
+        if (form && !form.dataset.bound) {
+            form.dataset.bound = 'true';
+            form.addEventListener('submit', (e) => {
+                e.preventDefault();
+                this.createAPIToken(form);
+            });
+        }
+    }
+
+    renderAPITokenScopes(scopes) {
+        const container = document.getElementById('apiTokenScopes');
+        if (!container || container.childElementCount) return;
+
+        scopes.forEach(scope => {
+            const label = document.createElement('label');
+            label.className = 'api-token-scope';
+            label.innerHTML = '<input type="checkbox" name="scopes"><code></code><span></span>';
+            label.querySelector('input').value = scope.name;
+            label.querySelector('code').textContent = scope.name;
+            label.querySelector('span').textContent = scope.description;
+            container.appendChild(label);
+        });
+    }
+
+    renderAPITokens(tokens) {
+        const container = document.getElementById('apiTokenList');
+        if (!container) return;
+
+        container.innerHTML = '';
+        tokens.forEach(token => {
+            const item = document.createElement('div');
+            item.className = 'setting-item';
+            item.innerHTML = `
+                <div class="setting-info">
+                    <label></label>
+                    <p></p>
+                </div>
+                <div class="setting-control"></div>
+            `;
+            const label = item.querySelector('.setting-info label');
+            label.textContent = `${token.name} (${token.prefix}…)`;
+            if (token.expired) {
+                const badge = document.createElement('span');
+                badge.className = 'api-token-expired';
+                badge.textContent = '已过期';
+                label.appendChild(badge);
+            }
+            const lastUsed = token.last_used_at ? `最近使用 ${token.last_used_at} (${token.last_used_ip})` : '从未使用';
+            item.querySelector('.setting-info p').textContent =
+                `${token.scopes.join(', ')} · ${lastUsed} · 到期 ${token.expires_at}`;
+
+            const button = document.createElement('button');
+            button.className = 'btn btn-outline';
+            button.textContent = '吊销';
+            button.addEventListener('click', () => this.revokeAPIToken(token, button));
+            item.querySelector('.setting-control').appendChild(button);
+            container.appendChild(item);
+        });
+    }
+
+    async createAPIToken(form) {
+        const scopes = Array.from(form.querySelectorAll('input[name="scopes"]:checked')).map(input => input.value);
+        if (scopes.length === 0) {
+            this.showNotification('至少选择一项权限', 'error');
+            return;
+        }
+
+        const button = form.querySelector('button[type="submit"]');
+        button.disabled = true;
+        try {
+            const result = await this.mfaRequest('/api/v1/me/tokens', 'POST', {
+                name: form.name.value.trim(),
+                scopes,
+                expires_in_days: parseInt(form.expires_in_days.value, 10)
+            });
+            form.reset();
+            this.showCreatedAPIToken(result.token, result.message);
+            await this.loadAPITokens();
+        } catch (error) {
+            this.showNotification(error.message || '生成令牌失败，请重试', 'error');
+        } finally {
+            button.disabled = false;
+        }
+    }
+
+    showCreatedAPIToken(token, message) {
+        const panel = document.getElementById('apiTokenCreated');
+        if (!panel) return;
+
+        panel.hidden = false;
+        panel.innerHTML = `
+            <p></p>
+            <code class="mfa-secret"></code>
+            <div class="form-actions">
+                <button type="button" class="btn btn-outline" id="copyAPIToken">复制</button>
+                <button type="button" class="btn btn-primary" id="apiTokenSaved">我已保存</button>
+            </div>
+        `;
+        panel.querySelector('p').textContent = message;
+        panel.querySelector('code').textContent = token;
+        document.getElementById('copyAPIToken').addEventListener('click', async () => {
+            try {
+                await navigator.clipboard.writeText(token);
+                this.showNotification('已复制到剪贴板', 'success');
+            } catch (error) {
+                this.showNotification('复制失败，请手动选择复制', 'error');
+            }
+        });
+        document.getElementById('apiTokenSaved').addEventListener('click', () => {
+            panel.hidden = true;
+            panel.innerHTML = '';
+        });
+    }
+
+    async revokeAPIToken(token, button) {
+        if (!confirm(`确定吊销令牌「${token.name}」吗？使用该令牌的脚本将立即无法访问`)) return;
+
+        button.disabled = true;
+        try {
+            const result = await this.mfaRequest(`/api/v1/me/tokens/${token.id}`, 'DELETE');
+            this.showNotification(result.message, 'success');
+            this.renderAPITokens(result.tokens);
+        } catch (error) {
+            button.disabled = false;
+            this.showNotification(error.message || '吊销失败，请重试', 'error');
+        }
+    }
+
+    // ===== 单点登录绑定 =====
+    async loadOIDCIdentities() {
+        const section = document.getElementById('oidcSection');
+        const container = document.getElementById('oidcIdentities');
+        if (!section || !container) return;
+
+        try {
+            const [providers, linked] = await Promise.all([
+                this.mfaRequest('/api/v1/oidc/providers'),
+                this.mfaRequest('/api/v1/oidc/identities')
+            ]);
+            // 没有配置身份提供者且没有历史绑定时不显示
+            if (providers.providers.length === 0 && linked.identities.length === 0) {
+                section.hidden = true;
+                return;
+            }
+            section.hidden = false;
+            container.innerHTML = '';
+
+            const names = new Set(providers.providers.map(p => p.name));
+            const items = providers.providers.map(p => ({ name: p.name, displayName: p.display_name }));
+            linked.identities.forEach(identity => {
+                if (!names.has(identity.provider)) {
+                    items.push({ name: identity.provider, displayName: identity.provider });
+                }
+            });
+
+            items.forEach(provider => {
+                const identity = linked.identities.find(i => i.provider === provider.name);
+                const item = document.createElement('div');
+                item.className = 'setting-item';
+                item.innerHTML = `
+                    <div class="setting-info">
+                        <label></label>
+                        <p></p>
+                    </div>
+                    <div class="setting-control">
+                        <button class="btn btn-outline"></button>
+                    </div>
+                `;
+                item.querySelector('.setting-info label').textContent = provider.displayName;
+                item.querySelector('.setting-info p').textContent = identity
+                    ? `已绑定 ${identity.email || ''}，绑定时间 ${identity.linked_at}`
+                    : '绑定后可以使用该身份提供者直接登录';
+                const button = item.querySelector('button');
+                if (identity) {
+                    button.textContent = '解除绑定';
+                    button.addEventListener('click', () => this.unlinkOIDCIdentity(provider, button));
+                } else {
+                    button.textContent = '绑定';
+                    button.addEventListener('click', () => this.linkOIDCIdentity(provider.name, button));
+                }
+                container.appendChild(item);
+            });
+        } catch (error) {
+            console.error('获取单点登录绑定失败:', error);
+            section.hidden = true;
+        }
+    }
+
+    async linkOIDCIdentity(providerName, button) {
+        button.disabled = true;
+        try {
+            const result = await this.mfaRequest(`/api/v1/oidc/${encodeURIComponent(providerName)}/link`, 'POST');
+            window.location.href = result.authorize_url;
+        } catch (error) {
+            button.disabled = false;
+            this.showNotification(error.message || '绑定失败，请重试', 'error');
+        }
+    }
+
+    async unlinkOIDCIdentity(provider, button) {
+        if (!confirm(`确定解除与 ${provider.displayName} 的绑定吗？解除后将不能再用它登录此账号`)) return;
+
+        button.disabled = true;
+        try {
+            const result = await this.mfaRequest(`/api/v1/oidc/identities/${encodeURIComponent(provider.name)}`, 'DELETE');
+            this.showNotification(result.message, 'success');
+            this.loadOIDCIdentities();
+        } catch (error) {
+            button.disabled = false;
+            this.showNotification(error.message || '解除绑定失败，请重试', 'error');
+        }
+    }
+
+    handleOIDCLinkResult() {
+        // 绑定回调页面把结果放在 sessionStorage 中，回到用户中心后显示并打开账户安全
+        const stored = sessionStorage.getItem('oidcLinkResult');
+        if (!stored) return;
+        sessionStorage.removeItem('oidcLinkResult');
+
+        try {
+            const result = JSON.parse(stored);
+            if (result.error) {
+                this.showNotification(result.error, 'error');
+            } else {
+                this.showNotification(result.message || '绑定成功', 'success');
+            }
+            this.switchSection('security');
+        } catch (error) {
+            console.error('解析单点登录绑定结果失败:', error);
+        }
+    }
+
+    async resendVerification(button) {
+        button.disabled = true;
+        try {
+            const response = await fetch('/api/v1/verify-email/resend', {
+                method: 'POST',
+                headers: { 'Authorization': `Bearer ${this.getAuthToken()}` }
+            });
+            const result = await response.json();
+            if (!response.ok) {
+                throw new Error(result.error || '发送失败');
+            }
+            this.showNotification(result.message, 'success');
+        } catch (error) {
+            console.error('重新发送验证邮件失败:', error);
+            this.showNotification(error.message || '发送失败，请稍后重试', 'error');
+        } finally {
+            button.disabled = false;
+        }
+    }
+
+    // ===== 数据与隐私 =====
+    async privacyRequest(url, method = 'GET', body = null) {
+        const options = {
+            method,
+            headers: {
+                'Authorization': `Bearer ${this.getAuthToken()}`,
+                'Accept': 'application/json'
+            }
+        };
+        if (body) {
+            options.headers['Content-Type'] = 'application/json';
+            options.body = JSON.stringify(body);
+        }
+        const response = await fetch(url, options);
+        const result = await response.json();
+        if (!response.ok || result.code !== 200) {
+            throw new Error(result.message || result.error || '操作失败');
+        }
+        return result;
+    }
+
+    async loadPrivacy() {
+        try {
+            const result = await this.privacyRequest('/api/v1/me/privacy');
+            this.renderPrivacy(result.data);
+        } catch (error) {
+            console.error('获取个人数据状态失败:', error);
+            this.showNotification(error.message || '获取个人数据状态失败', 'error');
+        }
+
+        const exportBtn = document.getElementById('requestExportBtn');
+        if (exportBtn && !exportBtn.dataset.bound) {
+            exportBtn.dataset.bound = 'true';
+            exportBtn.addEventListener('click', () => this.requestDataExport(exportBtn));
+        }
+        const form = document.getElementById('deletionForm');
+        if (form && !form.dataset.bound) {
+            form.dataset.bound = 'true';
+            form.addEventListener('submit', (e) => {
+                e.preventDefault();
+                this.requestAccountDeletion(form);
+            });
+        }
+        const cancelBtn = document.getElementById('cancelDeletionBtn');
+        if (cancelBtn && !cancelBtn.dataset.bound) {
+            cancelBtn.dataset.bound = 'true';
+            cancelBtn.addEventListener('click', () => this.cancelAccountDeletion(cancelBtn));
+        }
+    }
+
+    renderPrivacy(data) {
+        this.renderDataExports(data.exports || []);
+
+        const banner = document.getElementById('deletionBanner');
+        const form = document.getElementById('deletionForm');
+        const hint = document.getElementById('deletionHint');
+        const deletion = data.deletion;
+        if (banner) {
+            banner.hidden = !deletion;
+            if (deletion) {
+                const transfer = deletion.transfer_to_email ? `，课程将转交给 ${deletion.transfer_to_email}` : '';
+                document.getElementById('deletionBannerText').textContent = deletion.status === 'processing'
+                    ? '账号正在注销，无法撤销'
+                    : `账号将于 ${deletion.scheduled_for} 注销${transfer}，在此之前可以撤销`;
+                document.getElementById('cancelDeletionBtn').hidden = deletion.status !== 'scheduled';
+            }
+        }
+        if (form) {
+            form.hidden = !!deletion;
+        }
+        if (hint && data.grace_days) {
+            hint.dataset.base = hint.dataset.base || hint.textContent;
+            hint.textContent = `${hint.dataset.base}。申请后有 ${data.grace_days} 天宽限期，期间可以撤销`;
+        }
+
+        // 有导出正在打包时定时刷新，离开此模块后停止
+        clearTimeout(this.privacyRefreshTimer);
+        const inProgress = (data.exports || []).some(item => item.status === 'pending' || item.status === 'processing');
+        if (inProgress) {
+            this.privacyRefreshTimer = setTimeout(() => {
+                if (this.currentSection === 'privacy') this.loadPrivacy();
+            }, 10000);
+        }
+    }
+
+    renderDataExports(exports) {
+        const container = document.getElementById('exportList');
+        if (!container) return;
+
+        const statusLabels = {
+            pending: '排队中',
+            processing: '打包中',
+            ready: '可下载',
+            failed: '失败',
+            expired: '已过期'
+        };
+        container.innerHTML = '';
+        exports.forEach(item => {
+            const row = document.createElement('div');
+            row.className = 'setting-item';
+            row.innerHTML = `
+                <div class="setting-info">
+                    <label></label>
+                    <p></p>
+                </div>
+                <div class="setting-control"></div>
+            `;
+            const label = row.querySelector('.setting-info label');
+            label.textContent = `申请于 ${item.created_at}`;
+            const badge = document.createElement('span');
+            badge.className = `export-status ${item.status}`;
+            badge.textContent = statusLabels[item.status] || item.status;
+            label.appendChild(badge);
+
+            let detail = '';
+            if (item.status === 'ready') {
+                detail = `${this.formatFileSize(item.file_size || 0)} · 下载链接 ${item.expires_at} 失效`;
+            } else if (item.status === 'failed') {
+                detail = item.error || '打包失败，请重新申请';
+            } else if (item.status === 'expired') {
+                detail = '导出文件已删除，如需下载请重新申请';
+            } else {
+                detail = '正在打包，完成后会发送邮件通知';
+            }
+            row.querySelector('.setting-info p').textContent = detail;
+
+            if (item.status === 'ready') {
+                const button = document.createElement('button');
+                button.className = 'btn btn-outline';
+                button.innerHTML = '<i class="fas fa-download"></i> 下载';
+                button.addEventListener('click', () => this.downloadDataExport(item, button));
+                row.querySelector('.setting-control').appendChild(button);
+            }
+            container.appendChild(row);
+        });
+    }
+
+    formatFileSize(bytes) {
+        if (bytes < 1024) return `${bytes} B`;
+        if (bytes < 1024 * 1024) return `${(bytes / 1024).toFixed(1)} KB`;
+        return `${(bytes / 1024 / 1024).toFixed(1)} MB`;
+    }
+
+    async requestDataExport(button) {
+        button.disabled = true;
+        try {
+            const result = await this.privacyRequest('/api/v1/me/exports', 'POST');
+            this.showNotification(result.message, 'success');
+            this.renderPrivacy(result.data);
+        } catch (error) {
+            this.showNotification(error.message || '申请导出失败，请重试', 'error');
+        } finally {
+            button.disabled = false;
+        }
+    }
+
+    async downloadDataExport(item, button) {
+        button.disabled = true;
+        try {
+            // 下载接口需要认证头，先取回压缩包再保存
+            const response = await fetch(`/api/v1/me/exports/${item.id}/download`, {
+                headers: { 'Authorization': `Bearer ${this.getAuthToken()}` }
+            });
+            if (!response.ok) {
+                const result = await response.json().catch(() => ({}));
+                throw new Error(result.message || '下载失败');
+            }
+
+            const blob = await response.blob();
+            const url = URL.createObjectURL(blob);
+            const link = document.createElement('a');
+            link.href = url;
+            link.download = `data-export-${item.id}.zip`;
+            document.body.appendChild(link);
+            link.click();
+            link.remove();
+            URL.revokeObjectURL(url);
+        } catch (error) {
+            this.showNotification(error.message || '下载失败，请重试', 'error');
+            this.loadPrivacy();
+        } finally {
+            button.disabled = false;
+        }
+    }
+
+    async requestAccountDeletion(form) {
+        if (!confirm('确定注销账号吗？宽限期结束后账号和个人数据将被永久删除，无法恢复')) return;
+
+        const button = form.querySelector('button[type="submit"]');
+        button.disabled = true;
+        try {
+            const result = await this.privacyRequest('/api/v1/me/deletion', 'POST', {
+                confirm_email: form.confirm_email.value.trim(),
+                transfer_to_email: form.transfer_to_email.value.trim()
+            });
+            form.reset();
+            this.showNotification(result.message, 'success');
+            this.renderPrivacy(result.data);
+        } catch (error) {
+            this.showNotification(error.message || '申请注销失败，请重试', 'error');
+        } finally {
+            button.disabled = false;
+        }
+    }
+
+    async cancelAccountDeletion(button) {
+        button.disabled = true;
+        try {
+            const result = await this.privacyRequest('/api/v1/me/deletion', 'DELETE');
+            this.showNotification(result.message, 'success');
+            this.renderPrivacy(result.data);
+        } catch (error) {
+            this.showNotification(error.message || '撤销失败，请重试', 'error');
+        } finally {
+            button.disabled = false;
+        }
+    }
+
     // ===== 用户操作 =====
     logout() {
         console.log('👋 用户退出登录');
         
-        // 清除认证数据
+        // 断开实时通知并清除认证数据
+        if (this.notificationStream) {
+            this.notificationStream.abort();
+        }
         this.clearAuthData();
         
         // 显示通知
@@ -1035,6 +2221,12 @@ class UserDashboard {
     }
 
     loadDefaultSection() {
+        // 邮件中的链接通过 #privacy 等锚点直接打开对应模块
+        const section = window.location.hash.slice(1);
+        if (section && document.getElementById(`${section}-section`)) {
+            this.switchSection(section);
+            return;
+        }
         this.switchSection('learning-progress');
     }
 
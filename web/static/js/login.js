@@ -12,8 +12,12 @@ class LoginPage {
             this.showRegistrationSuccessAlert();
             this.initAuthForm();
             this.initMobileMenu();
+            if (this.handleOIDCResult()) {
+                return;
+            }
             this.checkExistingAuth();
             this.handleURLParams();
+            this.loadSSOProviders();
         });
     }
     
@@ -146,6 +150,56 @@ class LoginPage {
         return urlParams.get('redirect') || '/';
     }
     
+    async loadSSOProviders() {
+        // 获取已配置的身份提供者，没有时不显示单点登录
+        try {
+            const response = await fetch('/api/v1/oidc/providers');
+            const result = await response.json();
+            const providers = result.providers || [];
+            if (!response.ok || providers.length === 0) {
+                return;
+            }
+
+            const redirect = new URLSearchParams(window.location.search).get('redirect');
+            const query = redirect ? `?redirect=${encodeURIComponent(redirect)}` : '';
+            const container = document.getElementById('ssoProviders');
+            container.innerHTML = '';
+            providers.forEach(provider => {
+                const link = document.createElement('a');
+                link.className = 'sso-btn';
+                link.href = `/auth/oidc/${encodeURIComponent(provider.name)}/login${query}`;
+                link.innerHTML = '<i class="fas fa-building"></i> ';
+                link.appendChild(document.createTextNode(`使用 ${provider.display_name} 登录`));
+                container.appendChild(link);
+            });
+            document.getElementById('ssoLogin').style.display = 'block';
+        } catch (error) {
+            console.log('获取单点登录配置失败:', error);
+        }
+    }
+
+    handleOIDCResult() {
+        // 单点登录回调页面把登录结果放在 sessionStorage 中，处理方式与密码登录相同
+        const stored = sessionStorage.getItem('oidcLoginResult');
+        if (!stored) {
+            return false;
+        }
+        sessionStorage.removeItem('oidcLoginResult');
+
+        try {
+            const result = JSON.parse(stored);
+            if (result.mfa_required) {
+                this.showMFAStep(result, false);
+            } else {
+                this.handleLoginSuccess(result, false);
+            }
+            return true;
+        } catch (error) {
+            console.error('解析单点登录结果失败:', error);
+            return false;
+        }
+    }
+
     handleURLParams() {
         // 处理URL参数
         const urlParams = new URLSearchParams(window.location.search);
@@ -224,7 +278,10 @@ class LoginPage {
             
             const result = await response.json();
             
-            if (response.ok) {
+            if (response.ok && result.mfa_required) {
+                // 需要两步验证：输入验证码，或按账号类型要求先绑定身份验证器
+                this.showMFAStep(result, formData.rememberMe === 'on');
+            } else if (response.ok) {
                 // 登录成功
                 console.log('登录成功:', result);
                 this.handleLoginSuccess(result, formData.rememberMe === 'on');
@@ -242,6 +299,128 @@ class LoginPage {
         }
     }
     
+    showMFAStep(loginResult, rememberMe) {
+        const container = document.getElementById('authFormContainer');
+        const setup = loginResult.mfa_setup_required;
+        container.innerHTML = `
+            <div class="auth-form-container">
+                <div class="auth-form-card">
+                    <div class="auth-form-header">
+                        <h2 class="auth-title">两步验证</h2>
+                        <p class="auth-subtitle">${setup
+                            ? '你的账号类型要求启用两步验证，请用身份验证器（如 Google Authenticator）扫描二维码'
+                            : '请输入身份验证器中的6位验证码，手机不在身边时可以输入恢复码'}</p>
+                    </div>
+                    <div class="auth-error-area" id="mfaErrorArea" style="display: none;">
+                        <div class="error-message">
+                            <i class="fas fa-exclamation-circle"></i>
+                            <span id="mfaErrorText"></span>
+                        </div>
+                    </div>
+                    ${setup ? `
+                    <div class="mfa-setup" id="mfaSetup">
+                        <div class="mfa-qrcode" id="mfaQRCode"></div>
+                        <p class="mfa-secret">无法扫码时手动输入密钥：<code id="mfaSecret"></code></p>
+                    </div>` : ''}
+                    <form class="auth-form" id="mfaForm">
+                        <div class="form-group">
+                            <label for="mfaCode" class="form-label">验证码</label>
+                            <div class="input-wrapper">
+                                <i class="fas fa-shield-halved input-icon"></i>
+                                <input type="text" id="mfaCode" name="code" class="form-input"
+                                       placeholder="${setup ? '6位验证码' : '6位验证码或恢复码'}"
+                                       autocomplete="one-time-code" required>
+                            </div>
+                        </div>
+                        <button type="submit" class="auth-submit-btn" id="mfaSubmitBtn">${setup ? '启用并登录' : '验证'}</button>
+                    </form>
+                    <div class="auth-footer">
+                        <p class="auth-link"><a href="/login" class="link-primary">返回重新登录</a></p>
+                    </div>
+                </div>
+            </div>
+        `;
+
+        const showError = (message) => {
+            document.getElementById('mfaErrorText').textContent = message;
+            document.getElementById('mfaErrorArea').style.display = 'block';
+        };
+        const post = async (url, body) => {
+            const response = await fetch(url, {
+                method: 'POST',
+                headers: { 'Content-Type': 'application/json' },
+                body: JSON.stringify(body)
+            });
+            const result = await response.json();
+            if (!response.ok) {
+                throw new Error(result.error || '验证失败，请重试');
+            }
+            return result;
+        };
+
+        if (setup) {
+            post('/api/v1/login/mfa/setup', { mfa_token: loginResult.mfa_token })
+                .then(result => {
+                    document.getElementById('mfaSecret').textContent = result.secret;
+                    this.renderQRCode(document.getElementById('mfaQRCode'), result.provisioning_uri);
+                })
+                .catch(error => showError(error.message));
+        }
+
+        const form = document.getElementById('mfaForm');
+        form.addEventListener('submit', async (e) => {
+            e.preventDefault();
+            const button = document.getElementById('mfaSubmitBtn');
+            button.disabled = true;
+            try {
+                const code = form.code.value.trim();
+                const result = setup
+                    ? await post('/api/v1/login/mfa/enable', { mfa_token: loginResult.mfa_token, code })
+                    : await post('/api/v1/login/mfa', { mfa_token: loginResult.mfa_token, code });
+                if (setup && result.recovery_codes) {
+                    this.showRecoveryCodes(container, result, rememberMe);
+                } else {
+                    this.handleLoginSuccess(result, rememberMe);
+                }
+            } catch (error) {
+                showError(error.message);
+            } finally {
+                button.disabled = false;
+            }
+        });
+        document.getElementById('mfaCode').focus();
+    }
+
+    showRecoveryCodes(container, result, rememberMe) {
+        container.innerHTML = `
+            <div class="auth-form-container">
+                <div class="auth-form-card">
+                    <div class="auth-form-header">
+                        <h2 class="auth-title">保存恢复码</h2>
+                        <p class="auth-subtitle">手机丢失时可用恢复码登录，每个只能使用一次。恢复码只显示这一次，请抄写或下载后妥善保存</p>
+                    </div>
+                    <ul class="recovery-codes">
+                        ${result.recovery_codes.map(code => `<li><code>${code}</code></li>`).join('')}
+                    </ul>
+                    <button type="button" class="auth-submit-btn" id="recoveryCodesSaved">我已保存，继续</button>
+                </div>
+            </div>
+        `;
+        document.getElementById('recoveryCodesSaved').addEventListener('click', () => {
+            this.handleLoginSuccess(result, rememberMe);
+        });
+    }
+
+    renderQRCode(element, uri) {
+        element.innerHTML = '';
+        if (typeof QRCode === 'undefined') {
+            // 二维码脚本加载失败时只显示手动输入的密钥
+            element.style.display = 'none';
+            return;
+        }
+        new QRCode(element, { text: uri, width: 180, height: 180 });
+    }
+
     validateLoginData(formData) {
         // 用户名/邮箱验证
         if (!formData.identifier || formData.identifier.trim().length < 3) {
@@ -0,0 +1,76 @@
+// 找回密码与重置密码页面
+(function () {
+    const errorArea = document.getElementById('errorArea');
+    const successArea = document.getElementById('successArea');
+
+    function showError(message) {
+        successArea.style.display = 'none';
+        document.getElementById('errorText').textContent = message;
+        errorArea.style.display = 'block';
+    }
+
+    function showSuccess(message) {
+        errorArea.style.display = 'none';
+        document.getElementById('successText').textContent = message;
+        successArea.style.display = 'block';
+    }
+
+    async function submit(form, url, payload) {
+        const button = form.querySelector('button[type="submit"]');
+        button.disabled = true;
+        try {
+            const response = await fetch(url, {
+                method: 'POST',
+                headers: { 'Content-Type': 'application/json' },
+                body: JSON.stringify(payload)
+            });
+            const result = await response.json();
+            if (!response.ok) {
+                showError(result.error || '请求失败，请稍后重试');
+                return false;
+            }
+            showSuccess(result.message);
+            return true;
+        } catch (error) {
+            showError('网络错误，请稍后重试');
+            return false;
+        } finally {
+            button.disabled = false;
+        }
+    }
+
+    const forgotForm = document.getElementById('forgotPasswordForm');
+    if (forgotForm) {
+        forgotForm.addEventListener('submit', async (e) => {
+            e.preventDefault();
+            const email = forgotForm.email.value.trim();
+            if (await submit(forgotForm, '/api/v1/password/forgot', { email })) {
+                forgotForm.reset();
+            }
+        });
+    }
+
+    const resetForm = document.getElementById('resetPasswordForm');
+    if (resetForm) {
+        resetForm.addEventListener('submit', async (e) => {
+            e.preventDefault();
+            const newPassword = resetForm.newPassword.value;
+            if (newPassword !== resetForm.confirmPassword.value) {
+                showError('两次输入的密码不一致');
+                return;
+            }
+            const ok = await submit(resetForm, '/api/v1/password/reset', {
+                token: resetForm.dataset.token,
+                new_password: newPassword
+            });
+            if (ok) {
+                // 旧的登录令牌已失效，清除后跳转登录页
+                localStorage.removeItem('authToken');
+                localStorage.removeItem('tokenExpiry');
+                localStorage.removeItem('userInfo');
+                resetForm.style.display = 'none';
+                setTimeout(() => { window.location.href = '/login'; }, 2000);
+            }
+        });
+    }
+})();
@@ -0,0 +1,213 @@
+// ===== 课程测验答题功能 =====
+
+let activeAttempt = null;
+let activeQuiz = null;
+let quizTimerHandle = null;
+
+// 获取认证请求头
+function quizAuthHeaders() {
+    const token = localStorage.getItem('authToken') || sessionStorage.getItem('authToken');
+    return token ? { 'Authorization': `Bearer ${token}`, 'Content-Type': 'application/json' } : null;
+}
+
+// HTML转义，避免题干中的内容被当作标签渲染
+function escapeQuizHTML(text) {
+    const div = document.createElement('div');
+    div.textContent = text == null ? '' : String(text);
+    return div.innerHTML;
+}
+
+// 开始（或继续）测验
+async function startQuiz(quizId) {
+    const headers = quizAuthHeaders();
+    if (!headers) {
+        showNotification('请先登录后再参加测验', 'warning');
+        return;
+    }
+
+    try {
+        const response = await fetch(`/api/v1/quizzes/${quizId}/attempts`, { method: 'POST', headers });
+        const result = await response.json();
+        if (!response.ok || result.code !== 200) {
+            showNotification(result.message || '开始测验失败', 'error');
+            return;
+        }
+
+        activeAttempt = result.data.attempt;
+        activeQuiz = result.data.quiz;
+        renderQuizQuestions();
+        startQuizTimer();
+        document.getElementById('quizModal').hidden = false;
+    } catch (error) {
+        console.error('开始测验失败:', error);
+        showNotification('网络错误，请稍后重试', 'error');
+    }
+}
+
+// 渲染题目
+function renderQuizQuestions() {
+    document.getElementById('quizModalTitle').textContent = activeQuiz.title;
+    document.getElementById('quizSubmitBtn').hidden = false;
+
+    const form = document.getElementById('quizForm');
+    form.innerHTML = (activeQuiz.questions || []).map((q, index) => {
+        const name = `question_${q.id}`;
+        let inputs = '';
+        switch (q.type) {
+            case 'single_choice':
+            case 'multiple_choice': {
+                const inputType = q.type === 'single_choice' ? 'radio' : 'checkbox';
+                inputs = (q.options || []).map((option, i) => `
+                    <label><input type="${inputType}" name="${name}" value="${i}"> ${escapeQuizHTML(option)}</label>
+                `).join('');
+                break;
+            }
+            case 'true_false':
+                inputs = `
+                    <label><input type="radio" name="${name}" value="true"> 正确</label>
+                    <label><input type="radio" name="${name}" value="false"> 错误</label>
+                `;
+                break;
+            default:
+                inputs = `<input type="text" name="${name}" autocomplete="off">`;
+        }
+        return `
+            <div class="quiz-question" data-question-id="${q.id}">
+                <p>${index + 1}. ${escapeQuizHTML(q.content)} <small>(${q.score} 分)</small></p>
+                ${inputs}
+            </div>
+        `;
+    }).join('');
+}
+
+// 启动倒计时，时间到自动提交
+function startQuizTimer() {
+    stopQuizTimer();
+    const timer = document.getElementById('quizTimer');
+    if (!activeAttempt.expires_at) {
+        timer.textContent = '';
+        return;
+    }
+
+    const expiresAt = new Date(activeAttempt.expires_at).getTime();
+    const tick = () => {
+        const remaining = Math.max(0, Math.floor((expiresAt - Date.now()) / 1000));
+        const minutes = String(Math.floor(remaining / 60)).padStart(2, '0');
+        const seconds = String(remaining % 60).padStart(2, '0');
+        timer.textContent = `${minutes}:${seconds}`;
+        timer.classList.toggle('warning', remaining <= 60);
+        if (remaining === 0) {
+            stopQuizTimer();
+            showNotification('答题时间已到，正在自动提交', 'warning');
+            submitQuiz();
+        }
+    };
+    tick();
+    quizTimerHandle = setInterval(tick, 1000);
+}
+
+function stopQuizTimer() {
+    if (quizTimerHandle) {
+        clearInterval(quizTimerHandle);
+        quizTimerHandle = null;
+    }
+}
+
+// 收集答案
+function collectQuizAnswers() {
+    const form = document.getElementById('quizForm');
+    return (activeQuiz.questions || []).map(q => {
+        const name = `question_${q.id}`;
+        let answers;
+        if (q.type === 'fill_in') {
+            const input = form.querySelector(`input[name="${name}"]`);
+            answers = input && input.value.trim() ? [input.value.trim()] : [];
+        } else {
+            answers = Array.from(form.querySelectorAll(`input[name="${name}"]:checked`)).map(i => i.value);
+        }
+        return { question_id: q.id, answers };
+    });
+}
+
+// 提交答卷
+async function submitQuiz() {
+    if (!activeAttempt) {
+        return;
+    }
+    const headers = quizAuthHeaders();
+    const submitBtn = document.getElementById('quizSubmitBtn');
+    submitBtn.disabled = true;
+
+    try {
+        const response = await fetch(`/api/v1/quizzes/attempts/${activeAttempt.id}/submit`, {
+            method: 'POST',
+            headers,
+            body: JSON.stringify({ answers: collectQuizAnswers() })
+        });
+        const result = await response.json();
+        if (!response.ok || result.code !== 200) {
+            showNotification(result.message || '提交失败', 'error');
+            return;
+        }
+
+        stopQuizTimer();
+        renderQuizResult(result.data, result.message);
+        activeAttempt = null;
+    } catch (error) {
+        console.error('提交答卷失败:', error);
+        showNotification('网络错误，请稍后重试', 'error');
+    } finally {
+        submitBtn.disabled = false;
+    }
+}
+
+// 展示评分结果
+function renderQuizResult(attempt, message) {
+    const form = document.getElementById('quizForm');
+    const results = {};
+    (attempt.results || []).forEach(r => { results[r.question_id] = r; });
+
+    const summary = document.createElement('div');
+    summary.className = 'quiz-summary';
+    summary.textContent = `${message}：得分 ${attempt.score || 0} / ${attempt.max_score || 0}，${attempt.passed ? '已通过' : '未通过'}`;
+    form.prepend(summary);
+
+    form.querySelectorAll('.quiz-question').forEach(el => {
+        const result = results[el.getAttribute('data-question-id')];
+        el.querySelectorAll('input').forEach(input => { input.disabled = true; });
+        if (!result) {
+            return;
+        }
+        el.classList.add(result.correct ? 'correct' : 'incorrect');
+
+        const feedback = document.createElement('div');
+        feedback.className = 'quiz-feedback';
+        const question = (activeQuiz.questions || []).find(q => String(q.id) === String(result.question_id));
+        const correct = (result.correct_answers || []).map(a => formatQuizAnswer(question, a)).join('、');
+        feedback.innerHTML = `标准答案：${escapeQuizHTML(correct)}` +
+            (result.explanation ? `<br>解析：${escapeQuizHTML(result.explanation)}` : '');
+        el.appendChild(feedback);
+    });
+
+    document.getElementById('quizSubmitBtn').hidden = true;
+}
+
+// 将答案值转换为可读文本
+function formatQuizAnswer(question, answer) {
+    if (!question) {
+        return answer;
+    }
+    if (question.type === 'true_false') {
+        return answer === 'true' ? '正确' : '错误';
+    }
+    if (question.type === 'single_choice' || question.type === 'multiple_choice') {
+        return (question.options || [])[Number(answer)] || answer;
+    }
+    return answer;
+}
+
+// 关闭答题弹窗，未提交的答题记录在时限内可继续作答
+function closeQuiz() {
+    stopQuizTimer();
+    document.getElementById('quizModal').hidden = true;
+}
@@ -169,11 +169,11 @@ class RegisterPage {
     
     handleRegisterSuccess(result) {
         // 显示成功消息
-        this.showNotification('注册成功！正在跳转到登录页面...', 'success');
+        this.showNotification('注册成功！验证邮件已发送，请查收。正在跳转到登录页面...', 'success');
         
         // 保存成功信息到sessionStorage，供登录页面显示
         sessionStorage.setItem('registrationSuccess', JSON.stringify({
-            message: '注册成功！请使用您的账户信息登录',
+            message: '注册成功！请查收验证邮件，验证后即可购买和发布课程',
             username: result.username || '',
             timestamp: Date.now()
         }));